@@ -102,6 +102,9 @@ import (
 	"github.com/NibiruChain/nibiru/x/epochs"
 	epochskeeper "github.com/NibiruChain/nibiru/x/epochs/keeper"
 	epochstypes "github.com/NibiruChain/nibiru/x/epochs/types"
+	"github.com/NibiruChain/nibiru/x/oracle"
+	oraclekeeper "github.com/NibiruChain/nibiru/x/oracle/keeper"
+	oracletypes "github.com/NibiruChain/nibiru/x/oracle/types"
 	"github.com/NibiruChain/nibiru/x/perp"
 	perpkeeper "github.com/NibiruChain/nibiru/x/perp/keeper"
 	perptypes "github.com/NibiruChain/nibiru/x/perp/types"
@@ -146,6 +149,9 @@ var (
 			upgradeclient.CancelProposalHandler,
 			pricefeedcli.AddOracleProposalHandler,
 			vpoolcli.CreatePoolProposalHandler,
+			vpoolcli.ShutdownPoolProposalHandler,
+			vpoolcli.EditPoolConfigProposalHandler,
+			vpoolcli.RepegPoolProposalHandler,
 			// pricefeedcli.RemoveOracleProposalHandler, // TODO
 			ibcclientclient.UpdateClientProposalHandler,
 			ibcclientclient.UpgradeProposalHandler,
@@ -163,6 +169,7 @@ var (
 		ibctransfer.AppModuleBasic{},
 		// native x/
 		pricefeed.AppModuleBasic{},
+		oracle.AppModuleBasic{},
 		epochs.AppModuleBasic{},
 		perp.AppModuleBasic{},
 		vpool.AppModuleBasic{},
@@ -171,19 +178,21 @@ var (
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:       nil,
-		distrtypes.ModuleName:            nil,
-		minttypes.ModuleName:             {authtypes.Minter},
-		stakingtypes.BondedPoolName:      {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName:   {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:              {authtypes.Burner},
-		ibctransfertypes.ModuleName:      {authtypes.Minter, authtypes.Burner},
-		perptypes.ModuleName:             {authtypes.Minter, authtypes.Burner},
-		perptypes.VaultModuleAccount:     {},
-		perptypes.PerpEFModuleAccount:    {},
-		perptypes.FeePoolModuleAccount:   {},
-		epochstypes.ModuleName:           {},
-		common.TreasuryPoolModuleAccount: {},
+		authtypes.FeeCollectorName:           nil,
+		distrtypes.ModuleName:                nil,
+		minttypes.ModuleName:                 {authtypes.Minter},
+		stakingtypes.BondedPoolName:          {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:       {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:                  {authtypes.Burner},
+		ibctransfertypes.ModuleName:          {authtypes.Minter, authtypes.Burner},
+		perptypes.ModuleName:                 {authtypes.Minter, authtypes.Burner},
+		perptypes.VaultModuleAccount:         {},
+		perptypes.PerpEFModuleAccount:        {},
+		perptypes.FeePoolModuleAccount:       {},
+		perptypes.InsuranceFundModuleAccount: {authtypes.Minter, authtypes.Burner},
+		epochstypes.ModuleName:               {},
+		common.TreasuryPoolModuleAccount:     {},
+		oracletypes.ModuleName:               nil,
 	}
 )
 
@@ -253,6 +262,7 @@ type NibiruApp struct {
 	epochsKeeper    epochskeeper.Keeper
 	perpKeeper      perpkeeper.Keeper
 	pricefeedKeeper pricefeedkeeper.Keeper
+	oracleKeeper    oraclekeeper.Keeper
 	vpoolKeeper     vpoolkeeper.Keeper
 
 	// the module manager
@@ -313,6 +323,7 @@ func NewNibiruApp(
 		epochstypes.StoreKey,
 		perptypes.StoreKey,
 		vpooltypes.StoreKey,
+		oracletypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 
@@ -400,10 +411,19 @@ func NewNibiruApp(
 		app.GetSubspace(pricefeedtypes.ModuleName),
 	)
 
+	app.oracleKeeper = oraclekeeper.NewKeeper(
+		appCodec, keys[oracletypes.StoreKey], app.GetSubspace(oracletypes.ModuleName),
+		app.accountKeeper, app.bankKeeper, app.distrKeeper, &stakingKeeper,
+		distrtypes.ModuleName,
+	)
+	// the exchange rates voted by the validators are the index prices of x/vpool and x/perp
+	indexPriceKeeper := oraclekeeper.NewPricefeedAdapter(app.oracleKeeper)
+
 	app.vpoolKeeper = vpoolkeeper.NewKeeper(
 		appCodec,
 		keys[vpooltypes.StoreKey],
-		app.pricefeedKeeper,
+		app.GetSubspace(vpooltypes.ModuleName),
+		indexPriceKeeper,
 	)
 
 	app.epochsKeeper = epochskeeper.NewKeeper(
@@ -413,11 +433,14 @@ func NewNibiruApp(
 	app.perpKeeper = perpkeeper.NewKeeper(
 		appCodec, keys[perptypes.StoreKey],
 		app.GetSubspace(perptypes.ModuleName),
-		app.accountKeeper, app.bankKeeper, app.pricefeedKeeper, app.vpoolKeeper, app.epochsKeeper,
+		app.accountKeeper, app.bankKeeper, indexPriceKeeper, &app.vpoolKeeper, app.epochsKeeper,
 	)
 
+	// x/perp holds a pointer to the vpool keeper so that it sees the positions keeper set here
+	app.vpoolKeeper.SetPositionsKeeper(app.perpKeeper)
+
 	app.epochsKeeper.SetHooks(
-		epochstypes.NewMultiEpochHooks(app.perpKeeper.Hooks()),
+		epochstypes.NewMultiEpochHooks(app.perpKeeper.Hooks(), app.vpoolKeeper.Hooks()),
 	)
 
 	// ---------------------------------- IBC keepers
@@ -436,12 +459,12 @@ func NewNibiruApp(
 	govRouter := govtypes.NewRouter()
 	govRouter.
 		AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
+		AddRoute(paramproposal.RouterKey, perp.NewParamChangeProposalHandler(app.perpKeeper, params.NewParamChangeProposalHandler(app.paramsKeeper))).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.ibcKeeper.ClientKeeper)).
 		AddRoute(pricefeedtypes.RouterKey, pricefeed.NewPricefeedProposalHandler(app.pricefeedKeeper)).
-		AddRoute(vpooltypes.RouterKey, vpool.NewCreatePoolProposalHandler(app.vpoolKeeper))
+		AddRoute(vpooltypes.RouterKey, vpool.NewVpoolProposalHandler(app.vpoolKeeper))
 
 	app.transferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec,
@@ -493,11 +516,13 @@ func NewNibiruApp(
 	epochsModule := epochs.NewAppModule(appCodec, app.epochsKeeper)
 	perpModule := perp.NewAppModule(
 		appCodec, app.perpKeeper, app.accountKeeper, app.bankKeeper,
-		app.pricefeedKeeper,
+		indexPriceKeeper,
 	)
 	vpoolModule := vpool.NewAppModule(
-		appCodec, app.vpoolKeeper, app.pricefeedKeeper,
+		appCodec, app.vpoolKeeper, indexPriceKeeper,
 	)
+	oracleModule := oracle.NewAppModule(
+		appCodec, app.oracleKeeper, app.accountKeeper, app.bankKeeper)
 	utilModule := util.NewAppModule(app.bankKeeper)
 
 	// NOTE: Any module instantiated in the module manager that is later modified
@@ -524,6 +549,7 @@ func NewNibiruApp(
 
 		// native x/
 		pricefeedModule,
+		oracleModule,
 		epochsModule,
 		vpoolModule,
 		perpModule,
@@ -559,6 +585,7 @@ func NewNibiruApp(
 		stakingtypes.ModuleName,
 		// native x/
 		pricefeedtypes.ModuleName,
+		oracletypes.ModuleName,
 		epochstypes.ModuleName,
 		vpooltypes.ModuleName,
 		perptypes.ModuleName,
@@ -587,6 +614,7 @@ func NewNibiruApp(
 		// native x/
 		epochstypes.ModuleName,
 		pricefeedtypes.ModuleName,
+		oracletypes.ModuleName,
 		vpooltypes.ModuleName,
 		perptypes.ModuleName,
 		utiltypes.ModuleName,
@@ -619,6 +647,7 @@ func NewNibiruApp(
 		vestingtypes.ModuleName,
 		// native x/
 		pricefeedtypes.ModuleName,
+		oracletypes.ModuleName,
 		epochstypes.ModuleName,
 		vpooltypes.ModuleName,
 		perptypes.ModuleName,
@@ -641,6 +670,7 @@ func NewNibiruApp(
 		// no-op
 		return fromVM, nil
 	})
+	app.setOracleUpgrade()
 
 	// add test gRPC service for testing gRPC queries in isolation
 	testdata.RegisterQueryServer(app.GRPCQueryRouter(), testdata.QueryImpl{})
@@ -662,6 +692,7 @@ func NewNibiruApp(
 		authzmodule.NewAppModule(appCodec, app.authzKeeper, app.accountKeeper, app.bankKeeper, app.interfaceRegistry),
 		// native x/
 		pricefeedModule,
+		oracleModule,
 		epochsModule,
 		// ibc
 		capability.NewAppModule(appCodec, *app.capabilityKeeper),
@@ -922,11 +953,13 @@ func initParamsKeeper(
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	// Native module params keepers
 	paramsKeeper.Subspace(pricefeedtypes.ModuleName)
+	paramsKeeper.Subspace(oracletypes.ModuleName)
 	paramsKeeper.Subspace(epochstypes.ModuleName)
 	// ibc params keepers
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
 	paramsKeeper.Subspace(perptypes.ModuleName)
+	paramsKeeper.Subspace(vpooltypes.ModuleName)
 
 	return paramsKeeper
 }
@@ -0,0 +1,82 @@
+package app
+
+import (
+	"time"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	oraclekeeper "github.com/NibiruChain/nibiru/x/oracle/keeper"
+	oracletypes "github.com/NibiruChain/nibiru/x/oracle/types"
+	pricefeedkeeper "github.com/NibiruChain/nibiru/x/pricefeed/keeper"
+)
+
+// OracleUpgradeName is the upgrade that adds x/oracle to the chain and makes its
+// exchange rates the index prices of x/vpool and x/perp, in place of x/pricefeed.
+const OracleUpgradeName = "v0.14.0"
+
+// setOracleUpgrade registers the handler of the OracleUpgradeName upgrade and, when the
+// node is restarted to apply it, mounts the new x/oracle store.
+func (app *NibiruApp) setOracleUpgrade() {
+	app.upgradeKeeper.SetUpgradeHandler(OracleUpgradeName, func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// x/oracle is not part of fromVM, so it is initialized with its default genesis
+		versionMap, err := app.mm.RunMigrations(ctx, app.configurator, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		migratePricefeedToOracle(ctx, app.pricefeedKeeper, app.oracleKeeper)
+		return versionMap, nil
+	})
+
+	upgradeInfo, err := app.upgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(err)
+	}
+
+	if upgradeInfo.Name == OracleUpgradeName && !app.upgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storetypes.StoreUpgrades{
+			Added: []string{oracletypes.StoreKey},
+		}))
+	}
+}
+
+// migratePricefeedToOracle makes the validators vote on the pairs of x/pricefeed, and carries
+// over the current prices and the price snapshots within the snapshot retention window, so that
+// x/vpool and x/perp keep their index prices until the first vote period is tallied.
+func migratePricefeedToOracle(
+	ctx sdk.Context, pricefeedKeeper pricefeedkeeper.Keeper, oracleKeeper oraclekeeper.Keeper,
+) {
+	params := oracleKeeper.GetParams(ctx)
+	params.Whitelist = []string{}
+	for _, pair := range pricefeedKeeper.GetParams(ctx).Pairs {
+		params.Whitelist = append(params.Whitelist, pair.String())
+	}
+	oracleKeeper.SetParams(ctx, params)
+	oracleKeeper.ApplyWhitelist(ctx, params.Whitelist, map[string]struct{}{})
+
+	for _, currentPrice := range pricefeedKeeper.GetCurrentPrices(ctx) {
+		oracleKeeper.ExchangeRates.Insert(ctx, currentPrice.PairID, currentPrice.Price)
+	}
+
+	snapshots := pricefeedKeeper.PriceSnapshots.Iterate(ctx, collections.PairRange[common.AssetPair, time.Time]{}).Values()
+	cutoff := ctx.BlockTime().Add(-params.SnapshotRetentionWindow).UnixMilli()
+	for _, snapshot := range snapshots {
+		if snapshot.TimestampMs < cutoff {
+			continue
+		}
+		oracleKeeper.PriceSnapshots.Insert(
+			ctx,
+			collections.Join(snapshot.PairId, time.UnixMilli(snapshot.TimestampMs)),
+			oracletypes.PriceSnapshot{
+				Pair:        snapshot.PairId,
+				Price:       snapshot.Price,
+				TimestampMs: snapshot.TimestampMs,
+			},
+		)
+	}
+}
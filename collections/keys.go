@@ -2,6 +2,8 @@ package collections
 
 import (
 	"fmt"
+	"math"
+	"math/big"
 	"strconv"
 	"time"
 
@@ -19,6 +21,9 @@ var (
 	Uint64KeyEncoder KeyEncoder[uint64] = uint64Key{}
 	// ValAddressKeyEncoder can be used to encode sdk.ValAddress keys.
 	ValAddressKeyEncoder KeyEncoder[sdk.ValAddress] = valAddressKeyEncoder{}
+	// DecKeyEncoder can be used to encode sdk.Dec keys, negative ones included.
+	// The encoded keys sort in the same order as the decimals.
+	DecKeyEncoder KeyEncoder[sdk.Dec] = decKey{}
 )
 
 type stringKey struct{}
@@ -87,6 +92,59 @@ func (v valAddressKeyEncoder) Decode(b []byte) (int, sdk.ValAddress) {
 }
 func (v valAddressKeyEncoder) Stringify(key sdk.ValAddress) string { return key.String() }
 
+// decKey encodes a decimal as its sign, then the length of its absolute value and
+// the absolute value itself, in big endian bytes. The length and the bytes of the
+// negative decimals are inverted, so that the bigger absolute values sort first.
+type decKey struct{}
+
+func (decKey) Stringify(d sdk.Dec) string { return d.String() }
+
+func (decKey) Encode(d sdk.Dec) []byte {
+	abs := d.BigInt()
+	abs.Abs(abs)
+	absBytes := abs.Bytes()
+	if len(absBytes) > math.MaxUint8 {
+		panic(fmt.Errorf("invalid DecKey: too many bytes: %s", d))
+	}
+
+	if !d.IsNegative() {
+		return append([]byte{1, uint8(len(absBytes))}, absBytes...)
+	}
+	b := append([]byte{0, math.MaxUint8 - uint8(len(absBytes))}, absBytes...)
+	for i := 2; i < len(b); i++ {
+		b[i] = ^b[i]
+	}
+	return b
+}
+
+func (decKey) Decode(b []byte) (int, sdk.Dec) {
+	if len(b) < 2 {
+		panic("invalid DecKey bytes")
+	}
+	isNegative := b[0] == 0
+	size := int(b[1])
+	if isNegative {
+		size = math.MaxUint8 - size
+	}
+	if len(b) < 2+size {
+		panic(fmt.Errorf("invalid DecKey bytes: expected %d bytes: %x", 2+size, b))
+	}
+
+	absBytes := make([]byte, size)
+	copy(absBytes, b[2:2+size])
+	if isNegative {
+		for i := range absBytes {
+			absBytes[i] = ^absBytes[i]
+		}
+	}
+
+	i := new(big.Int).SetBytes(absBytes)
+	if isNegative {
+		i.Neg(i)
+	}
+	return 2 + size, sdk.NewDecFromBigIntWithPrec(i, sdk.Precision)
+}
+
 func (stringKey) Stringify(s string) string {
 	return s
 }
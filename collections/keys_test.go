@@ -89,3 +89,51 @@ func TestValAddressKey(t *testing.T) {
 		assertBijective(t, ValAddressKeyEncoder, sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()))
 	})
 }
+
+func TestDecKey(t *testing.T) {
+	t.Run("bijective", func(t *testing.T) {
+		for _, d := range []sdk.Dec{
+			sdk.ZeroDec(),
+			sdk.MustNewDecFromStr("0.000000000000000001"),
+			sdk.MustNewDecFromStr("-1234.5678"),
+			sdk.NewDec(1_000_000_000_000),
+		} {
+			encoded := DecKeyEncoder.Encode(d)
+			read, decoded := DecKeyEncoder.Decode(encoded)
+			require.Equal(t, len(encoded), read)
+			require.True(t, d.Equal(decoded), "expected %s, got %s", d, decoded)
+		}
+	})
+
+	t.Run("proper ordering", func(t *testing.T) {
+		decs := []sdk.Dec{
+			sdk.NewDec(-1_000_000_000_000),
+			sdk.NewDec(-256),
+			sdk.NewDec(-255),
+			sdk.MustNewDecFromStr("-1.5"),
+			sdk.MustNewDecFromStr("-0.000000000000000001"),
+			sdk.ZeroDec(),
+			sdk.MustNewDecFromStr("0.000000000000000001"),
+			sdk.MustNewDecFromStr("1.5"),
+			sdk.NewDec(255),
+			sdk.NewDec(256),
+			sdk.NewDec(1_000_000_000_000),
+		}
+
+		for i := 1; i < len(decs); i++ {
+			require.Equal(t, -1, bytes.Compare(DecKeyEncoder.Encode(decs[i-1]), DecKeyEncoder.Encode(decs[i])),
+				"%s must sort before %s", decs[i-1], decs[i])
+		}
+	})
+
+	t.Run("panics", func(t *testing.T) {
+		// invalid size
+		require.Panics(t, func() {
+			DecKeyEncoder.Decode([]byte{0x1})
+		})
+		// missing bytes
+		require.Panics(t, func() {
+			DecKeyEncoder.Decode([]byte{0x1, 0x2, 0x1})
+		})
+	})
+}
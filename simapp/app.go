@@ -101,6 +101,7 @@ import (
 	nibiapp "github.com/NibiruChain/nibiru/app"
 	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/dex"
+	dexcli "github.com/NibiruChain/nibiru/x/dex/client/cli"
 	dexkeeper "github.com/NibiruChain/nibiru/x/dex/keeper"
 	dextypes "github.com/NibiruChain/nibiru/x/dex/types"
 	"github.com/NibiruChain/nibiru/x/epochs"
@@ -159,6 +160,10 @@ var (
 			upgradeclient.CancelProposalHandler,
 			pricefeedcli.AddOracleProposalHandler,
 			vpoolcli.CreatePoolProposalHandler,
+			vpoolcli.ShutdownPoolProposalHandler,
+			vpoolcli.EditPoolConfigProposalHandler,
+			vpoolcli.RepegPoolProposalHandler,
+			dexcli.RampAmplificationProposalHandler,
 			// pricefeedcli.RemoveOracleProposalHandler, // TODO
 			ibcclientclient.UpdateClientProposalHandler,
 			ibcclientclient.UpgradeProposalHandler,
@@ -203,6 +208,7 @@ var (
 		perptypes.VaultModuleAccount:          {},
 		perptypes.PerpEFModuleAccount:         {},
 		perptypes.FeePoolModuleAccount:        {},
+		perptypes.InsuranceFundModuleAccount:  {authtypes.Minter, authtypes.Burner},
 		epochstypes.ModuleName:                {},
 		lockuptypes.ModuleName:                {authtypes.Minter, authtypes.Burner},
 		oracletypes.ModuleName:                nil,
@@ -455,6 +461,7 @@ func NewNibiruTestApp(
 	app.VpoolKeeper = vpoolkeeper.NewKeeper(
 		appCodec,
 		keys[vpooltypes.StoreKey],
+		app.GetSubspace(vpooltypes.ModuleName),
 		app.PricefeedKeeper,
 	)
 
@@ -465,11 +472,14 @@ func NewNibiruTestApp(
 	app.PerpKeeper = perpkeeper.NewKeeper(
 		appCodec, keys[perptypes.StoreKey],
 		app.GetSubspace(perptypes.ModuleName),
-		app.AccountKeeper, app.BankKeeper, app.PricefeedKeeper, app.VpoolKeeper, app.EpochsKeeper,
+		app.AccountKeeper, app.BankKeeper, app.PricefeedKeeper, &app.VpoolKeeper, app.EpochsKeeper,
 	)
 
+	// x/perp holds a pointer to the vpool keeper so that it sees the positions keeper set here
+	app.VpoolKeeper.SetPositionsKeeper(app.PerpKeeper)
+
 	app.EpochsKeeper.SetHooks(
-		epochstypes.NewMultiEpochHooks(app.StablecoinKeeper.Hooks(), app.PerpKeeper.Hooks()),
+		epochstypes.NewMultiEpochHooks(app.StablecoinKeeper.Hooks(), app.PerpKeeper.Hooks(), app.VpoolKeeper.Hooks()),
 	)
 
 	app.LockupKeeper = lockupkeeper.NewLockupKeeper(appCodec,
@@ -496,12 +506,13 @@ func NewNibiruTestApp(
 	govRouter := govtypes.NewRouter()
 	govRouter.
 		AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(paramproposal.RouterKey, perp.NewParamChangeProposalHandler(app.PerpKeeper, params.NewParamChangeProposalHandler(app.ParamsKeeper))).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
 		AddRoute(pricefeedtypes.RouterKey, pricefeed.NewPricefeedProposalHandler(app.PricefeedKeeper)).
-		AddRoute(vpooltypes.RouterKey, vpool.NewCreatePoolProposalHandler(app.VpoolKeeper))
+		AddRoute(vpooltypes.RouterKey, vpool.NewVpoolProposalHandler(app.VpoolKeeper)).
+		AddRoute(dextypes.RouterKey, dex.NewDexProposalHandler(app.DexKeeper))
 
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec,
@@ -1013,6 +1024,7 @@ func initParamsKeeper(
 	paramsKeeper.Subspace(stablecointypes.ModuleName)
 	paramsKeeper.Subspace(oracletypes.ModuleName)
 	paramsKeeper.Subspace(perptypes.ModuleName)
+	paramsKeeper.Subspace(vpooltypes.ModuleName)
 	// ibc params keepers
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
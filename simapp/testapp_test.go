@@ -94,6 +94,13 @@ func (s *TestappSuite) TestPricefeedGenesis_PostedPrices() {
 	s.Assert().Equal(common.Pair_USDC_NUSD.String(), currentPrices[1].PairID)
 }
 
+// TestPerpSharesVpoolKeeper verifies that x/perp uses the vpool keeper of the app, which
+// the positions keeper is set on after the perp keeper is built.
+func (s *TestappSuite) TestPerpSharesVpoolKeeper() {
+	nibiruApp := simapp.NewTestNibiruApp(true)
+	s.Assert().Same(&nibiruApp.VpoolKeeper, nibiruApp.PerpKeeper.VpoolKeeper)
+}
+
 func TestTestappSuite(t *testing.T) {
 	suite.Run(t, new(TestappSuite))
 }
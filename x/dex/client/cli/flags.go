@@ -25,6 +25,30 @@ const (
 
 	// FlagTokenOutDenom Will be parsed to string.
 	FlagTokenOutDenom = "token-out-denom"
+
+	// FlagRoutes Will be parsed to []types.SwapRoute.
+	FlagRoutes = "routes"
+
+	// FlagTokenOutMinAmount Will be parsed to sdk.Int.
+	FlagTokenOutMinAmount = "token-out-min-amount"
+
+	// FlagTokenInDenom Will be parsed to string.
+	FlagTokenInDenom = "token-in-denom"
+
+	// FlagTokenOut Will be parsed to sdk.Coin.
+	FlagTokenOut = "token-out"
+
+	// FlagTokenInMaxAmount Will be parsed to sdk.Int.
+	FlagTokenInMaxAmount = "token-in-max-amount"
+
+	// FlagTokenInMaxs Will be parsed to sdk.Coins.
+	FlagTokenInMaxs = "token-in-maxs"
+
+	// FlagTokensOut Will be parsed to sdk.Coins.
+	FlagTokensOut = "tokens-out"
+
+	// FlagPoolSharesInMax Will be parsed to sdk.Int.
+	FlagPoolSharesInMax = "pool-shares-in-max"
 )
 
 type createPoolInputs struct {
@@ -32,6 +56,8 @@ type createPoolInputs struct {
 	InitialDeposit string `json:"initial-deposit"`
 	SwapFee        string `json:"swap-fee"`
 	ExitFee        string `json:"exit-fee"`
+	PoolType       string `json:"pool-type"`
+	Amplification  uint64 `json:"amplification"`
 }
 
 func FlagSetCreatePool() *flag.FlagSet {
@@ -46,7 +72,7 @@ func FlagSetJoinPool() *flag.FlagSet {
 
 	fs.Uint64(FlagPoolId, 0, "The id of pool")
 	fs.StringArray(FlagTokensIn, []string{""}, "Amount of each denom to send into the pool (specify multiple denoms with: --tokens-in=1uusdc --tokens-in=1unusd)")
-	fs.Bool(FlagUseAllCoins, false, "Whether to use all the tokens in tokens-in to maximize shares out with a swap first")
+	fs.Bool(FlagUseAllCoins, false, "Whether to also join the tokens left over by the pool's proportions, one asset at a time. Allows joining with a subset of the pool's assets")
 	return fs
 }
 
@@ -55,6 +81,25 @@ func FlagSetExitPool() *flag.FlagSet {
 
 	fs.Uint64(FlagPoolId, 0, "The pool id to withdraw from.")
 	fs.String(FlagPoolSharesOut, "", "The amount of pool share tokens to burn.")
+	fs.String(FlagTokenOutDenom, "", "The denom to withdraw all the tokens in, instead of the pool's proportions.")
+	return fs
+}
+
+func FlagSetJoinPoolExactShares() *flag.FlagSet {
+	fs := flag.NewFlagSet("join-pool-exact-shares", flag.ContinueOnError)
+
+	fs.Uint64(FlagPoolId, 0, "The id of pool")
+	fs.String(FlagPoolSharesOut, "", "The amount of pool share tokens to mint.")
+	fs.StringArray(FlagTokenInMaxs, nil, "The maximum amount of each denom to send into the pool, or the join fails (specify multiple denoms with: --token-in-maxs=1uusdc --token-in-maxs=1unusd)")
+	return fs
+}
+
+func FlagSetExitPoolExactTokens() *flag.FlagSet {
+	fs := flag.NewFlagSet("exit-pool-exact-tokens", flag.ContinueOnError)
+
+	fs.Uint64(FlagPoolId, 0, "The pool id to withdraw from.")
+	fs.StringArray(FlagTokensOut, nil, "The amount of each denom to withdraw (specify multiple denoms with: --tokens-out=1uusdc --tokens-out=1unusd)")
+	fs.String(FlagPoolSharesInMax, "", "The maximum amount of pool share tokens to burn, or the exit fails.")
 	return fs
 }
 
@@ -66,3 +111,22 @@ func FlagSetSwapAssets() *flag.FlagSet {
 	fs.String(FlagTokenOutDenom, "", "The denom of the token to extract.")
 	return fs
 }
+
+func FlagSetSwapExactAmountIn() *flag.FlagSet {
+	fs := flag.NewFlagSet("swap-exact-amount-in", flag.ContinueOnError)
+
+	fs.StringSlice(FlagRoutes, nil, "The pools to swap through, as pool-id:token-out-denom (specify multiple hops with: --routes=1:uusdc,2:unusd)")
+	fs.String(FlagTokenIn, "", "The amount of tokens to swap in.")
+	fs.String(FlagTokenOutMinAmount, "", "The minimum amount of tokens to receive, or the swap fails.")
+	return fs
+}
+
+func FlagSetSwapExactAmountOut() *flag.FlagSet {
+	fs := flag.NewFlagSet("swap-exact-amount-out", flag.ContinueOnError)
+
+	fs.StringSlice(FlagRoutes, nil, "The pools to swap through, as pool-id:token-out-denom (specify multiple hops with: --routes=1:uusdc,2:unusd)")
+	fs.String(FlagTokenInDenom, "", "The denom of the tokens to swap in.")
+	fs.String(FlagTokenOut, "", "The amount of tokens to receive.")
+	fs.String(FlagTokenInMaxAmount, "", "The maximum amount of tokens to swap in, or the swap fails.")
+	return fs
+}
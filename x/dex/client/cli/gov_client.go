@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govclientrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/NibiruChain/nibiru/x/dex/types"
+)
+
+var RampAmplificationProposalHandler = govclient.NewProposalHandler(
+	/* govclient.CLIHandlerFn */ CmdRampAmplificationProposal,
+	/* govclient.RESTHandlerFn */ func(context client.Context) govclientrest.ProposalRESTHandler {
+		return govclientrest.ProposalRESTHandler{
+			SubRoute: "ramp_amplification",
+			Handler: func(writer http.ResponseWriter, request *http.Request) {
+				_, _ = writer.Write([]byte("deprecated"))
+				writer.WriteHeader(http.StatusMethodNotAllowed)
+			},
+		}
+	})
+
+// CmdRampAmplificationProposal implements the client command to submit a
+// governance proposal to ramp the amplification of a StableSwap pool.
+func CmdRampAmplificationProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ramp-amplification [proposal-json] --deposit=[deposit]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to ramp the amplification of a StableSwap pool",
+		Example: strings.TrimSpace(fmt.Sprintf(`
+			Example: 
+			$ %s tx gov submit-proposal ramp-amplification <path/to/proposal.json> --deposit="1000unibi" --from=<key_or_address> 
+			`, version.AppName)),
+		Long: strings.TrimSpace(
+			`Submits a proposal to move the amplification of a StableSwap pool linearly
+			to the future amplification, over the ramp duration starting when the
+			proposal passes. The ramp lasts at least a day, and the amplification can
+			change by a factor of at most 10.
+
+			A proposal.json for 'RampAmplificationProposal' contains:
+			{
+			  "title": "Ramp the amplification of the USDC:NUSD pool",
+			  "description": "Tighten the USDC:NUSD peg",
+			  "pool_id": "1",
+			  "future_amplification": "200",
+			  "ramp_duration": "604800s"
+			}
+			`),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			proposal := &types.RampAmplificationProposal{}
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			// marshals the contents into the proto.Message to which 'proposal' points.
+			if err = clientCtx.Codec.UnmarshalJSON(contents, proposal); err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(proposal, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(
+		/*name=*/ govcli.FlagDeposit,
+		/*defaultValue=*/ "",
+		/*usage=*/ "governance deposit for proposal")
+	if err := cmd.MarkFlagRequired(govcli.FlagDeposit); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -30,7 +31,11 @@ func GetTxCmd() *cobra.Command {
 		CmdCreatePool(),
 		CmdJoinPool(),
 		CmdExitPool(),
+		CmdJoinPoolExactShares(),
+		CmdExitPoolExactTokens(),
 		CmdSwapAssets(),
+		CmdSwapExactAmountIn(),
+		CmdSwapExactAmountOut(),
 	)
 
 	return cmd
@@ -100,6 +105,171 @@ $ %s tx dex swap-assets --pool-id 1 --tokens-in 100stake --token-out-denom valid
 	return cmd
 }
 
+func CmdSwapExactAmountIn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-exact-amount-in",
+		Short: "swap an exact amount of tokens through a route of pools, for at least a minimum amount out",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s tx dex swap-exact-amount-in --routes 1:uusdc,2:unusd --token-in 100unibi --token-out-min-amount 95 --from validator
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			hops, err := flagSet.GetStringSlice(FlagRoutes)
+			if err != nil {
+				return err
+			}
+			routes, err := parseSwapRoutes(hops)
+			if err != nil {
+				return err
+			}
+
+			tokenInStr, err := flagSet.GetString(FlagTokenIn)
+			if err != nil {
+				return err
+			}
+			tokenIn, err := sdk.ParseCoinNormalized(tokenInStr)
+			if err != nil {
+				return err
+			}
+
+			tokenOutMinAmountStr, err := flagSet.GetString(FlagTokenOutMinAmount)
+			if err != nil {
+				return err
+			}
+			tokenOutMinAmount, ok := sdk.NewIntFromString(tokenOutMinAmountStr)
+			if !ok {
+				return fmt.Errorf("invalid %s: %s", FlagTokenOutMinAmount, tokenOutMinAmountStr)
+			}
+
+			msg := types.NewMsgSwapExactAmountIn(
+				clientCtx.GetFromAddress().String(),
+				routes,
+				tokenIn,
+				tokenOutMinAmount,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetSwapExactAmountIn())
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagRoutes)
+	_ = cmd.MarkFlagRequired(FlagTokenIn)
+	_ = cmd.MarkFlagRequired(FlagTokenOutMinAmount)
+
+	return cmd
+}
+
+func CmdSwapExactAmountOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-exact-amount-out",
+		Short: "swap through a route of pools for an exact amount of tokens, paying at most a maximum amount in",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s tx dex swap-exact-amount-out --routes 1:uusdc,2:unusd --token-in-denom unibi --token-out 95unusd --token-in-max-amount 100 --from validator
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			hops, err := flagSet.GetStringSlice(FlagRoutes)
+			if err != nil {
+				return err
+			}
+			routes, err := parseSwapRoutes(hops)
+			if err != nil {
+				return err
+			}
+
+			tokenInDenom, err := flagSet.GetString(FlagTokenInDenom)
+			if err != nil {
+				return err
+			}
+
+			tokenOutStr, err := flagSet.GetString(FlagTokenOut)
+			if err != nil {
+				return err
+			}
+			tokenOut, err := sdk.ParseCoinNormalized(tokenOutStr)
+			if err != nil {
+				return err
+			}
+
+			tokenInMaxAmountStr, err := flagSet.GetString(FlagTokenInMaxAmount)
+			if err != nil {
+				return err
+			}
+			tokenInMaxAmount, ok := sdk.NewIntFromString(tokenInMaxAmountStr)
+			if !ok {
+				return fmt.Errorf("invalid %s: %s", FlagTokenInMaxAmount, tokenInMaxAmountStr)
+			}
+
+			msg := types.NewMsgSwapExactAmountOut(
+				clientCtx.GetFromAddress().String(),
+				routes,
+				tokenInDenom,
+				tokenOut,
+				tokenInMaxAmount,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetSwapExactAmountOut())
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagRoutes)
+	_ = cmd.MarkFlagRequired(FlagTokenInDenom)
+	_ = cmd.MarkFlagRequired(FlagTokenOut)
+	_ = cmd.MarkFlagRequired(FlagTokenInMaxAmount)
+
+	return cmd
+}
+
+// parseSwapRoutes parses the hops of a route given as pool-id:token-out-denom.
+func parseSwapRoutes(hops []string) (routes []types.SwapRoute, err error) {
+	for _, hop := range hops {
+		parts := strings.Split(hop, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid route %s, expected pool-id:token-out-denom", hop)
+		}
+		poolId, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pool id in route %s: %w", hop, err)
+		}
+		routes = append(routes, types.SwapRoute{PoolId: poolId, TokenOutDenom: parts[1]})
+	}
+	return routes, nil
+}
+
 func CmdJoinPool() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "join-pool",
@@ -166,8 +336,10 @@ func CmdExitPool() *cobra.Command {
 			fmt.Sprintf(`
 Example:
 $ %s tx dex exit-pool --pool-id 1 --pool-shares-out 100nibiru/pool/1 --from validator
+$ %s tx dex exit-pool --pool-id 1 --pool-shares-out 100nibiru/pool/1 --token-out-denom unusd --from validator
 `,
 				version.AppName,
+				version.AppName,
 			),
 		),
 		Args: cobra.ExactArgs(0),
@@ -193,11 +365,17 @@ $ %s tx dex exit-pool --pool-id 1 --pool-shares-out 100nibiru/pool/1 --from vali
 				return err
 			}
 
+			tokenOutDenom, err := flagSet.GetString(FlagTokenOutDenom)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgExitPool(
 				clientCtx.GetFromAddress().String(),
 				poolId,
 				parsedPoolSharesOut,
 			)
+			msg.TokenOutDenom = tokenOutDenom
 
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -215,6 +393,153 @@ $ %s tx dex exit-pool --pool-id 1 --pool-shares-out 100nibiru/pool/1 --from vali
 	return cmd
 }
 
+func CmdJoinPoolExactShares() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "join-pool-exact-shares",
+		Short: "join a pool for an exact amount of pool share tokens, depositing the tokens in the pool's proportions",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s tx dex join-pool-exact-shares --pool-id 1 --pool-shares-out 100nibiru/pool/1 --token-in-maxs 101uusdc --token-in-maxs 101unusd --from validator
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			poolId, err := flagSet.GetUint64(FlagPoolId)
+			if err != nil {
+				return err
+			}
+
+			poolSharesOutStr, err := flagSet.GetString(FlagPoolSharesOut)
+			if err != nil {
+				return err
+			}
+			poolSharesOut, err := sdk.ParseCoinNormalized(poolSharesOutStr)
+			if err != nil {
+				return err
+			}
+
+			tokenInMaxsStrs, err := flagSet.GetStringArray(FlagTokenInMaxs)
+			if err != nil {
+				return err
+			}
+			tokenInMaxs, err := parseCoinsArray(tokenInMaxsStrs)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgJoinPoolExactShares(
+				clientCtx.GetFromAddress().String(),
+				poolId,
+				poolSharesOut.Amount,
+				tokenInMaxs,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, flagSet, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetJoinPoolExactShares())
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagPoolId)
+	_ = cmd.MarkFlagRequired(FlagPoolSharesOut)
+
+	return cmd
+}
+
+func CmdExitPoolExactTokens() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exit-pool-exact-tokens",
+		Short: "exit a pool for an exact amount of tokens, burning at most a maximum amount of pool share tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s tx dex exit-pool-exact-tokens --pool-id 1 --tokens-out 100uusdc --pool-shares-in-max 110 --from validator
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			poolId, err := flagSet.GetUint64(FlagPoolId)
+			if err != nil {
+				return err
+			}
+
+			tokensOutStrs, err := flagSet.GetStringArray(FlagTokensOut)
+			if err != nil {
+				return err
+			}
+			tokensOut, err := parseCoinsArray(tokensOutStrs)
+			if err != nil {
+				return err
+			}
+
+			poolSharesInMaxStr, err := flagSet.GetString(FlagPoolSharesInMax)
+			if err != nil {
+				return err
+			}
+			poolSharesInMax, ok := sdk.NewIntFromString(poolSharesInMaxStr)
+			if !ok {
+				return fmt.Errorf("invalid %s: %s", FlagPoolSharesInMax, poolSharesInMaxStr)
+			}
+
+			msg := types.NewMsgExitPoolExactTokens(
+				clientCtx.GetFromAddress().String(),
+				poolId,
+				tokensOut,
+				poolSharesInMax,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, flagSet, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetExitPoolExactTokens())
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagPoolId)
+	_ = cmd.MarkFlagRequired(FlagTokensOut)
+	_ = cmd.MarkFlagRequired(FlagPoolSharesInMax)
+
+	return cmd
+}
+
+// parseCoinsArray parses coins given as repeated flags, each of which may hold
+// several comma separated coins.
+func parseCoinsArray(coinsStrs []string) (sdk.Coins, error) {
+	coins := sdk.Coins{}
+	for _, coinsStr := range coinsStrs {
+		parsed, err := sdk.ParseCoinsNormalized(coinsStr)
+		if err != nil {
+			return nil, err
+		}
+		coins = coins.Add(parsed...)
+	}
+	return coins, nil
+}
+
 func CmdCreatePool() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-pool [flags]",
@@ -233,6 +558,10 @@ Where pool.json contains:
 	"swap-fee": "0.01",
 	"exit-fee": "0.01"
 }
+
+The optional "pool-type" is "balancer" (default) or "stableswap". StableSwap
+pools also need an "amplification", e.g. "pool-type": "stableswap",
+"amplification": 100.
 `,
 				version.AppName,
 			),
@@ -289,12 +618,23 @@ Where pool.json contains:
 				}
 			}
 
+			poolType := types.PoolType_BALANCER
+			if pool.PoolType != "" {
+				value, ok := types.PoolType_value[strings.ToUpper(pool.PoolType)]
+				if !ok {
+					return types.ErrInvalidPoolType.Wrapf("unknown pool type %s", pool.PoolType)
+				}
+				poolType = types.PoolType(value)
+			}
+
 			msg := types.NewMsgCreatePool(
 				/*sender=*/ clientCtx.GetFromAddress().String(),
 				poolAssets,
 				&types.PoolParams{
-					SwapFee: sdk.MustNewDecFromStr(pool.SwapFee),
-					ExitFee: sdk.MustNewDecFromStr(pool.ExitFee),
+					SwapFee:       sdk.MustNewDecFromStr(pool.SwapFee),
+					ExitFee:       sdk.MustNewDecFromStr(pool.ExitFee),
+					PoolType:      poolType,
+					Amplification: pool.Amplification,
 				},
 			)
 
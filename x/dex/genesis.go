@@ -1,8 +1,12 @@
 package dex
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/dex/keeper"
 	"github.com/NibiruChain/nibiru/x/dex/types"
 )
@@ -11,12 +15,20 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	k.SetParams(ctx, genState.Params)
 	k.SetNextPoolNumber(ctx, uint64(genState.Params.StartingPoolNumber))
+
+	for _, record := range genState.TwapRecords {
+		pair := common.AssetPair{Token0: record.BaseDenom, Token1: record.QuoteDenom}
+		k.TwapRecords.Insert(ctx, collections.Join(collections.Join(record.PoolId, pair), record.Time), record)
+	}
 }
 
 // ExportGenesis returns the dex module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.TwapRecords = k.TwapRecords.Iterate(
+		ctx, collections.PairRange[collections.Pair[uint64, common.AssetPair], time.Time]{},
+	).Values()
 
 	return genesis
 }
@@ -2,6 +2,9 @@ package dex_test
 
 import (
 	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/x/testutil"
 
@@ -9,8 +12,10 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/dex"
 	"github.com/NibiruChain/nibiru/x/dex/types"
+	"github.com/NibiruChain/nibiru/x/testutil/mock"
 )
 
 func TestGenesis(t *testing.T) {
@@ -28,3 +33,49 @@ func TestGenesis(t *testing.T) {
 
 	require.Equal(t, genesisState, *got)
 }
+
+func TestGenesisTwapRecords(t *testing.T) {
+	app, ctx := simapp.NewTestNibiruAppAndContext(true)
+	ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0).UTC())
+	record := types.TwapRecord{
+		PoolId:          1,
+		BaseDenom:       "unibi",
+		QuoteDenom:      common.DenomNUSD,
+		Time:            ctx.BlockTime(),
+		SpotPrice:       sdk.MustNewDecFromStr("0.25"),
+		CumulativePrice: sdk.ZeroDec(),
+	}
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+		TwapRecords: []types.TwapRecord{
+			record,
+			{
+				PoolId:          1,
+				BaseDenom:       "unibi",
+				QuoteDenom:      common.DenomNUSD,
+				Time:            record.Time.Add(10 * time.Second),
+				SpotPrice:       sdk.OneDec(),
+				CumulativePrice: sdk.NewDec(2_500),
+			},
+		},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	dex.InitGenesis(ctx, app.DexKeeper, genesisState)
+	got := dex.ExportGenesis(ctx, app.DexKeeper)
+	require.Equal(t, genesisState.TwapRecords, got.TwapRecords)
+
+	t.Log("the imported records keep the twaps of the pools")
+	app.DexKeeper.SetPool(ctx, mock.DexPool(
+		/*poolId=*/ 1,
+		/*assets=*/ sdk.NewCoins(
+			sdk.NewInt64Coin("unibi", 100),
+			sdk.NewInt64Coin(common.DenomNUSD, 100),
+		),
+		/*shares=*/ 100,
+	))
+	ctx = ctx.WithBlockTime(record.Time.Add(40 * time.Second))
+	twap, err := app.DexKeeper.ArithmeticTwap(ctx, 1, "unibi", common.DenomNUSD, record.Time, ctx.BlockTime())
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.8125"), twap)
+}
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/NibiruChain/nibiru/x/dex/keeper"
 	"github.com/NibiruChain/nibiru/x/dex/types"
@@ -27,12 +28,40 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgExitPool:
 			res, err := msgServer.ExitPool(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgJoinPoolExactShares:
+			res, err := msgServer.JoinPoolExactShares(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgExitPoolExactTokens:
+			res, err := msgServer.ExitPoolExactTokens(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgSwapAssets:
 			res, err := msgServer.SwapAssets(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSwapExactAmountIn:
+			res, err := msgServer.SwapExactAmountIn(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSwapExactAmountOut:
+			res, err := msgServer.SwapExactAmountOut(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
 		}
 	}
 }
+
+func NewDexProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch m := content.(type) {
+		case *types.RampAmplificationProposal:
+			if err := m.ValidateBasic(); err != nil {
+				return err
+			}
+			return k.RampAmplification(ctx, m.PoolId, m.FutureAmplification, m.RampDuration)
+		default:
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrUnknownRequest,
+				"unrecognized %s proposal content type: %T", types.ModuleName, m)
+		}
+	}
+}
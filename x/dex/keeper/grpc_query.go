@@ -224,12 +224,13 @@ func (k queryServer) TotalShares(ctx context.Context, req *types.QueryTotalShare
 func (k queryServer) SpotPrice(ctx context.Context, req *types.QuerySpotPriceRequest) (
 	*types.QuerySpotPriceResponse, error,
 ) {
-	pool, err := k.FetchPool(sdk.UnwrapSDKContext(ctx), req.PoolId)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	pool, err := k.FetchPool(sdkCtx, req.PoolId)
 	if err != nil {
 		return nil, err
 	}
 
-	price, err := pool.CalcSpotPrice(req.TokenInDenom, req.TokenOutDenom)
+	price, err := pool.AsPoolI(sdkCtx.BlockTime()).CalcSpotPrice(req.TokenInDenom, req.TokenOutDenom)
 	if err != nil {
 		return nil, err
 	}
@@ -244,12 +245,13 @@ func (k queryServer) SpotPrice(ctx context.Context, req *types.QuerySpotPriceReq
 func (k queryServer) EstimateSwapExactAmountIn(
 	ctx context.Context, req *types.QuerySwapExactAmountInRequest,
 ) (*types.QuerySwapExactAmountInResponse, error) {
-	pool, err := k.FetchPool(sdk.UnwrapSDKContext(ctx), req.PoolId)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	pool, err := k.FetchPool(sdkCtx, req.PoolId)
 	if err != nil {
 		return nil, err
 	}
 
-	tokenOut, err := pool.CalcOutAmtGivenIn(req.TokenIn, req.TokenOutDenom, false)
+	tokenOut, err := pool.AsPoolI(sdkCtx.BlockTime()).CalcOutAmtGivenIn(req.TokenIn, req.TokenOutDenom, false)
 	if err != nil {
 		return nil, err
 	}
@@ -264,12 +266,13 @@ func (k queryServer) EstimateSwapExactAmountIn(
 func (k queryServer) EstimateSwapExactAmountOut(
 	ctx context.Context, req *types.QuerySwapExactAmountOutRequest,
 ) (*types.QuerySwapExactAmountOutResponse, error) {
-	pool, err := k.FetchPool(sdk.UnwrapSDKContext(ctx), req.PoolId)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	pool, err := k.FetchPool(sdkCtx, req.PoolId)
 	if err != nil {
 		return nil, err
 	}
 
-	tokenIn, err := pool.CalcInAmtGivenOut(req.TokenOut, req.TokenInDenom)
+	tokenIn, err := pool.AsPoolI(sdkCtx.BlockTime()).CalcInAmtGivenOut(req.TokenOut, req.TokenInDenom)
 	if err != nil {
 		return nil, err
 	}
@@ -279,16 +282,67 @@ func (k queryServer) EstimateSwapExactAmountOut(
 	}, nil
 }
 
+// Estimates the amount of tokens out of a swap along the routes, or along the
+// route giving the most tokens out when no route is given.
+func (k queryServer) EstimateSwapExactAmountInRoutes(
+	ctx context.Context, req *types.QuerySwapExactAmountInRoutesRequest,
+) (*types.QuerySwapExactAmountInRoutesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	routes := req.Routes
+	var tokenOut sdk.Coin
+	var err error
+	if len(routes) == 0 {
+		routes, tokenOut, err = k.BestSwapRouteExactAmountIn(sdkCtx, req.TokenIn, req.TokenOutDenom)
+	} else {
+		tokenOut, err = k.Keeper.EstimateSwapExactAmountInRoutes(sdkCtx, req.TokenIn, routes)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySwapExactAmountInRoutesResponse{
+		TokenOut: tokenOut,
+		Routes:   routes,
+	}, nil
+}
+
+// Estimates the amount of tokens required by a swap along the routes, or along
+// the route requiring the fewest tokens when no route is given.
+func (k queryServer) EstimateSwapExactAmountOutRoutes(
+	ctx context.Context, req *types.QuerySwapExactAmountOutRoutesRequest,
+) (*types.QuerySwapExactAmountOutRoutesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	routes := req.Routes
+	var tokenIn sdk.Coin
+	var err error
+	if len(routes) == 0 {
+		routes, tokenIn, err = k.BestSwapRouteExactAmountOut(sdkCtx, req.TokenInDenom, req.TokenOut)
+	} else {
+		tokenIn, err = k.Keeper.EstimateSwapExactAmountOutRoutes(sdkCtx, req.TokenInDenom, routes, req.TokenOut)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySwapExactAmountOutRoutesResponse{
+		TokenIn: tokenIn,
+		Routes:  routes,
+	}, nil
+}
+
 // Estimates the amount of pool shares returned given an amount of tokens to
 // join.
 func (k queryServer) EstimateJoinExactAmountIn(
 	ctx context.Context, req *types.QueryJoinExactAmountInRequest,
 ) (*types.QueryJoinExactAmountInResponse, error) {
-	pool, err := k.FetchPool(sdk.UnwrapSDKContext(ctx), req.PoolId)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	pool, err := k.FetchPool(sdkCtx, req.PoolId)
 	if err != nil {
 		return nil, err
 	}
-	numShares, remCoins, err := pool.AddTokensToPool(req.TokensIn)
+	numShares, remCoins, err := pool.AsPoolI(sdkCtx.BlockTime()).AddTokensToPool(req.TokensIn)
 	if err != nil {
 		return nil, err
 	}
@@ -300,8 +354,21 @@ func (k queryServer) EstimateJoinExactAmountIn(
 
 // Estimates the amount of tokens required to obtain an exact amount of pool
 // shares.
-func (k queryServer) EstimateJoinExactAmountOut(context.Context, *types.QueryJoinExactAmountOutRequest) (*types.QueryJoinExactAmountOutResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "Not Implemented")
+func (k queryServer) EstimateJoinExactAmountOut(
+	ctx context.Context, req *types.QueryJoinExactAmountOutRequest,
+) (*types.QueryJoinExactAmountOutResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	pool, err := k.FetchPool(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+	tokensIn, err := pool.AsPoolI(sdkCtx.BlockTime()).JoinPoolExactShares(req.PoolSharesOut)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryJoinExactAmountOutResponse{
+		TokensIn: tokensIn,
+	}, nil
 }
 
 // Estimates the amount of tokens returned to the user given an exact amount
@@ -309,11 +376,17 @@ func (k queryServer) EstimateJoinExactAmountOut(context.Context, *types.QueryJoi
 func (k queryServer) EstimateExitExactAmountIn(
 	ctx context.Context, req *types.QueryExitExactAmountInRequest,
 ) (*types.QueryExitExactAmountInResponse, error) {
-	pool, err := k.FetchPool(sdk.UnwrapSDKContext(ctx), req.PoolId)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	pool, err := k.FetchPool(sdkCtx, req.PoolId)
 	if err != nil {
 		return nil, err
 	}
-	tokensOut, err := pool.ExitPool(req.PoolSharesIn)
+	var tokensOut sdk.Coins
+	if req.TokenOutDenom == "" {
+		tokensOut, err = pool.AsPoolI(sdkCtx.BlockTime()).ExitPool(req.PoolSharesIn)
+	} else {
+		tokensOut, err = pool.AsPoolI(sdkCtx.BlockTime()).ExitPoolToSingleAsset(req.PoolSharesIn, req.TokenOutDenom)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -324,6 +397,44 @@ func (k queryServer) EstimateExitExactAmountIn(
 
 // Estimates the amount of pool shares required to extract an exact amount of
 // tokens from the pool.
-func (k queryServer) EstimateExitExactAmountOut(context.Context, *types.QueryExitExactAmountOutRequest) (*types.QueryExitExactAmountOutResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "Not Implemented")
+func (k queryServer) EstimateExitExactAmountOut(
+	ctx context.Context, req *types.QueryExitExactAmountOutRequest,
+) (*types.QueryExitExactAmountOutResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	pool, err := k.FetchPool(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+	numSharesIn, err := pool.AsPoolI(sdkCtx.BlockTime()).ExitPoolExactTokens(req.TokensOut)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryExitExactAmountOutResponse{
+		PoolSharesIn: numSharesIn,
+	}, nil
+}
+
+// Returns the arithmetic time weighted average price of a pool's base denom in
+// its quote denom. The interval ends at the block time when no end time is given.
+func (k queryServer) ArithmeticTwap(
+	ctx context.Context, req *types.QueryArithmeticTwapRequest,
+) (*types.QueryArithmeticTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	endTime := req.EndTime
+	if endTime.IsZero() {
+		endTime = sdkCtx.BlockTime()
+	}
+
+	twap, err := k.Keeper.ArithmeticTwap(
+		sdkCtx, req.PoolId, req.BaseDenom, req.QuoteDenom, req.StartTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryArithmeticTwapResponse{
+		ArithmeticTwap: twap,
+	}, nil
 }
@@ -613,6 +613,73 @@ func TestQueryEstimateSwapExactAmountOut(t *testing.T) {
 	}
 }
 
+func TestQueryEstimateSwapExactAmountInRoutes(t *testing.T) {
+	app, ctx := setupSwapRoutePools(t)
+	queryServer := keeper.NewQuerier(app.DexKeeper)
+	twoHops := []types.SwapRoute{
+		{PoolId: 1, TokenOutDenom: "uusdc"},
+		{PoolId: 2, TokenOutDenom: common.DenomNUSD},
+	}
+
+	t.Log("estimate along the given route")
+	direct := []types.SwapRoute{{PoolId: 3, TokenOutDenom: common.DenomNUSD}}
+	resp, err := queryServer.EstimateSwapExactAmountInRoutes(
+		sdk.WrapSDKContext(ctx),
+		&types.QuerySwapExactAmountInRoutesRequest{
+			TokenIn: sdk.NewInt64Coin("unibi", 100),
+			Routes:  direct,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(common.DenomNUSD, 50), resp.TokenOut)
+	require.Equal(t, direct, resp.Routes)
+
+	t.Log("estimate along the best route")
+	resp, err = queryServer.EstimateSwapExactAmountInRoutes(
+		sdk.WrapSDKContext(ctx),
+		&types.QuerySwapExactAmountInRoutesRequest{
+			TokenIn:       sdk.NewInt64Coin("unibi", 100),
+			TokenOutDenom: common.DenomNUSD,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(common.DenomNUSD, 82), resp.TokenOut)
+	require.Equal(t, twoHops, resp.Routes)
+}
+
+func TestQueryEstimateSwapExactAmountOutRoutes(t *testing.T) {
+	app, ctx := setupSwapRoutePools(t)
+	queryServer := keeper.NewQuerier(app.DexKeeper)
+	twoHops := []types.SwapRoute{
+		{PoolId: 1, TokenOutDenom: "uusdc"},
+		{PoolId: 2, TokenOutDenom: common.DenomNUSD},
+	}
+
+	t.Log("estimate along the given route")
+	resp, err := queryServer.EstimateSwapExactAmountOutRoutes(
+		sdk.WrapSDKContext(ctx),
+		&types.QuerySwapExactAmountOutRoutesRequest{
+			TokenOut:     sdk.NewInt64Coin(common.DenomNUSD, 49),
+			Routes:       []types.SwapRoute{{PoolId: 3, TokenOutDenom: common.DenomNUSD}},
+			TokenInDenom: "unibi",
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("unibi", 97), resp.TokenIn)
+
+	t.Log("estimate along the best route")
+	resp, err = queryServer.EstimateSwapExactAmountOutRoutes(
+		sdk.WrapSDKContext(ctx),
+		&types.QuerySwapExactAmountOutRoutesRequest{
+			TokenOut:     sdk.NewInt64Coin(common.DenomNUSD, 82),
+			TokenInDenom: "unibi",
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("unibi", 99), resp.TokenIn)
+	require.Equal(t, twoHops, resp.Routes)
+}
+
 func TestQueryEstimateJoinExactAmountIn(t *testing.T) {
 	tests := []struct {
 		name                  string
@@ -681,6 +748,37 @@ func TestQueryEstimateJoinExactAmountIn(t *testing.T) {
 	}
 }
 
+func TestQueryEstimateJoinExactAmountOut(t *testing.T) {
+	app, ctx := simapp2.NewTestNibiruAppAndContext(true)
+	app.DexKeeper.SetPool(ctx, mock.DexPool(
+		/*poolId=*/ 1,
+		/*assets=*/ sdk.NewCoins(
+			sdk.NewInt64Coin("unibi", 200),
+			sdk.NewInt64Coin(common.DenomNUSD, 100),
+		),
+		/*shares=*/ 100,
+	))
+	queryServer := keeper.NewQuerier(app.DexKeeper)
+
+	resp, err := queryServer.EstimateJoinExactAmountOut(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryJoinExactAmountOutRequest{
+			PoolId:        1,
+			PoolSharesOut: sdk.NewInt(25),
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewInt64Coin("unibi", 50),
+		sdk.NewInt64Coin(common.DenomNUSD, 25),
+	), resp.TokensIn)
+
+	// the estimate doesn't modify the pool
+	pool, err := app.DexKeeper.FetchPool(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(100), pool.TotalShares.Amount)
+}
+
 func TestQueryEstimateExitExactAmountIn(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -744,3 +842,38 @@ func TestQueryEstimateExitExactAmountIn(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryEstimateExitExactAmountOut(t *testing.T) {
+	app, ctx := simapp2.NewTestNibiruAppAndContext(true)
+	app.DexKeeper.SetPool(ctx, mock.DexPool(
+		/*poolId=*/ 1,
+		/*assets=*/ sdk.NewCoins(
+			sdk.NewInt64Coin("unibi", 100),
+			sdk.NewInt64Coin(common.DenomNUSD, 100),
+		),
+		/*shares=*/ 100,
+	))
+	queryServer := keeper.NewQuerier(app.DexKeeper)
+
+	resp, err := queryServer.EstimateExitExactAmountOut(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryExitExactAmountOutRequest{
+			PoolId: 1,
+			TokensOut: sdk.NewCoins(
+				sdk.NewInt64Coin("unibi", 49),
+				sdk.NewInt64Coin(common.DenomNUSD, 49),
+			),
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(49), resp.PoolSharesIn)
+
+	_, err = queryServer.EstimateExitExactAmountOut(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryExitExactAmountOutRequest{
+			PoolId:    1,
+			TokensOut: sdk.NewCoins(sdk.NewInt64Coin("unibi", 100)),
+		},
+	)
+	require.Error(t, err)
+}
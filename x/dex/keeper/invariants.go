@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/dex/types"
+)
+
+// RegisterInvariants registers the dex module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "pool-balances", PoolBalancesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "total-liquidity", TotalLiquidityInvariant(k))
+}
+
+// PoolBalancesInvariant checks that the bank balance of every pool covers its pool assets.
+// The balance can be higher since anyone can send coins to the address of a pool.
+func PoolBalancesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, pool := range k.FetchAllPools(ctx) {
+			balances := k.bankKeeper.GetAllBalances(ctx, pool.GetAddress())
+			if !balances.IsAllGTE(pool.PoolBalances()) {
+				broken = true
+				msg += fmt.Sprintf("\tpool %d holds %s, less than its pool assets %s\n", pool.Id, balances, pool.PoolBalances())
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "pool-balances", msg), broken
+	}
+}
+
+// TotalLiquidityInvariant checks that the total liquidity is the sum of the assets of all the pools.
+func TotalLiquidityInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := sdk.NewCoins()
+		for _, pool := range k.FetchAllPools(ctx) {
+			expected = expected.Add(pool.PoolBalances()...)
+		}
+
+		totalLiquidity := sdk.NewCoins(k.GetTotalLiquidity(ctx)...)
+		broken := !totalLiquidity.IsAllGTE(expected) || !expected.IsAllGTE(totalLiquidity)
+
+		return sdk.FormatInvariant(types.ModuleName, "total-liquidity", fmt.Sprintf(
+			"\ttotal liquidity %s, sum of the pool assets %s\n", totalLiquidity, expected)), broken
+	}
+}
@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	simapp2 "github.com/NibiruChain/nibiru/simapp"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/dex/keeper"
+	"github.com/NibiruChain/nibiru/x/dex/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+)
+
+func TestInvariants(t *testing.T) {
+	app, ctx := simapp2.NewTestNibiruAppAndContext(true)
+	dexKeeper := app.DexKeeper
+
+	poolCreationFeeCoin := sdk.NewInt64Coin(common.DenomNIBI, 1000_000_000)
+	dexKeeper.SetParams(ctx, types.NewParams(
+		/*startingPoolNumber=*/ 1,
+		/*poolCreationFee=*/ sdk.NewCoins(poolCreationFeeCoin),
+		/*whitelistedAssets*/ []string{"uatom", "uosmo"},
+	))
+
+	userAddr := testutil.AccAddress()
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, userAddr, sdk.NewCoins(
+		sdk.NewInt64Coin("uatom", 10_000),
+		sdk.NewInt64Coin("uosmo", 10_000),
+		poolCreationFeeCoin,
+	)))
+
+	requireInvariantsHold := func(t *testing.T, ctx sdk.Context) {
+		t.Helper()
+		for _, invariant := range []sdk.Invariant{
+			keeper.PoolBalancesInvariant(dexKeeper),
+			keeper.TotalLiquidityInvariant(dexKeeper),
+		} {
+			msg, broken := invariant(ctx)
+			require.False(t, broken, msg)
+		}
+	}
+
+	t.Log("create a pool, swap, join and exit it")
+	poolId, err := dexKeeper.NewPool(ctx, userAddr,
+		types.PoolParams{SwapFee: sdk.NewDecWithPrec(3, 2), ExitFee: sdk.NewDecWithPrec(3, 2)},
+		[]types.PoolAsset{
+			{Token: sdk.NewInt64Coin("uatom", 1_000), Weight: sdk.NewInt(1)},
+			{Token: sdk.NewInt64Coin("uosmo", 1_000), Weight: sdk.NewInt(1)},
+		},
+	)
+	require.NoError(t, err)
+	requireInvariantsHold(t, ctx)
+
+	_, err = dexKeeper.SwapExactAmountIn(ctx, userAddr, poolId, sdk.NewInt64Coin("uatom", 100), "uosmo")
+	require.NoError(t, err)
+	requireInvariantsHold(t, ctx)
+
+	_, poolSharesOut, _, err := dexKeeper.JoinPool(ctx, userAddr, poolId,
+		sdk.NewCoins(sdk.NewInt64Coin("uatom", 500), sdk.NewInt64Coin("uosmo", 500)), false)
+	require.NoError(t, err)
+	requireInvariantsHold(t, ctx)
+
+	_, err = dexKeeper.ExitPool(ctx, userAddr, poolId, poolSharesOut, "")
+	require.NoError(t, err)
+	requireInvariantsHold(t, ctx)
+
+	t.Log("coins sent to the pool address don't break the invariants")
+	pool, err := dexKeeper.FetchPool(ctx, poolId)
+	require.NoError(t, err)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, userAddr, pool.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("uatom", 10))))
+	requireInvariantsHold(t, ctx)
+
+	t.Log("pool assets not backed by the pool balance break the pool balances invariant")
+	pool.PoolAssets[0].Token.Amount = pool.PoolAssets[0].Token.Amount.AddRaw(1_000)
+	dexKeeper.SetPool(ctx, pool)
+	_, broken := keeper.PoolBalancesInvariant(dexKeeper)(ctx)
+	require.True(t, broken)
+
+	t.Log("a total liquidity which isn't the sum of the pool assets breaks the total liquidity invariant")
+	_, broken = keeper.TotalLiquidityInvariant(dexKeeper)(ctx)
+	require.True(t, broken)
+}
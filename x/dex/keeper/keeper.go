@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,6 +13,8 @@ import (
 	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/dex/types"
 )
 
@@ -24,6 +27,10 @@ type (
 		accountKeeper types.AccountKeeper
 		bankKeeper    types.BankKeeper
 		distrKeeper   types.DistrKeeper
+
+		// TwapRecords holds the cumulative prices of the denom pairs of the
+		// pools, by pool id and base:quote pair, at each time they changed.
+		TwapRecords collections.Map[collections.Pair[collections.Pair[uint64, common.AssetPair], time.Time], types.TwapRecord]
 	}
 )
 
@@ -62,6 +69,14 @@ func NewKeeper(
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
 		distrKeeper:   distrKeeper,
+		TwapRecords: collections.NewMap(
+			storeKey, types.NamespaceTwapRecords,
+			collections.PairKeyEncoder(
+				collections.PairKeyEncoder(collections.Uint64KeyEncoder, common.AssetPairKeyEncoder),
+				collections.TimeKeyEncoder,
+			),
+			collections.ProtoValueEncoder[types.TwapRecord](cdc),
+		),
 	}
 }
 
@@ -384,6 +399,9 @@ func (k Keeper) NewPool(
 	})
 
 	k.SetPool(ctx, pool)
+	if err = k.updateTwapRecords(ctx, pool); err != nil {
+		return uint64(0), err
+	}
 	k.RecordTotalLiquidityIncrease(ctx, coins)
 
 	err = ctx.EventManager().EmitTypedEvent(&types.EventPoolCreated{
@@ -417,6 +435,9 @@ and JoinPool is called with 75foo and bar, only 50foo and 50bar would be deposit
 25foo in remCoins would be returned to the user, along with 50 pool shares would be minted
 and given to the user.
 
+With shouldSwap, the leftover assets are joined too, one asset at a time, and
+tokensIn may hold only some of the pool's assets, e.g. a single one.
+
 Inverse of ExitPool.
 
 args:
@@ -440,7 +461,7 @@ func (k Keeper) JoinPool(
 ) (pool types.Pool, numSharesOut sdk.Coin, remCoins sdk.Coins, err error) {
 	pool, _ = k.FetchPool(ctx, poolId)
 
-	if len(tokensIn) != len(pool.PoolAssets) {
+	if !shouldSwap && len(tokensIn) != len(pool.PoolAssets) {
 		return pool, numSharesOut, remCoins, errors.New("too few assets to join this pool")
 	}
 
@@ -448,9 +469,9 @@ func (k Keeper) JoinPool(
 
 	var numShares sdk.Int
 	if !shouldSwap {
-		numShares, remCoins, err = pool.AddTokensToPool(tokensIn)
+		numShares, remCoins, err = pool.AsPoolI(ctx.BlockTime()).AddTokensToPool(tokensIn)
 	} else {
-		numShares, remCoins, err = pool.AddAllTokensToPool(tokensIn)
+		numShares, remCoins, err = pool.AsPoolI(ctx.BlockTime()).AddAllTokensToPool(tokensIn)
 	}
 	if err != nil {
 		return types.Pool{}, sdk.Coin{}, sdk.Coins{}, err
@@ -480,6 +501,9 @@ func (k Keeper) JoinPool(
 
 	// record changes to store
 	k.SetPool(ctx, pool)
+	if err = k.updateTwapRecords(ctx, pool); err != nil {
+		return types.Pool{}, sdk.Coin{}, sdk.Coins{}, err
+	}
 	k.RecordTotalLiquidityIncrease(ctx, tokensConsumed)
 
 	poolSharesOut := sdk.NewCoin(pool.TotalShares.Denom, numShares)
@@ -505,6 +529,9 @@ in proportion to the total amount of pool shares.
 For example, if a pool has 100 pool shares and ExitPool is called with 50 pool shares,
 half of the tokens (minus exit fees) are returned to the user.
 
+If tokenOutDenom is set, all the tokens are returned in that denom instead,
+following the weighted invariant.
+
 Inverse of JoinPool.
 
 Throws an error if the provided pool shares doesn't match up with the pool's actual pool share.
@@ -514,6 +541,7 @@ args:
   - sender: the user who wishes to withdraw tokens
   - poolId: the pool's numeric id
   - poolSharesOut: the amount of pool shares to burn
+  - tokenOutDenom: the denom of a single asset exit, or empty
 
 ret:
   - tokensOut: the amount of liquidity withdrawn from the pool
@@ -524,6 +552,7 @@ func (k Keeper) ExitPool(
 	sender sdk.AccAddress,
 	poolId uint64,
 	poolSharesOut sdk.Coin,
+	tokenOutDenom string,
 ) (tokensOut sdk.Coins, err error) {
 	pool, _ := k.FetchPool(ctx, poolId)
 
@@ -542,7 +571,11 @@ func (k Keeper) ExitPool(
 	}
 
 	// calculate withdrawn liquidity
-	tokensOut, err = pool.ExitPool(poolSharesOut.Amount)
+	if tokenOutDenom == "" {
+		tokensOut, err = pool.AsPoolI(ctx.BlockTime()).ExitPool(poolSharesOut.Amount)
+	} else {
+		tokensOut, err = pool.AsPoolI(ctx.BlockTime()).ExitPoolToSingleAsset(poolSharesOut.Amount, tokenOutDenom)
+	}
 	if err != nil {
 		return sdk.Coins{}, err
 	}
@@ -558,6 +591,9 @@ func (k Keeper) ExitPool(
 
 	// record state changes
 	k.SetPool(ctx, pool)
+	if err = k.updateTwapRecords(ctx, pool); err != nil {
+		return sdk.Coins{}, err
+	}
 	k.RecordTotalLiquidityDecrease(ctx, tokensOut)
 
 	err = ctx.EventManager().EmitTypedEvent(&types.EventPoolExited{
@@ -573,6 +609,176 @@ func (k Keeper) ExitPool(
 	return tokensOut, nil
 }
 
+/*
+Joins a pool for an exact amount of pool shares, depositing the tokens in the
+pool's proportions, rounded up.
+
+args:
+  - ctx: the cosmos-sdk context
+  - joinerAddr: the user who wishes to provide liquidity
+  - poolId: the pool's numeric id
+  - poolSharesOut: the amount of pool shares to mint
+  - tokenInMaxs: if set, the maximum amount of each token to deposit
+
+ret:
+  - pool: the updated pool after joining
+  - tokensIn: the tokens deposited into the pool
+  - err: error if any
+*/
+func (k Keeper) JoinPoolExactShares(
+	ctx sdk.Context,
+	joinerAddr sdk.AccAddress,
+	poolId uint64,
+	poolSharesOut sdk.Int,
+	tokenInMaxs sdk.Coins,
+) (pool types.Pool, tokensIn sdk.Coins, err error) {
+	pool, err = k.FetchPool(ctx, poolId)
+	if err != nil {
+		return types.Pool{}, sdk.Coins{}, err
+	}
+
+	tokensIn, err = pool.AsPoolI(ctx.BlockTime()).JoinPoolExactShares(poolSharesOut)
+	if err != nil {
+		return types.Pool{}, sdk.Coins{}, err
+	}
+	if !tokenInMaxs.Empty() && !tokensIn.IsAllLTE(tokenInMaxs) {
+		return types.Pool{}, sdk.Coins{}, types.ErrTokenInAboveMaxAmount.Wrapf(
+			"joining requires %s, more than %s", tokensIn, tokenInMaxs)
+	}
+
+	if err = k.bankKeeper.SendCoins(ctx, joinerAddr, pool.GetAddress(), tokensIn); err != nil {
+		return types.Pool{}, sdk.Coins{}, err
+	}
+	if err = k.mintPoolShareToAccount(ctx, pool.Id, joinerAddr, poolSharesOut); err != nil {
+		return types.Pool{}, sdk.Coins{}, err
+	}
+
+	k.SetPool(ctx, pool)
+	if err = k.updateTwapRecords(ctx, pool); err != nil {
+		return types.Pool{}, sdk.Coins{}, err
+	}
+	k.RecordTotalLiquidityIncrease(ctx, tokensIn)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventPoolJoined{
+		Address:       joinerAddr.String(),
+		PoolId:        poolId,
+		TokensIn:      tokensIn,
+		PoolSharesOut: sdk.NewCoin(pool.TotalShares.Denom, poolSharesOut),
+		RemCoins:      sdk.Coins{},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return pool, tokensIn, nil
+}
+
+/*
+Exits a pool for an exact amount of tokens, burning the pool shares they cost,
+exit fee included. The tokens may be any subset of the pool's assets: the part
+in the pool's proportions is exited proportionally, the rest as single asset
+exits.
+
+args:
+  - ctx: the cosmos-sdk context
+  - sender: the user who wishes to withdraw tokens
+  - poolId: the pool's numeric id
+  - tokensOut: the tokens to withdraw
+  - poolSharesInMax: the maximum amount of pool shares to burn
+
+ret:
+  - poolSharesIn: the pool shares burned
+  - err: error if any
+*/
+func (k Keeper) ExitPoolExactTokens(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokensOut sdk.Coins,
+	poolSharesInMax sdk.Int,
+) (poolSharesIn sdk.Coin, err error) {
+	pool, err := k.FetchPool(ctx, poolId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	numSharesIn, err := pool.AsPoolI(ctx.BlockTime()).ExitPoolExactTokens(tokensOut)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if numSharesIn.GT(poolSharesInMax) {
+		return sdk.Coin{}, types.ErrPoolSharesInAboveMaxAmount.Wrapf(
+			"exiting requires %s pool shares, more than %s", numSharesIn, poolSharesInMax)
+	}
+	poolSharesIn = sdk.NewCoin(pool.TotalShares.Denom, numSharesIn)
+
+	if err = k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, tokensOut); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err = k.burnPoolShareFromAccount(ctx, sender, poolSharesIn); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	k.SetPool(ctx, pool)
+	if err = k.updateTwapRecords(ctx, pool); err != nil {
+		return sdk.Coin{}, err
+	}
+	k.RecordTotalLiquidityDecrease(ctx, tokensOut)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventPoolExited{
+		Address:      sender.String(),
+		PoolId:       poolId,
+		PoolSharesIn: poolSharesIn,
+		TokensOut:    tokensOut,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return poolSharesIn, nil
+}
+
+/*
+Starts a linear ramp of the amplification of a StableSwap pool, from its
+current amplification to futureAmplification after rampDuration.
+
+args:
+  - ctx: the cosmos-sdk context
+  - poolId: the id of the StableSwap pool
+  - futureAmplification: the amplification at the end of the ramp
+  - rampDuration: how long the ramp lasts, from the block time
+
+ret:
+  - err: error if any
+*/
+func (k Keeper) RampAmplification(
+	ctx sdk.Context,
+	poolId uint64,
+	futureAmplification uint64,
+	rampDuration time.Duration,
+) (err error) {
+	pool, err := k.FetchPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	futureTime := ctx.BlockTime().Add(rampDuration)
+	if err = pool.RampAmplification(ctx.BlockTime(), futureAmplification, futureTime); err != nil {
+		return err
+	}
+	k.SetPool(ctx, pool)
+	if err = k.updateTwapRecords(ctx, pool); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventAmplificationRamped{
+		PoolId:               poolId,
+		InitialAmplification: pool.AmplificationRamp.InitialAmplification,
+		FutureAmplification:  futureAmplification,
+		FutureTime:           futureTime,
+	})
+}
+
 // TODO implement
 func (k Keeper) GetFromPair(ctx sdk.Context, denomA string, denomB string) (poolId uint64, err error) {
 	return 0, nil
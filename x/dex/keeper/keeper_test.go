@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/NibiruChain/nibiru/x/testutil"
 
@@ -276,6 +277,69 @@ func TestNewPool(t *testing.T) {
 	}, retrievedPool)
 }
 
+func TestStableSwapPool(t *testing.T) {
+	app, ctx := simapp2.NewTestNibiruAppAndContext(true)
+	ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0))
+
+	poolCreationFeeCoin := sdk.NewInt64Coin(common.DenomNIBI, 1000_000_000)
+	app.DexKeeper.SetParams(ctx, types.NewParams(
+		/*startingPoolNumber=*/ 1,
+		/*poolCreationFee=*/ sdk.NewCoins(poolCreationFeeCoin),
+		/*whitelistedAssets*/ []string{
+			"uusdc",
+			common.DenomNUSD,
+		},
+	))
+
+	userAddr := testutil.AccAddress()
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, userAddr, sdk.NewCoins(
+		sdk.NewInt64Coin("uusdc", 1100),
+		sdk.NewInt64Coin(common.DenomNUSD, 1000),
+		poolCreationFeeCoin,
+	)))
+
+	t.Log("create a stableswap pool")
+	poolId, err := app.DexKeeper.NewPool(ctx,
+		userAddr,
+		types.PoolParams{
+			SwapFee:       sdk.NewDecWithPrec(3, 2),
+			ExitFee:       sdk.NewDecWithPrec(3, 2),
+			PoolType:      types.PoolType_STABLESWAP,
+			Amplification: 100,
+		},
+		[]types.PoolAsset{
+			{Token: sdk.NewInt64Coin("uusdc", 1000), Weight: sdk.OneInt()},
+			{Token: sdk.NewInt64Coin(common.DenomNUSD, 1000), Weight: sdk.OneInt()},
+		})
+	require.NoError(t, err)
+
+	t.Log("swap along the stableswap invariant")
+	tokenOut, err := app.DexKeeper.SwapExactAmountIn(
+		ctx, userAddr, poolId, sdk.NewInt64Coin("uusdc", 100), common.DenomNUSD)
+	require.NoError(t, err)
+	// a balancer pool would only give 88
+	require.Equal(t, sdk.NewInt64Coin(common.DenomNUSD, 96), tokenOut)
+
+	t.Log("ramp the amplification down")
+	require.NoError(t, app.DexKeeper.RampAmplification(ctx, poolId, 10, 48*time.Hour))
+	testutil.RequireHasTypedEvent(t, ctx, &types.EventAmplificationRamped{
+		PoolId:               poolId,
+		InitialAmplification: 100,
+		FutureAmplification:  10,
+		FutureTime:           ctx.BlockTime().Add(48 * time.Hour),
+	})
+
+	pool, err := app.DexKeeper.FetchPool(ctx, poolId)
+	require.NoError(t, err)
+	require.EqualValues(t, 100, pool.AmplificationAt(ctx.BlockTime()))
+	require.EqualValues(t, 55, pool.AmplificationAt(ctx.BlockTime().Add(24*time.Hour)))
+	require.EqualValues(t, 10, pool.AmplificationAt(ctx.BlockTime().Add(48*time.Hour)))
+
+	t.Log("the ramp can't be too fast")
+	err = app.DexKeeper.RampAmplification(ctx, poolId, 100, time.Hour)
+	require.ErrorIs(t, err, types.ErrInvalidAmplification)
+}
+
 func TestNewPoolNotEnoughFunds(t *testing.T) {
 	app, ctx := simapp2.NewTestNibiruAppAndContext(true)
 
@@ -625,7 +689,7 @@ func TestJoinPoolAllAssets(t *testing.T) {
 				sdk.NewInt64Coin("bar", 50),
 				sdk.NewInt64Coin("foo", 75),
 			),
-			expectedNumSharesOut: sdk.NewInt64Coin(shareDenom, 61),
+			expectedNumSharesOut: sdk.NewInt64Coin(shareDenom, 62),
 			expectedRemCoins:     sdk.NewCoins(),
 			expectedJoinerFinalFunds: sdk.NewCoins(
 				sdk.NewInt64Coin(shareDenom, 50),
@@ -638,7 +702,37 @@ func TestJoinPoolAllAssets(t *testing.T) {
 					sdk.NewInt64Coin("bar", 150),
 					sdk.NewInt64Coin("foo", 175),
 				),
-				/*shares=*/ 161),
+				/*shares=*/ 162),
+		},
+		{
+			name: "join with a single asset",
+			joinerInitialFunds: sdk.NewCoins(
+				sdk.NewInt64Coin("foo", 100),
+			),
+			initialPool: mock.DexPool(
+				/*poolId=*/ 1,
+				/*assets=*/ sdk.NewCoins(
+					sdk.NewInt64Coin("bar", 100),
+					sdk.NewInt64Coin("foo", 100),
+				),
+				/*shares=*/ 100),
+			tokensIn: sdk.NewCoins(
+				sdk.NewInt64Coin("foo", 50),
+			),
+			// 100 * ((1 + 50/100)^(1/2) - 1)
+			expectedNumSharesOut: sdk.NewInt64Coin(shareDenom, 22),
+			expectedRemCoins:     sdk.NewCoins(),
+			expectedJoinerFinalFunds: sdk.NewCoins(
+				sdk.NewInt64Coin(shareDenom, 22),
+				sdk.NewInt64Coin("foo", 50),
+			),
+			expectedFinalPool: mock.DexPool(
+				/*poolId=*/ 1,
+				/*assets=*/ sdk.NewCoins(
+					sdk.NewInt64Coin("bar", 100),
+					sdk.NewInt64Coin("foo", 150),
+				),
+				/*shares=*/ 122),
 		},
 	}
 
@@ -673,6 +767,7 @@ func TestExitPool(t *testing.T) {
 		initialPoolFunds         sdk.Coins
 		initialPool              types.Pool
 		poolSharesIn             sdk.Coin
+		tokenOutDenom            string
 		expectedTokensOut        sdk.Coins
 		expectedJoinerFinalFunds sdk.Coins
 		expectedFinalPool        types.Pool
@@ -751,6 +846,44 @@ func TestExitPool(t *testing.T) {
 				),
 				/*shares=*/ 50,
 			),
+		}, {
+			name: "exit half pool shares in a single asset",
+			joinerInitialFunds: sdk.NewCoins(
+				sdk.NewInt64Coin("bar", 100),
+				sdk.NewInt64Coin("foo", 100),
+				sdk.NewInt64Coin(shareDenom, 100),
+			),
+			initialPoolFunds: sdk.NewCoins(
+				sdk.NewInt64Coin("bar", 100),
+				sdk.NewInt64Coin("foo", 100),
+			),
+			initialPool: mock.DexPool(
+				/*poolId=*/ 1,
+				/*assets=*/ sdk.NewCoins(
+					sdk.NewInt64Coin("bar", 100),
+					sdk.NewInt64Coin("foo", 100),
+				),
+				/*shares=*/ 100,
+			),
+			poolSharesIn:  sdk.NewInt64Coin(shareDenom, 50),
+			tokenOutDenom: "foo",
+			// 100 * (1 - (1 - 50/100)^2), minus the fees
+			expectedTokensOut: sdk.NewCoins(
+				sdk.NewInt64Coin("foo", 74),
+			),
+			expectedJoinerFinalFunds: sdk.NewCoins(
+				sdk.NewInt64Coin("bar", 100),
+				sdk.NewInt64Coin("foo", 174),
+				sdk.NewInt64Coin(shareDenom, 50),
+			),
+			expectedFinalPool: mock.DexPool(
+				/*poolId=*/ 1,
+				/*assets=*/ sdk.NewCoins(
+					sdk.NewInt64Coin("bar", 100),
+					sdk.NewInt64Coin("foo", 26),
+				),
+				/*shares=*/ 50,
+			),
 		},
 	}
 
@@ -768,7 +901,7 @@ func TestExitPool(t *testing.T) {
 			require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, sender, tc.joinerInitialFunds))
 			require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, tc.initialPool.GetAddress(), tc.initialPoolFunds))
 
-			tokensOut, err := app.DexKeeper.ExitPool(ctx, sender, 1, tc.poolSharesIn)
+			tokensOut, err := app.DexKeeper.ExitPool(ctx, sender, 1, tc.poolSharesIn, tc.tokenOutDenom)
 			require.NoError(t, err)
 			require.Equal(t, tc.expectedTokensOut, tokensOut)
 			require.Equal(t, tc.expectedJoinerFinalFunds, app.BankKeeper.GetAllBalances(ctx, sender))
@@ -777,3 +910,103 @@ func TestExitPool(t *testing.T) {
 		})
 	}
 }
+
+func TestJoinPoolExactShares(t *testing.T) {
+	const shareDenom = "nibiru/pool/1"
+
+	app, ctx := simapp2.NewTestNibiruAppAndContext(true)
+	initialPool := mock.DexPool(
+		/*poolId=*/ 1,
+		/*assets=*/ sdk.NewCoins(
+			sdk.NewInt64Coin("bar", 100),
+			sdk.NewInt64Coin("foo", 100),
+		),
+		/*shares=*/ 100,
+	)
+	initialPool.Address = testutil.AccAddress().String()
+	app.DexKeeper.SetPool(ctx, initialPool)
+
+	joiner := testutil.AccAddress()
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, joiner, sdk.NewCoins(
+		sdk.NewInt64Coin("bar", 100),
+		sdk.NewInt64Coin("foo", 100),
+	)))
+
+	t.Log("joining costs more than the maximum")
+	failedTxCtx, _ := ctx.CacheContext()
+	_, _, err := app.DexKeeper.JoinPoolExactShares(failedTxCtx, joiner, 1, sdk.NewInt(50), sdk.NewCoins(
+		sdk.NewInt64Coin("bar", 49),
+		sdk.NewInt64Coin("foo", 100),
+	))
+	require.ErrorIs(t, err, types.ErrTokenInAboveMaxAmount)
+
+	t.Log("join for half the pool shares")
+	pool, tokensIn, err := app.DexKeeper.JoinPoolExactShares(ctx, joiner, 1, sdk.NewInt(50), sdk.NewCoins(
+		sdk.NewInt64Coin("bar", 50),
+		sdk.NewInt64Coin("foo", 50),
+	))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewInt64Coin("bar", 50),
+		sdk.NewInt64Coin("foo", 50),
+	), tokensIn)
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewInt64Coin("bar", 50),
+		sdk.NewInt64Coin("foo", 50),
+		sdk.NewInt64Coin(shareDenom, 50),
+	), app.BankKeeper.GetAllBalances(ctx, joiner))
+	require.Equal(t, sdk.NewInt(150), pool.TotalShares.Amount)
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewInt64Coin("bar", 150),
+		sdk.NewInt64Coin("foo", 150),
+	), pool.PoolBalances())
+}
+
+func TestExitPoolExactTokens(t *testing.T) {
+	const shareDenom = "nibiru/pool/1"
+
+	app, ctx := simapp2.NewTestNibiruAppAndContext(true)
+	initialPool := mock.DexPool(
+		/*poolId=*/ 1,
+		/*assets=*/ sdk.NewCoins(
+			sdk.NewInt64Coin("bar", 100),
+			sdk.NewInt64Coin("foo", 100),
+		),
+		/*shares=*/ 100,
+	)
+	initialPool.Address = testutil.AccAddress().String()
+	app.DexKeeper.SetPool(ctx, initialPool)
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, initialPool.GetAddress(), initialPool.PoolBalances()))
+
+	sender := testutil.AccAddress()
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, sender, sdk.NewCoins(
+		sdk.NewInt64Coin(shareDenom, 100),
+	)))
+	tokensOut := sdk.NewCoins(
+		sdk.NewInt64Coin("bar", 49),
+		sdk.NewInt64Coin("foo", 49),
+	)
+
+	t.Log("exiting burns more pool shares than the maximum")
+	failedTxCtx, _ := ctx.CacheContext()
+	_, err := app.DexKeeper.ExitPoolExactTokens(failedTxCtx, sender, 1, tokensOut, sdk.NewInt(48))
+	require.ErrorIs(t, err, types.ErrPoolSharesInAboveMaxAmount)
+
+	t.Log("exit for 49 tokens of each asset")
+	poolSharesIn, err := app.DexKeeper.ExitPoolExactTokens(ctx, sender, 1, tokensOut, sdk.NewInt(49))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(shareDenom, 49), poolSharesIn)
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewInt64Coin("bar", 49),
+		sdk.NewInt64Coin("foo", 49),
+		sdk.NewInt64Coin(shareDenom, 51),
+	), app.BankKeeper.GetAllBalances(ctx, sender))
+
+	pool, err := app.DexKeeper.FetchPool(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(51), pool.TotalShares.Amount)
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewInt64Coin("bar", 51),
+		sdk.NewInt64Coin("foo", 51),
+	), pool.PoolBalances())
+}
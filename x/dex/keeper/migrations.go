@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator handles the in-place store migrations of the x/dex module.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 records the spot prices of the existing pools at the upgrade time, so
+// that their TWAPs start accumulating from it rather than from their next swap, join
+// or exit.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	for _, pool := range m.keeper.FetchAllPools(ctx) {
+		if err := m.keeper.updateTwapRecords(ctx, pool); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -116,6 +116,7 @@ func (k msgServer) ExitPool(ctx context.Context, msg *types.MsgExitPool) (*types
 		sender,
 		msg.PoolId,
 		msg.PoolShares,
+		msg.TokenOutDenom,
 	)
 	if err != nil {
 		return nil, err
@@ -126,6 +127,84 @@ func (k msgServer) ExitPool(ctx context.Context, msg *types.MsgExitPool) (*types
 	}, nil
 }
 
+/*
+Handler for the MsgJoinPoolExactShares transaction.
+
+args
+
+	ctx: the cosmos-sdk context
+	msg: a MsgJoinPoolExactShares proto object
+
+ret
+
+	MsgJoinPoolExactSharesResponse: the response, containing the tokens deposited into the pool
+	error: an error if any occurred
+*/
+func (k msgServer) JoinPoolExactShares(ctx context.Context, msg *types.MsgJoinPoolExactShares) (
+	*types.MsgJoinPoolExactSharesResponse, error,
+) {
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	_, tokensIn, err := k.Keeper.JoinPoolExactShares(
+		sdkContext,
+		sender,
+		msg.PoolId,
+		msg.PoolSharesOut,
+		msg.TokenInMaxs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgJoinPoolExactSharesResponse{
+		TokensIn: tokensIn,
+	}, nil
+}
+
+/*
+Handler for the MsgExitPoolExactTokens transaction.
+
+args
+
+	ctx: the cosmos-sdk context
+	msg: a MsgExitPoolExactTokens proto object
+
+ret
+
+	MsgExitPoolExactTokensResponse: the response, containing the pool shares burned
+	error: an error if any occurred
+*/
+func (k msgServer) ExitPoolExactTokens(ctx context.Context, msg *types.MsgExitPoolExactTokens) (
+	*types.MsgExitPoolExactTokensResponse, error,
+) {
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	poolSharesIn, err := k.Keeper.ExitPoolExactTokens(
+		sdkContext,
+		sender,
+		msg.PoolId,
+		msg.TokensOut,
+		msg.PoolSharesInMax,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExitPoolExactTokensResponse{
+		PoolSharesIn: poolSharesIn,
+	}, nil
+}
+
 /*
 Handler for the MsgJoinPool transaction.
 
@@ -164,3 +243,82 @@ func (k msgServer) SwapAssets(ctx context.Context, msg *types.MsgSwapAssets) (
 		TokenOut: tokenOut,
 	}, nil
 }
+
+/*
+Handler for the MsgSwapExactAmountIn transaction.
+
+args
+
+	ctx: the cosmos-sdk context
+	msg: a MsgSwapExactAmountIn proto object
+
+ret
+
+	MsgSwapExactAmountInResponse: the response, containing the tokens out of the last pool
+	error: an error if any occurred
+*/
+func (k msgServer) SwapExactAmountIn(ctx context.Context, msg *types.MsgSwapExactAmountIn) (
+	*types.MsgSwapExactAmountInResponse, error,
+) {
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOut, err := k.Keeper.MultihopSwapExactAmountIn(
+		sdkContext,
+		sender,
+		msg.Routes,
+		msg.TokenIn,
+		msg.TokenOutMinAmount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSwapExactAmountInResponse{
+		TokenOut: tokenOut,
+	}, nil
+}
+
+/*
+Handler for the MsgSwapExactAmountOut transaction.
+
+args
+
+	ctx: the cosmos-sdk context
+	msg: a MsgSwapExactAmountOut proto object
+
+ret
+
+	MsgSwapExactAmountOutResponse: the response, containing the tokens given to the first pool
+	error: an error if any occurred
+*/
+func (k msgServer) SwapExactAmountOut(ctx context.Context, msg *types.MsgSwapExactAmountOut) (
+	*types.MsgSwapExactAmountOutResponse, error,
+) {
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenIn, err := k.Keeper.MultihopSwapExactAmountOut(
+		sdkContext,
+		sender,
+		msg.Routes,
+		msg.TokenInDenom,
+		msg.TokenOut,
+		msg.TokenInMaxAmount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSwapExactAmountOutResponse{
+		TokenIn: tokenIn,
+	}, nil
+}
@@ -37,6 +37,9 @@ func (k Keeper) updatePoolForSwap(
 		return err
 	}
 	k.SetPool(ctx, pool)
+	if err = k.updateTwapRecords(ctx, pool); err != nil {
+		return err
+	}
 
 	k.RecordTotalLiquidityIncrease(ctx, sdk.Coins{tokenIn})
 	k.RecordTotalLiquidityDecrease(ctx, sdk.Coins{tokenOut})
@@ -79,7 +82,7 @@ func (k Keeper) SwapExactAmountIn(
 	}
 
 	// calculate tokenOut and validate
-	tokenOut, err = pool.CalcOutAmtGivenIn(tokenIn, tokenOutDenom, false)
+	tokenOut, err = pool.AsPoolI(ctx.BlockTime()).CalcOutAmtGivenIn(tokenIn, tokenOutDenom, false)
 	if err != nil {
 		return sdk.Coin{}, err
 	}
@@ -114,3 +117,71 @@ func (k Keeper) SwapExactAmountIn(
 
 	return tokenOut, nil
 }
+
+/*
+Given a poolId and the amount of tokens to take out of the pool, swaps the
+tokens required, in the tokenInDenom.
+
+args:
+  - ctx: the cosmos-sdk context
+  - sender: the address wishing to perform the swap
+  - poolId: the pool id number
+  - tokenInDenom: the denom of the token given to the pool
+  - tokenOut: the amount of tokens taken out of the pool
+
+ret:
+  - tokenIn: the amount of tokens given to the pool
+  - err: error if any
+*/
+func (k Keeper) SwapExactAmountOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokenInDenom string,
+	tokenOut sdk.Coin,
+) (tokenIn sdk.Coin, err error) {
+	if tokenInDenom == tokenOut.Denom {
+		return sdk.Coin{}, types.ErrSameTokenDenom
+	}
+
+	pool, err := k.FetchPool(ctx, poolId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// calculate tokenIn and validate
+	tokenIn, err = pool.AsPoolI(ctx.BlockTime()).CalcInAmtGivenOut(tokenOut, tokenInDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if tokenIn.Amount.LTE(sdk.ZeroInt()) {
+		return sdk.Coin{}, errors.New("tokenIn amount must be greater than zero")
+	}
+
+	// check sender has enough tokenIn
+	if err = k.CheckEnoughBalances(ctx, sdk.Coins{tokenIn}, sender); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// check pool has enough tokenOut
+	if err = k.CheckEnoughBalances(ctx, sdk.Coins{tokenOut}, pool.GetAddress()); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	err = k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOut)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventAssetsSwapped{
+		Address:  sender.String(),
+		PoolId:   poolId,
+		TokenIn:  tokenIn,
+		TokenOut: tokenOut,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return tokenIn, nil
+}
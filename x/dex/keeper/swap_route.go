@@ -0,0 +1,309 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/dex/types"
+)
+
+/*
+Swaps an exact amount of tokens through every pool of the routes, in order.
+
+args:
+  - ctx: the cosmos-sdk context
+  - sender: the address wishing to perform the swap
+  - routes: the pools to swap through, and the denom out of each
+  - tokenIn: the amount of tokens given to the first pool
+  - tokenOutMinAmount: the swap fails if fewer tokens come out of the last pool
+
+ret:
+  - tokenOut: the amount of tokens taken out of the last pool
+  - err: error if any
+*/
+func (k Keeper) MultihopSwapExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapRoute,
+	tokenIn sdk.Coin,
+	tokenOutMinAmount sdk.Int,
+) (tokenOut sdk.Coin, err error) {
+	if err = types.ValidateSwapRoutes(tokenIn.Denom, routes); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tokenOut = tokenIn
+	for _, route := range routes {
+		tokenOut, err = k.SwapExactAmountIn(ctx, sender, route.PoolId, tokenOut, route.TokenOutDenom)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	if tokenOut.Amount.LT(tokenOutMinAmount) {
+		return sdk.Coin{}, types.ErrTokenOutBelowMinAmount.Wrapf(
+			"got %s, expected at least %s", tokenOut.Amount, tokenOutMinAmount)
+	}
+	return tokenOut, nil
+}
+
+/*
+Swaps through every pool of the routes, in order, for an exact amount of tokens
+out of the last pool. The amount out of each pool is the amount the next pool
+requires, so that no intermediate tokens are left to the sender.
+
+args:
+  - ctx: the cosmos-sdk context
+  - sender: the address wishing to perform the swap
+  - routes: the pools to swap through, and the denom out of each
+  - tokenInDenom: the denom given to the first pool
+  - tokenOut: the amount of tokens taken out of the last pool
+  - tokenInMaxAmount: the swap fails if more tokens are required by the first pool
+
+ret:
+  - tokenIn: the amount of tokens given to the first pool
+  - err: error if any
+*/
+func (k Keeper) MultihopSwapExactAmountOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapRoute,
+	tokenInDenom string,
+	tokenOut sdk.Coin,
+	tokenInMaxAmount sdk.Int,
+) (tokenIn sdk.Coin, err error) {
+	hopTokensIn, err := k.estimateHopTokensIn(ctx, tokenInDenom, routes, tokenOut)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tokenIn = hopTokensIn[0]
+	if tokenIn.Amount.GT(tokenInMaxAmount) {
+		return sdk.Coin{}, types.ErrTokenInAboveMaxAmount.Wrapf(
+			"requires %s, expected at most %s", tokenIn.Amount, tokenInMaxAmount)
+	}
+
+	for i, route := range routes {
+		hopTokenOut := tokenOut
+		if i+1 < len(routes) {
+			hopTokenOut = hopTokensIn[i+1]
+		}
+		if _, err = k.SwapExactAmountOut(ctx, sender, route.PoolId, hopTokensIn[i].Denom, hopTokenOut); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	return tokenIn, nil
+}
+
+/*
+Estimates the amount of tokens out of a swap through every pool of the routes,
+without swapping.
+
+args:
+  - ctx: the cosmos-sdk context
+  - tokenIn: the amount of tokens given to the first pool
+  - routes: the pools to swap through, and the denom out of each
+
+ret:
+  - tokenOut: the amount of tokens that would be taken out of the last pool
+  - err: error if any
+*/
+func (k Keeper) EstimateSwapExactAmountInRoutes(
+	ctx sdk.Context, tokenIn sdk.Coin, routes []types.SwapRoute,
+) (tokenOut sdk.Coin, err error) {
+	if err = types.ValidateSwapRoutes(tokenIn.Denom, routes); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tokenOut = tokenIn
+	for _, route := range routes {
+		pool, err := k.FetchPool(ctx, route.PoolId)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		tokenOut, err = pool.AsPoolI(ctx.BlockTime()).CalcOutAmtGivenIn(tokenOut, route.TokenOutDenom, false)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+	return tokenOut, nil
+}
+
+/*
+Estimates the amount of tokens required by a swap through every pool of the
+routes for an exact amount of tokens out, without swapping.
+
+args:
+  - ctx: the cosmos-sdk context
+  - tokenInDenom: the denom given to the first pool
+  - routes: the pools to swap through, and the denom out of each
+  - tokenOut: the amount of tokens taken out of the last pool
+
+ret:
+  - tokenIn: the amount of tokens the first pool would require
+  - err: error if any
+*/
+func (k Keeper) EstimateSwapExactAmountOutRoutes(
+	ctx sdk.Context, tokenInDenom string, routes []types.SwapRoute, tokenOut sdk.Coin,
+) (tokenIn sdk.Coin, err error) {
+	hopTokensIn, err := k.estimateHopTokensIn(ctx, tokenInDenom, routes, tokenOut)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return hopTokensIn[0], nil
+}
+
+// estimateHopTokensIn computes, from the last pool back to the first, the
+// tokens each pool of the routes requires for the tokens out of the next one.
+func (k Keeper) estimateHopTokensIn(
+	ctx sdk.Context, tokenInDenom string, routes []types.SwapRoute, tokenOut sdk.Coin,
+) (hopTokensIn []sdk.Coin, err error) {
+	if err = types.ValidateSwapRoutes(tokenInDenom, routes); err != nil {
+		return nil, err
+	}
+	if types.TokenOutDenom(routes) != tokenOut.Denom {
+		return nil, types.ErrInvalidSwapRoutes.Wrapf(
+			"the route ends in %s instead of %s", types.TokenOutDenom(routes), tokenOut.Denom)
+	}
+
+	hopTokensIn = make([]sdk.Coin, len(routes))
+	hopTokenOut := tokenOut
+	for i := len(routes) - 1; i >= 0; i-- {
+		denomIn := tokenInDenom
+		if i > 0 {
+			denomIn = routes[i-1].TokenOutDenom
+		}
+
+		pool, err := k.FetchPool(ctx, routes[i].PoolId)
+		if err != nil {
+			return nil, err
+		}
+		hopTokensIn[i], err = pool.AsPoolI(ctx.BlockTime()).CalcInAmtGivenOut(hopTokenOut, denomIn)
+		if err != nil {
+			return nil, err
+		}
+		hopTokenOut = hopTokensIn[i]
+	}
+	return hopTokensIn, nil
+}
+
+/*
+Finds the route of at most MaxSwapRoutes pools giving the most tokens out for
+an exact amount of tokens in, over every pool. On a tie, the route through the
+fewest pools is kept.
+
+args:
+  - ctx: the cosmos-sdk context
+  - tokenIn: the amount of tokens to swap
+  - tokenOutDenom: the denom to swap for
+
+ret:
+  - routes: the best route
+  - tokenOut: the amount of tokens out of the best route
+  - err: ErrNoSwapRoute if no route swaps tokenIn for tokenOutDenom
+*/
+func (k Keeper) BestSwapRouteExactAmountIn(
+	ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string,
+) (routes []types.SwapRoute, tokenOut sdk.Coin, err error) {
+	pools := k.FetchAllPools(ctx)
+	visited := make(map[uint64]bool)
+
+	var search func(hopTokenIn sdk.Coin, path []types.SwapRoute)
+	search = func(hopTokenIn sdk.Coin, path []types.SwapRoute) {
+		for i := range pools {
+			pool := &pools[i]
+			if visited[pool.Id] || pool.PoolBalances().AmountOf(hopTokenIn.Denom).IsZero() {
+				continue
+			}
+			for _, asset := range pool.PoolAssets {
+				denom := asset.Token.Denom
+				if denom == hopTokenIn.Denom {
+					continue
+				}
+				hopTokenOut, err := pool.AsPoolI(ctx.BlockTime()).CalcOutAmtGivenIn(hopTokenIn, denom, false)
+				if err != nil {
+					continue
+				}
+
+				hopPath := append(append([]types.SwapRoute{}, path...), types.SwapRoute{PoolId: pool.Id, TokenOutDenom: denom})
+				if denom == tokenOutDenom {
+					if routes == nil || hopTokenOut.Amount.GT(tokenOut.Amount) ||
+						(hopTokenOut.Amount.Equal(tokenOut.Amount) && len(hopPath) < len(routes)) {
+						routes, tokenOut = hopPath, hopTokenOut
+					}
+				} else if len(hopPath) < types.MaxSwapRoutes {
+					visited[pool.Id] = true
+					search(hopTokenOut, hopPath)
+					visited[pool.Id] = false
+				}
+			}
+		}
+	}
+	search(tokenIn, nil)
+
+	if routes == nil {
+		return nil, sdk.Coin{}, types.ErrNoSwapRoute.Wrapf("from %s to %s", tokenIn.Denom, tokenOutDenom)
+	}
+	return routes, tokenOut, nil
+}
+
+/*
+Finds the route of at most MaxSwapRoutes pools requiring the fewest tokens in
+for an exact amount of tokens out, over every pool. On a tie, the route through
+the fewest pools is kept.
+
+args:
+  - ctx: the cosmos-sdk context
+  - tokenInDenom: the denom to swap
+  - tokenOut: the amount of tokens to swap for
+
+ret:
+  - routes: the best route
+  - tokenIn: the amount of tokens the best route requires
+  - err: ErrNoSwapRoute if no route swaps tokenInDenom for tokenOut
+*/
+func (k Keeper) BestSwapRouteExactAmountOut(
+	ctx sdk.Context, tokenInDenom string, tokenOut sdk.Coin,
+) (routes []types.SwapRoute, tokenIn sdk.Coin, err error) {
+	pools := k.FetchAllPools(ctx)
+	visited := make(map[uint64]bool)
+
+	// searches from the last pool of the route back to the first
+	var search func(hopTokenOut sdk.Coin, path []types.SwapRoute)
+	search = func(hopTokenOut sdk.Coin, path []types.SwapRoute) {
+		for i := range pools {
+			pool := &pools[i]
+			if visited[pool.Id] || pool.PoolBalances().AmountOf(hopTokenOut.Denom).IsZero() {
+				continue
+			}
+			for _, asset := range pool.PoolAssets {
+				denom := asset.Token.Denom
+				if denom == hopTokenOut.Denom {
+					continue
+				}
+				hopTokenIn, err := pool.AsPoolI(ctx.BlockTime()).CalcInAmtGivenOut(hopTokenOut, denom)
+				if err != nil {
+					continue
+				}
+
+				hopPath := append([]types.SwapRoute{{PoolId: pool.Id, TokenOutDenom: hopTokenOut.Denom}}, path...)
+				if denom == tokenInDenom {
+					if routes == nil || hopTokenIn.Amount.LT(tokenIn.Amount) ||
+						(hopTokenIn.Amount.Equal(tokenIn.Amount) && len(hopPath) < len(routes)) {
+						routes, tokenIn = hopPath, hopTokenIn
+					}
+				} else if len(hopPath) < types.MaxSwapRoutes {
+					visited[pool.Id] = true
+					search(hopTokenIn, hopPath)
+					visited[pool.Id] = false
+				}
+			}
+		}
+	}
+	search(tokenOut, nil)
+
+	if routes == nil {
+		return nil, sdk.Coin{}, types.ErrNoSwapRoute.Wrapf("from %s to %s", tokenInDenom, tokenOut.Denom)
+	}
+	return routes, tokenIn, nil
+}
@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	simapp2 "github.com/NibiruChain/nibiru/simapp"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/dex/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+	"github.com/NibiruChain/nibiru/x/testutil/mock"
+)
+
+// setupSwapRoutePools creates a deep unibi:uusdc pool 1, a deep uusdc:unusd
+// pool 2 and a shallow unibi:unusd pool 3, so that swapping unibi for unusd
+// through pools 1 and 2 beats the direct pool 3.
+func setupSwapRoutePools(t *testing.T) (*simapp2.NibiruTestApp, sdk.Context) {
+	app, ctx := simapp2.NewTestNibiruAppAndContext(true)
+
+	for _, pool := range []types.Pool{
+		mock.DexPool(1, sdk.NewCoins(sdk.NewInt64Coin("unibi", 1000), sdk.NewInt64Coin("uusdc", 1000)), 100),
+		mock.DexPool(2, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1000), sdk.NewInt64Coin(common.DenomNUSD, 1000)), 100),
+		mock.DexPool(3, sdk.NewCoins(sdk.NewInt64Coin("unibi", 100), sdk.NewInt64Coin(common.DenomNUSD, 100)), 100),
+	} {
+		poolAddr := testutil.AccAddress()
+		pool.Address = poolAddr.String()
+		require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, poolAddr, pool.PoolBalances()))
+		app.DexKeeper.SetPool(ctx, pool)
+	}
+
+	return app, ctx
+}
+
+func TestMultihopSwapExactAmountIn(t *testing.T) {
+	routes := []types.SwapRoute{
+		{PoolId: 1, TokenOutDenom: "uusdc"},
+		{PoolId: 2, TokenOutDenom: common.DenomNUSD},
+	}
+
+	for _, tc := range []struct {
+		name              string
+		routes            []types.SwapRoute
+		tokenOutMinAmount sdk.Int
+
+		expectedErr            error
+		expectedTokenOut       sdk.Coin
+		expectedUserFinalFunds sdk.Coins
+	}{
+		{
+			name:              "two hops",
+			routes:            routes,
+			tokenOutMinAmount: sdk.NewInt(80),
+			// 100 unibi -> 90 uusdc -> 82 unusd
+			expectedTokenOut:       sdk.NewInt64Coin(common.DenomNUSD, 82),
+			expectedUserFinalFunds: sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 82)),
+		},
+		{
+			name:                   "tokens out below the minimum",
+			routes:                 routes,
+			tokenOutMinAmount:      sdk.NewInt(83),
+			expectedErr:            types.ErrTokenOutBelowMinAmount,
+			expectedUserFinalFunds: sdk.NewCoins(sdk.NewInt64Coin("unibi", 100)),
+		},
+		{
+			name:                   "route through a missing pool",
+			routes:                 []types.SwapRoute{{PoolId: 4, TokenOutDenom: common.DenomNUSD}},
+			tokenOutMinAmount:      sdk.OneInt(),
+			expectedErr:            types.ErrPoolNotFound,
+			expectedUserFinalFunds: sdk.NewCoins(sdk.NewInt64Coin("unibi", 100)),
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := setupSwapRoutePools(t)
+			sender := testutil.AccAddress()
+			require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, sender, sdk.NewCoins(sdk.NewInt64Coin("unibi", 100))))
+
+			if tc.expectedErr != nil {
+				failedTxCtx, _ := ctx.CacheContext()
+				_, err := app.DexKeeper.MultihopSwapExactAmountIn(
+					failedTxCtx, sender, tc.routes, sdk.NewInt64Coin("unibi", 100), tc.tokenOutMinAmount)
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				tokenOut, err := app.DexKeeper.MultihopSwapExactAmountIn(
+					ctx, sender, tc.routes, sdk.NewInt64Coin("unibi", 100), tc.tokenOutMinAmount)
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedTokenOut, tokenOut)
+			}
+
+			require.Equal(t, tc.expectedUserFinalFunds, app.BankKeeper.GetAllBalances(ctx, sender))
+		})
+	}
+}
+
+func TestMultihopSwapExactAmountOut(t *testing.T) {
+	routes := []types.SwapRoute{
+		{PoolId: 1, TokenOutDenom: "uusdc"},
+		{PoolId: 2, TokenOutDenom: common.DenomNUSD},
+	}
+
+	for _, tc := range []struct {
+		name             string
+		routes           []types.SwapRoute
+		tokenInMaxAmount sdk.Int
+
+		expectedErr            error
+		expectedTokenIn        sdk.Coin
+		expectedUserFinalFunds sdk.Coins
+	}{
+		{
+			name:             "two hops",
+			routes:           routes,
+			tokenInMaxAmount: sdk.NewInt(100),
+			// 99 unibi -> 90 uusdc -> 82 unusd, without any uusdc left over
+			expectedTokenIn: sdk.NewInt64Coin("unibi", 99),
+			expectedUserFinalFunds: sdk.NewCoins(
+				sdk.NewInt64Coin("unibi", 1),
+				sdk.NewInt64Coin(common.DenomNUSD, 82),
+			),
+		},
+		{
+			name:                   "tokens in above the maximum",
+			routes:                 routes,
+			tokenInMaxAmount:       sdk.NewInt(98),
+			expectedErr:            types.ErrTokenInAboveMaxAmount,
+			expectedUserFinalFunds: sdk.NewCoins(sdk.NewInt64Coin("unibi", 100)),
+		},
+		{
+			name:                   "route ends in another denom",
+			routes:                 routes[:1],
+			tokenInMaxAmount:       sdk.NewInt(100),
+			expectedErr:            types.ErrInvalidSwapRoutes,
+			expectedUserFinalFunds: sdk.NewCoins(sdk.NewInt64Coin("unibi", 100)),
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := setupSwapRoutePools(t)
+			sender := testutil.AccAddress()
+			require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, sender, sdk.NewCoins(sdk.NewInt64Coin("unibi", 100))))
+
+			tokenOut := sdk.NewInt64Coin(common.DenomNUSD, 82)
+			if tc.expectedErr != nil {
+				failedTxCtx, _ := ctx.CacheContext()
+				_, err := app.DexKeeper.MultihopSwapExactAmountOut(
+					failedTxCtx, sender, tc.routes, "unibi", tokenOut, tc.tokenInMaxAmount)
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				tokenIn, err := app.DexKeeper.MultihopSwapExactAmountOut(
+					ctx, sender, tc.routes, "unibi", tokenOut, tc.tokenInMaxAmount)
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedTokenIn, tokenIn)
+			}
+
+			require.Equal(t, tc.expectedUserFinalFunds, app.BankKeeper.GetAllBalances(ctx, sender))
+		})
+	}
+}
+
+func TestBestSwapRoute(t *testing.T) {
+	app, ctx := setupSwapRoutePools(t)
+	twoHops := []types.SwapRoute{
+		{PoolId: 1, TokenOutDenom: "uusdc"},
+		{PoolId: 2, TokenOutDenom: common.DenomNUSD},
+	}
+
+	t.Log("the deep pools beat the shallow direct pool")
+	routes, tokenOut, err := app.DexKeeper.BestSwapRouteExactAmountIn(
+		ctx, sdk.NewInt64Coin("unibi", 100), common.DenomNUSD)
+	require.NoError(t, err)
+	require.Equal(t, twoHops, routes)
+	require.Equal(t, sdk.NewInt64Coin(common.DenomNUSD, 82), tokenOut)
+
+	routes, tokenIn, err := app.DexKeeper.BestSwapRouteExactAmountOut(
+		ctx, "unibi", sdk.NewInt64Coin(common.DenomNUSD, 82))
+	require.NoError(t, err)
+	require.Equal(t, twoHops, routes)
+	require.Equal(t, sdk.NewInt64Coin("unibi", 99), tokenIn)
+
+	t.Log("a small swap is best through the direct pool")
+	routes, tokenOut, err = app.DexKeeper.BestSwapRouteExactAmountIn(
+		ctx, sdk.NewInt64Coin("unibi", 2), common.DenomNUSD)
+	require.NoError(t, err)
+	require.Equal(t, []types.SwapRoute{{PoolId: 3, TokenOutDenom: common.DenomNUSD}}, routes)
+	require.Equal(t, sdk.NewInt64Coin(common.DenomNUSD, 1), tokenOut)
+
+	t.Log("no pool holds the denom")
+	_, _, err = app.DexKeeper.BestSwapRouteExactAmountIn(ctx, sdk.NewInt64Coin("uatom", 100), common.DenomNUSD)
+	require.ErrorIs(t, err, types.ErrNoSwapRoute)
+}
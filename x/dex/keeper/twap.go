@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/dex/types"
+)
+
+/*
+Records the spot prices of every denom pair of a pool at the block time, and
+accumulates the previous spot prices for the time they lasted. Called after
+every change of the pool balances. Several changes in a block overwrite the
+record of the block, so the spot price recorded is the one at the end of the
+block so far.
+
+Records older than types.TwapRecordHistoryKeepPeriod are pruned, except the
+most recent one before that period, which the TWAPs starting in it need.
+
+args:
+  - ctx: the cosmos-sdk context
+  - pool: the pool after the change
+*/
+func (k Keeper) updateTwapRecords(ctx sdk.Context, pool types.Pool) (err error) {
+	now := ctx.BlockTime()
+	balances := pool.PoolBalances()
+
+	for _, base := range pool.PoolAssets {
+		for _, quote := range pool.PoolAssets {
+			baseDenom, quoteDenom := base.Token.Denom, quote.Token.Denom
+			if baseDenom == quoteDenom {
+				continue
+			}
+			// an emptied pool has no price, the last one keeps accumulating
+			if balances.AmountOf(baseDenom).IsZero() || balances.AmountOf(quoteDenom).IsZero() {
+				continue
+			}
+
+			spotPrice, err := pool.AsPoolI(now).CalcSpotPrice(quoteDenom, baseDenom)
+			if err != nil {
+				return err
+			}
+
+			pair := common.AssetPair{Token0: baseDenom, Token1: quoteDenom}
+			cumulativePrice := sdk.ZeroDec()
+			if last, found := k.twapRecordAtOrBefore(ctx, pool.Id, pair, now); found {
+				cumulativePrice = cumulativePriceAt(last, now)
+			}
+
+			k.TwapRecords.Insert(ctx, collections.Join(collections.Join(pool.Id, pair), now), types.TwapRecord{
+				PoolId:          pool.Id,
+				BaseDenom:       baseDenom,
+				QuoteDenom:      quoteDenom,
+				Time:            now,
+				SpotPrice:       spotPrice,
+				CumulativePrice: cumulativePrice,
+			})
+			k.pruneTwapRecords(ctx, pool.Id, pair, now.Add(-types.TwapRecordHistoryKeepPeriod))
+		}
+	}
+	return nil
+}
+
+// pruneTwapRecords deletes the records of a denom pair of a pool older than
+// cutoff, but the most recent one of them.
+func (k Keeper) pruneTwapRecords(ctx sdk.Context, poolId uint64, pair common.AssetPair, cutoff time.Time) {
+	iter := k.TwapRecords.Iterate(
+		ctx,
+		collections.PairRange[collections.Pair[uint64, common.AssetPair], time.Time]{}.
+			Prefix(collections.Join(poolId, pair)).
+			EndExclusive(cutoff).
+			Descending(),
+	)
+	keys := iter.Keys()
+	if len(keys) <= 1 {
+		return
+	}
+	for _, key := range keys[1:] {
+		if err := k.TwapRecords.Delete(ctx, key); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// twapRecordAtOrBefore returns the most recent record of a denom pair of a
+// pool at or before t.
+func (k Keeper) twapRecordAtOrBefore(
+	ctx sdk.Context, poolId uint64, pair common.AssetPair, t time.Time,
+) (record types.TwapRecord, found bool) {
+	iter := k.TwapRecords.Iterate(
+		ctx,
+		collections.PairRange[collections.Pair[uint64, common.AssetPair], time.Time]{}.
+			Prefix(collections.Join(poolId, pair)).
+			EndInclusive(t).
+			Descending(),
+	)
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return types.TwapRecord{}, false
+	}
+	return iter.Value(), true
+}
+
+// cumulativePriceAt extrapolates the cumulative price of a record to a later
+// time, during which its spot price lasted.
+func cumulativePriceAt(record types.TwapRecord, t time.Time) sdk.Dec {
+	elapsedMs := t.Sub(record.Time).Milliseconds()
+	return record.CumulativePrice.Add(record.SpotPrice.MulInt64(elapsedMs))
+}
+
+/*
+Returns the arithmetic time weighted average price of baseDenom in quoteDenom
+in a pool between startTime and endTime: the average of the spot prices,
+weighted by how long they lasted.
+
+args:
+  - ctx: the cosmos-sdk context
+  - poolId: the pool's numeric id
+  - baseDenom: the denom priced
+  - quoteDenom: the denom the price is in
+  - startTime: the start of the interval, after the creation of the pool and
+    within the records kept
+  - endTime: the end of the interval, at the latest the block time
+
+ret:
+  - twap: the amount of quoteDenom for one baseDenom on average
+  - err: error if any
+*/
+func (k Keeper) ArithmeticTwap(
+	ctx sdk.Context,
+	poolId uint64,
+	baseDenom string,
+	quoteDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (twap sdk.Dec, err error) {
+	if !startTime.Before(endTime) {
+		return sdk.Dec{}, types.ErrInvalidTwapInterval.Wrapf(
+			"start time %s must be before end time %s", startTime, endTime)
+	}
+	if endTime.After(ctx.BlockTime()) {
+		return sdk.Dec{}, types.ErrInvalidTwapInterval.Wrapf(
+			"end time %s must not be after the block time %s", endTime, ctx.BlockTime())
+	}
+	if _, err = k.FetchPool(ctx, poolId); err != nil {
+		return sdk.Dec{}, err
+	}
+
+	pair := common.AssetPair{Token0: baseDenom, Token1: quoteDenom}
+	startRecord, found := k.twapRecordAtOrBefore(ctx, poolId, pair, startTime)
+	if !found {
+		return sdk.Dec{}, types.ErrNoTwapRecord.Wrapf(
+			"pool %d has no %s record at or before %s", poolId, pair, startTime)
+	}
+	endRecord, _ := k.twapRecordAtOrBefore(ctx, poolId, pair, endTime)
+
+	cumulativePrice := cumulativePriceAt(endRecord, endTime).Sub(cumulativePriceAt(startRecord, startTime))
+	return cumulativePrice.QuoInt64(endTime.Sub(startTime).Milliseconds()), nil
+}
@@ -0,0 +1,244 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/collections"
+	simapp2 "github.com/NibiruChain/nibiru/simapp"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/dex/keeper"
+	"github.com/NibiruChain/nibiru/x/dex/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+	"github.com/NibiruChain/nibiru/x/testutil/mock"
+)
+
+/*
+Sets up a pool whose price of unibi in unusd is 0.25 from startTime, and 1 from
+startTime + 10s, with the block time at startTime + 40s.
+*/
+func setupTwapPool(t *testing.T) (app *simapp2.NibiruTestApp, ctx sdk.Context, startTime time.Time) {
+	app, ctx = simapp2.NewTestNibiruAppAndContext(true)
+	startTime = time.Unix(1_000_000, 0).UTC()
+
+	pool := mock.DexPool(
+		/*poolId=*/ 1,
+		/*assets=*/ sdk.NewCoins(
+			sdk.NewInt64Coin("unibi", 100),
+			sdk.NewInt64Coin(common.DenomNUSD, 100),
+		),
+		/*shares=*/ 100,
+	)
+	poolAddr := testutil.AccAddress()
+	pool.Address = poolAddr.String()
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, poolAddr, pool.PoolBalances()))
+	app.DexKeeper.SetPool(ctx, pool)
+
+	sender := testutil.AccAddress()
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, sender, sdk.NewCoins(
+		sdk.NewInt64Coin("unibi", 100),
+		sdk.NewInt64Coin(common.DenomNUSD, 50),
+	)))
+
+	// 200unibi:50unusd
+	ctx = ctx.WithBlockTime(startTime)
+	_, err := app.DexKeeper.SwapExactAmountIn(ctx, sender, 1, sdk.NewInt64Coin("unibi", 100), common.DenomNUSD)
+	require.NoError(t, err)
+
+	// 100unibi:100unusd
+	ctx = ctx.WithBlockTime(startTime.Add(10 * time.Second))
+	_, err = app.DexKeeper.SwapExactAmountIn(ctx, sender, 1, sdk.NewInt64Coin(common.DenomNUSD, 50), "unibi")
+	require.NoError(t, err)
+
+	return app, ctx.WithBlockTime(startTime.Add(40 * time.Second)), startTime
+}
+
+func TestArithmeticTwap(t *testing.T) {
+	app, ctx, startTime := setupTwapPool(t)
+
+	tests := []struct {
+		name       string
+		baseDenom  string
+		quoteDenom string
+		startTime  time.Time
+		endTime    time.Time
+
+		expectedTwap  sdk.Dec
+		expectedError error
+	}{
+		{
+			name:         "whole history up to the block time",
+			baseDenom:    "unibi",
+			quoteDenom:   common.DenomNUSD,
+			startTime:    startTime,
+			endTime:      startTime.Add(40 * time.Second),
+			expectedTwap: sdk.MustNewDecFromStr("0.8125"),
+		},
+		{
+			name:         "inverse pair",
+			baseDenom:    common.DenomNUSD,
+			quoteDenom:   "unibi",
+			startTime:    startTime,
+			endTime:      startTime.Add(40 * time.Second),
+			expectedTwap: sdk.MustNewDecFromStr("1.75"),
+		},
+		{
+			name:         "interval between records",
+			baseDenom:    "unibi",
+			quoteDenom:   common.DenomNUSD,
+			startTime:    startTime.Add(5 * time.Second),
+			endTime:      startTime.Add(20 * time.Second),
+			expectedTwap: sdk.MustNewDecFromStr("0.75"),
+		},
+		{
+			name:         "interval within a single record",
+			baseDenom:    "unibi",
+			quoteDenom:   common.DenomNUSD,
+			startTime:    startTime.Add(2 * time.Second),
+			endTime:      startTime.Add(8 * time.Second),
+			expectedTwap: sdk.MustNewDecFromStr("0.25"),
+		},
+		{
+			name:          "start before the first record",
+			baseDenom:     "unibi",
+			quoteDenom:    common.DenomNUSD,
+			startTime:     startTime.Add(-time.Second),
+			endTime:       startTime.Add(40 * time.Second),
+			expectedError: types.ErrNoTwapRecord,
+		},
+		{
+			name:          "start not before end",
+			baseDenom:     "unibi",
+			quoteDenom:    common.DenomNUSD,
+			startTime:     startTime.Add(20 * time.Second),
+			endTime:       startTime.Add(20 * time.Second),
+			expectedError: types.ErrInvalidTwapInterval,
+		},
+		{
+			name:          "end after the block time",
+			baseDenom:     "unibi",
+			quoteDenom:    common.DenomNUSD,
+			startTime:     startTime,
+			endTime:       startTime.Add(41 * time.Second),
+			expectedError: types.ErrInvalidTwapInterval,
+		},
+		{
+			name:          "denom not in pool",
+			baseDenom:     "unibi",
+			quoteDenom:    "uusdc",
+			startTime:     startTime,
+			endTime:       startTime.Add(40 * time.Second),
+			expectedError: types.ErrNoTwapRecord,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			twap, err := app.DexKeeper.ArithmeticTwap(ctx, 1, tc.baseDenom, tc.quoteDenom, tc.startTime, tc.endTime)
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTwap, twap)
+		})
+	}
+}
+
+func TestArithmeticTwapPoolNotFound(t *testing.T) {
+	app, ctx, startTime := setupTwapPool(t)
+
+	_, err := app.DexKeeper.ArithmeticTwap(ctx, 2, "unibi", common.DenomNUSD, startTime, ctx.BlockTime())
+	require.Error(t, err)
+}
+
+func TestPruneTwapRecords(t *testing.T) {
+	app, ctx, startTime := setupTwapPool(t)
+	pair := common.AssetPair{Token0: "unibi", Token1: common.DenomNUSD}
+
+	sender := testutil.AccAddress()
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, sender, sdk.NewCoins(
+		sdk.NewInt64Coin("unibi", 100),
+	)))
+
+	// the cutoff falls after both records, only the most recent one is kept
+	ctx = ctx.WithBlockTime(startTime.Add(types.TwapRecordHistoryKeepPeriod + time.Hour))
+	_, err := app.DexKeeper.SwapExactAmountIn(ctx, sender, 1, sdk.NewInt64Coin("unibi", 100), common.DenomNUSD)
+	require.NoError(t, err)
+
+	_, err = app.DexKeeper.TwapRecords.Get(ctx, collections.Join(collections.Join(uint64(1), pair), startTime))
+	require.Error(t, err)
+	_, err = app.DexKeeper.TwapRecords.Get(ctx, collections.Join(collections.Join(uint64(1), pair), startTime.Add(10*time.Second)))
+	require.NoError(t, err)
+
+	// the price stayed at 1 between the kept record and the last swap
+	twap, err := app.DexKeeper.ArithmeticTwap(ctx, 1, "unibi", common.DenomNUSD,
+		startTime.Add(time.Hour), ctx.BlockTime())
+	require.NoError(t, err)
+	require.Equal(t, sdk.OneDec(), twap)
+}
+
+func TestQueryArithmeticTwap(t *testing.T) {
+	app, ctx, startTime := setupTwapPool(t)
+	queryServer := keeper.NewQuerier(app.DexKeeper)
+
+	// no end time defaults to the block time
+	resp, err := queryServer.ArithmeticTwap(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryArithmeticTwapRequest{
+			PoolId:     1,
+			BaseDenom:  "unibi",
+			QuoteDenom: common.DenomNUSD,
+			StartTime:  startTime,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.8125"), resp.ArithmeticTwap)
+
+	resp, err = queryServer.ArithmeticTwap(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryArithmeticTwapRequest{
+			PoolId:     1,
+			BaseDenom:  "unibi",
+			QuoteDenom: common.DenomNUSD,
+			StartTime:  startTime.Add(5 * time.Second),
+			EndTime:    startTime.Add(20 * time.Second),
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.75"), resp.ArithmeticTwap)
+}
+
+func TestMigrate2to3SeedsTwapRecords(t *testing.T) {
+	app, ctx := simapp2.NewTestNibiruAppAndContext(true)
+	startTime := time.Unix(1_000_000, 0).UTC()
+	ctx = ctx.WithBlockTime(startTime)
+
+	t.Log("a pool created before the upgrade has no twap record")
+	pool := mock.DexPool(
+		/*poolId=*/ 1,
+		/*assets=*/ sdk.NewCoins(
+			sdk.NewInt64Coin("unibi", 200),
+			sdk.NewInt64Coin(common.DenomNUSD, 50),
+		),
+		/*shares=*/ 100,
+	)
+	app.DexKeeper.SetPool(ctx, pool)
+	_, err := app.DexKeeper.ArithmeticTwap(ctx.WithBlockTime(startTime.Add(time.Minute)), 1, "unibi", common.DenomNUSD, startTime, startTime.Add(time.Minute))
+	require.ErrorIs(t, err, types.ErrNoTwapRecord)
+
+	t.Log("the migration records its spot prices at the upgrade time")
+	require.NoError(t, keeper.NewMigrator(app.DexKeeper).Migrate2to3(ctx))
+	ctx = ctx.WithBlockTime(startTime.Add(time.Minute))
+	twap, err := app.DexKeeper.ArithmeticTwap(ctx, 1, "unibi", common.DenomNUSD, startTime, startTime.Add(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.25"), twap)
+	twap, err = app.DexKeeper.ArithmeticTwap(ctx, 1, common.DenomNUSD, "unibi", startTime, startTime.Add(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(4), twap)
+}
@@ -37,17 +37,36 @@ func TestSolveConstantProductInvariantHappyPath(t *testing.T) {
 			yWeight:        sdk.NewDecWithPrec(5, 1),
 			expectedDeltaY: sdk.NewDecWithPrec(1122, 2),
 		},
-		// TODO(https://github.com/NibiruChain/nibiru/issues/141): allow for uneven weights
-		// {
-		// 	// 44*(1-(86/35)^(.75/.25))
-		// 	name:           "difficult numbers - uneven weights",
-		// 	xPrior:         sdk.NewDec(86),
-		// 	xAfter:         sdk.NewDec(35),
-		// 	xWeight:        sdk.NewDecWithPrec(75, 2),
-		// 	yPrior:         sdk.NewDec(44),
-		// 	yWeight:        sdk.NewDecWithPrec(25, 2),
-		// 	expectedDeltaY: sdk.NewDecWithPrec(-60874551603, 8),
-		// },
+		{
+			// 44*(1-(86/35)^(.75/.25))
+			name:           "difficult numbers - uneven weights",
+			xPrior:         sdk.NewDec(86),
+			xAfter:         sdk.NewDec(35),
+			xWeight:        sdk.NewDecWithPrec(75, 2),
+			yPrior:         sdk.NewDec(44),
+			yWeight:        sdk.NewDecWithPrec(25, 2),
+			expectedDeltaY: sdk.NewDecWithPrec(-60874551603, 8),
+		},
+		{
+			// 1000*(1-(1000/1100)^(.8/.2))
+			name:           "80/20 pool",
+			xPrior:         sdk.NewDec(1000),
+			xAfter:         sdk.NewDec(1100),
+			xWeight:        sdk.NewDecWithPrec(8, 1),
+			yPrior:         sdk.NewDec(1000),
+			yWeight:        sdk.NewDecWithPrec(2, 1),
+			expectedDeltaY: sdk.MustNewDecFromStr("316.986544634929"),
+		},
+		{
+			// 1000*(1-(1000/1100)^(.2/.8))
+			name:           "20/80 pool",
+			xPrior:         sdk.NewDec(1000),
+			xAfter:         sdk.NewDec(1100),
+			xWeight:        sdk.NewDecWithPrec(2, 1),
+			yPrior:         sdk.NewDec(1000),
+			yWeight:        sdk.NewDecWithPrec(8, 1),
+			expectedDeltaY: sdk.MustNewDecFromStr("23.545910323689"),
+		},
 	} {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
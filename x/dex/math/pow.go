@@ -0,0 +1,86 @@
+package math
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// powPrecision is where the binomial series of a fractional power stops:
+	// the first term smaller than it is dropped.
+	powPrecision = sdk.NewDecWithPrec(1, 15)
+
+	// The binomial series is only evaluated for bases in [powMinBase, powMaxBase],
+	// where each term is at most half of the previous one. Other bases are
+	// brought into the range with square roots and reciprocals.
+	powMinBase = sdk.NewDecWithPrec(5, 1)
+	powMaxBase = sdk.NewDecWithPrec(15, 1)
+)
+
+/*
+Pow computes base^exp with fixed-point arithmetic only, so that every node gets
+the same result.
+
+The integer part of the exponent is computed exactly by repeated squaring and
+the fractional part with the binomial series
+(1 + x)^a = 1 + ax + a(a-1)x^2/2! + ...,
+which is kept within |x| <= 1/2 by range reduction. The series then needs at
+most ~50 terms, and the relative error of the result stays below 1e-12 for
+results above 1e-6 (sdk.Dec only has 18 decimals below that).
+
+args:
+  - base: must be positive
+  - exp: must not be negative
+
+ret:
+  - base raised to exp
+*/
+func Pow(base, exp sdk.Dec) sdk.Dec {
+	if !base.IsPositive() {
+		panic(fmt.Errorf("base must be positive, got %s", base))
+	}
+	if exp.IsNegative() {
+		panic(fmt.Errorf("exponent must not be negative, got %s", exp))
+	}
+
+	integer := exp.TruncateInt()
+	fractional := exp.Sub(integer.ToDec())
+
+	result := base.Power(integer.Uint64())
+	if fractional.IsZero() {
+		return result
+	}
+	return result.Mul(powFractional(base, fractional))
+}
+
+// powFractional computes base^exp for 0 < exp < 1.
+func powFractional(base, exp sdk.Dec) sdk.Dec {
+	switch {
+	case base.GT(powMaxBase):
+		// base^exp = 1 / (1/base)^exp
+		return sdk.OneDec().Quo(powFractional(sdk.OneDec().Quo(base), exp))
+	case base.LT(powMinBase):
+		// base^exp = sqrt(base)^(2*exp), and sqrt(base) is closer to 1
+		root, err := base.ApproxSqrt()
+		if err != nil {
+			panic(err)
+		}
+		return Pow(root, exp.MulInt64(2))
+	default:
+		return powSeries(base, exp)
+	}
+}
+
+// powSeries sums the binomial series of base^exp, for base in
+// [powMinBase, powMaxBase] and 0 < exp < 1.
+func powSeries(base, exp sdk.Dec) sdk.Dec {
+	x := base.Sub(sdk.OneDec())
+	term, sum := sdk.OneDec(), sdk.OneDec()
+	for k := int64(1); term.Abs().GTE(powPrecision); k++ {
+		// term_k = term_{k-1} * (exp - k + 1) * x / k
+		term = term.Mul(exp.Sub(sdk.NewDec(k - 1))).Mul(x).QuoInt64(k)
+		sum = sum.Add(term)
+	}
+	return sum
+}
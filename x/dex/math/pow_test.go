@@ -0,0 +1,121 @@
+package math
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPow(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		base     sdk.Dec
+		exp      sdk.Dec
+		expected sdk.Dec
+	}{
+		{
+			name:     "zero exponent",
+			base:     sdk.NewDec(7),
+			exp:      sdk.ZeroDec(),
+			expected: sdk.OneDec(),
+		},
+		{
+			name:     "integer exponent is exact",
+			base:     sdk.MustNewDecFromStr("1.5"),
+			exp:      sdk.NewDec(3),
+			expected: sdk.MustNewDecFromStr("3.375"),
+		},
+		{
+			name:     "square root",
+			base:     sdk.NewDec(4),
+			exp:      sdk.MustNewDecFromStr("0.5"),
+			expected: sdk.NewDec(2),
+		},
+		{
+			name:     "small base",
+			base:     sdk.MustNewDecFromStr("0.0001"),
+			exp:      sdk.MustNewDecFromStr("0.25"),
+			expected: sdk.MustNewDecFromStr("0.1"),
+		},
+		{
+			name:     "base one",
+			base:     sdk.OneDec(),
+			exp:      sdk.MustNewDecFromStr("4.2"),
+			expected: sdk.OneDec(),
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got := Pow(tc.base, tc.exp)
+			require.True(t, got.Sub(tc.expected).Abs().LTE(sdk.NewDecWithPrec(1, 15)),
+				"expected %s, got %s", tc.expected, got)
+		})
+	}
+}
+
+func TestPowPanics(t *testing.T) {
+	require.Panics(t, func() { Pow(sdk.ZeroDec(), sdk.OneDec()) })
+	require.Panics(t, func() { Pow(sdk.NewDec(-2), sdk.OneDec()) })
+	require.Panics(t, func() { Pow(sdk.NewDec(2), sdk.NewDec(-1)) })
+}
+
+// TestPowAgainstReference compares Pow with a 256 bit computation of
+// base^(p/q) over random bases and weight ratios.
+func TestPowAgainstReference(t *testing.T) {
+	r := rand.New(rand.NewSource(141))
+	for i := 0; i < 500; i++ {
+		// bases from 1e-4 to 1e4, with 18 decimals
+		base := sdk.NewDecFromBigIntWithPrec(big.NewInt(r.Int63n(1e18-1e14)+1e14), 18).
+			Mul(sdk.NewDec(10).Power(uint64(r.Intn(5))))
+		p, q := r.Int63n(99)+1, r.Int63n(99)+1
+		exp := sdk.NewDec(p).QuoInt64(q)
+		if exp.GT(sdk.NewDec(4)) {
+			// keeps the results within what an sdk.Dec can hold
+			p, q = q, p
+			exp = sdk.NewDec(p).QuoInt64(q)
+		}
+
+		got, _ := new(big.Float).SetString(Pow(base, exp).String())
+		want := referencePow(base, p, q)
+
+		diff := new(big.Float).Sub(got, want)
+		diff.Abs(diff)
+		if want.Cmp(big.NewFloat(1e-6)) > 0 {
+			diff.Quo(diff, want)
+		}
+		relErr, _ := diff.Float64()
+		require.Less(t, relErr, 1e-12, "%s^(%d/%d): got %s, want %s", base, p, q, got.Text('g', 30), want.Text('g', 30))
+	}
+}
+
+// referencePow computes base^(p/q) as the q-th root of base^p, using Newton's
+// method on 256 bit floats.
+func referencePow(base sdk.Dec, p, q int64) *big.Float {
+	const prec = 256
+	x, _ := new(big.Float).SetPrec(prec).SetString(base.String())
+
+	y := new(big.Float).SetPrec(prec).SetInt64(1)
+	for i := int64(0); i < p; i++ {
+		y.Mul(y, x)
+	}
+
+	bigQ := new(big.Float).SetPrec(prec).SetInt64(q)
+	bigQMinusOne := new(big.Float).SetPrec(prec).SetInt64(q - 1)
+	xFloat, _ := x.Float64()
+	root := new(big.Float).SetPrec(prec).SetFloat64(math.Pow(xFloat, float64(p)/float64(q)))
+	for i := 0; i < 100; i++ {
+		// root = ((q-1) * root + y / root^(q-1)) / q
+		rootPow := new(big.Float).SetPrec(prec).SetInt64(1)
+		for j := int64(0); j < q-1; j++ {
+			rootPow.Mul(rootPow, root)
+		}
+		next := new(big.Float).SetPrec(prec).Mul(bigQMinusOne, root)
+		next.Add(next, new(big.Float).SetPrec(prec).Quo(y, rootPow))
+		root = next.Quo(next, bigQ)
+	}
+	return root
+}
@@ -0,0 +1,150 @@
+package math
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// stableSwapPrecision is where the Newton iterations of the StableSwap
+	// invariant stop, in units of the pool balances.
+	stableSwapPrecision = sdk.NewDecWithPrec(1, 9)
+
+	// maxStableSwapIterations bounds the Newton iterations, as in Curve.
+	maxStableSwapIterations = 255
+)
+
+/*
+SolveStableSwapInvariant computes the invariant D of Curve's StableSwap curve
+for the balances, which solves
+
+	A * n^n * sum(x_i) + D = A * n^n * D + D^(n+1) / (n^n * prod(x_i))
+
+with Newton's method, from D = sum(x_i).
+
+args:
+  - balances: the positive pool balances
+  - amplification: the amplification A of the pool
+
+ret:
+  - invariant: D
+  - err: error if the iterations didn't converge
+*/
+func SolveStableSwapInvariant(balances []sdk.Dec, amplification sdk.Dec) (invariant sdk.Dec, err error) {
+	n := int64(len(balances))
+	sum := sdk.ZeroDec()
+	for _, balance := range balances {
+		sum = sum.Add(balance)
+	}
+	if sum.IsZero() {
+		return sdk.ZeroDec(), nil
+	}
+
+	ann := amplification.Mul(sdk.NewDec(n).Power(uint64(n)))
+	invariant = sum
+	for i := 0; i < maxStableSwapIterations; i++ {
+		// dP = D^(n+1) / (n^n * prod(x_i))
+		dP := invariant
+		for _, balance := range balances {
+			dP = dP.Mul(invariant).Quo(balance.MulInt64(n))
+		}
+
+		prev := invariant
+		// D = (Ann * S + n * dP) * D / ((Ann - 1) * D + (n + 1) * dP)
+		numerator := ann.Mul(sum).Add(dP.MulInt64(n)).Mul(invariant)
+		denominator := ann.Sub(sdk.OneDec()).Mul(invariant).Add(dP.MulInt64(n + 1))
+		invariant = numerator.Quo(denominator)
+
+		if invariant.Sub(prev).Abs().LTE(stableSwapPrecision) {
+			return invariant, nil
+		}
+	}
+	return sdk.Dec{}, errors.New("the stableswap invariant did not converge")
+}
+
+/*
+SolveStableSwapBalance computes the balance of the asset at index that keeps
+the StableSwap invariant at the given value, the other balances being fixed.
+It solves y^2 + (b - D) * y = c with Newton's method, where
+
+	b = S' + D / (A * n^n), c = D^(n+1) / (n^n * P' * A * n^n)
+
+and S', P' are the sum and the product of the other balances.
+
+args:
+  - balances: the pool balances; the one at index is ignored
+  - index: the index of the balance to solve for
+  - invariant: the StableSwap invariant D to keep
+  - amplification: the amplification A of the pool
+
+ret:
+  - balance: the balance at index
+  - err: error if the iterations didn't converge
+*/
+func SolveStableSwapBalance(
+	balances []sdk.Dec, index int, invariant sdk.Dec, amplification sdk.Dec,
+) (balance sdk.Dec, err error) {
+	n := int64(len(balances))
+	ann := amplification.Mul(sdk.NewDec(n).Power(uint64(n)))
+
+	c := invariant
+	sum := sdk.ZeroDec()
+	for i, balance := range balances {
+		if i == index {
+			continue
+		}
+		sum = sum.Add(balance)
+		c = c.Mul(invariant).Quo(balance.MulInt64(n))
+	}
+	c = c.Mul(invariant).Quo(ann.MulInt64(n))
+	b := sum.Add(invariant.Quo(ann))
+
+	balance = invariant
+	for i := 0; i < maxStableSwapIterations; i++ {
+		prev := balance
+		// y = (y^2 + c) / (2y + b - D)
+		balance = balance.Mul(balance).Add(c).Quo(balance.MulInt64(2).Add(b).Sub(invariant))
+
+		if balance.Sub(prev).Abs().LTE(stableSwapPrecision) {
+			return balance, nil
+		}
+	}
+	return sdk.Dec{}, errors.New("the stableswap balance did not converge")
+}
+
+/*
+StableSwapSpotPrice computes the marginal price of the asset at indexOut in
+units of the asset at indexIn, along the StableSwap invariant:
+
+	price = (A * n^n + dP / x_out) / (A * n^n + dP / x_in)
+
+with dP = D^(n+1) / (n^n * prod(x_i)).
+
+args:
+  - balances: the pool balances
+  - indexIn: the index of the asset paid
+  - indexOut: the index of the asset bought
+  - amplification: the amplification A of the pool
+
+ret:
+  - price: the amount of the asset at indexIn per asset at indexOut
+  - err: error if the invariant didn't converge
+*/
+func StableSwapSpotPrice(
+	balances []sdk.Dec, indexIn int, indexOut int, amplification sdk.Dec,
+) (price sdk.Dec, err error) {
+	invariant, err := SolveStableSwapInvariant(balances, amplification)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	n := int64(len(balances))
+	ann := amplification.Mul(sdk.NewDec(n).Power(uint64(n)))
+	dP := invariant
+	for _, balance := range balances {
+		dP = dP.Mul(invariant).Quo(balance.MulInt64(n))
+	}
+
+	return ann.Add(dP.Quo(balances[indexOut])).Quo(ann.Add(dP.Quo(balances[indexIn]))), nil
+}
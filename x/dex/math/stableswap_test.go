@@ -0,0 +1,88 @@
+package math
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSolveStableSwapInvariant(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		balances      []sdk.Dec
+		amplification sdk.Dec
+		expected      sdk.Dec
+	}{
+		{
+			name:          "balanced pool is the sum of the balances",
+			balances:      []sdk.Dec{sdk.NewDec(1_000), sdk.NewDec(1_000)},
+			amplification: sdk.NewDec(100),
+			expected:      sdk.NewDec(2_000),
+		},
+		{
+			name:          "empty pool",
+			balances:      []sdk.Dec{sdk.ZeroDec(), sdk.ZeroDec()},
+			amplification: sdk.NewDec(100),
+			expected:      sdk.ZeroDec(),
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			invariant, err := SolveStableSwapInvariant(tc.balances, tc.amplification)
+			require.NoError(t, err)
+			require.True(t, invariant.Sub(tc.expected).Abs().LTE(stableSwapPrecision),
+				"expected %s, got %s", tc.expected, invariant)
+		})
+	}
+}
+
+func TestSolveStableSwapInvariantBounds(t *testing.T) {
+	// for an imbalanced pool, the invariant is between the constant product
+	// invariant n * sqrt(x * y) and the constant sum invariant x + y, and gets
+	// closer to the sum as the amplification grows
+	balances := []sdk.Dec{sdk.NewDec(1_000), sdk.NewDec(4_000)}
+	sum := sdk.NewDec(5_000)
+	product := sdk.NewDec(4_000)
+
+	low, err := SolveStableSwapInvariant(balances, sdk.NewDec(1))
+	require.NoError(t, err)
+	high, err := SolveStableSwapInvariant(balances, sdk.NewDec(1_000))
+	require.NoError(t, err)
+
+	require.True(t, low.GT(product))
+	require.True(t, high.GT(low))
+	require.True(t, sum.GT(high))
+}
+
+func TestSolveStableSwapBalance(t *testing.T) {
+	balances := []sdk.Dec{sdk.NewDec(1_200), sdk.NewDec(3_400)}
+	amplification := sdk.NewDec(85)
+	invariant, err := SolveStableSwapInvariant(balances, amplification)
+	require.NoError(t, err)
+
+	for index := range balances {
+		balance, err := SolveStableSwapBalance(balances, index, invariant, amplification)
+		require.NoError(t, err)
+		require.True(t, balance.Sub(balances[index]).Abs().LTE(sdk.NewDecWithPrec(1, 6)),
+			"expected %s, got %s", balances[index], balance)
+	}
+}
+
+func TestStableSwapSpotPrice(t *testing.T) {
+	amplification := sdk.NewDec(100)
+
+	price, err := StableSwapSpotPrice([]sdk.Dec{sdk.NewDec(500), sdk.NewDec(500)}, 0, 1, amplification)
+	require.NoError(t, err)
+	require.True(t, price.Sub(sdk.OneDec()).Abs().LTE(sdk.NewDecWithPrec(1, 12)), "got %s", price)
+
+	// the scarcer asset is worth more, but much less than in a balancer pool
+	price, err = StableSwapSpotPrice([]sdk.Dec{sdk.NewDec(1_000), sdk.NewDec(500)}, 0, 1, amplification)
+	require.NoError(t, err)
+	require.True(t, price.GT(sdk.OneDec()))
+	require.True(t, price.LT(sdk.MustNewDecFromStr("1.02")), "got %s", price)
+
+	inverse, err := StableSwapSpotPrice([]sdk.Dec{sdk.NewDec(1_000), sdk.NewDec(500)}, 1, 0, amplification)
+	require.NoError(t, err)
+	require.True(t, price.Mul(inverse).Sub(sdk.OneDec()).Abs().LTE(sdk.NewDecWithPrec(1, 12)))
+}
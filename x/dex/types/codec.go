@@ -5,6 +5,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
@@ -17,8 +18,14 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		/* implementations */
 		&MsgCreatePool{},
 		&MsgJoinPool{},
+		&MsgSwapExactAmountIn{},
+		&MsgSwapExactAmountOut{},
+		&MsgJoinPoolExactShares{},
+		&MsgExitPoolExactTokens{},
 	)
 
+	registry.RegisterImplementations((*govtypes.Content)(nil), &RampAmplificationProposal{})
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -18,6 +20,23 @@ const (
 	//
 	// This is done so that smooth weight changes have enough precision to actually be smooth.
 	GuaranteedWeightPrecision int64 = 1 << 30
+
+	// bounds of the amplification of a StableSwap pool
+	MinAmplification uint64 = 1
+	MaxAmplification uint64 = 1_000_000
+
+	// An amplification ramp can change the amplification by at most this factor,
+	// over at least MinAmplificationRampDuration, so that the price can't be
+	// moved abruptly against the liquidity providers.
+	MaxAmplificationChange       uint64 = 10
+	MinAmplificationRampDuration        = 24 * time.Hour
+
+	// maximum number of pools a swap can go through
+	MaxSwapRoutes = 3
+
+	// TWAP records older than this are pruned, except the most recent one
+	// before it, so TWAPs can be computed over this period at least.
+	TwapRecordHistoryKeepPeriod = 48 * time.Hour
 )
 
 var (
@@ -28,4 +28,21 @@ var (
 	ErrPoolNotFound       = sdkerrors.Register(ModuleName, 12, "pool not found")
 	ErrTokenDenomNotFound = sdkerrors.Register(ModuleName, 13, "token denom not found in pool")
 	ErrSameTokenDenom     = sdkerrors.Register(ModuleName, 14, "cannot use same token denom to swap in and out")
+
+	// StableSwap pools
+	ErrInvalidPoolType      = sdkerrors.Register(ModuleName, 15, "invalid pool type")
+	ErrInvalidAmplification = sdkerrors.Register(ModuleName, 16, "invalid stableswap amplification")
+
+	// Errors when swapping along routes
+	ErrInvalidSwapRoutes      = sdkerrors.Register(ModuleName, 17, "invalid swap routes")
+	ErrTokenOutBelowMinAmount = sdkerrors.Register(ModuleName, 18, "token out amount lower than the minimum")
+	ErrTokenInAboveMaxAmount  = sdkerrors.Register(ModuleName, 19, "token in amount higher than the maximum")
+	ErrNoSwapRoute            = sdkerrors.Register(ModuleName, 20, "no swap route found")
+
+	// Errors when joining or exiting for exact amounts
+	ErrPoolSharesInAboveMaxAmount = sdkerrors.Register(ModuleName, 21, "pool shares in amount higher than the maximum")
+
+	// Errors when computing TWAPs
+	ErrInvalidTwapInterval = sdkerrors.Register(ModuleName, 22, "invalid twap interval")
+	ErrNoTwapRecord        = sdkerrors.Register(ModuleName, 23, "no twap record at the start of the interval")
 )
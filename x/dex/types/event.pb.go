@@ -8,15 +8,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -288,44 +292,120 @@ func (m *EventAssetsSwapped) GetTokenOut() types.Coin {
 	return types.Coin{}
 }
 
+type EventAmplificationRamped struct {
+	PoolId               uint64    `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	InitialAmplification uint64    `protobuf:"varint,2,opt,name=initial_amplification,json=initialAmplification,proto3" json:"initial_amplification,omitempty"`
+	FutureAmplification  uint64    `protobuf:"varint,3,opt,name=future_amplification,json=futureAmplification,proto3" json:"future_amplification,omitempty"`
+	FutureTime           time.Time `protobuf:"bytes,4,opt,name=future_time,json=futureTime,proto3,stdtime" json:"future_time"`
+}
+
+func (m *EventAmplificationRamped) Reset()         { *m = EventAmplificationRamped{} }
+func (m *EventAmplificationRamped) String() string { return proto.CompactTextString(m) }
+func (*EventAmplificationRamped) ProtoMessage()    {}
+func (*EventAmplificationRamped) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9ee91b5e8b820cb, []int{4}
+}
+func (m *EventAmplificationRamped) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAmplificationRamped) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAmplificationRamped.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAmplificationRamped) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAmplificationRamped.Merge(m, src)
+}
+func (m *EventAmplificationRamped) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAmplificationRamped) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAmplificationRamped.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAmplificationRamped proto.InternalMessageInfo
+
+func (m *EventAmplificationRamped) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *EventAmplificationRamped) GetInitialAmplification() uint64 {
+	if m != nil {
+		return m.InitialAmplification
+	}
+	return 0
+}
+
+func (m *EventAmplificationRamped) GetFutureAmplification() uint64 {
+	if m != nil {
+		return m.FutureAmplification
+	}
+	return 0
+}
+
+func (m *EventAmplificationRamped) GetFutureTime() time.Time {
+	if m != nil {
+		return m.FutureTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*EventPoolJoined)(nil), "nibiru.dex.v1.EventPoolJoined")
 	proto.RegisterType((*EventPoolCreated)(nil), "nibiru.dex.v1.EventPoolCreated")
 	proto.RegisterType((*EventPoolExited)(nil), "nibiru.dex.v1.EventPoolExited")
 	proto.RegisterType((*EventAssetsSwapped)(nil), "nibiru.dex.v1.EventAssetsSwapped")
+	proto.RegisterType((*EventAmplificationRamped)(nil), "nibiru.dex.v1.EventAmplificationRamped")
 }
 
 func init() { proto.RegisterFile("dex/v1/event.proto", fileDescriptor_f9ee91b5e8b820cb) }
 
 var fileDescriptor_f9ee91b5e8b820cb = []byte{
-	// 427 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6e, 0x13, 0x31,
-	0x10, 0xc6, 0xe3, 0x36, 0x34, 0xd4, 0x50, 0x8a, 0x2c, 0x24, 0x96, 0x1e, 0x96, 0x28, 0xa7, 0x70,
-	0xb1, 0x15, 0xb8, 0x21, 0x84, 0x44, 0xa3, 0x15, 0x5a, 0x0e, 0x04, 0xa5, 0x37, 0x2e, 0xab, 0xfd,
-	0x63, 0x25, 0x16, 0x5d, 0xcf, 0xca, 0xf6, 0x2e, 0xcb, 0x23, 0x70, 0xe3, 0x95, 0xb8, 0x55, 0xe2,
-	0xd2, 0x23, 0x27, 0x84, 0x92, 0x17, 0x41, 0xb6, 0xb7, 0xa5, 0x97, 0x4a, 0xdb, 0xdc, 0x3c, 0xb2,
-	0x67, 0xc6, 0xdf, 0x6f, 0xe6, 0xc3, 0xa4, 0xe0, 0x2d, 0x6b, 0x66, 0x8c, 0x37, 0x5c, 0x1a, 0x5a,
-	0x29, 0x30, 0x40, 0x8e, 0xa4, 0xc8, 0x84, 0xaa, 0x69, 0xc1, 0x5b, 0xda, 0xcc, 0x4e, 0x9e, 0xac,
-	0x60, 0x05, 0xee, 0x86, 0xd9, 0x93, 0x7f, 0x74, 0x12, 0xe6, 0xa0, 0x4b, 0xd0, 0x2c, 0x4b, 0x35,
-	0x67, 0xcd, 0x2c, 0xe3, 0x26, 0x9d, 0xb1, 0x1c, 0x84, 0xf4, 0xf7, 0x93, 0xef, 0x7b, 0xf8, 0x38,
-	0xb2, 0x45, 0x3f, 0x01, 0x9c, 0x7f, 0x00, 0x21, 0x79, 0x41, 0x02, 0x3c, 0x4a, 0x8b, 0x42, 0x71,
-	0xad, 0x03, 0x34, 0x46, 0xd3, 0xc3, 0xe5, 0x55, 0x48, 0x9e, 0xe2, 0x51, 0x05, 0x70, 0x9e, 0x88,
-	0x22, 0xd8, 0x1b, 0xa3, 0xe9, 0x70, 0x79, 0x60, 0xc3, 0xb8, 0x20, 0x6f, 0xf0, 0xa1, 0x81, 0x2f,
-	0x5c, 0xea, 0x44, 0xc8, 0x60, 0x7f, 0xbc, 0x3f, 0x7d, 0xf0, 0xf2, 0x19, 0xf5, 0xad, 0xa9, 0x6d,
-	0x4d, 0xbb, 0xd6, 0x74, 0x0e, 0x42, 0x9e, 0x0e, 0x2f, 0xfe, 0x3c, 0x1f, 0x2c, 0xef, 0xfb, 0x8c,
-	0x58, 0x92, 0xf7, 0xf8, 0xd8, 0x95, 0xd5, 0xeb, 0x54, 0x71, 0x9d, 0x40, 0x6d, 0x82, 0xe1, 0x18,
-	0xf5, 0xa9, 0x71, 0x64, 0xf3, 0xce, 0x5c, 0xda, 0xa2, 0x36, 0xf6, 0x1b, 0x8a, 0x97, 0x89, 0xd5,
-	0xa7, 0x83, 0x7b, 0x3d, 0xbf, 0xa1, 0x78, 0x69, 0x43, 0x3d, 0x89, 0xf0, 0xe3, 0x6b, 0x14, 0x73,
-	0xc5, 0x53, 0xe3, 0x59, 0xe4, 0xf6, 0x08, 0xea, 0x8a, 0x45, 0x17, 0xde, 0xca, 0x62, 0xf2, 0x0b,
-	0xdd, 0x40, 0x1a, 0xb5, 0xc2, 0xec, 0x86, 0x34, 0xc2, 0x8f, 0x6e, 0x42, 0x71, 0x5c, 0x7b, 0x31,
-	0x79, 0xf8, 0x9f, 0x49, 0x2c, 0xc9, 0x5b, 0x8c, 0xbb, 0xc9, 0x78, 0xac, 0xbd, 0x98, 0x74, 0xc3,
-	0x5c, 0xd4, 0x66, 0xf2, 0x13, 0x61, 0xe2, 0xd4, 0xbc, 0xd3, 0x9a, 0x1b, 0x7d, 0xf6, 0x35, 0xad,
-	0xaa, 0xdd, 0x04, 0xbd, 0xc6, 0x7e, 0xe2, 0x77, 0x90, 0x32, 0x72, 0x09, 0xb1, 0xbc, 0xde, 0xaf,
-	0xbb, 0xec, 0x86, 0xef, 0xb6, 0xa8, 0xcd, 0xe9, 0xfc, 0x62, 0x13, 0xa2, 0xcb, 0x4d, 0x88, 0xfe,
-	0x6e, 0x42, 0xf4, 0x63, 0x1b, 0x0e, 0x2e, 0xb7, 0xe1, 0xe0, 0xf7, 0x36, 0x1c, 0x7c, 0x7e, 0xb1,
-	0x12, 0x66, 0x5d, 0x67, 0x34, 0x87, 0x92, 0x7d, 0x74, 0x76, 0x9a, 0xaf, 0x53, 0x21, 0x99, 0xb7,
-	0x16, 0x6b, 0x99, 0xf5, 0x9d, 0xf9, 0x56, 0x71, 0x9d, 0x1d, 0x38, 0xc3, 0xbc, 0xfa, 0x17, 0x00,
-	0x00, 0xff, 0xff, 0x7f, 0x2c, 0xe3, 0x4f, 0x8b, 0x03, 0x00, 0x00,
+	// 532 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0xeb, 0xad, 0xac, 0x9b, 0xc7, 0x18, 0x0a, 0x45, 0x84, 0x1e, 0xd2, 0xaa, 0xa7, 0x72,
+	0xb1, 0x55, 0x76, 0x43, 0x08, 0x89, 0x56, 0x15, 0x2a, 0x07, 0x86, 0x3a, 0x4e, 0x5c, 0x2a, 0xa7,
+	0x71, 0x53, 0x8b, 0xc4, 0x2f, 0x8a, 0x9d, 0x52, 0x3e, 0x02, 0xb7, 0x7d, 0x25, 0x6e, 0x93, 0xb8,
+	0xec, 0xc8, 0x09, 0x50, 0xfb, 0x45, 0x90, 0xed, 0x74, 0xb4, 0x48, 0x48, 0xdd, 0x6e, 0x79, 0x7a,
+	0xf9, 0xdb, 0xff, 0xff, 0xcf, 0x7e, 0xc6, 0x5e, 0xc4, 0x17, 0x74, 0xde, 0xa5, 0x7c, 0xce, 0xa5,
+	0x26, 0x59, 0x0e, 0x1a, 0xbc, 0x13, 0x29, 0x42, 0x91, 0x17, 0x24, 0xe2, 0x0b, 0x32, 0xef, 0x36,
+	0xea, 0x31, 0xc4, 0x60, 0x3b, 0xd4, 0x7c, 0xb9, 0x9f, 0x1a, 0xc1, 0x04, 0x54, 0x0a, 0x8a, 0x86,
+	0x4c, 0x71, 0x3a, 0xef, 0x86, 0x5c, 0xb3, 0x2e, 0x9d, 0x80, 0x90, 0x65, 0xbf, 0x19, 0x03, 0xc4,
+	0x09, 0xa7, 0xb6, 0x0a, 0x8b, 0x29, 0xd5, 0x22, 0xe5, 0x4a, 0xb3, 0x34, 0x73, 0x3f, 0xb4, 0xbf,
+	0xee, 0xe1, 0xd3, 0x81, 0xd9, 0xf5, 0x3d, 0x40, 0xf2, 0x16, 0x84, 0xe4, 0x91, 0xe7, 0xe3, 0x1a,
+	0x8b, 0xa2, 0x9c, 0x2b, 0xe5, 0xa3, 0x16, 0xea, 0x1c, 0x8d, 0xd6, 0xa5, 0xf7, 0x04, 0xd7, 0x32,
+	0x80, 0x64, 0x2c, 0x22, 0x7f, 0xaf, 0x85, 0x3a, 0xd5, 0xd1, 0x81, 0x29, 0x87, 0x91, 0xf7, 0x12,
+	0x1f, 0x69, 0xf8, 0xc4, 0xa5, 0x1a, 0x0b, 0xe9, 0xef, 0xb7, 0xf6, 0x3b, 0xc7, 0xcf, 0x9f, 0x12,
+	0xe7, 0x8d, 0x18, 0x6f, 0xa4, 0xf4, 0x46, 0xfa, 0x20, 0x64, 0xaf, 0x7a, 0xf5, 0xb3, 0x59, 0x19,
+	0x1d, 0x3a, 0xc5, 0x50, 0x7a, 0x6f, 0xf0, 0xa9, 0x5d, 0x56, 0xcd, 0x58, 0xce, 0xd5, 0x18, 0x0a,
+	0xed, 0x57, 0x5b, 0x68, 0x97, 0x35, 0x4e, 0x8c, 0xee, 0xc2, 0xca, 0xce, 0x0b, 0x6d, 0x6c, 0xe4,
+	0x3c, 0x1d, 0x1b, 0x00, 0xca, 0xbf, 0xb7, 0xa3, 0x8d, 0x9c, 0xa7, 0xa6, 0x54, 0xed, 0x01, 0x7e,
+	0x78, 0x83, 0xa2, 0x9f, 0x73, 0xa6, 0x1d, 0x8b, 0x89, 0xf9, 0x84, 0x7c, 0xcd, 0xa2, 0x2c, 0xff,
+	0xcb, 0xa2, 0xfd, 0x1d, 0x6d, 0x20, 0x1d, 0x2c, 0x84, 0xbe, 0x1b, 0xd2, 0x01, 0x7e, 0xb0, 0x09,
+	0xc5, 0x72, 0xdd, 0x89, 0xc9, 0xfd, 0xbf, 0x4c, 0x86, 0xd2, 0x7b, 0x85, 0x71, 0x79, 0x32, 0x0e,
+	0xeb, 0x4e, 0x4c, 0xca, 0xc3, 0x3c, 0x2f, 0x74, 0xfb, 0x1b, 0xc2, 0x9e, 0x4d, 0xf3, 0x5a, 0x29,
+	0xae, 0xd5, 0xc5, 0x67, 0x96, 0x65, 0x77, 0x0b, 0xf4, 0x02, 0xbb, 0x13, 0xbf, 0x45, 0x94, 0x9a,
+	0x15, 0x0c, 0xe5, 0xcd, 0xfd, 0xba, 0xcd, 0xdd, 0x70, 0xbb, 0x99, 0x0c, 0x4b, 0x84, 0x7d, 0x97,
+	0x21, 0xcd, 0x12, 0x31, 0x15, 0x13, 0xa6, 0x05, 0xc8, 0x11, 0x4b, 0x4d, 0x92, 0x0d, 0xbf, 0x68,
+	0xcb, 0xef, 0x19, 0x7e, 0x2c, 0xa4, 0xd0, 0x82, 0x25, 0x63, 0xb6, 0xa9, 0x2b, 0x63, 0xd5, 0xcb,
+	0xe6, 0xd6, 0x9a, 0x5e, 0x17, 0xd7, 0xa7, 0x85, 0x2e, 0x72, 0xfe, 0x8f, 0x66, 0xdf, 0x6a, 0x1e,
+	0xb9, 0xde, 0xb6, 0x64, 0x80, 0x8f, 0x4b, 0x89, 0x19, 0xce, 0x32, 0x5d, 0x83, 0xb8, 0xc9, 0x25,
+	0xeb, 0xc9, 0x25, 0x1f, 0xd6, 0x93, 0xdb, 0x3b, 0x34, 0xf1, 0x2e, 0x7f, 0x35, 0xd1, 0x08, 0x3b,
+	0xa1, 0x69, 0xf5, 0xfa, 0x57, 0xcb, 0x00, 0x5d, 0x2f, 0x03, 0xf4, 0x7b, 0x19, 0xa0, 0xcb, 0x55,
+	0x50, 0xb9, 0x5e, 0x05, 0x95, 0x1f, 0xab, 0xa0, 0xf2, 0xf1, 0x59, 0x2c, 0xf4, 0xac, 0x08, 0xc9,
+	0x04, 0x52, 0xfa, 0xce, 0x3e, 0x2a, 0xfd, 0x19, 0x13, 0x92, 0xba, 0x07, 0x86, 0x2e, 0xa8, 0x79,
+	0x7d, 0xf4, 0x97, 0x8c, 0xab, 0xf0, 0xc0, 0x6e, 0x77, 0xf6, 0x67, 0x00, 0xb8, 0xfa, 0xf5, 0x80,
+	0x91, 0x04, 0x00, 0x00,
 }
 
 func (m *EventPoolJoined) Marshal() (dAtA []byte, err error) {
@@ -550,6 +630,52 @@ func (m *EventAssetsSwapped) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventAmplificationRamped) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAmplificationRamped) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAmplificationRamped) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FutureTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FutureTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintEvent(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if m.FutureAmplification != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.FutureAmplification))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InitialAmplification != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.InitialAmplification))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -651,6 +777,26 @@ func (m *EventAssetsSwapped) Size() (n int) {
 	return n
 }
 
+func (m *EventAmplificationRamped) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvent(uint64(m.PoolId))
+	}
+	if m.InitialAmplification != 0 {
+		n += 1 + sovEvent(uint64(m.InitialAmplification))
+	}
+	if m.FutureAmplification != 0 {
+		n += 1 + sovEvent(uint64(m.FutureAmplification))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.FutureTime)
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1295,6 +1441,146 @@ func (m *EventAssetsSwapped) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventAmplificationRamped) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAmplificationRamped: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAmplificationRamped: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialAmplification", wireType)
+			}
+			m.InitialAmplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialAmplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureAmplification", wireType)
+			}
+			m.FutureAmplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FutureAmplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.FutureTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -10,5 +16,45 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(gs.TwapRecords))
+	for _, record := range gs.TwapRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%d/%s/%s/%d", record.PoolId, record.BaseDenom, record.QuoteDenom, record.Time.UnixNano())
+		if _, found := seen[key]; found {
+			return fmt.Errorf("duplicate twap record of %s in %s of pool %d at %s",
+				record.BaseDenom, record.QuoteDenom, record.PoolId, record.Time)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
+// Validate checks that the record is of two distinct denoms of a pool, with a
+// positive spot price and a non-negative cumulative price.
+func (r TwapRecord) Validate() error {
+	if r.PoolId == 0 {
+		return fmt.Errorf("twap record has no pool id")
+	}
+	if err := sdk.ValidateDenom(r.BaseDenom); err != nil {
+		return fmt.Errorf("twap record of pool %d: %w", r.PoolId, err)
+	}
+	if err := sdk.ValidateDenom(r.QuoteDenom); err != nil {
+		return fmt.Errorf("twap record of pool %d: %w", r.PoolId, err)
+	}
+	if r.BaseDenom == r.QuoteDenom {
+		return fmt.Errorf("twap record of pool %d prices %s in itself", r.PoolId, r.BaseDenom)
+	}
+	if r.SpotPrice.IsNil() || !r.SpotPrice.IsPositive() {
+		return fmt.Errorf("twap record of pool %d: spot price must be positive, got %s", r.PoolId, r.SpotPrice)
+	}
+	if r.CumulativePrice.IsNil() || r.CumulativePrice.IsNegative() {
+		return fmt.Errorf("twap record of pool %d: cumulative price must not be negative, got %s", r.PoolId, r.CumulativePrice)
+	}
+	return nil
 }
@@ -26,6 +26,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the dex module's genesis state.
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// the cumulative prices of the denom pairs of the pools
+	TwapRecords []TwapRecord `protobuf:"bytes,2,rep,name=twap_records,json=twapRecords,proto3" json:"twap_records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +70,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetTwapRecords() []TwapRecord {
+	if m != nil {
+		return m.TwapRecords
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "nibiru.dex.v1.GenesisState")
 }
@@ -75,20 +84,22 @@ func init() {
 func init() { proto.RegisterFile("dex/v1/genesis.proto", fileDescriptor_12a0429c56f27456) }
 
 var fileDescriptor_12a0429c56f27456 = []byte{
-	// 194 bytes of a gzipped FileDescriptorProto
+	// 239 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x49, 0x49, 0xad, 0xd0,
 	0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
 	0x17, 0xe2, 0xcd, 0xcb, 0x4c, 0xca, 0x2c, 0x2a, 0xd5, 0x4b, 0x49, 0xad, 0xd0, 0x2b, 0x33, 0x94,
-	0x12, 0x86, 0x2a, 0x2a, 0x48, 0x2c, 0x4a, 0xcc, 0x85, 0xaa, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0x33, 0xf5, 0x41, 0x2c, 0x88, 0xa8, 0x92, 0x33, 0x17, 0x8f, 0x3b, 0xc4, 0xa8, 0xe0, 0x92,
-	0xc4, 0x92, 0x54, 0x21, 0x63, 0x2e, 0x36, 0x88, 0x2e, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23,
-	0x51, 0x3d, 0x14, 0xa3, 0xf5, 0x02, 0xc0, 0x92, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41,
-	0x95, 0x3a, 0x39, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c,
+	0x12, 0x86, 0x2a, 0x2a, 0x48, 0x2c, 0x4a, 0xcc, 0x85, 0xaa, 0x91, 0x12, 0x84, 0x0a, 0x96, 0x94,
+	0x27, 0x16, 0x40, 0x85, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x22, 0xaa,
+	0xd4, 0xce, 0xc8, 0xc5, 0xe3, 0x0e, 0x31, 0x3e, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x98, 0x8b,
+	0x0d, 0x62, 0x92, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0xa8, 0x1e, 0x8a, 0x75, 0x7a, 0x01,
+	0x60, 0x49, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0x4a, 0x85, 0x9c, 0xb8, 0x78, 0x40,
+	0x36, 0xc5, 0x17, 0xa5, 0x26, 0xe7, 0x17, 0xa5, 0x14, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b,
+	0x49, 0xa2, 0x69, 0x0d, 0x29, 0x4f, 0x2c, 0x08, 0x02, 0xab, 0x80, 0x6a, 0xe7, 0x2e, 0x81, 0x8b,
+	0x14, 0x3b, 0x39, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c,
 	0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x66, 0x7a,
-	0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0xbe, 0x1f, 0xd8, 0x20, 0xe7, 0x8c, 0xc4,
-	0xcc, 0x3c, 0x7d, 0x88, 0xa1, 0xfa, 0x15, 0xfa, 0x20, 0x97, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27,
-	0xb1, 0x81, 0x1d, 0x64, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x37, 0xbc, 0x63, 0xf3, 0xe2, 0x00,
-	0x00, 0x00,
+	0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0xbe, 0x1f, 0xd8, 0x44, 0xe7, 0x8c, 0xc4,
+	0xcc, 0x3c, 0x7d, 0x88, 0xe9, 0xfa, 0x15, 0xfa, 0x20, 0xcf, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27,
+	0xb1, 0x81, 0x7d, 0x65, 0x0c, 0x18, 0x00, 0xdd, 0x0f, 0x1d, 0x21, 0x3a, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -111,6 +122,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TwapRecords) > 0 {
+		for iNdEx := len(m.TwapRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TwapRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -143,6 +168,12 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TwapRecords) > 0 {
+		for _, e := range m.TwapRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -214,6 +245,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TwapRecords = append(m.TwapRecords, TwapRecord{})
+			if err := m.TwapRecords[len(m.TwapRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,13 +2,34 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/NibiruChain/nibiru/x/dex/types"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	record := types.TwapRecord{
+		PoolId:          1,
+		BaseDenom:       "unibi",
+		QuoteDenom:      "unusd",
+		Time:            time.Unix(1_000_000, 0).UTC(),
+		SpotPrice:       sdk.MustNewDecFromStr("0.25"),
+		CumulativePrice: sdk.ZeroDec(),
+	}
+	withRecords := func(records ...types.TwapRecord) *types.GenesisState {
+		genState := types.DefaultGenesis()
+		genState.TwapRecords = records
+		return genState
+	}
+	modified := func(modify func(*types.TwapRecord)) types.TwapRecord {
+		modifiedRecord := record
+		modify(&modifiedRecord)
+		return modifiedRecord
+	}
+
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -24,6 +45,36 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{},
 			valid:    true,
 		},
+		{
+			desc:     "twap records",
+			genState: withRecords(record, modified(func(r *types.TwapRecord) { r.Time = r.Time.Add(time.Second) })),
+			valid:    true,
+		},
+		{
+			desc:     "duplicate twap record",
+			genState: withRecords(record, record),
+			valid:    false,
+		},
+		{
+			desc:     "twap record without a pool id",
+			genState: withRecords(modified(func(r *types.TwapRecord) { r.PoolId = 0 })),
+			valid:    false,
+		},
+		{
+			desc:     "twap record of a denom in itself",
+			genState: withRecords(modified(func(r *types.TwapRecord) { r.QuoteDenom = r.BaseDenom })),
+			valid:    false,
+		},
+		{
+			desc:     "twap record with a zero spot price",
+			genState: withRecords(modified(func(r *types.TwapRecord) { r.SpotPrice = sdk.ZeroDec() })),
+			valid:    false,
+		},
+		{
+			desc:     "twap record with a negative cumulative price",
+			genState: withRecords(modified(func(r *types.TwapRecord) { r.CumulativePrice = sdk.NewDec(-1) })),
+			valid:    false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
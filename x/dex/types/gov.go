@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeRampAmplification = "RampAmplification"
+)
+
+var _ govtypes.Content = &RampAmplificationProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeRampAmplification)
+	govtypes.RegisterProposalTypeCodec(&RampAmplificationProposal{}, "nibiru/RampAmplificationProposal")
+}
+
+func (m *RampAmplificationProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *RampAmplificationProposal) ProposalType() string {
+	return ProposalTypeRampAmplification
+}
+
+func (m *RampAmplificationProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	if m.PoolId == 0 {
+		return fmt.Errorf("pool id must be > 0")
+	}
+
+	if err := ValidateAmplification(m.FutureAmplification); err != nil {
+		return err
+	}
+
+	if m.RampDuration < MinAmplificationRampDuration {
+		return ErrInvalidAmplification.Wrapf(
+			"the ramp must last at least %s, got %s", MinAmplificationRampDuration, m.RampDuration)
+	}
+
+	return nil
+}
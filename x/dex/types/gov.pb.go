@@ -0,0 +1,506 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/v1/gov.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Changes the amplification of a StableSwap pool linearly over a duration,
+// starting when the proposal passes.
+type RampAmplificationProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// pool_id is the id of the StableSwap pool.
+	PoolId uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// future_amplification is the amplification at the end of the ramp.
+	FutureAmplification uint64 `protobuf:"varint,4,opt,name=future_amplification,json=futureAmplification,proto3" json:"future_amplification,omitempty"`
+	// ramp_duration is how long the amplification takes to reach its future value.
+	RampDuration time.Duration `protobuf:"bytes,5,opt,name=ramp_duration,json=rampDuration,proto3,stdduration" json:"ramp_duration"`
+}
+
+func (m *RampAmplificationProposal) Reset()         { *m = RampAmplificationProposal{} }
+func (m *RampAmplificationProposal) String() string { return proto.CompactTextString(m) }
+func (*RampAmplificationProposal) ProtoMessage()    {}
+func (*RampAmplificationProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1072d31b57a75eef, []int{0}
+}
+func (m *RampAmplificationProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RampAmplificationProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RampAmplificationProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RampAmplificationProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RampAmplificationProposal.Merge(m, src)
+}
+func (m *RampAmplificationProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RampAmplificationProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RampAmplificationProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RampAmplificationProposal proto.InternalMessageInfo
+
+func (m *RampAmplificationProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *RampAmplificationProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *RampAmplificationProposal) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *RampAmplificationProposal) GetFutureAmplification() uint64 {
+	if m != nil {
+		return m.FutureAmplification
+	}
+	return 0
+}
+
+func (m *RampAmplificationProposal) GetRampDuration() time.Duration {
+	if m != nil {
+		return m.RampDuration
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*RampAmplificationProposal)(nil), "nibiru.dex.v1.RampAmplificationProposal")
+}
+
+func init() { proto.RegisterFile("dex/v1/gov.proto", fileDescriptor_1072d31b57a75eef) }
+
+var fileDescriptor_1072d31b57a75eef = []byte{
+	// 308 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xc1, 0x4e, 0x32, 0x31,
+	0x14, 0x85, 0xa7, 0xff, 0x0f, 0xa8, 0x45, 0x12, 0x33, 0x92, 0x38, 0xb0, 0x28, 0x13, 0x57, 0xb8,
+	0x69, 0x83, 0x3e, 0x81, 0xe2, 0x42, 0x37, 0xc6, 0xcc, 0xd2, 0x0d, 0x99, 0x61, 0x4a, 0x69, 0x32,
+	0xc3, 0x6d, 0x4a, 0x4b, 0xf0, 0x2d, 0x5c, 0xfa, 0x48, 0x2c, 0x59, 0xba, 0x52, 0x03, 0x2b, 0xdf,
+	0xc2, 0x4c, 0x2b, 0x06, 0x77, 0x3d, 0xf7, 0x3b, 0xcd, 0xbd, 0xe7, 0xe0, 0x93, 0x9c, 0x2f, 0xd9,
+	0x62, 0xc0, 0x04, 0x2c, 0xa8, 0xd2, 0x60, 0x20, 0x6c, 0xcd, 0x64, 0x26, 0xb5, 0xa5, 0x39, 0x5f,
+	0xd2, 0xc5, 0xa0, 0xdb, 0x16, 0x20, 0xc0, 0x11, 0x56, 0xbd, 0xbc, 0xa9, 0x4b, 0x04, 0x80, 0x28,
+	0x38, 0x73, 0x2a, 0xb3, 0x13, 0x96, 0x5b, 0x9d, 0x1a, 0x09, 0x33, 0xcf, 0xcf, 0xbf, 0x10, 0xee,
+	0x24, 0x69, 0xa9, 0xae, 0x4b, 0x55, 0xc8, 0x89, 0x1c, 0x3b, 0xf6, 0xa8, 0x41, 0xc1, 0x3c, 0x2d,
+	0xc2, 0x36, 0xae, 0x1b, 0x69, 0x0a, 0x1e, 0xa1, 0x18, 0xf5, 0x8f, 0x12, 0x2f, 0xc2, 0x18, 0x37,
+	0x73, 0x3e, 0x1f, 0x6b, 0xa9, 0x2a, 0x73, 0xf4, 0xcf, 0xb1, 0xfd, 0x51, 0x78, 0x86, 0x0f, 0x14,
+	0x40, 0x31, 0x92, 0x79, 0xf4, 0x3f, 0x46, 0xfd, 0x5a, 0xd2, 0xa8, 0xe4, 0x7d, 0x1e, 0x0e, 0x70,
+	0x7b, 0x62, 0x8d, 0xd5, 0x7c, 0x94, 0xee, 0x2f, 0x8c, 0x6a, 0xce, 0x75, 0xea, 0xd9, 0x9f, 0x5b,
+	0xc2, 0x3b, 0xdc, 0xd2, 0x69, 0xa9, 0x46, 0xbb, 0xc3, 0xa3, 0x7a, 0x8c, 0xfa, 0xcd, 0xcb, 0x0e,
+	0xf5, 0xc9, 0xe8, 0x2e, 0x19, 0xbd, 0xfd, 0x31, 0xdc, 0x1c, 0xae, 0xde, 0x7b, 0xc1, 0xeb, 0x47,
+	0x0f, 0x25, 0xc7, 0xd5, 0xcf, 0xdf, 0xf9, 0x70, 0xb5, 0x21, 0x68, 0xbd, 0x21, 0xe8, 0x73, 0x43,
+	0xd0, 0xcb, 0x96, 0x04, 0xeb, 0x2d, 0x09, 0xde, 0xb6, 0x24, 0x78, 0xba, 0x10, 0xd2, 0x4c, 0x6d,
+	0x46, 0xc7, 0x50, 0xb2, 0x07, 0xd7, 0xea, 0x70, 0x9a, 0xca, 0x19, 0xf3, 0x0d, 0xb3, 0x25, 0xab,
+	0xca, 0x37, 0xcf, 0x8a, 0xcf, 0xb3, 0x86, 0xdb, 0x77, 0xf5, 0x3d, 0x00, 0x59, 0xa2, 0x6b, 0xb7,
+	0x90, 0x01, 0x00, 0x00,
+}
+
+func (m *RampAmplificationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RampAmplificationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RampAmplificationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RampDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RampDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGov(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.FutureAmplification != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.FutureAmplification))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RampAmplificationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovGov(uint64(m.PoolId))
+	}
+	if m.FutureAmplification != 0 {
+		n += 1 + sovGov(uint64(m.FutureAmplification))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RampDuration)
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RampAmplificationProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RampAmplificationProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RampAmplificationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureAmplification", wireType)
+			}
+			m.FutureAmplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FutureAmplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RampDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RampDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGov(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGov
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGov
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGov
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGov        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGov          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGov = fmt.Errorf("proto: unexpected end of group")
+)
@@ -39,6 +39,10 @@ var (
 	KeyPrefixPoolIds = []byte{0x04}
 )
 
+// NamespaceTwapRecords is the collections namespace of the TWAP records, after
+// the prefixes above.
+const NamespaceTwapRecords = 0x05
+
 func GetDenomPrefixPoolIds(denoms ...string) []byte {
 	sort.Strings(denoms)
 	concatenation := strings.Join(denoms[:], "")
@@ -9,6 +9,10 @@ const TypeMsgExitPool = "exit_pool"
 const TypeMsgJoinPool = "join_pool"
 const TypeMsgSwapAssets = "swap_assets"
 const TypeMsgCreatePool = "create_pool"
+const TypeMsgSwapExactAmountIn = "swap_exact_amount_in"
+const TypeMsgSwapExactAmountOut = "swap_exact_amount_out"
+const TypeMsgJoinPoolExactShares = "join_pool_exact_shares"
+const TypeMsgExitPoolExactTokens = "exit_pool_exact_tokens"
 
 var _ sdk.Msg = &MsgExitPool{}
 
@@ -46,6 +50,11 @@ func (msg *MsgExitPool) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
 	}
+	if msg.TokenOutDenom != "" {
+		if err := sdk.ValidateDenom(msg.TokenOutDenom); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid token out denom (%s)", err)
+		}
+	}
 	return nil
 }
 
@@ -89,6 +98,106 @@ func (msg *MsgJoinPool) ValidateBasic() error {
 	return nil
 }
 
+var _ sdk.Msg = &MsgJoinPoolExactShares{}
+
+func NewMsgJoinPoolExactShares(
+	sender string, poolId uint64, poolSharesOut sdk.Int, tokenInMaxs sdk.Coins,
+) *MsgJoinPoolExactShares {
+	return &MsgJoinPoolExactShares{
+		Sender:        sender,
+		PoolId:        poolId,
+		PoolSharesOut: poolSharesOut,
+		TokenInMaxs:   tokenInMaxs,
+	}
+}
+
+func (msg *MsgJoinPoolExactShares) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgJoinPoolExactShares) Type() string {
+	return TypeMsgJoinPoolExactShares
+}
+
+func (msg *MsgJoinPoolExactShares) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgJoinPoolExactShares) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgJoinPoolExactShares) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if msg.PoolSharesOut.IsNil() || !msg.PoolSharesOut.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "the pool shares out must be positive")
+	}
+
+	if err := msg.TokenInMaxs.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid token in maxs (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgExitPoolExactTokens{}
+
+func NewMsgExitPoolExactTokens(
+	sender string, poolId uint64, tokensOut sdk.Coins, poolSharesInMax sdk.Int,
+) *MsgExitPoolExactTokens {
+	return &MsgExitPoolExactTokens{
+		Sender:          sender,
+		PoolId:          poolId,
+		TokensOut:       tokensOut,
+		PoolSharesInMax: poolSharesInMax,
+	}
+}
+
+func (msg *MsgExitPoolExactTokens) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgExitPoolExactTokens) Type() string {
+	return TypeMsgExitPoolExactTokens
+}
+
+func (msg *MsgExitPoolExactTokens) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgExitPoolExactTokens) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgExitPoolExactTokens) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if msg.TokensOut.Empty() || !msg.TokensOut.IsValid() {
+		return ErrInvalidTokenOutDenom.Wrapf("invalid tokens out %s", msg.TokensOut.String())
+	}
+
+	if msg.PoolSharesInMax.IsNil() || !msg.PoolSharesInMax.IsPositive() {
+		return ErrPoolSharesInAboveMaxAmount.Wrap("the maximum amount of pool shares in must be positive")
+	}
+	return nil
+}
+
 var _ sdk.Msg = &MsgSwapAssets{}
 
 func NewMsgSwapAssets(sender string, poolId uint64, tokenIn sdk.Coin, tokenOutDenom string) *MsgSwapAssets {
@@ -142,6 +251,120 @@ func (msg *MsgSwapAssets) ValidateBasic() error {
 	return nil
 }
 
+var _ sdk.Msg = &MsgSwapExactAmountIn{}
+
+func NewMsgSwapExactAmountIn(
+	sender string, routes []SwapRoute, tokenIn sdk.Coin, tokenOutMinAmount sdk.Int,
+) *MsgSwapExactAmountIn {
+	return &MsgSwapExactAmountIn{
+		Sender:            sender,
+		Routes:            routes,
+		TokenIn:           tokenIn,
+		TokenOutMinAmount: tokenOutMinAmount,
+	}
+}
+
+func (msg *MsgSwapExactAmountIn) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSwapExactAmountIn) Type() string {
+	return TypeMsgSwapExactAmountIn
+}
+
+func (msg *MsgSwapExactAmountIn) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgSwapExactAmountIn) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSwapExactAmountIn) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if !msg.TokenIn.IsValid() || !msg.TokenIn.IsPositive() {
+		return ErrInvalidTokenIn.Wrapf("invalid argument %s", msg.TokenIn.String())
+	}
+
+	if msg.TokenOutMinAmount.IsNil() || !msg.TokenOutMinAmount.IsPositive() {
+		return ErrTokenOutBelowMinAmount.Wrap("the minimum amount of tokens out must be positive")
+	}
+
+	return ValidateSwapRoutes(msg.TokenIn.Denom, msg.Routes)
+}
+
+var _ sdk.Msg = &MsgSwapExactAmountOut{}
+
+func NewMsgSwapExactAmountOut(
+	sender string, routes []SwapRoute, tokenInDenom string, tokenOut sdk.Coin, tokenInMaxAmount sdk.Int,
+) *MsgSwapExactAmountOut {
+	return &MsgSwapExactAmountOut{
+		Sender:           sender,
+		Routes:           routes,
+		TokenInDenom:     tokenInDenom,
+		TokenOut:         tokenOut,
+		TokenInMaxAmount: tokenInMaxAmount,
+	}
+}
+
+func (msg *MsgSwapExactAmountOut) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSwapExactAmountOut) Type() string {
+	return TypeMsgSwapExactAmountOut
+}
+
+func (msg *MsgSwapExactAmountOut) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgSwapExactAmountOut) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSwapExactAmountOut) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.TokenInDenom); err != nil {
+		return ErrInvalidTokenIn.Wrap(err.Error())
+	}
+
+	if !msg.TokenOut.IsValid() || !msg.TokenOut.IsPositive() {
+		return ErrInvalidTokenOutDenom.Wrapf("invalid argument %s", msg.TokenOut.String())
+	}
+
+	if msg.TokenInMaxAmount.IsNil() || !msg.TokenInMaxAmount.IsPositive() {
+		return ErrTokenInAboveMaxAmount.Wrap("the maximum amount of tokens in must be positive")
+	}
+
+	if err := ValidateSwapRoutes(msg.TokenInDenom, msg.Routes); err != nil {
+		return err
+	}
+	if TokenOutDenom(msg.Routes) != msg.TokenOut.Denom {
+		return ErrInvalidSwapRoutes.Wrapf(
+			"the route ends in %s instead of %s", TokenOutDenom(msg.Routes), msg.TokenOut.Denom)
+	}
+	return nil
+}
+
 var _ sdk.Msg = &MsgCreatePool{}
 
 func NewMsgCreatePool(creator string, poolAssets []PoolAsset, poolParams *PoolParams) *MsgCreatePool {
@@ -200,5 +423,5 @@ func (msg *MsgCreatePool) ValidateBasic() error {
 		return ErrInvalidExitFee.Wrapf("invalid exit fee: %s", msg.PoolParams.ExitFee)
 	}
 
-	return nil
+	return msg.PoolParams.ValidatePoolType()
 }
@@ -236,6 +236,19 @@ func TestMsgExitPool_ValidateBasic(t *testing.T) {
 			msg: MsgExitPool{
 				Sender: testutil.AccAddress().String(),
 			},
+		}, {
+			name: "invalid token out denom",
+			msg: MsgExitPool{
+				Sender:        testutil.AccAddress().String(),
+				TokenOutDenom: "1foo",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "single asset exit",
+			msg: MsgExitPool{
+				Sender:        testutil.AccAddress().String(),
+				TokenOutDenom: "foo",
+			},
 		},
 	}
 	for _, tt := range tests {
@@ -348,3 +361,215 @@ func TestMsgSwapAssets_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSwapExactAmountIn_ValidateBasic(t *testing.T) {
+	routes := []SwapRoute{
+		{PoolId: 1, TokenOutDenom: "bar"},
+		{PoolId: 2, TokenOutDenom: "baz"},
+	}
+	tests := []struct {
+		name string
+		msg  MsgSwapExactAmountIn
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  *NewMsgSwapExactAmountIn("invalid_address", routes, sdk.NewInt64Coin("foo", 1), sdk.OneInt()),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid tokens in",
+			msg:  *NewMsgSwapExactAmountIn(testutil.AccAddress().String(), routes, sdk.NewInt64Coin("foo", 0), sdk.OneInt()),
+			err:  ErrInvalidTokenIn,
+		},
+		{
+			name: "no minimum amount out",
+			msg:  *NewMsgSwapExactAmountIn(testutil.AccAddress().String(), routes, sdk.NewInt64Coin("foo", 1), sdk.ZeroInt()),
+			err:  ErrTokenOutBelowMinAmount,
+		},
+		{
+			name: "no route",
+			msg:  *NewMsgSwapExactAmountIn(testutil.AccAddress().String(), nil, sdk.NewInt64Coin("foo", 1), sdk.OneInt()),
+			err:  ErrInvalidSwapRoutes,
+		},
+		{
+			name: "too many hops",
+			msg: *NewMsgSwapExactAmountIn(testutil.AccAddress().String(), []SwapRoute{
+				{PoolId: 1, TokenOutDenom: "bar"},
+				{PoolId: 2, TokenOutDenom: "baz"},
+				{PoolId: 3, TokenOutDenom: "qux"},
+				{PoolId: 4, TokenOutDenom: "quux"},
+			}, sdk.NewInt64Coin("foo", 1), sdk.OneInt()),
+			err: ErrInvalidSwapRoutes,
+		},
+		{
+			name: "pool swapped through twice",
+			msg: *NewMsgSwapExactAmountIn(testutil.AccAddress().String(), []SwapRoute{
+				{PoolId: 1, TokenOutDenom: "bar"},
+				{PoolId: 1, TokenOutDenom: "foo"},
+			}, sdk.NewInt64Coin("foo", 1), sdk.OneInt()),
+			err: ErrInvalidSwapRoutes,
+		},
+		{
+			name: "invalid pool id",
+			msg: *NewMsgSwapExactAmountIn(testutil.AccAddress().String(), []SwapRoute{
+				{PoolId: 0, TokenOutDenom: "bar"},
+			}, sdk.NewInt64Coin("foo", 1), sdk.OneInt()),
+			err: ErrInvalidPoolId,
+		},
+		{
+			name: "hop swaps a denom for itself",
+			msg: *NewMsgSwapExactAmountIn(testutil.AccAddress().String(), []SwapRoute{
+				{PoolId: 1, TokenOutDenom: "foo"},
+			}, sdk.NewInt64Coin("foo", 1), sdk.OneInt()),
+			err: ErrSameTokenDenom,
+		},
+		{
+			name: "valid message",
+			msg:  *NewMsgSwapExactAmountIn(testutil.AccAddress().String(), routes, sdk.NewInt64Coin("foo", 1), sdk.OneInt()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSwapExactAmountOut_ValidateBasic(t *testing.T) {
+	routes := []SwapRoute{
+		{PoolId: 1, TokenOutDenom: "bar"},
+		{PoolId: 2, TokenOutDenom: "baz"},
+	}
+	tests := []struct {
+		name string
+		msg  MsgSwapExactAmountOut
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  *NewMsgSwapExactAmountOut("invalid_address", routes, "foo", sdk.NewInt64Coin("baz", 1), sdk.OneInt()),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid token in denom",
+			msg:  *NewMsgSwapExactAmountOut(testutil.AccAddress().String(), routes, "", sdk.NewInt64Coin("baz", 1), sdk.OneInt()),
+			err:  ErrInvalidTokenIn,
+		},
+		{
+			name: "invalid tokens out",
+			msg:  *NewMsgSwapExactAmountOut(testutil.AccAddress().String(), routes, "foo", sdk.NewInt64Coin("baz", 0), sdk.OneInt()),
+			err:  ErrInvalidTokenOutDenom,
+		},
+		{
+			name: "no maximum amount in",
+			msg:  *NewMsgSwapExactAmountOut(testutil.AccAddress().String(), routes, "foo", sdk.NewInt64Coin("baz", 1), sdk.ZeroInt()),
+			err:  ErrTokenInAboveMaxAmount,
+		},
+		{
+			name: "route ends in another denom",
+			msg:  *NewMsgSwapExactAmountOut(testutil.AccAddress().String(), routes, "foo", sdk.NewInt64Coin("bar", 1), sdk.OneInt()),
+			err:  ErrInvalidSwapRoutes,
+		},
+		{
+			name: "valid message",
+			msg:  *NewMsgSwapExactAmountOut(testutil.AccAddress().String(), routes, "foo", sdk.NewInt64Coin("baz", 1), sdk.OneInt()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgJoinPoolExactShares_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgJoinPoolExactShares
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  *NewMsgJoinPoolExactShares("invalid_address", 1, sdk.OneInt(), sdk.Coins{}),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "no pool shares out",
+			msg:  *NewMsgJoinPoolExactShares(testutil.AccAddress().String(), 1, sdk.ZeroInt(), sdk.Coins{}),
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid token in maxs",
+			msg:  *NewMsgJoinPoolExactShares(testutil.AccAddress().String(), 1, sdk.OneInt(), sdk.Coins{sdk.Coin{Denom: "foo", Amount: sdk.ZeroInt()}}),
+			err:  sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "valid message without maxs",
+			msg:  *NewMsgJoinPoolExactShares(testutil.AccAddress().String(), 1, sdk.OneInt(), sdk.Coins{}),
+		},
+		{
+			name: "valid message",
+			msg:  *NewMsgJoinPoolExactShares(testutil.AccAddress().String(), 1, sdk.OneInt(), sdk.NewCoins(sdk.NewInt64Coin("foo", 1))),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgExitPoolExactTokens_ValidateBasic(t *testing.T) {
+	tokensOut := sdk.NewCoins(sdk.NewInt64Coin("foo", 1))
+	tests := []struct {
+		name string
+		msg  MsgExitPoolExactTokens
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  *NewMsgExitPoolExactTokens("invalid_address", 1, tokensOut, sdk.OneInt()),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "no tokens out",
+			msg:  *NewMsgExitPoolExactTokens(testutil.AccAddress().String(), 1, sdk.Coins{}, sdk.OneInt()),
+			err:  ErrInvalidTokenOutDenom,
+		},
+		{
+			name: "no maximum pool shares in",
+			msg:  *NewMsgExitPoolExactTokens(testutil.AccAddress().String(), 1, tokensOut, sdk.ZeroInt()),
+			err:  ErrPoolSharesInAboveMaxAmount,
+		},
+		{
+			name: "valid message",
+			msg:  *NewMsgExitPoolExactTokens(testutil.AccAddress().String(), 1, tokensOut, sdk.OneInt()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
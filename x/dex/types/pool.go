@@ -62,6 +62,10 @@ func NewPool(
 	poolParams PoolParams,
 	poolAssets []PoolAsset,
 ) (pool Pool, err error) {
+	if err = poolParams.ValidatePoolType(); err != nil {
+		return Pool{}, err
+	}
+
 	pool = Pool{
 		Id:          poolId,
 		Address:     poolAccountAddr.String(),
@@ -111,62 +115,72 @@ func (pool *Pool) AddTokensToPool(tokensIn sdk.Coins) (
 }
 
 /*
-Adds tokens to a pool optimizing the amount of shares (swap + join) and updates the pool balances (i.e. liquidity).
-We compute the swap and then join the pool.
+Adds all the tokens to a pool and updates the pool balances (i.e. liquidity).
+The tokens are first joined in the pool's proportions, then the remaining
+tokens are joined one asset at a time, following the weighted invariant.
+tokensIn may hold only some of the pool's assets, e.g. a single one.
 
 args:
   - tokensIn: the tokens to add to the pool
 
 ret:
   - numShares: the number of LP shares given to the user for the deposit
-  - remCoins: the number of coins remaining after the deposit
+  - remCoins: the coins too small to mint any share, which are not deposited
   - err: error if any
 */
 func (pool *Pool) AddAllTokensToPool(tokensIn sdk.Coins) (
 	numShares sdk.Int, remCoins sdk.Coins, err error,
 ) {
-	swapToken, err := pool.SwapForSwapAndJoin(tokensIn)
-	if err != nil {
-		return
-	}
-	if swapToken.Amount.LT(sdk.OneInt()) {
-		return pool.AddTokensToPool(tokensIn)
+	numShares, remCoins = sdk.ZeroInt(), tokensIn
+	if tokensIn.Len() == len(pool.PoolAssets) {
+		numShares, remCoins, err = pool.AddTokensToPool(tokensIn)
+		if err != nil {
+			return sdk.ZeroInt(), sdk.Coins{}, err
+		}
 	}
 
-	index, _, err := pool.getPoolAssetAndIndex(swapToken.Denom)
-
-	if err != nil {
-		return
+	notDeposited := sdk.Coins{}
+	for _, tokenIn := range remCoins {
+		singleAssetShares, err := pool.numSharesOutFromSingleTokenIn(tokenIn)
+		if err != nil {
+			return sdk.ZeroInt(), sdk.Coins{}, err
+		}
+		if singleAssetShares.IsZero() {
+			notDeposited = notDeposited.Add(tokenIn)
+			continue
+		}
+		if err = pool.incrementBalances(singleAssetShares, sdk.NewCoins(tokenIn)); err != nil {
+			return sdk.ZeroInt(), sdk.Coins{}, err
+		}
+		numShares = numShares.Add(singleAssetShares)
 	}
 
-	otherDenom := pool.PoolAssets[1-index].Token.Denom
-	tokenOut, err := pool.CalcOutAmtGivenIn(
-		/*tokenIn=*/ swapToken,
-		/*tokenOutDenom=*/ otherDenom,
-		/*noFee=*/ true,
-	)
+	return numShares, notDeposited, nil
+}
 
-	if err != nil {
-		return
-	}
+/*
+Joins the pool for an exact number of LP shares: the tokens are deposited in
+the pool's proportions, rounded up, and the pool balances are updated.
 
-	err = pool.ApplySwap(swapToken, tokenOut)
+args:
+  - numShares: the number of LP shares to mint
 
+ret:
+  - tokensIn: the tokens deposited into the pool
+  - err: error if any
+*/
+func (pool *Pool) JoinPoolExactShares(numShares sdk.Int) (
+	tokensIn sdk.Coins, err error,
+) {
+	tokensIn, err = pool.TokensInFromPoolSharesOut(numShares)
 	if err != nil {
-		return
+		return sdk.Coins{}, err
 	}
 
-	tokensIn = sdk.Coins{
-		{
-			Denom:  swapToken.Denom,
-			Amount: tokensIn.AmountOfNoDenomValidation(swapToken.Denom).Sub(swapToken.Amount),
-		},
-		{
-			Denom:  otherDenom,
-			Amount: tokensIn.AmountOfNoDenomValidation(otherDenom).Add(tokenOut.Amount),
-		},
-	}.Sort()
-	return pool.AddTokensToPool(tokensIn)
+	if err = pool.incrementBalances(numShares, tokensIn); err != nil {
+		return sdk.Coins{}, err
+	}
+	return tokensIn, nil
 }
 
 /*
@@ -215,6 +229,83 @@ func (pool *Pool) ExitPool(exitingShares sdk.Int) (
 	return exitedCoins, nil
 }
 
+/*
+Exits a pool in a single asset: the shares are redeemed for tokens of
+tokenOutDenom only, following the weighted invariant, and the pool is modified.
+Accounts for the swap fee and the exit fee, if any, on the pool.
+
+args:
+  - exitingShares: the number of pool shares to exit from the pool
+  - tokenOutDenom: the denom of the withdrawn tokens
+*/
+func (pool *Pool) ExitPoolToSingleAsset(exitingShares sdk.Int, tokenOutDenom string) (
+	exitedCoins sdk.Coins, err error,
+) {
+	tokenOut, err := pool.TokenOutFromPoolSharesInSingleAsset(exitingShares, tokenOutDenom)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+	if !tokenOut.IsPositive() {
+		return sdk.Coins{}, errors.New("not enough pool shares to withdraw")
+	}
+
+	if err = pool.SubtractPoolAssetBalance(tokenOut.Denom, tokenOut.Amount); err != nil {
+		return sdk.Coins{}, err
+	}
+
+	pool.TotalShares = sdk.NewCoin(pool.TotalShares.Denom, pool.TotalShares.Amount.Sub(exitingShares))
+	return sdk.NewCoins(tokenOut), nil
+}
+
+/*
+Exits the pool for an exact amount of tokens, burning the LP shares computed by
+PoolSharesInFromTokensOut, and modifies the pool. The fees stay in the pool.
+
+args:
+  - tokensOut: the tokens to withdraw, any subset of the pool's assets
+
+ret:
+  - numSharesIn: the number of LP shares to burn
+  - err: error if any
+*/
+func (pool *Pool) ExitPoolExactTokens(tokensOut sdk.Coins) (
+	numSharesIn sdk.Int, err error,
+) {
+	numSharesIn, err = pool.PoolSharesInFromTokensOut(tokensOut)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+	if err = pool.decrementBalances(numSharesIn, tokensOut); err != nil {
+		return sdk.ZeroInt(), err
+	}
+	return numSharesIn, nil
+}
+
+/*
+Withdraws tokens from the pool and burns the LP shares paying for them. All
+the shares can't be burned, since the pool would keep some tokens.
+
+args:
+  - numShares: the number of LP shares to burn
+  - tokensOut: the tokens withdrawn from the pool
+*/
+func (pool *Pool) decrementBalances(numShares sdk.Int, tokensOut sdk.Coins) (err error) {
+	if !numShares.IsPositive() {
+		return errors.New("not enough tokens out to burn any pool share")
+	}
+	if numShares.GTE(pool.TotalShares.Amount) {
+		return errors.New("num shares in must be lower than the pool's total shares")
+	}
+
+	for _, tokenOut := range tokensOut {
+		if err = pool.SubtractPoolAssetBalance(tokenOut.Denom, tokenOut.Amount); err != nil {
+			return err
+		}
+	}
+	pool.TotalShares = sdk.NewCoin(pool.TotalShares.Denom, pool.TotalShares.Amount.Sub(numShares))
+	return nil
+}
+
 /*
 Updates the pool's asset liquidity using the provided tokens.
 
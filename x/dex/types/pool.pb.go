@@ -9,16 +9,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,10 +30,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// The invariant a pool trades along.
+type PoolType int32
+
+const (
+	// Constant weighted product: x^w_x * y^w_y = k.
+	PoolType_BALANCER PoolType = 0
+	// Curve's StableSwap invariant: flat around the balanced point, for assets
+	// pegged to each other. The asset weights are ignored.
+	PoolType_STABLESWAP PoolType = 1
+)
+
+var PoolType_name = map[int32]string{
+	0: "BALANCER",
+	1: "STABLESWAP",
+}
+
+var PoolType_value = map[string]int32{
+	"BALANCER":   0,
+	"STABLESWAP": 1,
+}
+
+func (x PoolType) String() string {
+	return proto.EnumName(PoolType_name, int32(x))
+}
+
+func (PoolType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a6713224330b59ad, []int{0}
+}
+
 // Configuration parameters for the pool.
 type PoolParams struct {
-	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
-	ExitFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exit_fee,json=exitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exit_fee" yaml:"exit_fee"`
+	SwapFee  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
+	ExitFee  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exit_fee,json=exitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exit_fee" yaml:"exit_fee"`
+	PoolType PoolType                               `protobuf:"varint,3,opt,name=pool_type,json=poolType,proto3,enum=nibiru.dex.v1.PoolType" json:"pool_type,omitempty" yaml:"pool_type"`
+	// The amplification of a StableSwap pool. The higher, the flatter the curve
+	// around the balanced point. During a ramp, the target of the ramp.
+	Amplification uint64 `protobuf:"varint,4,opt,name=amplification,proto3" json:"amplification,omitempty" yaml:"amplification"`
 }
 
 func (m *PoolParams) Reset()         { *m = PoolParams{} }
@@ -65,6 +102,83 @@ func (m *PoolParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PoolParams proto.InternalMessageInfo
 
+func (m *PoolParams) GetPoolType() PoolType {
+	if m != nil {
+		return m.PoolType
+	}
+	return PoolType_BALANCER
+}
+
+func (m *PoolParams) GetAmplification() uint64 {
+	if m != nil {
+		return m.Amplification
+	}
+	return 0
+}
+
+// A linear change of the amplification of a StableSwap pool, from
+// initial_amplification at initial_time to the amplification of the pool
+// params at future_time.
+type AmplificationRamp struct {
+	InitialAmplification uint64    `protobuf:"varint,1,opt,name=initial_amplification,json=initialAmplification,proto3" json:"initial_amplification,omitempty" yaml:"initial_amplification"`
+	InitialTime          time.Time `protobuf:"bytes,2,opt,name=initial_time,json=initialTime,proto3,stdtime" json:"initial_time" yaml:"initial_time"`
+	FutureTime           time.Time `protobuf:"bytes,3,opt,name=future_time,json=futureTime,proto3,stdtime" json:"future_time" yaml:"future_time"`
+}
+
+func (m *AmplificationRamp) Reset()         { *m = AmplificationRamp{} }
+func (m *AmplificationRamp) String() string { return proto.CompactTextString(m) }
+func (*AmplificationRamp) ProtoMessage()    {}
+func (*AmplificationRamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6713224330b59ad, []int{1}
+}
+func (m *AmplificationRamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AmplificationRamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AmplificationRamp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AmplificationRamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AmplificationRamp.Merge(m, src)
+}
+func (m *AmplificationRamp) XXX_Size() int {
+	return m.Size()
+}
+func (m *AmplificationRamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_AmplificationRamp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AmplificationRamp proto.InternalMessageInfo
+
+func (m *AmplificationRamp) GetInitialAmplification() uint64 {
+	if m != nil {
+		return m.InitialAmplification
+	}
+	return 0
+}
+
+func (m *AmplificationRamp) GetInitialTime() time.Time {
+	if m != nil {
+		return m.InitialTime
+	}
+	return time.Time{}
+}
+
+func (m *AmplificationRamp) GetFutureTime() time.Time {
+	if m != nil {
+		return m.FutureTime
+	}
+	return time.Time{}
+}
+
 // Which assets the pool contains.
 type PoolAsset struct {
 	// Coins we are talking about,
@@ -78,7 +192,7 @@ func (m *PoolAsset) Reset()         { *m = PoolAsset{} }
 func (m *PoolAsset) String() string { return proto.CompactTextString(m) }
 func (*PoolAsset) ProtoMessage()    {}
 func (*PoolAsset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6713224330b59ad, []int{1}
+	return fileDescriptor_a6713224330b59ad, []int{2}
 }
 func (m *PoolAsset) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -128,13 +242,15 @@ type Pool struct {
 	TotalWeight github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=total_weight,json=totalWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_weight" yaml:"total_weight"`
 	// sum of all LP tokens sent out
 	TotalShares types.Coin `protobuf:"bytes,6,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
+	// the ongoing amplification ramp of a StableSwap pool, if any
+	AmplificationRamp *AmplificationRamp `protobuf:"bytes,7,opt,name=amplification_ramp,json=amplificationRamp,proto3" json:"amplification_ramp,omitempty" yaml:"amplification_ramp"`
 }
 
 func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6713224330b59ad, []int{2}
+	return fileDescriptor_a6713224330b59ad, []int{3}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -164,7 +280,9 @@ func (m *Pool) XXX_DiscardUnknown() {
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("nibiru.dex.v1.PoolType", PoolType_name, PoolType_value)
 	proto.RegisterType((*PoolParams)(nil), "nibiru.dex.v1.PoolParams")
+	proto.RegisterType((*AmplificationRamp)(nil), "nibiru.dex.v1.AmplificationRamp")
 	proto.RegisterType((*PoolAsset)(nil), "nibiru.dex.v1.PoolAsset")
 	proto.RegisterType((*Pool)(nil), "nibiru.dex.v1.Pool")
 }
@@ -172,40 +290,56 @@ func init() {
 func init() { proto.RegisterFile("dex/v1/pool.proto", fileDescriptor_a6713224330b59ad) }
 
 var fileDescriptor_a6713224330b59ad = []byte{
-	// 525 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xcf, 0x6a, 0xdb, 0x40,
-	0x10, 0xc6, 0xa5, 0xd8, 0xb1, 0x9b, 0x75, 0x92, 0xd2, 0x6d, 0x0e, 0x8a, 0x0b, 0x52, 0xd8, 0x43,
-	0x49, 0xa1, 0xd5, 0xe2, 0xf4, 0x96, 0x4b, 0x89, 0xdd, 0x04, 0x7a, 0x29, 0x41, 0xa5, 0x0d, 0x2d,
-	0x05, 0xb3, 0xb2, 0xb6, 0xd6, 0x12, 0x5b, 0x2b, 0xb4, 0x1b, 0xc7, 0x79, 0x83, 0x1e, 0xfb, 0x08,
-	0xbd, 0xf7, 0x1d, 0x7a, 0xf6, 0x31, 0xc7, 0xd2, 0x83, 0x28, 0xf6, 0x1b, 0xf8, 0x09, 0xca, 0xfe,
-	0x11, 0x4e, 0x20, 0x50, 0x42, 0x4f, 0xda, 0x61, 0x76, 0x7e, 0x9a, 0xef, 0x9b, 0x59, 0xf0, 0x28,
-	0xa1, 0x53, 0x3c, 0xe9, 0xe0, 0x9c, 0xf3, 0x51, 0x98, 0x17, 0x5c, 0x72, 0xb8, 0x95, 0xb1, 0x98,
-	0x15, 0x17, 0x61, 0x42, 0xa7, 0xe1, 0xa4, 0xd3, 0xde, 0x19, 0xf2, 0x21, 0xd7, 0x19, 0xac, 0x4e,
-	0xe6, 0x52, 0xdb, 0x1f, 0x70, 0x31, 0xe6, 0x02, 0xc7, 0x44, 0x50, 0x3c, 0xe9, 0xc4, 0x54, 0x92,
-	0x0e, 0x1e, 0x70, 0x96, 0xd9, 0xfc, 0xae, 0xc9, 0xf7, 0x4d, 0xa1, 0x09, 0x4c, 0x0a, 0xcd, 0x5c,
-	0x00, 0x4e, 0x39, 0x1f, 0x9d, 0x92, 0x82, 0x8c, 0x05, 0xfc, 0x0c, 0x1e, 0x88, 0x4b, 0x92, 0xf7,
-	0xbf, 0x50, 0xea, 0xb9, 0x7b, 0xee, 0xfe, 0x46, 0xf7, 0x68, 0x56, 0x06, 0xce, 0xef, 0x32, 0x78,
-	0x3a, 0x64, 0x32, 0xbd, 0x88, 0xc3, 0x01, 0x1f, 0x5b, 0x82, 0xfd, 0xbc, 0x10, 0xc9, 0x39, 0x96,
-	0x57, 0x39, 0x15, 0xe1, 0x6b, 0x3a, 0x58, 0x96, 0xc1, 0xc3, 0x2b, 0x32, 0x1e, 0x1d, 0xa2, 0x8a,
-	0x83, 0xa2, 0xa6, 0x3a, 0x9e, 0x50, 0xaa, 0xe8, 0x74, 0xca, 0xa4, 0xa6, 0xaf, 0xfd, 0x1f, 0xbd,
-	0xe2, 0xa0, 0xa8, 0xa9, 0x8e, 0x27, 0x94, 0xa2, 0x1f, 0x2e, 0xd8, 0x50, 0x52, 0x8e, 0x84, 0xa0,
-	0x12, 0x1e, 0x83, 0x75, 0xc9, 0xcf, 0x69, 0xa6, 0x65, 0xb4, 0x0e, 0x76, 0x43, 0x2b, 0x5b, 0x79,
-	0x14, 0x5a, 0x8f, 0xc2, 0x1e, 0x67, 0x59, 0x77, 0x47, 0xf5, 0xb0, 0x2c, 0x83, 0x4d, 0x43, 0xd6,
-	0x55, 0x28, 0x32, 0xd5, 0xf0, 0x0c, 0x34, 0x2e, 0x29, 0x1b, 0xa6, 0xd2, 0x36, 0xfc, 0xea, 0x1e,
-	0x0d, 0xbf, 0xc9, 0xe4, 0xb2, 0x0c, 0xb6, 0x0c, 0xd6, 0x50, 0x50, 0x64, 0x71, 0xe8, 0x67, 0x0d,
-	0xd4, 0x55, 0xb7, 0x70, 0x1b, 0xac, 0xb1, 0x44, 0x77, 0x59, 0x8f, 0xd6, 0x58, 0x02, 0x9f, 0x83,
-	0x26, 0x49, 0x92, 0x82, 0x0a, 0x61, 0x7f, 0x09, 0x97, 0x65, 0xb0, 0x6d, 0x20, 0x36, 0x81, 0xa2,
-	0xea, 0x0a, 0xfc, 0x00, 0x5a, 0x6a, 0x5b, 0xfa, 0xb9, 0x9e, 0x9f, 0x57, 0xb3, 0x62, 0x6f, 0x6d,
-	0x4d, 0xb8, 0x1a, 0x70, 0xb7, 0x6d, 0xc5, 0x42, 0x03, 0xbc, 0x51, 0x8b, 0x22, 0x90, 0xaf, 0x16,
-	0xe1, 0xbd, 0xe5, 0x12, 0x65, 0xa6, 0xf0, 0xea, 0x7b, 0xb5, 0xfd, 0xd6, 0x81, 0x77, 0x07, 0x57,
-	0xbb, 0x7d, 0x27, 0xd6, 0x94, 0x5a, 0xac, 0xbe, 0x26, 0x60, 0x0a, 0x36, 0x25, 0x97, 0x64, 0xd4,
-	0xb7, 0xa6, 0xae, 0x6b, 0x85, 0xc7, 0xf7, 0x36, 0xf5, 0x71, 0x35, 0xab, 0x15, 0x0b, 0x45, 0x2d,
-	0x1d, 0x9e, 0xe9, 0x08, 0x7e, 0xac, 0xfe, 0x24, 0x52, 0x52, 0x50, 0xe1, 0x35, 0xfe, 0xb5, 0x06,
-	0x4f, 0xac, 0x84, 0x5b, 0x68, 0x53, 0x5c, 0xa1, 0xdf, 0xe9, 0xe8, 0xb0, 0xfe, 0xf5, 0x7b, 0xe0,
-	0x74, 0x7b, 0xb3, 0xb9, 0xef, 0x5e, 0xcf, 0x7d, 0xf7, 0xcf, 0xdc, 0x77, 0xbf, 0x2d, 0x7c, 0xe7,
-	0x7a, 0xe1, 0x3b, 0xbf, 0x16, 0xbe, 0xf3, 0xe9, 0xd9, 0x0d, 0x19, 0x6f, 0xb5, 0x61, 0xbd, 0x94,
-	0xb0, 0x0c, 0x1b, 0xf3, 0xf0, 0x14, 0xab, 0x67, 0xae, 0xd5, 0xc4, 0x0d, 0xfd, 0x0a, 0x5f, 0xfe,
-	0x0d, 0x00, 0x00, 0xff, 0xff, 0x2b, 0x5c, 0xbb, 0x89, 0xfa, 0x03, 0x00, 0x00,
+	// 783 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0xc7, 0xe3, 0x34, 0xdb, 0xa6, 0x93, 0x34, 0xb4, 0xb3, 0x41, 0x38, 0x01, 0xec, 0x68, 0x0e,
+	0x28, 0x20, 0xb0, 0x95, 0x70, 0xdb, 0x03, 0x28, 0x0e, 0x41, 0x02, 0xad, 0x56, 0x95, 0xdb, 0xa5,
+	0xe2, 0x45, 0x44, 0x93, 0x64, 0x92, 0x8c, 0x36, 0xf6, 0x58, 0x99, 0x49, 0x37, 0xfd, 0x06, 0x1c,
+	0xf7, 0x23, 0x70, 0xe3, 0x80, 0xc4, 0xe7, 0xd8, 0xe3, 0x1e, 0x38, 0x20, 0x0e, 0x06, 0xb5, 0xdf,
+	0xc0, 0x9f, 0x00, 0xcd, 0x8b, 0xd9, 0x78, 0x89, 0xb4, 0x5a, 0x71, 0xaa, 0x67, 0x9e, 0xe7, 0xff,
+	0x7b, 0xec, 0xff, 0xf3, 0x3c, 0x0d, 0x38, 0x9b, 0x91, 0xad, 0x7f, 0xdd, 0xf3, 0x13, 0xc6, 0x56,
+	0x5e, 0xb2, 0x66, 0x82, 0xc1, 0x93, 0x98, 0x4e, 0xe8, 0x7a, 0xe3, 0xcd, 0xc8, 0xd6, 0xbb, 0xee,
+	0xb5, 0x9b, 0x0b, 0xb6, 0x60, 0x2a, 0xe2, 0xcb, 0x27, 0x9d, 0xd4, 0x76, 0xa6, 0x8c, 0x47, 0x8c,
+	0xfb, 0x13, 0xcc, 0x89, 0x7f, 0xdd, 0x9b, 0x10, 0x81, 0x7b, 0xfe, 0x94, 0xd1, 0xd8, 0xc4, 0x5b,
+	0x3a, 0x3e, 0xd6, 0x42, 0x7d, 0x30, 0x21, 0x77, 0xc1, 0xd8, 0x62, 0x45, 0x7c, 0x75, 0x9a, 0x6c,
+	0xe6, 0xbe, 0xa0, 0x11, 0xe1, 0x02, 0x47, 0x89, 0x4e, 0x40, 0xbf, 0x97, 0x01, 0x38, 0x67, 0x6c,
+	0x75, 0x8e, 0xd7, 0x38, 0xe2, 0xf0, 0x07, 0x50, 0xe5, 0x4f, 0x71, 0x32, 0x9e, 0x13, 0x62, 0x5b,
+	0x1d, 0xab, 0x7b, 0x1c, 0x0c, 0x9e, 0xa7, 0x6e, 0xe9, 0xcf, 0xd4, 0xfd, 0x60, 0x41, 0xc5, 0x72,
+	0x33, 0xf1, 0xa6, 0x2c, 0x32, 0x25, 0xcc, 0x9f, 0x4f, 0xf8, 0xec, 0x89, 0x2f, 0x6e, 0x12, 0xc2,
+	0xbd, 0x2f, 0xc8, 0x34, 0x4b, 0xdd, 0xb7, 0x6e, 0x70, 0xb4, 0x7a, 0x80, 0x72, 0x0e, 0x0a, 0x8f,
+	0xe4, 0xe3, 0x97, 0x84, 0x48, 0x3a, 0xd9, 0x52, 0xa1, 0xe8, 0xe5, 0xff, 0x47, 0xcf, 0x39, 0x28,
+	0x3c, 0x92, 0x8f, 0x92, 0xfe, 0x35, 0x38, 0x96, 0xce, 0x8e, 0x65, 0xb6, 0x7d, 0xd0, 0xb1, 0xba,
+	0x8d, 0xfe, 0x3b, 0x5e, 0xc1, 0x5f, 0x4f, 0x7e, 0xe9, 0xe5, 0x4d, 0x42, 0x82, 0x66, 0x96, 0xba,
+	0xa7, 0x9a, 0xf4, 0xaf, 0x06, 0x85, 0xd5, 0xc4, 0xc4, 0xe1, 0x67, 0xe0, 0x04, 0x47, 0xc9, 0x8a,
+	0xce, 0xe9, 0x14, 0x0b, 0xca, 0x62, 0xbb, 0xd2, 0xb1, 0xba, 0x95, 0xc0, 0xce, 0x52, 0xb7, 0xa9,
+	0x65, 0x85, 0x30, 0x0a, 0x8b, 0xe9, 0xe8, 0x97, 0x32, 0x38, 0x1b, 0xec, 0xde, 0x84, 0x38, 0x4a,
+	0xe0, 0x63, 0xf0, 0x36, 0x8d, 0xa9, 0xa0, 0x78, 0x35, 0x2e, 0xd2, 0x2d, 0x45, 0xef, 0x64, 0xa9,
+	0xfb, 0x9e, 0xa6, 0xef, 0x4d, 0x43, 0x61, 0xd3, 0xdc, 0x17, 0xd0, 0xf0, 0x47, 0x50, 0xcf, 0xf3,
+	0x65, 0x7b, 0x95, 0xb5, 0xb5, 0x7e, 0xdb, 0xd3, 0xbd, 0xf7, 0xf2, 0xde, 0x7b, 0x97, 0x79, 0xef,
+	0x03, 0x57, 0xda, 0x9e, 0xa5, 0xee, 0xfd, 0x62, 0x35, 0xa9, 0x46, 0xcf, 0xfe, 0x72, 0xad, 0xb0,
+	0x66, 0xae, 0xa4, 0x04, 0x7e, 0x0f, 0x6a, 0xf3, 0x8d, 0xd8, 0xac, 0x89, 0xc6, 0x1f, 0xbc, 0x16,
+	0xef, 0x18, 0x3c, 0xd4, 0xf8, 0x1d, 0xb1, 0xa6, 0x03, 0x7d, 0x23, 0x05, 0xe8, 0x57, 0x0b, 0x1c,
+	0xcb, 0xb6, 0x0c, 0x38, 0x27, 0x02, 0x8e, 0xc0, 0x3d, 0xc1, 0x9e, 0x10, 0xed, 0x48, 0xad, 0xdf,
+	0xf2, 0xcc, 0x34, 0xcb, 0xd1, 0xf7, 0xcc, 0xe8, 0x7b, 0x43, 0x46, 0xe3, 0xa0, 0x69, 0x6a, 0xd4,
+	0x75, 0x0d, 0xa5, 0x42, 0xa1, 0x56, 0xc3, 0x2b, 0x70, 0xf8, 0x94, 0xd0, 0xc5, 0x52, 0x98, 0x31,
+	0xfb, 0xfc, 0x0d, 0xc6, 0xec, 0xab, 0x58, 0x64, 0xa9, 0x7b, 0xa2, 0xb1, 0x9a, 0x82, 0x42, 0x83,
+	0x43, 0xbf, 0x55, 0x40, 0x45, 0xbe, 0x2d, 0x6c, 0x80, 0x32, 0x9d, 0xe9, 0xbe, 0x85, 0x65, 0x3a,
+	0x83, 0x1f, 0x83, 0x23, 0x3c, 0x9b, 0xad, 0x09, 0xe7, 0xa6, 0x24, 0xcc, 0x52, 0xb7, 0x61, 0x46,
+	0x45, 0x07, 0x50, 0x98, 0xa7, 0xc0, 0x6f, 0x40, 0x4d, 0x8d, 0x5d, 0xa2, 0xb6, 0xce, 0x38, 0xda,
+	0xda, 0x33, 0xac, 0x7a, 0x2d, 0x83, 0x76, 0xd1, 0xd0, 0x1d, 0x2d, 0x0a, 0x41, 0xf2, 0x72, 0x7d,
+	0x1f, 0x1b, 0x2e, 0x96, 0x66, 0x72, 0xbb, 0xd2, 0x39, 0xe8, 0xd6, 0xfa, 0xf6, 0x1e, 0xae, 0x72,
+	0x7b, 0x2f, 0x56, 0x4b, 0x0d, 0x56, 0xa5, 0x71, 0xb8, 0x04, 0x75, 0xc1, 0x04, 0x5e, 0x8d, 0x8d,
+	0xa9, 0xf7, 0xd4, 0x17, 0x8e, 0xde, 0xd8, 0xd4, 0xfb, 0x79, 0xaf, 0x5e, 0xb2, 0x50, 0x58, 0x53,
+	0xc7, 0x2b, 0x75, 0x82, 0xdf, 0xe6, 0x95, 0xf8, 0x12, 0xaf, 0x09, 0xb7, 0x0f, 0x5f, 0x37, 0x06,
+	0xef, 0x16, 0x27, 0x79, 0x57, 0x9c, 0xa3, 0x2f, 0xd4, 0x09, 0xc6, 0x00, 0x16, 0xb6, 0x69, 0xbc,
+	0xc6, 0x51, 0x62, 0x1f, 0xa9, 0x02, 0x9d, 0x57, 0x2c, 0xfa, 0xcf, 0xea, 0x06, 0xef, 0x67, 0xa9,
+	0xdb, 0xda, 0xb3, 0xf9, 0x8a, 0x82, 0xc2, 0x33, 0xfc, 0xaa, 0xe2, 0x41, 0xe5, 0xa7, 0x9f, 0xdd,
+	0xd2, 0x47, 0x5d, 0x50, 0xcd, 0xff, 0xe9, 0xc0, 0x3a, 0xa8, 0x06, 0x83, 0x87, 0x83, 0x47, 0xc3,
+	0x51, 0x78, 0x5a, 0x82, 0x0d, 0x00, 0x2e, 0x2e, 0x07, 0xc1, 0xc3, 0xd1, 0xc5, 0xd5, 0xe0, 0xfc,
+	0xd4, 0x0a, 0x86, 0xcf, 0x6f, 0x1d, 0xeb, 0xc5, 0xad, 0x63, 0xfd, 0x7d, 0xeb, 0x58, 0xcf, 0xee,
+	0x9c, 0xd2, 0x8b, 0x3b, 0xa7, 0xf4, 0xc7, 0x9d, 0x53, 0xfa, 0xee, 0xc3, 0x1d, 0x83, 0x1f, 0xa9,
+	0xf7, 0x1c, 0x2e, 0x31, 0x8d, 0x7d, 0xfd, 0xce, 0xfe, 0xd6, 0x97, 0xbf, 0x2b, 0xca, 0xe7, 0xc9,
+	0xa1, 0xda, 0xc6, 0x4f, 0xff, 0x19, 0x00, 0x34, 0x87, 0xb9, 0x26, 0x6b, 0x06, 0x00, 0x00,
 }
 
 func (m *PoolParams) Marshal() (dAtA []byte, err error) {
@@ -228,6 +362,16 @@ func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Amplification != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.Amplification))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PoolType != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.PoolType))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.ExitFee.Size()
 		i -= size
@@ -251,6 +395,50 @@ func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AmplificationRamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AmplificationRamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AmplificationRamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FutureTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FutureTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPool(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.InitialTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.InitialTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintPool(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.InitialAmplification != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.InitialAmplification))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PoolAsset) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -314,6 +502,18 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AmplificationRamp != nil {
+		{
+			size, err := m.AmplificationRamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	{
 		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -394,6 +594,28 @@ func (m *PoolParams) Size() (n int) {
 	n += 1 + l + sovPool(uint64(l))
 	l = m.ExitFee.Size()
 	n += 1 + l + sovPool(uint64(l))
+	if m.PoolType != 0 {
+		n += 1 + sovPool(uint64(m.PoolType))
+	}
+	if m.Amplification != 0 {
+		n += 1 + sovPool(uint64(m.Amplification))
+	}
+	return n
+}
+
+func (m *AmplificationRamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InitialAmplification != 0 {
+		n += 1 + sovPool(uint64(m.InitialAmplification))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.InitialTime)
+	n += 1 + l + sovPool(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.FutureTime)
+	n += 1 + l + sovPool(uint64(l))
 	return n
 }
 
@@ -435,6 +657,10 @@ func (m *Pool) Size() (n int) {
 	n += 1 + l + sovPool(uint64(l))
 	l = m.TotalShares.Size()
 	n += 1 + l + sovPool(uint64(l))
+	if m.AmplificationRamp != nil {
+		l = m.AmplificationRamp.Size()
+		n += 1 + l + sovPool(uint64(l))
+	}
 	return n
 }
 
@@ -541,6 +767,179 @@ func (m *PoolParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
+			}
+			m.PoolType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolType |= PoolType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			m.Amplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AmplificationRamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AmplificationRamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AmplificationRamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialAmplification", wireType)
+			}
+			m.InitialAmplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialAmplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.InitialTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.FutureTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPool(dAtA[iNdEx:])
@@ -893,6 +1292,42 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmplificationRamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AmplificationRamp == nil {
+				m.AmplificationRamp = &AmplificationRamp{}
+			}
+			if err := m.AmplificationRamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPool(dAtA[iNdEx:])
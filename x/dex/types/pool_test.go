@@ -287,14 +287,12 @@ func TestJoinPoolAllTokens(t *testing.T) {
 				sdk.NewInt64Coin("aaa", 10),
 				sdk.NewInt64Coin("bbb", 10),
 			),
-			expectedNumShares: sdk.NewInt(6),
-			expectedRemCoins: sdk.NewCoins(
-				sdk.NewInt64Coin("aaa", 1),
-			),
+			expectedNumShares: sdk.NewInt(7),
+			expectedRemCoins:  sdk.NewCoins(),
 			expectedPool: Pool{
 				PoolAssets: []PoolAsset{
 					{
-						Token:  sdk.NewInt64Coin("aaa", 109),
+						Token:  sdk.NewInt64Coin("aaa", 110),
 						Weight: sdk.NewInt(1 << 30),
 					},
 					{
@@ -302,7 +300,7 @@ func TestJoinPoolAllTokens(t *testing.T) {
 						Weight: sdk.NewInt(1 << 30),
 					},
 				},
-				TotalShares: sdk.NewInt64Coin("nibiru/pool/1", 106),
+				TotalShares: sdk.NewInt64Coin("nibiru/pool/1", 107),
 				TotalWeight: sdk.NewInt(2 << 30),
 				PoolParams:  PoolParams{SwapFee: sdk.ZeroDec()},
 			},
@@ -328,14 +326,12 @@ func TestJoinPoolAllTokens(t *testing.T) {
 				sdk.NewInt64Coin("aaa", 4859), // 0.138885 % of pool
 				sdk.NewInt64Coin("bbb", 1345), // 0.09580147 % of pool
 			),
-			expectedNumShares: sdk.NewInt(1172),
-			expectedRemCoins: sdk.NewCoins(
-				sdk.NewInt64Coin("aaa", 3),
-			),
+			expectedNumShares: sdk.NewInt(1173),
+			expectedRemCoins:  sdk.NewCoins(),
 			expectedPool: Pool{
 				PoolAssets: []PoolAsset{
 					{
-						Token:  sdk.NewInt64Coin("aaa", 3_503_435),
+						Token:  sdk.NewInt64Coin("aaa", 3_503_438),
 						Weight: sdk.NewInt(1 << 30),
 					},
 					{
@@ -343,7 +339,7 @@ func TestJoinPoolAllTokens(t *testing.T) {
 						Weight: sdk.NewInt(1 << 30),
 					},
 				},
-				TotalShares: sdk.NewInt64Coin("nibiru/pool/1", 1_001_172),
+				TotalShares: sdk.NewInt64Coin("nibiru/pool/1", 1_001_173),
 				TotalWeight: sdk.NewInt(2 << 30),
 				PoolParams:  PoolParams{SwapFee: sdk.ZeroDec()},
 			},
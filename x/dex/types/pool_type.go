@@ -0,0 +1,140 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/*
+PoolI is the AMM of a pool type. The keeper, the queries and the estimates only
+go through it, so that they work for every pool type. The methods modifying the
+pool modify the Pool it was created from.
+*/
+type PoolI interface {
+	CalcOutAmtGivenIn(tokenIn sdk.Coin, tokenOutDenom string, noFee bool) (tokenOut sdk.Coin, err error)
+	CalcInAmtGivenOut(tokenOut sdk.Coin, tokenInDenom string) (tokenIn sdk.Coin, err error)
+	CalcSpotPrice(tokenIn, tokenOut string) (sdk.Dec, error)
+	ApplySwap(tokenIn sdk.Coin, tokenOut sdk.Coin) (err error)
+	AddTokensToPool(tokensIn sdk.Coins) (numShares sdk.Int, remCoins sdk.Coins, err error)
+	AddAllTokensToPool(tokensIn sdk.Coins) (numShares sdk.Int, remCoins sdk.Coins, err error)
+	JoinPoolExactShares(numShares sdk.Int) (tokensIn sdk.Coins, err error)
+	ExitPool(exitingShares sdk.Int) (exitedCoins sdk.Coins, err error)
+	ExitPoolToSingleAsset(exitingShares sdk.Int, tokenOutDenom string) (exitedCoins sdk.Coins, err error)
+	ExitPoolExactTokens(tokensOut sdk.Coins) (numSharesIn sdk.Int, err error)
+}
+
+var (
+	_ PoolI = BalancerPool{}
+	_ PoolI = StableSwapPool{}
+)
+
+// BalancerPool is the AMM of a constant weighted product pool, whose math are
+// the methods of Pool.
+type BalancerPool struct {
+	*Pool
+}
+
+/*
+Returns the AMM of the pool type, at the block time.
+
+args:
+  - blockTime: the time of the block, for the amplification ramp of StableSwap pools
+
+ret:
+  - the AMM, modifying this pool
+*/
+func (pool *Pool) AsPoolI(blockTime time.Time) PoolI {
+	if pool.PoolParams.PoolType == PoolType_STABLESWAP {
+		return StableSwapPool{
+			Pool:          pool,
+			Amplification: pool.AmplificationAt(blockTime),
+		}
+	}
+	return BalancerPool{Pool: pool}
+}
+
+/*
+Returns the amplification of a StableSwap pool at a time. During a ramp, the
+amplification moves linearly from the initial amplification of the ramp to
+the amplification of the pool params.
+*/
+func (pool Pool) AmplificationAt(t time.Time) uint64 {
+	ramp := pool.AmplificationRamp
+	futureAmplification := pool.PoolParams.Amplification
+	if ramp == nil || !t.Before(ramp.FutureTime) {
+		return futureAmplification
+	}
+	if !t.After(ramp.InitialTime) {
+		return ramp.InitialAmplification
+	}
+
+	elapsed := sdk.NewInt(int64(t.Sub(ramp.InitialTime)))
+	duration := sdk.NewInt(int64(ramp.FutureTime.Sub(ramp.InitialTime)))
+	initial := sdk.NewIntFromUint64(ramp.InitialAmplification)
+	future := sdk.NewIntFromUint64(futureAmplification)
+	// initial + (future - initial) * elapsed / duration
+	return initial.Add(future.Sub(initial).Mul(elapsed).Quo(duration)).Uint64()
+}
+
+/*
+Starts a linear change of the amplification of a StableSwap pool, from its
+current amplification to futureAmplification at futureTime.
+
+args:
+  - now: the block time
+  - futureAmplification: the amplification at the end of the ramp
+  - futureTime: the end of the ramp
+*/
+func (pool *Pool) RampAmplification(now time.Time, futureAmplification uint64, futureTime time.Time) error {
+	if pool.PoolParams.PoolType != PoolType_STABLESWAP {
+		return ErrInvalidPoolType.Wrapf("pool %d is not a stableswap pool", pool.Id)
+	}
+	if err := ValidateAmplification(futureAmplification); err != nil {
+		return err
+	}
+	if futureTime.Before(now.Add(MinAmplificationRampDuration)) {
+		return ErrInvalidAmplification.Wrapf("the ramp must last at least %s", MinAmplificationRampDuration)
+	}
+
+	initialAmplification := pool.AmplificationAt(now)
+	if futureAmplification > initialAmplification*MaxAmplificationChange ||
+		futureAmplification*MaxAmplificationChange < initialAmplification {
+		return ErrInvalidAmplification.Wrapf(
+			"the amplification can change by a factor of at most %d, from %d to %d",
+			MaxAmplificationChange, initialAmplification, futureAmplification)
+	}
+
+	pool.AmplificationRamp = &AmplificationRamp{
+		InitialAmplification: initialAmplification,
+		InitialTime:          now,
+		FutureTime:           futureTime,
+	}
+	pool.PoolParams.Amplification = futureAmplification
+	return nil
+}
+
+// ValidateAmplification checks the amplification of a StableSwap pool is
+// within [MinAmplification, MaxAmplification].
+func ValidateAmplification(amplification uint64) error {
+	if amplification < MinAmplification || amplification > MaxAmplification {
+		return ErrInvalidAmplification.Wrapf(
+			"amplification must be within [%d, %d], got %d", MinAmplification, MaxAmplification, amplification)
+	}
+	return nil
+}
+
+// ValidatePoolType checks the pool type and its amplification.
+func (params PoolParams) ValidatePoolType() error {
+	switch params.PoolType {
+	case PoolType_BALANCER:
+		if params.Amplification != 0 {
+			return ErrInvalidAmplification.Wrap("balancer pools have no amplification")
+		}
+		return nil
+	case PoolType_STABLESWAP:
+		return ValidateAmplification(params.Amplification)
+	default:
+		return ErrInvalidPoolType.Wrapf("unknown pool type %d", params.PoolType)
+	}
+}
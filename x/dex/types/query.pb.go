@@ -12,19 +12,23 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -919,6 +923,122 @@ func (m *QuerySpotPriceResponse) GetSpotPrice() string {
 	return ""
 }
 
+// Returns the average amount of quote_denom paid for 1 base_denom between
+// start_time and end_time, weighted by how long each price lasted.
+type QueryArithmeticTwapRequest struct {
+	PoolId     uint64    `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	BaseDenom  string    `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	QuoteDenom string    `protobuf:"bytes,3,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	StartTime  time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// if unset, the block time
+	EndTime time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *QueryArithmeticTwapRequest) Reset()         { *m = QueryArithmeticTwapRequest{} }
+func (m *QueryArithmeticTwapRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryArithmeticTwapRequest) ProtoMessage()    {}
+func (*QueryArithmeticTwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ba1e1ef24357ddf, []int{20}
+}
+func (m *QueryArithmeticTwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryArithmeticTwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryArithmeticTwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryArithmeticTwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArithmeticTwapRequest.Merge(m, src)
+}
+func (m *QueryArithmeticTwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryArithmeticTwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArithmeticTwapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryArithmeticTwapRequest proto.InternalMessageInfo
+
+func (m *QueryArithmeticTwapRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *QueryArithmeticTwapRequest) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *QueryArithmeticTwapRequest) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *QueryArithmeticTwapRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryArithmeticTwapRequest) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+type QueryArithmeticTwapResponse struct {
+	ArithmeticTwap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=arithmetic_twap,json=arithmeticTwap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"arithmetic_twap"`
+}
+
+func (m *QueryArithmeticTwapResponse) Reset()         { *m = QueryArithmeticTwapResponse{} }
+func (m *QueryArithmeticTwapResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryArithmeticTwapResponse) ProtoMessage()    {}
+func (*QueryArithmeticTwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ba1e1ef24357ddf, []int{21}
+}
+func (m *QueryArithmeticTwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryArithmeticTwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryArithmeticTwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryArithmeticTwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArithmeticTwapResponse.Merge(m, src)
+}
+func (m *QueryArithmeticTwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryArithmeticTwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArithmeticTwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryArithmeticTwapResponse proto.InternalMessageInfo
+
 // Given an exact amount of tokens in and a target tokenOutDenom, calculates
 // the expected amount of tokens out received from a swap.
 type QuerySwapExactAmountInRequest struct {
@@ -931,7 +1051,7 @@ func (m *QuerySwapExactAmountInRequest) Reset()         { *m = QuerySwapExactAmo
 func (m *QuerySwapExactAmountInRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountInRequest) ProtoMessage()    {}
 func (*QuerySwapExactAmountInRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4ba1e1ef24357ddf, []int{20}
+	return fileDescriptor_4ba1e1ef24357ddf, []int{22}
 }
 func (m *QuerySwapExactAmountInRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -989,7 +1109,7 @@ func (m *QuerySwapExactAmountInResponse) Reset()         { *m = QuerySwapExactAm
 func (m *QuerySwapExactAmountInResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountInResponse) ProtoMessage()    {}
 func (*QuerySwapExactAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4ba1e1ef24357ddf, []int{21}
+	return fileDescriptor_4ba1e1ef24357ddf, []int{23}
 }
 func (m *QuerySwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1037,7 +1157,7 @@ func (m *QuerySwapExactAmountOutRequest) Reset()         { *m = QuerySwapExactAm
 func (m *QuerySwapExactAmountOutRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountOutRequest) ProtoMessage()    {}
 func (*QuerySwapExactAmountOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4ba1e1ef24357ddf, []int{22}
+	return fileDescriptor_4ba1e1ef24357ddf, []int{24}
 }
 func (m *QuerySwapExactAmountOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1095,7 +1215,7 @@ func (m *QuerySwapExactAmountOutResponse) Reset()         { *m = QuerySwapExactA
 func (m *QuerySwapExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountOutResponse) ProtoMessage()    {}
 func (*QuerySwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4ba1e1ef24357ddf, []int{23}
+	return fileDescriptor_4ba1e1ef24357ddf, []int{25}
 }
 func (m *QuerySwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1131,6 +1251,238 @@ func (m *QuerySwapExactAmountOutResponse) GetTokenIn() types.Coin {
 	return types.Coin{}
 }
 
+// Given an exact amount of tokens in, calculates the expected amount of tokens
+// out of a swap along the routes, or along the best route to token_out_denom.
+type QuerySwapExactAmountInRoutesRequest struct {
+	TokenIn types.Coin  `protobuf:"bytes,1,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	Routes  []SwapRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
+	// the denom out, used when no route is given
+	TokenOutDenom string `protobuf:"bytes,3,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty"`
+}
+
+func (m *QuerySwapExactAmountInRoutesRequest) Reset()         { *m = QuerySwapExactAmountInRoutesRequest{} }
+func (m *QuerySwapExactAmountInRoutesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapExactAmountInRoutesRequest) ProtoMessage()    {}
+func (*QuerySwapExactAmountInRoutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ba1e1ef24357ddf, []int{26}
+}
+func (m *QuerySwapExactAmountInRoutesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapExactAmountInRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapExactAmountInRoutesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapExactAmountInRoutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapExactAmountInRoutesRequest.Merge(m, src)
+}
+func (m *QuerySwapExactAmountInRoutesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapExactAmountInRoutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapExactAmountInRoutesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapExactAmountInRoutesRequest proto.InternalMessageInfo
+
+func (m *QuerySwapExactAmountInRoutesRequest) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *QuerySwapExactAmountInRoutesRequest) GetRoutes() []SwapRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *QuerySwapExactAmountInRoutesRequest) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+type QuerySwapExactAmountInRoutesResponse struct {
+	TokenOut types.Coin `protobuf:"bytes,1,opt,name=token_out,json=tokenOut,proto3" json:"token_out" yaml:"token_out"`
+	// the routes of the request, or the best route found
+	Routes []SwapRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
+}
+
+func (m *QuerySwapExactAmountInRoutesResponse) Reset()         { *m = QuerySwapExactAmountInRoutesResponse{} }
+func (m *QuerySwapExactAmountInRoutesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapExactAmountInRoutesResponse) ProtoMessage()    {}
+func (*QuerySwapExactAmountInRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ba1e1ef24357ddf, []int{27}
+}
+func (m *QuerySwapExactAmountInRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapExactAmountInRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapExactAmountInRoutesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapExactAmountInRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapExactAmountInRoutesResponse.Merge(m, src)
+}
+func (m *QuerySwapExactAmountInRoutesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapExactAmountInRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapExactAmountInRoutesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapExactAmountInRoutesResponse proto.InternalMessageInfo
+
+func (m *QuerySwapExactAmountInRoutesResponse) GetTokenOut() types.Coin {
+	if m != nil {
+		return m.TokenOut
+	}
+	return types.Coin{}
+}
+
+func (m *QuerySwapExactAmountInRoutesResponse) GetRoutes() []SwapRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+// Given an exact amount of tokens out, calculates the expected amount of tokens
+// in required by a swap along the routes, or along the best route from
+// token_in_denom.
+type QuerySwapExactAmountOutRoutesRequest struct {
+	TokenOut     types.Coin  `protobuf:"bytes,1,opt,name=token_out,json=tokenOut,proto3" json:"token_out" yaml:"token_out"`
+	Routes       []SwapRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
+	TokenInDenom string      `protobuf:"bytes,3,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty"`
+}
+
+func (m *QuerySwapExactAmountOutRoutesRequest) Reset()         { *m = QuerySwapExactAmountOutRoutesRequest{} }
+func (m *QuerySwapExactAmountOutRoutesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapExactAmountOutRoutesRequest) ProtoMessage()    {}
+func (*QuerySwapExactAmountOutRoutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ba1e1ef24357ddf, []int{28}
+}
+func (m *QuerySwapExactAmountOutRoutesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapExactAmountOutRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapExactAmountOutRoutesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapExactAmountOutRoutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapExactAmountOutRoutesRequest.Merge(m, src)
+}
+func (m *QuerySwapExactAmountOutRoutesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapExactAmountOutRoutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapExactAmountOutRoutesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapExactAmountOutRoutesRequest proto.InternalMessageInfo
+
+func (m *QuerySwapExactAmountOutRoutesRequest) GetTokenOut() types.Coin {
+	if m != nil {
+		return m.TokenOut
+	}
+	return types.Coin{}
+}
+
+func (m *QuerySwapExactAmountOutRoutesRequest) GetRoutes() []SwapRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *QuerySwapExactAmountOutRoutesRequest) GetTokenInDenom() string {
+	if m != nil {
+		return m.TokenInDenom
+	}
+	return ""
+}
+
+type QuerySwapExactAmountOutRoutesResponse struct {
+	TokenIn types.Coin `protobuf:"bytes,1,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	// the routes of the request, or the best route found
+	Routes []SwapRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
+}
+
+func (m *QuerySwapExactAmountOutRoutesResponse) Reset()         { *m = QuerySwapExactAmountOutRoutesResponse{} }
+func (m *QuerySwapExactAmountOutRoutesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapExactAmountOutRoutesResponse) ProtoMessage()    {}
+func (*QuerySwapExactAmountOutRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ba1e1ef24357ddf, []int{29}
+}
+func (m *QuerySwapExactAmountOutRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapExactAmountOutRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapExactAmountOutRoutesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapExactAmountOutRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapExactAmountOutRoutesResponse.Merge(m, src)
+}
+func (m *QuerySwapExactAmountOutRoutesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapExactAmountOutRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapExactAmountOutRoutesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapExactAmountOutRoutesResponse proto.InternalMessageInfo
+
+func (m *QuerySwapExactAmountOutRoutesResponse) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *QuerySwapExactAmountOutRoutesResponse) GetRoutes() []SwapRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
 type QueryJoinExactAmountInRequest struct {
 	PoolId   uint64                                   `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 	TokensIn github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=tokens_in,json=tokensIn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_in" yaml:"tokens_in"`
@@ -1140,7 +1492,7 @@ func (m *QueryJoinExactAmountInRequest) Reset()         { *m = QueryJoinExactAmo
 func (m *QueryJoinExactAmountInRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryJoinExactAmountInRequest) ProtoMessage()    {}
 func (*QueryJoinExactAmountInRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4ba1e1ef24357ddf, []int{24}
+	return fileDescriptor_4ba1e1ef24357ddf, []int{30}
 }
 func (m *QueryJoinExactAmountInRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1194,7 +1546,7 @@ func (m *QueryJoinExactAmountInResponse) Reset()         { *m = QueryJoinExactAm
 func (m *QueryJoinExactAmountInResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryJoinExactAmountInResponse) ProtoMessage()    {}
 func (*QueryJoinExactAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4ba1e1ef24357ddf, []int{25}
+	return fileDescriptor_4ba1e1ef24357ddf, []int{31}
 }
 func (m *QueryJoinExactAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1232,13 +1584,15 @@ func (m *QueryJoinExactAmountInResponse) GetRemCoins() github_com_cosmos_cosmos_
 
 type QueryJoinExactAmountOutRequest struct {
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// amount of pool shares to obtain
+	PoolSharesOut github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=pool_shares_out,json=poolSharesOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"pool_shares_out" yaml:"pool_shares_out"`
 }
 
 func (m *QueryJoinExactAmountOutRequest) Reset()         { *m = QueryJoinExactAmountOutRequest{} }
 func (m *QueryJoinExactAmountOutRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryJoinExactAmountOutRequest) ProtoMessage()    {}
 func (*QueryJoinExactAmountOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4ba1e1ef24357ddf, []int{26}
+	return fileDescriptor_4ba1e1ef24357ddf, []int{32}
 }
 func (m *QueryJoinExactAmountOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1275,13 +1629,15 @@ func (m *QueryJoinExactAmountOutRequest) GetPoolId() uint64 {
 }
 
 type QueryJoinExactAmountOutResponse struct {
+	// tokens to deposit, in the pool's proportions
+	TokensIn github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=tokens_in,json=tokensIn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_in" yaml:"tokens_in"`
 }
 
 func (m *QueryJoinExactAmountOutResponse) Reset()         { *m = QueryJoinExactAmountOutResponse{} }
 func (m *QueryJoinExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryJoinExactAmountOutResponse) ProtoMessage()    {}
 func (*QueryJoinExactAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4ba1e1ef24357ddf, []int{27}
+	return fileDescriptor_4ba1e1ef24357ddf, []int{33}
 }
 func (m *QueryJoinExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1310,17 +1666,26 @@ func (m *QueryJoinExactAmountOutResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryJoinExactAmountOutResponse proto.InternalMessageInfo
 
+func (m *QueryJoinExactAmountOutResponse) GetTokensIn() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TokensIn
+	}
+	return nil
+}
+
 type QueryExitExactAmountInRequest struct {
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 	// amount of pool shares to return to pool
 	PoolSharesIn github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=pool_shares_in,json=poolSharesIn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"pool_shares_in" yaml:"pool_shares_in"`
+	// if set, estimates a withdrawal of all the tokens in this denom
+	TokenOutDenom string `protobuf:"bytes,3,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty"`
 }
 
 func (m *QueryExitExactAmountInRequest) Reset()         { *m = QueryExitExactAmountInRequest{} }
 func (m *QueryExitExactAmountInRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryExitExactAmountInRequest) ProtoMessage()    {}
 func (*QueryExitExactAmountInRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4ba1e1ef24357ddf, []int{28}
+	return fileDescriptor_4ba1e1ef24357ddf, []int{34}
 }
 func (m *QueryExitExactAmountInRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1356,6 +1721,13 @@ func (m *QueryExitExactAmountInRequest) GetPoolId() uint64 {
 	return 0
 }
 
+func (m *QueryExitExactAmountInRequest) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
 type QueryExitExactAmountInResponse struct {
 	// coins obtained after exiting
 	TokensOut github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=tokens_out,json=tokensOut,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_out" yaml:"tokens_out"`
@@ -1365,7 +1737,7 @@ func (m *QueryExitExactAmountInResponse) Reset()         { *m = QueryExitExactAm
 func (m *QueryExitExactAmountInResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryExitExactAmountInResponse) ProtoMessage()    {}
 func (*QueryExitExactAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4ba1e1ef24357ddf, []int{29}
+	return fileDescriptor_4ba1e1ef24357ddf, []int{35}
 }
 func (m *QueryExitExactAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1403,13 +1775,15 @@ func (m *QueryExitExactAmountInResponse) GetTokensOut() github_com_cosmos_cosmos
 
 type QueryExitExactAmountOutRequest struct {
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// tokens to withdraw, any subset of the pool's assets
+	TokensOut github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=tokens_out,json=tokensOut,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_out" yaml:"tokens_out"`
 }
 
 func (m *QueryExitExactAmountOutRequest) Reset()         { *m = QueryExitExactAmountOutRequest{} }
 func (m *QueryExitExactAmountOutRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryExitExactAmountOutRequest) ProtoMessage()    {}
 func (*QueryExitExactAmountOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4ba1e1ef24357ddf, []int{30}
+	return fileDescriptor_4ba1e1ef24357ddf, []int{36}
 }
 func (m *QueryExitExactAmountOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1445,14 +1819,23 @@ func (m *QueryExitExactAmountOutRequest) GetPoolId() uint64 {
 	return 0
 }
 
+func (m *QueryExitExactAmountOutRequest) GetTokensOut() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TokensOut
+	}
+	return nil
+}
+
 type QueryExitExactAmountOutResponse struct {
+	// amount of pool shares to return to the pool, exit fee included
+	PoolSharesIn github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=pool_shares_in,json=poolSharesIn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"pool_shares_in" yaml:"pool_shares_in"`
 }
 
 func (m *QueryExitExactAmountOutResponse) Reset()         { *m = QueryExitExactAmountOutResponse{} }
 func (m *QueryExitExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryExitExactAmountOutResponse) ProtoMessage()    {}
 func (*QueryExitExactAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4ba1e1ef24357ddf, []int{31}
+	return fileDescriptor_4ba1e1ef24357ddf, []int{37}
 }
 func (m *QueryExitExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1502,10 +1885,16 @@ func init() {
 	proto.RegisterType((*QueryTotalSharesResponse)(nil), "nibiru.dex.v1.QueryTotalSharesResponse")
 	proto.RegisterType((*QuerySpotPriceRequest)(nil), "nibiru.dex.v1.QuerySpotPriceRequest")
 	proto.RegisterType((*QuerySpotPriceResponse)(nil), "nibiru.dex.v1.QuerySpotPriceResponse")
+	proto.RegisterType((*QueryArithmeticTwapRequest)(nil), "nibiru.dex.v1.QueryArithmeticTwapRequest")
+	proto.RegisterType((*QueryArithmeticTwapResponse)(nil), "nibiru.dex.v1.QueryArithmeticTwapResponse")
 	proto.RegisterType((*QuerySwapExactAmountInRequest)(nil), "nibiru.dex.v1.QuerySwapExactAmountInRequest")
 	proto.RegisterType((*QuerySwapExactAmountInResponse)(nil), "nibiru.dex.v1.QuerySwapExactAmountInResponse")
 	proto.RegisterType((*QuerySwapExactAmountOutRequest)(nil), "nibiru.dex.v1.QuerySwapExactAmountOutRequest")
 	proto.RegisterType((*QuerySwapExactAmountOutResponse)(nil), "nibiru.dex.v1.QuerySwapExactAmountOutResponse")
+	proto.RegisterType((*QuerySwapExactAmountInRoutesRequest)(nil), "nibiru.dex.v1.QuerySwapExactAmountInRoutesRequest")
+	proto.RegisterType((*QuerySwapExactAmountInRoutesResponse)(nil), "nibiru.dex.v1.QuerySwapExactAmountInRoutesResponse")
+	proto.RegisterType((*QuerySwapExactAmountOutRoutesRequest)(nil), "nibiru.dex.v1.QuerySwapExactAmountOutRoutesRequest")
+	proto.RegisterType((*QuerySwapExactAmountOutRoutesResponse)(nil), "nibiru.dex.v1.QuerySwapExactAmountOutRoutesResponse")
 	proto.RegisterType((*QueryJoinExactAmountInRequest)(nil), "nibiru.dex.v1.QueryJoinExactAmountInRequest")
 	proto.RegisterType((*QueryJoinExactAmountInResponse)(nil), "nibiru.dex.v1.QueryJoinExactAmountInResponse")
 	proto.RegisterType((*QueryJoinExactAmountOutRequest)(nil), "nibiru.dex.v1.QueryJoinExactAmountOutRequest")
@@ -1519,100 +1908,122 @@ func init() {
 func init() { proto.RegisterFile("dex/v1/query.proto", fileDescriptor_4ba1e1ef24357ddf) }
 
 var fileDescriptor_4ba1e1ef24357ddf = []byte{
-	// 1478 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xdf, 0x6f, 0x14, 0x55,
-	0x14, 0xee, 0x40, 0x29, 0xdd, 0x53, 0x7e, 0xf5, 0xb6, 0xa5, 0xed, 0x96, 0xee, 0xc2, 0x05, 0x0a,
-	0x54, 0xd9, 0xa1, 0x05, 0x7f, 0x80, 0xbc, 0x58, 0xa8, 0x58, 0xa2, 0x50, 0x17, 0x7d, 0x50, 0x1f,
-	0x36, 0xd3, 0xee, 0x64, 0x19, 0xe9, 0xce, 0x1d, 0x76, 0xee, 0x40, 0x1b, 0x41, 0x13, 0x63, 0xa2,
-	0x0f, 0x3e, 0x90, 0xf8, 0xea, 0x83, 0x3e, 0x99, 0x98, 0x18, 0xe3, 0x83, 0x26, 0xfe, 0x07, 0x3c,
-	0x92, 0xf8, 0x62, 0x7c, 0xa8, 0x86, 0xfa, 0x17, 0x90, 0xf8, 0x6e, 0xee, 0xbd, 0x67, 0x66, 0xe7,
-	0xe7, 0xce, 0x6c, 0xc2, 0x83, 0x4f, 0x6c, 0xef, 0x9c, 0x73, 0xbe, 0xef, 0x7c, 0xe7, 0xdc, 0xd9,
-	0x6f, 0x01, 0xd2, 0x34, 0x37, 0xf5, 0x7b, 0x0b, 0xfa, 0x5d, 0xcf, 0xec, 0x6c, 0xd5, 0x9c, 0x0e,
-	0xe3, 0x8c, 0xec, 0xb7, 0xad, 0x35, 0xab, 0xe3, 0xd5, 0x9a, 0xe6, 0x66, 0xed, 0xde, 0x42, 0x79,
-	0xbc, 0xc5, 0x5a, 0x4c, 0x3e, 0xd1, 0xc5, 0x27, 0x15, 0x54, 0x3e, 0xd2, 0x62, 0xac, 0xb5, 0x61,
-	0xea, 0x86, 0x63, 0xe9, 0x86, 0x6d, 0x33, 0x6e, 0x70, 0x8b, 0xd9, 0x2e, 0x3e, 0x9d, 0x5f, 0x67,
-	0x6e, 0x9b, 0xb9, 0xfa, 0x9a, 0xe1, 0x9a, 0xaa, 0xb6, 0x7e, 0x6f, 0x61, 0xcd, 0xe4, 0xc6, 0x82,
-	0xee, 0x18, 0x2d, 0xcb, 0x96, 0xc1, 0x18, 0x3b, 0x86, 0x14, 0x1c, 0xa3, 0x63, 0xb4, 0xfd, 0x02,
-	0xa3, 0xfe, 0x21, 0x63, 0x1b, 0x78, 0x54, 0x09, 0xd7, 0xf4, 0xab, 0xad, 0x33, 0x0b, 0xeb, 0xd0,
-	0x71, 0x20, 0xef, 0x08, 0xa4, 0x55, 0x59, 0xa7, 0x6e, 0xde, 0xf5, 0x4c, 0x97, 0xd3, 0xeb, 0x30,
-	0x16, 0x39, 0x75, 0x1d, 0x66, 0xbb, 0x26, 0x39, 0x0f, 0x43, 0x0a, 0x6f, 0x4a, 0x3b, 0xaa, 0x9d,
-	0x1e, 0x59, 0x9c, 0xa8, 0x45, 0x9a, 0xae, 0xa9, 0xf0, 0xa5, 0xc1, 0xc7, 0xdb, 0xd5, 0x81, 0x3a,
-	0x86, 0xd2, 0x29, 0x38, 0xac, 0x6a, 0x31, 0xb6, 0x71, 0xc3, 0x6b, 0xaf, 0x99, 0x1d, 0x1f, 0x65,
-	0x11, 0x26, 0x13, 0x4f, 0x10, 0x69, 0x12, 0xf6, 0x8a, 0x26, 0x1a, 0x56, 0x53, 0x42, 0x0d, 0xd6,
-	0x87, 0xc4, 0x9f, 0x2b, 0x4d, 0xfa, 0x02, 0x1c, 0x0a, 0x72, 0xb0, 0x4e, 0x76, 0xf0, 0x65, 0x18,
-	0x0d, 0x05, 0x63, 0xe9, 0x53, 0x30, 0x28, 0x1e, 0x63, 0x0b, 0x63, 0xf1, 0x16, 0x44, 0xa8, 0x0c,
-	0xa0, 0x1f, 0x86, 0xb2, 0x7d, 0x65, 0xc8, 0x1b, 0x00, 0xdd, 0x59, 0x60, 0x8d, 0xb9, 0x9a, 0x12,
-	0xb9, 0x26, 0x44, 0xae, 0xa9, 0xa5, 0x40, 0xa9, 0x6b, 0xab, 0x46, 0xcb, 0xc4, 0xdc, 0x7a, 0x28,
-	0x93, 0x7e, 0xa9, 0xf9, 0xc2, 0xab, 0xea, 0x48, 0xee, 0x0c, 0xec, 0x11, 0xd8, 0x42, 0xe0, 0xdd,
-	0x59, 0xec, 0x54, 0x04, 0xb9, 0x16, 0x61, 0xb2, 0x4b, 0x32, 0x39, 0x95, 0xcb, 0x44, 0xe1, 0x44,
-	0xa8, 0x2c, 0x84, 0x06, 0x14, 0x59, 0x83, 0x6c, 0x61, 0xdf, 0x0b, 0x4d, 0x2e, 0xb6, 0x23, 0x97,
-	0x60, 0x44, 0xe6, 0x44, 0x16, 0x65, 0x3a, 0xa5, 0x0f, 0xcc, 0x03, 0x27, 0xf8, 0x4c, 0x0f, 0xc3,
-	0xb8, 0x2c, 0x7b, 0xc3, 0x6b, 0x87, 0x45, 0xa7, 0x17, 0x60, 0x22, 0x76, 0x8e, 0x60, 0x33, 0x50,
-	0xb2, 0xbd, 0x76, 0xc3, 0x97, 0x4c, 0x50, 0x1c, 0xb6, 0x31, 0x88, 0x1e, 0x81, 0xb2, 0xcc, 0x7a,
-	0x97, 0x71, 0x63, 0xe3, 0x2d, 0xeb, 0xae, 0x67, 0x35, 0x2d, 0xbe, 0xe5, 0xd7, 0xfc, 0x46, 0x83,
-	0x99, 0xd4, 0xc7, 0x58, 0xfa, 0x21, 0x94, 0x36, 0xfc, 0x43, 0x9c, 0xc6, 0x74, 0x44, 0x5d, 0x5f,
-	0xd7, 0x2b, 0xcc, 0xb2, 0x97, 0xae, 0x8a, 0x95, 0x7f, 0xb6, 0x5d, 0x3d, 0xb4, 0x65, 0xb4, 0x37,
-	0x2e, 0xd1, 0x20, 0x93, 0xfe, 0xf0, 0x57, 0xf5, 0x74, 0xcb, 0xe2, 0xb7, 0xbd, 0xb5, 0xda, 0x3a,
-	0x6b, 0xeb, 0x78, 0x1b, 0xd5, 0x3f, 0x67, 0xdd, 0xe6, 0x1d, 0x9d, 0x6f, 0x39, 0xa6, 0x2b, 0x8b,
-	0xb8, 0xf5, 0x2e, 0x22, 0xbd, 0x08, 0x95, 0x2e, 0x3b, 0xd1, 0x4f, 0xbc, 0x81, 0xec, 0xe1, 0x7c,
-	0xab, 0x41, 0x35, 0x33, 0xf7, 0xff, 0xd1, 0x9d, 0x7f, 0xf3, 0x25, 0xc3, 0x5b, 0xb7, 0x8d, 0x8e,
-	0x99, 0xbf, 0x73, 0x1e, 0x4c, 0x25, 0x73, 0xb0, 0x9d, 0xf7, 0x61, 0x1f, 0x17, 0xc7, 0x0d, 0x57,
-	0x9e, 0x07, 0x5b, 0x97, 0xd9, 0xd1, 0x0c, 0x76, 0x34, 0xa6, 0x3a, 0x0a, 0x27, 0xd3, 0xfa, 0x08,
-	0xef, 0x42, 0xd0, 0x4f, 0x70, 0xf7, 0x6e, 0x39, 0x8c, 0xaf, 0x76, 0xac, 0x75, 0x33, 0x8f, 0x28,
-	0x39, 0x01, 0x07, 0x38, 0xbb, 0x63, 0xda, 0x0d, 0xcb, 0x6e, 0x34, 0x4d, 0x9b, 0xb5, 0xe5, 0xe5,
-	0x2c, 0xd5, 0xf7, 0xc9, 0xd3, 0x15, 0xfb, 0xaa, 0x38, 0x23, 0x73, 0x70, 0x50, 0x45, 0x31, 0x8f,
-	0x63, 0xd8, 0x6e, 0x19, 0xb6, 0x5f, 0x1e, 0xdf, 0xf4, 0xb8, 0x8c, 0xa3, 0xaf, 0xe0, 0xed, 0x0c,
-	0xe1, 0x63, 0xd3, 0xb3, 0x00, 0xae, 0xc3, 0x78, 0xc3, 0x11, 0xa7, 0x92, 0x43, 0xa9, 0x5e, 0x72,
-	0xfd, 0x30, 0xfa, 0x93, 0x06, 0xb3, 0x2a, 0xf3, 0xbe, 0xe1, 0x2c, 0x6f, 0x1a, 0xeb, 0xfc, 0xf5,
-	0x36, 0xf3, 0x6c, 0xbe, 0x62, 0xe7, 0x76, 0xf0, 0x36, 0x0c, 0xfb, 0x1d, 0xe0, 0x8b, 0xa5, 0x87,
-	0x94, 0x93, 0x28, 0xe5, 0x41, 0x5f, 0x4a, 0x95, 0x48, 0xeb, 0x7b, 0xb1, 0xdf, 0xc2, 0xad, 0x76,
-	0x70, 0xe7, 0x53, 0x08, 0x63, 0xcb, 0xab, 0x50, 0x0a, 0x2a, 0xe5, 0x33, 0x9b, 0x8a, 0xae, 0x6d,
-	0x90, 0x49, 0xeb, 0xc3, 0x3e, 0x30, 0xfd, 0x59, 0x4b, 0x07, 0xbd, 0xe9, 0xf1, 0x5c, 0x99, 0x9e,
-	0x3b, 0x9b, 0x94, 0xd5, 0xd9, 0x9d, 0x5c, 0x1d, 0xea, 0xe0, 0xfd, 0x4e, 0xa3, 0x8c, 0x42, 0x3d,
-	0xdf, 0x09, 0xd2, 0x5f, 0xfd, 0x5d, 0xba, 0xce, 0x2c, 0xbb, 0xbf, 0x5d, 0x7a, 0x80, 0x22, 0xb9,
-	0x8a, 0x4a, 0x7f, 0x6f, 0x9a, 0x20, 0xb3, 0xbf, 0x37, 0x8d, 0xea, 0xdd, 0x5d, 0xb1, 0xe9, 0xa3,
-	0x5d, 0x38, 0xde, 0x14, 0xe2, 0x28, 0x95, 0x03, 0x07, 0x25, 0x73, 0x75, 0xfb, 0xe5, 0x2c, 0xe5,
-	0x5d, 0x5a, 0x7a, 0x53, 0x70, 0xf9, 0x73, 0xbb, 0x3a, 0x57, 0x00, 0x77, 0xc5, 0xe6, 0xcf, 0xb6,
-	0xab, 0x87, 0x15, 0xeb, 0x58, 0x39, 0x5a, 0xdf, 0x2f, 0x4e, 0xd4, 0xfb, 0x44, 0x4c, 0xf9, 0x01,
-	0x94, 0x3a, 0x66, 0xbb, 0x21, 0x5c, 0x98, 0xdb, 0xb7, 0x24, 0x41, 0x66, 0x9f, 0x92, 0x74, 0xcc,
-	0xb6, 0xfc, 0x14, 0x7c, 0xb3, 0xc4, 0x14, 0x29, 0xb0, 0xf0, 0xf4, 0x18, 0x2e, 0x5e, 0x5a, 0xaa,
-	0x52, 0x93, 0x7e, 0xef, 0x6f, 0xca, 0xf2, 0xa6, 0xc5, 0xfb, 0xdb, 0x94, 0x36, 0x1c, 0x08, 0x2b,
-	0x87, 0x9b, 0x5b, 0x5a, 0xba, 0xd6, 0xf7, 0x1c, 0x26, 0x92, 0x73, 0x10, 0xeb, 0xbc, 0xaf, 0x3b,
-	0x86, 0x15, 0x9b, 0x7e, 0xe7, 0xdf, 0xfc, 0x14, 0xa6, 0xb8, 0x1a, 0x9f, 0x02, 0xe0, 0x06, 0xaa,
-	0xad, 0xc8, 0x99, 0xd4, 0x32, 0x4e, 0x6a, 0x34, 0xb2, 0xbc, 0x62, 0x03, 0xfa, 0xfb, 0x9e, 0x54,
-	0x89, 0xe2, 0xed, 0x74, 0x31, 0x9d, 0x62, 0x3f, 0xb3, 0x4a, 0x4b, 0x55, 0xed, 0x2d, 0xfe, 0x4b,
-	0x60, 0x8f, 0x8c, 0x21, 0x77, 0x60, 0x48, 0x59, 0x30, 0x72, 0x2c, 0xe6, 0xd4, 0x92, 0x3f, 0x0e,
-	0xca, 0xb4, 0x57, 0x08, 0xae, 0x41, 0xf9, 0xb3, 0xdf, 0xff, 0xf9, 0x7a, 0xd7, 0x38, 0x21, 0xba,
-	0x8a, 0xd5, 0xc5, 0x2f, 0x13, 0x65, 0x09, 0xc9, 0x03, 0x80, 0xae, 0xe3, 0x27, 0x27, 0x53, 0xab,
-	0xc5, 0x7f, 0x2b, 0x94, 0xe7, 0xf2, 0xc2, 0x10, 0xb8, 0x2a, 0x81, 0xa7, 0xc9, 0x64, 0x04, 0x58,
-	0x28, 0x64, 0x2b, 0xbc, 0x75, 0x18, 0x14, 0x69, 0xa4, 0x9a, 0x55, 0xd0, 0x47, 0x3c, 0x9a, 0x1d,
-	0x80, 0x58, 0x53, 0x12, 0x8b, 0x90, 0x43, 0x71, 0x2c, 0xd2, 0x82, 0x3d, 0xd2, 0x83, 0x92, 0xcc,
-	0x22, 0x81, 0x9a, 0xc7, 0x7a, 0x44, 0x20, 0xce, 0xb4, 0xc4, 0x19, 0x23, 0xa3, 0x71, 0x1c, 0x97,
-	0x7c, 0xa1, 0x29, 0x31, 0x71, 0x7a, 0x99, 0x62, 0x46, 0x27, 0x38, 0x97, 0x17, 0x86, 0xc0, 0xf3,
-	0x12, 0xf8, 0x04, 0xa1, 0x09, 0x60, 0xfd, 0x63, 0xdc, 0xba, 0x87, 0xfe, 0x54, 0x39, 0x0c, 0xfb,
-	0xf6, 0x9c, 0x1c, 0x4f, 0xab, 0x1f, 0x33, 0xf5, 0xe5, 0x13, 0xbd, 0x83, 0x90, 0xc2, 0xac, 0xa4,
-	0x30, 0x49, 0x26, 0xc2, 0x14, 0x02, 0xcf, 0x4f, 0xbe, 0xd2, 0xe0, 0x40, 0xd4, 0xc0, 0x93, 0x33,
-	0x69, 0x75, 0x53, 0x7f, 0x03, 0x94, 0xe7, 0x8b, 0x84, 0x22, 0x91, 0xe3, 0x92, 0xc8, 0x2c, 0x99,
-	0x09, 0x13, 0x51, 0xbe, 0x31, 0xf0, 0xb5, 0xe4, 0x47, 0x0d, 0x48, 0xd2, 0x75, 0x93, 0xb3, 0x99,
-	0x38, 0x69, 0xce, 0xbe, 0x5c, 0x2b, 0x1a, 0x8e, 0xd4, 0x5e, 0x95, 0xd4, 0x16, 0xc9, 0xb9, 0x5e,
-	0x63, 0x52, 0x54, 0xe5, 0x9f, 0x5d, 0xbe, 0x8f, 0x34, 0x18, 0x09, 0xf9, 0x69, 0x32, 0x97, 0x89,
-	0x1c, 0x31, 0xe9, 0xe5, 0x53, 0xb9, 0x71, 0x48, 0xed, 0x9c, 0xa4, 0x36, 0x4f, 0x4e, 0xe7, 0x53,
-	0x53, 0x2f, 0x6a, 0xf2, 0xb9, 0x06, 0xa5, 0xc0, 0xeb, 0x92, 0xd4, 0x25, 0x89, 0x5b, 0xf1, 0xf2,
-	0xc9, 0x9c, 0xa8, 0xbe, 0xd6, 0x59, 0xa4, 0xb8, 0xe4, 0x17, 0x0d, 0xa6, 0x97, 0x5d, 0x6e, 0xb5,
-	0x0d, 0x6e, 0x26, 0xfc, 0x28, 0x79, 0x31, 0x15, 0x30, 0xc3, 0x67, 0x97, 0xcf, 0x16, 0x8c, 0x46,
-	0x9a, 0xaf, 0x49, 0x9a, 0x2f, 0x91, 0xf3, 0x61, 0x9a, 0x5d, 0x82, 0x26, 0xb2, 0xd2, 0xdd, 0xfb,
-	0x86, 0xd3, 0x30, 0x45, 0x89, 0x86, 0x21, 0x6b, 0x34, 0x2c, 0x9b, 0xfc, 0xa6, 0x41, 0x39, 0x83,
-	0xb7, 0xb0, 0x1e, 0x45, 0xa8, 0x74, 0xbf, 0x5d, 0xd2, 0x37, 0x31, 0xdb, 0x76, 0xd2, 0xcb, 0x92,
-	0xfa, 0xcb, 0xe4, 0x42, 0xdf, 0xd4, 0x99, 0xc7, 0x23, 0x9a, 0x27, 0xfc, 0x5a, 0xba, 0xe6, 0x59,
-	0x7e, 0x34, 0x5d, 0xf3, 0x4c, 0x13, 0x58, 0x58, 0xf3, 0x8f, 0x98, 0x65, 0xf7, 0xd4, 0x3c, 0x69,
-	0x8d, 0x48, 0x11, 0x2a, 0x79, 0x9a, 0xf7, 0x70, 0x5c, 0x45, 0x35, 0x4f, 0x52, 0x8f, 0x6b, 0x9e,
-	0x30, 0x42, 0xe9, 0x9a, 0x67, 0x39, 0xbb, 0x74, 0xcd, 0x33, 0xdd, 0x55, 0x61, 0xcd, 0xcd, 0x4d,
-	0x8b, 0xf7, 0xd4, 0x3c, 0x69, 0x71, 0x48, 0x11, 0x2a, 0x79, 0x9a, 0x67, 0x3b, 0xa7, 0xc2, 0x9a,
-	0x27, 0xa9, 0x33, 0x8f, 0x2f, 0x5d, 0x79, 0xfc, 0xb4, 0xa2, 0x3d, 0x79, 0x5a, 0xd1, 0xfe, 0x7e,
-	0x5a, 0xd1, 0x1e, 0xed, 0x54, 0x06, 0x9e, 0xec, 0x54, 0x06, 0xfe, 0xd8, 0xa9, 0x0c, 0x7c, 0x70,
-	0x26, 0x64, 0x12, 0x6f, 0xc8, 0xca, 0x57, 0x6e, 0x1b, 0x96, 0xed, 0xa3, 0x6c, 0xaa, 0x2f, 0x1d,
-	0xe1, 0x15, 0xd7, 0x86, 0xe4, 0xff, 0xdf, 0x9e, 0xff, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x00,
-	0xe4, 0x34, 0x8c, 0x16, 0x00, 0x00,
+	// 1839 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x77, 0x3b, 0xb6, 0xe3, 0x79, 0x4e, 0xec, 0xb8, 0xfc, 0x35, 0x6e, 0xc7, 0x33, 0x4e, 0xc5,
+	0x71, 0x1c, 0x83, 0x7b, 0x62, 0x3b, 0x04, 0x12, 0x22, 0xa1, 0xd8, 0x31, 0xc1, 0x11, 0x24, 0x66,
+	0x12, 0x84, 0x80, 0xc3, 0xa8, 0x3d, 0xd3, 0x8c, 0x9b, 0xb8, 0x3f, 0x3c, 0x5d, 0xed, 0x0f, 0x91,
+	0x80, 0x84, 0x90, 0xe0, 0xc0, 0xc1, 0x88, 0x23, 0x48, 0xc0, 0x15, 0xc4, 0xd7, 0x01, 0x24, 0x38,
+	0x73, 0xc8, 0x6d, 0x23, 0xed, 0x6a, 0xb5, 0xda, 0x83, 0xb3, 0x4a, 0xf6, 0x2f, 0xc8, 0x75, 0x2f,
+	0xab, 0xfa, 0xe8, 0x99, 0xee, 0xe9, 0xee, 0xe9, 0xee, 0xdd, 0x24, 0x9b, 0x93, 0x3d, 0x55, 0xef,
+	0xe3, 0xf7, 0x7e, 0xef, 0xbd, 0xae, 0xaa, 0x07, 0xa8, 0xa6, 0x1d, 0x94, 0xf6, 0x96, 0x4a, 0xbb,
+	0xae, 0xd6, 0x38, 0x54, 0xec, 0x86, 0x45, 0x2c, 0x74, 0xda, 0xd4, 0xb7, 0xf4, 0x86, 0xab, 0xd4,
+	0xb4, 0x03, 0x65, 0x6f, 0x49, 0x1e, 0xad, 0x5b, 0x75, 0x8b, 0xed, 0x94, 0xe8, 0x7f, 0x5c, 0x48,
+	0x3e, 0x5b, 0xb7, 0xac, 0xfa, 0x8e, 0x56, 0x52, 0x6d, 0xbd, 0xa4, 0x9a, 0xa6, 0x45, 0x54, 0xa2,
+	0x5b, 0xa6, 0x23, 0x76, 0x17, 0xaa, 0x96, 0x63, 0x58, 0x4e, 0x69, 0x4b, 0x75, 0x34, 0x6e, 0xbb,
+	0xb4, 0xb7, 0xb4, 0xa5, 0x11, 0x75, 0xa9, 0x64, 0xab, 0x75, 0xdd, 0x64, 0xc2, 0x42, 0x76, 0x44,
+	0x40, 0xb0, 0xd5, 0x86, 0x6a, 0x78, 0x06, 0x86, 0xbd, 0x45, 0xcb, 0xda, 0x11, 0x4b, 0x43, 0x62,
+	0x89, 0x1c, 0x88, 0x85, 0x82, 0xdf, 0x89, 0x67, 0xbe, 0x6a, 0xe9, 0x9e, 0xe1, 0xa2, 0x80, 0xc8,
+	0x7e, 0x6d, 0xb9, 0x3f, 0x2e, 0x11, 0xdd, 0xd0, 0x1c, 0xa2, 0x1a, 0x36, 0x17, 0xc0, 0xa3, 0x80,
+	0xbe, 0x4b, 0xb1, 0x6d, 0x32, 0xcf, 0x65, 0x6d, 0xd7, 0xd5, 0x1c, 0x82, 0xef, 0xc0, 0x48, 0x60,
+	0xd5, 0xb1, 0x2d, 0xd3, 0xd1, 0xd0, 0x0a, 0xf4, 0x71, 0x84, 0x79, 0x69, 0x46, 0x9a, 0x1f, 0x58,
+	0x1e, 0x53, 0x02, 0x34, 0x29, 0x5c, 0x7c, 0xb5, 0xe7, 0xc9, 0x71, 0xb1, 0xab, 0x2c, 0x44, 0x71,
+	0x1e, 0xc6, 0xb9, 0x2d, 0xcb, 0xda, 0xb9, 0xeb, 0x1a, 0x5b, 0x5a, 0xc3, 0xf3, 0xb2, 0x0c, 0x13,
+	0xa1, 0x1d, 0xe1, 0x69, 0x02, 0x4e, 0xd2, 0xb0, 0x2b, 0x7a, 0x8d, 0xb9, 0xea, 0x29, 0xf7, 0xd1,
+	0x9f, 0x1b, 0x35, 0xfc, 0x25, 0x38, 0xd3, 0xd4, 0x11, 0x76, 0xe2, 0x85, 0x6f, 0xc0, 0xb0, 0x4f,
+	0x58, 0x98, 0xbe, 0x08, 0x3d, 0x74, 0x5b, 0x84, 0x30, 0xd2, 0x1e, 0x02, 0x15, 0x65, 0x02, 0xf8,
+	0x47, 0x3e, 0x6d, 0x8f, 0x19, 0xf4, 0x4d, 0x80, 0x56, 0xf6, 0x84, 0x8d, 0x39, 0x85, 0x67, 0x41,
+	0xa1, 0x59, 0x50, 0x78, 0x19, 0x89, 0x5c, 0x28, 0x9b, 0x6a, 0x5d, 0x13, 0xba, 0x65, 0x9f, 0x26,
+	0xfe, 0xb5, 0x04, 0xc8, 0x6f, 0x5d, 0x80, 0xbb, 0x04, 0xbd, 0xd4, 0x37, 0x25, 0xf8, 0x44, 0x1c,
+	0x3a, 0x2e, 0x81, 0x6e, 0x07, 0x90, 0x74, 0x33, 0x24, 0x17, 0x13, 0x91, 0x70, 0x3f, 0x01, 0x28,
+	0x4b, 0xbe, 0x04, 0x05, 0xca, 0x20, 0x9e, 0xd8, 0xef, 0xc1, 0x44, 0x48, 0x45, 0x44, 0x70, 0x1d,
+	0x06, 0x98, 0x4e, 0xa0, 0x50, 0x26, 0x23, 0xe2, 0x10, 0x7a, 0x60, 0x37, 0xff, 0xc7, 0xe3, 0x30,
+	0xca, 0xcc, 0xde, 0x75, 0x0d, 0x3f, 0xe9, 0xf8, 0x0a, 0x8c, 0xb5, 0xad, 0x0b, 0x67, 0x53, 0x90,
+	0x33, 0x5d, 0xa3, 0xe2, 0x51, 0x46, 0x21, 0xf6, 0x9b, 0x42, 0x08, 0x9f, 0x05, 0x99, 0x69, 0x3d,
+	0xb0, 0x88, 0xba, 0xf3, 0x6d, 0x7d, 0xd7, 0xd5, 0x6b, 0x3a, 0x39, 0xf4, 0x6c, 0xfe, 0x41, 0x82,
+	0xa9, 0xc8, 0x6d, 0x61, 0xfa, 0x31, 0xe4, 0x76, 0xbc, 0x45, 0x91, 0x8d, 0xc9, 0x00, 0xbb, 0x1e,
+	0xaf, 0x6b, 0x96, 0x6e, 0xae, 0xde, 0xa2, 0x25, 0xff, 0xf2, 0xb8, 0x78, 0xe6, 0x50, 0x35, 0x76,
+	0xae, 0xe3, 0xa6, 0x26, 0xfe, 0xcb, 0xb3, 0xe2, 0x7c, 0x5d, 0x27, 0xdb, 0xee, 0x96, 0x52, 0xb5,
+	0x8c, 0x92, 0x68, 0x57, 0xfe, 0x67, 0xd1, 0xa9, 0x3d, 0x2c, 0x91, 0x43, 0x5b, 0x73, 0x98, 0x11,
+	0xa7, 0xdc, 0xf2, 0x88, 0xaf, 0x41, 0xa1, 0x85, 0x8e, 0xc6, 0xd3, 0x1e, 0x40, 0x7c, 0x72, 0xfe,
+	0x24, 0x41, 0x31, 0x56, 0xf7, 0xed, 0x88, 0xce, 0xeb, 0x7c, 0x86, 0xf0, 0xfe, 0xb6, 0xda, 0xd0,
+	0x92, 0x6b, 0xce, 0x85, 0x7c, 0x58, 0x47, 0x84, 0xf3, 0x03, 0x38, 0x45, 0xe8, 0x72, 0xc5, 0x61,
+	0xeb, 0xcd, 0xaa, 0x8b, 0x8d, 0x68, 0x4a, 0x44, 0x34, 0xc2, 0x23, 0xf2, 0x2b, 0xe3, 0xf2, 0x00,
+	0x69, 0xb9, 0xc0, 0x3f, 0x13, 0xb5, 0x77, 0xdf, 0xb6, 0xc8, 0x66, 0x43, 0xaf, 0x6a, 0x49, 0x40,
+	0xd1, 0x2c, 0x0c, 0x12, 0xeb, 0xa1, 0x66, 0x56, 0x74, 0xb3, 0x52, 0xd3, 0x4c, 0xcb, 0x60, 0xcd,
+	0x99, 0x2b, 0x9f, 0x62, 0xab, 0x1b, 0xe6, 0x2d, 0xba, 0x86, 0xe6, 0x60, 0x88, 0x4b, 0x59, 0x2e,
+	0x11, 0x62, 0x27, 0x98, 0xd8, 0x69, 0xb6, 0x7c, 0xcf, 0x25, 0x4c, 0x0e, 0x7f, 0x15, 0xc6, 0xdb,
+	0xfd, 0x8b, 0xa0, 0xa7, 0x01, 0x1c, 0xdb, 0x22, 0x15, 0x9b, 0xae, 0x32, 0x0c, 0xb9, 0x72, 0xce,
+	0xf1, 0xc4, 0xf0, 0x27, 0x92, 0xa8, 0xff, 0x9b, 0x0d, 0x9d, 0x6c, 0x1b, 0x1a, 0xd1, 0xab, 0x0f,
+	0xf6, 0x55, 0x3b, 0x11, 0xfe, 0x34, 0x00, 0xe5, 0x2b, 0x00, 0x3d, 0x47, 0x57, 0x38, 0xee, 0x22,
+	0x0c, 0xec, 0xba, 0x16, 0xd1, 0x02, 0x98, 0x81, 0x2d, 0x71, 0x81, 0x35, 0x00, 0x87, 0xa8, 0x0d,
+	0x52, 0xa1, 0x47, 0x4d, 0xbe, 0x87, 0x65, 0x42, 0x56, 0xf8, 0x39, 0xa4, 0x78, 0xe7, 0x90, 0xf2,
+	0xc0, 0x3b, 0x87, 0x56, 0xfb, 0x69, 0x2a, 0x8e, 0x9e, 0x15, 0xa5, 0x72, 0x8e, 0xe9, 0xd1, 0x1d,
+	0xf4, 0x0d, 0xe8, 0xd7, 0xcc, 0x1a, 0x37, 0xd1, 0x9b, 0xc1, 0xc4, 0x49, 0xcd, 0xac, 0xd1, 0x75,
+	0xbc, 0x07, 0x53, 0x91, 0xc1, 0x0b, 0xee, 0xbe, 0x0f, 0x43, 0x6a, 0x73, 0xa7, 0x42, 0xf6, 0x55,
+	0x9b, 0x13, 0xb8, 0xaa, 0x50, 0x53, 0x1f, 0x1e, 0x17, 0xe7, 0x52, 0x94, 0xf5, 0x2d, 0xad, 0x5a,
+	0x1e, 0x54, 0x03, 0x0e, 0xf0, 0x3f, 0x24, 0x98, 0xe6, 0xf9, 0xda, 0x57, 0xed, 0xf5, 0x03, 0xb5,
+	0x4a, 0x6e, 0x1a, 0x96, 0x6b, 0x92, 0x0d, 0x33, 0x91, 0xf8, 0xef, 0x40, 0xbf, 0x57, 0x37, 0xf9,
+	0xee, 0xa4, 0x02, 0x9e, 0x10, 0x05, 0x3c, 0xe4, 0x15, 0x30, 0x57, 0xc4, 0xe5, 0x93, 0xa2, 0xca,
+	0x52, 0x17, 0x58, 0x03, 0x0a, 0x71, 0x80, 0x05, 0x59, 0x9b, 0x90, 0x6b, 0x5a, 0x4a, 0x46, 0x96,
+	0x0f, 0x7e, 0x2c, 0x9a, 0x9a, 0xb8, 0xdc, 0xef, 0x39, 0xc6, 0xff, 0x92, 0xa2, 0x9d, 0xde, 0x73,
+	0x49, 0x22, 0x4d, 0xaf, 0x1c, 0x4d, 0x44, 0xc3, 0x9e, 0x08, 0x37, 0x2c, 0xb6, 0xa1, 0x18, 0x0b,
+	0x59, 0x10, 0xf5, 0x6a, 0x33, 0x88, 0xdf, 0x93, 0xe0, 0x7c, 0x4c, 0x6a, 0x2c, 0x97, 0xb4, 0x3e,
+	0x99, 0x7e, 0xb7, 0xd2, 0xe7, 0x2f, 0x9c, 0xab, 0xd0, 0xd7, 0x60, 0xf6, 0xf3, 0xdd, 0xec, 0x60,
+	0xc8, 0xb7, 0x1d, 0xde, 0x14, 0x0d, 0x03, 0xe0, 0x5d, 0xf4, 0xb8, 0x74, 0xea, 0x82, 0xfb, 0xa7,
+	0x04, 0xb3, 0x9d, 0xc3, 0x8a, 0xaa, 0x3b, 0xe9, 0x55, 0x64, 0xfa, 0x33, 0x86, 0x86, 0xdf, 0x8f,
+	0x81, 0x4c, 0x93, 0x1f, 0x48, 0xc5, 0x5b, 0x03, 0x39, 0x65, 0x51, 0xff, 0x5d, 0x82, 0x0b, 0x09,
+	0x81, 0x45, 0xd4, 0xf6, 0x17, 0x57, 0x64, 0xf8, 0x3f, 0xde, 0xf7, 0xf5, 0x8e, 0xa5, 0x9b, 0xd9,
+	0xbe, 0xaf, 0x8f, 0x44, 0x6e, 0x1c, 0xde, 0x9e, 0xd9, 0xee, 0x3c, 0x4d, 0xcd, 0x6c, 0x77, 0x1e,
+	0xce, 0x99, 0xb3, 0x61, 0xe2, 0xa3, 0x6e, 0x28, 0xc4, 0x01, 0x17, 0x14, 0xdb, 0x30, 0xc4, 0x90,
+	0xf3, 0x7b, 0x48, 0xb3, 0x84, 0x72, 0xab, 0xdf, 0xca, 0x70, 0x28, 0x6d, 0x98, 0xe4, 0xe5, 0x71,
+	0x71, 0x9c, 0xa3, 0x6e, 0x33, 0x87, 0xcb, 0xa7, 0xe9, 0x0a, 0xbf, 0xd9, 0xd0, 0xe2, 0x7a, 0x04,
+	0xb9, 0x86, 0x66, 0x54, 0xe8, 0x83, 0xd1, 0xc9, 0x4c, 0x49, 0x53, 0x33, 0x23, 0x25, 0x0d, 0xcd,
+	0x60, 0xff, 0xe1, 0xbf, 0x4a, 0xd1, 0x94, 0xa4, 0x39, 0x05, 0x22, 0xb8, 0xea, 0x7e, 0xad, 0x5c,
+	0xe1, 0x3f, 0x7a, 0xd7, 0xea, 0x28, 0xb4, 0x22, 0x83, 0x81, 0x12, 0x93, 0xde, 0x74, 0x89, 0xbd,
+	0xe3, 0xf5, 0xc6, 0xfa, 0x81, 0x4e, 0xb2, 0xf5, 0x86, 0x01, 0x83, 0xfe, 0xf8, 0xc5, 0xf9, 0x95,
+	0x5b, 0xbd, 0x9d, 0x99, 0xcd, 0xb1, 0x30, 0x9b, 0xb4, 0xf1, 0x4f, 0xb5, 0xc8, 0xcc, 0x70, 0x37,
+	0xf9, 0xb3, 0x57, 0x21, 0x11, 0x11, 0x09, 0xca, 0x7f, 0x0e, 0x20, 0x88, 0xe3, 0xfd, 0x92, 0xc0,
+	0xf9, 0xba, 0xe0, 0x7c, 0x38, 0xc0, 0x39, 0xcd, 0x77, 0xb6, 0xb7, 0x0c, 0x57, 0xa4, 0x75, 0xf1,
+	0xbf, 0x18, 0x8c, 0x69, 0xaa, 0x38, 0x08, 0xbe, 0xfb, 0xcd, 0x83, 0x3f, 0xf2, 0x8a, 0x3a, 0x0a,
+	0xbc, 0x60, 0x38, 0x5c, 0x1b, 0xd2, 0x6b, 0xac, 0x8d, 0xe5, 0xdf, 0x4f, 0x40, 0x2f, 0x83, 0x84,
+	0x1e, 0x42, 0x1f, 0x1f, 0x0c, 0xa0, 0x73, 0x6d, 0xa7, 0x43, 0x78, 0x64, 0x25, 0xe3, 0x4e, 0x22,
+	0x3c, 0x12, 0x2c, 0xff, 0xe2, 0xdd, 0x8f, 0x7f, 0xd7, 0x3d, 0x8a, 0x50, 0x89, 0xcb, 0x96, 0xe8,
+	0x38, 0x8d, 0x0f, 0x2a, 0xd0, 0x23, 0x80, 0xd6, 0x1c, 0x0a, 0x5d, 0x88, 0xb4, 0xd6, 0x3e, 0xc1,
+	0x92, 0xe7, 0x92, 0xc4, 0x84, 0xe3, 0x22, 0x73, 0x3c, 0x89, 0x26, 0x02, 0x8e, 0x29, 0x0d, 0x26,
+	0xf7, 0x57, 0x85, 0x1e, 0xaa, 0x86, 0x8a, 0x71, 0x06, 0x3d, 0x8f, 0x33, 0xf1, 0x02, 0xc2, 0x57,
+	0x9e, 0xf9, 0x42, 0xe8, 0x4c, 0xbb, 0x2f, 0x54, 0x87, 0xde, 0x4d, 0x36, 0x3a, 0x8a, 0x35, 0xd2,
+	0x64, 0xf3, 0x5c, 0x07, 0x09, 0xe1, 0x67, 0x92, 0xf9, 0x19, 0x41, 0xc3, 0xed, 0x7e, 0x1c, 0xf4,
+	0x2b, 0x89, 0x93, 0x29, 0xb2, 0x17, 0x4b, 0x66, 0x30, 0x83, 0x73, 0x49, 0x62, 0xc2, 0xf1, 0x02,
+	0x73, 0x3c, 0x8b, 0x70, 0xc8, 0x71, 0xe9, 0xa7, 0xa2, 0xcd, 0x1e, 0x7b, 0x59, 0x25, 0xd0, 0xef,
+	0x0d, 0x8d, 0xd0, 0xf9, 0x28, 0xfb, 0x6d, 0xa3, 0x26, 0x79, 0xb6, 0xb3, 0x90, 0x80, 0x30, 0xcd,
+	0x20, 0x4c, 0xa0, 0x31, 0x3f, 0x84, 0xe6, 0x24, 0x0a, 0xfd, 0x46, 0x82, 0xc1, 0xe0, 0x58, 0x09,
+	0x5d, 0x8a, 0xb2, 0x1b, 0x39, 0x99, 0x92, 0x17, 0xd2, 0x88, 0x0a, 0x20, 0xe7, 0x19, 0x90, 0x69,
+	0x34, 0xe5, 0x07, 0xc2, 0xa7, 0x19, 0xcd, 0x69, 0x0b, 0xfa, 0x9b, 0x04, 0x28, 0x3c, 0x0b, 0x42,
+	0x8b, 0xb1, 0x7e, 0xa2, 0xe6, 0x4d, 0xb2, 0x92, 0x56, 0x5c, 0x40, 0xfb, 0x1a, 0x83, 0xb6, 0x8c,
+	0x2e, 0x77, 0x4a, 0x13, 0x87, 0xca, 0x7e, 0xb6, 0xf0, 0x1e, 0x49, 0x30, 0xe0, 0x9b, 0xf2, 0xa0,
+	0xb9, 0x58, 0xcf, 0x81, 0xd1, 0x91, 0x7c, 0x31, 0x51, 0x4e, 0x40, 0xbb, 0xcc, 0xa0, 0x2d, 0xa0,
+	0xf9, 0x64, 0x68, 0xfc, 0x33, 0x85, 0x7e, 0x29, 0x41, 0xae, 0x39, 0x81, 0x41, 0x91, 0x45, 0xd2,
+	0x3e, 0x20, 0x92, 0x2f, 0x24, 0x48, 0x65, 0x2a, 0x67, 0xaa, 0xe2, 0xa0, 0xdf, 0x4a, 0x30, 0x18,
+	0x9c, 0x68, 0x44, 0x17, 0x56, 0xe4, 0xc8, 0x47, 0x5e, 0x48, 0x23, 0x2a, 0x50, 0xcd, 0x33, 0x54,
+	0x18, 0xcd, 0x74, 0xa4, 0x88, 0x02, 0xf8, 0xb7, 0x04, 0x93, 0xeb, 0x0e, 0xd1, 0x0d, 0x95, 0x68,
+	0xa1, 0x17, 0x1d, 0xfa, 0x72, 0x24, 0x09, 0x31, 0xb3, 0x11, 0x79, 0x31, 0xa5, 0xb4, 0x00, 0xf9,
+	0x75, 0x06, 0xf2, 0x2b, 0x68, 0xc5, 0x0f, 0xb2, 0x05, 0x4f, 0x13, 0xa8, 0x4a, 0xce, 0xbe, 0x6a,
+	0x57, 0x34, 0x6a, 0xa2, 0xa2, 0x32, 0x1b, 0x15, 0xdd, 0x44, 0xff, 0x95, 0x40, 0x8e, 0xc1, 0x4d,
+	0xaf, 0xc6, 0x69, 0xa0, 0xb4, 0x8e, 0x78, 0x59, 0x49, 0x2b, 0x2e, 0xa0, 0xdf, 0x60, 0xd0, 0xaf,
+	0xa2, 0x2b, 0x99, 0xa1, 0x5b, 0x2e, 0x41, 0xff, 0x97, 0xa0, 0x18, 0xcb, 0x39, 0x7f, 0xb8, 0xa1,
+	0xe5, 0x74, 0x5c, 0xfa, 0x9f, 0xaf, 0xf2, 0x4a, 0x26, 0x9d, 0x4e, 0x8d, 0xde, 0x91, 0xfb, 0x8a,
+	0x78, 0xa3, 0x3e, 0x91, 0x60, 0x26, 0x3e, 0x05, 0x22, 0x8e, 0x95, 0x94, 0xcc, 0x06, 0x02, 0xb9,
+	0x92, 0x4d, 0x49, 0x44, 0x72, 0x8d, 0x45, 0xb2, 0x82, 0x96, 0x52, 0x46, 0x42, 0x6f, 0xaf, 0x22,
+	0x14, 0x7f, 0x17, 0x84, 0x5e, 0x78, 0xd1, 0x5d, 0x10, 0xf7, 0x82, 0x95, 0x17, 0x53, 0x4a, 0x67,
+	0xec, 0x82, 0x9f, 0x58, 0xba, 0xd9, 0xb1, 0x0b, 0xc2, 0x0f, 0x1b, 0x94, 0x06, 0x4a, 0x52, 0x17,
+	0xc4, 0xbf, 0x97, 0x52, 0x77, 0x41, 0x18, 0x3a, 0xed, 0x02, 0x3f, 0xe7, 0xa1, 0x07, 0x42, 0x34,
+	0xe7, 0x71, 0x2f, 0x23, 0x79, 0x31, 0xa5, 0x74, 0x46, 0xce, 0xb5, 0x03, 0x9d, 0x74, 0xe4, 0x3c,
+	0x7c, 0xef, 0x46, 0x69, 0xa0, 0x24, 0x71, 0x1e, 0x7f, 0x9d, 0x4f, 0xcd, 0x79, 0x18, 0xba, 0xe5,
+	0x92, 0xd5, 0xb5, 0x27, 0xcf, 0x0b, 0xd2, 0xd3, 0xe7, 0x05, 0xe9, 0xa3, 0xe7, 0x05, 0xe9, 0xe8,
+	0x45, 0xa1, 0xeb, 0xe9, 0x8b, 0x42, 0xd7, 0x07, 0x2f, 0x0a, 0x5d, 0x3f, 0xbc, 0xe4, 0x7b, 0x06,
+	0xdc, 0x65, 0x96, 0xd7, 0xb6, 0x55, 0xdd, 0xf4, 0xbc, 0x1c, 0x30, 0x3f, 0xec, 0x35, 0xb0, 0xd5,
+	0xc7, 0x66, 0xf8, 0x2b, 0x9f, 0x0e, 0x00, 0xb9, 0x5d, 0x88, 0xd0, 0x7a, 0x1f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1647,12 +2058,20 @@ type QueryClient interface {
 	TotalShares(ctx context.Context, in *QueryTotalSharesRequest, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error)
 	// Instantaneous price of an asset in a pool.
 	SpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error)
+	// Time weighted average price of an asset in a pool, between two times.
+	ArithmeticTwap(ctx context.Context, in *QueryArithmeticTwapRequest, opts ...grpc.CallOption) (*QueryArithmeticTwapResponse, error)
 	// Estimates the amount of assets returned given an exact amount of tokens to
 	// swap.
 	EstimateSwapExactAmountIn(ctx context.Context, in *QuerySwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountInResponse, error)
 	// Estimates the amount of tokens required to return the exact amount of
 	// assets requested.
 	EstimateSwapExactAmountOut(ctx context.Context, in *QuerySwapExactAmountOutRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountOutResponse, error)
+	// Estimates the amount of tokens out of a swap along a route of pools. Without
+	// a route, the route giving the most tokens out is found.
+	EstimateSwapExactAmountInRoutes(ctx context.Context, in *QuerySwapExactAmountInRoutesRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountInRoutesResponse, error)
+	// Estimates the amount of tokens in required by a swap along a route of
+	// pools. Without a route, the route requiring the fewest tokens in is found.
+	EstimateSwapExactAmountOutRoutes(ctx context.Context, in *QuerySwapExactAmountOutRoutesRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountOutRoutesResponse, error)
 	// Estimates the amount of pool shares returned given an amount of tokens to
 	// join.
 	EstimateJoinExactAmountIn(ctx context.Context, in *QueryJoinExactAmountInRequest, opts ...grpc.CallOption) (*QueryJoinExactAmountInResponse, error)
@@ -1765,6 +2184,15 @@ func (c *queryClient) SpotPrice(ctx context.Context, in *QuerySpotPriceRequest,
 	return out, nil
 }
 
+func (c *queryClient) ArithmeticTwap(ctx context.Context, in *QueryArithmeticTwapRequest, opts ...grpc.CallOption) (*QueryArithmeticTwapResponse, error) {
+	out := new(QueryArithmeticTwapResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.dex.v1.Query/ArithmeticTwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) EstimateSwapExactAmountIn(ctx context.Context, in *QuerySwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountInResponse, error) {
 	out := new(QuerySwapExactAmountInResponse)
 	err := c.cc.Invoke(ctx, "/nibiru.dex.v1.Query/EstimateSwapExactAmountIn", in, out, opts...)
@@ -1783,6 +2211,24 @@ func (c *queryClient) EstimateSwapExactAmountOut(ctx context.Context, in *QueryS
 	return out, nil
 }
 
+func (c *queryClient) EstimateSwapExactAmountInRoutes(ctx context.Context, in *QuerySwapExactAmountInRoutesRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountInRoutesResponse, error) {
+	out := new(QuerySwapExactAmountInRoutesResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.dex.v1.Query/EstimateSwapExactAmountInRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateSwapExactAmountOutRoutes(ctx context.Context, in *QuerySwapExactAmountOutRoutesRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountOutRoutesResponse, error) {
+	out := new(QuerySwapExactAmountOutRoutesResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.dex.v1.Query/EstimateSwapExactAmountOutRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) EstimateJoinExactAmountIn(ctx context.Context, in *QueryJoinExactAmountInRequest, opts ...grpc.CallOption) (*QueryJoinExactAmountInResponse, error) {
 	out := new(QueryJoinExactAmountInResponse)
 	err := c.cc.Invoke(ctx, "/nibiru.dex.v1.Query/EstimateJoinExactAmountIn", in, out, opts...)
@@ -1841,12 +2287,20 @@ type QueryServer interface {
 	TotalShares(context.Context, *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error)
 	// Instantaneous price of an asset in a pool.
 	SpotPrice(context.Context, *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error)
+	// Time weighted average price of an asset in a pool, between two times.
+	ArithmeticTwap(context.Context, *QueryArithmeticTwapRequest) (*QueryArithmeticTwapResponse, error)
 	// Estimates the amount of assets returned given an exact amount of tokens to
 	// swap.
 	EstimateSwapExactAmountIn(context.Context, *QuerySwapExactAmountInRequest) (*QuerySwapExactAmountInResponse, error)
 	// Estimates the amount of tokens required to return the exact amount of
 	// assets requested.
 	EstimateSwapExactAmountOut(context.Context, *QuerySwapExactAmountOutRequest) (*QuerySwapExactAmountOutResponse, error)
+	// Estimates the amount of tokens out of a swap along a route of pools. Without
+	// a route, the route giving the most tokens out is found.
+	EstimateSwapExactAmountInRoutes(context.Context, *QuerySwapExactAmountInRoutesRequest) (*QuerySwapExactAmountInRoutesResponse, error)
+	// Estimates the amount of tokens in required by a swap along a route of
+	// pools. Without a route, the route requiring the fewest tokens in is found.
+	EstimateSwapExactAmountOutRoutes(context.Context, *QuerySwapExactAmountOutRoutesRequest) (*QuerySwapExactAmountOutRoutesResponse, error)
 	// Estimates the amount of pool shares returned given an amount of tokens to
 	// join.
 	EstimateJoinExactAmountIn(context.Context, *QueryJoinExactAmountInRequest) (*QueryJoinExactAmountInResponse, error)
@@ -1895,12 +2349,21 @@ func (*UnimplementedQueryServer) TotalShares(ctx context.Context, req *QueryTota
 func (*UnimplementedQueryServer) SpotPrice(ctx context.Context, req *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SpotPrice not implemented")
 }
+func (*UnimplementedQueryServer) ArithmeticTwap(ctx context.Context, req *QueryArithmeticTwapRequest) (*QueryArithmeticTwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArithmeticTwap not implemented")
+}
 func (*UnimplementedQueryServer) EstimateSwapExactAmountIn(ctx context.Context, req *QuerySwapExactAmountInRequest) (*QuerySwapExactAmountInResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountIn not implemented")
 }
 func (*UnimplementedQueryServer) EstimateSwapExactAmountOut(ctx context.Context, req *QuerySwapExactAmountOutRequest) (*QuerySwapExactAmountOutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountOut not implemented")
 }
+func (*UnimplementedQueryServer) EstimateSwapExactAmountInRoutes(ctx context.Context, req *QuerySwapExactAmountInRoutesRequest) (*QuerySwapExactAmountInRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountInRoutes not implemented")
+}
+func (*UnimplementedQueryServer) EstimateSwapExactAmountOutRoutes(ctx context.Context, req *QuerySwapExactAmountOutRoutesRequest) (*QuerySwapExactAmountOutRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountOutRoutes not implemented")
+}
 func (*UnimplementedQueryServer) EstimateJoinExactAmountIn(ctx context.Context, req *QueryJoinExactAmountInRequest) (*QueryJoinExactAmountInResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateJoinExactAmountIn not implemented")
 }
@@ -2098,6 +2561,24 @@ func _Query_SpotPrice_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ArithmeticTwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryArithmeticTwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ArithmeticTwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.dex.v1.Query/ArithmeticTwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ArithmeticTwap(ctx, req.(*QueryArithmeticTwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_EstimateSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuerySwapExactAmountInRequest)
 	if err := dec(in); err != nil {
@@ -2134,6 +2615,42 @@ func _Query_EstimateSwapExactAmountOut_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EstimateSwapExactAmountInRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapExactAmountInRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSwapExactAmountInRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.dex.v1.Query/EstimateSwapExactAmountInRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSwapExactAmountInRoutes(ctx, req.(*QuerySwapExactAmountInRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateSwapExactAmountOutRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapExactAmountOutRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSwapExactAmountOutRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.dex.v1.Query/EstimateSwapExactAmountOutRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSwapExactAmountOutRoutes(ctx, req.(*QuerySwapExactAmountOutRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_EstimateJoinExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryJoinExactAmountInRequest)
 	if err := dec(in); err != nil {
@@ -2250,6 +2767,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SpotPrice",
 			Handler:    _Query_SpotPrice_Handler,
 		},
+		{
+			MethodName: "ArithmeticTwap",
+			Handler:    _Query_ArithmeticTwap_Handler,
+		},
 		{
 			MethodName: "EstimateSwapExactAmountIn",
 			Handler:    _Query_EstimateSwapExactAmountIn_Handler,
@@ -2258,6 +2779,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateSwapExactAmountOut",
 			Handler:    _Query_EstimateSwapExactAmountOut_Handler,
 		},
+		{
+			MethodName: "EstimateSwapExactAmountInRoutes",
+			Handler:    _Query_EstimateSwapExactAmountInRoutes_Handler,
+		},
+		{
+			MethodName: "EstimateSwapExactAmountOutRoutes",
+			Handler:    _Query_EstimateSwapExactAmountOutRoutes_Handler,
+		},
 		{
 			MethodName: "EstimateJoinExactAmountIn",
 			Handler:    _Query_EstimateJoinExactAmountIn_Handler,
@@ -2905,6 +3434,97 @@ func (m *QuerySpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryArithmeticTwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryArithmeticTwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryArithmeticTwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x2a
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryArithmeticTwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryArithmeticTwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryArithmeticTwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ArithmeticTwap.Size()
+		i -= size
+		if _, err := m.ArithmeticTwap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QuerySwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3061,7 +3681,7 @@ func (m *QuerySwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryJoinExactAmountInRequest) Marshal() (dAtA []byte, err error) {
+func (m *QuerySwapExactAmountInRoutesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3071,20 +3691,27 @@ func (m *QueryJoinExactAmountInRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryJoinExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySwapExactAmountInRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryJoinExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySwapExactAmountInRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TokensIn) > 0 {
-		for iNdEx := len(m.TokensIn) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TokensIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3095,15 +3722,20 @@ func (m *QueryJoinExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 			dAtA[i] = 0x12
 		}
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryJoinExactAmountInResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySwapExactAmountInRoutesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3113,20 +3745,20 @@ func (m *QueryJoinExactAmountInResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryJoinExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySwapExactAmountInRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryJoinExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySwapExactAmountInRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RemCoins) > 0 {
-		for iNdEx := len(m.RemCoins) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.RemCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3138,11 +3770,11 @@ func (m *QueryJoinExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		}
 	}
 	{
-		size := m.PoolSharesOut.Size()
-		i -= size
-		if _, err := m.PoolSharesOut.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
@@ -3150,7 +3782,7 @@ func (m *QueryJoinExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryJoinExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
+func (m *QuerySwapExactAmountOutRoutesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3160,25 +3792,51 @@ func (m *QueryJoinExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryJoinExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySwapExactAmountOutRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryJoinExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySwapExactAmountOutRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenInDenom)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1a
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryJoinExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySwapExactAmountOutRoutesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3188,20 +3846,44 @@ func (m *QueryJoinExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryJoinExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySwapExactAmountOutRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryJoinExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySwapExactAmountOutRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryExitExactAmountInRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryJoinExactAmountInRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3211,26 +3893,30 @@ func (m *QueryExitExactAmountInRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryExitExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryJoinExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryExitExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryJoinExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.PoolSharesIn.Size()
-		i -= size
-		if _, err := m.PoolSharesIn.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.TokensIn) > 0 {
+		for iNdEx := len(m.TokensIn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
 	if m.PoolId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
@@ -3239,7 +3925,7 @@ func (m *QueryExitExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryExitExactAmountInResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryJoinExactAmountInResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3249,20 +3935,20 @@ func (m *QueryExitExactAmountInResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryExitExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryJoinExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryExitExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryJoinExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TokensOut) > 0 {
-		for iNdEx := len(m.TokensOut) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.RemCoins) > 0 {
+		for iNdEx := len(m.RemCoins) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TokensOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RemCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3270,7 +3956,174 @@ func (m *QueryExitExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.PoolSharesOut.Size()
+		i -= size
+		if _, err := m.PoolSharesOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryJoinExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryJoinExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryJoinExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PoolSharesOut.Size()
+		i -= size
+		if _, err := m.PoolSharesOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryJoinExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryJoinExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryJoinExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokensIn) > 0 {
+		for iNdEx := len(m.TokensIn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExitExactAmountInRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExitExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExitExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.PoolSharesIn.Size()
+		i -= size
+		if _, err := m.PoolSharesIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExitExactAmountInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExitExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExitExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokensOut) > 0 {
+		for iNdEx := len(m.TokensOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
 	return len(dAtA) - i, nil
@@ -3296,6 +4149,20 @@ func (m *QueryExitExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.TokensOut) > 0 {
+		for iNdEx := len(m.TokensOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.PoolId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
@@ -3324,6 +4191,16 @@ func (m *QueryExitExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.PoolSharesIn.Size()
+		i -= size
+		if _, err := m.PoolSharesIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -3589,6 +4466,41 @@ func (m *QuerySpotPriceResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryArithmeticTwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryArithmeticTwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ArithmeticTwap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QuerySwapExactAmountInRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3647,34 +4559,37 @@ func (m *QuerySwapExactAmountOutResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryJoinExactAmountInRequest) Size() (n int) {
+func (m *QuerySwapExactAmountInRoutesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if len(m.TokensIn) > 0 {
-		for _, e := range m.TokensIn {
+	l = m.TokenIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryJoinExactAmountInResponse) Size() (n int) {
+func (m *QuerySwapExactAmountInRoutesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.PoolSharesOut.Size()
+	l = m.TokenOut.Size()
 	n += 1 + l + sovQuery(uint64(l))
-	if len(m.RemCoins) > 0 {
-		for _, e := range m.RemCoins {
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -3682,24 +4597,105 @@ func (m *QueryJoinExactAmountInResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryJoinExactAmountOutRequest) Size() (n int) {
+func (m *QuerySwapExactAmountOutRoutesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	l = m.TokenOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryJoinExactAmountOutResponse) Size() (n int) {
+func (m *QuerySwapExactAmountOutRoutesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = m.TokenIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryJoinExactAmountInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if len(m.TokensIn) > 0 {
+		for _, e := range m.TokensIn {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryJoinExactAmountInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PoolSharesOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.RemCoins) > 0 {
+		for _, e := range m.RemCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryJoinExactAmountOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = m.PoolSharesOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryJoinExactAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokensIn) > 0 {
+		for _, e := range m.TokensIn {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3714,6 +4710,10 @@ func (m *QueryExitExactAmountInRequest) Size() (n int) {
 	}
 	l = m.PoolSharesIn.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3741,6 +4741,12 @@ func (m *QueryExitExactAmountOutRequest) Size() (n int) {
 	if m.PoolId != 0 {
 		n += 1 + sovQuery(uint64(m.PoolId))
 	}
+	if len(m.TokensOut) > 0 {
+		for _, e := range m.TokensOut {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3750,6 +4756,8 @@ func (m *QueryExitExactAmountOutResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.PoolSharesIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -4790,30 +5798,666 @@ func (m *QueryTotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalPoolLiquidityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalPoolLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalPoolLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalPoolLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liquidity = append(m.Liquidity, types.Coin{})
+			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalSharesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalSharesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalSharesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalSharesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpotPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpotPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpotPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryArithmeticTwapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryArithmeticTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalPoolLiquidityRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalPoolLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4823,11 +6467,25 @@ func (m *QueryTotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4849,7 +6507,7 @@ func (m *QueryTotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryArithmeticTwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4872,17 +6530,17 @@ func (m *QueryTotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalPoolLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryArithmeticTwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalPoolLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryArithmeticTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArithmeticTwap", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4892,23 +6550,23 @@ func (m *QueryTotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Liquidity = append(m.Liquidity, types.Coin{})
-			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ArithmeticTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4933,7 +6591,7 @@ func (m *QueryTotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalSharesRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4956,10 +6614,10 @@ func (m *QueryTotalSharesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalSharesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwapExactAmountInRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4981,6 +6639,71 @@ func (m *QueryTotalSharesRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5002,7 +6725,7 @@ func (m *QueryTotalSharesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalSharesResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5025,15 +6748,15 @@ func (m *QueryTotalSharesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalSharesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwapExactAmountInResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5060,7 +6783,7 @@ func (m *QueryTotalSharesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5085,7 +6808,7 @@ func (m *QueryTotalSharesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5108,10 +6831,10 @@ func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpotPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwapExactAmountOutRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwapExactAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5135,9 +6858,9 @@ func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5147,27 +6870,28 @@ func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5195,7 +6919,7 @@ func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5218,7 +6942,7 @@ func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5241,17 +6965,17 @@ func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpotPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwapExactAmountOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5261,23 +6985,24 @@ func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpotPrice = string(dAtA[iNdEx:postIndex])
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5300,7 +7025,7 @@ func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapExactAmountInRoutesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5323,17 +7048,17 @@ func (m *QuerySwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapExactAmountInRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwapExactAmountInRoutesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwapExactAmountInRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5343,14 +7068,28 @@ func (m *QuerySwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5377,7 +7116,8 @@ func (m *QuerySwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Routes = append(m.Routes, SwapRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5434,7 +7174,7 @@ func (m *QuerySwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapExactAmountInRoutesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5444,28 +7184,61 @@ func (m *QuerySwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapExactAmountInRoutesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapExactAmountInRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapExactAmountInResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5492,7 +7265,8 @@ func (m *QuerySwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Routes = append(m.Routes, SwapRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5517,7 +7291,7 @@ func (m *QuerySwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapExactAmountOutRoutesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5540,17 +7314,17 @@ func (m *QuerySwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapExactAmountOutRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwapExactAmountOutRoutesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapExactAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwapExactAmountOutRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5560,14 +7334,28 @@ func (m *QuerySwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5594,7 +7382,8 @@ func (m *QuerySwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Routes = append(m.Routes, SwapRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5651,7 +7440,7 @@ func (m *QuerySwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapExactAmountOutRoutesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5674,13 +7463,13 @@ func (m *QuerySwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapExactAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwapExactAmountOutRoutesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwapExactAmountOutRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
@@ -5713,6 +7502,40 @@ func (m *QuerySwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6003,6 +7826,40 @@ func (m *QueryJoinExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolSharesOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolSharesOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6053,6 +7910,40 @@ func (m *QueryJoinExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: QueryJoinExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensIn = append(m.TokensIn, types.Coin{})
+			if err := m.TokensIn[len(m.TokensIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6156,6 +8047,38 @@ func (m *QueryExitExactAmountInRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6309,6 +8232,40 @@ func (m *QueryExitExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensOut = append(m.TokensOut, types.Coin{})
+			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6359,6 +8316,40 @@ func (m *QueryExitExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: QueryExitExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolSharesIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolSharesIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
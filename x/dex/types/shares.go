@@ -2,74 +2,95 @@ package types
 
 import (
 	"errors"
-	math "math"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/dex/math"
 )
 
 /*
-For 2 asset pools, swap first to maximize the amount of tokens deposited in the pool.
-A user can deposit either one or 2 tokens, and we will swap first the biggest individual share and then join the pool.
+Calculates the number of pool shares minted for a deposit of a single asset,
+following the weighted invariant. Depositing a single asset is equivalent to
+a proportional join followed by a swap of the other assets back to tokenIn, so
+the swap fee is only charged on that part of the deposit:
+
+tokenInAfterFee = tokenIn * (1 - swapFee * (1 - weightIn/totalWeight))
+numShares = totalShares * ((1 + tokenInAfterFee/balanceIn)^(weightIn/totalWeight) - 1)
+
+Note that this function is pure/read-only. It only calculates the theoretical amount
+and doesn't modify the actual state.
 
 args:
-  - tokensIn: the tokens to add to the pool
+  - tokenIn: the token to add to the pool
 
 ret:
-  - out: the tokens to swap before joining the pool
-  - remCoins: the number of coins remaining after the deposit
+  - numShares: the number of LP shares minted for the deposit
   - err: error if any
 */
-func (pool *Pool) SwapForSwapAndJoin(tokensIn sdk.Coins) (
-	out sdk.Coin, err error,
+func (pool Pool) numSharesOutFromSingleTokenIn(tokenIn sdk.Coin) (
+	numShares sdk.Int, err error,
 ) {
-	if len(pool.PoolAssets) != 2 {
-		err = errors.New("swap and add tokens to pool only available for 2 assets pool")
-		return
+	_, poolAssetIn, err := pool.getPoolAssetAndIndex(tokenIn.Denom)
+	if err != nil {
+		return sdk.ZeroInt(), err
 	}
 
-	var xAmt sdk.Int
-	var yAmt sdk.Int
-	var xDenom string
+	normalizedWeight := poolAssetIn.Weight.ToDec().QuoInt(pool.TotalWeight)
+	feeRatio := sdk.OneDec().Sub(pool.PoolParams.SwapFee.Mul(sdk.OneDec().Sub(normalizedWeight)))
+	tokenAmountInAfterFee := tokenIn.Amount.ToDec().Mul(feeRatio)
 
-	// check who's x and y (x/)
-	if len(tokensIn) == 1 {
-		xAmt = tokensIn[0].Amount
-		xDenom = tokensIn[0].Denom
+	balanceRatio := sdk.OneDec().Add(tokenAmountInAfterFee.QuoInt(poolAssetIn.Token.Amount))
+	shareRatio := math.Pow(balanceRatio, normalizedWeight).Sub(sdk.OneDec())
 
-		yAmt = sdk.ZeroInt()
-	} else {
-		// 2 assets
-		poolLiquidity := pool.PoolBalances()
+	return shareRatio.MulInt(pool.TotalShares.Amount).TruncateInt(), nil
+}
 
-		sharePctX := tokensIn[0].Amount.ToDec().Quo(poolLiquidity.AmountOfNoDenomValidation(tokensIn[0].Denom).ToDec())
-		sharePctY := tokensIn[1].Amount.ToDec().Quo(poolLiquidity.AmountOfNoDenomValidation(tokensIn[1].Denom).ToDec())
+/*
+Calculates the amount of a single asset withdrawn for LP shares returned to the
+pool, following the weighted invariant. It is the inverse of
+numSharesOutFromSingleTokenIn, and the swap fee is charged on the part of the
+withdrawal that is not in the pool's proportions, before the exit fee:
 
-		if sharePctX.GTE(sharePctY) {
-			xAmt = tokensIn[0].Amount
-			yAmt = tokensIn[1].Amount
+tokenOutBeforeFee = balanceOut * (1 - (1 - numSharesIn/totalShares)^(totalWeight/weightOut))
+tokenOut = tokenOutBeforeFee * (1 - swapFee * (1 - weightOut/totalWeight)) * (1 - exitFee)
 
-			xDenom = tokensIn[0].Denom
-		} else {
-			xAmt = tokensIn[1].Amount
-			yAmt = tokensIn[0].Amount
+Note that this function is pure/read-only. It only calculates the theoretical amount
+and doesn't modify the actual state.
 
-			xDenom = tokensIn[1].Denom
-		}
+args:
+  - numSharesIn: number of LP shares to return to the pool
+  - tokenOutDenom: the denom of the withdrawn token
+
+ret:
+  - tokenOut: the token withdrawn from the pool
+  - err: error if any
+*/
+func (pool Pool) TokenOutFromPoolSharesInSingleAsset(numSharesIn sdk.Int, tokenOutDenom string) (
+	tokenOut sdk.Coin, err error,
+) {
+	if !numSharesIn.IsPositive() {
+		return tokenOut, errors.New("num shares in must be greater than zero")
+	}
+	if numSharesIn.GTE(pool.TotalShares.Amount) {
+		return tokenOut, errors.New("num shares in must be lower than the pool's total shares to exit in a single asset")
 	}
 
-	xIndex, xPoolAsset, err := pool.getPoolAssetAndIndex(xDenom)
-	liquidityX := xPoolAsset.Token.Amount
-	liquidityY := pool.PoolAssets[1-xIndex].Token.Amount
+	_, poolAssetOut, err := pool.getPoolAssetAndIndex(tokenOutDenom)
+	if err != nil {
+		return tokenOut, err
+	}
 
-	// x'=\sqrt{\frac{xk+kl_x}{y+l_y}}-l_x;\:x'=-\sqrt{\frac{xk+kl_x}{y+l_y}}-l_x
-	invariant := liquidityX.Mul(liquidityY)
+	normalizedWeight := poolAssetOut.Weight.ToDec().QuoInt(pool.TotalWeight)
+	shareRatio := numSharesIn.ToDec().QuoInt(pool.TotalShares.Amount)
+	balanceRatio := math.Pow(sdk.OneDec().Sub(shareRatio), sdk.OneDec().Quo(normalizedWeight))
 
-	xSwap := sdk.NewInt(
-		int64(math.Sqrt(
-			(xAmt.Mul(invariant).Add(invariant.Mul(liquidityX))).ToDec().Quo(
-				yAmt.Add(liquidityY).ToDec()).MustFloat64()))).Sub(liquidityX)
+	tokenAmountOut := poolAssetOut.Token.Amount.ToDec().Mul(sdk.OneDec().Sub(balanceRatio)).
+		Mul(sdk.OneDec().Sub(pool.PoolParams.SwapFee.Mul(sdk.OneDec().Sub(normalizedWeight)))).
+		Mul(sdk.OneDec().Sub(pool.PoolParams.ExitFee)).
+		TruncateInt()
 
-	return sdk.NewCoin(pool.PoolAssets[xIndex].Token.Denom, xSwap), err
+	return sdk.NewCoin(tokenOutDenom, tokenAmountOut), nil
 }
 
 /*
@@ -181,6 +202,122 @@ func (pool Pool) TokensOutFromPoolSharesIn(numSharesIn sdk.Int) (
 	return tokensOut, nil
 }
 
+/*
+Calculates the tokens to deposit, in the pool's proportions, to obtain an exact
+number of LP shares. It is the inverse of numSharesOutFromTokensIn, and each
+amount is rounded up so that the pool never mints shares for free:
+
+tokenIn = ceil(numSharesOut / totalShares * balanceIn)
+
+args:
+  - numSharesOut: the number of LP shares to mint
+
+ret:
+  - tokensIn: the tokens to deposit into the pool
+  - err: error if any
+*/
+func (pool Pool) TokensInFromPoolSharesOut(numSharesOut sdk.Int) (
+	tokensIn sdk.Coins, err error,
+) {
+	if !numSharesOut.IsPositive() {
+		return sdk.Coins{}, errors.New("num shares out must be greater than zero")
+	}
+
+	shareRatio := numSharesOut.ToDec().QuoInt(pool.TotalShares.Amount)
+	tokensIn = sdk.Coins{}
+	for _, coin := range pool.PoolBalances() {
+		tokenInAmt := shareRatio.MulInt(coin.Amount).Ceil().TruncateInt()
+		tokensIn = tokensIn.Add(sdk.NewCoin(coin.Denom, tokenInAmt))
+	}
+	return tokensIn, nil
+}
+
+/*
+Calculates the number of LP shares to return to the pool to withdraw an exact
+amount of tokens, exit fee included. It is the inverse of
+TokensOutFromPoolSharesIn followed by TokenOutFromPoolSharesInSingleAsset: the
+largest part of tokensOut in the pool's proportions is exited proportionally,
+and the rest is exited one asset at a time along the weighted invariant,
+paying the swap fee.
+
+ratio = min(tokenOut / (balanceOut * (1 - exitFee)))
+numSharesIn = ratio * totalShares
+
+then for the remaining tokenOut of each asset:
+
+tokenOutBeforeFee = tokenOut / ((1 - swapFee * (1 - weightOut/totalWeight)) * (1 - exitFee))
+numSharesIn = totalShares * (1 - (1 - tokenOutBeforeFee/balanceOut)^(weightOut/totalWeight))
+
+The shares are rounded up. Note that this function is pure/read-only.
+
+args:
+  - tokensOut: the tokens to withdraw, any subset of the pool's assets
+
+ret:
+  - numSharesIn: the number of LP shares to burn
+  - err: error if any
+*/
+func (pool Pool) PoolSharesInFromTokensOut(tokensOut sdk.Coins) (
+	numSharesIn sdk.Int, err error,
+) {
+	if !tokensOut.IsValid() || tokensOut.Empty() {
+		return sdk.ZeroInt(), fmt.Errorf("invalid tokens out %s", tokensOut)
+	}
+
+	balances := make([]sdk.Dec, len(pool.PoolAssets))
+	remaining := make([]sdk.Dec, len(pool.PoolAssets))
+	for i, asset := range pool.PoolAssets {
+		balances[i] = asset.Token.Amount.ToDec()
+		remaining[i] = sdk.ZeroDec()
+	}
+	for _, tokenOut := range tokensOut {
+		i, _, err := pool.getPoolAssetAndIndex(tokenOut.Denom)
+		if err != nil {
+			return sdk.ZeroInt(), err
+		}
+		if tokenOut.Amount.GTE(pool.PoolAssets[i].Token.Amount) {
+			return sdk.ZeroInt(), fmt.Errorf("tokenOut (%s) must be lower than the pool's balance", tokenOut)
+		}
+		remaining[i] = tokenOut.Amount.ToDec()
+	}
+	totalShares := pool.TotalShares.Amount.ToDec()
+	exitFeeRatio := sdk.OneDec().Sub(pool.PoolParams.ExitFee)
+
+	// proportional exit
+	ratio := sdk.MaxSortableDec
+	for i := range balances {
+		ratio = sdk.MinDec(ratio, remaining[i].Quo(balances[i].Mul(exitFeeRatio)))
+	}
+	sharesIn := ratio.Mul(totalShares)
+	for i := range balances {
+		exited := ratio.Mul(balances[i]).Mul(exitFeeRatio)
+		remaining[i] = remaining[i].Sub(exited)
+		balances[i] = balances[i].Sub(exited)
+	}
+	totalShares = totalShares.Sub(sharesIn)
+
+	// single asset exits
+	for i, asset := range pool.PoolAssets {
+		if !remaining[i].IsPositive() {
+			continue
+		}
+		normalizedWeight := asset.Weight.ToDec().QuoInt(pool.TotalWeight)
+		feeRatio := sdk.OneDec().Sub(pool.PoolParams.SwapFee.Mul(sdk.OneDec().Sub(normalizedWeight))).Mul(exitFeeRatio)
+		tokenAmountOutBeforeFee := remaining[i].Quo(feeRatio)
+		if tokenAmountOutBeforeFee.GTE(balances[i]) {
+			return sdk.ZeroInt(), fmt.Errorf("not enough %s in the pool to withdraw %s", asset.Token.Denom, tokensOut)
+		}
+
+		balanceRatio := math.Pow(sdk.OneDec().Sub(tokenAmountOutBeforeFee.Quo(balances[i])), normalizedWeight)
+		singleAssetShares := totalShares.Mul(sdk.OneDec().Sub(balanceRatio))
+		sharesIn = sharesIn.Add(singleAssetShares)
+		balances[i] = balances[i].Sub(remaining[i])
+		totalShares = totalShares.Sub(singleAssetShares)
+	}
+
+	return sharesIn.Ceil().TruncateInt(), nil
+}
+
 /*
 Adds new liquidity to the pool and increments the total number of shares.
 
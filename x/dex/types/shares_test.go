@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"math/rand"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -133,21 +134,21 @@ func TestMaximalSharesFromExactRatioJoin(t *testing.T) {
 	}
 }
 
-func TestSwapForSwapAndJoin(t *testing.T) {
+func TestNumSharesOutFromSingleTokenIn(t *testing.T) {
 	for _, tc := range []struct {
-		name             string
-		poolAssets       []PoolAsset
-		existingShares   int64
-		tokensIn         sdk.Coins
-		expectedX0Denom  string
-		expectedX0Amount int64
-		err              error
+		name              string
+		poolAssets        []PoolAsset
+		swapFee           sdk.Dec
+		tokenIn           sdk.Coin
+		expectedNumShares sdk.Dec
+		err               error
 	}{
 		{
-			name: "tokens bbb need to be swapped, but no swap necessary",
+			// 100e18 * ((1 + 928/230)^(1/2) - 1)
+			name: "equal weights",
 			poolAssets: []PoolAsset{
 				{
-					Token:  sdk.NewInt64Coin("aaa", 1000),
+					Token:  sdk.NewInt64Coin("aaa", 230),
 					Weight: sdk.NewInt(1 << 30),
 				},
 				{
@@ -155,59 +156,49 @@ func TestSwapForSwapAndJoin(t *testing.T) {
 					Weight: sdk.NewInt(1 << 30),
 				},
 			},
-			existingShares: 100,
-			tokensIn: sdk.NewCoins(
-				sdk.NewInt64Coin("aaa", 100),
-				sdk.NewInt64Coin("bbb", 101),
-			),
-			expectedX0Denom:  "bbb",
-			expectedX0Amount: 0,
+			swapFee:           sdk.ZeroDec(),
+			tokenIn:           sdk.NewInt64Coin("aaa", 928),
+			expectedNumShares: sdk.MustNewDecFromStr("124383212578295441738.985394429865913893"),
 		},
 		{
-			name: "tokens aaa need to be swapped, but no swap necessary",
+			// 100e18 * ((1 + 928*(1-0.003*(1-0.8))/230)^(4/5) - 1)
+			name: "80/20 pool, heavy asset",
 			poolAssets: []PoolAsset{
 				{
-					Token:  sdk.NewInt64Coin("aaa", 1000),
-					Weight: sdk.NewInt(1 << 30),
+					Token:  sdk.NewInt64Coin("aaa", 230),
+					Weight: sdk.NewInt(4 << 30),
 				},
 				{
 					Token:  sdk.NewInt64Coin("bbb", 1000),
 					Weight: sdk.NewInt(1 << 30),
 				},
 			},
-			existingShares: 100,
-			tokensIn: sdk.NewCoins(
-				sdk.NewInt64Coin("aaa", 101),
-				sdk.NewInt64Coin("bbb", 100),
-			),
-			expectedX0Denom:  "aaa",
-			expectedX0Amount: 0,
+			swapFee:           sdk.MustNewDecFromStr("0.003"),
+			tokenIn:           sdk.NewInt64Coin("aaa", 928),
+			expectedNumShares: sdk.MustNewDecFromStr("264265030926051413531.872902013541203738"),
 		},
 		{
-			name: "tokens aaa need to be swapped",
+			// 100e18 * ((1 + 928*(1-0.003*(1-0.2))/1000)^(1/5) - 1)
+			name: "80/20 pool, light asset",
 			poolAssets: []PoolAsset{
 				{
-					Token:  sdk.NewInt64Coin("aaa", 1000),
-					Weight: sdk.NewInt(1 << 30),
+					Token:  sdk.NewInt64Coin("aaa", 230),
+					Weight: sdk.NewInt(4 << 30),
 				},
 				{
 					Token:  sdk.NewInt64Coin("bbb", 1000),
 					Weight: sdk.NewInt(1 << 30),
 				},
 			},
-			existingShares: 100,
-			tokensIn: sdk.NewCoins(
-				sdk.NewInt64Coin("aaa", 101),
-				sdk.NewInt64Coin("bbb", 43),
-			),
-			expectedX0Denom:  "aaa",
-			expectedX0Amount: 27,
+			swapFee:           sdk.MustNewDecFromStr("0.003"),
+			tokenIn:           sdk.NewInt64Coin("bbb", 928),
+			expectedNumShares: sdk.MustNewDecFromStr("14004241555879589093.783151793017144426"),
 		},
 		{
-			name: "tokens bbb need to be swapped",
+			name: "denom not in pool",
 			poolAssets: []PoolAsset{
 				{
-					Token:  sdk.NewInt64Coin("aaa", 1000),
+					Token:  sdk.NewInt64Coin("aaa", 230),
 					Weight: sdk.NewInt(1 << 30),
 				},
 				{
@@ -215,16 +206,51 @@ func TestSwapForSwapAndJoin(t *testing.T) {
 					Weight: sdk.NewInt(1 << 30),
 				},
 			},
-			existingShares: 100,
-			tokensIn: sdk.NewCoins(
-				sdk.NewInt64Coin("aaa", 43),
-				sdk.NewInt64Coin("bbb", 101),
-			),
-			expectedX0Denom:  "bbb",
-			expectedX0Amount: 27,
+			swapFee: sdk.ZeroDec(),
+			tokenIn: sdk.NewInt64Coin("ccc", 928),
+			err:     errors.New("could not find denom ccc in pool id 1"),
 		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			totalWeight := sdk.ZeroInt()
+			for _, asset := range tc.poolAssets {
+				totalWeight = totalWeight.Add(asset.Weight)
+			}
+			pool := Pool{
+				Id:          1,
+				Address:     "some_address",
+				PoolParams:  PoolParams{SwapFee: tc.swapFee},
+				PoolAssets:  tc.poolAssets,
+				TotalWeight: totalWeight,
+				TotalShares: sdk.NewCoin("nibiru/pool/1", sdk.NewIntWithDecimal(100, 18)),
+			}
+			numShares, err := pool.numSharesOutFromSingleTokenIn(tc.tokenIn)
+			if tc.err != nil {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			relErr := numShares.ToDec().Sub(tc.expectedNumShares).Quo(tc.expectedNumShares).Abs()
+			require.True(t, relErr.LT(sdk.NewDecWithPrec(1, 12)), "expected %s, got %s", tc.expectedNumShares, numShares)
+		})
+	}
+}
+
+func TestTokenOutFromPoolSharesInSingleAsset(t *testing.T) {
+	for _, tc := range []struct {
+		name             string
+		poolAssets       []PoolAsset
+		swapFee          sdk.Dec
+		exitFee          sdk.Dec
+		numSharesIn      sdk.Int
+		tokenOutDenom    string
+		expectedTokenOut sdk.Coin
+		expectedErr      bool
+	}{
 		{
-			name: "single asset join, aaa",
+			// 230 * (1 - (1 - 50/100)^2)
+			name: "equal weights",
 			poolAssets: []PoolAsset{
 				{
 					Token:  sdk.NewInt64Coin("aaa", 230),
@@ -235,114 +261,142 @@ func TestSwapForSwapAndJoin(t *testing.T) {
 					Weight: sdk.NewInt(1 << 30),
 				},
 			},
-			existingShares: 100,
-			tokensIn: sdk.NewCoins(
-				sdk.NewInt64Coin("aaa", 928),
-			),
-			expectedX0Denom:  "aaa",
-			expectedX0Amount: 286,
+			swapFee:          sdk.ZeroDec(),
+			exitFee:          sdk.ZeroDec(),
+			numSharesIn:      sdk.NewIntWithDecimal(50, 18),
+			tokenOutDenom:    "aaa",
+			expectedTokenOut: sdk.NewInt64Coin("aaa", 172),
 		},
 		{
-			name: "single asset join, bbb",
+			// 230 * (1 - (1 - 50/100)^(5/4)) * (1 - 0.003*(1-0.8)) * (1 - 0.01)
+			name: "80/20 pool, heavy asset",
 			poolAssets: []PoolAsset{
 				{
 					Token:  sdk.NewInt64Coin("aaa", 230),
-					Weight: sdk.NewInt(1 << 30),
+					Weight: sdk.NewInt(4 << 30),
 				},
 				{
 					Token:  sdk.NewInt64Coin("bbb", 1000),
 					Weight: sdk.NewInt(1 << 30),
 				},
 			},
-			existingShares: 100,
-			tokensIn: sdk.NewCoins(
-				sdk.NewInt64Coin("bbb", 928),
-			),
-			expectedX0Denom:  "bbb",
-			expectedX0Amount: 388,
+			swapFee:          sdk.MustNewDecFromStr("0.003"),
+			exitFee:          sdk.MustNewDecFromStr("0.01"),
+			numSharesIn:      sdk.NewIntWithDecimal(50, 18),
+			tokenOutDenom:    "aaa",
+			expectedTokenOut: sdk.NewInt64Coin("aaa", 131),
 		},
-
 		{
-			name: "3 asset pool, raise issue",
+			// 1000 * (1 - (1 - 10/100)^5) * (1 - 0.003*(1-0.2)) * (1 - 0.01)
+			name: "80/20 pool, light asset",
 			poolAssets: []PoolAsset{
 				{
-					Token:  sdk.NewInt64Coin("aaa", 1000),
-					Weight: sdk.NewInt(1 << 30),
+					Token:  sdk.NewInt64Coin("aaa", 230),
+					Weight: sdk.NewInt(4 << 30),
 				},
 				{
 					Token:  sdk.NewInt64Coin("bbb", 1000),
 					Weight: sdk.NewInt(1 << 30),
 				},
+			},
+			swapFee:          sdk.MustNewDecFromStr("0.003"),
+			exitFee:          sdk.MustNewDecFromStr("0.01"),
+			numSharesIn:      sdk.NewIntWithDecimal(10, 18),
+			tokenOutDenom:    "bbb",
+			expectedTokenOut: sdk.NewInt64Coin("bbb", 404),
+		},
+		{
+			name: "all the shares",
+			poolAssets: []PoolAsset{
 				{
-					Token:  sdk.NewInt64Coin("ccc", 1000),
+					Token:  sdk.NewInt64Coin("aaa", 230),
+					Weight: sdk.NewInt(1 << 30),
+				},
+				{
+					Token:  sdk.NewInt64Coin("bbb", 1000),
 					Weight: sdk.NewInt(1 << 30),
 				},
 			},
-			existingShares: 100,
-			tokensIn: sdk.NewCoins(
-				sdk.NewInt64Coin("aaa", 43),
-				sdk.NewInt64Coin("bbb", 101),
-			),
-			err: errors.New("swap and add tokens to pool only available for 2 assets pool"),
+			swapFee:       sdk.ZeroDec(),
+			exitFee:       sdk.ZeroDec(),
+			numSharesIn:   sdk.NewIntWithDecimal(100, 18),
+			tokenOutDenom: "aaa",
+			expectedErr:   true,
 		},
 	} {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
+			totalWeight := sdk.ZeroInt()
+			for _, asset := range tc.poolAssets {
+				totalWeight = totalWeight.Add(asset.Weight)
+			}
 			pool := Pool{
 				Id:          1,
 				Address:     "some_address",
-				PoolParams:  PoolParams{SwapFee: sdk.ZeroDec()},
+				PoolParams:  PoolParams{SwapFee: tc.swapFee, ExitFee: tc.exitFee},
 				PoolAssets:  tc.poolAssets,
-				TotalWeight: sdk.NewInt(2 << 30),
+				TotalWeight: totalWeight,
 				TotalShares: sdk.NewCoin("nibiru/pool/1", sdk.NewIntWithDecimal(100, 18)),
 			}
-			swapCoin, err := pool.SwapForSwapAndJoin(tc.tokensIn)
-			if tc.err == nil {
-				require.NoError(t, err)
-				require.EqualValues(t, tc.expectedX0Denom, swapCoin.Denom)
-				require.EqualValues(t, tc.expectedX0Amount, swapCoin.Amount.Int64())
-
-				if swapCoin.Amount.GT(sdk.ZeroInt()) {
-					index, _, err := pool.getPoolAssetAndIndex(swapCoin.Denom)
-
-					require.NoError(t, err)
-					otherDenom := pool.PoolAssets[1-index].Token.Denom
-
-					tokenOut, err := pool.CalcOutAmtGivenIn(swapCoin, otherDenom, false)
-					require.NoError(t, err)
-
-					err = pool.ApplySwap(swapCoin, tokenOut)
-					require.NoError(t, err)
-
-					tokensIn := sdk.Coins{
-						{
-							Denom:  swapCoin.Denom,
-							Amount: tc.tokensIn.AmountOfNoDenomValidation(swapCoin.Denom).Sub(swapCoin.Amount),
-						},
-						{
-							Denom:  otherDenom,
-							Amount: tc.tokensIn.AmountOfNoDenomValidation(otherDenom).Add(tokenOut.Amount),
-						},
-					}
-
-					_, remCoins, err := pool.numSharesOutFromTokensIn(tokensIn)
-					require.NoError(t, err)
-
-					// Because of rounding errors, we might receive remcoins up to ~ly/lx
-					_, assetX, _ := pool.getPoolAssetAndIndex(swapCoin.Denom)
-					_, assetY, _ := pool.getPoolAssetAndIndex(otherDenom)
-
-					maxError := assetX.Token.Amount.ToDec().Quo(assetY.Token.Amount.ToDec())
-
-					require.LessOrEqual(t, remCoins.AmountOf(swapCoin.Denom).Int64(), maxError.TruncateInt64())
-				}
-			} else {
+			tokenOut, err := pool.TokenOutFromPoolSharesInSingleAsset(tc.numSharesIn, tc.tokenOutDenom)
+			if tc.expectedErr {
 				require.Error(t, err)
+				return
 			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTokenOut, tokenOut)
 		})
 	}
 }
 
+// TestSingleAssetJoinExitRoundTrip checks over random weighted pools that
+// exiting the shares of a single asset join in the same asset never returns
+// more than the deposit, and returns all of it, up to rounding, without fees.
+func TestSingleAssetJoinExitRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(141))
+	for i := 0; i < 200; i++ {
+		pool := Pool{
+			Id:      1,
+			Address: "some_address",
+			PoolParams: PoolParams{
+				SwapFee: sdk.ZeroDec(),
+				ExitFee: sdk.ZeroDec(),
+			},
+			PoolAssets: []PoolAsset{
+				{
+					Token:  sdk.NewInt64Coin("aaa", r.Int63n(1e12)+1e6),
+					Weight: sdk.NewInt(r.Int63n(99) + 1).MulRaw(GuaranteedWeightPrecision),
+				},
+				{
+					Token:  sdk.NewInt64Coin("bbb", r.Int63n(1e12)+1e6),
+					Weight: sdk.NewInt(r.Int63n(99) + 1).MulRaw(GuaranteedWeightPrecision),
+				},
+			},
+			TotalShares: sdk.NewCoin("nibiru/pool/1", sdk.NewIntWithDecimal(100, 18)),
+		}
+		pool.TotalWeight = pool.PoolAssets[0].Weight.Add(pool.PoolAssets[1].Weight)
+		if r.Intn(2) == 0 {
+			pool.PoolParams.SwapFee = sdk.MustNewDecFromStr("0.003")
+			pool.PoolParams.ExitFee = sdk.MustNewDecFromStr("0.001")
+		}
+
+		tokenIn := sdk.NewInt64Coin("aaa", r.Int63n(pool.PoolAssets[0].Token.Amount.Int64())+1)
+		numShares, remCoins, err := pool.AddAllTokensToPool(sdk.NewCoins(tokenIn))
+		require.NoError(t, err)
+		if !remCoins.Empty() {
+			continue
+		}
+
+		tokensOut, err := pool.ExitPoolToSingleAsset(numShares, "aaa")
+		require.NoError(t, err)
+		tokenOut := tokensOut.AmountOf("aaa")
+		require.True(t, tokenOut.LTE(tokenIn.Amount), "deposited %s, withdrew %s", tokenIn, tokenOut)
+		if pool.PoolParams.SwapFee.IsZero() {
+			require.InDelta(t, tokenIn.Amount.Int64(), tokenOut.Int64(), float64(tokenIn.Amount.Int64())*1e-9+2)
+		}
+	}
+}
+
 func TestTokensOutFromExactSharesHappyPath(t *testing.T) {
 	for _, tc := range []struct {
 		name              string
@@ -515,6 +569,144 @@ func TestTokensOutFromExactSharesErrors(t *testing.T) {
 	}
 }
 
+func TestTokensInFromPoolSharesOut(t *testing.T) {
+	pool := Pool{
+		PoolAssets: []PoolAsset{
+			{Token: sdk.NewInt64Coin("aaa", 230)},
+			{Token: sdk.NewInt64Coin("bbb", 1000)},
+		},
+		TotalShares: sdk.NewInt64Coin("nibiru/pool/1", 100),
+	}
+
+	tokensIn, err := pool.TokensInFromPoolSharesOut(sdk.NewInt(10))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("aaa", 23), sdk.NewInt64Coin("bbb", 100)), tokensIn)
+
+	// 230 * 15/100 = 34.5 is rounded up
+	tokensIn, err = pool.TokensInFromPoolSharesOut(sdk.NewInt(15))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("aaa", 35), sdk.NewInt64Coin("bbb", 150)), tokensIn)
+
+	_, err = pool.TokensInFromPoolSharesOut(sdk.ZeroInt())
+	require.Error(t, err)
+}
+
+func TestPoolSharesInFromTokensOut(t *testing.T) {
+	for _, tc := range []struct {
+		name                string
+		weights             []int64
+		swapFee             sdk.Dec
+		exitFee             sdk.Dec
+		tokensOut           sdk.Coins
+		expectedNumSharesIn sdk.Int
+		expectedErr         bool
+	}{
+		{
+			name:                "proportional exit",
+			weights:             []int64{1, 1},
+			swapFee:             sdk.ZeroDec(),
+			exitFee:             sdk.ZeroDec(),
+			tokensOut:           sdk.NewCoins(sdk.NewInt64Coin("aaa", 23), sdk.NewInt64Coin("bbb", 100)),
+			expectedNumSharesIn: sdk.NewInt(10),
+		},
+		{
+			// 23/(230 * 0.99) * 100 = 10.1
+			name:                "proportional exit pays the exit fee",
+			weights:             []int64{1, 1},
+			swapFee:             sdk.ZeroDec(),
+			exitFee:             sdk.MustNewDecFromStr("0.01"),
+			tokensOut:           sdk.NewCoins(sdk.NewInt64Coin("aaa", 23), sdk.NewInt64Coin("bbb", 100)),
+			expectedNumSharesIn: sdk.NewInt(11),
+		},
+		{
+			// 100 * (1 - (1 - 172/230)^(1/2)) = 49.78
+			name:                "single asset exit",
+			weights:             []int64{1, 1},
+			swapFee:             sdk.ZeroDec(),
+			exitFee:             sdk.ZeroDec(),
+			tokensOut:           sdk.NewCoins(sdk.NewInt64Coin("aaa", 172)),
+			expectedNumSharesIn: sdk.NewInt(50),
+		},
+		{
+			// 10.10 shares for the proportional part, 2.11 for the rest of bbb
+			name:                "80/20 pool, mixed exit with fees",
+			weights:             []int64{4, 1},
+			swapFee:             sdk.MustNewDecFromStr("0.003"),
+			exitFee:             sdk.MustNewDecFromStr("0.01"),
+			tokensOut:           sdk.NewCoins(sdk.NewInt64Coin("aaa", 23), sdk.NewInt64Coin("bbb", 200)),
+			expectedNumSharesIn: sdk.NewInt(13),
+		},
+		{
+			name:        "all the balance",
+			weights:     []int64{1, 1},
+			swapFee:     sdk.ZeroDec(),
+			exitFee:     sdk.ZeroDec(),
+			tokensOut:   sdk.NewCoins(sdk.NewInt64Coin("aaa", 230)),
+			expectedErr: true,
+		},
+		{
+			name:        "denom not in pool",
+			weights:     []int64{1, 1},
+			swapFee:     sdk.ZeroDec(),
+			exitFee:     sdk.ZeroDec(),
+			tokensOut:   sdk.NewCoins(sdk.NewInt64Coin("ccc", 1)),
+			expectedErr: true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pool := Pool{
+				PoolParams: PoolParams{SwapFee: tc.swapFee, ExitFee: tc.exitFee},
+				PoolAssets: []PoolAsset{
+					{Token: sdk.NewInt64Coin("aaa", 230), Weight: sdk.NewInt(tc.weights[0] << 30)},
+					{Token: sdk.NewInt64Coin("bbb", 1000), Weight: sdk.NewInt(tc.weights[1] << 30)},
+				},
+				TotalWeight: sdk.NewInt((tc.weights[0] + tc.weights[1]) << 30),
+				TotalShares: sdk.NewInt64Coin("nibiru/pool/1", 100),
+			}
+			numSharesIn, err := pool.PoolSharesInFromTokensOut(tc.tokensOut)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedNumSharesIn, numSharesIn)
+		})
+	}
+}
+
+// TestExactSharesJoinExitRoundTrip checks over random pools that withdrawing
+// the tokens deposited for an exact number of shares burns at least as many
+// shares.
+func TestExactSharesJoinExitRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(241))
+	for i := 0; i < 200; i++ {
+		pool := Pool{
+			PoolParams: PoolParams{SwapFee: sdk.ZeroDec(), ExitFee: sdk.ZeroDec()},
+			PoolAssets: []PoolAsset{
+				{
+					Token:  sdk.NewInt64Coin("aaa", r.Int63n(1e12)+1e6),
+					Weight: sdk.NewInt(r.Int63n(99) + 1).MulRaw(GuaranteedWeightPrecision),
+				},
+				{
+					Token:  sdk.NewInt64Coin("bbb", r.Int63n(1e12)+1e6),
+					Weight: sdk.NewInt(r.Int63n(99) + 1).MulRaw(GuaranteedWeightPrecision),
+				},
+			},
+			TotalShares: sdk.NewCoin("nibiru/pool/1", sdk.NewIntWithDecimal(100, 18)),
+		}
+		pool.TotalWeight = pool.PoolAssets[0].Weight.Add(pool.PoolAssets[1].Weight)
+
+		numShares := sdk.NewIntWithDecimal(r.Int63n(100)+1, 17)
+		tokensIn, err := pool.JoinPoolExactShares(numShares)
+		require.NoError(t, err)
+
+		numSharesIn, err := pool.ExitPoolExactTokens(tokensIn)
+		require.NoError(t, err)
+		require.True(t, numSharesIn.GTE(numShares), "minted %s, burned %s", numShares, numSharesIn)
+	}
+}
+
 func TestUpdateLiquidityHappyPath(t *testing.T) {
 	for _, tc := range []struct {
 		name                  string
@@ -0,0 +1,339 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/dex/math"
+)
+
+/*
+StableSwapPool is the AMM of a pool trading along Curve's StableSwap invariant.
+The proportional joins and exits, and the swaps once computed, update the pool
+as in a balancer pool, so only the math of the invariant is overridden.
+*/
+type StableSwapPool struct {
+	*Pool
+
+	// the amplification at the block time
+	Amplification uint64
+}
+
+func (pool StableSwapPool) amplification() sdk.Dec {
+	return sdk.NewDecFromInt(sdk.NewIntFromUint64(pool.Amplification))
+}
+
+// balances returns the pool balances, in the order of the pool assets.
+func (pool StableSwapPool) balances() []sdk.Dec {
+	balances := make([]sdk.Dec, len(pool.PoolAssets))
+	for i, asset := range pool.PoolAssets {
+		balances[i] = asset.Token.Amount.ToDec()
+	}
+	return balances
+}
+
+// imbalanceFeeRatio is the fee charged on the imbalanced part of a join or an
+// exit, which is equivalent to a swap. As in Curve, swapFee * n / (4 * (n - 1)).
+func (pool StableSwapPool) imbalanceFeeRatio() sdk.Dec {
+	n := int64(len(pool.PoolAssets))
+	return pool.PoolParams.SwapFee.MulInt64(n).QuoInt64(4 * (n - 1))
+}
+
+/*
+Calculates the amount of tokenOut given tokenIn, deducting the swap fee, along
+the StableSwap invariant.
+
+args:
+  - tokenIn: the amount of tokens to swap
+  - tokenOutDenom: the target token denom
+  - noFee: whether we want to bypass swap fee
+
+ret:
+  - tokenOut: the tokens received from the swap
+  - err: error if any
+*/
+func (pool StableSwapPool) CalcOutAmtGivenIn(tokenIn sdk.Coin, tokenOutDenom string, noFee bool) (
+	tokenOut sdk.Coin, err error,
+) {
+	indexIn, _, err := pool.getPoolAssetAndIndex(tokenIn.Denom)
+	if err != nil {
+		return tokenOut, err
+	}
+	indexOut, poolAssetOut, err := pool.getPoolAssetAndIndex(tokenOutDenom)
+	if err != nil {
+		return tokenOut, err
+	}
+
+	tokenAmountInAfterFee := tokenIn.Amount.ToDec()
+	if !noFee {
+		tokenAmountInAfterFee = tokenAmountInAfterFee.Mul(sdk.OneDec().Sub(pool.PoolParams.SwapFee))
+	}
+
+	balances := pool.balances()
+	invariant, err := math.SolveStableSwapInvariant(balances, pool.amplification())
+	if err != nil {
+		return tokenOut, err
+	}
+
+	balances[indexIn] = balances[indexIn].Add(tokenAmountInAfterFee)
+	balanceOut, err := math.SolveStableSwapBalance(balances, indexOut, invariant, pool.amplification())
+	if err != nil {
+		return tokenOut, err
+	}
+
+	tokenAmountOut := poolAssetOut.Token.Amount.ToDec().Sub(balanceOut).TruncateInt()
+	if !tokenAmountOut.IsPositive() {
+		return tokenOut, fmt.Errorf("tokenIn (%s) must be higher to perform a swap", tokenIn.Denom)
+	}
+
+	return sdk.NewCoin(tokenOutDenom, tokenAmountOut), nil
+}
+
+/*
+Calculates the amount of tokenIn required to obtain tokenOut coins from a swap,
+accounting for the swap fee, along the StableSwap invariant.
+
+args:
+  - tokenOut: the amount of tokens to swap
+  - tokenInDenom: the target token denom
+
+ret:
+  - tokenIn: the tokens to give to the pool
+  - err: error if any
+*/
+func (pool StableSwapPool) CalcInAmtGivenOut(tokenOut sdk.Coin, tokenInDenom string) (
+	tokenIn sdk.Coin, err error,
+) {
+	indexOut, poolAssetOut, err := pool.getPoolAssetAndIndex(tokenOut.Denom)
+	if err != nil {
+		return tokenIn, err
+	}
+	indexIn, poolAssetIn, err := pool.getPoolAssetAndIndex(tokenInDenom)
+	if err != nil {
+		return tokenIn, err
+	}
+
+	if tokenOut.Amount.GTE(poolAssetOut.Token.Amount) {
+		return tokenIn, fmt.Errorf("tokenOut (%s) must be lower than the pool's balance", tokenOut)
+	}
+
+	balances := pool.balances()
+	invariant, err := math.SolveStableSwapInvariant(balances, pool.amplification())
+	if err != nil {
+		return tokenIn, err
+	}
+
+	balances[indexOut] = balances[indexOut].Sub(tokenOut.Amount.ToDec())
+	balanceIn, err := math.SolveStableSwapBalance(balances, indexIn, invariant, pool.amplification())
+	if err != nil {
+		return tokenIn, err
+	}
+
+	tokenAmountIn := balanceIn.Sub(poolAssetIn.Token.Amount.ToDec())
+	tokenAmountInBeforeFee := tokenAmountIn.Quo(sdk.OneDec().Sub(pool.PoolParams.SwapFee)).Ceil().TruncateInt()
+	return sdk.NewCoin(tokenInDenom, tokenAmountInBeforeFee), nil
+}
+
+// CalcSpotPrice calculates the marginal price of tokenOut in tokenIn along the
+// StableSwap invariant.
+func (pool StableSwapPool) CalcSpotPrice(tokenIn, tokenOut string) (sdk.Dec, error) {
+	indexIn, _, err := pool.getPoolAssetAndIndex(tokenIn)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	indexOut, _, err := pool.getPoolAssetAndIndex(tokenOut)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return math.StableSwapSpotPrice(pool.balances(), indexIn, indexOut, pool.amplification())
+}
+
+/*
+Adds all the tokens to a pool, in any proportions, and updates the pool
+balances. As in Curve, the shares are minted in proportion to the increase of
+the StableSwap invariant, and the fee is charged on the difference between each
+new balance and the balance a proportional join would have given.
+
+args:
+  - tokensIn: the tokens to add to the pool, any subset of the pool's assets
+
+ret:
+  - numShares: the number of LP shares given to the user for the deposit
+  - remCoins: tokensIn if too small to mint any share, which are then not deposited
+  - err: error if any
+*/
+func (pool StableSwapPool) AddAllTokensToPool(tokensIn sdk.Coins) (
+	numShares sdk.Int, remCoins sdk.Coins, err error,
+) {
+	oldBalances := pool.balances()
+	newBalances := pool.balances()
+	for _, tokenIn := range tokensIn {
+		index, _, err := pool.getPoolAssetAndIndex(tokenIn.Denom)
+		if err != nil {
+			return sdk.ZeroInt(), sdk.Coins{}, err
+		}
+		newBalances[index] = newBalances[index].Add(tokenIn.Amount.ToDec())
+	}
+
+	oldInvariant, err := math.SolveStableSwapInvariant(oldBalances, pool.amplification())
+	if err != nil {
+		return sdk.ZeroInt(), sdk.Coins{}, err
+	}
+	newInvariant, err := math.SolveStableSwapInvariant(newBalances, pool.amplification())
+	if err != nil {
+		return sdk.ZeroInt(), sdk.Coins{}, err
+	}
+
+	feeRatio := pool.imbalanceFeeRatio()
+	for i := range newBalances {
+		idealBalance := oldBalances[i].Mul(newInvariant).Quo(oldInvariant)
+		newBalances[i] = newBalances[i].Sub(feeRatio.Mul(newBalances[i].Sub(idealBalance).Abs()))
+	}
+	invariantAfterFee, err := math.SolveStableSwapInvariant(newBalances, pool.amplification())
+	if err != nil {
+		return sdk.ZeroInt(), sdk.Coins{}, err
+	}
+
+	numShares = invariantAfterFee.Sub(oldInvariant).Quo(oldInvariant).MulInt(pool.TotalShares.Amount).TruncateInt()
+	if !numShares.IsPositive() {
+		return sdk.ZeroInt(), tokensIn, nil
+	}
+
+	if err = pool.incrementBalances(numShares, tokensIn); err != nil {
+		return sdk.ZeroInt(), sdk.Coins{}, err
+	}
+	return numShares, sdk.Coins{}, nil
+}
+
+/*
+Exits a pool in a single asset, following Curve's withdrawal of one coin: the
+invariant decreases in proportion to the shares, and the fee is charged on the
+difference between each balance and the balance a proportional exit would have
+left. The exit fee is then deducted.
+
+args:
+  - exitingShares: the number of pool shares to exit from the pool
+  - tokenOutDenom: the denom of the withdrawn tokens
+*/
+func (pool StableSwapPool) ExitPoolToSingleAsset(exitingShares sdk.Int, tokenOutDenom string) (
+	exitedCoins sdk.Coins, err error,
+) {
+	if !exitingShares.IsPositive() {
+		return sdk.Coins{}, errors.New("num shares in must be greater than zero")
+	}
+	if exitingShares.GTE(pool.TotalShares.Amount) {
+		return sdk.Coins{}, errors.New("num shares in must be lower than the pool's total shares to exit in a single asset")
+	}
+
+	indexOut, _, err := pool.getPoolAssetAndIndex(tokenOutDenom)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	balances := pool.balances()
+	invariant, err := math.SolveStableSwapInvariant(balances, pool.amplification())
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+	shareRatio := exitingShares.ToDec().QuoInt(pool.TotalShares.Amount)
+	newInvariant := invariant.Sub(invariant.Mul(shareRatio))
+
+	balanceOut, err := math.SolveStableSwapBalance(balances, indexOut, newInvariant, pool.amplification())
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	feeRatio := pool.imbalanceFeeRatio()
+	reducedBalances := make([]sdk.Dec, len(balances))
+	for i, balance := range balances {
+		idealBalance := balance.Mul(newInvariant).Quo(invariant)
+		expectedDelta := balance.Sub(idealBalance)
+		if i == indexOut {
+			expectedDelta = idealBalance.Sub(balanceOut)
+		}
+		reducedBalances[i] = balance.Sub(feeRatio.Mul(expectedDelta))
+	}
+	reducedBalanceOut, err := math.SolveStableSwapBalance(reducedBalances, indexOut, newInvariant, pool.amplification())
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	tokenAmountOut := reducedBalances[indexOut].Sub(reducedBalanceOut).
+		Mul(sdk.OneDec().Sub(pool.PoolParams.ExitFee)).
+		TruncateInt()
+	if !tokenAmountOut.IsPositive() {
+		return sdk.Coins{}, errors.New("not enough pool shares to withdraw")
+	}
+
+	if err = pool.SubtractPoolAssetBalance(tokenOutDenom, tokenAmountOut); err != nil {
+		return sdk.Coins{}, err
+	}
+	pool.TotalShares = sdk.NewCoin(pool.TotalShares.Denom, pool.TotalShares.Amount.Sub(exitingShares))
+	return sdk.NewCoins(sdk.NewCoin(tokenOutDenom, tokenAmountOut)), nil
+}
+
+/*
+Exits the pool for an exact amount of tokens, following Curve's imbalanced
+withdrawal: the shares burned are proportional to the decrease of the
+invariant, once the fee is charged on the difference between each new balance
+and the balance a proportional exit would have left. The tokens are grossed up
+by the exit fee first, and the shares are rounded up.
+
+args:
+  - tokensOut: the tokens to withdraw, any subset of the pool's assets
+
+ret:
+  - numSharesIn: the number of LP shares burned
+  - err: error if any
+*/
+func (pool StableSwapPool) ExitPoolExactTokens(tokensOut sdk.Coins) (
+	numSharesIn sdk.Int, err error,
+) {
+	if !tokensOut.IsValid() || tokensOut.Empty() {
+		return sdk.ZeroInt(), fmt.Errorf("invalid tokens out %s", tokensOut)
+	}
+
+	oldBalances := pool.balances()
+	newBalances := pool.balances()
+	exitFeeRatio := sdk.OneDec().Sub(pool.PoolParams.ExitFee)
+	for _, tokenOut := range tokensOut {
+		index, _, err := pool.getPoolAssetAndIndex(tokenOut.Denom)
+		if err != nil {
+			return sdk.ZeroInt(), err
+		}
+		newBalances[index] = newBalances[index].Sub(tokenOut.Amount.ToDec().Quo(exitFeeRatio))
+		if !newBalances[index].IsPositive() {
+			return sdk.ZeroInt(), fmt.Errorf("not enough %s in the pool to withdraw %s", tokenOut.Denom, tokensOut)
+		}
+	}
+
+	oldInvariant, err := math.SolveStableSwapInvariant(oldBalances, pool.amplification())
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+	newInvariant, err := math.SolveStableSwapInvariant(newBalances, pool.amplification())
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	feeRatio := pool.imbalanceFeeRatio()
+	for i := range newBalances {
+		idealBalance := oldBalances[i].Mul(newInvariant).Quo(oldInvariant)
+		newBalances[i] = newBalances[i].Sub(feeRatio.Mul(newBalances[i].Sub(idealBalance).Abs()))
+	}
+	invariantAfterFee, err := math.SolveStableSwapInvariant(newBalances, pool.amplification())
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	numSharesIn = oldInvariant.Sub(invariantAfterFee).Quo(oldInvariant).
+		MulInt(pool.TotalShares.Amount).
+		Ceil().TruncateInt()
+	if err = pool.decrementBalances(numSharesIn, tokensOut); err != nil {
+		return sdk.ZeroInt(), err
+	}
+	return numSharesIn, nil
+}
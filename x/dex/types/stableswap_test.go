@@ -0,0 +1,325 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// stableSwapPool returns a StableSwap pool of aaa and bbb, with 1000 shares.
+func stableSwapPool(amplification uint64, aaa, bbb int64) *Pool {
+	return &Pool{
+		Id: 1,
+		PoolParams: PoolParams{
+			SwapFee:       sdk.MustNewDecFromStr("0.0003"),
+			ExitFee:       sdk.ZeroDec(),
+			PoolType:      PoolType_STABLESWAP,
+			Amplification: amplification,
+		},
+		PoolAssets: []PoolAsset{
+			{Token: sdk.NewInt64Coin("aaa", aaa), Weight: sdk.OneInt()},
+			{Token: sdk.NewInt64Coin("bbb", bbb), Weight: sdk.OneInt()},
+		},
+		TotalWeight: sdk.NewInt(2),
+		TotalShares: sdk.NewInt64Coin(GetPoolShareBaseDenom(1), 1_000),
+	}
+}
+
+func TestAsPoolI(t *testing.T) {
+	pool := stableSwapPool(100, 1_000, 1_000)
+	require.Equal(t, StableSwapPool{Pool: pool, Amplification: 100}, pool.AsPoolI(time.Now()))
+
+	pool.PoolParams.PoolType = PoolType_BALANCER
+	require.Equal(t, BalancerPool{Pool: pool}, pool.AsPoolI(time.Now()))
+}
+
+func TestStableSwapCalcOutAmtGivenIn(t *testing.T) {
+	for _, tc := range []struct {
+		name             string
+		pool             *Pool
+		tokenIn          sdk.Coin
+		expectedTokenOut sdk.Coin
+		shouldError      bool
+	}{
+		{
+			name:             "balanced pool swaps close to 1:1",
+			pool:             stableSwapPool(100, 1_000_000, 1_000_000),
+			tokenIn:          sdk.NewInt64Coin("aaa", 10_000),
+			expectedTokenOut: sdk.NewInt64Coin("bbb", 9_996),
+		},
+		{
+			name:             "low amplification slips more",
+			pool:             stableSwapPool(1, 1_000_000, 1_000_000),
+			tokenIn:          sdk.NewInt64Coin("aaa", 10_000),
+			expectedTokenOut: sdk.NewInt64Coin("bbb", 9_963),
+		},
+		{
+			name:        "amount too small",
+			pool:        stableSwapPool(100, 1_000_000, 1_000_000),
+			tokenIn:     sdk.NewInt64Coin("aaa", 1),
+			shouldError: true,
+		},
+		{
+			name:        "unknown denom",
+			pool:        stableSwapPool(100, 1_000_000, 1_000_000),
+			tokenIn:     sdk.NewInt64Coin("ccc", 10_000),
+			shouldError: true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tokenOut, err := tc.pool.AsPoolI(time.Now()).CalcOutAmtGivenIn(tc.tokenIn, "bbb", false)
+			if tc.shouldError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedTokenOut, tokenOut)
+			}
+		})
+	}
+}
+
+func TestStableSwapSlippageBelowBalancer(t *testing.T) {
+	pool := stableSwapPool(100, 1_000_000, 1_000_000)
+	tokenIn := sdk.NewInt64Coin("aaa", 100_000)
+
+	stableOut, err := pool.AsPoolI(time.Now()).CalcOutAmtGivenIn(tokenIn, "bbb", false)
+	require.NoError(t, err)
+
+	pool.PoolParams.PoolType = PoolType_BALANCER
+	balancerOut, err := pool.AsPoolI(time.Now()).CalcOutAmtGivenIn(tokenIn, "bbb", false)
+	require.NoError(t, err)
+
+	require.True(t, stableOut.Amount.GT(balancerOut.Amount), "stable %s, balancer %s", stableOut, balancerOut)
+}
+
+func TestStableSwapCalcInAmtGivenOut(t *testing.T) {
+	pool := stableSwapPool(100, 1_000_000, 2_000_000).AsPoolI(time.Now())
+
+	tokenIn, err := pool.CalcInAmtGivenOut(sdk.NewInt64Coin("bbb", 50_000), "aaa")
+	require.NoError(t, err)
+
+	// swapping tokenIn back gives at least the tokens asked for
+	tokenOut, err := pool.CalcOutAmtGivenIn(tokenIn, "bbb", false)
+	require.NoError(t, err)
+	require.True(t, tokenOut.Amount.GTE(sdk.NewInt(50_000)), "got %s", tokenOut)
+	require.True(t, tokenOut.Amount.LTE(sdk.NewInt(50_002)), "got %s", tokenOut)
+
+	_, err = pool.CalcInAmtGivenOut(sdk.NewInt64Coin("bbb", 2_000_000), "aaa")
+	require.Error(t, err)
+}
+
+func TestStableSwapCalcSpotPrice(t *testing.T) {
+	price, err := stableSwapPool(100, 1_000, 1_000).AsPoolI(time.Now()).CalcSpotPrice("aaa", "bbb")
+	require.NoError(t, err)
+	require.Equal(t, sdk.OneDec(), price)
+}
+
+func TestStableSwapAddAllTokensToPool(t *testing.T) {
+	t.Run("proportional join", func(t *testing.T) {
+		pool := stableSwapPool(100, 1_000_000, 1_000_000)
+		numShares, remCoins, err := pool.AsPoolI(time.Now()).AddAllTokensToPool(
+			sdk.NewCoins(sdk.NewInt64Coin("aaa", 10_000), sdk.NewInt64Coin("bbb", 10_000)))
+		require.NoError(t, err)
+		require.Empty(t, remCoins)
+		require.Equal(t, sdk.NewInt(10), numShares)
+		require.Equal(t, sdk.NewInt(1_010), pool.TotalShares.Amount)
+		require.Equal(t, sdk.NewInt(1_010_000), pool.PoolAssets[0].Token.Amount)
+	})
+
+	t.Run("single asset join is charged a fee", func(t *testing.T) {
+		pool := stableSwapPool(100, 1_000_000, 1_000_000)
+		numShares, remCoins, err := pool.AsPoolI(time.Now()).AddAllTokensToPool(
+			sdk.NewCoins(sdk.NewInt64Coin("aaa", 200_000)))
+		require.NoError(t, err)
+		require.Empty(t, remCoins)
+		require.True(t, numShares.LT(sdk.NewInt(100)), "got %s", numShares)
+		require.True(t, numShares.GT(sdk.NewInt(98)), "got %s", numShares)
+		require.Equal(t, sdk.NewInt(1_200_000), pool.PoolAssets[0].Token.Amount)
+	})
+
+	t.Run("too small to mint shares", func(t *testing.T) {
+		pool := stableSwapPool(100, 1_000_000, 1_000_000)
+		tokensIn := sdk.NewCoins(sdk.NewInt64Coin("aaa", 10))
+		numShares, remCoins, err := pool.AsPoolI(time.Now()).AddAllTokensToPool(tokensIn)
+		require.NoError(t, err)
+		require.True(t, numShares.IsZero())
+		require.Equal(t, tokensIn, remCoins)
+		require.Equal(t, sdk.NewInt(1_000_000), pool.PoolAssets[0].Token.Amount)
+	})
+}
+
+func TestStableSwapExitPoolToSingleAsset(t *testing.T) {
+	pool := stableSwapPool(100, 1_000_000, 1_000_000)
+	exitedCoins, err := pool.AsPoolI(time.Now()).ExitPoolToSingleAsset(sdk.NewInt(100), "aaa")
+	require.NoError(t, err)
+
+	// a tenth of the shares is worth a bit less than 200_000 aaa
+	require.True(t, exitedCoins.AmountOf("aaa").LT(sdk.NewInt(200_000)), "got %s", exitedCoins)
+	require.True(t, exitedCoins.AmountOf("aaa").GT(sdk.NewInt(199_000)), "got %s", exitedCoins)
+	require.Equal(t, sdk.NewInt(900), pool.TotalShares.Amount)
+	require.Equal(t, sdk.NewInt(1_000_000).Sub(exitedCoins.AmountOf("aaa")), pool.PoolAssets[0].Token.Amount)
+
+	_, err = pool.AsPoolI(time.Now()).ExitPoolToSingleAsset(sdk.NewInt(900), "aaa")
+	require.Error(t, err)
+}
+
+func TestStableSwapExitPoolExactTokens(t *testing.T) {
+	t.Run("proportional exit", func(t *testing.T) {
+		pool := stableSwapPool(100, 1_000_000, 1_000_000)
+		numSharesIn, err := pool.AsPoolI(time.Now()).ExitPoolExactTokens(
+			sdk.NewCoins(sdk.NewInt64Coin("aaa", 10_500), sdk.NewInt64Coin("bbb", 10_500)))
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt(11), numSharesIn)
+		require.Equal(t, sdk.NewInt(989), pool.TotalShares.Amount)
+		require.Equal(t, sdk.NewInt(989_500), pool.PoolAssets[0].Token.Amount)
+	})
+
+	t.Run("single asset exit is charged a fee", func(t *testing.T) {
+		// a tenth of the shares is worth a bit less than 200_000 aaa
+		pool := stableSwapPool(100, 1_000_000, 1_000_000)
+		numSharesIn, err := pool.AsPoolI(time.Now()).ExitPoolExactTokens(
+			sdk.NewCoins(sdk.NewInt64Coin("aaa", 200_000)))
+		require.NoError(t, err)
+		require.True(t, numSharesIn.GT(sdk.NewInt(100)), "got %s", numSharesIn)
+		require.True(t, numSharesIn.LTE(sdk.NewInt(102)), "got %s", numSharesIn)
+		require.Equal(t, sdk.NewInt(800_000), pool.PoolAssets[0].Token.Amount)
+	})
+
+	t.Run("more than the pool's balance", func(t *testing.T) {
+		pool := stableSwapPool(100, 1_000_000, 1_000_000)
+		_, err := pool.AsPoolI(time.Now()).ExitPoolExactTokens(
+			sdk.NewCoins(sdk.NewInt64Coin("aaa", 1_000_000)))
+		require.Error(t, err)
+	})
+}
+
+func TestAmplificationAt(t *testing.T) {
+	start := time.Unix(1_000_000, 0)
+	pool := stableSwapPool(300, 1_000, 1_000)
+	pool.AmplificationRamp = &AmplificationRamp{
+		InitialAmplification: 100,
+		InitialTime:          start,
+		FutureTime:           start.Add(10 * time.Hour),
+	}
+
+	require.EqualValues(t, 100, pool.AmplificationAt(start.Add(-time.Hour)))
+	require.EqualValues(t, 100, pool.AmplificationAt(start))
+	require.EqualValues(t, 150, pool.AmplificationAt(start.Add(150*time.Minute)))
+	require.EqualValues(t, 200, pool.AmplificationAt(start.Add(5*time.Hour)))
+	require.EqualValues(t, 300, pool.AmplificationAt(start.Add(10*time.Hour)))
+	require.EqualValues(t, 300, pool.AmplificationAt(start.Add(20*time.Hour)))
+
+	pool.AmplificationRamp = nil
+	require.EqualValues(t, 300, pool.AmplificationAt(start))
+}
+
+func TestRampAmplification(t *testing.T) {
+	now := time.Unix(1_000_000, 0)
+	day := 24 * time.Hour
+
+	for _, tc := range []struct {
+		name                string
+		pool                *Pool
+		futureAmplification uint64
+		futureTime          time.Time
+		expectedErr         error
+	}{
+		{
+			name:                "ramp up",
+			pool:                stableSwapPool(100, 1_000, 1_000),
+			futureAmplification: 1_000,
+			futureTime:          now.Add(day),
+		},
+		{
+			name:                "ramp down",
+			pool:                stableSwapPool(100, 1_000, 1_000),
+			futureAmplification: 10,
+			futureTime:          now.Add(7 * day),
+		},
+		{
+			name:                "too fast",
+			pool:                stableSwapPool(100, 1_000, 1_000),
+			futureAmplification: 200,
+			futureTime:          now.Add(day - time.Second),
+			expectedErr:         ErrInvalidAmplification,
+		},
+		{
+			name:                "too large a change",
+			pool:                stableSwapPool(100, 1_000, 1_000),
+			futureAmplification: 1_001,
+			futureTime:          now.Add(day),
+			expectedErr:         ErrInvalidAmplification,
+		},
+		{
+			name:                "out of bounds",
+			pool:                stableSwapPool(100, 1_000, 1_000),
+			futureAmplification: 0,
+			futureTime:          now.Add(day),
+			expectedErr:         ErrInvalidAmplification,
+		},
+		{
+			name: "balancer pool",
+			pool: func() *Pool {
+				pool := stableSwapPool(0, 1_000, 1_000)
+				pool.PoolParams.PoolType = PoolType_BALANCER
+				return pool
+			}(),
+			futureAmplification: 100,
+			futureTime:          now.Add(day),
+			expectedErr:         ErrInvalidPoolType,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			initialAmplification := tc.pool.PoolParams.Amplification
+			err := tc.pool.RampAmplification(now, tc.futureAmplification, tc.futureTime)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.futureAmplification, tc.pool.PoolParams.Amplification)
+			require.Equal(t, initialAmplification, tc.pool.AmplificationAt(now))
+			require.Equal(t, tc.futureAmplification, tc.pool.AmplificationAt(tc.futureTime))
+		})
+	}
+}
+
+func TestValidatePoolType(t *testing.T) {
+	require.NoError(t, PoolParams{PoolType: PoolType_BALANCER}.ValidatePoolType())
+	require.ErrorIs(t, PoolParams{PoolType: PoolType_BALANCER, Amplification: 10}.ValidatePoolType(), ErrInvalidAmplification)
+	require.NoError(t, PoolParams{PoolType: PoolType_STABLESWAP, Amplification: 10}.ValidatePoolType())
+	require.ErrorIs(t, PoolParams{PoolType: PoolType_STABLESWAP}.ValidatePoolType(), ErrInvalidAmplification)
+	require.ErrorIs(t, PoolParams{PoolType: PoolType_STABLESWAP, Amplification: MaxAmplification + 1}.ValidatePoolType(), ErrInvalidAmplification)
+	require.ErrorIs(t, PoolParams{PoolType: 7}.ValidatePoolType(), ErrInvalidPoolType)
+}
+
+func TestRampAmplificationProposal_ValidateBasic(t *testing.T) {
+	valid := RampAmplificationProposal{
+		Title:               "ramp",
+		Description:         "ramp the amplification",
+		PoolId:              1,
+		FutureAmplification: 200,
+		RampDuration:        7 * 24 * time.Hour,
+	}
+	require.NoError(t, valid.ValidateBasic())
+
+	noPool := valid
+	noPool.PoolId = 0
+	require.Error(t, noPool.ValidateBasic())
+
+	tooLarge := valid
+	tooLarge.FutureAmplification = MaxAmplification + 1
+	require.ErrorIs(t, tooLarge.ValidateBasic(), ErrInvalidAmplification)
+
+	tooFast := valid
+	tooFast.RampDuration = time.Hour
+	require.ErrorIs(t, tooFast.ValidateBasic(), ErrInvalidAmplification)
+
+	noTitle := valid
+	noTitle.Title = ""
+	require.Error(t, noTitle.ValidateBasic())
+}
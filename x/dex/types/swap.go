@@ -10,7 +10,8 @@ import (
 
 /*
 Calculates the amount of tokenOut given tokenIn, deducting the swap fee.
-Solved using the SolveConstantProductInvariant AMM curve.
+Solved using the SolveConstantProductInvariant AMM curve, weighted by the
+weights of the two assets.
 Only supports single asset swaps.
 
 args:
@@ -89,10 +90,14 @@ func (pool Pool) CalcInAmtGivenOut(tokenOut sdk.Coin, tokenInDenom string) (
 		return tokenIn, err
 	}
 
+	if tokenOut.Amount.GTE(poolAssetOut.Token.Amount) {
+		return tokenIn, fmt.Errorf("tokenOut (%s) must be lower than the pool's balance", tokenOut)
+	}
+
 	// assuming the user wishes to withdraw 'tokenOut', the balance of 'tokenOut' post swap will be lower
 	poolTokenOutBalance := poolAssetOut.Token.Amount.ToDec()
 	poolTokenOutBalancePostSwap := poolTokenOutBalance.Sub(tokenOut.Amount.ToDec())
-	// (x_0)(y_0) = (x_0 + in)(y_0 - out)
+	// (x_0)^(w_x) * (y_0)^(w_y) = (x_0 - out)^(w_x) * (y_0 + in)^(w_y)
 	tokenAmountIn := math.SolveConstantProductInvariant(
 		/*xPrior=*/ poolTokenOutBalance,
 		/*xAfter=*/ poolTokenOutBalancePostSwap,
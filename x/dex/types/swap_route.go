@@ -0,0 +1,55 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/*
+Checks a route of pools to swap tokenInDenom through: there is at least one hop
+and at most MaxSwapRoutes, every hop swaps for another denom, and a pool is only
+swapped through once, since the estimates of a route don't update the pools.
+
+args:
+  - tokenInDenom: the denom swapped into the first pool
+  - routes: the hops, in order
+
+ret:
+  - err: ErrInvalidSwapRoutes if the route is invalid
+*/
+func ValidateSwapRoutes(tokenInDenom string, routes []SwapRoute) error {
+	if len(routes) == 0 {
+		return ErrInvalidSwapRoutes.Wrap("no route given")
+	}
+	if len(routes) > MaxSwapRoutes {
+		return ErrInvalidSwapRoutes.Wrapf("at most %d pools can be swapped through, got %d", MaxSwapRoutes, len(routes))
+	}
+
+	denom := tokenInDenom
+	seenPools := make(map[uint64]bool, len(routes))
+	for _, route := range routes {
+		if route.PoolId == 0 {
+			return ErrInvalidPoolId.Wrapf("pool id cannot be %d", route.PoolId)
+		}
+		if seenPools[route.PoolId] {
+			return ErrInvalidSwapRoutes.Wrapf("pool %d is swapped through twice", route.PoolId)
+		}
+		seenPools[route.PoolId] = true
+
+		if err := sdk.ValidateDenom(route.TokenOutDenom); err != nil {
+			return ErrInvalidTokenOutDenom.Wrap(err.Error())
+		}
+		if route.TokenOutDenom == denom {
+			return ErrSameTokenDenom.Wrapf("pool %d swaps %s for itself", route.PoolId, denom)
+		}
+		denom = route.TokenOutDenom
+	}
+	return nil
+}
+
+// TokenOutDenom is the denom received at the end of the route.
+func TokenOutDenom(routes []SwapRoute) string {
+	if len(routes) == 0 {
+		return ""
+	}
+	return routes[len(routes)-1].TokenOutDenom
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"math"
+	"math/rand"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -127,6 +129,29 @@ func TestCalcOutAmtGivenIn(t *testing.T) {
 			tokenOutDenom: "bbb",
 			shouldError:   true,
 		},
+		{
+			// 100*(1-(100/(100+10*(1-0.0003)))^(4/1))
+			name: "80/20 pool",
+			pool: Pool{
+				PoolParams: PoolParams{
+					SwapFee: sdk.MustNewDecFromStr("0.0003"),
+				},
+				PoolAssets: []PoolAsset{
+					{
+						Token:  sdk.NewInt64Coin("aaa", 100),
+						Weight: sdk.NewInt(4),
+					},
+					{
+						Token:  sdk.NewInt64Coin("bbb", 100),
+						Weight: sdk.OneInt(),
+					},
+				},
+				TotalWeight: sdk.NewInt(5),
+			},
+			tokenIn:          sdk.NewInt64Coin("aaa", 10),
+			tokenOutDenom:    "bbb",
+			expectedTokenOut: sdk.NewInt64Coin("bbb", 31),
+		},
 	} {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
@@ -148,6 +173,7 @@ func TestCalcInAmtGivenOut(t *testing.T) {
 		tokenOut        sdk.Coin
 		tokenInDenom    string
 		expectedTokenIn sdk.Coin
+		shouldError     bool
 	}{
 		{
 			name: "simple swap",
@@ -238,17 +264,135 @@ func TestCalcInAmtGivenOut(t *testing.T) {
 			tokenInDenom:    "aaa",
 			expectedTokenIn: sdk.NewInt64Coin("aaa", 5844626),
 		},
+		{
+			// 100*((100/90)^(1/4)-1)/(1-0.0003)
+			name: "80/20 pool, light asset out",
+			pool: Pool{
+				PoolParams: PoolParams{
+					SwapFee: sdk.MustNewDecFromStr("0.0003"),
+				},
+				PoolAssets: []PoolAsset{
+					{
+						Token:  sdk.NewInt64Coin("aaa", 100),
+						Weight: sdk.NewInt(4),
+					},
+					{
+						Token:  sdk.NewInt64Coin("bbb", 100),
+						Weight: sdk.OneInt(),
+					},
+				},
+				TotalWeight: sdk.NewInt(5),
+			},
+			tokenOut:        sdk.NewInt64Coin("bbb", 10),
+			tokenInDenom:    "aaa",
+			expectedTokenIn: sdk.NewInt64Coin("aaa", 3),
+		},
+		{
+			// 100*((100/90)^(4/1)-1)/(1-0.0003)
+			name: "80/20 pool, heavy asset out",
+			pool: Pool{
+				PoolParams: PoolParams{
+					SwapFee: sdk.MustNewDecFromStr("0.0003"),
+				},
+				PoolAssets: []PoolAsset{
+					{
+						Token:  sdk.NewInt64Coin("aaa", 100),
+						Weight: sdk.NewInt(4),
+					},
+					{
+						Token:  sdk.NewInt64Coin("bbb", 100),
+						Weight: sdk.OneInt(),
+					},
+				},
+				TotalWeight: sdk.NewInt(5),
+			},
+			tokenOut:        sdk.NewInt64Coin("aaa", 10),
+			tokenInDenom:    "bbb",
+			expectedTokenIn: sdk.NewInt64Coin("bbb", 53),
+		},
+		{
+			name: "the whole pool balance",
+			pool: Pool{
+				PoolParams: PoolParams{
+					SwapFee: sdk.MustNewDecFromStr("0.0003"),
+				},
+				PoolAssets: []PoolAsset{
+					{
+						Token:  sdk.NewInt64Coin("aaa", 100),
+						Weight: sdk.OneInt(),
+					},
+					{
+						Token:  sdk.NewInt64Coin("bbb", 100),
+						Weight: sdk.OneInt(),
+					},
+				},
+				TotalWeight: sdk.NewInt(2),
+			},
+			tokenOut:     sdk.NewInt64Coin("bbb", 100),
+			tokenInDenom: "aaa",
+			shouldError:  true,
+		},
 	} {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
 			// CalcInAmtGivenOut is the inverse, so we can use the same test inputs/outputs
 			tokenIn, err := tc.pool.CalcInAmtGivenOut(tc.tokenOut, tc.tokenInDenom)
+			if tc.shouldError {
+				require.Error(t, err)
+				return
+			}
 			require.NoError(t, err)
 			require.Equal(t, tc.expectedTokenIn, tokenIn)
 		})
 	}
 }
 
+// TestWeightedSwapInvariant checks over random weighted pools that swaps never
+// decrease the weighted product balanceX^weightX * balanceY^weightY, and that
+// CalcInAmtGivenOut is the inverse of CalcOutAmtGivenIn up to rounding.
+func TestWeightedSwapInvariant(t *testing.T) {
+	weightedLogProduct := func(pool Pool) float64 {
+		var sum float64
+		for _, asset := range pool.PoolAssets {
+			sum += float64(asset.Weight.Int64()) * math.Log(float64(asset.Token.Amount.Int64()))
+		}
+		return sum
+	}
+
+	r := rand.New(rand.NewSource(141))
+	for i := 0; i < 200; i++ {
+		pool := Pool{
+			PoolParams: PoolParams{SwapFee: sdk.MustNewDecFromStr("0.003")},
+			PoolAssets: []PoolAsset{
+				{
+					Token:  sdk.NewInt64Coin("aaa", r.Int63n(1e12)+1e6),
+					Weight: sdk.NewInt(r.Int63n(99) + 1),
+				},
+				{
+					Token:  sdk.NewInt64Coin("bbb", r.Int63n(1e12)+1e6),
+					Weight: sdk.NewInt(r.Int63n(99) + 1),
+				},
+			},
+		}
+		pool.TotalWeight = pool.PoolAssets[0].Weight.Add(pool.PoolAssets[1].Weight)
+		tokenIn := sdk.NewInt64Coin("aaa", r.Int63n(pool.PoolAssets[0].Token.Amount.Int64())+1)
+
+		tokenOut, err := pool.CalcOutAmtGivenIn(tokenIn, "bbb", false)
+		if err != nil {
+			// too small to get anything out
+			continue
+		}
+
+		tokenInForOut, err := pool.CalcInAmtGivenOut(tokenOut, "aaa")
+		require.NoError(t, err)
+		require.True(t, tokenInForOut.Amount.LTE(tokenIn.Amount), "%s for %s, but %s given", tokenInForOut, tokenOut, tokenIn)
+
+		before := weightedLogProduct(pool)
+		require.NoError(t, pool.ApplySwap(tokenIn, tokenOut))
+		require.GreaterOrEqual(t, weightedLogProduct(pool), before-1e-9)
+	}
+}
+
 func TestApplySwap(t *testing.T) {
 	for _, tc := range []struct {
 		name               string
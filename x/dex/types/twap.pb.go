@@ -0,0 +1,574 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/v1/twap.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// The cumulative price of a denom pair of a pool, recorded after every swap,
+// join and exit changing the pool. The arithmetic TWAP between two times is
+// the difference of the cumulative prices at these times over their duration.
+type TwapRecord struct {
+	PoolId     uint64    `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	BaseDenom  string    `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
+	QuoteDenom string    `protobuf:"bytes,3,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty" yaml:"quote_denom"`
+	Time       time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	// the price of the base denom in the quote denom from time on, until the
+	// next record
+	SpotPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=spot_price,json=spotPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"spot_price" yaml:"spot_price"`
+	// the sum of the spot prices times the milliseconds they lasted, from the
+	// creation of the pool to time
+	CumulativePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=cumulative_price,json=cumulativePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_price" yaml:"cumulative_price"`
+}
+
+func (m *TwapRecord) Reset()         { *m = TwapRecord{} }
+func (m *TwapRecord) String() string { return proto.CompactTextString(m) }
+func (*TwapRecord) ProtoMessage()    {}
+func (*TwapRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fb9e471b76d4356, []int{0}
+}
+func (m *TwapRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapRecord.Merge(m, src)
+}
+func (m *TwapRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapRecord proto.InternalMessageInfo
+
+func (m *TwapRecord) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *TwapRecord) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *TwapRecord) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *TwapRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*TwapRecord)(nil), "nibiru.dex.v1.TwapRecord")
+}
+
+func init() { proto.RegisterFile("dex/v1/twap.proto", fileDescriptor_0fb9e471b76d4356) }
+
+var fileDescriptor_0fb9e471b76d4356 = []byte{
+	// 409 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xcd, 0x8e, 0xd3, 0x30,
+	0x18, 0x8c, 0xd9, 0x52, 0x54, 0x57, 0xfc, 0xac, 0x05, 0x6c, 0xd4, 0x43, 0x5c, 0xf9, 0x80, 0x8a,
+	0x10, 0xb6, 0x16, 0x90, 0x90, 0x38, 0xa6, 0x2b, 0xa1, 0xbd, 0x20, 0x14, 0xed, 0x89, 0x4b, 0x95,
+	0x1f, 0x93, 0xb5, 0x48, 0xea, 0x90, 0x38, 0xdd, 0xee, 0x5b, 0xec, 0x63, 0x55, 0xe2, 0xd2, 0x23,
+	0xe2, 0x10, 0x50, 0xfb, 0x06, 0x79, 0x02, 0x64, 0x3b, 0x55, 0x2a, 0x6e, 0x9c, 0xf2, 0x4d, 0xc6,
+	0x33, 0xe3, 0xb1, 0x3e, 0x78, 0x9a, 0xf0, 0x35, 0x5b, 0x9d, 0x33, 0x75, 0x13, 0x16, 0xb4, 0x28,
+	0xa5, 0x92, 0xe8, 0xe1, 0x52, 0x44, 0xa2, 0xac, 0x69, 0xc2, 0xd7, 0x74, 0x75, 0x3e, 0x79, 0x9a,
+	0xca, 0x54, 0x1a, 0x86, 0xe9, 0xc9, 0x1e, 0x9a, 0xe0, 0x54, 0xca, 0x34, 0xe3, 0xcc, 0xa0, 0xa8,
+	0xfe, 0xca, 0x94, 0xc8, 0x79, 0xa5, 0xc2, 0xbc, 0x73, 0x21, 0x3f, 0x4e, 0x20, 0xbc, 0xba, 0x09,
+	0x8b, 0x80, 0xc7, 0xb2, 0x4c, 0xd0, 0x2b, 0xf8, 0xa0, 0x90, 0x32, 0x5b, 0x88, 0xc4, 0x05, 0x53,
+	0x30, 0x1b, 0xf8, 0xa8, 0x6d, 0xf0, 0xa3, 0xdb, 0x30, 0xcf, 0x3e, 0x90, 0x8e, 0x20, 0xc1, 0x50,
+	0x4f, 0x97, 0x09, 0x7a, 0x07, 0x61, 0x14, 0x56, 0x7c, 0x91, 0xf0, 0xa5, 0xcc, 0xdd, 0x7b, 0x53,
+	0x30, 0x1b, 0xf9, 0xcf, 0xda, 0x06, 0x9f, 0xda, 0xf3, 0x3d, 0x47, 0x82, 0x91, 0x06, 0x17, 0x7a,
+	0x46, 0xef, 0xe1, 0xf8, 0x7b, 0x2d, 0xd5, 0x41, 0x76, 0x62, 0x64, 0xcf, 0xdb, 0x06, 0x23, 0x2b,
+	0x3b, 0x22, 0x49, 0x00, 0x0d, 0xb2, 0xc2, 0x8f, 0x70, 0xa0, 0x6f, 0xef, 0x0e, 0xa6, 0x60, 0x36,
+	0x7e, 0x33, 0xa1, 0xb6, 0x1a, 0x3d, 0x54, 0xa3, 0x57, 0x87, 0x6a, 0xfe, 0xd9, 0xa6, 0xc1, 0x4e,
+	0xdb, 0xe0, 0xb1, 0x75, 0xd4, 0x2a, 0x72, 0xf7, 0x1b, 0x83, 0xc0, 0x18, 0xa0, 0x08, 0xc2, 0xaa,
+	0x90, 0x6a, 0x51, 0x94, 0x22, 0xe6, 0xee, 0x7d, 0x73, 0x81, 0xb9, 0x96, 0xfc, 0x6a, 0xf0, 0x8b,
+	0x54, 0xa8, 0xeb, 0x3a, 0xa2, 0xb1, 0xcc, 0x59, 0x2c, 0xab, 0x5c, 0x56, 0xdd, 0xe7, 0x75, 0x95,
+	0x7c, 0x63, 0xea, 0xb6, 0xe0, 0x15, 0xbd, 0xe0, 0x71, 0xdf, 0xb2, 0x77, 0x22, 0xc1, 0x48, 0x83,
+	0xcf, 0x7a, 0x46, 0x0a, 0x3e, 0x89, 0xeb, 0xbc, 0xce, 0x42, 0x25, 0x56, 0xbc, 0x4b, 0x1a, 0x9a,
+	0xa4, 0xcb, 0xff, 0x4e, 0x3a, 0xb3, 0x49, 0xff, 0xfa, 0x91, 0xe0, 0x71, 0xff, 0xcb, 0xa4, 0xfa,
+	0xf3, 0xcd, 0xce, 0x03, 0xdb, 0x9d, 0x07, 0xfe, 0xec, 0x3c, 0x70, 0xb7, 0xf7, 0x9c, 0xed, 0xde,
+	0x73, 0x7e, 0xee, 0x3d, 0xe7, 0xcb, 0xcb, 0xa3, 0xb4, 0x4f, 0x66, 0x71, 0xe6, 0xd7, 0xa1, 0x58,
+	0x32, 0xbb, 0x44, 0x6c, 0xcd, 0xf4, 0x82, 0x99, 0xd0, 0x68, 0x68, 0x5e, 0xf4, 0xed, 0xdf, 0x01,
+	0x00, 0x5e, 0x89, 0xce, 0x58, 0x74, 0x02, 0x00, 0x00,
+}
+
+func (m *TwapRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CumulativePrice.Size()
+		i -= size
+		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SpotPrice.Size()
+		i -= size
+		if _, err := m.SpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTwap(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintTwap(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintTwap(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTwap(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTwap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTwap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TwapRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTwap(uint64(m.PoolId))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTwap(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovTwap(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTwap(uint64(l))
+	l = m.SpotPrice.Size()
+	n += 1 + l + sovTwap(uint64(l))
+	l = m.CumulativePrice.Size()
+	n += 1 + l + sovTwap(uint64(l))
+	return n
+}
+
+func sovTwap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTwap(x uint64) (n int) {
+	return sovTwap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TwapRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTwap(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTwap
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTwap
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTwap
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTwap
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTwap        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTwap          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTwap = fmt.Errorf("proto: unexpected end of group")
+)
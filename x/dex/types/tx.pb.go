@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -134,8 +135,7 @@ func (m *MsgCreatePoolResponse) GetPoolId() uint64 {
 	return 0
 }
 
-//
-//Message to join a pool (identified by poolId) with a set of tokens to deposit.
+// Message to join a pool (identified by poolId) with a set of tokens to deposit.
 type MsgJoinPool struct {
 	Sender      string       `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
 	PoolId      uint64       `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
@@ -204,8 +204,7 @@ func (m *MsgJoinPool) GetUseAllCoins() bool {
 	return false
 }
 
-//
-//Response when a user joins a pool.
+// Response when a user joins a pool.
 type MsgJoinPoolResponse struct {
 	// the final state of the pool after a join
 	Pool *Pool `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
@@ -273,6 +272,9 @@ type MsgExitPool struct {
 	Sender     string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
 	PoolId     uint64     `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 	PoolShares types.Coin `protobuf:"bytes,3,opt,name=pool_shares,json=poolShares,proto3" json:"pool_shares" yaml:"pool_shares"`
+	// if set, all the tokens are withdrawn in this denom, at the price of the
+	// weighted invariant. Otherwise the tokens are withdrawn proportionally.
+	TokenOutDenom string `protobuf:"bytes,4,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
 }
 
 func (m *MsgExitPool) Reset()         { *m = MsgExitPool{} }
@@ -329,6 +331,13 @@ func (m *MsgExitPool) GetPoolShares() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgExitPool) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
 type MsgExitPoolResponse struct {
 	TokensOut []types.Coin `protobuf:"bytes,3,rep,name=tokens_out,json=tokensOut,proto3" json:"tokens_out" yaml:"tokens_out"`
 }
@@ -485,805 +494,3227 @@ func (m *MsgSwapAssetsResponse) GetTokenOut() types.Coin {
 	return types.Coin{}
 }
 
-func init() {
-	proto.RegisterType((*MsgCreatePool)(nil), "nibiru.dex.v1.MsgCreatePool")
-	proto.RegisterType((*MsgCreatePoolResponse)(nil), "nibiru.dex.v1.MsgCreatePoolResponse")
-	proto.RegisterType((*MsgJoinPool)(nil), "nibiru.dex.v1.MsgJoinPool")
-	proto.RegisterType((*MsgJoinPoolResponse)(nil), "nibiru.dex.v1.MsgJoinPoolResponse")
-	proto.RegisterType((*MsgExitPool)(nil), "nibiru.dex.v1.MsgExitPool")
-	proto.RegisterType((*MsgExitPoolResponse)(nil), "nibiru.dex.v1.MsgExitPoolResponse")
-	proto.RegisterType((*MsgSwapAssets)(nil), "nibiru.dex.v1.MsgSwapAssets")
-	proto.RegisterType((*MsgSwapAssetsResponse)(nil), "nibiru.dex.v1.MsgSwapAssetsResponse")
+// A hop of a swap route: the tokens are swapped in the pool for token_out_denom.
+type SwapRoute struct {
+	PoolId        uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TokenOutDenom string `protobuf:"bytes,2,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
 }
 
-func init() { proto.RegisterFile("dex/v1/tx.proto", fileDescriptor_18e8aa85ff669608) }
-
-var fileDescriptor_18e8aa85ff669608 = []byte{
-	// 829 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x6b, 0xe3, 0x46,
-	0x14, 0xb6, 0x62, 0x93, 0x38, 0x63, 0xbc, 0x4e, 0x26, 0xdb, 0x5d, 0x45, 0x04, 0xdb, 0x0c, 0x85,
-	0x7a, 0x29, 0x48, 0xb5, 0x7b, 0x2b, 0x85, 0xb2, 0x72, 0x7b, 0x48, 0xc1, 0x4d, 0x50, 0xa0, 0x87,
-	0x52, 0x30, 0x63, 0x7b, 0x50, 0x26, 0x95, 0x66, 0x84, 0x67, 0x94, 0xf5, 0x52, 0x7a, 0xe9, 0xb1,
-	0xa7, 0x42, 0xff, 0x9e, 0xf6, 0xda, 0x3d, 0x2e, 0xf4, 0xd2, 0x93, 0x29, 0x49, 0xff, 0x02, 0x43,
-	0x2f, 0x3d, 0x15, 0xcd, 0x8c, 0x14, 0x79, 0xd7, 0xa9, 0x29, 0x25, 0x37, 0xcd, 0xbc, 0x1f, 0xdf,
-	0xfb, 0xbe, 0xf7, 0xde, 0x08, 0xb4, 0x66, 0x64, 0xe1, 0x5d, 0xf7, 0x3d, 0xb9, 0x70, 0x93, 0x39,
-	0x97, 0x1c, 0x36, 0x19, 0x9d, 0xd0, 0x79, 0xea, 0xce, 0xc8, 0xc2, 0xbd, 0xee, 0x3b, 0x87, 0xc6,
-	0x9e, 0x70, 0x1e, 0x69, 0x0f, 0xe7, 0x71, 0xc8, 0x43, 0xae, 0x3e, 0xbd, 0xec, 0xcb, 0xdc, 0xb6,
-	0xa7, 0x5c, 0xc4, 0x5c, 0x78, 0x13, 0x2c, 0x88, 0x77, 0xdd, 0x9f, 0x10, 0x89, 0xfb, 0xde, 0x94,
-	0x53, 0x66, 0xec, 0x27, 0x21, 0xe7, 0x61, 0x44, 0x3c, 0x9c, 0x50, 0x0f, 0x33, 0xc6, 0x25, 0x96,
-	0x94, 0x33, 0xa1, 0xad, 0xe8, 0x17, 0x0b, 0x34, 0x47, 0x22, 0x1c, 0xce, 0x09, 0x96, 0xe4, 0x9c,
-	0xf3, 0x08, 0xda, 0x60, 0x6f, 0x9a, 0x9d, 0xf8, 0xdc, 0xb6, 0xba, 0x56, 0x6f, 0x3f, 0xc8, 0x8f,
-	0x30, 0x00, 0x8d, 0xac, 0x9a, 0x71, 0x82, 0xe7, 0x38, 0x16, 0xf6, 0x4e, 0xd7, 0xea, 0x35, 0x06,
-	0xc7, 0xee, 0x5a, 0xdd, 0x6e, 0x96, 0xe3, 0x5c, 0x39, 0xf8, 0x4f, 0x56, 0xcb, 0x0e, 0x7c, 0x89,
-	0xe3, 0xe8, 0x23, 0x54, 0x8a, 0x43, 0x01, 0x48, 0x0a, 0x1f, 0xf8, 0x89, 0xc9, 0x89, 0x85, 0x20,
-	0x52, 0xd8, 0xd5, 0x6e, 0xb5, 0xd7, 0x18, 0xd8, 0x1b, 0x72, 0x3e, 0xcf, 0x1c, 0xfc, 0xda, 0xab,
-	0x65, 0xa7, 0xa2, 0x13, 0xa8, 0x0b, 0x81, 0x3e, 0x00, 0xef, 0xac, 0xd5, 0x1f, 0x10, 0x91, 0x70,
-	0x26, 0x08, 0x7c, 0x0a, 0xf6, 0x54, 0x66, 0x3a, 0x53, 0x3c, 0x6a, 0xc1, 0x6e, 0x76, 0x3c, 0x9d,
-	0xa1, 0xbf, 0x2c, 0xd0, 0x18, 0x89, 0xf0, 0x73, 0x4e, 0x99, 0x22, 0xfc, 0x0c, 0xec, 0x0a, 0xc2,
-	0x66, 0xc4, 0xf0, 0xf5, 0x0f, 0x57, 0xcb, 0x4e, 0x53, 0x97, 0xad, 0xef, 0x51, 0x60, 0x1c, 0xe0,
-	0xfb, 0x77, 0x39, 0x33, 0xf6, 0x35, 0x1f, 0xae, 0x96, 0x9d, 0x47, 0x25, 0x8a, 0x74, 0x86, 0x72,
-	0x1c, 0x78, 0x0e, 0xf6, 0x25, 0xff, 0x86, 0x30, 0x31, 0xa6, 0xcc, 0x10, 0x3b, 0x76, 0x75, 0xb3,
-	0xdc, 0xac, 0x59, 0xae, 0x69, 0x96, 0x3b, 0xe4, 0x94, 0xf9, 0x76, 0xc6, 0x6c, 0xb5, 0xec, 0x1c,
-	0xe8, 0x6c, 0x45, 0x24, 0x0a, 0xea, 0xfa, 0xfb, 0x94, 0xc1, 0x8f, 0x41, 0x33, 0x15, 0x64, 0x8c,
-	0xa3, 0x68, 0x9c, 0x35, 0x58, 0xd8, 0xb5, 0xae, 0xd5, 0xab, 0xfb, 0xf6, 0x6a, 0xd9, 0x79, 0xac,
-	0xc3, 0xd6, 0xcc, 0x28, 0x68, 0xa4, 0x82, 0x3c, 0x8f, 0xa2, 0xa1, 0x3a, 0xfd, 0xb0, 0x03, 0x8e,
-	0x4a, 0xbc, 0x0b, 0xa1, 0xde, 0x03, 0xb5, 0xac, 0x62, 0xc5, 0xbe, 0x31, 0x38, 0xda, 0xa0, 0x7d,
-	0xa0, 0x1c, 0x60, 0x04, 0x8e, 0x58, 0x1a, 0x8f, 0x15, 0x51, 0x71, 0x89, 0xe7, 0x44, 0x8c, 0x79,
-	0x2a, 0x8b, 0x39, 0xb8, 0x97, 0x1a, 0x32, 0xd4, 0x1c, 0x5d, 0xe3, 0x86, 0x1c, 0x28, 0x38, 0x60,
-	0x69, 0x9c, 0x41, 0x5d, 0xa8, 0xbb, 0xb3, 0x54, 0xc2, 0xaf, 0x41, 0x6b, 0x4e, 0x62, 0x4c, 0x19,
-	0x65, 0xa1, 0xa1, 0xfb, 0x3f, 0x44, 0x7c, 0x54, 0xe4, 0xd2, 0x62, 0xfc, 0xac, 0x87, 0xe0, 0xb3,
-	0x05, 0x95, 0x0f, 0x3a, 0x04, 0x5f, 0x9a, 0xf9, 0xd6, 0x5c, 0xed, 0xea, 0x36, 0xad, 0x1c, 0xc3,
-	0xa0, 0xbc, 0x37, 0x3a, 0xd6, 0xec, 0x8d, 0x16, 0x08, 0x5d, 0xa9, 0x5e, 0xe6, 0xe5, 0x17, 0xbd,
-	0xbc, 0x00, 0xc0, 0x90, 0xce, 0x3a, 0xb3, 0x55, 0xaf, 0x63, 0x83, 0x76, 0xb8, 0xa6, 0x97, 0x6a,
-	0x88, 0x99, 0xdd, 0xb3, 0x54, 0xa2, 0xbf, 0xf5, 0x1b, 0x71, 0xf1, 0x02, 0x27, 0x7a, 0xe9, 0x1e,
-	0x4c, 0xad, 0x11, 0xd0, 0xc3, 0xae, 0x37, 0x66, 0x8b, 0x54, 0x4f, 0x4d, 0xf1, 0xad, 0x52, 0xf1,
-	0xaa, 0xd7, 0x7b, 0xea, 0xf3, 0x94, 0x41, 0x1f, 0xb4, 0xf4, 0x2d, 0x4f, 0xe5, 0x78, 0x46, 0x18,
-	0x8f, 0xd5, 0xc6, 0xec, 0xfb, 0xce, 0x6a, 0xd9, 0x79, 0x52, 0x0e, 0x2b, 0x1c, 0x50, 0xd0, 0x54,
-	0x37, 0x67, 0xa9, 0xfc, 0x54, 0x9d, 0xa9, 0x7a, 0x5f, 0xee, 0xb8, 0x17, 0x52, 0xe7, 0xeb, 0x6d,
-	0x94, 0xb6, 0xfe, 0xfb, 0x64, 0x6a, 0xa1, 0xeb, 0x39, 0xde, 0xe0, 0xd7, 0x2a, 0xa8, 0x8e, 0x44,
-	0x08, 0xaf, 0x00, 0x28, 0xbd, 0xc7, 0x27, 0x6f, 0x2c, 0xe4, 0xda, 0x6b, 0xe7, 0xbc, 0xfb, 0x6f,
-	0xd6, 0xbc, 0x56, 0x64, 0x7f, 0xff, 0xdb, 0x9f, 0x3f, 0xed, 0x40, 0x74, 0xe0, 0x69, 0x6f, 0x2f,
-	0xfb, 0xb9, 0xa8, 0x9d, 0x66, 0xa0, 0x5e, 0x3c, 0x84, 0xce, 0xdb, 0xb9, 0x72, 0x9b, 0x83, 0xee,
-	0xb7, 0x15, 0x28, 0x48, 0xa1, 0x9c, 0x20, 0xa7, 0x8c, 0xf2, 0xad, 0x69, 0xf2, 0x77, 0xde, 0x15,
-	0xa7, 0x2c, 0xc3, 0x2b, 0x76, 0x6e, 0x03, 0x5e, 0x6e, 0xdb, 0x84, 0xf7, 0xe6, 0xb0, 0x6f, 0xc3,
-	0x23, 0x0b, 0x2a, 0xa1, 0x04, 0xa0, 0x34, 0xb7, 0x1b, 0xb4, 0xbc, 0xb3, 0x6e, 0xd2, 0xf2, 0xed,
-	0xbe, 0x6f, 0x43, 0x15, 0x2f, 0x70, 0xe2, 0x0f, 0x5f, 0xdd, 0xb4, 0xad, 0xd7, 0x37, 0x6d, 0xeb,
-	0x8f, 0x9b, 0xb6, 0xf5, 0xe3, 0x6d, 0xbb, 0xf2, 0xfa, 0xb6, 0x5d, 0xf9, 0xfd, 0xb6, 0x5d, 0xf9,
-	0xea, 0x59, 0x48, 0xe5, 0x65, 0x3a, 0x71, 0xa7, 0x3c, 0xf6, 0xbe, 0x50, 0xf1, 0xc3, 0x4b, 0x4c,
-	0x59, 0x9e, 0x6b, 0xa1, 0xb2, 0xc9, 0x97, 0x09, 0x11, 0x93, 0x5d, 0xf5, 0x87, 0xfe, 0xf0, 0x9f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x4e, 0x90, 0x89, 0x2a, 0x08, 0x00, 0x00,
+func (m *SwapRoute) Reset()         { *m = SwapRoute{} }
+func (m *SwapRoute) String() string { return proto.CompactTextString(m) }
+func (*SwapRoute) ProtoMessage()    {}
+func (*SwapRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18e8aa85ff669608, []int{8}
+}
+func (m *SwapRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapRoute.Merge(m, src)
+}
+func (m *SwapRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapRoute.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_SwapRoute proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Used to create a pool.
-	CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error)
-	// Join a pool as a liquidity provider.
-	JoinPool(ctx context.Context, in *MsgJoinPool, opts ...grpc.CallOption) (*MsgJoinPoolResponse, error)
-	// Exit a pool position by returning LP shares
-	ExitPool(ctx context.Context, in *MsgExitPool, opts ...grpc.CallOption) (*MsgExitPoolResponse, error)
-	// Swap assets in a pool
-	SwapAssets(ctx context.Context, in *MsgSwapAssets, opts ...grpc.CallOption) (*MsgSwapAssetsResponse, error)
+func (m *SwapRoute) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *SwapRoute) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+type MsgSwapExactAmountIn struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// the pools to swap through, in order. The denom out of the last hop is the
+	// denom received.
+	Routes  []SwapRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+	TokenIn types.Coin  `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	// the swap fails if fewer tokens would be received
+	TokenOutMinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
 }
 
-func (c *msgClient) CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error) {
-	out := new(MsgCreatePoolResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.dex.v1.Msg/CreatePool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSwapExactAmountIn) Reset()         { *m = MsgSwapExactAmountIn{} }
+func (m *MsgSwapExactAmountIn) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapExactAmountIn) ProtoMessage()    {}
+func (*MsgSwapExactAmountIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18e8aa85ff669608, []int{9}
+}
+func (m *MsgSwapExactAmountIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapExactAmountIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapExactAmountIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSwapExactAmountIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapExactAmountIn.Merge(m, src)
+}
+func (m *MsgSwapExactAmountIn) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapExactAmountIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapExactAmountIn.DiscardUnknown(m)
 }
 
-func (c *msgClient) JoinPool(ctx context.Context, in *MsgJoinPool, opts ...grpc.CallOption) (*MsgJoinPoolResponse, error) {
-	out := new(MsgJoinPoolResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.dex.v1.Msg/JoinPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSwapExactAmountIn proto.InternalMessageInfo
+
+func (m *MsgSwapExactAmountIn) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ExitPool(ctx context.Context, in *MsgExitPool, opts ...grpc.CallOption) (*MsgExitPoolResponse, error) {
-	out := new(MsgExitPoolResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.dex.v1.Msg/ExitPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSwapExactAmountIn) GetRoutes() []SwapRoute {
+	if m != nil {
+		return m.Routes
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) SwapAssets(ctx context.Context, in *MsgSwapAssets, opts ...grpc.CallOption) (*MsgSwapAssetsResponse, error) {
-	out := new(MsgSwapAssetsResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.dex.v1.Msg/SwapAssets", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSwapExactAmountIn) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Used to create a pool.
-	CreatePool(context.Context, *MsgCreatePool) (*MsgCreatePoolResponse, error)
-	// Join a pool as a liquidity provider.
-	JoinPool(context.Context, *MsgJoinPool) (*MsgJoinPoolResponse, error)
-	// Exit a pool position by returning LP shares
-	ExitPool(context.Context, *MsgExitPool) (*MsgExitPoolResponse, error)
-	// Swap assets in a pool
-	SwapAssets(context.Context, *MsgSwapAssets) (*MsgSwapAssetsResponse, error)
+type MsgSwapExactAmountInResponse struct {
+	TokenOut types.Coin `protobuf:"bytes,1,opt,name=token_out,json=tokenOut,proto3" json:"token_out" yaml:"token_out"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgSwapExactAmountInResponse) Reset()         { *m = MsgSwapExactAmountInResponse{} }
+func (m *MsgSwapExactAmountInResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapExactAmountInResponse) ProtoMessage()    {}
+func (*MsgSwapExactAmountInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18e8aa85ff669608, []int{10}
 }
-
-func (*UnimplementedMsgServer) CreatePool(ctx context.Context, req *MsgCreatePool) (*MsgCreatePoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePool not implemented")
+func (m *MsgSwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) JoinPool(ctx context.Context, req *MsgJoinPool) (*MsgJoinPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method JoinPool not implemented")
+func (m *MsgSwapExactAmountInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapExactAmountInResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ExitPool(ctx context.Context, req *MsgExitPool) (*MsgExitPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExitPool not implemented")
+func (m *MsgSwapExactAmountInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapExactAmountInResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SwapAssets(ctx context.Context, req *MsgSwapAssets) (*MsgSwapAssetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SwapAssets not implemented")
+func (m *MsgSwapExactAmountInResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgSwapExactAmountInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapExactAmountInResponse.DiscardUnknown(m)
 }
 
-func _Msg_CreatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreatePool)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreatePool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.dex.v1.Msg/CreatePool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreatePool(ctx, req.(*MsgCreatePool))
+var xxx_messageInfo_MsgSwapExactAmountInResponse proto.InternalMessageInfo
+
+func (m *MsgSwapExactAmountInResponse) GetTokenOut() types.Coin {
+	if m != nil {
+		return m.TokenOut
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Msg_JoinPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgJoinPool)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).JoinPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.dex.v1.Msg/JoinPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).JoinPool(ctx, req.(*MsgJoinPool))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgSwapExactAmountOut struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// the pools to swap through, in order. The denom out of the last hop must be
+	// the denom of token_out.
+	Routes       []SwapRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+	TokenInDenom string      `protobuf:"bytes,3,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
+	TokenOut     types.Coin  `protobuf:"bytes,4,opt,name=token_out,json=tokenOut,proto3" json:"token_out" yaml:"token_out"`
+	// the swap fails if more tokens would be paid
+	TokenInMaxAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=token_in_max_amount,json=tokenInMaxAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_in_max_amount" yaml:"token_in_max_amount"`
 }
 
-func _Msg_ExitPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgExitPool)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ExitPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.dex.v1.Msg/ExitPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ExitPool(ctx, req.(*MsgExitPool))
+func (m *MsgSwapExactAmountOut) Reset()         { *m = MsgSwapExactAmountOut{} }
+func (m *MsgSwapExactAmountOut) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapExactAmountOut) ProtoMessage()    {}
+func (*MsgSwapExactAmountOut) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18e8aa85ff669608, []int{11}
+}
+func (m *MsgSwapExactAmountOut) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapExactAmountOut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapExactAmountOut.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSwapExactAmountOut) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapExactAmountOut.Merge(m, src)
+}
+func (m *MsgSwapExactAmountOut) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapExactAmountOut) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapExactAmountOut.DiscardUnknown(m)
 }
 
-func _Msg_SwapAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSwapAssets)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSwapExactAmountOut proto.InternalMessageInfo
+
+func (m *MsgSwapExactAmountOut) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SwapAssets(ctx, in)
+	return ""
+}
+
+func (m *MsgSwapExactAmountOut) GetRoutes() []SwapRoute {
+	if m != nil {
+		return m.Routes
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.dex.v1.Msg/SwapAssets",
+	return nil
+}
+
+func (m *MsgSwapExactAmountOut) GetTokenInDenom() string {
+	if m != nil {
+		return m.TokenInDenom
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SwapAssets(ctx, req.(*MsgSwapAssets))
+	return ""
+}
+
+func (m *MsgSwapExactAmountOut) GetTokenOut() types.Coin {
+	if m != nil {
+		return m.TokenOut
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "nibiru.dex.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreatePool",
-			Handler:    _Msg_CreatePool_Handler,
-		},
-		{
-			MethodName: "JoinPool",
-			Handler:    _Msg_JoinPool_Handler,
-		},
-		{
-			MethodName: "ExitPool",
-			Handler:    _Msg_ExitPool_Handler,
-		},
-		{
-			MethodName: "SwapAssets",
-			Handler:    _Msg_SwapAssets_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dex/v1/tx.proto",
+type MsgSwapExactAmountOutResponse struct {
+	TokenIn types.Coin `protobuf:"bytes,1,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
 }
 
-func (m *MsgCreatePool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSwapExactAmountOutResponse) Reset()         { *m = MsgSwapExactAmountOutResponse{} }
+func (m *MsgSwapExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapExactAmountOutResponse) ProtoMessage()    {}
+func (*MsgSwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18e8aa85ff669608, []int{12}
+}
+func (m *MsgSwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapExactAmountOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapExactAmountOutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSwapExactAmountOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapExactAmountOutResponse.Merge(m, src)
+}
+func (m *MsgSwapExactAmountOutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapExactAmountOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapExactAmountOutResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreatePool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSwapExactAmountOutResponse proto.InternalMessageInfo
+
+func (m *MsgSwapExactAmountOutResponse) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
 }
 
-func (m *MsgCreatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PoolAssets) > 0 {
-		for iNdEx := len(m.PoolAssets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PoolAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+type MsgJoinPoolExactShares struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// the amount of LP shares to mint
+	PoolSharesOut github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=pool_shares_out,json=poolSharesOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"pool_shares_out" yaml:"pool_shares_out"`
+	// if set, the join fails if more tokens of a denom would be deposited, or if
+	// a denom is missing
+	TokenInMaxs github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=token_in_maxs,json=tokenInMaxs,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"token_in_maxs" yaml:"token_in_maxs"`
+}
+
+func (m *MsgJoinPoolExactShares) Reset()         { *m = MsgJoinPoolExactShares{} }
+func (m *MsgJoinPoolExactShares) String() string { return proto.CompactTextString(m) }
+func (*MsgJoinPoolExactShares) ProtoMessage()    {}
+func (*MsgJoinPoolExactShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18e8aa85ff669608, []int{13}
+}
+func (m *MsgJoinPoolExactShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgJoinPoolExactShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgJoinPoolExactShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.PoolParams != nil {
-		{
-			size, err := m.PoolParams.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgJoinPoolExactShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgJoinPoolExactShares.Merge(m, src)
+}
+func (m *MsgJoinPoolExactShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgJoinPoolExactShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgJoinPoolExactShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgJoinPoolExactShares proto.InternalMessageInfo
+
+func (m *MsgJoinPoolExactShares) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgJoinPoolExactShares) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgCreatePoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgJoinPoolExactShares) GetTokenInMaxs() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TokenInMaxs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCreatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgJoinPoolExactSharesResponse struct {
+	// the tokens deposited in the pool
+	TokensIn github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=tokens_in,json=tokensIn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_in" yaml:"tokens_in"`
 }
 
-func (m *MsgCreatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgJoinPoolExactSharesResponse) Reset()         { *m = MsgJoinPoolExactSharesResponse{} }
+func (m *MsgJoinPoolExactSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgJoinPoolExactSharesResponse) ProtoMessage()    {}
+func (*MsgJoinPoolExactSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18e8aa85ff669608, []int{14}
+}
+func (m *MsgJoinPoolExactSharesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgJoinPoolExactSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgJoinPoolExactSharesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgJoinPoolExactSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgJoinPoolExactSharesResponse.Merge(m, src)
+}
+func (m *MsgJoinPoolExactSharesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgJoinPoolExactSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgJoinPoolExactSharesResponse.DiscardUnknown(m)
 }
 
-func (m *MsgJoinPool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgJoinPoolExactSharesResponse proto.InternalMessageInfo
+
+func (m *MsgJoinPoolExactSharesResponse) GetTokensIn() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TokensIn
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgJoinPool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgExitPoolExactTokens struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// the tokens to withdraw, any subset of the pool's assets
+	TokensOut github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=tokens_out,json=tokensOut,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_out" yaml:"tokens_out"`
+	// the exit fails if more LP shares would be burned
+	PoolSharesInMax github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=pool_shares_in_max,json=poolSharesInMax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"pool_shares_in_max" yaml:"pool_shares_in_max"`
 }
 
-func (m *MsgJoinPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UseAllCoins {
-		i--
-		if m.UseAllCoins {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.TokensIn) > 0 {
-		for iNdEx := len(m.TokensIn) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TokensIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgExitPoolExactTokens) Reset()         { *m = MsgExitPoolExactTokens{} }
+func (m *MsgExitPoolExactTokens) String() string { return proto.CompactTextString(m) }
+func (*MsgExitPoolExactTokens) ProtoMessage()    {}
+func (*MsgExitPoolExactTokens) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18e8aa85ff669608, []int{15}
+}
+func (m *MsgExitPoolExactTokens) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExitPoolExactTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExitPoolExactTokens.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *MsgExitPoolExactTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExitPoolExactTokens.Merge(m, src)
+}
+func (m *MsgExitPoolExactTokens) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExitPoolExactTokens) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExitPoolExactTokens.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExitPoolExactTokens proto.InternalMessageInfo
+
+func (m *MsgExitPoolExactTokens) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgExitPoolExactTokens) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgJoinPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgExitPoolExactTokens) GetTokensOut() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TokensOut
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgJoinPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgExitPoolExactTokensResponse struct {
+	// the LP shares burned
+	PoolSharesIn types.Coin `protobuf:"bytes,1,opt,name=pool_shares_in,json=poolSharesIn,proto3" json:"pool_shares_in" yaml:"pool_shares_in"`
 }
 
-func (m *MsgJoinPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RemainingCoins) > 0 {
-		for iNdEx := len(m.RemainingCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RemainingCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgExitPoolExactTokensResponse) Reset()         { *m = MsgExitPoolExactTokensResponse{} }
+func (m *MsgExitPoolExactTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExitPoolExactTokensResponse) ProtoMessage()    {}
+func (*MsgExitPoolExactTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18e8aa85ff669608, []int{16}
+}
+func (m *MsgExitPoolExactTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExitPoolExactTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExitPoolExactTokensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	{
-		size, err := m.NumPoolSharesOut.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.Pool != nil {
-		{
-			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgExitPoolExactTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExitPoolExactTokensResponse.Merge(m, src)
+}
+func (m *MsgExitPoolExactTokensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExitPoolExactTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExitPoolExactTokensResponse.DiscardUnknown(m)
 }
 
-func (m *MsgExitPool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgExitPoolExactTokensResponse proto.InternalMessageInfo
+
+func (m *MsgExitPoolExactTokensResponse) GetPoolSharesIn() types.Coin {
+	if m != nil {
+		return m.PoolSharesIn
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgExitPool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*MsgCreatePool)(nil), "nibiru.dex.v1.MsgCreatePool")
+	proto.RegisterType((*MsgCreatePoolResponse)(nil), "nibiru.dex.v1.MsgCreatePoolResponse")
+	proto.RegisterType((*MsgJoinPool)(nil), "nibiru.dex.v1.MsgJoinPool")
+	proto.RegisterType((*MsgJoinPoolResponse)(nil), "nibiru.dex.v1.MsgJoinPoolResponse")
+	proto.RegisterType((*MsgExitPool)(nil), "nibiru.dex.v1.MsgExitPool")
+	proto.RegisterType((*MsgExitPoolResponse)(nil), "nibiru.dex.v1.MsgExitPoolResponse")
+	proto.RegisterType((*MsgSwapAssets)(nil), "nibiru.dex.v1.MsgSwapAssets")
+	proto.RegisterType((*MsgSwapAssetsResponse)(nil), "nibiru.dex.v1.MsgSwapAssetsResponse")
+	proto.RegisterType((*SwapRoute)(nil), "nibiru.dex.v1.SwapRoute")
+	proto.RegisterType((*MsgSwapExactAmountIn)(nil), "nibiru.dex.v1.MsgSwapExactAmountIn")
+	proto.RegisterType((*MsgSwapExactAmountInResponse)(nil), "nibiru.dex.v1.MsgSwapExactAmountInResponse")
+	proto.RegisterType((*MsgSwapExactAmountOut)(nil), "nibiru.dex.v1.MsgSwapExactAmountOut")
+	proto.RegisterType((*MsgSwapExactAmountOutResponse)(nil), "nibiru.dex.v1.MsgSwapExactAmountOutResponse")
+	proto.RegisterType((*MsgJoinPoolExactShares)(nil), "nibiru.dex.v1.MsgJoinPoolExactShares")
+	proto.RegisterType((*MsgJoinPoolExactSharesResponse)(nil), "nibiru.dex.v1.MsgJoinPoolExactSharesResponse")
+	proto.RegisterType((*MsgExitPoolExactTokens)(nil), "nibiru.dex.v1.MsgExitPoolExactTokens")
+	proto.RegisterType((*MsgExitPoolExactTokensResponse)(nil), "nibiru.dex.v1.MsgExitPoolExactTokensResponse")
 }
 
-func (m *MsgExitPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PoolShares.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("dex/v1/tx.proto", fileDescriptor_18e8aa85ff669608) }
+
+var fileDescriptor_18e8aa85ff669608 = []byte{
+	// 1353 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xda, 0x26, 0x4d, 0x9e, 0xeb, 0x38, 0x59, 0xc7, 0xa9, 0xb3, 0xa4, 0x76, 0x98, 0xb6,
+	0xd4, 0x51, 0xa9, 0x97, 0x84, 0x1b, 0x42, 0xaa, 0xea, 0xb4, 0xa2, 0x01, 0x4c, 0xaa, 0x2d, 0xe2,
+	0x80, 0x10, 0xd6, 0x26, 0x5e, 0x39, 0x9b, 0xda, 0x33, 0x2b, 0xcf, 0x6e, 0xea, 0xaa, 0xe5, 0xe7,
+	0x05, 0x89, 0x0b, 0x48, 0x3d, 0x82, 0xf8, 0x03, 0x90, 0xf8, 0x17, 0x38, 0xf7, 0x58, 0x89, 0x0b,
+	0x70, 0x30, 0x28, 0xe1, 0x06, 0x27, 0x4b, 0x5c, 0x38, 0xa1, 0xf9, 0xb1, 0xeb, 0x75, 0xbc, 0x8e,
+	0x6d, 0x35, 0x11, 0xa7, 0x78, 0x77, 0xde, 0xbc, 0xf7, 0x7d, 0xdf, 0xfb, 0x66, 0x76, 0x26, 0x90,
+	0xae, 0x59, 0x6d, 0xfd, 0x60, 0x5d, 0x77, 0xdb, 0x25, 0xa7, 0x45, 0x5c, 0xa2, 0xa6, 0xb0, 0xbd,
+	0x63, 0xb7, 0xbc, 0x52, 0xcd, 0x6a, 0x97, 0x0e, 0xd6, 0xb5, 0x05, 0x39, 0xee, 0x10, 0xd2, 0x10,
+	0x11, 0xda, 0x62, 0x9d, 0xd4, 0x09, 0xff, 0xa9, 0xb3, 0x5f, 0xf2, 0x6d, 0x7e, 0x97, 0xd0, 0x26,
+	0xa1, 0xfa, 0x8e, 0x49, 0x2d, 0xfd, 0x60, 0x7d, 0xc7, 0x72, 0xcd, 0x75, 0x7d, 0x97, 0xd8, 0x58,
+	0x8e, 0xaf, 0xd4, 0x09, 0xa9, 0x37, 0x2c, 0xdd, 0x74, 0x6c, 0xdd, 0xc4, 0x98, 0xb8, 0xa6, 0x6b,
+	0x13, 0x4c, 0xc5, 0x28, 0xfa, 0x49, 0x81, 0x54, 0x85, 0xd6, 0x37, 0x5b, 0x96, 0xe9, 0x5a, 0x77,
+	0x09, 0x69, 0xa8, 0x39, 0x38, 0xb7, 0xcb, 0x9e, 0x48, 0x2b, 0xa7, 0xac, 0x2a, 0xc5, 0x59, 0xc3,
+	0x7f, 0x54, 0x0d, 0x48, 0x32, 0x34, 0x55, 0xc7, 0x6c, 0x99, 0x4d, 0x9a, 0x8b, 0xad, 0x2a, 0xc5,
+	0xe4, 0xc6, 0x72, 0xa9, 0x0f, 0x77, 0x89, 0xe5, 0xb8, 0xcb, 0x03, 0xca, 0x4b, 0xdd, 0x4e, 0x41,
+	0x7d, 0x68, 0x36, 0x1b, 0xaf, 0xa3, 0xd0, 0x3c, 0x64, 0x80, 0x13, 0xc4, 0xa8, 0x37, 0x64, 0x4e,
+	0x93, 0x52, 0xcb, 0xa5, 0xb9, 0xf8, 0x6a, 0xbc, 0x98, 0xdc, 0xc8, 0x45, 0xe4, 0xbc, 0xc9, 0x02,
+	0xca, 0x89, 0xa7, 0x9d, 0xc2, 0x94, 0x48, 0xc0, 0x5f, 0x50, 0xf4, 0x2a, 0x64, 0xfb, 0xf0, 0x1b,
+	0x16, 0x75, 0x08, 0xa6, 0x96, 0x7a, 0x01, 0xce, 0xf1, 0xcc, 0x76, 0x8d, 0xf3, 0x48, 0x18, 0xd3,
+	0xec, 0x71, 0xab, 0x86, 0xfe, 0x51, 0x20, 0x59, 0xa1, 0xf5, 0xb7, 0x88, 0x8d, 0x39, 0xe1, 0x35,
+	0x98, 0xa6, 0x16, 0xae, 0x59, 0x92, 0x6f, 0x79, 0xa1, 0xdb, 0x29, 0xa4, 0x04, 0x6c, 0xf1, 0x1e,
+	0x19, 0x32, 0x40, 0xbd, 0xd6, 0xcb, 0xc9, 0xd8, 0x27, 0xca, 0x6a, 0xb7, 0x53, 0x98, 0x0b, 0x51,
+	0xb4, 0x6b, 0xc8, 0xaf, 0xa3, 0xde, 0x85, 0x59, 0x97, 0xdc, 0xb7, 0x30, 0xad, 0xda, 0x58, 0x12,
+	0x5b, 0x2e, 0x89, 0x66, 0x95, 0x58, 0xb3, 0x4a, 0xb2, 0x59, 0xa5, 0x4d, 0x62, 0xe3, 0x72, 0x8e,
+	0x31, 0xeb, 0x76, 0x0a, 0xf3, 0x22, 0x5b, 0x30, 0x13, 0x19, 0x33, 0xe2, 0xf7, 0x16, 0x56, 0xdf,
+	0x80, 0x94, 0x47, 0xad, 0xaa, 0xd9, 0x68, 0x54, 0x59, 0x83, 0x69, 0x2e, 0xb1, 0xaa, 0x14, 0x67,
+	0xca, 0xb9, 0x6e, 0xa7, 0xb0, 0x28, 0xa6, 0xf5, 0x0d, 0x23, 0x23, 0xe9, 0x51, 0xeb, 0x66, 0xa3,
+	0xb1, 0xc9, 0x9f, 0xbe, 0x8a, 0x41, 0x26, 0xc4, 0x3b, 0x10, 0xea, 0x2a, 0x24, 0x18, 0x62, 0xce,
+	0x3e, 0xb9, 0x91, 0x89, 0xd0, 0xde, 0xe0, 0x01, 0x6a, 0x03, 0x32, 0xd8, 0x6b, 0x56, 0x39, 0x51,
+	0xba, 0x67, 0xb6, 0x2c, 0x5a, 0x25, 0x9e, 0x1b, 0xf8, 0x60, 0x28, 0x35, 0x24, 0xa9, 0x69, 0x02,
+	0x63, 0x44, 0x0e, 0x64, 0xcc, 0x63, 0xaf, 0xc9, 0x4a, 0xdd, 0xe3, 0xef, 0xb6, 0x3d, 0x57, 0xfd,
+	0x10, 0xd2, 0x2d, 0xab, 0x69, 0xda, 0xd8, 0xc6, 0x75, 0x49, 0xf7, 0x39, 0x44, 0x9c, 0x0b, 0x72,
+	0x09, 0x31, 0x3e, 0x8f, 0x71, 0x13, 0xdc, 0x6e, 0xdb, 0xee, 0x99, 0x9a, 0xe0, 0x7d, 0x48, 0x86,
+	0xb8, 0xe6, 0xe2, 0xa3, 0xb4, 0xd2, 0x24, 0x83, 0xf0, 0xba, 0x11, 0x73, 0xe5, 0xba, 0x11, 0x02,
+	0xa9, 0x65, 0x48, 0x73, 0x76, 0x4c, 0xbd, 0x6a, 0xcd, 0xc2, 0xa4, 0xc9, 0xcd, 0x30, 0x5b, 0xd6,
+	0xba, 0x9d, 0xc2, 0x52, 0x88, 0x7e, 0x2f, 0x00, 0x19, 0x29, 0xfe, 0x66, 0xdb, 0x73, 0x6f, 0xf1,
+	0xe7, 0x7d, 0xc8, 0x84, 0x24, 0x08, 0xfc, 0x70, 0x0f, 0x40, 0x0a, 0xc7, 0xba, 0x3b, 0x52, 0xf3,
+	0x65, 0x89, 0x78, 0xa1, 0x4f, 0x73, 0xde, 0x54, 0xe9, 0xff, 0x6d, 0xcf, 0x45, 0xff, 0x8a, 0x7d,
+	0xe6, 0xde, 0x03, 0xd3, 0x11, 0x0b, 0xf7, 0xcc, 0x14, 0xaf, 0x80, 0x58, 0x30, 0x62, 0xd5, 0x8d,
+	0x90, 0xfb, 0x82, 0x04, 0x9f, 0x0e, 0x2b, 0xc6, 0xfc, 0x72, 0x8e, 0xff, 0xdc, 0xc2, 0xa7, 0x22,
+	0xb4, 0x0d, 0xd9, 0x3e, 0xee, 0x81, 0xd4, 0xfe, 0x16, 0x21, 0x95, 0x56, 0x26, 0x77, 0xb7, 0x10,
+	0x7a, 0xc6, 0xaf, 0x87, 0x1e, 0xc3, 0x2c, 0xab, 0x63, 0x10, 0xcf, 0xb5, 0xc2, 0xba, 0x29, 0x23,
+	0x75, 0x8b, 0x20, 0x1a, 0x9b, 0x94, 0xe8, 0xaf, 0x31, 0x58, 0x94, 0x4c, 0x6f, 0xb7, 0xcd, 0x5d,
+	0xf7, 0x66, 0x93, 0x78, 0xd8, 0xdd, 0xc2, 0x93, 0x34, 0xfb, 0x4d, 0x98, 0x6e, 0x31, 0xf4, 0xec,
+	0x03, 0x13, 0xf5, 0x31, 0x08, 0xe8, 0x95, 0xb3, 0x52, 0x0f, 0x99, 0x48, 0xcc, 0x42, 0x86, 0x9c,
+	0x7e, 0xda, 0x46, 0xf8, 0x04, 0x16, 0x7b, 0xf4, 0x9b, 0x36, 0xae, 0x9a, 0x9c, 0x9c, 0x74, 0x43,
+	0x85, 0xcd, 0xff, 0xad, 0x53, 0x78, 0xb9, 0x6e, 0xbb, 0x7b, 0xde, 0x4e, 0x69, 0x97, 0x34, 0x75,
+	0xf9, 0x61, 0x16, 0x7f, 0xae, 0xd3, 0xda, 0x7d, 0xdd, 0x7d, 0xe8, 0x58, 0xb4, 0xb4, 0x85, 0xdd,
+	0x6e, 0xa7, 0xf0, 0xe2, 0x71, 0x49, 0x7b, 0x39, 0x91, 0xb1, 0xe0, 0xeb, 0x5a, 0xb1, 0xb1, 0x10,
+	0x11, 0x39, 0xb0, 0x12, 0x25, 0x6d, 0xb4, 0x97, 0x94, 0xd3, 0xf0, 0xd2, 0xb7, 0x71, 0xc8, 0x0e,
+	0x96, 0x64, 0x7b, 0xf3, 0xff, 0xd1, 0xce, 0x1b, 0x30, 0xe7, 0x77, 0x45, 0xda, 0x33, 0xce, 0x6b,
+	0x2f, 0x77, 0x3b, 0x85, 0x6c, 0x7f, 0xd7, 0x7c, 0x77, 0x9e, 0x97, 0xbd, 0xe3, 0xe6, 0xec, 0x17,
+	0x28, 0x71, 0x0a, 0x02, 0xa9, 0x8f, 0x20, 0x13, 0x94, 0x6c, 0x9a, 0x6d, 0xdf, 0x11, 0x2f, 0x70,
+	0x5c, 0xef, 0x4c, 0xec, 0x08, 0xed, 0x18, 0x8b, 0x5e, 0x4a, 0x64, 0xcc, 0x4b, 0x2a, 0x15, 0xb3,
+	0x2d, 0xfd, 0x80, 0xe1, 0x62, 0x64, 0x73, 0x02, 0x43, 0x84, 0xfd, 0xaf, 0x3c, 0xb7, 0xff, 0xd1,
+	0xdf, 0x31, 0x58, 0x0a, 0x1d, 0x1f, 0x78, 0x51, 0xf9, 0x31, 0x3a, 0xab, 0xad, 0xdc, 0x81, 0xf4,
+	0xf1, 0xc3, 0x86, 0xe8, 0xf9, 0x9d, 0x89, 0xb5, 0x5d, 0x1a, 0xf8, 0x9e, 0x8a, 0x66, 0xa6, 0x9c,
+	0xbe, 0x43, 0xc7, 0x97, 0x0a, 0xa4, 0xc2, 0xfa, 0xb3, 0x23, 0xd6, 0x88, 0xef, 0xdf, 0x1d, 0xa9,
+	0xdc, 0xe2, 0x60, 0xf7, 0x28, 0xfa, 0xe1, 0xf7, 0x42, 0x71, 0x0c, 0x8c, 0x2c, 0x11, 0x35, 0x92,
+	0xbd, 0x1e, 0x53, 0xf4, 0xbd, 0x02, 0xf9, 0x68, 0xb9, 0x83, 0x06, 0x3f, 0x0e, 0x1f, 0x30, 0x95,
+	0x51, 0x38, 0x6f, 0x0d, 0x3b, 0x1b, 0x4d, 0x84, 0x31, 0x38, 0x8c, 0xa2, 0xbf, 0x84, 0x1f, 0xfc,
+	0xe3, 0x03, 0x07, 0xf8, 0x1e, 0x1f, 0x3c, 0x33, 0x3f, 0x7c, 0x3a, 0xd9, 0xc9, 0xe4, 0xf6, 0xd0,
+	0x93, 0xc9, 0x44, 0x94, 0x7b, 0xa7, 0x18, 0xb5, 0x0d, 0x6a, 0xd8, 0x41, 0xa2, 0xcb, 0xf2, 0x0b,
+	0xf0, 0xf6, 0xc4, 0x9e, 0x5c, 0x1e, 0xf4, 0xa4, 0xc8, 0x88, 0x8c, 0x74, 0xcf, 0x96, 0xdc, 0x0f,
+	0xe8, 0x33, 0x61, 0x87, 0x08, 0xb5, 0x03, 0x3b, 0x7c, 0x04, 0x73, 0xfd, 0xa9, 0x46, 0xaf, 0xfa,
+	0x8b, 0x52, 0xa1, 0x6c, 0x14, 0x12, 0x64, 0x9c, 0x0f, 0xa3, 0xd8, 0xf8, 0x71, 0x06, 0xe2, 0x15,
+	0x5a, 0x57, 0xf7, 0x01, 0x42, 0xd7, 0xc5, 0x95, 0x63, 0xfb, 0x79, 0xdf, 0x65, 0x4c, 0xbb, 0x7c,
+	0xd2, 0xa8, 0x8f, 0x1c, 0xe5, 0xbe, 0xf8, 0xf9, 0xcf, 0x27, 0x31, 0x15, 0xcd, 0xeb, 0x22, 0x5a,
+	0x67, 0x77, 0x5f, 0x7e, 0xe5, 0xc0, 0x30, 0x13, 0xdc, 0xd3, 0xb4, 0xc1, 0x5c, 0xfe, 0x98, 0x86,
+	0x86, 0x8f, 0x05, 0x55, 0x10, 0xaf, 0xb2, 0x82, 0xb4, 0x70, 0x95, 0x47, 0xd2, 0x64, 0x1f, 0xeb,
+	0xfb, 0xc4, 0xc6, 0xac, 0x5e, 0x70, 0x25, 0x88, 0xa8, 0xe7, 0x8f, 0x69, 0x68, 0xf8, 0xd8, 0xb8,
+	0xf5, 0xac, 0xb6, 0xed, 0xaa, 0xdf, 0x29, 0x90, 0x89, 0xda, 0x51, 0xaf, 0x0c, 0xe7, 0x13, 0x0a,
+	0xd3, 0xae, 0x8f, 0x15, 0x16, 0x20, 0xd2, 0x39, 0xa2, 0x35, 0x74, 0x75, 0xb8, 0x02, 0x55, 0x8b,
+	0xcd, 0x93, 0x26, 0xe0, 0xf0, 0xa2, 0x16, 0xf8, 0x95, 0xe1, 0xf4, 0x43, 0x61, 0xda, 0xf5, 0xb1,
+	0xc2, 0xc6, 0x85, 0xc7, 0x04, 0x93, 0xf0, 0xc4, 0x8a, 0x54, 0x5d, 0x80, 0xd0, 0x85, 0x22, 0xc2,
+	0x89, 0xbd, 0x51, 0xed, 0xf2, 0x49, 0xa3, 0xe3, 0xf6, 0x8c, 0x3e, 0x30, 0x1d, 0xf5, 0x6b, 0x05,
+	0x16, 0x06, 0x4f, 0xb8, 0x97, 0xa2, 0xf3, 0xf7, 0x05, 0x69, 0xd7, 0xc6, 0x08, 0x0a, 0xb0, 0x14,
+	0x39, 0x16, 0x84, 0x56, 0xc3, 0x58, 0x18, 0x02, 0x29, 0x82, 0x38, 0x1a, 0x54, 0x6d, 0xac, 0x3e,
+	0x51, 0x40, 0x8d, 0x38, 0xa5, 0x5d, 0x1e, 0x59, 0x6d, 0xdb, 0x73, 0xb5, 0x57, 0xc6, 0x89, 0x0a,
+	0x40, 0xad, 0x71, 0x50, 0x97, 0xd0, 0x4b, 0x27, 0x83, 0x22, 0x9e, 0x5b, 0xde, 0x7c, 0x7a, 0x98,
+	0x57, 0x9e, 0x1d, 0xe6, 0x95, 0x3f, 0x0e, 0xf3, 0xca, 0x37, 0x47, 0xf9, 0xa9, 0x67, 0x47, 0xf9,
+	0xa9, 0x5f, 0x8e, 0xf2, 0x53, 0x1f, 0xac, 0x85, 0xb6, 0xc8, 0x77, 0x79, 0x9a, 0xcd, 0x3d, 0xd3,
+	0xc6, 0x7e, 0xca, 0x36, 0x4f, 0xca, 0x77, 0xca, 0x9d, 0x69, 0xfe, 0x6f, 0xaa, 0xd7, 0xfe, 0x1b,
+	0x00, 0x18, 0x5d, 0xb5, 0xbf, 0x2f, 0x13, 0x00, 0x00,
 }
 
-func (m *MsgExitPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Used to create a pool.
+	CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error)
+	// Join a pool as a liquidity provider.
+	JoinPool(ctx context.Context, in *MsgJoinPool, opts ...grpc.CallOption) (*MsgJoinPoolResponse, error)
+	// Exit a pool position by returning LP shares
+	ExitPool(ctx context.Context, in *MsgExitPool, opts ...grpc.CallOption) (*MsgExitPoolResponse, error)
+	// Join a pool for an exact amount of LP shares, depositing the tokens in the
+	// pool's proportions.
+	JoinPoolExactShares(ctx context.Context, in *MsgJoinPoolExactShares, opts ...grpc.CallOption) (*MsgJoinPoolExactSharesResponse, error)
+	// Withdraw an exact amount of tokens from a pool, burning as few LP shares
+	// as needed.
+	ExitPoolExactTokens(ctx context.Context, in *MsgExitPoolExactTokens, opts ...grpc.CallOption) (*MsgExitPoolExactTokensResponse, error)
+	// Swap assets in a pool
+	SwapAssets(ctx context.Context, in *MsgSwapAssets, opts ...grpc.CallOption) (*MsgSwapAssetsResponse, error)
+	// Swap an exact amount of tokens along a route of pools, for at least a
+	// minimum amount of tokens out.
+	SwapExactAmountIn(ctx context.Context, in *MsgSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSwapExactAmountInResponse, error)
+	// Swap along a route of pools for an exact amount of tokens out, paying at
+	// most a maximum amount of tokens in.
+	SwapExactAmountOut(ctx context.Context, in *MsgSwapExactAmountOut, opts ...grpc.CallOption) (*MsgSwapExactAmountOutResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error) {
+	out := new(MsgCreatePoolResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.dex.v1.Msg/CreatePool", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgExitPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) JoinPool(ctx context.Context, in *MsgJoinPool, opts ...grpc.CallOption) (*MsgJoinPoolResponse, error) {
+	out := new(MsgJoinPoolResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.dex.v1.Msg/JoinPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgExitPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokensOut) > 0 {
-		for iNdEx := len(m.TokensOut) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TokensOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+func (c *msgClient) ExitPool(ctx context.Context, in *MsgExitPool, opts ...grpc.CallOption) (*MsgExitPoolResponse, error) {
+	out := new(MsgExitPoolResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.dex.v1.Msg/ExitPool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSwapAssets) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) JoinPoolExactShares(ctx context.Context, in *MsgJoinPoolExactShares, opts ...grpc.CallOption) (*MsgJoinPoolExactSharesResponse, error) {
+	out := new(MsgJoinPoolExactSharesResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.dex.v1.Msg/JoinPoolExactShares", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSwapAssets) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ExitPoolExactTokens(ctx context.Context, in *MsgExitPoolExactTokens, opts ...grpc.CallOption) (*MsgExitPoolExactTokensResponse, error) {
+	out := new(MsgExitPoolExactTokensResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.dex.v1.Msg/ExitPoolExactTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSwapAssets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenOutDenom) > 0 {
-		i -= len(m.TokenOutDenom)
-		copy(dAtA[i:], m.TokenOutDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOutDenom)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
+func (c *msgClient) SwapAssets(ctx context.Context, in *MsgSwapAssets, opts ...grpc.CallOption) (*MsgSwapAssetsResponse, error) {
+	out := new(MsgSwapAssetsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.dex.v1.Msg/SwapAssets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) SwapExactAmountIn(ctx context.Context, in *MsgSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSwapExactAmountInResponse, error) {
+	out := new(MsgSwapExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.dex.v1.Msg/SwapExactAmountIn", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSwapAssetsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SwapExactAmountOut(ctx context.Context, in *MsgSwapExactAmountOut, opts ...grpc.CallOption) (*MsgSwapExactAmountOutResponse, error) {
+	out := new(MsgSwapExactAmountOutResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.dex.v1.Msg/SwapExactAmountOut", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSwapAssetsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Used to create a pool.
+	CreatePool(context.Context, *MsgCreatePool) (*MsgCreatePoolResponse, error)
+	// Join a pool as a liquidity provider.
+	JoinPool(context.Context, *MsgJoinPool) (*MsgJoinPoolResponse, error)
+	// Exit a pool position by returning LP shares
+	ExitPool(context.Context, *MsgExitPool) (*MsgExitPoolResponse, error)
+	// Join a pool for an exact amount of LP shares, depositing the tokens in the
+	// pool's proportions.
+	JoinPoolExactShares(context.Context, *MsgJoinPoolExactShares) (*MsgJoinPoolExactSharesResponse, error)
+	// Withdraw an exact amount of tokens from a pool, burning as few LP shares
+	// as needed.
+	ExitPoolExactTokens(context.Context, *MsgExitPoolExactTokens) (*MsgExitPoolExactTokensResponse, error)
+	// Swap assets in a pool
+	SwapAssets(context.Context, *MsgSwapAssets) (*MsgSwapAssetsResponse, error)
+	// Swap an exact amount of tokens along a route of pools, for at least a
+	// minimum amount of tokens out.
+	SwapExactAmountIn(context.Context, *MsgSwapExactAmountIn) (*MsgSwapExactAmountInResponse, error)
+	// Swap along a route of pools for an exact amount of tokens out, paying at
+	// most a maximum amount of tokens in.
+	SwapExactAmountOut(context.Context, *MsgSwapExactAmountOut) (*MsgSwapExactAmountOutResponse, error)
 }
 
-func (m *MsgSwapAssetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (*UnimplementedMsgServer) CreatePool(ctx context.Context, req *MsgCreatePool) (*MsgCreatePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePool not implemented")
+}
+func (*UnimplementedMsgServer) JoinPool(ctx context.Context, req *MsgJoinPool) (*MsgJoinPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinPool not implemented")
+}
+func (*UnimplementedMsgServer) ExitPool(ctx context.Context, req *MsgExitPool) (*MsgExitPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExitPool not implemented")
+}
+func (*UnimplementedMsgServer) JoinPoolExactShares(ctx context.Context, req *MsgJoinPoolExactShares) (*MsgJoinPoolExactSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinPoolExactShares not implemented")
+}
+func (*UnimplementedMsgServer) ExitPoolExactTokens(ctx context.Context, req *MsgExitPoolExactTokens) (*MsgExitPoolExactTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExitPoolExactTokens not implemented")
+}
+func (*UnimplementedMsgServer) SwapAssets(ctx context.Context, req *MsgSwapAssets) (*MsgSwapAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapAssets not implemented")
+}
+func (*UnimplementedMsgServer) SwapExactAmountIn(ctx context.Context, req *MsgSwapExactAmountIn) (*MsgSwapExactAmountInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapExactAmountIn not implemented")
+}
+func (*UnimplementedMsgServer) SwapExactAmountOut(ctx context.Context, req *MsgSwapExactAmountOut) (*MsgSwapExactAmountOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapExactAmountOut not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePool)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.dex.v1.Msg/CreatePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePool(ctx, req.(*MsgCreatePool))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (m *MsgCreatePool) Size() (n int) {
-	if m == nil {
-		return 0
+
+func _Msg_JoinPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgJoinPool)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).JoinPool(ctx, in)
 	}
-	if m.PoolParams != nil {
-		l = m.PoolParams.Size()
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.dex.v1.Msg/JoinPool",
 	}
-	if len(m.PoolAssets) > 0 {
-		for _, e := range m.PoolAssets {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).JoinPool(ctx, req.(*MsgJoinPool))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreatePoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ExitPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExitPool)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	if interceptor == nil {
+		return srv.(MsgServer).ExitPool(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.dex.v1.Msg/ExitPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExitPool(ctx, req.(*MsgExitPool))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgJoinPool) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_JoinPoolExactShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgJoinPoolExactShares)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).JoinPoolExactShares(ctx, in)
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.dex.v1.Msg/JoinPoolExactShares",
 	}
-	if len(m.TokensIn) > 0 {
-		for _, e := range m.TokensIn {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).JoinPoolExactShares(ctx, req.(*MsgJoinPoolExactShares))
 	}
-	if m.UseAllCoins {
-		n += 2
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExitPoolExactTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExitPoolExactTokens)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ExitPoolExactTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.dex.v1.Msg/ExitPoolExactTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExitPoolExactTokens(ctx, req.(*MsgExitPoolExactTokens))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgJoinPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SwapAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapAssets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SwapAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.dex.v1.Msg/SwapAssets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapAssets(ctx, req.(*MsgSwapAssets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapExactAmountIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SwapExactAmountIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.dex.v1.Msg/SwapExactAmountIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapExactAmountIn(ctx, req.(*MsgSwapExactAmountIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SwapExactAmountOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapExactAmountOut)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SwapExactAmountOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.dex.v1.Msg/SwapExactAmountOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapExactAmountOut(ctx, req.(*MsgSwapExactAmountOut))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "nibiru.dex.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePool",
+			Handler:    _Msg_CreatePool_Handler,
+		},
+		{
+			MethodName: "JoinPool",
+			Handler:    _Msg_JoinPool_Handler,
+		},
+		{
+			MethodName: "ExitPool",
+			Handler:    _Msg_ExitPool_Handler,
+		},
+		{
+			MethodName: "JoinPoolExactShares",
+			Handler:    _Msg_JoinPoolExactShares_Handler,
+		},
+		{
+			MethodName: "ExitPoolExactTokens",
+			Handler:    _Msg_ExitPoolExactTokens_Handler,
+		},
+		{
+			MethodName: "SwapAssets",
+			Handler:    _Msg_SwapAssets_Handler,
+		},
+		{
+			MethodName: "SwapExactAmountIn",
+			Handler:    _Msg_SwapExactAmountIn_Handler,
+		},
+		{
+			MethodName: "SwapExactAmountOut",
+			Handler:    _Msg_SwapExactAmountOut_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dex/v1/tx.proto",
+}
+
+func (m *MsgCreatePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pool != nil {
-		l = m.Pool.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.PoolAssets) > 0 {
+		for iNdEx := len(m.PoolAssets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = m.NumPoolSharesOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.RemainingCoins) > 0 {
-		for _, e := range m.RemainingCoins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.PoolParams != nil {
+		{
+			size, err := m.PoolParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJoinPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UseAllCoins {
+		i--
+		if m.UseAllCoins {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TokensIn) > 0 {
+		for iNdEx := len(m.TokensIn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJoinPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemainingCoins) > 0 {
+		for iNdEx := len(m.RemainingCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemainingCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.NumPoolSharesOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Pool != nil {
+		{
+			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExitPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExitPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExitPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.PoolShares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExitPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExitPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExitPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokensOut) > 0 {
+		for iNdEx := len(m.TokensOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapAssets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapAssets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapAssets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapAssetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapAssetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapAssetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapExactAmountIn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapExactAmountIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapExactAmountIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutMinAmount.Size()
+		i -= size
+		if _, err := m.TokenOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapExactAmountOut) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapExactAmountOut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapExactAmountOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenInMaxAmount.Size()
+		i -= size
+		if _, err := m.TokenInMaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJoinPoolExactShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinPoolExactShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinPoolExactShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenInMaxs) > 0 {
+		for iNdEx := len(m.TokenInMaxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenInMaxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.PoolSharesOut.Size()
+		i -= size
+		if _, err := m.PoolSharesOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJoinPoolExactSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinPoolExactSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinPoolExactSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokensIn) > 0 {
+		for iNdEx := len(m.TokensIn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExitPoolExactTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExitPoolExactTokens) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExitPoolExactTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PoolSharesInMax.Size()
+		i -= size
+		if _, err := m.PoolSharesInMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.TokensOut) > 0 {
+		for iNdEx := len(m.TokensOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExitPoolExactTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExitPoolExactTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExitPoolExactTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PoolSharesIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreatePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolParams != nil {
+		l = m.PoolParams.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PoolAssets) > 0 {
+		for _, e := range m.PoolAssets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreatePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *MsgJoinPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if len(m.TokensIn) > 0 {
+		for _, e := range m.TokensIn {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.UseAllCoins {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgJoinPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.NumPoolSharesOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.RemainingCoins) > 0 {
+		for _, e := range m.RemainingCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExitPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.PoolShares.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExitPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokensOut) > 0 {
+		for _, e := range m.TokensOut {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSwapAssets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSwapAssetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *SwapRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSwapExactAmountIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSwapExactAmountInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSwapExactAmountOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenInMaxAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSwapExactAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgJoinPoolExactShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.PoolSharesOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.TokenInMaxs) > 0 {
+		for _, e := range m.TokenInMaxs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgJoinPoolExactSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokensIn) > 0 {
+		for _, e := range m.TokensIn {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExitPoolExactTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if len(m.TokensOut) > 0 {
+		for _, e := range m.TokensOut {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.PoolSharesInMax.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgExitPoolExactTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PoolSharesIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PoolParams == nil {
+				m.PoolParams = &PoolParams{}
+			}
+			if err := m.PoolParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolAssets = append(m.PoolAssets, PoolAsset{})
+			if err := m.PoolAssets[len(m.PoolAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgJoinPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgJoinPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensIn = append(m.TokensIn, types.Coin{})
+			if err := m.TokensIn[len(m.TokensIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseAllCoins", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseAllCoins = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgJoinPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgJoinPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgJoinPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pool == nil {
+				m.Pool = &Pool{}
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPoolSharesOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NumPoolSharesOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingCoins = append(m.RemainingCoins, types.Coin{})
+			if err := m.RemainingCoins[len(m.RemainingCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExitPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExitPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExitPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExitPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExitPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensOut = append(m.TokensOut, types.Coin{})
+			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapAssets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapAssets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapAssets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapAssetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapAssetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapAssetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgExitPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.PoolShares.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return nil
 }
-
-func (m *MsgExitPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.TokensOut) > 0 {
-		for _, e := range m.TokensOut {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *SwapRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *MsgSwapAssets) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
-	}
-	l = m.TokenIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.TokenOutDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
 
-func (m *MsgSwapAssetsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.TokenOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1306,15 +3737,15 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactAmountIn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1342,11 +3773,11 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1373,16 +3804,14 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PoolParams == nil {
-				m.PoolParams = &PoolParams{}
-			}
-			if err := m.PoolParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Routes = append(m.Routes, SwapRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolAssets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1402,15 +3831,48 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolAssets = append(m.PoolAssets, PoolAsset{})
-			if err := m.PoolAssets[len(m.PoolAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1435,7 +3897,7 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1458,17 +3920,17 @@ func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1478,11 +3940,25 @@ func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1504,7 +3980,7 @@ func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1527,10 +4003,10 @@ func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactAmountOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1566,10 +4042,10 @@ func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1579,14 +4055,61 @@ func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokensIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1613,16 +4136,15 @@ func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokensIn = append(m.TokensIn, types.Coin{})
-			if err := m.TokensIn[len(m.TokensIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseAllCoins", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1632,12 +4154,26 @@ func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UseAllCoins = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1659,7 +4195,7 @@ func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1682,84 +4218,15 @@ func (m *MsgJoinPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pool == nil {
-				m.Pool = &Pool{}
-			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumPoolSharesOut", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.NumPoolSharesOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1786,8 +4253,7 @@ func (m *MsgJoinPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemainingCoins = append(m.RemainingCoins, types.Coin{})
-			if err := m.RemainingCoins[len(m.RemainingCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1812,7 +4278,7 @@ func (m *MsgJoinPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinPoolExactShares) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1835,10 +4301,10 @@ func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExitPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinPoolExactShares: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExitPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinPoolExactShares: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1894,7 +4360,41 @@ func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolSharesOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolSharesOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1921,7 +4421,8 @@ func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PoolShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TokenInMaxs = append(m.TokenInMaxs, types.Coin{})
+			if err := m.TokenInMaxs[len(m.TokenInMaxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1946,7 +4447,7 @@ func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExitPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinPoolExactSharesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1969,15 +4470,15 @@ func (m *MsgExitPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExitPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinPoolExactSharesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExitPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinPoolExactSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2004,8 +4505,8 @@ func (m *MsgExitPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokensOut = append(m.TokensOut, types.Coin{})
-			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TokensIn = append(m.TokensIn, types.Coin{})
+			if err := m.TokensIn[len(m.TokensIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2030,7 +4531,7 @@ func (m *MsgExitPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapAssets) Unmarshal(dAtA []byte) error {
+func (m *MsgExitPoolExactTokens) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2053,10 +4554,10 @@ func (m *MsgSwapAssets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapAssets: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExitPoolExactTokens: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapAssets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExitPoolExactTokens: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2112,7 +4613,7 @@ func (m *MsgSwapAssets) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2139,13 +4640,14 @@ func (m *MsgSwapAssets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TokensOut = append(m.TokensOut, types.Coin{})
+			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolSharesInMax", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2173,7 +4675,9 @@ func (m *MsgSwapAssets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.PoolSharesInMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2196,7 +4700,7 @@ func (m *MsgSwapAssets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapAssetsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExitPoolExactTokensResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2219,15 +4723,15 @@ func (m *MsgSwapAssetsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapAssetsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExitPoolExactTokensResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapAssetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExitPoolExactTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolSharesIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2254,7 +4758,7 @@ func (m *MsgSwapAssetsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PoolSharesIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/lockup/types"
+)
+
+// RegisterInvariants registers the lockup module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "locked-coins", LockedCoinsInvariant(k))
+}
+
+// LockedCoinsInvariant checks that the module account holds exactly the coins of all the locks,
+// including the ones which are unlocking but have not been withdrawn yet.
+func LockedCoinsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		moduleBalance := k.bk.GetAllBalances(ctx, k.ak.GetModuleAddress(types.ModuleName))
+		lockedCoins := sdk.NewCoins(k.LocksState(ctx).IterateTotalLockedCoins()...)
+		broken := !moduleBalance.IsAllGTE(lockedCoins) || !lockedCoins.IsAllGTE(moduleBalance)
+
+		return sdk.FormatInvariant(types.ModuleName, "locked-coins", fmt.Sprintf(
+			"\tmodule balance %s, total locked coins %s\n", moduleBalance, lockedCoins)), broken
+	}
+}
@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simapp2 "github.com/NibiruChain/nibiru/simapp"
+	"github.com/NibiruChain/nibiru/x/lockup/keeper"
+	"github.com/NibiruChain/nibiru/x/lockup/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+)
+
+func TestLockedCoinsInvariant(t *testing.T) {
+	app, _ := simapp2.NewTestNibiruAppAndContext(true)
+	ctx := app.NewContext(false, tmproto.Header{Time: time.Now()})
+	invariant := keeper.LockedCoinsInvariant(app.LockupKeeper)
+
+	addr := testutil.AccAddress()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 1000))
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, addr, coins.Add(coins...)))
+
+	t.Log("lock and start unlocking coins")
+	lock, err := app.LockupKeeper.LockTokens(ctx, addr, coins, time.Second)
+	require.NoError(t, err)
+	_, err = app.LockupKeeper.LockTokens(ctx, addr, coins, time.Hour)
+	require.NoError(t, err)
+	_, err = app.LockupKeeper.InitiateUnlocking(ctx, lock.LockId)
+	require.NoError(t, err)
+	msg, broken := invariant(ctx)
+	require.False(t, broken, msg)
+
+	t.Log("unlock coins")
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Second))
+	_, err = app.LockupKeeper.UnlockTokens(ctx, lock.LockId)
+	require.NoError(t, err)
+	msg, broken = invariant(ctx)
+	require.False(t, broken, msg)
+
+	t.Log("coins in the module account which aren't locked break the invariant")
+	require.NoError(t, simapp.FundModuleAccount(app.BankKeeper, ctx, types.ModuleName, coins))
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+}
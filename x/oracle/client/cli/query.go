@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -25,6 +26,8 @@ func GetQueryCmd() *cobra.Command {
 
 	oracleQueryCmd.AddCommand(
 		GetCmdQueryExchangeRates(),
+		GetCmdQueryExchangeRateTwap(),
+		GetCmdQueryExchangeRateHistory(),
 		GetCmdQueryActives(),
 		GetCmdQueryParams(),
 		GetCmdQueryFeederDelegation(),
@@ -86,6 +89,89 @@ $ nibid query oracle exchange-rates nibi:usd
 	return cmd
 }
 
+// GetCmdQueryExchangeRateTwap implements the query exchange rate twap command.
+func GetCmdQueryExchangeRateTwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exchange-rate-twap [pair] [lookback-window]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Query the time-weighted average exchange rate of a pair",
+		Long: strings.TrimSpace(`
+Query the time-weighted average exchange rate of a pair over the twap lookback window param
+
+$ nibid query oracle exchange-rate-twap nibi:usd
+
+Or, over a custom lookback window
+
+$ nibid query oracle exchange-rate-twap nibi:usd 1h
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryExchangeRateTwapRequest{Pair: args[0]}
+			if len(args) == 2 {
+				req.LookbackWindow, err = time.ParseDuration(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.ExchangeRateTwap(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryExchangeRateHistory implements the query exchange rate history command.
+func GetCmdQueryExchangeRateHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exchange-rate-history [pair]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the exchange rate snapshots of a pair",
+		Long: strings.TrimSpace(`
+Query the exchange rate snapshots of a pair, oldest first
+
+$ nibid query oracle exchange-rate-history nibi:usd --limit 10 --reverse
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ExchangeRateHistory(
+				context.Background(),
+				&types.QueryExchangeRateHistoryRequest{Pair: args[0], Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "exchange-rate-history")
+	return cmd
+}
+
 // GetCmdQueryActives implements the query actives command.
 func GetCmdQueryActives() *cobra.Command {
 	cmd := &cobra.Command{
@@ -2,6 +2,7 @@ package oracle
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/NibiruChain/nibiru/collections"
 
@@ -77,6 +78,11 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 	if len(data.PairRewards) != 0 {
 		keeper.PairRewardsID.Set(ctx, data.PairRewards[len(data.PairRewards)-1].Id)
 	}
+
+	for _, snapshot := range data.PriceSnapshots {
+		keeper.PriceSnapshots.Insert(ctx, collections.Join(snapshot.Pair, time.UnixMilli(snapshot.TimestampMs)), snapshot)
+	}
+
 	keeper.SetParams(ctx, data.Params)
 
 	// check if the module account exists
@@ -115,7 +121,7 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	var pairs []string
 	pairs = append(pairs, keeper.Pairs.Iterate(ctx, collections.Range[string]{}).Keys()...)
 
-	return types.NewGenesisState(params,
+	genesis := types.NewGenesisState(params,
 		exchangeRates,
 		feederDelegations,
 		missCounters,
@@ -124,4 +130,6 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		pairs,
 		keeper.PairRewards.Iterate(ctx, collections.Range[uint64]{}).Values(),
 	)
+	genesis.PriceSnapshots = keeper.PriceSnapshots.Iterate(ctx, collections.PairRange[string, time.Time]{}).Values()
+	return genesis
 }
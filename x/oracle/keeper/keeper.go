@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/NibiruChain/nibiru/collections"
 
@@ -16,6 +17,9 @@ import (
 	"github.com/NibiruChain/nibiru/x/oracle/types"
 )
 
+// priceSnapshotsNamespace is shared with the querier, which paginates over the raw price snapshots.
+const priceSnapshotsNamespace collections.Namespace = 10
+
 // Keeper of the oracle store
 type Keeper struct {
 	cdc        codec.BinaryCodec
@@ -39,6 +43,8 @@ type Keeper struct {
 	Pairs         collections.KeySet[string]
 	PairRewards   collections.IndexedMap[uint64, types.PairReward, PairRewardsIndexes]
 	PairRewardsID collections.Sequence
+	// PriceSnapshots maps the exchange rate tallied at the end of a vote period to the pair and the block time.
+	PriceSnapshots collections.Map[collections.Pair[string, time.Time], types.PriceSnapshot]
 }
 
 type PairRewardsIndexes struct {
@@ -89,6 +95,10 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey,
 				}),
 			}),
 		PairRewardsID: collections.NewSequence(storeKey, 9),
+		PriceSnapshots: collections.NewMap(storeKey, priceSnapshotsNamespace,
+			collections.PairKeyEncoder[string, time.Time](collections.StringKeyEncoder, collections.TimeKeyEncoder),
+			collections.ProtoValueEncoder[types.PriceSnapshot](cdc),
+		),
 	}
 }
 
@@ -36,13 +36,15 @@ func TestParams(t *testing.T) {
 
 	// Should really test validateParams, but skipping because obvious
 	newParams := types.Params{
-		VotePeriod:        votePeriod,
-		VoteThreshold:     voteThreshold,
-		RewardBand:        oracleRewardBand,
-		Whitelist:         whitelist,
-		SlashFraction:     slashFraction,
-		SlashWindow:       slashWindow,
-		MinValidPerWindow: minValidPerWindow,
+		VotePeriod:              votePeriod,
+		VoteThreshold:           voteThreshold,
+		RewardBand:              oracleRewardBand,
+		Whitelist:               whitelist,
+		SlashFraction:           slashFraction,
+		SlashWindow:             slashWindow,
+		MinValidPerWindow:       minValidPerWindow,
+		TwapLookbackWindow:      types.DefaultTwapLookbackWindow,
+		SnapshotRetentionWindow: types.DefaultSnapshotRetentionWindow,
 	}
 	input.OracleKeeper.SetParams(input.Ctx, newParams)
 
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+// Migrator handles the in-place store migrations of the x/oracle module.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the TWAP lookback window and the snapshot retention window, which the
+// first version does not have, to their defaults and keeps the other params.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := types.DefaultParams()
+	m.keeper.paramSpace.GetParamSetIfExists(ctx, &params)
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
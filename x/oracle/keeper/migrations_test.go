@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/simapp"
+	"github.com/NibiruChain/nibiru/x/oracle/keeper"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	nibiruApp, ctx := simapp.NewTestNibiruAppAndContext(true)
+	k := nibiruApp.OracleKeeper
+
+	t.Log("set params of the first version, which doesn't have the twap windows")
+	params := types.DefaultParams()
+	params.VotePeriod = 20
+	params.VoteThreshold = sdk.NewDecWithPrec(60, 2)
+	k.SetParams(ctx, params)
+	paramStore := prefix.NewStore(ctx.KVStore(nibiruApp.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	paramStore.Delete(types.KeyTwapLookbackWindow)
+	paramStore.Delete(types.KeySnapshotRetentionWindow)
+	require.Panics(t, func() { k.GetParams(ctx) })
+
+	t.Log("migrate the store")
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+
+	t.Log("assert the twap windows are set to their defaults and the other params are kept")
+	migrated := k.GetParams(ctx)
+	assert.EqualValues(t, 20, migrated.VotePeriod)
+	assert.Equal(t, sdk.NewDecWithPrec(60, 2), migrated.VoteThreshold)
+	assert.Equal(t, types.DefaultTwapLookbackWindow, migrated.TwapLookbackWindow)
+	assert.Equal(t, types.DefaultSnapshotRetentionWindow, migrated.SnapshotRetentionWindow)
+}
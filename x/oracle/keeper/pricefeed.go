@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+	pftypes "github.com/NibiruChain/nibiru/x/pricefeed/types"
+)
+
+// PricefeedAdapter serves the exchange rates voted by the validators through the
+// price API that x/vpool and x/perp consume from x/pricefeed, which lets the oracle
+// be used as their index price source.
+type PricefeedAdapter struct {
+	k Keeper
+}
+
+// NewPricefeedAdapter returns a PricefeedAdapter reading from the given oracle keeper.
+func NewPricefeedAdapter(k Keeper) PricefeedAdapter {
+	return PricefeedAdapter{k: k}
+}
+
+// GetCurrentPrice returns the exchange rate of the last vote period for token0:token1.
+// If only the inverse pair is voted on, the inverse of its exchange rate is returned.
+func (a PricefeedAdapter) GetCurrentPrice(ctx sdk.Context, token0 string, token1 string,
+) (pftypes.CurrentPrice, error) {
+	pair, inverse := a.votedPair(ctx, token0, token1)
+
+	exchangeRate, err := a.k.ExchangeRates.Get(ctx, pair.String())
+	if err != nil {
+		return pftypes.CurrentPrice{}, types.ErrNoValidPrice.Wrap(pair.String())
+	}
+
+	if inverse {
+		exchangeRate = sdk.OneDec().Quo(exchangeRate)
+	}
+	return pftypes.NewCurrentPrice(token0, token1, exchangeRate), nil
+}
+
+// GetCurrentTWAP returns the time-weighted average exchange rate of token0:token1.
+// If only the inverse pair is voted on, the inverse of its TWAP is returned.
+func (a PricefeedAdapter) GetCurrentTWAP(ctx sdk.Context, token0 string, token1 string,
+) (sdk.Dec, error) {
+	pair, inverse := a.votedPair(ctx, token0, token1)
+
+	twap, err := a.k.GetExchangeRateTWAP(ctx, pair.String())
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	if inverse {
+		return sdk.OneDec().Quo(twap), nil
+	}
+	return twap, nil
+}
+
+// GetSnapshotRetentionWindow returns how long the price snapshots of the exchange rates
+// are kept before being pruned.
+func (a PricefeedAdapter) GetSnapshotRetentionWindow(ctx sdk.Context) time.Duration {
+	return a.k.GetParams(ctx).SnapshotRetentionWindow
+}
+
+// GatherRawPrices is a no-op, the exchange rates are tallied from the validator votes
+// at the end of every vote period.
+func (a PricefeedAdapter) GatherRawPrices(ctx sdk.Context, token0 string, token1 string) error {
+	pair := common.AssetPair{Token0: token0, Token1: token1}
+	if !a.IsActivePair(ctx, pair.String()) {
+		return types.ErrUnknownPair.Wrap(pair.String())
+	}
+	return nil
+}
+
+// IsActivePair returns true if validators vote on the exchange rate of the pair.
+func (a PricefeedAdapter) IsActivePair(ctx sdk.Context, pairID string) bool {
+	return a.k.Pairs.Has(ctx, pairID)
+}
+
+// votedPair returns the pair validators vote on for token0 and token1, and whether
+// it is the inverse of token0:token1.
+func (a PricefeedAdapter) votedPair(ctx sdk.Context, token0 string, token1 string,
+) (pair common.AssetPair, inverse bool) {
+	pair = common.AssetPair{Token0: token0, Token1: token1}
+	if !a.IsActivePair(ctx, pair.String()) && a.IsActivePair(ctx, pair.Inverse().String()) {
+		return pair.Inverse(), true
+	}
+	return pair, false
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/oracle/keeper"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+	perptypes "github.com/NibiruChain/nibiru/x/perp/types"
+	pftypes "github.com/NibiruChain/nibiru/x/pricefeed/types"
+	vpooltypes "github.com/NibiruChain/nibiru/x/vpool/types"
+)
+
+var (
+	_ perptypes.PricefeedKeeper  = keeper.PricefeedAdapter{}
+	_ vpooltypes.PricefeedKeeper = keeper.PricefeedAdapter{}
+)
+
+func TestPricefeedAdapter(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	ctx := input.Ctx.WithBlockTime(time.UnixMilli(10_000))
+	adapter := keeper.NewPricefeedAdapter(input.OracleKeeper)
+
+	pair := common.Pair_BTC_NUSD
+	input.OracleKeeper.Pairs.Insert(ctx, pair.String())
+	input.OracleKeeper.ExchangeRates.Insert(ctx, pair.String(), sdk.NewDec(20))
+	input.OracleKeeper.PriceSnapshots.Insert(ctx, collections.Join(pair.String(), time.UnixMilli(5_000)), types.PriceSnapshot{
+		Pair:        pair.String(),
+		Price:       sdk.NewDec(10),
+		TimestampMs: 5_000,
+	})
+
+	t.Run("active pairs", func(t *testing.T) {
+		assert.True(t, adapter.IsActivePair(ctx, pair.String()))
+		assert.False(t, adapter.IsActivePair(ctx, "uatom:unusd"))
+
+		require.NoError(t, adapter.GatherRawPrices(ctx, pair.Token0, pair.Token1))
+		require.ErrorIs(t, adapter.GatherRawPrices(ctx, "uatom", "unusd"), types.ErrUnknownPair)
+	})
+
+	t.Run("current price", func(t *testing.T) {
+		price, err := adapter.GetCurrentPrice(ctx, pair.Token0, pair.Token1)
+		require.NoError(t, err)
+		assert.Equal(t, pftypes.NewCurrentPrice(pair.Token0, pair.Token1, sdk.NewDec(20)), price)
+
+		inversePrice, err := adapter.GetCurrentPrice(ctx, pair.Token1, pair.Token0)
+		require.NoError(t, err)
+		assert.Equal(t, pftypes.NewCurrentPrice(pair.Token1, pair.Token0, sdk.MustNewDecFromStr("0.05")), inversePrice)
+
+		_, err = adapter.GetCurrentPrice(ctx, "uatom", "unusd")
+		require.ErrorIs(t, err, types.ErrNoValidPrice)
+	})
+
+	t.Run("current twap", func(t *testing.T) {
+		twap, err := adapter.GetCurrentTWAP(ctx, pair.Token0, pair.Token1)
+		require.NoError(t, err)
+		assert.Equal(t, sdk.NewDec(10), twap)
+
+		inverseTwap, err := adapter.GetCurrentTWAP(ctx, pair.Token1, pair.Token0)
+		require.NoError(t, err)
+		assert.Equal(t, sdk.MustNewDecFromStr("0.1"), inverseTwap)
+
+		_, err = adapter.GetCurrentTWAP(ctx, "uatom", "unusd")
+		require.ErrorIs(t, err, types.ErrNoValidTWAP)
+	})
+}
@@ -8,7 +8,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/NibiruChain/nibiru/x/oracle/types"
 )
@@ -54,6 +56,66 @@ func (q querier) ExchangeRate(c context.Context, req *types.QueryExchangeRateReq
 	return &types.QueryExchangeRateResponse{ExchangeRate: exchangeRate}, nil
 }
 
+// ExchangeRateTwap queries the time-weighted average exchange rate of a pair
+func (q querier) ExchangeRateTwap(c context.Context, req *types.QueryExchangeRateTwapRequest) (*types.QueryExchangeRateTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Pair) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty pair")
+	}
+
+	if req.LookbackWindow < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "negative lookback window: %s", req.LookbackWindow)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	lookbackWindow := req.LookbackWindow
+	if lookbackWindow == 0 {
+		lookbackWindow = q.GetParams(ctx).TwapLookbackWindow
+	}
+
+	twap, err := q.Keeper.TWAP(ctx, req.Pair, lookbackWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryExchangeRateTwapResponse{ExchangeRateTwap: twap}, nil
+}
+
+// ExchangeRateHistory queries the exchange rate snapshots of a pair, oldest first
+func (q querier) ExchangeRateHistory(c context.Context, req *types.QueryExchangeRateHistoryRequest) (*types.QueryExchangeRateHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Pair) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty pair")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(
+		ctx.KVStore(q.storeKey),
+		append(priceSnapshotsNamespace.Prefix(), collections.StringKeyEncoder.Encode(req.Pair)...),
+	)
+
+	var snapshots []types.PriceSnapshot
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var snapshot types.PriceSnapshot
+		if err := q.cdc.Unmarshal(value, &snapshot); err != nil {
+			return err
+		}
+		snapshots = append(snapshots, snapshot)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryExchangeRateHistoryResponse{PriceSnapshots: snapshots, Pagination: pageRes}, nil
+}
+
 // ExchangeRates queries exchange rates of all pairs
 func (q querier) ExchangeRates(c context.Context, _ *types.QueryExchangeRatesRequest) (*types.QueryExchangeRatesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -3,6 +3,7 @@ package keeper
 import (
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/NibiruChain/nibiru/collections"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/NibiruChain/nibiru/x/oracle/types"
 )
@@ -46,6 +48,81 @@ func TestQueryExchangeRate(t *testing.T) {
 	require.Equal(t, rate, res.ExchangeRate)
 }
 
+func TestQueryExchangeRateTwap(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := input.Ctx.WithBlockTime(time.UnixMilli(20 * time.Minute.Milliseconds()))
+	querier := NewQuerier(input.OracleKeeper)
+
+	pair := common.Pair_ETH_NUSD.String()
+	for _, snapshot := range []types.PriceSnapshot{
+		{Pair: pair, Price: sdk.NewDec(1000), TimestampMs: 0},
+		{Pair: pair, Price: sdk.NewDec(1700), TimestampMs: 10 * time.Minute.Milliseconds()},
+	} {
+		input.OracleKeeper.PriceSnapshots.Insert(ctx, collections.Join(pair, time.UnixMilli(snapshot.TimestampMs)), snapshot)
+	}
+
+	// empty request
+	_, err := querier.ExchangeRateTwap(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+
+	// negative lookback window
+	_, err = querier.ExchangeRateTwap(sdk.WrapSDKContext(ctx), &types.QueryExchangeRateTwapRequest{
+		Pair:           pair,
+		LookbackWindow: -time.Minute,
+	})
+	require.Error(t, err)
+
+	// defaults to the twap lookback window of 15 minutes: (1000 * 5 + 1700 * 10) / 15
+	res, err := querier.ExchangeRateTwap(sdk.WrapSDKContext(ctx), &types.QueryExchangeRateTwapRequest{Pair: pair})
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("1466.666666666666666666"), res.ExchangeRateTwap)
+
+	// (1000 * 10 + 1700 * 10) / 20
+	res, err = querier.ExchangeRateTwap(sdk.WrapSDKContext(ctx), &types.QueryExchangeRateTwapRequest{
+		Pair:           pair,
+		LookbackWindow: 20 * time.Minute,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(1350), res.ExchangeRateTwap)
+}
+
+func TestQueryExchangeRateHistory(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	pair := common.Pair_ETH_NUSD.String()
+	var snapshots []types.PriceSnapshot
+	for i := int64(0); i < 3; i++ {
+		snapshot := types.PriceSnapshot{Pair: pair, Price: sdk.NewDec(1700 + i), TimestampMs: i * 1_000, VotePower: 10}
+		input.OracleKeeper.PriceSnapshots.Insert(input.Ctx, collections.Join(pair, time.UnixMilli(snapshot.TimestampMs)), snapshot)
+		snapshots = append(snapshots, snapshot)
+	}
+	input.OracleKeeper.PriceSnapshots.Insert(input.Ctx, collections.Join(common.Pair_BTC_NUSD.String(), time.UnixMilli(0)), types.PriceSnapshot{
+		Pair: common.Pair_BTC_NUSD.String(), Price: sdk.NewDec(20_000),
+	})
+
+	// empty request
+	_, err := querier.ExchangeRateHistory(ctx, nil)
+	require.Error(t, err)
+
+	res, err := querier.ExchangeRateHistory(ctx, &types.QueryExchangeRateHistoryRequest{
+		Pair:       pair,
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, snapshots[:2], res.PriceSnapshots)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	res, err = querier.ExchangeRateHistory(ctx, &types.QueryExchangeRateHistoryRequest{
+		Pair:       pair,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Equal(t, snapshots[2:], res.PriceSnapshots)
+	require.Nil(t, res.Pagination.NextKey)
+}
+
 func TestQueryMissCounter(t *testing.T) {
 	input := CreateTestInput(t)
 	ctx := sdk.WrapSDKContext(input.Ctx)
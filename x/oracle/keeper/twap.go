@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+// addPriceSnapshot records the exchange rate of the pair at the current block time,
+// along with the total power of the validators whose votes were tallied into it.
+func (k Keeper) addPriceSnapshot(ctx sdk.Context, pair string, exchangeRate sdk.Dec, votePower int64) {
+	k.PriceSnapshots.Insert(ctx, collections.Join(pair, ctx.BlockTime()), types.PriceSnapshot{
+		Pair:        pair,
+		Price:       exchangeRate,
+		TimestampMs: ctx.BlockTime().UnixMilli(),
+		VotePower:   votePower,
+	})
+}
+
+// PruneSnapshots removes the price snapshots of the vote targets that are older than
+// the snapshot retention window, at most types.MaxSnapshotsPrunedPerVotePeriod at a time.
+// The most recent snapshot before the retention window is kept, as its price is still
+// active at the start of the window.
+func (k Keeper) PruneSnapshots(ctx sdk.Context) (pruned int) {
+	cutoff := ctx.BlockTime().Add(-1 * k.GetParams(ctx).SnapshotRetentionWindow)
+
+	for _, pair := range k.Pairs.Iterate(ctx, collections.Range[string]{}).Keys() {
+		iter := k.PriceSnapshots.Iterate(
+			ctx,
+			collections.PairRange[string, time.Time]{}.
+				Prefix(pair).
+				EndExclusive(cutoff).
+				Descending(),
+		)
+		if iter.Valid() {
+			iter.Next()
+		}
+		var keys []collections.Pair[string, time.Time]
+		for ; iter.Valid() && pruned+len(keys) < types.MaxSnapshotsPrunedPerVotePeriod; iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+
+		for _, key := range keys {
+			if err := k.PriceSnapshots.Delete(ctx, key); err != nil {
+				panic(err)
+			}
+		}
+		pruned += len(keys)
+		if pruned >= types.MaxSnapshotsPrunedPerVotePeriod {
+			return pruned
+		}
+	}
+	return pruned
+}
+
+// GetExchangeRateTWAP returns the time-weighted average exchange rate of the pair over the
+// twap lookback window.
+func (k Keeper) GetExchangeRateTWAP(ctx sdk.Context, pair string) (sdk.Dec, error) {
+	return k.TWAP(ctx, pair, k.GetParams(ctx).TwapLookbackWindow)
+}
+
+/*
+TWAP returns the time-weighted average exchange rate of the pair over
+[ ctx.BlockTime() - lookbackWindow, ctx.BlockTime() ).
+Note the open-ended right bracket.
+
+The price of the most recent snapshot before the window is active from the start of the window.
+If there's only one snapshot, then this function returns the exchange rate of that single snapshot.
+Snapshots older than the snapshot retention window are pruned, so longer lookback windows are
+truncated to it.
+*/
+func (k Keeper) TWAP(ctx sdk.Context, pair string, lookbackWindow time.Duration) (sdk.Dec, error) {
+	start := ctx.BlockTime().Add(-1 * lookbackWindow)
+	snapshots := k.PriceSnapshots.Iterate(
+		ctx,
+		collections.PairRange[string, time.Time]{}.
+			Prefix(pair).
+			StartInclusive(start).
+			EndExclusive(ctx.BlockTime()),
+	).Values()
+
+	iter := k.PriceSnapshots.Iterate(
+		ctx,
+		collections.PairRange[string, time.Time]{}.
+			Prefix(pair).
+			EndExclusive(start).
+			Descending(),
+	)
+	if iter.Valid() {
+		activeAtStart := iter.Value()
+		activeAtStart.TimestampMs = start.UnixMilli()
+		snapshots = append([]types.PriceSnapshot{activeAtStart}, snapshots...)
+	}
+	iter.Close()
+
+	if len(snapshots) == 0 {
+		return sdk.Dec{}, types.ErrNoValidTWAP.Wrap(pair)
+	}
+
+	return calcTwap(ctx, snapshots), nil
+}
+
+// calcTwap weights the price of every snapshot by the amount of time it was active for,
+// the last one being active until the current block time. snapshots must not be empty.
+func calcTwap(ctx sdk.Context, snapshots []types.PriceSnapshot) sdk.Dec {
+	cumulativeTime := ctx.BlockTime().UnixMilli() - snapshots[0].TimestampMs
+	if cumulativeTime == 0 {
+		// the snapshots were taken within the current millisecond
+		return snapshots[len(snapshots)-1].Price
+	}
+	cumulativePrice := sdk.ZeroDec()
+
+	for i, s := range snapshots {
+		nextTimestampMs := ctx.BlockTime().UnixMilli()
+		if i < len(snapshots)-1 {
+			nextTimestampMs = snapshots[i+1].TimestampMs
+		}
+		cumulativePrice = cumulativePrice.Add(s.Price.MulInt64(nextTimestampMs - s.TimestampMs))
+	}
+	return cumulativePrice.QuoInt64(cumulativeTime)
+}
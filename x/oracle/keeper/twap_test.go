@@ -0,0 +1,194 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/oracle"
+	"github.com/NibiruChain/nibiru/x/oracle/keeper"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+func TestGetExchangeRateTWAP(t *testing.T) {
+	pair := common.Pair_BTC_NUSD.String()
+
+	tests := []struct {
+		name         string
+		snapshots    []types.PriceSnapshot
+		blockTimeMs  int64
+		expectedTWAP sdk.Dec
+		expectedErr  error
+	}{
+		{
+			name:        "no snapshots",
+			blockTimeMs: 10_000,
+			expectedErr: types.ErrNoValidTWAP,
+		},
+		{
+			name: "single snapshot",
+			snapshots: []types.PriceSnapshot{
+				{Pair: pair, Price: sdk.NewDec(10), TimestampMs: 5_000},
+			},
+			blockTimeMs:  10_000,
+			expectedTWAP: sdk.NewDec(10),
+		},
+		{
+			name: "weighted by the time every price was active",
+			snapshots: []types.PriceSnapshot{
+				{Pair: pair, Price: sdk.NewDec(10), TimestampMs: 1_000},
+				{Pair: pair, Price: sdk.NewDec(20), TimestampMs: 4_000},
+			},
+			blockTimeMs: 5_000,
+			// (10 * 3_000 + 20 * 1_000) / 4_000
+			expectedTWAP: sdk.MustNewDecFromStr("12.5"),
+		},
+		{
+			name: "price active at the start of the lookback window",
+			snapshots: []types.PriceSnapshot{
+				{Pair: pair, Price: sdk.NewDec(1_000), TimestampMs: 0},
+				{Pair: pair, Price: sdk.NewDec(10), TimestampMs: time.Hour.Milliseconds()},
+				{Pair: pair, Price: sdk.NewDec(40), TimestampMs: (time.Hour + 10*time.Minute).Milliseconds()},
+			},
+			blockTimeMs: (time.Hour + 15*time.Minute).Milliseconds(),
+			// (10 * 10min + 40 * 5min) / 15min, the first snapshot is not active within the window
+			expectedTWAP: sdk.NewDec(20),
+		},
+		{
+			name: "ignores snapshots of the current block",
+			snapshots: []types.PriceSnapshot{
+				{Pair: pair, Price: sdk.NewDec(10), TimestampMs: 1_000},
+				{Pair: pair, Price: sdk.NewDec(20), TimestampMs: 2_000},
+			},
+			blockTimeMs:  2_000,
+			expectedTWAP: sdk.NewDec(10),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			input := keeper.CreateTestInput(t)
+			ctx := input.Ctx.WithBlockTime(time.UnixMilli(tc.blockTimeMs))
+			for _, snapshot := range tc.snapshots {
+				input.OracleKeeper.PriceSnapshots.Insert(ctx, collections.Join(snapshot.Pair, time.UnixMilli(snapshot.TimestampMs)), snapshot)
+			}
+
+			twap, err := input.OracleKeeper.GetExchangeRateTWAP(ctx, pair)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedTWAP, twap)
+		})
+	}
+}
+
+func TestUpdateExchangeRatesRecordsPriceSnapshots(t *testing.T) {
+	input, h := setup(t)
+	pair := common.Pair_BTC_NUSD.String()
+	start := time.UnixMilli(1_000_000_000)
+	retentionWindow := input.OracleKeeper.GetParams(input.Ctx).SnapshotRetentionWindow
+
+	t.Log("add snapshots that are older than the snapshot retention window")
+	var expiredSnapshots []types.PriceSnapshot
+	for _, expiredTime := range []time.Time{start.Add(-retentionWindow - time.Minute), start.Add(-retentionWindow - time.Second)} {
+		snapshot := types.PriceSnapshot{Pair: pair, Price: sdk.NewDec(1), TimestampMs: expiredTime.UnixMilli()}
+		input.OracleKeeper.PriceSnapshots.Insert(input.Ctx, collections.Join(pair, expiredTime), snapshot)
+		expiredSnapshots = append(expiredSnapshots, snapshot)
+	}
+
+	t.Log("tally the votes of the validators")
+	exchangeRates := types.ExchangeRateTuples{{Pair: pair, ExchangeRate: sdk.NewDec(20_000)}}
+	votePower := int64(0)
+	for i, valAddr := range keeper.ValAddrs[:3] {
+		makeAggregatePrevoteAndVote(t, input, h, 0, exchangeRates, i)
+		votePower += input.StakingKeeper.Validator(input.Ctx, valAddr).GetConsensusPower(input.StakingKeeper.PowerReduction(input.Ctx))
+	}
+	oracle.EndBlocker(input.Ctx.WithBlockHeight(1).WithBlockTime(start), input.OracleKeeper)
+
+	t.Log("assert the exchange rate was recorded and the expired snapshots pruned, except the most recent one")
+	assert.Equal(t,
+		[]types.PriceSnapshot{
+			expiredSnapshots[1],
+			{Pair: pair, Price: sdk.NewDec(20_000), TimestampMs: start.UnixMilli(), VotePower: votePower},
+		},
+		input.OracleKeeper.PriceSnapshots.Iterate(input.Ctx, collections.PairRange[string, time.Time]{}.Prefix(pair)).Values(),
+	)
+
+	twap, err := input.OracleKeeper.TWAP(input.Ctx.WithBlockTime(start.Add(time.Minute)), pair, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(20_000), twap)
+}
+
+func TestPruneSnapshots(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	pair := common.Pair_BTC_NUSD.String()
+	start := time.UnixMilli(1_000_000_000)
+	ctx := input.Ctx.WithBlockTime(start)
+	retentionWindow := input.OracleKeeper.GetParams(ctx).SnapshotRetentionWindow
+
+	t.Log("add more expired snapshots than can be pruned in a vote period")
+	for i := 0; i < types.MaxSnapshotsPrunedPerVotePeriod+2; i++ {
+		snapshotTime := start.Add(-retentionWindow - time.Duration(i+1)*time.Second)
+		input.OracleKeeper.PriceSnapshots.Insert(ctx, collections.Join(pair, snapshotTime), types.PriceSnapshot{
+			Pair:        pair,
+			Price:       sdk.NewDec(1),
+			TimestampMs: snapshotTime.UnixMilli(),
+		})
+	}
+	input.OracleKeeper.PriceSnapshots.Insert(ctx, collections.Join(pair, start.Add(-retentionWindow)), types.PriceSnapshot{
+		Pair:        pair,
+		Price:       sdk.NewDec(2),
+		TimestampMs: start.Add(-retentionWindow).UnixMilli(),
+	})
+
+	assert.Equal(t, types.MaxSnapshotsPrunedPerVotePeriod, input.OracleKeeper.PruneSnapshots(ctx))
+	assert.Equal(t, 1, input.OracleKeeper.PruneSnapshots(ctx))
+	assert.Equal(t, 0, input.OracleKeeper.PruneSnapshots(ctx))
+
+	t.Log("assert the most recent expired snapshot and the snapshot within the retention window are kept")
+	assert.Equal(t,
+		[]types.PriceSnapshot{
+			{Pair: pair, Price: sdk.NewDec(1), TimestampMs: start.Add(-retentionWindow - time.Second).UnixMilli()},
+			{Pair: pair, Price: sdk.NewDec(2), TimestampMs: start.Add(-retentionWindow).UnixMilli()},
+		},
+		input.OracleKeeper.PriceSnapshots.Iterate(ctx, collections.PairRange[string, time.Time]{}.Prefix(pair)).Values(),
+	)
+}
+
+func TestTWAPLookbackWindow(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	pair := common.Pair_BTC_NUSD.String()
+	ctx := input.Ctx.WithBlockTime(time.UnixMilli(10_000))
+
+	for _, snapshot := range []types.PriceSnapshot{
+		{Pair: pair, Price: sdk.NewDec(10), TimestampMs: 2_000},
+		{Pair: pair, Price: sdk.NewDec(20), TimestampMs: 6_000},
+	} {
+		input.OracleKeeper.PriceSnapshots.Insert(ctx, collections.Join(pair, time.UnixMilli(snapshot.TimestampMs)), snapshot)
+	}
+
+	// (10 * 4_000 + 20 * 4_000) / 8_000
+	twap, err := input.OracleKeeper.TWAP(ctx, pair, 8*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(15), twap)
+
+	twap, err = input.OracleKeeper.TWAP(ctx, pair, 4*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(20), twap)
+
+	// the price of the second snapshot is active at the start of the window
+	twap, err = input.OracleKeeper.TWAP(ctx, pair, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(20), twap)
+
+	_, err = input.OracleKeeper.TWAP(ctx.WithBlockTime(time.UnixMilli(2_000)), pair, time.Second)
+	require.ErrorIs(t, err, types.ErrNoValidTWAP)
+}
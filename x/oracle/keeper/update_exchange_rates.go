@@ -60,6 +60,7 @@ func (k Keeper) UpdateExchangeRates(ctx sdk.Context) {
 
 		// Set the exchange rate, emit ABCI event
 		k.ExchangeRates.Insert(ctx, pair, exchangeRate)
+		k.addPriceSnapshot(ctx, pair, exchangeRate, ballot.Power())
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(types.EventTypeExchangeRateUpdate,
 				sdk.NewAttribute(types.AttributeKeyPair, pair),
@@ -88,6 +89,9 @@ func (k Keeper) UpdateExchangeRates(ctx sdk.Context) {
 	// Clear the ballot
 	k.ClearBallots(ctx, params.VotePeriod)
 
+	// Prune the price snapshots out of the retention window
+	k.PruneSnapshots(ctx)
+
 	// Update vote targets
 	k.ApplyWhitelist(ctx, params.Whitelist, pairsMap)
 }
@@ -109,9 +109,11 @@ func RandomizedGenState(simState *module.SimulationState) {
 				common.Pair_BTC_NUSD.String(),
 				common.Pair_NIBI_NUSD.String(),
 			},
-			SlashFraction:     slashFraction,
-			SlashWindow:       slashWindow,
-			MinValidPerWindow: minValidPerWindow,
+			SlashFraction:           slashFraction,
+			SlashWindow:             slashWindow,
+			MinValidPerWindow:       minValidPerWindow,
+			TwapLookbackWindow:      types.DefaultTwapLookbackWindow,
+			SnapshotRetentionWindow: types.DefaultSnapshotRetentionWindow,
 		},
 		[]types.ExchangeRateTuple{},
 		[]types.FeederDelegation{},
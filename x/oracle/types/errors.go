@@ -22,4 +22,6 @@ var (
 	ErrNoAggregatePrevote    = sdkerrors.Register(ModuleName, 11, "no aggregate prevote")
 	ErrNoAggregateVote       = sdkerrors.Register(ModuleName, 12, "no aggregate vote")
 	ErrUnknownPair           = sdkerrors.Register(ModuleName, 13, "unknown pair")
+	ErrNoValidPrice          = sdkerrors.Register(ModuleName, 14, "no valid exchange rate for pair")
+	ErrNoValidTWAP           = sdkerrors.Register(ModuleName, 15, "no exchange rate snapshots within the twap lookback window")
 )
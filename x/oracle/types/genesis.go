@@ -29,7 +29,7 @@ func NewGenesisState(
 
 // DefaultGenesisState - default GenesisState
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(
+	genesis := NewGenesisState(
 		DefaultParams(),
 		[]ExchangeRateTuple{},
 		[]FeederDelegation{},
@@ -38,6 +38,8 @@ func DefaultGenesisState() *GenesisState {
 		[]AggregateExchangeRateVote{},
 		[]string{},
 		[]PairReward{})
+	genesis.PriceSnapshots = []PriceSnapshot{}
+	return genesis
 }
 
 // ValidateGenesis validates the oracle genesis state
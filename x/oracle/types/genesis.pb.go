@@ -34,6 +34,7 @@ type GenesisState struct {
 	AggregateExchangeRateVotes    []AggregateExchangeRateVote    `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
 	Pairs                         []string                       `protobuf:"bytes,7,rep,name=pairs,proto3" json:"pairs,omitempty"`
 	PairRewards                   []PairReward                   `protobuf:"bytes,8,rep,name=pair_rewards,json=pairRewards,proto3" json:"pair_rewards"`
+	PriceSnapshots                []PriceSnapshot                `protobuf:"bytes,9,rep,name=price_snapshots,json=priceSnapshots,proto3" json:"price_snapshots"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -125,6 +126,13 @@ func (m *GenesisState) GetPairRewards() []PairReward {
 	return nil
 }
 
+func (m *GenesisState) GetPriceSnapshots() []PriceSnapshot {
+	if m != nil {
+		return m.PriceSnapshots
+	}
+	return nil
+}
+
 // FeederDelegation is the address for where oracle feeder authority are
 // delegated to. By default this struct is only used at genesis to feed in
 // default feeder addresses.
@@ -243,41 +251,43 @@ func init() {
 func init() { proto.RegisterFile("oracle/v1beta1/genesis.proto", fileDescriptor_6d8ee91da7d45482) }
 
 var fileDescriptor_6d8ee91da7d45482 = []byte{
-	// 530 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0xe3, 0x36, 0x09, 0x74, 0x93, 0x54, 0xed, 0xaa, 0x48, 0x56, 0x20, 0x6e, 0x1a, 0x09,
-	0x11, 0x09, 0x64, 0xd3, 0xf6, 0xc8, 0xa9, 0x29, 0x14, 0x09, 0xa9, 0xa8, 0x32, 0x88, 0x03, 0x02,
-	0x59, 0x1b, 0x7b, 0xe2, 0xac, 0x48, 0xbc, 0xd6, 0x8e, 0x13, 0xda, 0x2b, 0x4f, 0xc0, 0x73, 0xf0,
-	0x22, 0xf4, 0xd8, 0x23, 0x27, 0x40, 0xc9, 0x8b, 0xa0, 0xec, 0x2e, 0x49, 0x08, 0x31, 0x12, 0xb7,
-	0xf5, 0xcc, 0xf7, 0xcf, 0x3f, 0xb3, 0x9e, 0x25, 0xf7, 0x84, 0x64, 0xe1, 0x00, 0xbc, 0xf1, 0x61,
-	0x17, 0x32, 0x76, 0xe8, 0xc5, 0x90, 0x00, 0x72, 0x74, 0x53, 0x29, 0x32, 0x41, 0xef, 0x24, 0xbc,
-	0xcb, 0xe5, 0xc8, 0xd5, 0x90, 0x6b, 0xa0, 0xfa, 0x5e, 0x2c, 0x62, 0xa1, 0x08, 0x6f, 0x76, 0xd2,
-	0x70, 0xfd, 0xee, 0x4a, 0x29, 0x23, 0xd2, 0x49, 0x27, 0x14, 0x38, 0x14, 0xe8, 0x75, 0x19, 0x2e,
-	0x88, 0x50, 0xf0, 0x44, 0xe7, 0x5b, 0x5f, 0x4b, 0xa4, 0xfa, 0x5c, 0x7b, 0xbf, 0xca, 0x58, 0x06,
-	0xf4, 0x09, 0x29, 0xa7, 0x4c, 0xb2, 0x21, 0xda, 0x56, 0xd3, 0x6a, 0x57, 0x8e, 0x1a, 0xee, 0xda,
-	0x5e, 0xdc, 0x0b, 0x05, 0x75, 0x8a, 0xd7, 0xdf, 0xf7, 0x0b, 0xbe, 0x91, 0xd0, 0x77, 0x84, 0xf6,
-	0x00, 0x22, 0x90, 0x41, 0x04, 0x03, 0x88, 0x59, 0xc6, 0x45, 0x82, 0xf6, 0x46, 0x73, 0xb3, 0x5d,
-	0x39, 0x7a, 0x90, 0x53, 0xe8, 0x4c, 0x09, 0x9e, 0xce, 0x79, 0x53, 0x72, 0xb7, 0xb7, 0x12, 0x47,
-	0xfa, 0x81, 0x6c, 0xc3, 0x65, 0xd8, 0x67, 0x49, 0x0c, 0x81, 0x64, 0x19, 0xa0, 0xbd, 0xa9, 0x2a,
-	0xb7, 0x73, 0x2a, 0x3f, 0x33, 0xb0, 0xcf, 0x32, 0x78, 0x3d, 0x4a, 0x07, 0xd0, 0xa9, 0xcf, 0x4a,
-	0x7f, 0xf9, 0xb1, 0x4f, 0xff, 0x4a, 0xa1, 0x5f, 0x83, 0xa5, 0x18, 0xd2, 0x73, 0x52, 0x1b, 0x72,
-	0xc4, 0x20, 0x14, 0xa3, 0x24, 0x03, 0x89, 0x76, 0x51, 0x79, 0xb5, 0x72, 0xbc, 0xce, 0x39, 0xe2,
-	0xa9, 0x46, 0xcd, 0x00, 0xd5, 0xe1, 0x22, 0x84, 0xf4, 0x93, 0x45, 0x9a, 0x2c, 0x8e, 0xe5, 0x6c,
-	0x18, 0x08, 0xfe, 0x18, 0x23, 0x48, 0x25, 0x8c, 0xc5, 0x6c, 0x9c, 0x92, 0xb2, 0x38, 0xce, 0xb1,
-	0x38, 0xf9, 0x2d, 0x5f, 0x6e, 0xfe, 0x42, 0x6b, 0x8d, 0x67, 0x83, 0xfd, 0x83, 0x41, 0x7a, 0x45,
-	0x1a, 0x79, 0x3d, 0xe8, 0x06, 0xca, 0xaa, 0x81, 0xc7, 0xff, 0xd3, 0xc0, 0x9b, 0x85, 0x7b, 0x9d,
-	0xe5, 0x01, 0x48, 0xf7, 0x48, 0x29, 0x65, 0x5c, 0xa2, 0x7d, 0xab, 0xb9, 0xd9, 0xde, 0xf2, 0xf5,
-	0x07, 0x7d, 0x41, 0xaa, 0xb3, 0x43, 0x20, 0xe1, 0x23, 0x93, 0x11, 0xda, 0xb7, 0x95, 0xff, 0x41,
-	0xee, 0xca, 0x71, 0xe9, 0x2b, 0xd2, 0x18, 0x56, 0xd2, 0x79, 0x04, 0x5b, 0x3d, 0xb2, 0xb3, 0xba,
-	0x4a, 0xf4, 0x3e, 0xd9, 0x36, 0xfb, 0xc8, 0xa2, 0x48, 0x02, 0xea, 0xa5, 0xde, 0xf2, 0x6b, 0x3a,
-	0x7a, 0xa2, 0x83, 0xf4, 0x21, 0xd9, 0x1d, 0xb3, 0x01, 0x8f, 0x58, 0x26, 0x16, 0xe4, 0x86, 0x22,
-	0x77, 0xe6, 0x09, 0x03, 0xb7, 0xde, 0x93, 0xca, 0xd2, 0xcf, 0x5e, 0xaf, 0xb5, 0xd6, 0x6b, 0xe9,
-	0x01, 0xa9, 0x2e, 0x2f, 0x95, 0xf2, 0x28, 0xfa, 0x95, 0xa5, 0x4d, 0xe9, 0x9c, 0x5d, 0x4f, 0x1c,
-	0xeb, 0x66, 0xe2, 0x58, 0x3f, 0x27, 0x8e, 0xf5, 0x79, 0xea, 0x14, 0x6e, 0xa6, 0x4e, 0xe1, 0xdb,
-	0xd4, 0x29, 0xbc, 0x7d, 0x14, 0xf3, 0xac, 0x3f, 0xea, 0xba, 0xa1, 0x18, 0x7a, 0x2f, 0xd5, 0x05,
-	0x9d, 0xf6, 0x19, 0x4f, 0x3c, 0x7d, 0x59, 0xde, 0xa5, 0x79, 0xf8, 0x5e, 0x76, 0x95, 0x02, 0x76,
-	0xcb, 0xea, 0x7d, 0x1f, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x15, 0xda, 0x57, 0x11, 0x69, 0x04,
-	0x00, 0x00,
+	// 561 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0xe3, 0xfe, 0xc9, 0xef, 0xd7, 0x4d, 0x1a, 0xda, 0x55, 0x91, 0xac, 0x40, 0xdc, 0x34,
+	0x02, 0x11, 0x09, 0x64, 0xd3, 0xf6, 0xc8, 0xa9, 0x29, 0x14, 0x09, 0xa9, 0xa8, 0x72, 0x10, 0x07,
+	0x04, 0xb2, 0x36, 0xf6, 0xc6, 0x59, 0x91, 0x78, 0xad, 0x9d, 0x4d, 0x68, 0xaf, 0x3c, 0x01, 0x0f,
+	0xc0, 0x13, 0xf0, 0x24, 0x3d, 0xf6, 0xc8, 0x09, 0x50, 0xf2, 0x22, 0xc8, 0xbb, 0x4b, 0x62, 0x42,
+	0x8c, 0xc4, 0x6d, 0x3d, 0xf3, 0x99, 0xef, 0x77, 0x66, 0x35, 0x5e, 0x74, 0x97, 0x0b, 0x12, 0x0e,
+	0xa9, 0x37, 0x39, 0xec, 0x51, 0x49, 0x0e, 0xbd, 0x98, 0x26, 0x14, 0x18, 0xb8, 0xa9, 0xe0, 0x92,
+	0xe3, 0xdb, 0x09, 0xeb, 0x31, 0x31, 0x76, 0x35, 0xe4, 0x1a, 0xa8, 0xbe, 0x17, 0xf3, 0x98, 0x2b,
+	0xc2, 0xcb, 0x4e, 0x1a, 0xae, 0xdf, 0x59, 0x92, 0x32, 0x45, 0x3a, 0xe9, 0x84, 0x1c, 0x46, 0x1c,
+	0xbc, 0x1e, 0x81, 0x05, 0x11, 0x72, 0x96, 0xe8, 0x7c, 0xeb, 0x73, 0x19, 0x55, 0x9f, 0x6b, 0xef,
+	0xae, 0x24, 0x92, 0xe2, 0x27, 0xa8, 0x9c, 0x12, 0x41, 0x46, 0x60, 0x5b, 0x4d, 0xab, 0x5d, 0x39,
+	0x6a, 0xb8, 0x2b, 0x7b, 0x71, 0x2f, 0x14, 0xd4, 0xd9, 0xb8, 0xfe, 0xb6, 0x5f, 0xf2, 0x4d, 0x09,
+	0x7e, 0x8b, 0x70, 0x9f, 0xd2, 0x88, 0x8a, 0x20, 0xa2, 0x43, 0x1a, 0x13, 0xc9, 0x78, 0x02, 0xf6,
+	0x5a, 0x73, 0xbd, 0x5d, 0x39, 0x7a, 0x50, 0x20, 0x74, 0xa6, 0x0a, 0x9e, 0xce, 0x79, 0x23, 0xb9,
+	0xdb, 0x5f, 0x8a, 0x03, 0x7e, 0x8f, 0x6a, 0xf4, 0x32, 0x1c, 0x90, 0x24, 0xa6, 0x81, 0x20, 0x92,
+	0x82, 0xbd, 0xae, 0x94, 0xdb, 0x05, 0xca, 0xcf, 0x0c, 0xec, 0x13, 0x49, 0x5f, 0x8d, 0xd3, 0x21,
+	0xed, 0xd4, 0x33, 0xe9, 0x2f, 0xdf, 0xf7, 0xf1, 0x1f, 0x29, 0xf0, 0xb7, 0x69, 0x2e, 0x06, 0xf8,
+	0x1c, 0x6d, 0x8f, 0x18, 0x40, 0x10, 0xf2, 0x71, 0x22, 0xa9, 0x00, 0x7b, 0x43, 0x79, 0xb5, 0x0a,
+	0xbc, 0xce, 0x19, 0xc0, 0xa9, 0x46, 0xcd, 0x00, 0xd5, 0xd1, 0x22, 0x04, 0xf8, 0xa3, 0x85, 0x9a,
+	0x24, 0x8e, 0x45, 0x36, 0x0c, 0x0d, 0x7e, 0x1b, 0x23, 0x48, 0x05, 0x9d, 0xf0, 0x6c, 0x9c, 0x4d,
+	0x65, 0x71, 0x5c, 0x60, 0x71, 0xf2, 0xab, 0x3c, 0xdf, 0xfc, 0x85, 0xae, 0x35, 0x9e, 0x0d, 0xf2,
+	0x17, 0x06, 0xf0, 0x15, 0x6a, 0x14, 0xf5, 0xa0, 0x1b, 0x28, 0xab, 0x06, 0x1e, 0xff, 0x4b, 0x03,
+	0xaf, 0x17, 0xee, 0x75, 0x52, 0x04, 0x00, 0xde, 0x43, 0x9b, 0x29, 0x61, 0x02, 0xec, 0xff, 0x9a,
+	0xeb, 0xed, 0x2d, 0x5f, 0x7f, 0xe0, 0x17, 0xa8, 0x9a, 0x1d, 0x02, 0x41, 0x3f, 0x10, 0x11, 0x81,
+	0xfd, 0xbf, 0xf2, 0x3f, 0x28, 0x5c, 0x39, 0x26, 0x7c, 0x45, 0x1a, 0xc3, 0x4a, 0x3a, 0x8f, 0x00,
+	0xee, 0xa2, 0x5b, 0xa9, 0x60, 0x21, 0x0d, 0x20, 0x21, 0x29, 0x0c, 0xb8, 0x04, 0x7b, 0x4b, 0xc9,
+	0xdd, 0x2b, 0x92, 0xcb, 0xe8, 0xae, 0x81, 0x8d, 0x62, 0x2d, 0xcd, 0x07, 0xa1, 0xd5, 0x47, 0x3b,
+	0xcb, 0xfb, 0x89, 0xef, 0xa3, 0x9a, 0x59, 0x72, 0x12, 0x45, 0x82, 0x82, 0xfe, 0x53, 0xb6, 0xfc,
+	0x6d, 0x1d, 0x3d, 0xd1, 0x41, 0xfc, 0x10, 0xed, 0x4e, 0xc8, 0x90, 0x45, 0x44, 0xf2, 0x05, 0xb9,
+	0xa6, 0xc8, 0x9d, 0x79, 0xc2, 0xc0, 0xad, 0x77, 0xa8, 0x92, 0xdb, 0xa0, 0xd5, 0xb5, 0xd6, 0xea,
+	0x5a, 0x7c, 0x80, 0xaa, 0xf9, 0x4d, 0x55, 0x1e, 0x1b, 0x7e, 0x25, 0xb7, 0x7e, 0x9d, 0xb3, 0xeb,
+	0xa9, 0x63, 0xdd, 0x4c, 0x1d, 0xeb, 0xc7, 0xd4, 0xb1, 0x3e, 0xcd, 0x9c, 0xd2, 0xcd, 0xcc, 0x29,
+	0x7d, 0x9d, 0x39, 0xa5, 0x37, 0x8f, 0x62, 0x26, 0x07, 0xe3, 0x9e, 0x1b, 0xf2, 0x91, 0xf7, 0x52,
+	0x5d, 0xd3, 0xe9, 0x80, 0xb0, 0xc4, 0xd3, 0x57, 0xe6, 0x5d, 0x9a, 0xd7, 0xc4, 0x93, 0x57, 0x29,
+	0x85, 0x5e, 0x59, 0x3d, 0x1a, 0xc7, 0x3f, 0x07, 0x00, 0x17, 0xd5, 0x71, 0x35, 0xbe, 0x04, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -300,6 +310,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PriceSnapshots) > 0 {
+		for iNdEx := len(m.PriceSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.PairRewards) > 0 {
 		for iNdEx := len(m.PairRewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -539,6 +563,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PriceSnapshots) > 0 {
+		for _, e := range m.PriceSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -879,6 +909,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceSnapshots = append(m.PriceSnapshots, PriceSnapshot{})
+			if err := m.PriceSnapshots[len(m.PriceSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
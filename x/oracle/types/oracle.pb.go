@@ -9,15 +9,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -34,6 +38,11 @@ type Params struct {
 	SlashFraction     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
 	SlashWindow       uint64                                 `protobuf:"varint,6,opt,name=slash_window,json=slashWindow,proto3" json:"slash_window,omitempty" yaml:"slash_window"`
 	MinValidPerWindow github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=min_valid_per_window,json=minValidPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_valid_per_window" yaml:"min_valid_per_window"`
+	// amount of time to look back for the exchange rate TWAP calculation.
+	TwapLookbackWindow time.Duration `protobuf:"bytes,8,opt,name=twap_lookback_window,json=twapLookbackWindow,proto3,stdduration" json:"twap_lookback_window,omitempty" yaml:"twap_lookback_window"`
+	// amount of time the exchange rate snapshots are kept before being pruned.
+	// It must be at least the twap_lookback_window.
+	SnapshotRetentionWindow time.Duration `protobuf:"bytes,9,opt,name=snapshot_retention_window,json=snapshotRetentionWindow,proto3,stdduration" json:"snapshot_retention_window,omitempty" yaml:"snapshot_retention_window"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -89,6 +98,20 @@ func (m *Params) GetSlashWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetTwapLookbackWindow() time.Duration {
+	if m != nil {
+		return m.TwapLookbackWindow
+	}
+	return 0
+}
+
+func (m *Params) GetSnapshotRetentionWindow() time.Duration {
+	if m != nil {
+		return m.SnapshotRetentionWindow
+	}
+	return 0
+}
+
 // struct for aggregate prevoting on the ExchangeRateVote.
 // The purpose of aggregate prevote is to hide vote exchange rates with hash
 // which is formatted as hex string in SHA256("{salt}:({pair},{exchange_rate})|...|({pair},{exchange_rate}):{voter}")
@@ -281,65 +304,141 @@ func (m *PairReward) GetCoins() []types.Coin {
 	return nil
 }
 
+// PriceSnapshot is the exchange rate of a pair tallied at the end of a vote period.
+type PriceSnapshot struct {
+	Pair  string                                 `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// milliseconds since unix epoch
+	TimestampMs int64 `protobuf:"varint,3,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// total power of the validators whose votes were tallied into the price
+	VotePower int64 `protobuf:"varint,4,opt,name=vote_power,json=votePower,proto3" json:"vote_power,omitempty"`
+}
+
+func (m *PriceSnapshot) Reset()         { *m = PriceSnapshot{} }
+func (m *PriceSnapshot) String() string { return proto.CompactTextString(m) }
+func (*PriceSnapshot) ProtoMessage()    {}
+func (*PriceSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2784fd4b0e83b02f, []int{5}
+}
+func (m *PriceSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceSnapshot.Merge(m, src)
+}
+func (m *PriceSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceSnapshot proto.InternalMessageInfo
+
+func (m *PriceSnapshot) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *PriceSnapshot) GetTimestampMs() int64 {
+	if m != nil {
+		return m.TimestampMs
+	}
+	return 0
+}
+
+func (m *PriceSnapshot) GetVotePower() int64 {
+	if m != nil {
+		return m.VotePower
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "nibiru.oracle.v1beta1.Params")
 	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "nibiru.oracle.v1beta1.AggregateExchangeRatePrevote")
 	proto.RegisterType((*AggregateExchangeRateVote)(nil), "nibiru.oracle.v1beta1.AggregateExchangeRateVote")
 	proto.RegisterType((*ExchangeRateTuple)(nil), "nibiru.oracle.v1beta1.ExchangeRateTuple")
 	proto.RegisterType((*PairReward)(nil), "nibiru.oracle.v1beta1.PairReward")
+	proto.RegisterType((*PriceSnapshot)(nil), "nibiru.oracle.v1beta1.PriceSnapshot")
 }
 
 func init() { proto.RegisterFile("oracle/v1beta1/oracle.proto", fileDescriptor_2784fd4b0e83b02f) }
 
 var fileDescriptor_2784fd4b0e83b02f = []byte{
-	// 737 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x31, 0x4f, 0x23, 0x47,
-	0x14, 0xf6, 0x82, 0x4d, 0xe2, 0xb1, 0x21, 0x30, 0x31, 0x89, 0x81, 0xc8, 0xeb, 0x4c, 0x24, 0xe4,
-	0x22, 0xd9, 0x15, 0x44, 0x51, 0x14, 0x77, 0x59, 0x08, 0xa9, 0x12, 0x59, 0x23, 0x44, 0xa4, 0x34,
-	0xd6, 0xec, 0xee, 0xc4, 0x3b, 0x62, 0xbd, 0x63, 0xcd, 0xac, 0x31, 0x34, 0x29, 0x52, 0x45, 0xa9,
-	0xd2, 0x44, 0x4a, 0x49, 0x9d, 0x36, 0xba, 0xff, 0x40, 0x49, 0x79, 0xba, 0x62, 0xef, 0x04, 0xcd,
-	0x5d, 0xeb, 0x5f, 0x70, 0x9a, 0x99, 0x05, 0x2f, 0xd8, 0xc5, 0xa1, 0xab, 0xec, 0xef, 0xbd, 0x37,
-	0xef, 0x7d, 0xf3, 0xbd, 0x37, 0x6f, 0xc1, 0x0e, 0x17, 0x24, 0x88, 0xa9, 0x7b, 0xb6, 0xe7, 0xd3,
-	0x94, 0xec, 0xb9, 0x06, 0x3a, 0x23, 0xc1, 0x53, 0x0e, 0x37, 0x13, 0xe6, 0x33, 0x31, 0x76, 0x72,
-	0x63, 0x1e, 0xb3, 0xdd, 0x18, 0xf0, 0x01, 0xd7, 0x11, 0xae, 0xfa, 0x67, 0x82, 0xb7, 0x5b, 0x01,
-	0x97, 0x43, 0x2e, 0x5d, 0x9f, 0xc8, 0x59, 0xba, 0x80, 0xb3, 0xc4, 0xf8, 0xd1, 0x1f, 0x15, 0xb0,
-	0xd2, 0x23, 0x82, 0x0c, 0x25, 0xfc, 0x16, 0xd4, 0xce, 0x78, 0x4a, 0xfb, 0x23, 0x2a, 0x18, 0x0f,
-	0x9b, 0x56, 0xdb, 0xea, 0x94, 0xbd, 0x4f, 0xa6, 0x99, 0x0d, 0x2f, 0xc8, 0x30, 0xee, 0xa2, 0x82,
-	0x13, 0x61, 0xa0, 0x50, 0x4f, 0x03, 0x98, 0x80, 0x35, 0xed, 0x4b, 0x23, 0x41, 0x65, 0xc4, 0xe3,
-	0xb0, 0xb9, 0xd4, 0xb6, 0x3a, 0x55, 0xef, 0xc7, 0xab, 0xcc, 0x2e, 0xbd, 0xc8, 0xec, 0xdd, 0x01,
-	0x4b, 0xa3, 0xb1, 0xef, 0x04, 0x7c, 0xe8, 0xe6, 0x74, 0xcc, 0xcf, 0x57, 0x32, 0x3c, 0x75, 0xd3,
-	0x8b, 0x11, 0x95, 0xce, 0x21, 0x0d, 0xa6, 0x99, 0xbd, 0x59, 0xa8, 0x74, 0x9f, 0x0d, 0xe1, 0x55,
-	0x65, 0x38, 0xbe, 0xc3, 0x90, 0x82, 0x9a, 0xa0, 0x13, 0x22, 0xc2, 0xbe, 0x4f, 0x92, 0xb0, 0xb9,
-	0xac, 0x8b, 0x1d, 0x3e, 0xb9, 0x58, 0x7e, 0xad, 0x42, 0x2a, 0x84, 0x81, 0x41, 0x1e, 0x49, 0x42,
-	0xb8, 0x0f, 0xaa, 0x93, 0x88, 0xa5, 0x34, 0x66, 0x32, 0x6d, 0x96, 0xdb, 0xcb, 0x9d, 0xaa, 0xd7,
-	0x98, 0x66, 0xf6, 0xba, 0x39, 0x76, 0xef, 0x42, 0x78, 0x16, 0xa6, 0xa4, 0x90, 0x31, 0x91, 0x51,
-	0xff, 0x37, 0x41, 0x82, 0x94, 0xf1, 0xa4, 0x59, 0x79, 0x3f, 0x29, 0x1e, 0x66, 0x43, 0x78, 0x55,
-	0x1b, 0x8e, 0x72, 0x0c, 0xbb, 0xa0, 0x6e, 0x22, 0x26, 0x2c, 0x09, 0xf9, 0xa4, 0xb9, 0xa2, 0x9b,
-	0xf6, 0xe9, 0x34, 0xb3, 0x3f, 0x2e, 0x9e, 0x37, 0x5e, 0x84, 0x6b, 0x1a, 0xfe, 0xa2, 0x11, 0xfc,
-	0x1d, 0x34, 0x86, 0x2c, 0xe9, 0x9f, 0x91, 0x98, 0x85, 0xaa, 0xaf, 0x77, 0x39, 0x3e, 0xd0, 0x8c,
-	0x7f, 0x7a, 0x32, 0xe3, 0x1d, 0x53, 0x71, 0x51, 0x4e, 0x84, 0x37, 0x86, 0x2c, 0x39, 0x51, 0xd6,
-	0x1e, 0x15, 0xa6, 0x7e, 0xf7, 0xc3, 0x7f, 0x2f, 0xed, 0xd2, 0xeb, 0x4b, 0xdb, 0x42, 0xcf, 0x2c,
-	0xf0, 0xd9, 0xf7, 0x83, 0x81, 0xa0, 0x03, 0x92, 0xd2, 0x1f, 0xce, 0x83, 0x88, 0x24, 0x03, 0x8a,
-	0x49, 0x4a, 0x7b, 0x82, 0xaa, 0xd6, 0xc3, 0x2f, 0x40, 0x39, 0x22, 0x32, 0xd2, 0x33, 0x59, 0xf5,
-	0x3e, 0x9a, 0x66, 0x76, 0xcd, 0x14, 0x53, 0x56, 0x84, 0xb5, 0x13, 0xee, 0x82, 0x8a, 0x0a, 0x16,
-	0xf9, 0xf4, 0xad, 0x4f, 0x33, 0xbb, 0x3e, 0x9b, 0x27, 0x81, 0xb0, 0x71, 0x6b, 0xcd, 0xc6, 0xfe,
-	0x90, 0xa5, 0x7d, 0x3f, 0xe6, 0xc1, 0xa9, 0x9e, 0x9f, 0x87, 0x9a, 0x15, 0xbc, 0x4a, 0x33, 0x0d,
-	0x3d, 0x85, 0xba, 0xf5, 0x3f, 0x2f, 0xed, 0x52, 0xce, 0xbb, 0x84, 0xde, 0x58, 0x60, 0x6b, 0x21,
-	0xef, 0x13, 0x45, 0xfa, 0x1f, 0x0b, 0x34, 0x68, 0x6e, 0xec, 0x0b, 0xa2, 0x46, 0x7a, 0x3c, 0x8a,
-	0xa9, 0x6c, 0x5a, 0xed, 0xe5, 0x4e, 0x6d, 0xbf, 0xe3, 0x2c, 0x7c, 0xc7, 0x4e, 0x31, 0xcf, 0xb1,
-	0x3a, 0xe0, 0x7d, 0xa7, 0x5a, 0x31, 0x13, 0x78, 0x51, 0x4e, 0xf4, 0xdf, 0x4b, 0x1b, 0xce, 0x9d,
-	0x94, 0x18, 0xd2, 0x39, 0xdb, 0xbb, 0xea, 0xf4, 0xe8, 0xae, 0xff, 0x5b, 0x60, 0x63, 0xae, 0x80,
-	0x6a, 0xcc, 0x88, 0x30, 0x31, 0xdf, 0x18, 0x65, 0x45, 0x58, 0x3b, 0xe1, 0x29, 0x58, 0x7d, 0xc0,
-	0x39, 0x2f, 0x7c, 0xf4, 0xe4, 0x09, 0x6b, 0x2c, 0x10, 0x00, 0xe1, 0x7a, 0xf1, 0x8e, 0x8f, 0x58,
-	0xff, 0x65, 0x01, 0xd0, 0x23, 0x4c, 0x60, 0xfd, 0xac, 0x21, 0x2c, 0xd2, 0xcd, 0xd9, 0xad, 0x81,
-	0x25, 0x66, 0x36, 0x56, 0x19, 0x2f, 0xb1, 0x10, 0x7e, 0x0e, 0xea, 0x85, 0x4d, 0x27, 0xcd, 0x78,
-	0xe0, 0xda, 0x6c, 0xdf, 0x49, 0xf8, 0x0d, 0xa8, 0xa8, 0x15, 0x2a, 0xf5, 0x56, 0xa8, 0xed, 0x6f,
-	0x39, 0x86, 0xaf, 0xa3, 0x96, 0xec, 0x7d, 0x1f, 0x0f, 0x38, 0x4b, 0xbc, 0xb2, 0xba, 0x23, 0x36,
-	0xd1, 0xde, 0xd1, 0xd5, 0x4d, 0xcb, 0xba, 0xbe, 0x69, 0x59, 0xaf, 0x6e, 0x5a, 0xd6, 0xdf, 0xb7,
-	0xad, 0xd2, 0xf5, 0x6d, 0xab, 0xf4, 0xfc, 0xb6, 0x55, 0xfa, 0xf5, 0xcb, 0x82, 0x04, 0x3f, 0xeb,
-	0xa9, 0x38, 0x88, 0x08, 0x4b, 0x5c, 0x33, 0x21, 0xee, 0x79, 0xfe, 0x01, 0x30, 0x62, 0xf8, 0x2b,
-	0x7a, 0x75, 0x7f, 0xfd, 0x36, 0x00, 0x00, 0xff, 0xff, 0xc0, 0x80, 0xc2, 0x10, 0x26, 0x06, 0x00,
-	0x00,
+	// 921 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x36, 0x4e, 0x5a, 0x8f, 0x93, 0xd2, 0x0e, 0x2e, 0x75, 0x5a, 0xf0, 0x9a, 0xad, 0x54,
+	0xf9, 0x50, 0x76, 0xd5, 0x20, 0x84, 0xc8, 0x8d, 0x6d, 0x08, 0x42, 0xa2, 0xc8, 0x9a, 0x56, 0x45,
+	0xe2, 0x62, 0xcd, 0xee, 0x4e, 0xbd, 0x23, 0xef, 0xee, 0xac, 0x66, 0xc6, 0x71, 0x73, 0xe1, 0x8c,
+	0x38, 0x71, 0x01, 0xf5, 0x98, 0x73, 0x39, 0x22, 0xbe, 0x43, 0x8e, 0x3d, 0x22, 0x0e, 0x2e, 0x4a,
+	0x2e, 0xc0, 0xd1, 0x9f, 0x00, 0xcd, 0x1f, 0xc7, 0x9b, 0xda, 0x15, 0x44, 0x9c, 0xec, 0xf7, 0x7e,
+	0x6f, 0xde, 0xfb, 0xbd, 0x7f, 0x33, 0x0b, 0x6e, 0x33, 0x8e, 0xe3, 0x8c, 0x04, 0x07, 0xf7, 0x23,
+	0x22, 0xf1, 0xfd, 0xc0, 0x88, 0x7e, 0xc9, 0x99, 0x64, 0xf0, 0x46, 0x41, 0x23, 0xca, 0xc7, 0xbe,
+	0x55, 0x5a, 0x9b, 0x5b, 0xad, 0x21, 0x1b, 0x32, 0x6d, 0x11, 0xa8, 0x7f, 0xc6, 0xf8, 0x56, 0x27,
+	0x66, 0x22, 0x67, 0x22, 0x88, 0xb0, 0x58, 0xb8, 0x8b, 0x19, 0x2d, 0xe6, 0xf8, 0x90, 0xb1, 0x61,
+	0x46, 0x02, 0x2d, 0x45, 0xe3, 0xa7, 0x41, 0x32, 0xe6, 0x58, 0x52, 0x66, 0x71, 0xef, 0xf8, 0x32,
+	0xd8, 0xe8, 0x63, 0x8e, 0x73, 0x01, 0x3f, 0x06, 0xcd, 0x03, 0x26, 0xc9, 0xa0, 0x24, 0x9c, 0xb2,
+	0xa4, 0xed, 0x74, 0x9d, 0x5e, 0x3d, 0x7c, 0x67, 0x36, 0x75, 0xe1, 0x21, 0xce, 0xb3, 0x5d, 0xaf,
+	0x02, 0x7a, 0x08, 0x28, 0xa9, 0xaf, 0x05, 0x58, 0x80, 0xab, 0x1a, 0x93, 0x29, 0x27, 0x22, 0x65,
+	0x59, 0xd2, 0xbe, 0xd4, 0x75, 0x7a, 0x8d, 0xf0, 0xf3, 0xe3, 0xa9, 0x5b, 0xfb, 0x7d, 0xea, 0xde,
+	0x1d, 0x52, 0x99, 0x8e, 0x23, 0x3f, 0x66, 0x79, 0x60, 0xe9, 0x9a, 0x9f, 0x0f, 0x44, 0x32, 0x0a,
+	0xe4, 0x61, 0x49, 0x84, 0xbf, 0x47, 0xe2, 0xd9, 0xd4, 0xbd, 0x51, 0x89, 0x74, 0xe6, 0xcd, 0x43,
+	0x5b, 0x4a, 0xf1, 0x78, 0x2e, 0x43, 0x02, 0x9a, 0x9c, 0x4c, 0x30, 0x4f, 0x06, 0x11, 0x2e, 0x92,
+	0xf6, 0x9a, 0x0e, 0xb6, 0x77, 0xe1, 0x60, 0x36, 0xad, 0x8a, 0x2b, 0x0f, 0x01, 0x23, 0x85, 0xb8,
+	0x48, 0xe0, 0x0e, 0x68, 0x4c, 0x52, 0x2a, 0x49, 0x46, 0x85, 0x6c, 0xd7, 0xbb, 0x6b, 0xbd, 0x46,
+	0xd8, 0x9a, 0x4d, 0xdd, 0x6b, 0xe6, 0xd8, 0x19, 0xe4, 0xa1, 0x85, 0x99, 0x2a, 0x85, 0xc8, 0xb0,
+	0x48, 0x07, 0x4f, 0x39, 0x8e, 0x55, 0x99, 0xdb, 0xeb, 0xff, 0xaf, 0x14, 0xe7, 0xbd, 0x79, 0x68,
+	0x4b, 0x2b, 0xf6, 0xad, 0x0c, 0x77, 0xc1, 0xa6, 0xb1, 0x98, 0xd0, 0x22, 0x61, 0x93, 0xf6, 0x86,
+	0x6e, 0xda, 0xcd, 0xd9, 0xd4, 0x7d, 0xbb, 0x7a, 0xde, 0xa0, 0x1e, 0x6a, 0x6a, 0xf1, 0x6b, 0x2d,
+	0xc1, 0x6f, 0x41, 0x2b, 0xa7, 0xc5, 0xe0, 0x00, 0x67, 0x34, 0x51, 0x7d, 0x9d, 0xfb, 0xb8, 0xac,
+	0x19, 0x3f, 0xbc, 0x30, 0xe3, 0xdb, 0x26, 0xe2, 0x2a, 0x9f, 0x1e, 0xba, 0x9e, 0xd3, 0xe2, 0x89,
+	0xd2, 0xf6, 0x09, 0xb7, 0xf1, 0x7f, 0x72, 0x40, 0x4b, 0x4e, 0x70, 0x39, 0xc8, 0x18, 0x1b, 0x45,
+	0x38, 0x1e, 0xcd, 0x09, 0x5c, 0xe9, 0x3a, 0xbd, 0xe6, 0xce, 0xb6, 0x6f, 0x46, 0xd7, 0x9f, 0x8f,
+	0xae, 0xbf, 0x67, 0x47, 0x37, 0xfc, 0x42, 0x71, 0xfb, 0x7b, 0xea, 0x76, 0x56, 0x1d, 0xbf, 0xc7,
+	0x72, 0x2a, 0x49, 0x5e, 0xca, 0xc3, 0x05, 0xa7, 0x55, 0x76, 0xde, 0xf3, 0x57, 0xae, 0x83, 0xa0,
+	0x82, 0xbe, 0xb4, 0x88, 0x25, 0xf6, 0xc2, 0x01, 0xdb, 0xa2, 0xc0, 0xa5, 0x48, 0x99, 0x1c, 0x70,
+	0x22, 0x49, 0xa1, 0xa2, 0xce, 0xd9, 0x35, 0xfe, 0x8d, 0xdd, 0x23, 0xcb, 0xee, 0xce, 0x1b, 0x7d,
+	0x9c, 0xa3, 0xd8, 0xb5, 0x8d, 0x7a, 0x93, 0xb1, 0xe1, 0x79, 0x73, 0x8e, 0xa3, 0x39, 0x6c, 0xc8,
+	0xee, 0x5e, 0x79, 0x7e, 0xe4, 0xd6, 0xfe, 0x3c, 0x72, 0x1d, 0xef, 0x57, 0x07, 0xbc, 0xfb, 0xe9,
+	0x70, 0xc8, 0xc9, 0x10, 0x4b, 0xf2, 0xd9, 0xb3, 0x38, 0xc5, 0xc5, 0x90, 0x20, 0x2c, 0x49, 0x9f,
+	0x13, 0xb5, 0x40, 0xf0, 0x0e, 0xa8, 0xa7, 0x58, 0xa4, 0x7a, 0xb3, 0x1b, 0xe1, 0x5b, 0xb3, 0xa9,
+	0xdb, 0x34, 0xb1, 0x95, 0xd6, 0x43, 0x1a, 0x84, 0x77, 0xc1, 0xba, 0x32, 0xe6, 0x76, 0x87, 0xaf,
+	0xcd, 0xa6, 0xee, 0xe6, 0x62, 0x2b, 0xb9, 0x87, 0x0c, 0xac, 0x27, 0x6f, 0x1c, 0xe5, 0x54, 0x0e,
+	0xa2, 0x8c, 0xc5, 0xa3, 0xf6, 0xda, 0xd2, 0xe4, 0x55, 0x50, 0x35, 0x79, 0x5a, 0x0c, 0x95, 0xb4,
+	0xbb, 0xf9, 0xdd, 0x91, 0x5b, 0xb3, 0xbc, 0x6b, 0xde, 0x5f, 0x0e, 0xd8, 0x5e, 0xc9, 0xfb, 0x89,
+	0x22, 0xfd, 0xa3, 0x03, 0x5a, 0xc4, 0x2a, 0x07, 0x1c, 0xab, 0x8b, 0x61, 0x5c, 0x66, 0x44, 0xb4,
+	0x9d, 0xee, 0x5a, 0xaf, 0xb9, 0xd3, 0xf3, 0x57, 0xde, 0x96, 0x7e, 0xd5, 0xcf, 0x63, 0x75, 0x20,
+	0xfc, 0x44, 0xb5, 0x65, 0x31, 0x12, 0xab, 0x7c, 0x7a, 0x2f, 0x5e, 0xb9, 0x70, 0xe9, 0xa4, 0x40,
+	0x90, 0x2c, 0xe9, 0xfe, 0x6b, 0x9d, 0x5e, 0xcb, 0xf5, 0x17, 0x07, 0x5c, 0x5f, 0x0a, 0xa0, 0x1a,
+	0x53, 0x62, 0xca, 0x97, 0x1b, 0xa3, 0xb4, 0x1e, 0xd2, 0x20, 0x1c, 0x81, 0xad, 0x73, 0x9c, 0x6d,
+	0xe0, 0xfd, 0x0b, 0xef, 0x69, 0x6b, 0x45, 0x01, 0x3c, 0xb4, 0x59, 0xcd, 0xf1, 0x35, 0xd6, 0xdf,
+	0x3b, 0x00, 0xf4, 0x31, 0xe5, 0x48, 0x5f, 0x8e, 0x10, 0x56, 0xe9, 0x5a, 0x76, 0x57, 0xc1, 0x25,
+	0x6a, 0xee, 0xfd, 0x3a, 0xba, 0x44, 0x13, 0xf8, 0x3e, 0xd8, 0xac, 0xbc, 0x17, 0xc2, 0x8c, 0x07,
+	0x6a, 0x2e, 0x5e, 0x0d, 0x01, 0x3f, 0x02, 0xeb, 0xea, 0xa1, 0x12, 0xfa, 0x6e, 0x55, 0x1b, 0x65,
+	0xf8, 0xfa, 0xea, 0x29, 0x3b, 0xeb, 0xe3, 0x03, 0x46, 0x8b, 0xb0, 0xae, 0x72, 0x44, 0xc6, 0xda,
+	0xfb, 0xd9, 0x01, 0x5b, 0x7d, 0x4e, 0x63, 0xf2, 0xc8, 0x6e, 0xc4, 0x4a, 0x3e, 0x7b, 0x60, 0xbd,
+	0x54, 0x46, 0xb6, 0x4a, 0xfe, 0xc5, 0xaa, 0x84, 0xcc, 0x61, 0x95, 0x85, 0xa4, 0x39, 0x11, 0x12,
+	0xe7, 0xe5, 0x20, 0x37, 0x59, 0xac, 0xa1, 0xe6, 0x99, 0xee, 0xa1, 0x80, 0xef, 0x01, 0x60, 0x12,
+	0x65, 0x13, 0xc2, 0xdb, 0x75, 0x6d, 0xd0, 0xd0, 0x69, 0x2a, 0x45, 0xb8, 0x7f, 0x7c, 0xd2, 0x71,
+	0x5e, 0x9e, 0x74, 0x9c, 0x3f, 0x4e, 0x3a, 0xce, 0x0f, 0xa7, 0x9d, 0xda, 0xcb, 0xd3, 0x4e, 0xed,
+	0xb7, 0xd3, 0x4e, 0xed, 0x9b, 0x7b, 0x15, 0x2a, 0x5f, 0xe9, 0x19, 0x7e, 0x90, 0x62, 0x5a, 0x04,
+	0x66, 0x9e, 0x83, 0x67, 0xf6, 0xa3, 0xc0, 0x90, 0x8a, 0x36, 0xf4, 0x3d, 0xf3, 0xe1, 0x3f, 0x03,
+	0x00, 0xc0, 0x8c, 0xd6, 0x97, 0x3a, 0x08, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -387,6 +486,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinValidPerWindow.Equal(that1.MinValidPerWindow) {
 		return false
 	}
+	if this.TwapLookbackWindow != that1.TwapLookbackWindow {
+		return false
+	}
+	if this.SnapshotRetentionWindow != that1.SnapshotRetentionWindow {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -409,6 +514,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.SnapshotRetentionWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.SnapshotRetentionWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintOracle(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapLookbackWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapLookbackWindow):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintOracle(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
 	{
 		size := m.MinValidPerWindow.Size()
 		i -= size
@@ -651,6 +772,56 @@ func (m *PairReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PriceSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotePower != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotePower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TimestampMs != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.TimestampMs))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
 	offset -= sovOracle(v)
 	base := offset
@@ -688,6 +859,10 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinValidPerWindow.Size()
 	n += 1 + l + sovOracle(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapLookbackWindow)
+	n += 1 + l + sovOracle(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.SnapshotRetentionWindow)
+	n += 1 + l + sovOracle(uint64(l))
 	return n
 }
 
@@ -770,6 +945,27 @@ func (m *PairReward) Size() (n int) {
 	return n
 }
 
+func (m *PriceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.TimestampMs != 0 {
+		n += 1 + sovOracle(uint64(m.TimestampMs))
+	}
+	if m.VotePower != 0 {
+		n += 1 + sovOracle(uint64(m.VotePower))
+	}
+	return n
+}
+
 func sovOracle(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1011,6 +1207,72 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapLookbackWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TwapLookbackWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotRetentionWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.SnapshotRetentionWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1551,6 +1813,160 @@ func (m *PairReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMs", wireType)
+			}
+			m.TimestampMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimestampMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePower", wireType)
+			}
+			m.VotePower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipOracle(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
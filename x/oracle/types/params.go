@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"gopkg.in/yaml.v2"
 
@@ -13,13 +14,15 @@ import (
 
 // Parameter keys
 var (
-	KeyVotePeriod        = []byte("VotePeriod")
-	KeyVoteThreshold     = []byte("VoteThreshold")
-	KeyRewardBand        = []byte("RewardBand")
-	KeyWhitelist         = []byte("Whitelist")
-	KeySlashFraction     = []byte("SlashFraction")
-	KeySlashWindow       = []byte("SlashWindow")
-	KeyMinValidPerWindow = []byte("MinValidPerWindow")
+	KeyVotePeriod              = []byte("VotePeriod")
+	KeyVoteThreshold           = []byte("VoteThreshold")
+	KeyRewardBand              = []byte("RewardBand")
+	KeyWhitelist               = []byte("Whitelist")
+	KeySlashFraction           = []byte("SlashFraction")
+	KeySlashWindow             = []byte("SlashWindow")
+	KeyMinValidPerWindow       = []byte("MinValidPerWindow")
+	KeyTwapLookbackWindow      = []byte("TwapLookbackWindow")
+	KeySnapshotRetentionWindow = []byte("SnapshotRetentionWindow")
 )
 
 // Default parameter values
@@ -31,6 +34,10 @@ const (
 	DefaultSlashWindow = 604800 // 1 week
 )
 
+// MaxSnapshotsPrunedPerVotePeriod bounds the number of price snapshots deleted at the
+// end of a vote period, so that shortening the retention window can't halt the chain.
+const MaxSnapshotsPrunedPerVotePeriod = 100
+
 // Default parameter values
 var (
 	DefaultVoteThreshold = sdk.NewDecWithPrec(50, 2) // 50%
@@ -41,8 +48,10 @@ var (
 		common.Pair_ETH_NUSD.String(),
 		common.Pair_NIBI_NUSD.String(),
 	}
-	DefaultSlashFraction     = sdk.NewDecWithPrec(1, 4) // 0.01%
-	DefaultMinValidPerWindow = sdk.NewDecWithPrec(5, 2) // 5%
+	DefaultSlashFraction           = sdk.NewDecWithPrec(1, 4) // 0.01%
+	DefaultMinValidPerWindow       = sdk.NewDecWithPrec(5, 2) // 5%
+	DefaultTwapLookbackWindow      = 15 * time.Minute
+	DefaultSnapshotRetentionWindow = 24 * time.Hour
 )
 
 var _ paramstypes.ParamSet = &Params{}
@@ -50,13 +59,15 @@ var _ paramstypes.ParamSet = &Params{}
 // DefaultParams creates default oracle module parameters
 func DefaultParams() Params {
 	return Params{
-		VotePeriod:        DefaultVotePeriod,
-		VoteThreshold:     DefaultVoteThreshold,
-		RewardBand:        DefaultRewardBand,
-		Whitelist:         DefaultWhitelist,
-		SlashFraction:     DefaultSlashFraction,
-		SlashWindow:       DefaultSlashWindow,
-		MinValidPerWindow: DefaultMinValidPerWindow,
+		VotePeriod:              DefaultVotePeriod,
+		VoteThreshold:           DefaultVoteThreshold,
+		RewardBand:              DefaultRewardBand,
+		Whitelist:               DefaultWhitelist,
+		SlashFraction:           DefaultSlashFraction,
+		SlashWindow:             DefaultSlashWindow,
+		MinValidPerWindow:       DefaultMinValidPerWindow,
+		TwapLookbackWindow:      DefaultTwapLookbackWindow,
+		SnapshotRetentionWindow: DefaultSnapshotRetentionWindow,
 	}
 }
 
@@ -76,6 +87,8 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeySlashFraction, &p.SlashFraction, validateSlashFraction),
 		paramstypes.NewParamSetPair(KeySlashWindow, &p.SlashWindow, validateSlashWindow),
 		paramstypes.NewParamSetPair(KeyMinValidPerWindow, &p.MinValidPerWindow, validateMinValidPerWindow),
+		paramstypes.NewParamSetPair(KeyTwapLookbackWindow, &p.TwapLookbackWindow, validateTwapLookbackWindow),
+		paramstypes.NewParamSetPair(KeySnapshotRetentionWindow, &p.SnapshotRetentionWindow, validateSnapshotRetentionWindow),
 	}
 }
 
@@ -110,6 +123,14 @@ func (p Params) Validate() error {
 		return fmt.Errorf("oracle parameter MinValidPerWindow must be between [0, 1]")
 	}
 
+	if p.TwapLookbackWindow <= 0 {
+		return fmt.Errorf("oracle parameter TwapLookbackWindow must be > 0, is %s", p.TwapLookbackWindow)
+	}
+
+	if p.SnapshotRetentionWindow < p.TwapLookbackWindow {
+		return fmt.Errorf("oracle parameter SnapshotRetentionWindow must be greater than or equal with TwapLookbackWindow")
+	}
+
 	for _, pair := range p.Whitelist {
 		if _, err := common.NewAssetPair(pair); err != nil {
 			return fmt.Errorf("oracle parameter Whitelist Pair invalid format: %w", err)
@@ -226,3 +247,29 @@ func validateMinValidPerWindow(i interface{}) error {
 
 	return nil
 }
+
+func validateTwapLookbackWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("twap lookback window must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateSnapshotRetentionWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("snapshot retention window must be positive: %s", v)
+	}
+
+	return nil
+}
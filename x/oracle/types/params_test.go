@@ -3,6 +3,7 @@ package types_test
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -51,6 +52,18 @@ func TestParamsEqual(t *testing.T) {
 	err = p6.Validate()
 	require.Error(t, err)
 
+	// zero twap lookback window
+	p7 := types.DefaultParams()
+	p7.TwapLookbackWindow = 0
+	err = p7.Validate()
+	require.Error(t, err)
+
+	// snapshot retention window shorter than the twap lookback window
+	p8 := types.DefaultParams()
+	p8.SnapshotRetentionWindow = p8.TwapLookbackWindow - time.Second
+	err = p8.Validate()
+	require.Error(t, err)
+
 	// empty name
 	p10 := types.DefaultParams()
 	p10.Whitelist[0] = ""
@@ -8,22 +8,27 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -110,6 +115,213 @@ func (m *QueryExchangeRateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryExchangeRateResponse proto.InternalMessageInfo
 
+// QueryExchangeRateTwapRequest is the request type for the Query/ExchangeRateTwap RPC method.
+type QueryExchangeRateTwapRequest struct {
+	// pair defines the pair to query for.
+	Pair string `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	// lookback_window defines the amount of time the exchange rate is averaged over.
+	// Defaults to the twap_lookback_window param when zero.
+	LookbackWindow time.Duration `protobuf:"bytes,2,opt,name=lookback_window,json=lookbackWindow,proto3,stdduration" json:"lookback_window"`
+}
+
+func (m *QueryExchangeRateTwapRequest) Reset()         { *m = QueryExchangeRateTwapRequest{} }
+func (m *QueryExchangeRateTwapRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRateTwapRequest) ProtoMessage()    {}
+func (*QueryExchangeRateTwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_812803c014dfa45a, []int{2}
+}
+func (m *QueryExchangeRateTwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeRateTwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeRateTwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExchangeRateTwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRateTwapRequest.Merge(m, src)
+}
+func (m *QueryExchangeRateTwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExchangeRateTwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRateTwapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExchangeRateTwapRequest proto.InternalMessageInfo
+
+func (m *QueryExchangeRateTwapRequest) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *QueryExchangeRateTwapRequest) GetLookbackWindow() time.Duration {
+	if m != nil {
+		return m.LookbackWindow
+	}
+	return 0
+}
+
+// QueryExchangeRateTwapResponse is response type for the
+// Query/ExchangeRateTwap RPC method.
+type QueryExchangeRateTwapResponse struct {
+	// exchange_rate_twap defines the time-weighted average exchange rate of the pair
+	ExchangeRateTwap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=exchange_rate_twap,json=exchangeRateTwap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate_twap"`
+}
+
+func (m *QueryExchangeRateTwapResponse) Reset()         { *m = QueryExchangeRateTwapResponse{} }
+func (m *QueryExchangeRateTwapResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRateTwapResponse) ProtoMessage()    {}
+func (*QueryExchangeRateTwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_812803c014dfa45a, []int{3}
+}
+func (m *QueryExchangeRateTwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeRateTwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeRateTwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExchangeRateTwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRateTwapResponse.Merge(m, src)
+}
+func (m *QueryExchangeRateTwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExchangeRateTwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRateTwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExchangeRateTwapResponse proto.InternalMessageInfo
+
+// QueryExchangeRateHistoryRequest is the request type for the Query/ExchangeRateHistory RPC method.
+type QueryExchangeRateHistoryRequest struct {
+	// pair defines the pair to query for.
+	Pair string `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExchangeRateHistoryRequest) Reset()         { *m = QueryExchangeRateHistoryRequest{} }
+func (m *QueryExchangeRateHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRateHistoryRequest) ProtoMessage()    {}
+func (*QueryExchangeRateHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_812803c014dfa45a, []int{4}
+}
+func (m *QueryExchangeRateHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeRateHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeRateHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExchangeRateHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRateHistoryRequest.Merge(m, src)
+}
+func (m *QueryExchangeRateHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExchangeRateHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRateHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExchangeRateHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryExchangeRateHistoryRequest) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *QueryExchangeRateHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryExchangeRateHistoryResponse is response type for the
+// Query/ExchangeRateHistory RPC method.
+type QueryExchangeRateHistoryResponse struct {
+	// price_snapshots defines the exchange rate snapshots of the pair, oldest first.
+	PriceSnapshots []PriceSnapshot `protobuf:"bytes,1,rep,name=price_snapshots,json=priceSnapshots,proto3" json:"price_snapshots"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExchangeRateHistoryResponse) Reset()         { *m = QueryExchangeRateHistoryResponse{} }
+func (m *QueryExchangeRateHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRateHistoryResponse) ProtoMessage()    {}
+func (*QueryExchangeRateHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_812803c014dfa45a, []int{5}
+}
+func (m *QueryExchangeRateHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeRateHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeRateHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExchangeRateHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRateHistoryResponse.Merge(m, src)
+}
+func (m *QueryExchangeRateHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExchangeRateHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRateHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExchangeRateHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryExchangeRateHistoryResponse) GetPriceSnapshots() []PriceSnapshot {
+	if m != nil {
+		return m.PriceSnapshots
+	}
+	return nil
+}
+
+func (m *QueryExchangeRateHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryExchangeRatesRequest is the request type for the Query/ExchangeRates RPC method.
 type QueryExchangeRatesRequest struct {
 }
@@ -118,7 +330,7 @@ func (m *QueryExchangeRatesRequest) Reset()         { *m = QueryExchangeRatesReq
 func (m *QueryExchangeRatesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryExchangeRatesRequest) ProtoMessage()    {}
 func (*QueryExchangeRatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_812803c014dfa45a, []int{2}
+	return fileDescriptor_812803c014dfa45a, []int{6}
 }
 func (m *QueryExchangeRatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -158,7 +370,7 @@ func (m *QueryExchangeRatesResponse) Reset()         { *m = QueryExchangeRatesRe
 func (m *QueryExchangeRatesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryExchangeRatesResponse) ProtoMessage()    {}
 func (*QueryExchangeRatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_812803c014dfa45a, []int{3}
+	return fileDescriptor_812803c014dfa45a, []int{7}
 }
 func (m *QueryExchangeRatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -202,7 +414,7 @@ func (m *QueryActivesRequest) Reset()         { *m = QueryActivesRequest{} }
 func (m *QueryActivesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryActivesRequest) ProtoMessage()    {}
 func (*QueryActivesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_812803c014dfa45a, []int{4}
+	return fileDescriptor_812803c014dfa45a, []int{8}
 }
 func (m *QueryActivesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -242,7 +454,7 @@ func (m *QueryActivesResponse) Reset()         { *m = QueryActivesResponse{} }
 func (m *QueryActivesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryActivesResponse) ProtoMessage()    {}
 func (*QueryActivesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_812803c014dfa45a, []int{5}
+	return fileDescriptor_812803c014dfa45a, []int{9}
 }
 func (m *QueryActivesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,7 +498,7 @@ func (m *QueryVoteTargetsRequest) Reset()         { *m = QueryVoteTargetsRequest
 func (m *QueryVoteTargetsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteTargetsRequest) ProtoMessage()    {}
 func (*QueryVoteTargetsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_812803c014dfa45a, []int{6}
+	return fileDescriptor_812803c014dfa45a, []int{10}
 }
 func (m *QueryVoteTargetsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -327,7 +539,7 @@ func (m *QueryVoteTargetsResponse) Reset()         { *m = QueryVoteTargetsRespon
 func (m *QueryVoteTargetsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteTargetsResponse) ProtoMessage()    {}
 func (*QueryVoteTargetsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_812803c014dfa45a, []int{7}
+	return fileDescriptor_812803c014dfa45a, []int{11}
 }
 func (m *QueryVoteTargetsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -373,7 +585,7 @@ func (m *QueryFeederDelegationRequest) Reset()         { *m = QueryFeederDelegat
 func (m *QueryFeederDelegationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFeederDelegationRequest) ProtoMessage()    {}
 func (*QueryFeederDelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_812803c014dfa45a, []int{8}
+	return fileDescriptor_812803c014dfa45a, []int{12}
 }
 func (m *QueryFeederDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -413,7 +625,7 @@ func (m *QueryFeederDelegationResponse) Reset()         { *m = QueryFeederDelega
 func (m *QueryFeederDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFeederDelegationResponse) ProtoMessage()    {}
 func (*QueryFeederDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_812803c014dfa45a, []int{9}
+	return fileDescriptor_812803c014dfa45a, []int{13}
 }
 func (m *QueryFeederDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,7 +671,7 @@ func (m *QueryMissCounterRequest) Reset()         { *m = QueryMissCounterRequest
 func (m *QueryMissCounterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMissCounterRequest) ProtoMessage()    {}
 func (*QueryMissCounterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_812803c014dfa45a, []int{10}
+	return fileDescriptor_812803c014dfa45a, []int{14}
 }
 func (m *QueryMissCounterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -499,7 +711,7 @@ func (m *QueryMissCounterResponse) Reset()         { *m = QueryMissCounterRespon
 func (m *QueryMissCounterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMissCounterResponse) ProtoMessage()    {}
 func (*QueryMissCounterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_812803c014dfa45a, []int{11}
+	return fileDescriptor_812803c014dfa45a, []int{15}
 }
 func (m *QueryMissCounterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -545,7 +757,7 @@ func (m *QueryAggregatePrevoteRequest) Reset()         { *m = QueryAggregatePrev
 func (m *QueryAggregatePrevoteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevoteRequest) ProtoMessage()    {}
 func (*QueryAggregatePrevoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_812803c014dfa45a, []int{12}
+	return fileDescriptor_812803c014dfa45a, []int{16}
 }
 func (m *QueryAggregatePrevoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -585,7 +797,7 @@ func (m *QueryAggregatePrevoteResponse) Reset()         { *m = QueryAggregatePre
 func (m *QueryAggregatePrevoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevoteResponse) ProtoMessage()    {}
 func (*QueryAggregatePrevoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_812803c014dfa45a, []int{13}
+	return fileDescriptor_812803c014dfa45a, []int{17}
 }
 func (m *QueryAggregatePrevoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -629,7 +841,7 @@ func (m *QueryAggregatePrevotesRequest) Reset()         { *m = QueryAggregatePre
 func (m *QueryAggregatePrevotesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevotesRequest) ProtoMessage()    {}
 func (*QueryAggregatePrevotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_812803c014dfa45a, []int{14}
+	return fileDescriptor_812803c014dfa45a, []int{18}
 }
 func (m *QueryAggregatePrevotesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -669,7 +881,7 @@ func (m *QueryAggregatePrevotesResponse) Reset()         { *m = QueryAggregatePr
 func (m *QueryAggregatePrevotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevotesResponse) ProtoMessage()    {}
 func (*QueryAggregatePrevotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_812803c014dfa45a, []int{15}
+	return fileDescriptor_812803c014dfa45a, []int{19}
 }
 func (m *QueryAggregatePrevotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -715,7 +927,7 @@ func (m *QueryAggregateVoteRequest) Reset()         { *m = QueryAggregateVoteReq
 func (m *QueryAggregateVoteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVoteRequest) ProtoMessage()    {}
 func (*QueryAggregateVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_812803c014dfa45a, []int{16}
+	return fileDescriptor_812803c014dfa45a, []int{20}
 }
 func (m *QueryAggregateVoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -755,7 +967,7 @@ func (m *QueryAggregateVoteResponse) Reset()         { *m = QueryAggregateVoteRe
 func (m *QueryAggregateVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVoteResponse) ProtoMessage()    {}
 func (*QueryAggregateVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_812803c014dfa45a, []int{17}
+	return fileDescriptor_812803c014dfa45a, []int{21}
 }
 func (m *QueryAggregateVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -799,7 +1011,7 @@ func (m *QueryAggregateVotesRequest) Reset()         { *m = QueryAggregateVotesR
 func (m *QueryAggregateVotesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVotesRequest) ProtoMessage()    {}
 func (*QueryAggregateVotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_812803c014dfa45a, []int{18}
+	return fileDescriptor_812803c014dfa45a, []int{22}
 }
 func (m *QueryAggregateVotesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -839,7 +1051,7 @@ func (m *QueryAggregateVotesResponse) Reset()         { *m = QueryAggregateVotes
 func (m *QueryAggregateVotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVotesResponse) ProtoMessage()    {}
 func (*QueryAggregateVotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_812803c014dfa45a, []int{19}
+	return fileDescriptor_812803c014dfa45a, []int{23}
 }
 func (m *QueryAggregateVotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -883,7 +1095,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_812803c014dfa45a, []int{20}
+	return fileDescriptor_812803c014dfa45a, []int{24}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -922,7 +1134,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_812803c014dfa45a, []int{21}
+	return fileDescriptor_812803c014dfa45a, []int{25}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -961,6 +1173,10 @@ func (m *QueryParamsResponse) GetParams() Params {
 func init() {
 	proto.RegisterType((*QueryExchangeRateRequest)(nil), "nibiru.oracle.v1beta1.QueryExchangeRateRequest")
 	proto.RegisterType((*QueryExchangeRateResponse)(nil), "nibiru.oracle.v1beta1.QueryExchangeRateResponse")
+	proto.RegisterType((*QueryExchangeRateTwapRequest)(nil), "nibiru.oracle.v1beta1.QueryExchangeRateTwapRequest")
+	proto.RegisterType((*QueryExchangeRateTwapResponse)(nil), "nibiru.oracle.v1beta1.QueryExchangeRateTwapResponse")
+	proto.RegisterType((*QueryExchangeRateHistoryRequest)(nil), "nibiru.oracle.v1beta1.QueryExchangeRateHistoryRequest")
+	proto.RegisterType((*QueryExchangeRateHistoryResponse)(nil), "nibiru.oracle.v1beta1.QueryExchangeRateHistoryResponse")
 	proto.RegisterType((*QueryExchangeRatesRequest)(nil), "nibiru.oracle.v1beta1.QueryExchangeRatesRequest")
 	proto.RegisterType((*QueryExchangeRatesResponse)(nil), "nibiru.oracle.v1beta1.QueryExchangeRatesResponse")
 	proto.RegisterType((*QueryActivesRequest)(nil), "nibiru.oracle.v1beta1.QueryActivesRequest")
@@ -986,75 +1202,90 @@ func init() {
 func init() { proto.RegisterFile("oracle/v1beta1/query.proto", fileDescriptor_812803c014dfa45a) }
 
 var fileDescriptor_812803c014dfa45a = []byte{
-	// 1073 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x3d, 0x50, 0x52, 0xfa, 0x1c, 0xbb, 0xc9, 0x90, 0x8a, 0x74, 0x93, 0xd8, 0x65, 0x45,
-	0xab, 0xb4, 0x69, 0xbd, 0x71, 0x5c, 0x7e, 0x84, 0x82, 0xc0, 0x49, 0x88, 0x04, 0x2a, 0x50, 0x96,
-	0x2a, 0x07, 0x24, 0x64, 0x8d, 0xed, 0xe9, 0x66, 0x55, 0x7b, 0x67, 0xbb, 0xb3, 0xb6, 0x5a, 0x45,
-	0xe5, 0xc0, 0x81, 0x5f, 0xa7, 0x4a, 0x9c, 0x91, 0xca, 0x11, 0x84, 0x84, 0xc4, 0x1f, 0x00, 0x1c,
-	0x2b, 0x71, 0xa9, 0xc4, 0x05, 0x71, 0x28, 0x28, 0xe1, 0xc0, 0x9f, 0x81, 0x76, 0x76, 0x76, 0xbd,
-	0x6b, 0xef, 0x6e, 0x6d, 0xf7, 0x14, 0x67, 0xe6, 0xbd, 0xef, 0xfb, 0xbc, 0x37, 0xe3, 0xf9, 0xca,
-	0xa0, 0x30, 0x87, 0xb4, 0x3a, 0x54, 0xeb, 0x57, 0x9b, 0xd4, 0x25, 0x55, 0xed, 0x56, 0x8f, 0x3a,
-	0x77, 0x2a, 0xb6, 0xc3, 0x5c, 0x86, 0x4f, 0x59, 0x66, 0xd3, 0x74, 0x7a, 0x15, 0x3f, 0xa4, 0x22,
-	0x43, 0x94, 0x05, 0x83, 0x19, 0x4c, 0x44, 0x68, 0xde, 0x27, 0x3f, 0x58, 0x59, 0x36, 0x18, 0x33,
-	0x3a, 0x54, 0x23, 0xb6, 0xa9, 0x11, 0xcb, 0x62, 0x2e, 0x71, 0x4d, 0x66, 0x71, 0xb9, 0xbb, 0x34,
-	0x54, 0x46, 0x4a, 0xfa, 0x9b, 0xa5, 0x16, 0xe3, 0x5d, 0xc6, 0xb5, 0x26, 0xe1, 0x83, 0x88, 0x16,
-	0x33, 0x2d, 0x7f, 0x5f, 0x7d, 0x15, 0x16, 0x3f, 0xf4, 0xb0, 0xde, 0xbe, 0xdd, 0xda, 0x27, 0x96,
-	0x41, 0x75, 0xe2, 0x52, 0x9d, 0xde, 0xea, 0x51, 0xee, 0x62, 0x0c, 0xc7, 0x6c, 0x62, 0x3a, 0x8b,
-	0xe8, 0x0c, 0x5a, 0x3d, 0xa1, 0x8b, 0xcf, 0xaf, 0x3d, 0xfb, 0xe5, 0xfd, 0x72, 0xee, 0xbf, 0xfb,
-	0xe5, 0x9c, 0x6a, 0xc3, 0xe9, 0x84, 0x4c, 0x6e, 0x33, 0x8b, 0x53, 0xfc, 0x11, 0x14, 0xa8, 0x5c,
-	0x6f, 0x38, 0xc4, 0xa5, 0xbe, 0xc6, 0x56, 0xe5, 0xc1, 0xa3, 0x72, 0xee, 0xaf, 0x47, 0xe5, 0x73,
-	0x86, 0xe9, 0xee, 0xf7, 0x9a, 0x95, 0x16, 0xeb, 0x6a, 0x12, 0xd0, 0xff, 0x73, 0x89, 0xb7, 0x6f,
-	0x6a, 0xee, 0x1d, 0x9b, 0xf2, 0xca, 0x0e, 0x6d, 0xe9, 0xb3, 0x34, 0x22, 0xae, 0x2e, 0x25, 0x54,
-	0xe4, 0x12, 0x56, 0xfd, 0x0a, 0x81, 0x92, 0xb4, 0x2b, 0x81, 0x6e, 0x42, 0x31, 0x06, 0xc4, 0x17,
-	0xd1, 0x99, 0xa7, 0x57, 0xf3, 0x1b, 0xab, 0x95, 0xc4, 0x83, 0xa8, 0x44, 0x55, 0xae, 0xf7, 0xec,
-	0x0e, 0xdd, 0x52, 0x3c, 0xf6, 0x1f, 0xfe, 0x2e, 0xe3, 0x91, 0x2d, 0xae, 0x17, 0xa2, 0x9c, 0x5c,
-	0x3d, 0x05, 0xcf, 0x09, 0x94, 0x7a, 0xcb, 0x35, 0xfb, 0x03, 0xc4, 0x75, 0x58, 0x88, 0x2f, 0x4b,
-	0xb6, 0x45, 0x38, 0x4e, 0xfc, 0x25, 0x01, 0x75, 0x42, 0x0f, 0xfe, 0x55, 0x4f, 0xc3, 0xf3, 0x22,
-	0x63, 0x8f, 0xb9, 0xf4, 0x3a, 0x71, 0x0c, 0xea, 0x86, 0x62, 0x6f, 0xc8, 0x83, 0x8b, 0x6d, 0x49,
-	0xc1, 0x17, 0x60, 0xb6, 0xcf, 0x5c, 0xda, 0x70, 0xfd, 0x75, 0xa9, 0x9a, 0xef, 0x0f, 0x42, 0xd5,
-	0x0f, 0x60, 0x59, 0xa4, 0xef, 0x52, 0xda, 0xa6, 0xce, 0x0e, 0xed, 0x50, 0x43, 0x5c, 0xaa, 0xe0,
-	0xec, 0xcf, 0x42, 0xb1, 0x4f, 0x3a, 0x66, 0x9b, 0xb8, 0xcc, 0x69, 0x90, 0x76, 0x3b, 0xb8, 0x05,
-	0x85, 0x70, 0xb5, 0xde, 0x6e, 0x47, 0xaf, 0xc3, 0x5b, 0xb0, 0x92, 0x22, 0x28, 0xa1, 0xca, 0x90,
-	0xbf, 0x21, 0xf6, 0xa2, 0x72, 0xe0, 0x2f, 0x79, 0x5a, 0xea, 0xbb, 0xb2, 0xd9, 0xf7, 0x4c, 0xce,
-	0xb7, 0x59, 0xcf, 0x72, 0xa9, 0x33, 0x35, 0x4d, 0x30, 0x9d, 0x98, 0xd6, 0x60, 0x3a, 0x5d, 0x93,
-	0xf3, 0x46, 0xcb, 0x5f, 0x17, 0x52, 0xc7, 0xf4, 0x7c, 0x77, 0x10, 0x1a, 0x4e, 0xa7, 0x6e, 0x18,
-	0x8e, 0xd7, 0x07, 0xbd, 0xe6, 0x50, 0x6f, 0x7a, 0x53, 0xf3, 0x7c, 0x81, 0xe4, 0x78, 0x46, 0x15,
-	0x25, 0xd5, 0x0d, 0x98, 0x27, 0xc1, 0x5e, 0xc3, 0xf6, 0x37, 0x85, 0x6a, 0x7e, 0xa3, 0x96, 0x72,
-	0x47, 0x43, 0xad, 0xe8, 0x8d, 0x94, 0xba, 0x5b, 0xc7, 0xbc, 0xeb, 0xaa, 0xcf, 0x91, 0xa1, 0x7a,
-	0x6a, 0x39, 0x05, 0x24, 0xbc, 0x58, 0x5f, 0x23, 0x28, 0xa5, 0x45, 0x48, 0xd6, 0x7d, 0xc0, 0x23,
-	0xac, 0xc1, 0x17, 0xea, 0x09, 0x60, 0xe7, 0x87, 0x61, 0xb9, 0x7a, 0x55, 0x7e, 0xe5, 0xc3, 0xec,
-	0xbd, 0x27, 0x39, 0x85, 0x03, 0xf9, 0x44, 0x0c, 0xa9, 0xc9, 0xae, 0x3e, 0x81, 0xe2, 0xa0, 0xab,
-	0xc8, 0xf8, 0xd7, 0x27, 0xe9, 0x68, 0x6f, 0xd0, 0x4e, 0x81, 0x44, 0xcb, 0xa8, 0xcb, 0x49, 0xc5,
-	0xc3, 0xa9, 0x7f, 0x0a, 0x4b, 0x89, 0xbb, 0x92, 0xad, 0x01, 0x27, 0xe3, 0x6c, 0xc1, 0xb8, 0xa7,
-	0x85, 0x2b, 0xc6, 0xe0, 0xb8, 0xba, 0x00, 0x58, 0xd4, 0xbf, 0x46, 0x1c, 0xd2, 0x0d, 0xa9, 0x74,
-	0xf9, 0x90, 0x05, 0xab, 0x92, 0xe6, 0x0a, 0xcc, 0xd8, 0x62, 0x45, 0x4e, 0x68, 0x25, 0x05, 0xc2,
-	0x4f, 0x93, 0x15, 0x65, 0xca, 0xc6, 0xb7, 0x27, 0xe1, 0x19, 0x21, 0x8a, 0x7f, 0x44, 0x30, 0x1b,
-	0xc5, 0xc3, 0x5a, 0x8a, 0x4e, 0x9a, 0x43, 0x29, 0xeb, 0xe3, 0x27, 0xf8, 0xe8, 0xea, 0xe6, 0x67,
-	0x7f, 0xfc, 0xfb, 0xcd, 0x53, 0x35, 0x5c, 0xd5, 0xfc, 0x4c, 0x6d, 0xc8, 0x3c, 0x3d, 0x93, 0xe3,
-	0xda, 0x81, 0xf7, 0xe7, 0xae, 0x16, 0x73, 0x0c, 0xfc, 0x3d, 0x82, 0x42, 0xcc, 0x5c, 0xf0, 0xd8,
-	0xe5, 0x83, 0x81, 0x2a, 0xd5, 0x09, 0x32, 0x24, 0x71, 0x4d, 0x10, 0x5f, 0xc2, 0x6b, 0x99, 0xc4,
-	0x71, 0x73, 0xc3, 0xf7, 0x10, 0x1c, 0x97, 0x36, 0x83, 0x2f, 0x64, 0xd5, 0x8c, 0x5b, 0x94, 0xb2,
-	0x36, 0x56, 0xac, 0x24, 0xbb, 0x28, 0xc8, 0xce, 0xe1, 0x17, 0x33, 0xc9, 0xa4, 0x97, 0xe1, 0xef,
-	0x10, 0xe4, 0x23, 0x66, 0x85, 0x2b, 0x59, 0xa5, 0x46, 0x0d, 0x4f, 0xd1, 0xc6, 0x8e, 0x97, 0x78,
-	0x55, 0x81, 0xb7, 0x86, 0xcf, 0x67, 0xe2, 0x45, 0x8d, 0x12, 0xff, 0x86, 0x60, 0x6e, 0xd8, 0xc0,
-	0x70, 0x2d, 0xab, 0x70, 0x8a, 0x7f, 0x2a, 0x97, 0x27, 0x4b, 0x92, 0xc8, 0x75, 0x81, 0x7c, 0x05,
-	0x6f, 0xa6, 0x20, 0x87, 0x0f, 0x1b, 0xd7, 0x0e, 0xe2, 0x4f, 0xdf, 0x5d, 0xcd, 0x77, 0x52, 0xfc,
-	0x13, 0x82, 0x7c, 0xc4, 0xf5, 0xb2, 0xc7, 0x3c, 0x6a, 0xb5, 0xd9, 0x63, 0x4e, 0xb0, 0x53, 0xf5,
-	0x4d, 0xc1, 0xbc, 0x89, 0x5f, 0x99, 0x82, 0xd9, 0xf3, 0x5c, 0xfc, 0x3b, 0x82, 0xb9, 0x61, 0xaf,
-	0xc9, 0x1e, 0x7a, 0x8a, 0x2d, 0x67, 0x0f, 0x3d, 0xcd, 0x79, 0xd5, 0xab, 0xa2, 0x81, 0x5d, 0xbc,
-	0x33, 0x45, 0x03, 0x23, 0x36, 0x88, 0x7f, 0x41, 0x30, 0x3f, 0xe2, 0x9c, 0x78, 0x22, 0xb2, 0xf0,
-	0xca, 0xbf, 0x34, 0x61, 0x96, 0x6c, 0xe8, 0x75, 0xd1, 0xd0, 0xcb, 0xf8, 0xf2, 0xe3, 0x1b, 0x1a,
-	0xb5, 0x71, 0xfc, 0x2b, 0x82, 0x42, 0xcc, 0x85, 0xb2, 0x9f, 0xb9, 0x24, 0x67, 0xce, 0x7e, 0xe6,
-	0x12, 0xdd, 0x57, 0x7d, 0x47, 0x40, 0x6f, 0xe3, 0x7a, 0x3a, 0x74, 0xdb, 0x7c, 0xec, 0x29, 0x88,
-	0x23, 0xf8, 0x19, 0x41, 0x31, 0xee, 0xa3, 0x78, 0x7c, 0xa0, 0x70, 0xf8, 0x1b, 0x93, 0xa4, 0x8c,
-	0xe9, 0x2e, 0x89, 0x93, 0xf7, 0xc7, 0xfe, 0x39, 0x82, 0x19, 0xdf, 0x2f, 0xf1, 0xf9, 0xac, 0xca,
-	0x31, 0x83, 0x56, 0x2e, 0x8c, 0x13, 0x2a, 0xe1, 0xce, 0x0a, 0xb8, 0x32, 0x5e, 0x49, 0x7d, 0x0f,
-	0x85, 0x5b, 0xef, 0x3e, 0x38, 0x2c, 0xa1, 0x87, 0x87, 0x25, 0xf4, 0xcf, 0x61, 0x09, 0xdd, 0x3b,
-	0x2a, 0xe5, 0x1e, 0x1e, 0x95, 0x72, 0x7f, 0x1e, 0x95, 0x72, 0x1f, 0x5f, 0x8c, 0xfc, 0x6a, 0x7b,
-	0x5f, 0x48, 0x6c, 0xef, 0x13, 0xd3, 0x0a, 0xe4, 0x6e, 0x07, 0x82, 0xe2, 0xf7, 0x5b, 0x73, 0x46,
-	0xfc, 0xc0, 0xac, 0xfd, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x43, 0x4b, 0x5a, 0x52, 0x06, 0x0f, 0x00,
-	0x00,
+	// 1322 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x98, 0xcb, 0x6f, 0x1b, 0x45,
+	0x1c, 0xc7, 0x3d, 0xa5, 0xf4, 0xf1, 0x73, 0xed, 0xa6, 0xd3, 0x56, 0xb8, 0xdb, 0xc6, 0x0e, 0xab,
+	0xb6, 0xa4, 0x4d, 0xbb, 0x1b, 0x27, 0x21, 0x6d, 0x28, 0xaf, 0x3c, 0x08, 0x0f, 0x05, 0x08, 0x6e,
+	0x15, 0x24, 0x04, 0xb2, 0xc6, 0xf6, 0x64, 0xbd, 0x8a, 0xe3, 0xdd, 0xee, 0xac, 0x93, 0x46, 0x51,
+	0x10, 0xe2, 0xc0, 0xeb, 0x54, 0x89, 0x0b, 0xc7, 0x72, 0x2c, 0x42, 0x42, 0xe2, 0x0e, 0xf4, 0x58,
+	0xc4, 0xa5, 0x12, 0x17, 0xc4, 0xa1, 0x45, 0x09, 0x07, 0xfe, 0x0c, 0xb4, 0xb3, 0xb3, 0xeb, 0x5d,
+	0xdb, 0xbb, 0xf1, 0xba, 0x27, 0xdb, 0x33, 0xbf, 0xc7, 0xe7, 0xfb, 0x9b, 0xdf, 0x7a, 0x7e, 0x5a,
+	0x90, 0x0c, 0x8b, 0x54, 0x1b, 0x54, 0xdd, 0x28, 0x56, 0xa8, 0x4d, 0x8a, 0xea, 0xed, 0x16, 0xb5,
+	0xb6, 0x14, 0xd3, 0x32, 0x6c, 0x03, 0x9f, 0x6e, 0xea, 0x15, 0xdd, 0x6a, 0x29, 0xae, 0x89, 0x22,
+	0x4c, 0xa4, 0x53, 0x9a, 0xa1, 0x19, 0xdc, 0x42, 0x75, 0xbe, 0xb9, 0xc6, 0xd2, 0x39, 0xcd, 0x30,
+	0xb4, 0x06, 0x55, 0x89, 0xa9, 0xab, 0xa4, 0xd9, 0x34, 0x6c, 0x62, 0xeb, 0x46, 0x93, 0x89, 0xdd,
+	0xbc, 0xd8, 0xe5, 0xbf, 0x2a, 0xad, 0x55, 0xb5, 0xd6, 0xb2, 0xb8, 0x81, 0xd8, 0xbf, 0x5c, 0x35,
+	0xd8, 0xba, 0xc1, 0xd4, 0x0a, 0x61, 0xd4, 0x65, 0xf0, 0x89, 0x4c, 0xa2, 0xe9, 0xcd, 0xa0, 0xed,
+	0xd9, 0x0e, 0x64, 0x81, 0x27, 0x12, 0x05, 0x03, 0x79, 0x16, 0x55, 0x43, 0x17, 0xce, 0xf2, 0x75,
+	0xc8, 0x7d, 0xe0, 0x84, 0x7f, 0xe3, 0x4e, 0xb5, 0x4e, 0x9a, 0x1a, 0x2d, 0x11, 0x9b, 0x96, 0xe8,
+	0xed, 0x16, 0x65, 0x36, 0xc6, 0x70, 0xd0, 0x24, 0xba, 0x95, 0x43, 0x23, 0x68, 0xf4, 0x68, 0x89,
+	0x7f, 0x7f, 0xe9, 0xc8, 0x57, 0xf7, 0x0a, 0xa9, 0xff, 0xee, 0x15, 0x52, 0xb2, 0x09, 0x67, 0x7a,
+	0x78, 0x32, 0xd3, 0x68, 0x32, 0x8a, 0x6f, 0x42, 0x86, 0x8a, 0xf5, 0xb2, 0x45, 0x6c, 0xea, 0xc6,
+	0x98, 0x53, 0x1e, 0x3e, 0x2e, 0xa4, 0xfe, 0x7e, 0x5c, 0xb8, 0xa8, 0xe9, 0x76, 0xbd, 0x55, 0x51,
+	0xaa, 0xc6, 0xba, 0x2a, 0x00, 0xdd, 0x8f, 0xab, 0xac, 0xb6, 0xa6, 0xda, 0x5b, 0x26, 0x65, 0xca,
+	0x02, 0xad, 0x96, 0x8e, 0xd1, 0x40, 0x70, 0xf9, 0x33, 0x04, 0xe7, 0xba, 0x52, 0xde, 0xda, 0x24,
+	0x66, 0x0c, 0x30, 0x5e, 0x82, 0xe3, 0x0d, 0xc3, 0x58, 0xab, 0x90, 0xea, 0x5a, 0x79, 0x53, 0x6f,
+	0xd6, 0x8c, 0xcd, 0xdc, 0x81, 0x11, 0x34, 0x9a, 0x9e, 0x38, 0xa3, 0xb8, 0x67, 0xa0, 0x78, 0x67,
+	0xa0, 0x2c, 0x88, 0x33, 0x98, 0x3b, 0xe2, 0x60, 0x7e, 0xf7, 0xa4, 0x80, 0x4a, 0x59, 0xcf, 0xf7,
+	0x43, 0xee, 0x2a, 0xef, 0xc0, 0x70, 0x04, 0x81, 0x10, 0xfe, 0x31, 0xe0, 0x90, 0xf0, 0xb2, 0xbd,
+	0x49, 0xcc, 0x01, 0xd5, 0x0f, 0xd1, 0x8e, 0x2c, 0xf2, 0x0e, 0x14, 0xba, 0xd2, 0xbf, 0xa5, 0x33,
+	0xdb, 0xb0, 0xb6, 0xe2, 0x6a, 0xb0, 0x08, 0xd0, 0xee, 0x1a, 0x21, 0xff, 0xa2, 0xe2, 0xe6, 0x54,
+	0x9c, 0xce, 0x50, 0xdc, 0x36, 0x17, 0xfd, 0xa1, 0x2c, 0x13, 0xcd, 0x6b, 0x82, 0x52, 0xc0, 0x53,
+	0x7e, 0x80, 0x60, 0x24, 0x3a, 0xbf, 0x7f, 0xf4, 0xc7, 0x4d, 0x4b, 0xaf, 0xd2, 0x32, 0x6b, 0x12,
+	0x93, 0xd5, 0x0d, 0x9b, 0xe5, 0xd0, 0xc8, 0x33, 0xa3, 0xe9, 0x89, 0xf3, 0x4a, 0xcf, 0xe7, 0x47,
+	0x59, 0x76, 0xac, 0x6f, 0x0a, 0xe3, 0xb9, 0x83, 0x4e, 0x91, 0x4a, 0x59, 0x33, 0xb8, 0xc8, 0xf0,
+	0x9b, 0x3d, 0x14, 0xbc, 0xb0, 0xaf, 0x02, 0x97, 0x28, 0x24, 0xe1, 0x6c, 0x8f, 0xae, 0x65, 0x42,
+	0xab, 0xfc, 0x35, 0x02, 0xa9, 0xd7, 0xae, 0x50, 0xb6, 0x06, 0xd9, 0xd0, 0xd9, 0x7a, 0xc2, 0x46,
+	0x23, 0x84, 0x85, 0x9a, 0xa4, 0x65, 0x36, 0xe8, 0x9c, 0xe4, 0x88, 0xfb, 0xe1, 0x49, 0x01, 0x77,
+	0x6d, 0xb1, 0x52, 0x26, 0x78, 0xda, 0x4c, 0x3e, 0x0d, 0x27, 0x39, 0xca, 0x6c, 0xd5, 0xd6, 0x37,
+	0xda, 0x88, 0xe3, 0x70, 0x2a, 0xbc, 0x2c, 0xd8, 0x72, 0x70, 0x98, 0xb8, 0x4b, 0x1c, 0xea, 0x68,
+	0xc9, 0xfb, 0x29, 0x9f, 0x81, 0xe7, 0xb8, 0xc7, 0x8a, 0x61, 0xd3, 0x5b, 0xc4, 0xd2, 0xa8, 0xed,
+	0x07, 0x7b, 0x05, 0x72, 0xdd, 0x5b, 0x22, 0xe0, 0xf3, 0x70, 0x6c, 0xc3, 0x70, 0xfa, 0xd7, 0x5d,
+	0x17, 0x51, 0xd3, 0x1b, 0x6d, 0x53, 0xf9, 0x7d, 0xf1, 0x38, 0x2e, 0x52, 0x5a, 0xa3, 0xd6, 0x02,
+	0x6d, 0x50, 0x8d, 0x17, 0xd9, 0x6b, 0xc5, 0x0b, 0x90, 0xdd, 0x20, 0x0d, 0xbd, 0x46, 0x6c, 0xc3,
+	0x2a, 0x93, 0x5a, 0xcd, 0x6b, 0xca, 0x8c, 0xbf, 0x3a, 0x5b, 0xab, 0x05, 0xff, 0x52, 0x5e, 0x87,
+	0xe1, 0x88, 0x80, 0x02, 0xaa, 0x00, 0xe9, 0x55, 0xbe, 0x17, 0x0c, 0x07, 0xee, 0x92, 0x13, 0x4b,
+	0x7e, 0x47, 0x88, 0x7d, 0x57, 0x67, 0x6c, 0xde, 0x68, 0x35, 0x6d, 0x6a, 0x0d, 0x4c, 0xe3, 0x55,
+	0x27, 0x14, 0xab, 0x5d, 0x9d, 0x75, 0x9d, 0xb1, 0x72, 0xd5, 0x5d, 0xe7, 0xa1, 0x0e, 0x96, 0xd2,
+	0xeb, 0x6d, 0x53, 0xbf, 0x3a, 0xb3, 0x9a, 0x66, 0x39, 0x3a, 0xe8, 0xb2, 0x45, 0x9d, 0xea, 0x0d,
+	0xcc, 0xf3, 0x25, 0x82, 0xe1, 0x88, 0x88, 0x82, 0x6a, 0x15, 0x4e, 0x10, 0x6f, 0xaf, 0x6c, 0xba,
+	0x9b, 0x3c, 0x6a, 0x7a, 0x62, 0x32, 0xa2, 0x47, 0xfd, 0x58, 0xc1, 0x8e, 0x14, 0x71, 0xc5, 0xb3,
+	0x38, 0x44, 0x3a, 0xf2, 0xc9, 0x85, 0x08, 0x10, 0xbf, 0xb1, 0xbe, 0x41, 0x90, 0x8f, 0xb2, 0x10,
+	0xac, 0x75, 0xc0, 0x5d, 0xac, 0xde, 0x03, 0xf5, 0x14, 0xb0, 0x27, 0x3a, 0x61, 0x99, 0xbc, 0x24,
+	0x1e, 0x79, 0xdf, 0x7b, 0xe5, 0x69, 0x4e, 0x61, 0x1b, 0xa4, 0x5e, 0xd1, 0x84, 0xaa, 0x4f, 0x20,
+	0xdb, 0x56, 0x15, 0x28, 0xff, 0x78, 0x12, 0x45, 0x2b, 0x6d, 0x39, 0x19, 0x12, 0x4c, 0x23, 0x9f,
+	0xeb, 0x95, 0xdc, 0xaf, 0xfa, 0xa7, 0x70, 0xb6, 0xe7, 0xae, 0x60, 0x2b, 0xc3, 0xf1, 0x30, 0x9b,
+	0x57, 0xee, 0x41, 0xe1, 0xb2, 0x21, 0x38, 0x26, 0x9f, 0x02, 0xcc, 0xf3, 0x2f, 0x13, 0x8b, 0xac,
+	0xfb, 0x54, 0x25, 0x38, 0x19, 0x5a, 0x15, 0x34, 0x37, 0xe0, 0x90, 0xc9, 0x57, 0x44, 0x85, 0x86,
+	0xa3, 0x6e, 0x07, 0x6e, 0x24, 0x32, 0x0a, 0x97, 0x89, 0xfb, 0x18, 0x9e, 0xe5, 0x41, 0xf1, 0x8f,
+	0x08, 0x8e, 0x05, 0xf1, 0xb0, 0x1a, 0x11, 0x27, 0x6a, 0xca, 0x91, 0xc6, 0xfb, 0x77, 0x70, 0xd1,
+	0xe5, 0x99, 0xcf, 0xff, 0xfc, 0xf7, 0xdb, 0x03, 0x93, 0xb8, 0xa8, 0xba, 0x9e, 0x6a, 0xc7, 0x00,
+	0xe6, 0xdc, 0xb9, 0x4c, 0xdd, 0x76, 0x3e, 0x76, 0xd4, 0xd0, 0x8d, 0x81, 0x7f, 0x41, 0x30, 0xd4,
+	0x39, 0x3b, 0xe0, 0xc9, 0x7e, 0x09, 0x02, 0xb3, 0x8e, 0x34, 0x95, 0xcc, 0x49, 0xa0, 0xbf, 0xca,
+	0xd1, 0xaf, 0xe3, 0xe9, 0xc4, 0xe8, 0x7c, 0x90, 0xc1, 0xbf, 0x23, 0x38, 0xd9, 0xe3, 0xf2, 0xc7,
+	0xd3, 0xfd, 0xd2, 0x84, 0xa7, 0x15, 0xe9, 0x5a, 0x62, 0x3f, 0x21, 0x64, 0x96, 0x0b, 0xb9, 0x81,
+	0x67, 0x92, 0x0b, 0xa9, 0x0b, 0xe6, 0xfb, 0x08, 0x32, 0xa1, 0x8b, 0x1e, 0xf7, 0xdd, 0x0a, 0x5e,
+	0x73, 0x4b, 0xc5, 0x04, 0x1e, 0x82, 0x7c, 0x92, 0x93, 0x5f, 0xc5, 0x63, 0xb1, 0xe4, 0xe1, 0x41,
+	0x03, 0xdf, 0x45, 0x70, 0x58, 0x5c, 0xf9, 0xf8, 0x72, 0x5c, 0xce, 0xf0, 0xb8, 0x20, 0x8d, 0xf5,
+	0x65, 0x2b, 0xc8, 0xae, 0x70, 0xb2, 0x8b, 0xf8, 0x7c, 0x2c, 0x99, 0x98, 0x2b, 0xf0, 0xf7, 0x08,
+	0xd2, 0x81, 0xc1, 0x01, 0x2b, 0x71, 0xa9, 0xba, 0x87, 0x0f, 0x49, 0xed, 0xdb, 0x5e, 0xe0, 0x15,
+	0x39, 0xde, 0x18, 0xbe, 0x14, 0x8b, 0x17, 0x1c, 0x5a, 0xf0, 0x03, 0x04, 0x43, 0x9d, 0xc3, 0x44,
+	0xfc, 0xe3, 0x16, 0x31, 0xcb, 0x48, 0x53, 0xc9, 0x9c, 0xfa, 0xec, 0x52, 0xff, 0x92, 0x61, 0xea,
+	0x76, 0xf8, 0x1a, 0xda, 0x51, 0xdd, 0xa9, 0x06, 0xff, 0x84, 0x20, 0x1d, 0x98, 0x40, 0xe2, 0xcb,
+	0xdc, 0x3d, 0xf6, 0x48, 0x6a, 0xdf, 0xf6, 0x82, 0xf9, 0x35, 0xce, 0x3c, 0x83, 0xaf, 0x0d, 0xc0,
+	0xec, 0xcc, 0x3f, 0xf8, 0x0f, 0x04, 0x43, 0x9d, 0xf7, 0x7e, 0x7c, 0xd1, 0x23, 0x46, 0x24, 0x69,
+	0x2a, 0x99, 0x93, 0x10, 0xb0, 0xc4, 0x05, 0x2c, 0xe2, 0x85, 0x01, 0x04, 0x74, 0x8d, 0x24, 0xf8,
+	0x57, 0x04, 0x27, 0x3a, 0x53, 0x31, 0x9c, 0x88, 0xcc, 0x6f, 0xf9, 0x17, 0x13, 0x7a, 0x09, 0x41,
+	0x2f, 0x73, 0x41, 0xd3, 0x78, 0x6a, 0x7f, 0x41, 0xdd, 0x23, 0x15, 0xfe, 0x0d, 0x41, 0x26, 0x34,
+	0x11, 0xc4, 0xff, 0xcd, 0xf5, 0x9a, 0x92, 0xa4, 0x62, 0x02, 0x0f, 0x01, 0xfd, 0x36, 0x87, 0x9e,
+	0xc7, 0xb3, 0xd1, 0xd0, 0x35, 0x7d, 0xdf, 0x53, 0xe0, 0x47, 0xf0, 0x33, 0x82, 0x6c, 0x28, 0x09,
+	0xc3, 0xfd, 0x03, 0xf9, 0xc5, 0x9f, 0x48, 0xe2, 0xd2, 0xe7, 0x4d, 0xdf, 0xb3, 0xf2, 0x6e, 0xd9,
+	0xbf, 0x40, 0x70, 0xc8, 0x9d, 0x5d, 0xf0, 0xa5, 0xb8, 0xcc, 0xa1, 0x61, 0x49, 0xba, 0xdc, 0x8f,
+	0xa9, 0x80, 0xbb, 0xc0, 0xe1, 0x0a, 0x78, 0x38, 0xf2, 0xff, 0x90, 0x4f, 0x4e, 0x8b, 0x0f, 0x77,
+	0xf3, 0xe8, 0xd1, 0x6e, 0x1e, 0xfd, 0xb3, 0x9b, 0x47, 0x77, 0xf7, 0xf2, 0xa9, 0x47, 0x7b, 0xf9,
+	0xd4, 0x5f, 0x7b, 0xf9, 0xd4, 0x47, 0x57, 0x02, 0xef, 0x21, 0xde, 0xe3, 0x21, 0xe6, 0xeb, 0x44,
+	0x6f, 0x7a, 0xe1, 0xee, 0x78, 0x01, 0xf9, 0x1b, 0x89, 0xca, 0x21, 0xfe, 0x9e, 0x64, 0xf2, 0xff,
+	0x01, 0x00, 0x38, 0x68, 0xc6, 0x05, 0x22, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1071,6 +1302,10 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// ExchangeRate returns exchange rate of a pair
 	ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
+	// ExchangeRateTwap returns the time-weighted average exchange rate of a pair
+	ExchangeRateTwap(ctx context.Context, in *QueryExchangeRateTwapRequest, opts ...grpc.CallOption) (*QueryExchangeRateTwapResponse, error)
+	// ExchangeRateHistory returns the exchange rate snapshots of a pair
+	ExchangeRateHistory(ctx context.Context, in *QueryExchangeRateHistoryRequest, opts ...grpc.CallOption) (*QueryExchangeRateHistoryResponse, error)
 	// ExchangeRates returns exchange rates of all pairs
 	ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
 	// Actives returns all active pairs
@@ -1110,6 +1345,24 @@ func (c *queryClient) ExchangeRate(ctx context.Context, in *QueryExchangeRateReq
 	return out, nil
 }
 
+func (c *queryClient) ExchangeRateTwap(ctx context.Context, in *QueryExchangeRateTwapRequest, opts ...grpc.CallOption) (*QueryExchangeRateTwapResponse, error) {
+	out := new(QueryExchangeRateTwapResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1beta1.Query/ExchangeRateTwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExchangeRateHistory(ctx context.Context, in *QueryExchangeRateHistoryRequest, opts ...grpc.CallOption) (*QueryExchangeRateHistoryResponse, error) {
+	out := new(QueryExchangeRateHistoryResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1beta1.Query/ExchangeRateHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error) {
 	out := new(QueryExchangeRatesResponse)
 	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1beta1.Query/ExchangeRates", in, out, opts...)
@@ -1204,6 +1457,10 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 type QueryServer interface {
 	// ExchangeRate returns exchange rate of a pair
 	ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
+	// ExchangeRateTwap returns the time-weighted average exchange rate of a pair
+	ExchangeRateTwap(context.Context, *QueryExchangeRateTwapRequest) (*QueryExchangeRateTwapResponse, error)
+	// ExchangeRateHistory returns the exchange rate snapshots of a pair
+	ExchangeRateHistory(context.Context, *QueryExchangeRateHistoryRequest) (*QueryExchangeRateHistoryResponse, error)
 	// ExchangeRates returns exchange rates of all pairs
 	ExchangeRates(context.Context, *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error)
 	// Actives returns all active pairs
@@ -1233,6 +1490,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) ExchangeRate(ctx context.Context, req *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRate not implemented")
 }
+func (*UnimplementedQueryServer) ExchangeRateTwap(ctx context.Context, req *QueryExchangeRateTwapRequest) (*QueryExchangeRateTwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRateTwap not implemented")
+}
+func (*UnimplementedQueryServer) ExchangeRateHistory(ctx context.Context, req *QueryExchangeRateHistoryRequest) (*QueryExchangeRateHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRateHistory not implemented")
+}
 func (*UnimplementedQueryServer) ExchangeRates(ctx context.Context, req *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRates not implemented")
 }
@@ -1286,6 +1549,42 @@ func _Query_ExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ExchangeRateTwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRateTwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRateTwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1beta1.Query/ExchangeRateTwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRateTwap(ctx, req.(*QueryExchangeRateTwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExchangeRateHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRateHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRateHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1beta1.Query/ExchangeRateHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRateHistory(ctx, req.(*QueryExchangeRateHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryExchangeRatesRequest)
 	if err := dec(in); err != nil {
@@ -1474,6 +1773,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExchangeRate",
 			Handler:    _Query_ExchangeRate_Handler,
 		},
+		{
+			MethodName: "ExchangeRateTwap",
+			Handler:    _Query_ExchangeRateTwap_Handler,
+		},
+		{
+			MethodName: "ExchangeRateHistory",
+			Handler:    _Query_ExchangeRateHistory_Handler,
+		},
 		{
 			MethodName: "ExchangeRates",
 			Handler:    _Query_ExchangeRates_Handler,
@@ -1582,7 +1889,7 @@ func (m *QueryExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryExchangeRatesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryExchangeRateTwapRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1592,20 +1899,35 @@ func (m *QueryExchangeRatesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryExchangeRatesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryExchangeRateTwapRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryExchangeRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryExchangeRateTwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LookbackWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LookbackWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintQuery(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryExchangeRateTwapResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1615,34 +1937,30 @@ func (m *QueryExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryExchangeRateTwapResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryExchangeRateTwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ExchangeRates) > 0 {
-		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size := m.ExchangeRateTwap.Size()
+		i -= size
+		if _, err := m.ExchangeRateTwap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryActivesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryExchangeRateHistoryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1652,20 +1970,39 @@ func (m *QueryActivesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryActivesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryExchangeRateHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryActivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryExchangeRateHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryActivesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryExchangeRateHistoryResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1675,18 +2012,150 @@ func (m *QueryActivesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryActivesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryExchangeRateHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryActivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryExchangeRateHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Actives) > 0 {
-		for iNdEx := len(m.Actives) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PriceSnapshots) > 0 {
+		for iNdEx := len(m.PriceSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExchangeRates) > 0 {
+		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActivesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActivesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActivesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActivesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actives) > 0 {
+		for iNdEx := len(m.Actives) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Actives[iNdEx])
 			copy(dAtA[i:], m.Actives[iNdEx])
 			i = encodeVarintQuery(dAtA, i, uint64(len(m.Actives[iNdEx])))
@@ -2207,6 +2676,68 @@ func (m *QueryExchangeRateResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryExchangeRateTwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.LookbackWindow)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryExchangeRateTwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExchangeRateTwap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryExchangeRateHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExchangeRateHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceSnapshots) > 0 {
+		for _, e := range m.PriceSnapshots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryExchangeRatesRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2618,6 +3149,443 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryExchangeRateTwapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateTwapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.LookbackWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRateTwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateTwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTwap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRateTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRateHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRateHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceSnapshots = append(m.PriceSnapshots, PriceSnapshot{})
+			if err := m.PriceSnapshots[len(m.PriceSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
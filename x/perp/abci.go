@@ -0,0 +1,22 @@
+package perp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/NibiruChain/nibiru/x/perp/keeper"
+)
+
+// EndBlocker Called every block to halt the pairs whose mark price moved past the circuit breaker band,
+// to execute the resting orders whose trigger price was crossed
+// to settle the positions of the pairs which have been shut down,
+// to forget the liquidator priority windows of the positions which are healthy again
+// and to reset the count of the permissionless liquidations of the block.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
+	k.CheckCircuitBreakers(ctx)
+	k.ExecuteTriggeredOrders(ctx)
+	k.SettleShutdownPairs(ctx)
+	k.ClearHealthyLiquidatableSince(ctx)
+	k.ClearPermissionlessLiquidations(ctx)
+	return []abci.ValidatorUpdate{}
+}
@@ -23,6 +23,10 @@ import (
 	vpooltypes "github.com/NibiruChain/nibiru/x/vpool/types"
 )
 
+// positionTxGas is the gas limit of the txs that change positions, which read and write
+// more state than the default gas limit of the CLI allows for.
+var positionTxGas = testutilcli.WithTxGas(300_000)
+
 type IntegrationTestSuite struct {
 	suite.Suite
 
@@ -99,20 +103,14 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	// setup perp
 	perpGenesis := perptypes.DefaultGenesis()
 	perpGenesis.PairMetadata = []perptypes.PairMetadata{
-		{
-			Pair: common.Pair_BTC_NUSD,
-			CumulativePremiumFractions: []sdk.Dec{
-				sdk.ZeroDec(),
-				sdk.OneDec(),
-				sdk.NewDec(2),
-			},
-		},
-		{
-			Pair: common.Pair_ETH_NUSD,
-			CumulativePremiumFractions: []sdk.Dec{
-				sdk.ZeroDec(),
-			},
-		},
+		{Pair: common.Pair_BTC_NUSD},
+		{Pair: common.Pair_ETH_NUSD},
+	}
+	perpGenesis.CumulativePremiumFractions = []perptypes.CumulativePremiumFraction{
+		{Pair: common.Pair_BTC_NUSD, Epoch: 0, Value: sdk.ZeroDec()},
+		{Pair: common.Pair_BTC_NUSD, Epoch: 1, Value: sdk.OneDec()},
+		{Pair: common.Pair_BTC_NUSD, Epoch: 2, Value: sdk.NewDec(2)},
+		{Pair: common.Pair_ETH_NUSD, Epoch: 0, Value: sdk.ZeroDec()},
 	}
 	perpGenesis.Params.WhitelistedLiquidators = []string{"nibi1w89pf5yq8ntjg89048qmtaz929fdxup0a57d8m"} // address associated with mnemonic below
 	genesisState[perptypes.ModuleName] = encodingConfig.Marshaler.MustMarshalJSON(perpGenesis)
@@ -218,6 +216,7 @@ func (s *IntegrationTestSuite) TestOpenPositionsAndCloseCmd() {
 		/* leverage */ "1",
 		/* quoteAmt */ "1000000", // 10^6 uNUSD
 		/* baseAssetLimit */ "1"},
+		positionTxGas,
 	)
 	s.NoError(err)
 	s.EqualValues(abcitypes.CodeTypeOK, txResp.Code)
@@ -250,7 +249,7 @@ func (s *IntegrationTestSuite) TestOpenPositionsAndCloseCmd() {
 		/* leverage */ "2",
 		/* quoteAmt */ "1000000", // 10^6 uNUSD
 		/* baseAmtLimit */ "0",
-	})
+	}, positionTxGas)
 	s.NoError(err)
 	s.EqualValues(abcitypes.CodeTypeOK, txResp.Code)
 
@@ -274,7 +273,7 @@ func (s *IntegrationTestSuite) TestOpenPositionsAndCloseCmd() {
 		/* leverage */ "1",
 		/* quoteAmt */ "100", // 100 uNUSD
 		/* baseAssetLimit */ "1",
-	})
+	}, positionTxGas)
 	s.NoError(err)
 	s.EqualValues(abcitypes.CodeTypeOK, txResp.Code)
 
@@ -305,7 +304,7 @@ func (s *IntegrationTestSuite) TestOpenPositionsAndCloseCmd() {
 		/* leverage */ "1",
 		/* quoteAmt */ "4000000", // 4*10^6 uNUSD
 		/* baseAssetLimit */ "0",
-	})
+	}, positionTxGas)
 	s.NoError(err)
 	s.EqualValues(abcitypes.CodeTypeOK, txResp.Code)
 
@@ -323,14 +322,27 @@ func (s *IntegrationTestSuite) TestOpenPositionsAndCloseCmd() {
 	// there is a random delta due to twap margin ratio calculation and random block times in the in-process network
 	s.InDelta(1, queryResp.MarginRatioMark.MustFloat64(), 0.008)
 
-	s.T().Log("F. Close position")
+	s.T().Log("F. Close half of the position")
+	txResp, err = testutilcli.ExecTx(s.network, cli.ClosePositionCmd(), user, []string{
+		common.Pair_BTC_NUSD.String(),
+		"--" + cli.FlagFraction, "0.5",
+	}, positionTxGas)
+	s.NoError(err)
+	s.EqualValues(abcitypes.CodeTypeOK, txResp.Code)
+
+	s.T().Log("F. Check trader position")
+	queryResp, err = testutilcli.QueryPosition(val.ClientCtx, common.Pair_BTC_NUSD, user)
+	s.NoError(err)
+	s.InDelta(-83.343055856502701472, queryResp.Position.Size_.MustFloat64(), 0.000001)
+
+	s.T().Log("G. Close position")
 	txResp, err = testutilcli.ExecTx(s.network, cli.ClosePositionCmd(), user, []string{
 		common.Pair_BTC_NUSD.String(),
-	})
+	}, positionTxGas)
 	s.NoError(err)
 	s.EqualValues(abcitypes.CodeTypeOK, txResp.Code)
 
-	s.T().Log("F. check trader position")
+	s.T().Log("G. check trader position")
 	queryResp, err = testutilcli.QueryPosition(val.ClientCtx, common.Pair_BTC_NUSD, user)
 	s.Error(err)
 	s.T().Logf("query response: %+v", queryResp)
@@ -351,7 +363,7 @@ func (s *IntegrationTestSuite) TestPositionEmptyAndClose() {
 	// close position should produce error
 	_, err = testutilcli.ExecTx(s.network, cli.ClosePositionCmd(), user, []string{
 		common.Pair_ETH_NUSD.String(),
-	})
+	}, positionTxGas)
 	s.Contains(err.Error(), collections.ErrNotFound.Error())
 }
 
@@ -373,7 +385,7 @@ func (s *IntegrationTestSuite) TestRemoveMargin() {
 		"10", // Leverage
 		"1",  // Quote asset amount
 		"0.0000001",
-	})
+	}, positionTxGas)
 	s.NoError(err)
 	s.EqualValues(abcitypes.CodeTypeOK, txResp.Code)
 
@@ -382,7 +394,7 @@ func (s *IntegrationTestSuite) TestRemoveMargin() {
 	_, err = testutilcli.ExecTx(s.network, cli.RemoveMarginCmd(), s.users[0], []string{
 		common.Pair_BTC_NUSD.String(),
 		fmt.Sprintf("%s%s", "100", common.DenomNUSD),
-	})
+	}, positionTxGas)
 	s.Contains(err.Error(), perptypes.ErrFailedRemoveMarginCanCauseBadDebt.Error())
 }
 
@@ -398,7 +410,7 @@ func (s *IntegrationTestSuite) TestX_AddMargin() {
 		"10",    // Leverage
 		"10000", // Quote asset amount
 		"0.0000001",
-	})
+	}, positionTxGas)
 	s.NoError(err)
 	s.EqualValues(abcitypes.CodeTypeOK, txResp.Code)
 
@@ -430,7 +442,7 @@ func (s *IntegrationTestSuite) TestX_AddMargin() {
 	for _, tc := range testCases {
 		s.T().Run(tc.name, func(t *testing.T) {
 			s.T().Log("adding margin on user 3....")
-			txResp, err = testutilcli.ExecTx(s.network, cli.AddMarginCmd(), s.users[3], tc.args, testutilcli.WithTxCanFail(true))
+			txResp, err = testutilcli.ExecTx(s.network, cli.AddMarginCmd(), s.users[3], tc.args, testutilcli.WithTxCanFail(true), positionTxGas)
 			s.NoError(err)
 			s.EqualValues(tc.expectedCode, txResp.Code)
 
@@ -449,7 +461,7 @@ func (s *IntegrationTestSuite) TestLiquidate() {
 	_, err := testutilcli.ExecTx(s.network, cli.LiquidateCmd(), s.users[4], []string{
 		common.Pair_ETH_NUSD.String(),
 		s.users[1].String(),
-	})
+	}, positionTxGas)
 	s.Contains(err.Error(), collections.ErrNotFound.Error())
 
 	s.T().Log("opening a position with user 1....")
@@ -459,7 +471,7 @@ func (s *IntegrationTestSuite) TestLiquidate() {
 		"15",    // Leverage
 		"90000", // Quote asset amount
 		"0",
-	})
+	}, positionTxGas)
 	s.NoError(err)
 	s.EqualValues(abcitypes.CodeTypeOK, txResp.Code)
 
@@ -467,7 +479,7 @@ func (s *IntegrationTestSuite) TestLiquidate() {
 	_, err = testutilcli.ExecTx(s.network, cli.LiquidateCmd(), s.users[4], []string{
 		common.Pair_ETH_NUSD.String(),
 		s.users[1].String(),
-	})
+	}, positionTxGas)
 	s.Contains(err.Error(), "margin is higher than required maintenance margin ratio")
 
 	s.T().Log("opening a position with user 2...")
@@ -477,7 +489,7 @@ func (s *IntegrationTestSuite) TestLiquidate() {
 		"15",       // Leverage
 		"45000000", // Quote asset amount
 		"0",
-	})
+	}, positionTxGas)
 	s.NoError(err)
 	s.EqualValues(abcitypes.CodeTypeOK, txResp.Code)
 
@@ -491,7 +503,7 @@ func (s *IntegrationTestSuite) TestLiquidate() {
 	txResp, err = testutilcli.ExecTx(s.network, cli.LiquidateCmd(), s.users[4], []string{
 		common.Pair_ETH_NUSD.String(),
 		s.users[1].String(),
-	})
+	}, positionTxGas)
 	s.NoError(err)
 	s.EqualValues(abcitypes.CodeTypeOK, txResp.Code)
 }
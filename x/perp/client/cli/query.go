@@ -29,6 +29,16 @@ func GetQueryCmd() *cobra.Command {
 		CmdQueryPosition(),
 		CmdQueryPositions(),
 		CmdQueryFundingRates(),
+		CmdQueryOrders(),
+		CmdQueryMarginAccount(),
+		CmdQueryInsuranceFund(),
+		CmdQueryInsuranceFundWithdrawal(),
+		CmdQueryOpenInterest(),
+		CmdQueryPositionsByPair(),
+		CmdQueryLiquidatablePositions(),
+		CmdQueryEstimateOpenPosition(),
+		CmdQueryAutoDeleverageRank(),
+		CmdQuerySubaccounts(),
 	}
 	for _, cmd := range cmds {
 		perpQueryCmd.AddCommand(cmd)
@@ -177,3 +187,398 @@ func CmdQueryFundingRates() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "orders [trader] [token-pair]",
+		Short: "return a trader's resting orders, optionally filtered by token pair",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			trader, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid trader address: %w", err)
+			}
+
+			req := &types.QueryOrdersRequest{Trader: trader.String()}
+			if len(args) == 2 {
+				tokenPair, err := common.NewAssetPair(args[1])
+				if err != nil {
+					return err
+				}
+				req.TokenPair = tokenPair.String()
+			}
+
+			res, err := queryClient.QueryOrders(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryMarginAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "margin-account [trader] [quote-denom]",
+		Short: "return the margin mode of a trader and, for cross margin, the health of its account",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			trader, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid trader address: %w", err)
+			}
+
+			res, err := queryClient.QueryMarginAccount(
+				cmd.Context(), &types.QueryMarginAccountRequest{
+					Trader:     trader.String(),
+					QuoteDenom: args[1],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryInsuranceFund() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "insurance-fund [denom]",
+		Short: "return the assets, shares and share price of the insurance fund of a quote denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryInsuranceFund(
+				cmd.Context(), &types.QueryInsuranceFundRequest{
+					Denom: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryInsuranceFundWithdrawal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "insurance-fund-withdrawal [depositor] [denom]",
+		Short: "return the pending withdrawal of a depositor from the insurance fund of a quote denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			depositor, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid depositor address: %w", err)
+			}
+
+			res, err := queryClient.QueryInsuranceFundWithdrawal(
+				cmd.Context(), &types.QueryInsuranceFundWithdrawalRequest{
+					Depositor: depositor.String(),
+					Denom:     args[1],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// sample token-pair: btc:nusd
+func CmdQueryOpenInterest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open-interest [token-pair]",
+		Short: "return the long and short open interest of a pair, and its caps",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryOpenInterest(
+				cmd.Context(), &types.QueryOpenInterestRequest{
+					TokenPair: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// sample token-pair: btc:nusd
+func CmdQueryPositionsByPair() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "positions-by-pair [token-pair]",
+		Short: "return the open positions of a pair, with their liquidation prices",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryPositionsByPair(
+				cmd.Context(), &types.QueryPositionsByPairRequest{
+					TokenPair:  args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "positions-by-pair")
+
+	return cmd
+}
+
+// sample token-pair: btc:nusd
+func CmdQueryLiquidatablePositions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquidatable-positions [token-pair]",
+		Short: "return the positions of a pair which can be liquidated",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			limit, err := cmd.Flags().GetUint64(flags.FlagLimit)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryLiquidatablePositions(
+				cmd.Context(), &types.QueryLiquidatablePositionsRequest{
+					TokenPair: args[0],
+					Limit:     limit,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint64(flags.FlagLimit, 0, "maximum number of positions returned, defaults to 100")
+
+	return cmd
+}
+
+func CmdQueryEstimateOpenPosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-open-position [trader] [buy/sell] [pair] [leverage] [quoteAmt / sdk.Int] [baseAmtLimit / sdk.Int]",
+		Short: "preview opening, increasing, reducing or reversing a position without submitting it",
+		Args:  cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var side types.Side
+			switch args[1] {
+			case "buy":
+				side = types.Side_BUY
+			case "sell":
+				side = types.Side_SELL
+			default:
+				return fmt.Errorf("invalid side: %s", args[1])
+			}
+
+			leverage, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid leverage: %s", args[3])
+			}
+
+			quoteAmt, ok := sdk.NewIntFromString(args[4])
+			if !ok {
+				return fmt.Errorf("invalid quote amount: %s", args[4])
+			}
+
+			baseAmtLimit, ok := sdk.NewIntFromString(args[5])
+			if !ok {
+				return fmt.Errorf("invalid base amount limit: %s", args[5])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EstimateOpenPosition(
+				cmd.Context(), &types.QueryEstimateOpenPositionRequest{
+					TokenPair:            args[2],
+					Trader:               args[0],
+					Side:                 side,
+					QuoteAssetAmount:     quoteAmt,
+					Leverage:             leverage,
+					BaseAssetAmountLimit: baseAmtLimit,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryAutoDeleverageRank() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auto-deleverage-rank [trader] [token-pair]",
+		Short: "rank of the trader's position in the auto-deleverage queue of its side",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			trader, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid trader address: %w", err)
+			}
+
+			tokenPair, err := common.NewAssetPair(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryAutoDeleverageRank(
+				cmd.Context(), &types.QueryAutoDeleverageRankRequest{
+					Trader:    trader.String(),
+					TokenPair: tokenPair.String(),
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQuerySubaccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subaccounts [owner]",
+		Short: "the subaccounts of an owner, with their addresses",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid owner address: %w", err)
+			}
+
+			res, err := queryClient.QuerySubaccounts(
+				cmd.Context(), &types.QuerySubaccountsRequest{
+					Owner: owner.String(),
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -31,6 +32,16 @@ func GetTxCmd() *cobra.Command {
 		OpenPositionCmd(),
 		ClosePositionCmd(),
 		DonateToEcosystemFundCmd(),
+		PlaceLimitOrderCmd(),
+		PlaceCloseOrderCmd(),
+		CancelOrderCmd(),
+		SetMarginModeCmd(),
+		SettlePositionCmd(),
+		DepositToInsuranceFundCmd(),
+		RequestInsuranceFundWithdrawalCmd(),
+		WithdrawFromInsuranceFundCmd(),
+		SetTradingHaltCmd(),
+		TransferSubaccountMarginCmd(),
 	)
 
 	return txCmd
@@ -71,6 +82,11 @@ func OpenPositionCmd() *cobra.Command {
 
 			baseAmtLimit := sdk.MustNewDecFromStr(args[4])
 
+			subaccountID, err := cmd.Flags().GetUint64(FlagSubaccount)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgOpenPosition{
 				Sender:               clientCtx.GetFromAddress().String(),
 				TokenPair:            assetPair.String(),
@@ -78,6 +94,7 @@ func OpenPositionCmd() *cobra.Command {
 				QuoteAssetAmount:     amount,
 				Leverage:             leverage,
 				BaseAssetAmountLimit: baseAmtLimit.RoundInt(),
+				SubaccountId:         subaccountID,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -88,26 +105,70 @@ func OpenPositionCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(FlagSubaccount, 0, "subaccount of the sender trading, the sender itself by default")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
 // TODO: how is a position idenitfiied? by pair? by id?
+const (
+	// FlagSubaccount is the subaccount of the sender trading, 0 for the sender itself.
+	FlagSubaccount = "subaccount"
+	// FlagSize is the base size of the position to close.
+	FlagSize = "size"
+	// FlagFraction is the fraction of the position size to close.
+	FlagFraction = "fraction"
+	// FlagQuoteLimit is the slippage limit on the quote exchanged closing a position.
+	FlagQuoteLimit = "quote-limit"
+)
+
 func ClosePositionCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "close-position [pair]",
-		Short: "Closes a position",
-		Args:  cobra.ExactArgs(1),
+		Short: "Closes a position, entirely or partially",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			Closes the entire position, or the given base size or fraction of it without flipping its side.
+			The quote limit is the minimum quote received closing a long position, or the maximum quote
+			paid closing a short position.
+
+			$ %s tx perp close-position osmo:nusd
+			$ %s tx perp close-position osmo:nusd --size 10 --quote-limit 95
+			$ %s tx perp close-position osmo:nusd --fraction 0.5
+			`, version.AppName, version.AppName, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
+			decFlags := make(map[string]sdk.Dec)
+			for _, flag := range []string{FlagSize, FlagFraction, FlagQuoteLimit} {
+				value, err := cmd.Flags().GetString(flag)
+				if err != nil {
+					return err
+				}
+				decFlags[flag], err = sdk.NewDecFromStr(value)
+				if err != nil {
+					return fmt.Errorf("invalid %s: %w", flag, err)
+				}
+			}
+
+			subaccountID, err := cmd.Flags().GetUint64(FlagSubaccount)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgClosePosition{
-				Sender:    clientCtx.GetFromAddress().String(),
-				TokenPair: args[0],
+				Sender:                clientCtx.GetFromAddress().String(),
+				TokenPair:             args[0],
+				BaseAssetAmount:       decFlags[FlagSize],
+				Fraction:              decFlags[FlagFraction],
+				QuoteAssetAmountLimit: decFlags[FlagQuoteLimit],
+				SubaccountId:          subaccountID,
 			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
@@ -117,6 +178,10 @@ func ClosePositionCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagSize, "0", "base size of the position to close, the entire position by default")
+	cmd.Flags().String(FlagFraction, "0", "fraction of the position size to close, between 0 and 1")
+	cmd.Flags().String(FlagQuoteLimit, "0", "minimum quote received for a long, maximum quote paid for a short, 0 for no limit")
+	cmd.Flags().Uint64(FlagSubaccount, 0, "subaccount of the sender trading, the sender itself by default")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -147,10 +212,16 @@ func RemoveMarginCmd() *cobra.Command {
 				return err
 			}
 
+			subaccountID, err := cmd.Flags().GetUint64(FlagSubaccount)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgRemoveMargin{
-				Sender:    clientCtx.GetFromAddress().String(),
-				TokenPair: args[0],
-				Margin:    marginToRemove,
+				Sender:       clientCtx.GetFromAddress().String(),
+				TokenPair:    args[0],
+				Margin:       marginToRemove,
+				SubaccountId: subaccountID,
 			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
@@ -160,6 +231,7 @@ func RemoveMarginCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(FlagSubaccount, 0, "subaccount of the sender trading, the sender itself by default")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -186,10 +258,16 @@ func AddMarginCmd() *cobra.Command {
 				return err
 			}
 
+			subaccountID, err := cmd.Flags().GetUint64(FlagSubaccount)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgAddMargin{
-				Sender:    clientCtx.GetFromAddress().String(),
-				TokenPair: args[0],
-				Margin:    marginToAdd,
+				Sender:       clientCtx.GetFromAddress().String(),
+				TokenPair:    args[0],
+				Margin:       marginToAdd,
+				SubaccountId: subaccountID,
 			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
@@ -199,6 +277,7 @@ func AddMarginCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(FlagSubaccount, 0, "subaccount of the sender trading, the sender itself by default")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -280,3 +359,478 @@ func DonateToEcosystemFundCmd() *cobra.Command {
 
 	return cmd
 }
+
+func PlaceLimitOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-limit-order [buy/sell] [pair] [trigger-price] [leverage] [quoteAmt / sdk.Int] [baseAmtLimit / sdk.Dec]",
+		Short: "Places an order that opens a position once the mark price reaches the trigger price",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx perp place-limit-order buy ubtc:unusd 19000 10 100 0
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var side types.Side
+			switch args[0] {
+			case "buy":
+				side = types.Side_BUY
+			case "sell":
+				side = types.Side_SELL
+			default:
+				return fmt.Errorf("invalid side: %s", args[0])
+			}
+
+			assetPair, err := common.NewAssetPair(args[1])
+			if err != nil {
+				return err
+			}
+
+			triggerPrice, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid trigger price: %w", err)
+			}
+
+			leverage, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid leverage: %w", err)
+			}
+
+			amount, ok := sdk.NewIntFromString(args[4])
+			if !ok {
+				return fmt.Errorf("invalid quote amount: %s", args[4])
+			}
+
+			baseAmtLimit, err := sdk.NewDecFromStr(args[5])
+			if err != nil {
+				return fmt.Errorf("invalid base amount limit: %w", err)
+			}
+
+			subaccountID, err := cmd.Flags().GetUint64(FlagSubaccount)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgPlaceOrder{
+				Sender:               clientCtx.GetFromAddress().String(),
+				TokenPair:            assetPair.String(),
+				OrderType:            types.OrderType_LIMIT,
+				Side:                 side,
+				TriggerPrice:         triggerPrice,
+				QuoteAssetAmount:     amount,
+				Leverage:             leverage,
+				BaseAssetAmountLimit: baseAmtLimit.RoundInt(),
+				SubaccountId:         subaccountID,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagSubaccount, 0, "subaccount of the sender trading, the sender itself by default")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func PlaceCloseOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-close-order [stop-loss/take-profit] [pair] [trigger-price]",
+		Short: "Places an order that closes the open position once the mark price reaches the trigger price",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx perp place-close-order stop-loss ubtc:unusd 18000
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var orderType types.OrderType
+			switch args[0] {
+			case "stop-loss":
+				orderType = types.OrderType_STOP_LOSS
+			case "take-profit":
+				orderType = types.OrderType_TAKE_PROFIT
+			default:
+				return fmt.Errorf("invalid order type: %s", args[0])
+			}
+
+			assetPair, err := common.NewAssetPair(args[1])
+			if err != nil {
+				return err
+			}
+
+			triggerPrice, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid trigger price: %w", err)
+			}
+
+			subaccountID, err := cmd.Flags().GetUint64(FlagSubaccount)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgPlaceOrder{
+				Sender:               clientCtx.GetFromAddress().String(),
+				TokenPair:            assetPair.String(),
+				OrderType:            orderType,
+				TriggerPrice:         triggerPrice,
+				QuoteAssetAmount:     sdk.ZeroInt(),
+				Leverage:             sdk.ZeroDec(),
+				BaseAssetAmountLimit: sdk.ZeroInt(),
+				SubaccountId:         subaccountID,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagSubaccount, 0, "subaccount of the sender trading, the sender itself by default")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CancelOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-order [order-id]",
+		Short: "Cancels a resting order, returning its escrowed margin and deposit",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx perp cancel-order 1
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orderID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid order id: %w", err)
+			}
+
+			subaccountID, err := cmd.Flags().GetUint64(FlagSubaccount)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCancelOrder{
+				Sender:       clientCtx.GetFromAddress().String(),
+				OrderId:      orderID,
+				SubaccountId: subaccountID,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagSubaccount, 0, "subaccount of the sender trading, the sender itself by default")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func SetMarginModeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-margin-mode [isolated/cross]",
+		Short: "Switches between isolated and cross margin",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx perp set-margin-mode cross
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var marginMode types.MarginMode
+			switch args[0] {
+			case "isolated":
+				marginMode = types.MarginMode_ISOLATED
+			case "cross":
+				marginMode = types.MarginMode_CROSS
+			default:
+				return fmt.Errorf("invalid margin mode: %s", args[0])
+			}
+
+			subaccountID, err := cmd.Flags().GetUint64(FlagSubaccount)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetMarginMode{
+				Sender:       clientCtx.GetFromAddress().String(),
+				MarginMode:   marginMode,
+				SubaccountId: subaccountID,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagSubaccount, 0, "subaccount of the sender trading, the sender itself by default")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func SettlePositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settle-position [pair]",
+		Short: "Settles a position on a pair whose vpool has been shut down",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subaccountID, err := cmd.Flags().GetUint64(FlagSubaccount)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSettlePosition{
+				Sender:       clientCtx.GetFromAddress().String(),
+				TokenPair:    args[0],
+				SubaccountId: subaccountID,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagSubaccount, 0, "subaccount of the sender trading, the sender itself by default")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func DepositToInsuranceFundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-insurance-fund [amount]",
+		Short: "Deposits <amount> of quote tokens into their insurance fund in exchange for shares",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx perp deposit-insurance-fund 100unusd
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgDepositToInsuranceFund{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Deposit: deposit,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func RequestInsuranceFundWithdrawalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request-insurance-fund-withdrawal [shares]",
+		Short: "Escrows insurance fund <shares> and starts their withdrawal cooldown",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx perp request-insurance-fund-withdrawal 100perp/insurance/unusd
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			shares, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRequestInsuranceFundWithdrawal{
+				Sender: clientCtx.GetFromAddress().String(),
+				Shares: shares,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func WithdrawFromInsuranceFundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-insurance-fund [denom]",
+		Short: "Redeems the shares of a pending withdrawal from the insurance fund of <denom> once its cooldown is over",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx perp withdraw-insurance-fund unusd
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgWithdrawFromInsuranceFund{
+				Sender: clientCtx.GetFromAddress().String(),
+				Denom:  args[0],
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func SetTradingHaltCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-trading-halt [true/false] [pair]",
+		Short: "Halts or resumes trading on a pair, or on the whole exchange if no pair is given. Guardian only",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx perp set-trading-halt true ubtc:unusd
+			$ %s tx perp set-trading-halt false
+			`, version.AppName, version.AppName),
+		),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			halted, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetTradingHalt{
+				Sender: clientCtx.GetFromAddress().String(),
+				Halted: halted,
+			}
+			if len(args) == 2 {
+				msg.TokenPair = args[1]
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TransferSubaccountMarginCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-subaccount-margin [from-subaccount] [to-subaccount] [amount]",
+		Short: "Transfers margin between two subaccounts of the sender, 0 being the sender itself",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx perp transfer-subaccount-margin 0 1 100unusd
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid subaccount: %w", err)
+			}
+			toID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid subaccount: %w", err)
+			}
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgTransferSubaccountMargin{
+				Sender:           clientCtx.GetFromAddress().String(),
+				FromSubaccountId: fromID,
+				ToSubaccountId:   toID,
+				Amount:           amount,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
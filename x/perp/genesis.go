@@ -22,6 +22,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, p := range genState.Positions {
 		k.Positions.Insert(ctx, collections.Join(p.Pair, sdk.MustAccAddressFromBech32(p.TraderAddress)), p)
 	}
+	k.RebuildOpenInterests(ctx)
 
 	// set params
 	k.SetParams(ctx, genState.Params)
@@ -30,6 +31,37 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, pbd := range genState.PrepaidBadDebts {
 		k.PrepaidBadDebt.Insert(ctx, pbd.Denom, pbd)
 	}
+
+	// set resting orders
+	var nextOrderID = collections.DefaultSequenceStart
+	for _, o := range genState.Orders {
+		k.Orders.Insert(ctx, o.Id, o)
+		if o.Id >= nextOrderID {
+			nextOrderID = o.Id + 1
+		}
+	}
+	k.OrderID.Set(ctx, nextOrderID)
+
+	// set cumulative premium fractions
+	for _, f := range genState.CumulativePremiumFractions {
+		k.CumulativePremiumFractions.Insert(ctx, collections.Join(f.Pair, f.Epoch), f.Value)
+	}
+
+	// set pending insurance fund withdrawals
+	for _, w := range genState.InsuranceFundWithdrawals {
+		quoteDenom, _ := types.InsuranceFundQuoteDenom(w.Shares.Denom)
+		k.InsuranceFundWithdrawals.Insert(ctx, collections.Join(sdk.MustAccAddressFromBech32(w.Depositor), quoteDenom), w)
+	}
+
+	// set cross margin accounts
+	for _, trader := range genState.CrossMarginAccounts {
+		k.CrossMarginAccounts.Insert(ctx, sdk.MustAccAddressFromBech32(trader))
+	}
+
+	// set subaccounts
+	for _, s := range genState.Subaccounts {
+		k.Subaccounts.Insert(ctx, collections.Join(sdk.MustAccAddressFromBech32(s.Owner), s.Id), s)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -47,5 +79,30 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	// export pairMetadata
 	genesis.PairMetadata = k.PairsMetadata.Iterate(ctx, collections.Range[common.AssetPair]{}).Values()
 
+	// export resting orders
+	genesis.Orders = k.Orders.Iterate(ctx, collections.Range[uint64]{}).Values()
+
+	// export cross margin accounts
+	genesis.CrossMarginAccounts = []string{}
+	for _, trader := range k.CrossMarginAccounts.Iterate(ctx, collections.Range[sdk.AccAddress]{}).Keys() {
+		genesis.CrossMarginAccounts = append(genesis.CrossMarginAccounts, trader.String())
+	}
+
+	// export cumulative premium fractions
+	genesis.CumulativePremiumFractions = []types.CumulativePremiumFraction{}
+	for _, kv := range k.CumulativePremiumFractions.Iterate(ctx, collections.PairRange[common.AssetPair, uint64]{}).KeyValues() {
+		genesis.CumulativePremiumFractions = append(genesis.CumulativePremiumFractions, types.CumulativePremiumFraction{
+			Pair:  kv.Key.K1(),
+			Epoch: kv.Key.K2(),
+			Value: kv.Value,
+		})
+	}
+
+	// export pending insurance fund withdrawals
+	genesis.InsuranceFundWithdrawals = k.InsuranceFundWithdrawals.Iterate(ctx, collections.PairRange[sdk.AccAddress, string]{}).Values()
+
+	// export subaccounts
+	genesis.Subaccounts = k.Subaccounts.Iterate(ctx, collections.PairRange[sdk.AccAddress, uint64]{}).Values()
+
 	return genesis
 }
@@ -18,6 +18,8 @@ import (
 	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/perp"
 	"github.com/NibiruChain/nibiru/x/perp/types"
+	pricefeedtypes "github.com/NibiruChain/nibiru/x/pricefeed/types"
+	vpooltypes "github.com/NibiruChain/nibiru/x/vpool/types"
 )
 
 func TestGenesis(t *testing.T) {
@@ -28,12 +30,17 @@ func TestGenesis(t *testing.T) {
 
 		// create some params
 		app.PerpKeeper.SetParams(ctx, types.Params{
-			Stopped:                 true,
-			FeePoolFeeRatio:         sdk.MustNewDecFromStr("0.00001"),
-			EcosystemFundFeeRatio:   sdk.MustNewDecFromStr("0.000005"),
-			LiquidationFeeRatio:     sdk.MustNewDecFromStr("0.000007"),
-			PartialLiquidationRatio: sdk.MustNewDecFromStr("0.00001"),
-			TwapLookbackWindow:      15 * time.Minute,
+			Stopped:                  true,
+			FeePoolFeeRatio:          sdk.MustNewDecFromStr("0.00001"),
+			EcosystemFundFeeRatio:    sdk.MustNewDecFromStr("0.000005"),
+			LiquidationFeeRatio:      sdk.MustNewDecFromStr("0.000007"),
+			PartialLiquidationRatio:  sdk.MustNewDecFromStr("0.00001"),
+			TwapLookbackWindow:       15 * time.Minute,
+			InsuranceFundFeeShare:    sdk.ZeroDec(),
+			CircuitBreakerBand:       sdk.ZeroDec(),
+			MaxLiquidatorRewardRatio: sdk.MustNewDecFromStr("0.5"),
+			MakerRebateRatio:         sdk.ZeroDec(),
+			OrderDeposit:             sdk.ZeroInt(),
 		})
 
 		// create some positions
@@ -58,11 +65,29 @@ func TestGenesis(t *testing.T) {
 			})
 		}
 
+		// create some pending insurance fund withdrawals
+		for i := int64(0); i < 10; i++ {
+			depositor := testutil.AccAddress()
+			app.PerpKeeper.InsuranceFundWithdrawals.Insert(ctx, collections.Join(depositor, common.DenomNUSD), types.InsuranceFundWithdrawal{
+				Depositor:  depositor.String(),
+				Shares:     sdk.NewInt64Coin(types.InsuranceFundShareDenom(common.DenomNUSD), i+1),
+				UnlockTime: time.Unix(i, 0).UTC(),
+			})
+		}
+
+		// create some subaccounts
+		for i := uint64(1); i <= 10; i++ {
+			owner := testutil.AccAddress()
+			app.PerpKeeper.Subaccounts.Insert(ctx, collections.Join(owner, i), types.NewSubaccount(owner, i))
+		}
+
 		// export genesis
 		genState := perp.ExportGenesis(ctx, app.PerpKeeper)
 
-		// create new context and init genesis
+		// create new context and init genesis, after the modules whose snapshots perp relies on
 		ctx, _ = ctxUncached.CacheContext()
+		app.PricefeedKeeper.SetParams(ctx, pricefeedtypes.DefaultParams())
+		app.VpoolKeeper.SetParams(ctx, vpooltypes.DefaultParams())
 		perp.InitGenesis(ctx, app.PerpKeeper, *genState)
 
 		// export again to ensure they match
@@ -73,9 +98,19 @@ func TestGenesis(t *testing.T) {
 		}
 		require.Equalf(t, genState.PairMetadata, genStateAfterInit.PairMetadata, "%s <-> %s", genState.PairMetadata, genStateAfterInit.PairMetadata)
 		require.Equal(t, genState.PrepaidBadDebts, genStateAfterInit.PrepaidBadDebts)
+		require.Len(t, genStateAfterInit.InsuranceFundWithdrawals, 10)
+		require.Equal(t, genState.InsuranceFundWithdrawals, genStateAfterInit.InsuranceFundWithdrawals)
+		require.Len(t, genStateAfterInit.Subaccounts, 10)
+		require.Equal(t, genState.Subaccounts, genStateAfterInit.Subaccounts)
 		require.Equal(t, len(genState.Positions), len(genStateAfterInit.Positions))
 		for i, pos := range genState.Positions {
 			require.Equalf(t, pos, genStateAfterInit.Positions[i], "%s <-> %s", pos, genStateAfterInit.Positions[i])
 		}
+
+		// the open interest is rebuilt from the positions: 1 + 2 + ... + 100
+		require.Equal(t,
+			types.OpenInterest{Pair: common.Pair_NIBI_NUSD, Long: sdk.NewDec(5050), Short: sdk.ZeroDec()},
+			app.PerpKeeper.GetOpenInterest(ctx, common.Pair_NIBI_NUSD),
+		)
 	})
 }
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/NibiruChain/nibiru/x/perp/keeper"
 	"github.com/NibiruChain/nibiru/x/perp/types"
@@ -40,6 +41,27 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgClosePosition:
 			res, err := msgServer.ClosePosition(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgPlaceOrder:
+			res, err := msgServer.PlaceOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelOrder:
+			res, err := msgServer.CancelOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetMarginMode:
+			res, err := msgServer.SetMarginMode(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSettlePosition:
+			res, err := msgServer.SettlePosition(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDepositToInsuranceFund:
+			res, err := msgServer.DepositToInsuranceFund(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRequestInsuranceFundWithdrawal:
+			res, err := msgServer.RequestInsuranceFundWithdrawal(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgWithdrawFromInsuranceFund:
+			res, err := msgServer.WithdrawFromInsuranceFund(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			errMsg := fmt.Sprintf(
 				"unrecognized %s message type: %T", types.ModuleName, msg)
@@ -47,3 +69,19 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		}
 	}
 }
+
+/*
+NewParamChangeProposalHandler wraps the handler of the param change proposals so that
+a change leaving the index price or the reserve snapshots pruned before the x/perp TWAP
+lookback window is over is rejected, whether it changes the lookback window or one of
+the retention windows of x/pricefeed and x/vpool.
+The proposal is handled in a cached context which is dropped on error.
+*/
+func NewParamChangeProposalHandler(k keeper.Keeper, handler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if err := handler(ctx, content); err != nil {
+			return err
+		}
+		return k.CheckSnapshotRetentionWindows(ctx)
+	}
+}
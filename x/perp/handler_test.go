@@ -0,0 +1,44 @@
+package perp_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/stretchr/testify/require"
+
+	simapp2 "github.com/NibiruChain/nibiru/simapp"
+	"github.com/NibiruChain/nibiru/x/perp"
+	vpooltypes "github.com/NibiruChain/nibiru/x/vpool/types"
+)
+
+func TestParamChangeProposalHandler(t *testing.T) {
+	app, ctx := simapp2.NewTestNibiruAppAndContext(true)
+	handler := perp.NewParamChangeProposalHandler(app.PerpKeeper, params.NewParamChangeProposalHandler(app.ParamsKeeper))
+	twapLookbackWindow := app.PerpKeeper.GetParams(ctx).TwapLookbackWindow
+
+	t.Log("a reserve snapshot retention window shorter than the twap lookback window is rejected")
+	cachedCtx, _ := ctx.CacheContext()
+	err := handler(cachedCtx, &paramsproposal.ParameterChangeProposal{
+		Title:       "shorten the reserve snapshot retention",
+		Description: "keep the reserve snapshots for less than the twap lookback window",
+		Changes: []paramsproposal.ParamChange{
+			paramsproposal.NewParamChange(vpooltypes.ModuleName, string(vpooltypes.KeySnapshotRetentionWindow),
+				fmt.Sprintf(`"%d"`, twapLookbackWindow/2)),
+		},
+	})
+	require.ErrorContains(t, err, "shorter than the twap lookback window")
+
+	t.Log("a retention window covering the twap lookback window goes through")
+	err = handler(ctx, &paramsproposal.ParameterChangeProposal{
+		Title:       "lengthen the reserve snapshot retention",
+		Description: "keep the reserve snapshots for twice the twap lookback window",
+		Changes: []paramsproposal.ParamChange{
+			paramsproposal.NewParamChange(vpooltypes.ModuleName, string(vpooltypes.KeySnapshotRetentionWindow),
+				fmt.Sprintf(`"%d"`, 2*twapLookbackWindow)),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2*twapLookbackWindow, app.VpoolKeeper.GetParams(ctx).SnapshotRetentionWindow)
+}
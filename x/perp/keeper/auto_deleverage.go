@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+)
+
+// autoDeleverageEntry is a profitable position in the auto-deleverage queue of its side.
+type autoDeleverageEntry struct {
+	position         types.Position
+	positionNotional sdk.Dec
+	unrealizedPnl    sdk.Dec
+	// score is the unrealized PnL times the effective leverage of the position.
+	score sdk.Dec
+}
+
+/*
+autoDeleverageQueue returns the profitable positions of one side of the pair, the ones
+reduced first when a bad debt can't be covered by the insurance fund and the PerpEF.
+They are ranked by their unrealized PnL times their effective leverage, the position
+notional over the margin plus the unrealized PnL, the highest first.
+*/
+func (k Keeper) autoDeleverageQueue(ctx sdk.Context, pair common.AssetPair, long bool) []autoDeleverageEntry {
+	var queue []autoDeleverageEntry
+
+	iter := k.Positions.Indexes.PositionsByPair.ExactMatch(ctx, pair)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		position, err := k.Positions.Get(ctx, iter.PrimaryKey())
+		if err != nil {
+			panic(err)
+		}
+		if position.Size_.IsPositive() != long || position.Size_.IsZero() {
+			continue
+		}
+
+		positionNotional, unrealizedPnl, err := k.getPositionNotionalAndUnrealizedPnL(
+			ctx, position, types.PnLCalcOption_SPOT_PRICE)
+		if err != nil {
+			k.Logger(ctx).Error("failed to rank position for auto-deleveraging",
+				"pair", pair.String(), "trader", position.TraderAddress, "error", err)
+			continue
+		}
+		equity := position.Margin.Add(unrealizedPnl)
+		if !unrealizedPnl.IsPositive() || !equity.IsPositive() {
+			continue
+		}
+
+		queue = append(queue, autoDeleverageEntry{
+			position:         position,
+			positionNotional: positionNotional,
+			unrealizedPnl:    unrealizedPnl,
+			score:            unrealizedPnl.Mul(positionNotional).Quo(equity),
+		})
+	}
+
+	sort.SliceStable(queue, func(i, j int) bool {
+		if !queue[i].score.Equal(queue[j].score) {
+			return queue[i].score.GT(queue[j].score)
+		}
+		return queue[i].position.TraderAddress < queue[j].position.TraderAddress
+	})
+	return queue
+}
+
+/*
+autoDeleverage covers a bad debt left uncovered by the insurance fund and the PerpEF
+by reducing the profitable positions on the other side of the bankrupt position, in
+the order of the auto-deleverage queue, and keeping their realized PnL up to the
+shortfall. A position that can't be reduced is skipped, and whatever the queue can't
+cover is logged.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair of the bankrupt position
+  - bankruptSize: the size of the bankrupt position, whose sign gives its side
+  - shortfall: the bad debt left uncovered, in quote units
+*/
+func (k Keeper) autoDeleverage(ctx sdk.Context, pair common.AssetPair, bankruptSize sdk.Dec, shortfall sdk.Int) error {
+	remaining := shortfall.ToDec()
+	queue := k.autoDeleverageQueue(ctx, pair, bankruptSize.IsNegative())
+	for i, entry := range queue {
+		if !remaining.IsPositive() {
+			break
+		}
+
+		// a position failing to reduce is rolled back, events included
+		cachedCtx, write := ctx.CacheContext()
+		cachedCtx = cachedCtx.WithEventManager(sdk.NewEventManager())
+		haircut, err := k.deleveragePosition(cachedCtx, entry.position, remaining, uint64(i+1))
+		if err != nil {
+			k.Logger(ctx).Error("failed to auto-deleverage position",
+				"pair", pair.String(), "trader", entry.position.TraderAddress, "error", err)
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+		remaining = remaining.Sub(haircut)
+	}
+
+	if remaining.IsPositive() {
+		k.Logger(ctx).Error("bad debt left uncovered after auto-deleveraging",
+			"pair", pair.String(), "uncovered", remaining)
+	}
+	return nil
+}
+
+// deleveragePosition reduces the queued position so that its realized PnL covers the
+// target, closing it if its whole unrealized PnL is needed, and takes the realized PnL,
+// up to the target, off its margin. The position is read again from the store, since the
+// positions deleveraged before it move the mark price. Returns the haircut taken.
+func (k Keeper) deleveragePosition(
+	ctx sdk.Context, queued types.Position, target sdk.Dec, rank uint64,
+) (haircut sdk.Dec, err error) {
+	trader, err := sdk.AccAddressFromBech32(queued.TraderAddress)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	position, err := k.Positions.Get(ctx, collections.Join(queued.Pair, trader))
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if position.Size_.IsZero() || position.Size_.IsPositive() != queued.Size_.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("position changed side since it was queued")
+	}
+	positionNotional, unrealizedPnl, err := k.getPositionNotionalAndUnrealizedPnL(
+		ctx, position, types.PnLCalcOption_SPOT_PRICE)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if !unrealizedPnl.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("position is no longer profitable")
+	}
+
+	var positionResp *types.PositionResp
+	if target.GTE(unrealizedPnl) {
+		positionResp, err = k.closePositionEntirely(
+			ctx,
+			position,
+			/* quoteAssetAmountLimit */ sdk.ZeroDec(),
+			/* skipFluctuationLimitCheck */ true,
+		)
+	} else {
+		positionResp, err = k.decreasePosition(
+			ctx,
+			position,
+			/* decreasedNotional */ positionNotional.Mul(target).Quo(unrealizedPnl),
+			/* baseAmtLimit */ sdk.ZeroDec(),
+			/* skipFluctuationLimitCheck */ true,
+		)
+	}
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	haircut = sdk.MinDec(sdk.MaxDec(positionResp.RealizedPnl, sdk.ZeroDec()), target)
+	k.clearLiquidatableSince(ctx, position.Pair, trader)
+	if positionResp.Position.Size_.IsZero() {
+		payout := positionResp.MarginToVault.Neg().Sub(haircut).RoundInt()
+		if _, err = k.withdrawPayout(ctx, position.Pair, trader, payout); err != nil {
+			return sdk.Dec{}, err
+		}
+	} else {
+		positionResp.Position.Margin = positionResp.Position.Margin.Sub(haircut)
+		k.Positions.Insert(ctx, collections.Join(position.Pair, trader), *positionResp.Position)
+	}
+
+	markPrice, err := k.VpoolKeeper.GetMarkPrice(ctx, position.Pair)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return haircut, ctx.EventManager().EmitTypedEvent(&types.PositionAutoDeleveragedEvent{
+		Pair:                  position.Pair.String(),
+		TraderAddress:         position.TraderAddress,
+		ExchangedPositionSize: positionResp.ExchangedPositionSize,
+		RealizedPnl:           positionResp.RealizedPnl,
+		Haircut:               sdk.NewCoin(position.Pair.QuoteDenom(), haircut.RoundInt()),
+		Rank:                  rank,
+		MarkPrice:             markPrice,
+		BlockHeight:           ctx.BlockHeight(),
+	})
+}
@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	perpkeeper "github.com/NibiruChain/nibiru/x/perp/keeper"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+	"github.com/NibiruChain/nibiru/x/vpool"
+)
+
+func TestAutoDeleverage(t *testing.T) {
+	pair := common.MustNewAssetPair("xxx:yyy")
+	nibiruApp, ctx := setupOpenInterestPool(t, pair, sdk.ZeroDec(), sdk.ZeroDec())
+	perpKeeper := nibiruApp.PerpKeeper
+	queryServer := perpkeeper.NewQuerier(perpKeeper)
+
+	oracle := testutil.AccAddress()
+	nibiruApp.PricefeedKeeper.WhitelistOraclesForPairs(ctx, []sdk.AccAddress{oracle}, []common.AssetPair{pair})
+	require.NoError(t, nibiruApp.PricefeedKeeper.PostRawPrice(ctx, oracle, pair.String(), sdk.OneDec(), ctx.BlockTime().Add(time.Hour)))
+	require.NoError(t, nibiruApp.PricefeedKeeper.GatherRawPrices(ctx, pair.BaseDenom(), pair.QuoteDenom()))
+
+	t.Log("without trading fees, the insurance fund and the PerpEF stay empty")
+	liquidator := testutil.AccAddress()
+	params := perpKeeper.GetParams(ctx)
+	params.FeePoolFeeRatio = sdk.ZeroDec()
+	params.EcosystemFundFeeRatio = sdk.ZeroDec()
+	params.WhitelistedLiquidators = []string{liquidator.String()}
+	perpKeeper.SetParams(ctx, params)
+
+	alice, bob, carol, dave := testutil.AccAddress(), testutil.AccAddress(), testutil.AccAddress(), testutil.AccAddress()
+	for _, trader := range []sdk.AccAddress{alice, bob, carol, dave} {
+		require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin("yyy", 100_000))))
+	}
+
+	t.Log("bob goes long at 10x, carol and dave short at 5x and 2x")
+	for _, trade := range []struct {
+		trader   sdk.AccAddress
+		side     types.Side
+		leverage int64
+	}{
+		{bob, types.Side_BUY, 10},
+		{carol, types.Side_SELL, 5},
+		{dave, types.Side_SELL, 2},
+	} {
+		_, err := perpKeeper.OpenPosition(ctx, pair, trade.side, trade.trader, sdk.NewInt(1_000), sdk.NewDec(trade.leverage), sdk.ZeroDec())
+		require.NoError(t, err)
+	}
+
+	t.Log("alice dumps the price, making bob bankrupt")
+	_, err := perpKeeper.OpenPosition(ctx, pair, types.Side_SELL, alice, sdk.NewInt(100_000), sdk.NewDec(2), sdk.ZeroDec())
+	require.NoError(t, err)
+	vpool.EndBlocker(ctx, nibiruApp.VpoolKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Hour))
+
+	t.Log("carol ranks before dave, and alice's short is at a loss at the spot price")
+	wantRanks := map[string]uint64{alice.String(): 0, carol.String(): 1, dave.String(): 2}
+	for trader, wantRank := range wantRanks {
+		resp, err := queryServer.QueryAutoDeleverageRank(sdk.WrapSDKContext(ctx), &types.QueryAutoDeleverageRankRequest{
+			TokenPair: pair.String(),
+			Trader:    trader,
+		})
+		require.NoError(t, err)
+		assert.EqualValues(t, wantRank, resp.Rank, trader)
+		assert.EqualValues(t, 2, resp.QueueSize)
+		assert.Equal(t, wantRank > 0, resp.Score.IsPositive())
+	}
+
+	t.Log("bob's long is not queued")
+	resp, err := queryServer.QueryAutoDeleverageRank(sdk.WrapSDKContext(ctx), &types.QueryAutoDeleverageRankRequest{
+		TokenPair: pair.String(),
+		Trader:    bob.String(),
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, resp.Rank)
+	assert.EqualValues(t, 0, resp.QueueSize)
+
+	_, err = queryServer.QueryAutoDeleverageRank(sdk.WrapSDKContext(ctx), &types.QueryAutoDeleverageRankRequest{
+		TokenPair: pair.String(),
+		Trader:    testutil.AccAddress().String(),
+	})
+	require.Error(t, err)
+
+	t.Log("closing bob's long pushes alice's short into profit, so she is deleveraged first")
+	alicePosition, err := perpKeeper.Positions.Get(ctx, collections.Join(pair, alice))
+	require.NoError(t, err)
+	positionsBefore := map[string]types.Position{}
+	for _, trader := range []sdk.AccAddress{carol, dave} {
+		positionsBefore[trader.String()] = perpKeeper.Positions.GetOr(ctx, collections.Join(pair, trader), types.Position{})
+	}
+	_, _, err = perpKeeper.Liquidate(ctx, liquidator, pair, bob)
+	require.NoError(t, err)
+
+	var liquidated *types.PositionLiquidatedEvent
+	var deleveraged []*types.PositionAutoDeleveragedEvent
+	for _, event := range ctx.EventManager().Events() {
+		switch event.Type {
+		case proto.MessageName(&types.PositionLiquidatedEvent{}):
+			typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(t, err)
+			liquidated = typedEvent.(*types.PositionLiquidatedEvent)
+		case proto.MessageName(&types.PositionAutoDeleveragedEvent{}):
+			typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(t, err)
+			deleveraged = append(deleveraged, typedEvent.(*types.PositionAutoDeleveragedEvent))
+		}
+	}
+	require.NotNil(t, liquidated)
+	require.True(t, liquidated.BadDebt.IsPositive())
+	require.Len(t, deleveraged, 1)
+	assert.Equal(t, alice.String(), deleveraged[0].TraderAddress)
+	assert.EqualValues(t, 1, deleveraged[0].Rank)
+	assert.Equal(t, liquidated.BadDebt, deleveraged[0].Haircut)
+	assert.Equal(t, liquidated.MarkPrice, deleveraged[0].MarkPrice)
+
+	t.Log("alice's short is reduced, not closed, and carol and dave are untouched")
+	position, err := perpKeeper.Positions.Get(ctx, collections.Join(pair, alice))
+	require.NoError(t, err)
+	assert.True(t, position.Size_.IsNegative())
+	assert.True(t, position.Size_.GT(alicePosition.Size_))
+	assert.Equal(t, alicePosition.Size_.Add(deleveraged[0].ExchangedPositionSize), position.Size_)
+	assert.Equal(t,
+		alicePosition.Margin.Add(deleveraged[0].RealizedPnl).Sub(deleveraged[0].Haircut.Amount.ToDec()),
+		position.Margin)
+	for _, trader := range []sdk.AccAddress{carol, dave} {
+		position, err := perpKeeper.Positions.Get(ctx, collections.Join(pair, trader))
+		require.NoError(t, err)
+		assert.Equal(t, positionsBefore[trader.String()], position)
+	}
+}
+
+func TestPayoutShortfallCutsReceiver(t *testing.T) {
+	pair := common.MustNewAssetPair("xxx:yyy")
+	nibiruApp, ctx := setupOpenInterestPool(t, pair, sdk.ZeroDec(), sdk.ZeroDec())
+	perpKeeper := nibiruApp.PerpKeeper
+
+	oracle := testutil.AccAddress()
+	nibiruApp.PricefeedKeeper.WhitelistOraclesForPairs(ctx, []sdk.AccAddress{oracle}, []common.AssetPair{pair})
+	require.NoError(t, nibiruApp.PricefeedKeeper.PostRawPrice(ctx, oracle, pair.String(), sdk.OneDec(), ctx.BlockTime().Add(time.Hour)))
+	require.NoError(t, nibiruApp.PricefeedKeeper.GatherRawPrices(ctx, pair.BaseDenom(), pair.QuoteDenom()))
+
+	t.Log("without trading fees, the insurance fund and the PerpEF stay empty")
+	params := perpKeeper.GetParams(ctx)
+	params.FeePoolFeeRatio = sdk.ZeroDec()
+	params.EcosystemFundFeeRatio = sdk.ZeroDec()
+	perpKeeper.SetParams(ctx, params)
+
+	alice, carol, dave := testutil.AccAddress(), testutil.AccAddress(), testutil.AccAddress()
+	for _, trader := range []sdk.AccAddress{alice, carol, dave} {
+		require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin("yyy", 100_000))))
+	}
+
+	t.Log("carol and alice go short, and dave's short puts them in profit")
+	for _, trade := range []struct {
+		trader   sdk.AccAddress
+		side     types.Side
+		quote    int64
+		leverage int64
+	}{
+		{carol, types.Side_SELL, 1_000, 5},
+		{alice, types.Side_SELL, 10_000, 2},
+		{dave, types.Side_SELL, 20_000, 2},
+	} {
+		_, err := perpKeeper.OpenPosition(ctx, pair, trade.side, trade.trader, sdk.NewInt(trade.quote), sdk.NewDec(trade.leverage), sdk.ZeroDec())
+		require.NoError(t, err)
+	}
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Hour))
+
+	t.Log("the vault is drained down to alice's margin")
+	vaultBalance := moduleBalance(nibiruApp, ctx, types.VaultModuleAccount, "yyy")
+	require.NoError(t, nibiruApp.BankKeeper.SendCoinsFromModuleToAccount(
+		ctx, types.VaultModuleAccount, testutil.AccAddress(), sdk.NewCoins(sdk.NewCoin("yyy", vaultBalance.SubRaw(5_000)))))
+	carolBefore, err := perpKeeper.Positions.Get(ctx, collections.Join(pair, carol))
+	require.NoError(t, err)
+
+	t.Log("alice closes her short in profit, she is cut short rather than carol being deleveraged")
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	aliceBefore := nibiruApp.BankKeeper.GetBalance(ctx, alice, "yyy").Amount
+	_, err = perpKeeper.ClosePosition(ctx, pair, alice)
+	require.NoError(t, err)
+
+	var deleveraged []*types.PositionAutoDeleveragedEvent
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != proto.MessageName(&types.PositionAutoDeleveragedEvent{}) {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		deleveraged = append(deleveraged, typedEvent.(*types.PositionAutoDeleveragedEvent))
+	}
+	require.Len(t, deleveraged, 1)
+	assert.Equal(t, pair.String(), deleveraged[0].Pair)
+	assert.Equal(t, alice.String(), deleveraged[0].TraderAddress)
+	assert.EqualValues(t, 0, deleveraged[0].Rank)
+	assert.True(t, deleveraged[0].Haircut.IsPositive())
+
+	t.Log("alice gets what the vault held, and the loss isn't charged to carol as well")
+	assert.EqualValues(t, 5_000, nibiruApp.BankKeeper.GetBalance(ctx, alice, "yyy").Amount.Sub(aliceBefore).Int64())
+	assert.True(t, moduleBalance(nibiruApp, ctx, types.VaultModuleAccount, "yyy").IsZero())
+	carolAfter, err := perpKeeper.Positions.Get(ctx, collections.Join(pair, carol))
+	require.NoError(t, err)
+	assert.Equal(t, carolBefore, carolAfter)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	"github.com/NibiruChain/nibiru/collections"
 	"github.com/NibiruChain/nibiru/x/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -83,7 +84,8 @@ Args:
 
 Returns:
 - freeCollateral: Amount of collateral (margin) that can be removed from the
-position without making it go underwater.
+position without making it go underwater. For cross margin accounts, this is
+the free collateral of the whole account.
 - err: error
 */
 func (k Keeper) calcFreeCollateral(
@@ -97,6 +99,10 @@ func (k Keeper) calcFreeCollateral(
 		return sdk.Dec{}, err
 	}
 
+	if k.isCrossMarginPosition(ctx, pos) {
+		return k.calcAccountFreeCollateral(ctx, k.getAccountPositions(ctx, pos))
+	}
+
 	positionNotional, unrealizedPnL, err := k.
 		GetPreferencePositionNotionalAndUnrealizedPnL(
 			ctx,
@@ -115,12 +121,16 @@ func (k Keeper) calcFreeCollateral(
 }
 
 // getLatestCumulativePremiumFraction returns the last cumulative funding rate recorded for the
-// specific pair.
+// specific pair, or zero if no funding epoch of the pair has ended yet.
 func (k Keeper) getLatestCumulativePremiumFraction(
 	ctx sdk.Context, pair common.AssetPair,
 ) (sdk.Dec, error) {
-	pairMetadata, err := k.PairsMetadata.Get(ctx, pair)
-	if err != nil {
+	_, cumulativePremiumFraction, found := k.getLatestFundingEpoch(ctx, pair)
+	if found {
+		return cumulativePremiumFraction, nil
+	}
+
+	if _, err := k.PairsMetadata.Get(ctx, pair); err != nil {
 		k.Logger(ctx).Error(
 			err.Error(),
 			"pair",
@@ -128,6 +138,22 @@ func (k Keeper) getLatestCumulativePremiumFraction(
 		)
 		return sdk.Dec{}, err
 	}
-	// this should never fail
-	return pairMetadata.CumulativePremiumFractions[len(pairMetadata.CumulativePremiumFractions)-1], nil
+	return sdk.ZeroDec(), nil
+}
+
+// getLatestFundingEpoch returns the last funding epoch recorded for the pair and its
+// cumulative premium fraction. found is false if no funding epoch was recorded.
+func (k Keeper) getLatestFundingEpoch(
+	ctx sdk.Context, pair common.AssetPair,
+) (epoch uint64, cumulativePremiumFraction sdk.Dec, found bool) {
+	iter := k.CumulativePremiumFractions.Iterate(
+		ctx,
+		collections.PairRange[common.AssetPair, uint64]{}.Prefix(pair).Descending(),
+	)
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, sdk.Dec{}, false
+	}
+	return iter.Key().K2(), iter.Value(), true
 }
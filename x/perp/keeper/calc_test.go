@@ -54,15 +54,17 @@ func TestCalcRemainMarginWithFundingPayment(t *testing.T) {
 					/* maxOracleSpreadRatio */ sdk.MustNewDecFromStr("1.0"), // 100%
 					/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
 					/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+					/* maxOpenInterest */ sdk.ZeroDec(),
+					/* maxPositionSize */ sdk.ZeroDec(),
 				)
 				fundingRates := []sdk.Dec{sdk.ZeroDec()} // fPayment -> 0
 				require.True(t, vpoolKeeper.ExistsPool(ctx, pair))
 
 				t.Log("Set vpool defined by pair on PerpKeeper")
 				setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
-					Pair:                       pair,
-					CumulativePremiumFractions: fundingRates,
+					Pair: pair,
 				})
+				setCumulativePremiumFractions(nibiruApp.PerpKeeper, ctx, pair, fundingRates...)
 
 				pos := &types.Position{
 					TraderAddress:                   trader.String(),
@@ -106,6 +108,8 @@ func TestCalcRemainMarginWithFundingPayment(t *testing.T) {
 					/* maxOracleSpreadRatio */ sdk.MustNewDecFromStr("1.0"), // 100%
 					/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
 					/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+					/* maxOpenInterest */ sdk.ZeroDec(),
+					/* maxPositionSize */ sdk.ZeroDec(),
 				)
 				fundingRates := []sdk.Dec{
 					sdk.MustNewDecFromStr("0.25"),
@@ -116,9 +120,9 @@ func TestCalcRemainMarginWithFundingPayment(t *testing.T) {
 
 				t.Log("Set vpool defined by pair on PerpKeeper")
 				setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
-					Pair:                       pair,
-					CumulativePremiumFractions: fundingRates,
+					Pair: pair,
 				})
+				setCumulativePremiumFractions(nibiruApp.PerpKeeper, ctx, pair, fundingRates...)
 
 				pos := &types.Position{
 					TraderAddress:                   trader.String(),
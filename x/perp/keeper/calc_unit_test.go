@@ -193,14 +193,11 @@ func TestGetLatestCumulativePremiumFraction(t *testing.T) {
 			test: func() {
 				keeper, _, ctx := getKeeper(t)
 
-				metadata := &types.PairMetadata{
-					Pair: common.Pair_NIBI_NUSD,
-					CumulativePremiumFractions: []sdk.Dec{
-						sdk.NewDec(1),
-						sdk.NewDec(2), // returns the latest from the list
-					},
-				}
-				setPairMetadata(keeper, ctx, *metadata)
+				setPairMetadata(keeper, ctx, types.PairMetadata{Pair: common.Pair_NIBI_NUSD})
+				setCumulativePremiumFractions(keeper, ctx, common.Pair_NIBI_NUSD,
+					sdk.NewDec(1),
+					sdk.NewDec(2), // returns the latest from the list
+				)
 
 				latestCumulativePremiumFraction, err := keeper.
 					getLatestCumulativePremiumFraction(ctx, common.Pair_NIBI_NUSD)
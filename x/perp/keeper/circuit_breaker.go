@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+)
+
+// circuitBreakerName is reported as the author of the halts tripped by the circuit breaker.
+const circuitBreakerName = "circuit_breaker"
+
+/*
+SetTradingHalt halts or resumes trading, on its own pair or on the whole
+exchange if tokenPair is empty. Only the guardian set in the params can use it,
+governance changes the same params through param change proposals. Resuming
+trading records the block, so that the circuit breaker doesn't trip again on the
+price moves which led to the halt. The resumes by governance are recorded by the
+circuit breaker at the end of the block.
+
+args:
+  - ctx: cosmos-sdk context
+  - sender: the account changing the halt, must be the guardian
+  - tokenPair: the pair to halt or resume, empty for the whole exchange
+  - halted: whether trading should be halted
+
+ret:
+  - err: error if any
+*/
+func (k Keeper) SetTradingHalt(ctx sdk.Context, sender sdk.AccAddress, tokenPair string, halted bool) error {
+	params := k.GetParams(ctx)
+	if params.Guardian == "" || params.Guardian != sender.String() {
+		return types.ErrUnauthorized.Wrapf("%s is not the guardian", sender)
+	}
+
+	if tokenPair == "" {
+		params.Stopped = halted
+	} else {
+		pair, err := common.NewAssetPair(tokenPair)
+		if err != nil {
+			return err
+		}
+		params.HaltedPairs = setPairHalted(params.HaltedPairs, pair, halted)
+	}
+	k.SetParams(ctx, params)
+	if !halted {
+		k.TradingResumes.Insert(ctx, tokenPair, types.TradingResume{
+			Pair:        tokenPair,
+			BlockHeight: ctx.BlockHeight(),
+			Time:        ctx.BlockTime(),
+		})
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.TradingHaltChangedEvent{
+		Pair:        tokenPair,
+		Halted:      halted,
+		ChangedBy:   sender.String(),
+		BlockHeight: ctx.BlockHeight(),
+	})
+}
+
+// CheckCircuitBreakers halts the pairs whose mark price moved by more than the
+// circuit breaker band within the circuit breaker window, leaving out the reserve
+// snapshots taken before trading was last resumed. The halt stays until the
+// guardian or governance lifts it.
+func (k Keeper) CheckCircuitBreakers(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	k.recordTradingResumes(ctx, params)
+	defer k.syncTradingHalts(ctx)
+	if params.Stopped || !params.CircuitBreakerBand.IsPositive() {
+		return
+	}
+
+	for _, metadata := range k.PairsMetadata.Iterate(ctx, collections.Range[common.AssetPair]{}).Values() {
+		pair := metadata.Pair
+		if params.IsTradingHalted(pair) || !k.VpoolKeeper.ExistsPool(ctx, pair) || k.isPairShutdown(ctx, pair) {
+			continue
+		}
+
+		low, high, err := k.VpoolKeeper.GetMarkPriceRange(ctx, pair, k.circuitBreakerWindow(ctx, params, pair))
+		if err != nil {
+			k.Logger(ctx).Error("failed to get the mark price range", "pair", pair.String(), "error", err)
+			continue
+		}
+		if !low.IsPositive() || high.Sub(low).Quo(low).LTE(params.CircuitBreakerBand) {
+			continue
+		}
+
+		params.HaltedPairs = setPairHalted(params.HaltedPairs, pair, true)
+		k.SetParams(ctx, params)
+		k.Logger(ctx).Info("circuit breaker tripped", "pair", pair.String(), "low", low, "high", high)
+
+		if err = ctx.EventManager().EmitTypedEvent(&types.TradingHaltChangedEvent{
+			Pair:        pair.String(),
+			Halted:      true,
+			ChangedBy:   circuitBreakerName,
+			BlockHeight: ctx.BlockHeight(),
+		}); err != nil {
+			k.Logger(ctx).Error("failed to emit the trading halt event", "pair", pair.String(), "error", err)
+		}
+	}
+}
+
+// recordTradingResumes records a resume for the halts found at the previous block which
+// have been lifted since, whether by the guardian or by a param change proposal.
+func (k Keeper) recordTradingResumes(ctx sdk.Context, params types.Params) {
+	halts := tradingHalts(params)
+	for _, key := range k.TradingHalts.Iterate(ctx, collections.Range[string]{}).Keys() {
+		if _, halted := halts[key]; halted {
+			continue
+		}
+		k.TradingResumes.Insert(ctx, key, types.TradingResume{
+			Pair:        key,
+			BlockHeight: ctx.BlockHeight(),
+			Time:        ctx.BlockTime(),
+		})
+	}
+}
+
+// syncTradingHalts stores the current halts, for the next block to find the ones lifted.
+func (k Keeper) syncTradingHalts(ctx sdk.Context) {
+	halts := tradingHalts(k.GetParams(ctx))
+	for _, key := range k.TradingHalts.Iterate(ctx, collections.Range[string]{}).Keys() {
+		if _, halted := halts[key]; !halted {
+			k.TradingHalts.Delete(ctx, key)
+		}
+	}
+	for key := range halts {
+		k.TradingHalts.Insert(ctx, key)
+	}
+}
+
+// tradingHalts returns the set of halted pairs, with an empty key for the whole exchange.
+func tradingHalts(params types.Params) map[string]struct{} {
+	halts := make(map[string]struct{}, len(params.HaltedPairs)+1)
+	if params.Stopped {
+		halts[""] = struct{}{}
+	}
+	for _, pair := range params.HaltedPairs {
+		halts[pair] = struct{}{}
+	}
+	return halts
+}
+
+// circuitBreakerWindow returns the circuit breaker window of the pair, cut short at the
+// last time trading was resumed on the pair or on the whole exchange.
+func (k Keeper) circuitBreakerWindow(ctx sdk.Context, params types.Params, pair common.AssetPair) time.Duration {
+	window := params.CircuitBreakerWindow
+	for _, key := range []string{"", pair.String()} {
+		resume, err := k.TradingResumes.Get(ctx, key)
+		if err != nil {
+			continue
+		}
+		if sinceResume := ctx.BlockTime().Sub(resume.Time); sinceResume < window {
+			window = sinceResume
+		}
+	}
+	return window
+}
+
+// setPairHalted adds the pair to or removes it from the halted pairs.
+func setPairHalted(haltedPairs []string, pair common.AssetPair, halted bool) []string {
+	result := make([]string, 0, len(haltedPairs)+1)
+	for _, haltedPair := range haltedPairs {
+		if haltedPair != pair.String() {
+			result = append(result, haltedPair)
+		}
+	}
+	if halted {
+		result = append(result, pair.String())
+	}
+	return result
+}
@@ -0,0 +1,202 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+)
+
+func TestSetTradingHalt(t *testing.T) {
+	pair := common.MustNewAssetPair("xxx:yyy")
+	nibiruApp, ctx := setupOpenInterestPool(t, pair, sdk.ZeroDec(), sdk.ZeroDec())
+	perpKeeper := nibiruApp.PerpKeeper
+
+	oracle := testutil.AccAddress()
+	nibiruApp.PricefeedKeeper.WhitelistOraclesForPairs(ctx, []sdk.AccAddress{oracle}, []common.AssetPair{pair})
+	require.NoError(t, nibiruApp.PricefeedKeeper.PostRawPrice(ctx, oracle, pair.String(), sdk.OneDec(), ctx.BlockTime().Add(time.Hour)))
+	require.NoError(t, nibiruApp.PricefeedKeeper.GatherRawPrices(ctx, pair.BaseDenom(), pair.QuoteDenom()))
+
+	guardian, liquidator := testutil.AccAddress(), testutil.AccAddress()
+	params := perpKeeper.GetParams(ctx)
+	params.Guardian = guardian.String()
+	params.WhitelistedLiquidators = []string{liquidator.String()}
+	perpKeeper.SetParams(ctx, params)
+
+	alice, bob, carol := testutil.AccAddress(), testutil.AccAddress(), testutil.AccAddress()
+	for _, trader := range []sdk.AccAddress{alice, bob, carol} {
+		require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin("yyy", 1_000))))
+	}
+	_, err := perpKeeper.OpenPosition(ctx, pair, types.Side_BUY, alice, sdk.NewInt(100), sdk.NewDec(5), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	t.Log("only the guardian can halt trading")
+	err = perpKeeper.SetTradingHalt(ctx, alice, pair.String(), true)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.NoError(t, perpKeeper.SetTradingHalt(ctx, guardian, pair.String(), true))
+	assert.Equal(t, []string{pair.String()}, perpKeeper.GetParams(ctx).HaltedPairs)
+
+	t.Log("positions cannot be opened or increased while the pair is halted")
+	_, err = perpKeeper.OpenPosition(ctx, pair, types.Side_SELL, bob, sdk.NewInt(10), sdk.NewDec(5), sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrTradingHalted)
+	_, err = perpKeeper.OpenPosition(ctx, pair, types.Side_BUY, alice, sdk.NewInt(10), sdk.NewDec(5), sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrTradingHalted)
+
+	t.Log("margin can still be removed and positions liquidated while the pair is halted")
+	_, _, _, err = perpKeeper.RemoveMargin(ctx, pair, alice, sdk.NewInt64Coin("yyy", 1))
+	require.NoError(t, err)
+	_, _, err = perpKeeper.Liquidate(ctx, liquidator, pair, alice)
+	require.ErrorIs(t, err, types.ErrMarginHighEnough)
+
+	t.Log("positions can still be reduced, topped up and closed")
+	_, err = perpKeeper.OpenPosition(ctx, pair, types.Side_SELL, alice, sdk.NewInt(10), sdk.NewDec(5), sdk.ZeroDec())
+	require.NoError(t, err)
+	_, err = perpKeeper.AddMargin(ctx, pair, alice, sdk.NewInt64Coin("yyy", 10))
+	require.NoError(t, err)
+	_, err = perpKeeper.ClosePosition(ctx, pair, alice)
+	require.NoError(t, err)
+
+	t.Log("resume trading on the pair")
+	require.NoError(t, perpKeeper.SetTradingHalt(ctx, guardian, pair.String(), false))
+	assert.Empty(t, perpKeeper.GetParams(ctx).HaltedPairs)
+	resume, err := perpKeeper.TradingResumes.Get(ctx, pair.String())
+	require.NoError(t, err)
+	assert.Equal(t, types.TradingResume{Pair: pair.String(), BlockHeight: ctx.BlockHeight(), Time: ctx.BlockTime()}, resume)
+	_, err = perpKeeper.OpenPosition(ctx, pair, types.Side_SELL, carol, sdk.NewInt(10), sdk.NewDec(5), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	t.Log("halt the whole exchange")
+	require.NoError(t, perpKeeper.SetTradingHalt(ctx, guardian, "", true))
+	assert.True(t, perpKeeper.GetParams(ctx).Stopped)
+	_, err = perpKeeper.OpenPosition(ctx, pair, types.Side_SELL, carol, sdk.NewInt(10), sdk.NewDec(5), sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrTradingHalted)
+
+	testutil.RequireContainsTypedEvent(t, ctx, &types.TradingHaltChangedEvent{
+		Pair:        "",
+		Halted:      true,
+		ChangedBy:   guardian.String(),
+		BlockHeight: ctx.BlockHeight(),
+	})
+}
+
+func TestCheckCircuitBreakers(t *testing.T) {
+	pair := common.MustNewAssetPair("xxx:yyy")
+	nibiruApp, ctx := setupOpenInterestPool(t, pair, sdk.ZeroDec(), sdk.ZeroDec())
+	perpKeeper := nibiruApp.PerpKeeper
+
+	params := perpKeeper.GetParams(ctx)
+	params.CircuitBreakerBand = sdk.OneDec()
+	params.CircuitBreakerWindow = 15 * time.Minute
+	perpKeeper.SetParams(ctx, params)
+
+	t.Log("move the mark price from 1 to ~1.69")
+	alice := testutil.AccAddress()
+	require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("yyy", 40_000))))
+	_, err := perpKeeper.OpenPosition(ctx, pair, types.Side_BUY, alice, sdk.NewInt(30_000), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	t.Log("a move within the band doesn't halt the pair")
+	perpKeeper.CheckCircuitBreakers(ctx)
+	assert.False(t, perpKeeper.GetParams(ctx).IsTradingHalted(pair))
+
+	t.Log("a move past the band halts the pair")
+	params = perpKeeper.GetParams(ctx)
+	params.CircuitBreakerBand = sdk.MustNewDecFromStr("0.1")
+	perpKeeper.SetParams(ctx, params)
+	perpKeeper.CheckCircuitBreakers(ctx)
+	assert.True(t, perpKeeper.GetParams(ctx).IsTradingHalted(pair))
+	testutil.RequireContainsTypedEvent(t, ctx, &types.TradingHaltChangedEvent{
+		Pair:        pair.String(),
+		Halted:      true,
+		ChangedBy:   "circuit_breaker",
+		BlockHeight: ctx.BlockHeight(),
+	})
+
+	t.Log("the move is out of the window later on")
+	params = perpKeeper.GetParams(ctx)
+	params.HaltedPairs = nil
+	perpKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	perpKeeper.CheckCircuitBreakers(ctx)
+	assert.False(t, perpKeeper.GetParams(ctx).IsTradingHalted(pair))
+}
+
+func TestCheckCircuitBreakers_AfterResume(t *testing.T) {
+	pair := common.MustNewAssetPair("xxx:yyy")
+	nibiruApp, ctx := setupOpenInterestPool(t, pair, sdk.ZeroDec(), sdk.ZeroDec())
+	perpKeeper := nibiruApp.PerpKeeper
+
+	guardian := testutil.AccAddress()
+	params := perpKeeper.GetParams(ctx)
+	params.Guardian = guardian.String()
+	params.CircuitBreakerBand = sdk.MustNewDecFromStr("0.1")
+	params.CircuitBreakerWindow = 15 * time.Minute
+	perpKeeper.SetParams(ctx, params)
+
+	t.Log("move the mark price from 1 to ~1.69, which halts the pair")
+	alice := testutil.AccAddress()
+	require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("yyy", 40_000))))
+	_, err := perpKeeper.OpenPosition(ctx, pair, types.Side_BUY, alice, sdk.NewInt(30_000), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+	perpKeeper.CheckCircuitBreakers(ctx)
+	require.True(t, perpKeeper.GetParams(ctx).IsTradingHalted(pair))
+
+	t.Log("the guardian resumes trading a minute later, within the window")
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	require.NoError(t, perpKeeper.SetTradingHalt(ctx, guardian, pair.String(), false))
+
+	t.Log("the move before the resume doesn't halt the pair again")
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	perpKeeper.CheckCircuitBreakers(ctx)
+	assert.False(t, perpKeeper.GetParams(ctx).IsTradingHalted(pair))
+}
+
+func TestCheckCircuitBreakers_AfterResumeByParamChange(t *testing.T) {
+	pair := common.MustNewAssetPair("xxx:yyy")
+	nibiruApp, ctx := setupOpenInterestPool(t, pair, sdk.ZeroDec(), sdk.ZeroDec())
+	perpKeeper := nibiruApp.PerpKeeper
+
+	perpParams := perpKeeper.GetParams(ctx)
+	perpParams.CircuitBreakerBand = sdk.MustNewDecFromStr("0.1")
+	perpParams.CircuitBreakerWindow = 15 * time.Minute
+	perpKeeper.SetParams(ctx, perpParams)
+
+	t.Log("move the mark price from 1 to ~1.69, which halts the pair")
+	alice := testutil.AccAddress()
+	require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("yyy", 40_000))))
+	_, err := perpKeeper.OpenPosition(ctx, pair, types.Side_BUY, alice, sdk.NewInt(30_000), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+	perpKeeper.CheckCircuitBreakers(ctx)
+	require.True(t, perpKeeper.GetParams(ctx).IsTradingHalted(pair))
+
+	t.Log("governance lifts the halt a minute later, within the window")
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	require.NoError(t, params.NewParamChangeProposalHandler(nibiruApp.ParamsKeeper)(ctx, &paramsproposal.ParameterChangeProposal{
+		Title:       "resume xxx:yyy",
+		Description: "the price move was genuine",
+		Changes: []paramsproposal.ParamChange{
+			paramsproposal.NewParamChange(types.ModuleName, "HaltedPairs", `[]`),
+		},
+	}))
+	require.False(t, perpKeeper.GetParams(ctx).IsTradingHalted(pair))
+
+	t.Log("the circuit breaker records the resume and doesn't halt the pair again on the move before it")
+	perpKeeper.CheckCircuitBreakers(ctx)
+	assert.False(t, perpKeeper.GetParams(ctx).IsTradingHalted(pair))
+	resume, err := perpKeeper.TradingResumes.Get(ctx, pair.String())
+	require.NoError(t, err)
+	assert.Equal(t, ctx.BlockHeight(), resume.BlockHeight)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	perpKeeper.CheckCircuitBreakers(ctx)
+	assert.False(t, perpKeeper.GetParams(ctx).IsTradingHalted(pair))
+}
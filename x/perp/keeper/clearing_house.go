@@ -46,6 +46,11 @@ func (k Keeper) OpenPosition(
 	// require params
 	params := k.GetParams(ctx)
 
+	markPriceBefore, err := k.markPriceBeforeTrade(ctx, params, pair)
+	if err != nil {
+		return nil, err
+	}
+
 	position, err := k.Positions.Get(ctx, collections.Join(pair, traderAddr))
 	isNewPosition := errors.Is(err, collections.ErrNotFound)
 	if isNewPosition {
@@ -84,7 +89,16 @@ func (k Keeper) OpenPosition(
 		}
 	}
 
-	if err = k.afterPositionUpdate(ctx, pair, traderAddr, params, isNewPosition, *positionResp); err != nil {
+	// while trading is halted, positions can only be reduced
+	if grewLong, grewShort := positionGrew(position.Size_, positionResp.Position.Size_); (grewLong || grewShort) && params.IsTradingHalted(pair) {
+		return nil, types.ErrTradingHalted.Wrapf("%s: only position reductions are allowed", pair)
+	}
+
+	if err = k.checkPositionLimits(ctx, pair, position.Size_, positionResp.Position.Size_); err != nil {
+		return nil, err
+	}
+
+	if err = k.afterPositionUpdate(ctx, pair, traderAddr, params, isNewPosition, markPriceBefore, *positionResp); err != nil {
 		return nil, err
 	}
 
@@ -117,6 +131,15 @@ func (k Keeper) checkOpenPositionRequirements(ctx sdk.Context, pair common.Asset
 	return nil
 }
 
+// markPriceBeforeTrade returns the mark price of the pair before a trade, which decides if
+// the trade earns a maker rebate. It is nil when the rebates are disabled.
+func (k Keeper) markPriceBeforeTrade(ctx sdk.Context, params types.Params, pair common.AssetPair) (sdk.Dec, error) {
+	if !params.MakerRebateRatio.IsPositive() {
+		return sdk.Dec{}, nil
+	}
+	return k.VpoolKeeper.GetMarkPrice(ctx, pair)
+}
+
 // afterPositionUpdate is called when a position has been updated.
 func (k Keeper) afterPositionUpdate(
 	ctx sdk.Context,
@@ -124,6 +147,7 @@ func (k Keeper) afterPositionUpdate(
 	traderAddr sdk.AccAddress,
 	params types.Params,
 	isNewPosition bool,
+	markPriceBefore sdk.Dec,
 	positionResp types.PositionResp,
 ) (err error) {
 	// update position in state
@@ -134,6 +158,7 @@ func (k Keeper) afterPositionUpdate(
 	if !positionResp.BadDebt.IsZero() {
 		return fmt.Errorf("bad debt must be zero to prevent attacker from leveraging it")
 	}
+	k.clearLiquidatableSince(ctx, pair, traderAddr)
 
 	if !positionResp.Position.Size_.IsZero() {
 		marginRatio, err := k.GetMarginRatio(
@@ -145,7 +170,14 @@ func (k Keeper) afterPositionUpdate(
 			return err
 		}
 
-		maintenanceMarginRatio := k.VpoolKeeper.GetMaintenanceMarginRatio(ctx, pair)
+		maintenanceMarginRatio, err := k.getMaintenanceMarginRatio(
+			ctx,
+			*positionResp.Position,
+			types.MarginCalculationPriceOption_MAX_PNL,
+		)
+		if err != nil {
+			return err
+		}
 		if err = requireMoreMarginRatio(marginRatio, maintenanceMarginRatio, true); err != nil {
 			return types.ErrMarginRatioTooLow
 		}
@@ -161,7 +193,7 @@ func (k Keeper) afterPositionUpdate(
 			return err
 		}
 	case marginToVault.IsNegative():
-		if err = k.Withdraw(ctx, pair.QuoteDenom(), traderAddr, marginToVault.Abs()); err != nil {
+		if _, err = k.withdrawPayout(ctx, pair, traderAddr, marginToVault.Abs()); err != nil {
 			return err
 		}
 	}
@@ -170,12 +202,18 @@ func (k Keeper) afterPositionUpdate(
 	if err != nil {
 		return err
 	}
+	k.recordTraderVolume(ctx, traderAddr, positionResp.ExchangedNotionalValue.Abs())
 
 	markPrice, err := k.VpoolKeeper.GetMarkPrice(ctx, pair)
 	if err != nil {
 		return err
 	}
 
+	if _, err = k.payMakerRebate(
+		ctx, pair, traderAddr, markPriceBefore, markPrice, positionResp); err != nil {
+		return err
+	}
+
 	// calculate positionNotional (it's different depends on long or short side)
 	// long: unrealizedPnl = positionNotional - openNotional => positionNotional = openNotional + unrealizedPnl
 	// short: unrealizedPnl = openNotional - positionNotional => positionNotional = openNotional - unrealizedPnl
@@ -192,6 +230,7 @@ func (k Keeper) afterPositionUpdate(
 		Margin:                sdk.NewCoin(pair.QuoteDenom(), positionResp.Position.Margin.RoundInt()),
 		PositionNotional:      positionNotional,
 		ExchangedPositionSize: positionResp.ExchangedPositionSize,
+		ExchangedNotional:     positionResp.ExchangedNotionalValue,
 		TransactionFee:        sdk.NewCoin(pair.QuoteDenom(), transferredFee),
 		PositionSize:          positionResp.Position.Size_,
 		RealizedPnl:           positionResp.RealizedPnl,
@@ -290,6 +329,7 @@ func (k Keeper) increasePosition(
 		LatestCumulativePremiumFraction: remaining.LatestCumulativePremiumFraction,
 		BlockNumber:                     ctx.BlockHeight(),
 	}
+	k.updateOpenInterest(ctx, currentPosition.Pair, currentPosition.Size_, positionResp.Position.Size_)
 
 	return positionResp, nil
 }
@@ -443,6 +483,7 @@ func (k Keeper) decreasePosition(
 		LatestCumulativePremiumFraction: remaining.LatestCumulativePremiumFraction,
 		BlockNumber:                     ctx.BlockHeight(),
 	}
+	k.updateOpenInterest(ctx, currentPosition.Pair, currentPosition.Size_, positionResp.Position.Size_)
 
 	return positionResp, nil
 }
@@ -647,13 +688,15 @@ func (k Keeper) closePositionEntirely(
 	if err != nil {
 		return nil, err
 	}
+	k.updateOpenInterest(ctx, currentPosition.Pair, currentPosition.Size_, sdk.ZeroDec())
 
 	return positionResp, nil
 }
 
 /*
 ClosePosition closes a position entirely and transfers the remaining margin back to the user.
-Errors if the position has bad debt.
+Errors if the position has bad debt. The bad debt of a cross margin position is first
+covered by the margin of the other positions of the account.
 
 args:
   - ctx: the cosmos-sdk context
@@ -665,21 +708,64 @@ ret:
   - err: error if any
 */
 func (k Keeper) ClosePosition(ctx sdk.Context, pair common.AssetPair, traderAddr sdk.AccAddress) (*types.PositionResp, error) {
+	return k.ReducePosition(ctx, pair, traderAddr, sdk.ZeroDec(), sdk.ZeroDec())
+}
+
+/*
+ReducePosition closes baseAmount of the size of a position, or the entire position if
+baseAmount is zero or covers its whole size. It is reduce only: the position never
+flips to the other side. The realized PnL of a partial close stays in the margin of the
+position, the remaining margin of a closed position is transferred back to the user.
+
+args:
+  - ctx: the cosmos-sdk context
+  - pair: the trading pair
+  - traderAddr: the trader's address
+  - baseAmount: the base size to close, zero to close the entire position
+  - quoteAssetAmountLimit: the minimum quote received closing a long position, or the
+    maximum quote paid closing a short position. Zero for no limit.
+
+ret:
+  - positionResp: the response containing the updated position and applied funding payment, bad debt, PnL
+  - err: error if any
+*/
+func (k Keeper) ReducePosition(
+	ctx sdk.Context, pair common.AssetPair, traderAddr sdk.AccAddress, baseAmount sdk.Dec, quoteAssetAmountLimit sdk.Dec,
+) (*types.PositionResp, error) {
+	if baseAmount.IsNegative() {
+		return nil, fmt.Errorf("base amount to close must not be negative: %s", baseAmount)
+	}
+
 	position, err := k.Positions.Get(ctx, collections.Join(pair, traderAddr))
 	if err != nil {
 		return nil, err
 	}
 
-	positionResp, err := k.closePositionEntirely(
-		ctx,
-		position,
-		/* quoteAssetAmountLimit */ sdk.ZeroDec(),
-		/* skipFluctuationLimitCheck */ false,
-	)
+	params := k.GetParams(ctx)
+	markPriceBefore, err := k.markPriceBeforeTrade(ctx, params, pair)
+	if err != nil {
+		return nil, err
+	}
+
+	var positionResp *types.PositionResp
+	if baseAmount.IsZero() || baseAmount.GTE(position.Size_.Abs()) {
+		positionResp, err = k.closePositionEntirely(
+			ctx,
+			position,
+			quoteAssetAmountLimit,
+			/* skipFluctuationLimitCheck */ false,
+		)
+	} else {
+		positionResp, err = k.decreasePositionByBase(ctx, position, baseAmount, quoteAssetAmountLimit)
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	if positionResp.BadDebt.IsPositive() && k.isCrossMarginPosition(ctx, position) {
+		positionResp.BadDebt = k.coverBadDebtWithAccountMargin(ctx, position, positionResp.BadDebt)
+	}
+
 	if positionResp.BadDebt.IsPositive() {
 		return nil, fmt.Errorf("underwater position")
 	}
@@ -688,8 +774,9 @@ func (k Keeper) ClosePosition(ctx sdk.Context, pair common.AssetPair, traderAddr
 		ctx,
 		pair,
 		traderAddr,
-		k.GetParams(ctx),
+		params,
 		/* isNewPosition */ false,
+		markPriceBefore,
 		*positionResp,
 	); err != nil {
 		return nil, err
@@ -698,14 +785,60 @@ func (k Keeper) ClosePosition(ctx sdk.Context, pair common.AssetPair, traderAddr
 	return positionResp, nil
 }
 
+// decreasePositionByBase decreases a position by baseAmount, which must be lower than its
+// size, checking the quote exchanged against the quote asset amount limit.
+func (k Keeper) decreasePositionByBase(
+	ctx sdk.Context, currentPosition types.Position, baseAmount sdk.Dec, quoteAssetAmountLimit sdk.Dec,
+) (*types.PositionResp, error) {
+	var baseAssetDirection vpooltypes.Direction
+	if currentPosition.Size_.IsPositive() {
+		baseAssetDirection = vpooltypes.Direction_ADD_TO_POOL
+	} else {
+		baseAssetDirection = vpooltypes.Direction_REMOVE_FROM_POOL
+	}
+
+	decreasedNotional, err := k.VpoolKeeper.GetBaseAssetPrice(ctx, currentPosition.Pair, baseAssetDirection, baseAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	if !quoteAssetAmountLimit.IsZero() {
+		if currentPosition.Size_.IsPositive() && decreasedNotional.LT(quoteAssetAmountLimit) {
+			return nil, vpooltypes.ErrAssetFailsUserLimit.Wrapf(
+				"quote received (%s) is less than the limit (%s)", decreasedNotional, quoteAssetAmountLimit)
+		}
+		if currentPosition.Size_.IsNegative() && decreasedNotional.GT(quoteAssetAmountLimit) {
+			return nil, vpooltypes.ErrAssetFailsUserLimit.Wrapf(
+				"quote paid (%s) is greater than the limit (%s)", decreasedNotional, quoteAssetAmountLimit)
+		}
+	}
+
+	positionResp, err := k.decreasePosition(
+		ctx,
+		currentPosition,
+		decreasedNotional,
+		/* baseAmtLimit */ sdk.ZeroDec(),
+		/* skipFluctuationLimitCheck */ false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// the base swapped for the notional can differ from baseAmount by rounding
+	if positionResp.Position.Size_.IsZero() || positionResp.Position.Size_.IsPositive() != currentPosition.Size_.IsPositive() {
+		return nil, types.ErrReduceOnly.Wrapf("closing %s of a position of size %s", baseAmount, currentPosition.Size_)
+	}
+
+	return positionResp, nil
+}
+
 func (k Keeper) transferFee(
 	ctx sdk.Context,
 	pair common.AssetPair,
 	trader sdk.AccAddress,
 	positionNotional sdk.Dec,
 ) (fees sdk.Int, err error) {
-	params := k.GetParams(ctx)
-	feeToFeePool := params.FeePoolFeeRatio.Mul(positionNotional).RoundInt()
+	feeToFeePool, feeToEcosystemFund, feeToInsuranceFund := k.calcFees(ctx, pair, trader, positionNotional)
 	if feeToFeePool.IsPositive() {
 		if err = k.BankKeeper.SendCoinsFromAccountToModule(
 			ctx,
@@ -722,7 +855,22 @@ func (k Keeper) transferFee(
 		}
 	}
 
-	feeToEcosystemFund := params.EcosystemFundFeeRatio.Mul(positionNotional).RoundInt()
+	if feeToInsuranceFund.IsPositive() {
+		if err = k.BankKeeper.SendCoinsFromAccountToModule(
+			ctx,
+			/* from */ trader,
+			/* to */ types.InsuranceFundModuleAccount,
+			/* coins */ sdk.NewCoins(
+				sdk.NewCoin(
+					pair.QuoteDenom(),
+					feeToInsuranceFund,
+				),
+			),
+		); err != nil {
+			return sdk.Int{}, err
+		}
+	}
+
 	if feeToEcosystemFund.IsPositive() {
 		if err = k.BankKeeper.SendCoinsFromAccountToModule(
 			ctx,
@@ -739,7 +887,24 @@ func (k Keeper) transferFee(
 		}
 	}
 
-	return feeToFeePool.Add(feeToEcosystemFund), nil
+	return feeToFeePool.Add(feeToEcosystemFund).Add(feeToInsuranceFund), nil
+}
+
+// calcFees splits the fees of a position change of the given notional between the fee
+// pool, the ecosystem fund and the insurance fund, which takes its cut of the ecosystem
+// fund fee. The fee ratios are the ones of the fee tier reached by the trader's volume.
+func (k Keeper) calcFees(
+	ctx sdk.Context, pair common.AssetPair, trader sdk.AccAddress, positionNotional sdk.Dec,
+) (feeToFeePool sdk.Int, feeToEcosystemFund sdk.Int, feeToInsuranceFund sdk.Int) {
+	params := k.GetParams(ctx)
+	feePoolFeeRatio, ecosystemFundFeeRatio := params.FeePoolFeeRatio, params.EcosystemFundFeeRatio
+	if len(params.FeeTiers) > 0 {
+		feePoolFeeRatio, ecosystemFundFeeRatio = params.FeeRatios(k.GetTraderVolume(ctx, trader))
+	}
+	feeToFeePool = feePoolFeeRatio.Mul(positionNotional).RoundInt()
+	ecosystemFundFee := ecosystemFundFeeRatio.Mul(positionNotional).RoundInt()
+	feeToInsuranceFund = k.insuranceFundFeeCut(ctx, pair.QuoteDenom(), params.InsuranceFundFeeShare, ecosystemFundFee)
+	return feeToFeePool, ecosystemFundFee.Sub(feeToInsuranceFund), feeToInsuranceFund
 }
 
 /*
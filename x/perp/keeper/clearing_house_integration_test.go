@@ -269,10 +269,11 @@ func TestOpenPositionSuccess(t *testing.T) {
 				/* maxOracleSpreadRatio */ sdk.OneDec(),
 				/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+				/* maxOpenInterest */ sdk.ZeroDec(),
+				/* maxPositionSize */ sdk.ZeroDec(),
 			)
 			setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
-				Pair:                       common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec()},
+				Pair: common.Pair_BTC_NUSD,
 			})
 
 			t.Log("initialize trader funds")
@@ -503,10 +504,11 @@ func TestOpenPositionError(t *testing.T) {
 				/* maxOracleSpreadRatio */ sdk.OneDec(),
 				/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+				/* maxOpenInterest */ sdk.ZeroDec(),
+				/* maxPositionSize */ sdk.ZeroDec(),
 			)
 			setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
-				Pair:                       common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec()},
+				Pair: common.Pair_BTC_NUSD,
 			})
 
 			t.Log("initialize trader funds")
@@ -570,6 +572,8 @@ func TestOpenPositionInvalidPair(t *testing.T) {
 					sdk.MustNewDecFromStr("0.1"),
 					/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
 					/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+					/* maxOpenInterest */ sdk.ZeroDec(),
+					/* maxPositionSize */ sdk.ZeroDec(),
 				)
 				nibiruApp.PricefeedKeeper.ActivePairsStore().Set(ctx, pair, true)
 
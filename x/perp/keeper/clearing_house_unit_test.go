@@ -213,12 +213,12 @@ func TestIncreasePosition(t *testing.T) {
 				setPairMetadata(perpKeeper, ctx,
 					types.PairMetadata{
 						Pair: common.Pair_BTC_NUSD,
-						CumulativePremiumFractions: []sdk.Dec{
-							sdk.ZeroDec(),
-							sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-						},
 					},
 				)
+				setCumulativePremiumFractions(perpKeeper, ctx, common.Pair_BTC_NUSD,
+					sdk.ZeroDec(),
+					sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC,
+				)
 			},
 			when: func(ctx sdk.Context, perpKeeper Keeper, initPosition types.Position) (*types.PositionResp, error) {
 				t.Log("Increase position with 10 NUSD margin and 10x leverage.")
@@ -289,11 +289,11 @@ func TestIncreasePosition(t *testing.T) {
 				t.Log("set up pair metadata and last cumulative funding rate")
 				setPairMetadata(perpKeeper, ctx, types.PairMetadata{
 					Pair: common.Pair_BTC_NUSD,
-					CumulativePremiumFractions: []sdk.Dec{
-						sdk.ZeroDec(),
-						sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-					},
 				})
+				setCumulativePremiumFractions(perpKeeper, ctx, common.Pair_BTC_NUSD,
+					sdk.ZeroDec(),
+					sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC,
+				)
 			},
 			when: func(ctx sdk.Context, perpKeeper Keeper, initPosition types.Position) (*types.PositionResp, error) {
 				t.Log("Increase position with 10 NUSD margin and 10x leverage.")
@@ -367,11 +367,11 @@ func TestIncreasePosition(t *testing.T) {
 				t.Log("set up pair metadata and last cumulative funding rate")
 				setPairMetadata(perpKeeper, ctx, types.PairMetadata{
 					Pair: common.Pair_BTC_NUSD,
-					CumulativePremiumFractions: []sdk.Dec{
-						sdk.ZeroDec(),
-						sdk.MustNewDecFromStr("0.2"), // 0.2 NUSD / BTC
-					},
 				})
+				setCumulativePremiumFractions(perpKeeper, ctx, common.Pair_BTC_NUSD,
+					sdk.ZeroDec(),
+					sdk.MustNewDecFromStr("0.2"), // 0.2 NUSD / BTC,
+				)
 			},
 			when: func(ctx sdk.Context, perpKeeper Keeper, initPosition types.Position) (*types.PositionResp, error) {
 				t.Log("Increase position with 10 NUSD margin and 10x leverage.")
@@ -443,11 +443,11 @@ func TestIncreasePosition(t *testing.T) {
 				t.Log("set up pair metadata and last cumulative funding rate")
 				setPairMetadata(perpKeeper, ctx, types.PairMetadata{
 					Pair: common.Pair_BTC_NUSD,
-					CumulativePremiumFractions: []sdk.Dec{
-						sdk.ZeroDec(),
-						sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-					},
 				})
+				setCumulativePremiumFractions(perpKeeper, ctx, common.Pair_BTC_NUSD,
+					sdk.ZeroDec(),
+					sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC,
+				)
 			},
 			when: func(ctx sdk.Context, perpKeeper Keeper, initPosition types.Position) (*types.PositionResp, error) {
 				t.Log("Increase position with 10 NUSD margin and 10x leverage.")
@@ -519,11 +519,11 @@ func TestIncreasePosition(t *testing.T) {
 				t.Log("set up pair metadata and last cumulative funding rate")
 				setPairMetadata(perpKeeper, ctx, types.PairMetadata{
 					Pair: common.Pair_BTC_NUSD,
-					CumulativePremiumFractions: []sdk.Dec{
-						sdk.ZeroDec(),
-						sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-					},
 				})
+				setCumulativePremiumFractions(perpKeeper, ctx, common.Pair_BTC_NUSD,
+					sdk.ZeroDec(),
+					sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC,
+				)
 			},
 			when: func(ctx sdk.Context, perpKeeper Keeper, initPosition types.Position) (*types.PositionResp, error) {
 				t.Log("Increase position with 10 NUSD margin and 10x leverage.")
@@ -598,11 +598,11 @@ func TestIncreasePosition(t *testing.T) {
 				t.Log("set up pair metadata and last cumulative funding rate")
 				setPairMetadata(perpKeeper, ctx, types.PairMetadata{
 					Pair: common.Pair_BTC_NUSD,
-					CumulativePremiumFractions: []sdk.Dec{
-						sdk.ZeroDec(),
-						sdk.MustNewDecFromStr("-0.3"), // - 0.3 NUSD / BTC
-					},
 				})
+				setCumulativePremiumFractions(perpKeeper, ctx, common.Pair_BTC_NUSD,
+					sdk.ZeroDec(),
+					sdk.MustNewDecFromStr("-0.3"), // - 0.3 NUSD / BTC,
+				)
 			},
 			when: func(ctx sdk.Context, perpKeeper Keeper, initPosition types.Position) (*types.PositionResp, error) {
 				t.Log("Increase position with 10.5 NUSD margin and 10x leverage.")
@@ -653,12 +653,12 @@ func TestIncreasePosition(t *testing.T) {
 
 func TestClosePositionEntirely(t *testing.T) {
 	tests := []struct {
-		name                string
-		initialPosition     types.Position
-		pairMetadata        types.PairMetadata
-		direction           vpooltypes.Direction
-		newPositionNotional sdk.Dec
-		quoteAssetLimit     sdk.Dec
+		name                       string
+		initialPosition            types.Position
+		cumulativePremiumFractions []sdk.Dec
+		direction                  vpooltypes.Direction
+		newPositionNotional        sdk.Dec
+		quoteAssetLimit            sdk.Dec
 
 		expectedFundingPayment sdk.Dec
 		expectedBadDebt        sdk.Dec
@@ -681,12 +681,9 @@ func TestClosePositionEntirely(t *testing.T) {
 				LatestCumulativePremiumFraction: sdk.ZeroDec(),
 				BlockNumber:                     0,
 			},
-			pairMetadata: types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-					sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-				},
+			cumulativePremiumFractions: []sdk.Dec{
+				sdk.ZeroDec(),
+				sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
 			},
 			direction:              vpooltypes.Direction_ADD_TO_POOL,
 			newPositionNotional:    sdk.NewDec(200),
@@ -711,12 +708,9 @@ func TestClosePositionEntirely(t *testing.T) {
 				LatestCumulativePremiumFraction: sdk.ZeroDec(),
 				BlockNumber:                     0,
 			},
-			pairMetadata: types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-					sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-				},
+			cumulativePremiumFractions: []sdk.Dec{
+				sdk.ZeroDec(),
+				sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
 			},
 			direction:              vpooltypes.Direction_ADD_TO_POOL,
 			newPositionNotional:    sdk.NewDec(100),
@@ -741,12 +735,9 @@ func TestClosePositionEntirely(t *testing.T) {
 				LatestCumulativePremiumFraction: sdk.ZeroDec(),
 				BlockNumber:                     0,
 			},
-			pairMetadata: types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-					sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-				},
+			cumulativePremiumFractions: []sdk.Dec{
+				sdk.ZeroDec(),
+				sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
 			},
 			direction:              vpooltypes.Direction_ADD_TO_POOL,
 			newPositionNotional:    sdk.NewDec(100),
@@ -773,12 +764,9 @@ func TestClosePositionEntirely(t *testing.T) {
 				LatestCumulativePremiumFraction: sdk.ZeroDec(),
 				BlockNumber:                     0,
 			},
-			pairMetadata: types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-					sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-				},
+			cumulativePremiumFractions: []sdk.Dec{
+				sdk.ZeroDec(),
+				sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
 			},
 			direction:              vpooltypes.Direction_REMOVE_FROM_POOL,
 			newPositionNotional:    sdk.NewDec(100),
@@ -803,12 +791,9 @@ func TestClosePositionEntirely(t *testing.T) {
 				LatestCumulativePremiumFraction: sdk.ZeroDec(),
 				BlockNumber:                     0,
 			},
-			pairMetadata: types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-					sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-				},
+			cumulativePremiumFractions: []sdk.Dec{
+				sdk.ZeroDec(),
+				sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
 			},
 			direction:              vpooltypes.Direction_REMOVE_FROM_POOL,
 			newPositionNotional:    sdk.NewDec(105),
@@ -833,12 +818,9 @@ func TestClosePositionEntirely(t *testing.T) {
 				LatestCumulativePremiumFraction: sdk.ZeroDec(),
 				BlockNumber:                     0,
 			},
-			pairMetadata: types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-					sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-				},
+			cumulativePremiumFractions: []sdk.Dec{
+				sdk.ZeroDec(),
+				sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
 			},
 			direction:              vpooltypes.Direction_REMOVE_FROM_POOL,
 			newPositionNotional:    sdk.NewDec(150),
@@ -879,7 +861,8 @@ func TestClosePositionEntirely(t *testing.T) {
 				).Return( /*quoteAssetAmount=*/ tc.newPositionNotional, nil)
 
 			t.Log("set up pair metadata and last cumulative funding rate")
-			setPairMetadata(perpKeeper, ctx, tc.pairMetadata)
+			setPairMetadata(perpKeeper, ctx, types.PairMetadata{Pair: common.Pair_BTC_NUSD})
+			setCumulativePremiumFractions(perpKeeper, ctx, common.Pair_BTC_NUSD, tc.cumulativePremiumFractions...)
 
 			t.Log("close position")
 			resp, err := perpKeeper.closePositionEntirely(
@@ -905,7 +888,7 @@ func TestClosePositionEntirely(t *testing.T) {
 			assert.EqualValues(t, sdk.ZeroDec(), resp.Position.Margin)       // always zero
 			assert.EqualValues(t, sdk.ZeroDec(), resp.Position.OpenNotional) // always zero
 			assert.EqualValues(t,
-				tc.pairMetadata.CumulativePremiumFractions[len(tc.pairMetadata.CumulativePremiumFractions)-1],
+				tc.cumulativePremiumFractions[len(tc.cumulativePremiumFractions)-1],
 				resp.Position.LatestCumulativePremiumFraction,
 			)
 			assert.EqualValues(t, ctx.BlockHeight(), resp.Position.BlockNumber)
@@ -1177,11 +1160,11 @@ func TestDecreasePosition(t *testing.T) {
 			t.Log("set up pair metadata and last cumulative funding rate")
 			setPairMetadata(perpKeeper, ctx, types.PairMetadata{
 				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-					sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-				},
 			})
+			setCumulativePremiumFractions(perpKeeper, ctx, common.Pair_BTC_NUSD,
+				sdk.ZeroDec(),
+				sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC,
+			)
 
 			t.Log("decrease position")
 			resp, err := perpKeeper.decreasePosition(
@@ -1591,10 +1574,9 @@ func TestCloseAndOpenReversePosition(t *testing.T) {
 			t.Log("set up pair metadata and last cumulative funding rate")
 			setPairMetadata(perpKeeper, ctx, types.PairMetadata{
 				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-				},
 			})
+			// 0.02 NUSD / BTC
+			setCumulativePremiumFractions(perpKeeper, ctx, common.Pair_BTC_NUSD, sdk.MustNewDecFromStr("0.02"))
 
 			t.Log("close position and open reverse")
 			resp, err := perpKeeper.closeAndOpenReversePosition(
@@ -1657,6 +1639,9 @@ func TestTransferFee(t *testing.T) {
 				ctx, trader, types.FeePoolModuleAccount,
 				sdk.NewCoins(sdk.NewInt64Coin(pair.QuoteDenom(), 5)),
 			).Return(wantError)
+			mocks.mockBankKeeper.EXPECT().GetSupply(
+				ctx, types.InsuranceFundShareDenom(pair.QuoteDenom()),
+			).Return(sdk.NewInt64Coin(types.InsuranceFundShareDenom(pair.QuoteDenom()), 0))
 			mocks.mockBankKeeper.EXPECT().SendCoinsFromAccountToModule(
 				ctx, trader, types.PerpEFModuleAccount,
 				sdk.NewCoins(sdk.NewInt64Coin(pair.QuoteDenom(), 5)),
@@ -1667,6 +1652,32 @@ func TestTransferFee(t *testing.T) {
 			require.NoError(t, err)
 		})
 
+	t.Run("insurance fund has depositors - part of the ecosystem fund fee goes to the insurance fund",
+		func(t *testing.T) {
+			k, mocks, ctx, pair, trader, positionNotional := setup()
+
+			mocks.mockBankKeeper.EXPECT().SendCoinsFromAccountToModule(
+				ctx, trader, types.FeePoolModuleAccount,
+				sdk.NewCoins(sdk.NewInt64Coin(pair.QuoteDenom(), 5)),
+			).Return(nil)
+			mocks.mockBankKeeper.EXPECT().GetSupply(
+				ctx, types.InsuranceFundShareDenom(pair.QuoteDenom()),
+			).Return(sdk.NewInt64Coin(types.InsuranceFundShareDenom(pair.QuoteDenom()), 1_000))
+			mocks.mockBankKeeper.EXPECT().SendCoinsFromAccountToModule(
+				ctx, trader, types.InsuranceFundModuleAccount,
+				sdk.NewCoins(sdk.NewInt64Coin(pair.QuoteDenom(), 2)),
+			).Return(nil)
+			mocks.mockBankKeeper.EXPECT().SendCoinsFromAccountToModule(
+				ctx, trader, types.PerpEFModuleAccount,
+				sdk.NewCoins(sdk.NewInt64Coin(pair.QuoteDenom(), 3)),
+			).Return(nil)
+
+			fees, err := k.transferFee(
+				ctx, pair, trader, positionNotional)
+			require.NoError(t, err)
+			assert.EqualValues(t, sdk.NewInt(10), fees)
+		})
+
 	t.Run("not enough funds for Perp Ecosystem Fund (spread) - error",
 		func(t *testing.T) {
 			k, mocks, ctx, pair, trader, positionNotional := setup()
@@ -1675,6 +1686,9 @@ func TestTransferFee(t *testing.T) {
 				ctx, trader, types.FeePoolModuleAccount,
 				sdk.NewCoins(sdk.NewInt64Coin(pair.QuoteDenom(), 5)),
 			).Return(nil)
+			mocks.mockBankKeeper.EXPECT().GetSupply(
+				ctx, types.InsuranceFundShareDenom(pair.QuoteDenom()),
+			).Return(sdk.NewInt64Coin(types.InsuranceFundShareDenom(pair.QuoteDenom()), 0))
 
 			expectedError := fmt.Errorf(
 				"trader missing funds for %s", types.PerpEFModuleAccount)
@@ -1691,6 +1705,10 @@ func TestTransferFee(t *testing.T) {
 		func(t *testing.T) {
 			k, mocks, ctx, pair, trader, positionNotional := setup()
 
+			mocks.mockBankKeeper.EXPECT().GetSupply(
+				ctx, types.InsuranceFundShareDenom(pair.QuoteDenom()),
+			).Return(sdk.NewInt64Coin(types.InsuranceFundShareDenom(pair.QuoteDenom()), 0))
+
 			expectedError := fmt.Errorf(
 				"trader missing funds for %s", types.FeePoolModuleAccount)
 			mocks.mockBankKeeper.EXPECT().SendCoinsFromAccountToModule(
@@ -1894,10 +1912,9 @@ func TestClosePosition(t *testing.T) {
 			t.Log("set up pair metadata and last cumulative funding rate")
 			setPairMetadata(perpKeeper, ctx, types.PairMetadata{
 				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-				},
 			})
+			// 0.02 NUSD / BTC
+			setCumulativePremiumFractions(perpKeeper, ctx, common.Pair_BTC_NUSD, sdk.MustNewDecFromStr("0.02"))
 
 			t.Log("close position")
 			resp, err := perpKeeper.ClosePosition(
@@ -1930,6 +1947,7 @@ func TestClosePosition(t *testing.T) {
 				Margin:                sdk.NewInt64Coin(tc.initialPosition.Pair.QuoteDenom(), 0),
 				PositionNotional:      sdk.ZeroDec(),
 				ExchangedPositionSize: tc.initialPosition.Size_.Neg(),
+				ExchangedNotional:     tc.newPositionNotional,
 				PositionSize:          sdk.ZeroDec(),
 				RealizedPnl:           tc.expectedRealizedPnl,
 				UnrealizedPnlAfter:    sdk.ZeroDec(),
@@ -2032,10 +2050,9 @@ func TestClosePositionWithBadDebt(t *testing.T) {
 			t.Log("set up pair metadata and last cumulative funding rate")
 			setPairMetadata(perpKeeper, ctx, types.PairMetadata{
 				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-				},
 			})
+			// 0.02 NUSD / BTC
+			setCumulativePremiumFractions(perpKeeper, ctx, common.Pair_BTC_NUSD, sdk.MustNewDecFromStr("0.02"))
 
 			t.Log("close position")
 			resp, err := perpKeeper.ClosePosition(
@@ -2057,3 +2074,10 @@ func setPosition(k Keeper, ctx sdk.Context, pos types.Position) {
 func setPairMetadata(k Keeper, ctx sdk.Context, pm types.PairMetadata) {
 	k.PairsMetadata.Insert(ctx, pm.Pair, pm)
 }
+
+// setCumulativePremiumFractions records the cumulative premium fractions, in order, as the funding epochs of the pair.
+func setCumulativePremiumFractions(k Keeper, ctx sdk.Context, pair common.AssetPair, cumulativePremiumFractions ...sdk.Dec) {
+	for epoch, cumulativePremiumFraction := range cumulativePremiumFractions {
+		k.CumulativePremiumFractions.Insert(ctx, collections.Join(pair, uint64(epoch)), cumulativePremiumFraction)
+	}
+}
@@ -0,0 +1,336 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+)
+
+/*
+SetMarginMode switches the margin mode of a trader.
+
+In CROSS mode, the positions of the trader that share a quote denom form a
+single account: their margin is pooled for margin ratio checks, free collateral
+and liquidations. Switching back to ISOLATED mode is only allowed if every open
+position of the trader is above its maintenance margin ratio on its own.
+
+args:
+  - ctx: cosmos-sdk context
+  - traderAddr: the trader switching margin mode
+  - marginMode: ISOLATED or CROSS
+
+ret:
+  - err: error
+*/
+func (k Keeper) SetMarginMode(ctx sdk.Context, traderAddr sdk.AccAddress, marginMode types.MarginMode) (err error) {
+	switch marginMode {
+	case types.MarginMode_CROSS:
+		k.CrossMarginAccounts.Insert(ctx, traderAddr)
+	case types.MarginMode_ISOLATED:
+		for _, pool := range k.VpoolKeeper.GetAllPools(ctx) {
+			position, err := k.Positions.Get(ctx, collections.Join(pool.Pair, traderAddr))
+			if err != nil || position.Size_.IsZero() {
+				continue
+			}
+
+			marginRatio, err := k.getIsolatedMarginRatio(ctx, position, types.MarginCalculationPriceOption_MAX_PNL)
+			if err != nil {
+				return err
+			}
+			maintenanceMarginRatio := k.VpoolKeeper.GetMaintenanceMarginRatio(ctx, pool.Pair)
+			if err = requireMoreMarginRatio(marginRatio, maintenanceMarginRatio, true); err != nil {
+				return sdkerrors.Wrapf(types.ErrMarginRatioTooLow, "position on %s cannot be isolated", pool.Pair)
+			}
+		}
+
+		k.CrossMarginAccounts.Delete(ctx, traderAddr)
+	default:
+		return fmt.Errorf("invalid margin mode: %s", marginMode)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.MarginModeChangedEvent{
+		TraderAddress: traderAddr.String(),
+		MarginMode:    marginMode,
+		BlockHeight:   ctx.BlockHeight(),
+	})
+}
+
+// GetMarginMode returns the margin mode of a trader.
+func (k Keeper) GetMarginMode(ctx sdk.Context, traderAddr sdk.AccAddress) types.MarginMode {
+	if k.CrossMarginAccounts.Has(ctx, traderAddr) {
+		return types.MarginMode_CROSS
+	}
+	return types.MarginMode_ISOLATED
+}
+
+/*
+GetAccountMarginRatio computes the margin ratio of the cross margin account of
+a trader in the given quote denom.
+
+ret:
+  - marginRatio: the sum of the remaining margins of the positions, divided by
+    the sum of their notionals
+  - maintenanceMarginRatio: the notional weighted maintenance margin ratio of
+    the pairs of the positions
+  - err: error
+*/
+func (k Keeper) GetAccountMarginRatio(
+	ctx sdk.Context,
+	traderAddr sdk.AccAddress,
+	quoteDenom string,
+	priceOption types.MarginCalculationPriceOption,
+) (marginRatio sdk.Dec, maintenanceMarginRatio sdk.Dec, err error) {
+	return k.calcAccountMarginRatio(ctx, k.getTraderPositions(ctx, traderAddr, quoteDenom), priceOption)
+}
+
+// isCrossMarginPosition returns true if the position belongs to a cross margin account.
+func (k Keeper) isCrossMarginPosition(ctx sdk.Context, position types.Position) bool {
+	traderAddr, err := sdk.AccAddressFromBech32(position.TraderAddress)
+	if err != nil {
+		return false
+	}
+	return k.CrossMarginAccounts.Has(ctx, traderAddr)
+}
+
+// getTraderPositions returns the open positions of a trader on pairs quoted in
+// quoteDenom, sorted by pair.
+func (k Keeper) getTraderPositions(ctx sdk.Context, traderAddr sdk.AccAddress, quoteDenom string) []types.Position {
+	var positions []types.Position
+	for _, pool := range k.VpoolKeeper.GetAllPools(ctx) {
+		if pool.Pair.QuoteDenom() != quoteDenom {
+			continue
+		}
+
+		position, err := k.Positions.Get(ctx, collections.Join(pool.Pair, traderAddr))
+		if err != nil || position.Size_.IsZero() {
+			continue
+		}
+		positions = append(positions, position)
+	}
+	return positions
+}
+
+// getAccountPositions returns the positions of the cross margin account the
+// position belongs to. The given position replaces its stored version, so that
+// updates which are not persisted yet are accounted for.
+func (k Keeper) getAccountPositions(ctx sdk.Context, position types.Position) []types.Position {
+	positions := k.getTraderPositions(
+		ctx,
+		sdk.MustAccAddressFromBech32(position.TraderAddress),
+		position.Pair.QuoteDenom(),
+	)
+
+	for i := range positions {
+		if positions[i].Pair == position.Pair {
+			if position.Size_.IsZero() {
+				return append(positions[:i], positions[i+1:]...)
+			}
+			positions[i] = position
+			return positions
+		}
+	}
+
+	if !position.Size_.IsZero() {
+		positions = append(positions, position)
+	}
+	return positions
+}
+
+// calcAccountMarginRatio computes the margin ratio and the notional weighted
+// maintenance margin ratio of a set of positions sharing the same quote denom.
+func (k Keeper) calcAccountMarginRatio(
+	ctx sdk.Context, positions []types.Position, priceOption types.MarginCalculationPriceOption,
+) (marginRatio sdk.Dec, maintenanceMarginRatio sdk.Dec, err error) {
+	if len(positions) == 0 {
+		return sdk.Dec{}, sdk.Dec{}, types.ErrPositionZero
+	}
+
+	totalMargin := sdk.ZeroDec()
+	totalNotional := sdk.ZeroDec()
+	totalMaintenanceMargin := sdk.ZeroDec()
+	for _, position := range positions {
+		positionNotional, unrealizedPnL, err := k.getMarginCalculationNotionalAndPnL(ctx, position, priceOption)
+		if err != nil {
+			return sdk.Dec{}, sdk.Dec{}, err
+		}
+
+		remaining, err := k.CalcRemainMarginWithFundingPayment(ctx, position, unrealizedPnL)
+		if err != nil {
+			return sdk.Dec{}, sdk.Dec{}, err
+		}
+
+		totalMargin = totalMargin.Add(remaining.Margin).Sub(remaining.BadDebt)
+		totalNotional = totalNotional.Add(positionNotional)
+		totalMaintenanceMargin = totalMaintenanceMargin.Add(
+			positionNotional.Mul(k.VpoolKeeper.GetMaintenanceMarginRatio(ctx, position.Pair)),
+		)
+	}
+
+	if totalNotional.IsZero() {
+		// NOTE causes division by zero in margin ratio calculation
+		return sdk.Dec{}, sdk.Dec{},
+			fmt.Errorf("margin ratio doesn't make sense with zero position notional")
+	}
+
+	return totalMargin.Quo(totalNotional), totalMaintenanceMargin.Quo(totalNotional), nil
+}
+
+// calcAccountFreeCollateral computes the free collateral of a set of positions
+// sharing the same quote denom. See calcFreeCollateral.
+func (k Keeper) calcAccountFreeCollateral(
+	ctx sdk.Context, positions []types.Position,
+) (freeCollateral sdk.Dec, err error) {
+	freeCollateral = sdk.ZeroDec()
+	for _, position := range positions {
+		positionNotional, unrealizedPnL, err := k.GetPreferencePositionNotionalAndUnrealizedPnL(
+			ctx,
+			position,
+			types.PnLPreferenceOption_MIN,
+		)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+
+		remainingMargin := sdk.MinDec(position.Margin, position.Margin.Add(unrealizedPnL))
+		maintenanceMarginRatio := k.VpoolKeeper.GetMaintenanceMarginRatio(ctx, position.Pair)
+		freeCollateral = freeCollateral.Add(remainingMargin).Sub(positionNotional.Mul(maintenanceMarginRatio))
+	}
+
+	return freeCollateral, nil
+}
+
+// isOverSpreadLimit returns true if the pair of the position, or any pair of
+// its cross margin account, is over the spread limit.
+func (k Keeper) isOverSpreadLimit(ctx sdk.Context, position types.Position) bool {
+	if !k.isCrossMarginPosition(ctx, position) {
+		return k.VpoolKeeper.IsOverSpreadLimit(ctx, position.Pair)
+	}
+
+	for _, accountPosition := range k.getAccountPositions(ctx, position) {
+		if k.VpoolKeeper.IsOverSpreadLimit(ctx, accountPosition.Pair) {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+coverBadDebtWithAccountMargin uses the margin of the other positions of the
+cross margin account to cover the bad debt of a position. The margin stays in
+the vault, so no funds are moved.
+
+ret:
+  - remainingBadDebt: the bad debt that could not be covered by the account
+*/
+func (k Keeper) coverBadDebtWithAccountMargin(
+	ctx sdk.Context, position types.Position, badDebt sdk.Dec,
+) (remainingBadDebt sdk.Dec) {
+	traderAddr := sdk.MustAccAddressFromBech32(position.TraderAddress)
+
+	remainingBadDebt = badDebt
+	for _, sibling := range k.getTraderPositions(ctx, traderAddr, position.Pair.QuoteDenom()) {
+		if !remainingBadDebt.IsPositive() {
+			break
+		}
+		if sibling.Pair == position.Pair || !sibling.Margin.IsPositive() {
+			continue
+		}
+
+		covered := sdk.MinDec(sibling.Margin, remainingBadDebt)
+		sibling.Margin = sibling.Margin.Sub(covered)
+		remainingBadDebt = remainingBadDebt.Sub(covered)
+		k.Positions.Insert(ctx, collections.Join(sibling.Pair, traderAddr), sibling)
+	}
+
+	return remainingBadDebt
+}
+
+// positionsByLargestLoss sorts positions by their loss, including funding
+// payments, at the spot price. The position with the largest loss comes first.
+func (k Keeper) positionsByLargestLoss(ctx sdk.Context, positions []types.Position) ([]types.Position, error) {
+	losses := make(map[string]sdk.Dec, len(positions))
+	for _, position := range positions {
+		_, unrealizedPnL, err := k.getPositionNotionalAndUnrealizedPnL(ctx, position, types.PnLCalcOption_SPOT_PRICE)
+		if err != nil {
+			return nil, err
+		}
+
+		remaining, err := k.CalcRemainMarginWithFundingPayment(ctx, position, unrealizedPnL)
+		if err != nil {
+			return nil, err
+		}
+
+		losses[position.Pair.String()] = position.Margin.Sub(remaining.Margin).Add(remaining.BadDebt)
+	}
+
+	sort.SliceStable(positions, func(i, j int) bool {
+		return losses[positions[i].Pair.String()].GT(losses[positions[j].Pair.String()])
+	})
+	return positions, nil
+}
+
+/*
+liquidateCrossMarginAccount fully liquidates the positions of a cross margin
+account, in order of largest loss, until the account is back above its
+maintenance margin ratio or has no position left. It is assumed that the margin
+ratio of the account has already been checked prior to calling this method.
+
+args:
+  - ctx: cosmos-sdk context
+  - liquidatorAddr: the liquidator who is executing the liquidation
+  - position: any position of the account
+
+ret:
+  - feeToLiquidator: the total amount of coins given to the liquidator
+  - feeToFund: the total amount of coins given to the ecosystem fund
+  - err: error
+*/
+func (k Keeper) liquidateCrossMarginAccount(
+	ctx sdk.Context, liquidatorAddr sdk.AccAddress, position types.Position,
+) (feeToLiquidator sdk.Coin, feeToFund sdk.Coin, err error) {
+	traderAddr := sdk.MustAccAddressFromBech32(position.TraderAddress)
+	quoteDenom := position.Pair.QuoteDenom()
+
+	positions, err := k.positionsByLargestLoss(ctx, k.getTraderPositions(ctx, traderAddr, quoteDenom))
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	feeToLiquidator = sdk.NewInt64Coin(quoteDenom, 0)
+	feeToFund = sdk.NewInt64Coin(quoteDenom, 0)
+	for _, toLiquidate := range positions {
+		// the margin of the position may have been used to cover bad debt
+		current, err := k.Positions.Get(ctx, collections.Join(toLiquidate.Pair, traderAddr))
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+
+		liquidationResponse, err := k.ExecuteFullLiquidation(ctx, liquidatorAddr, &current)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+		feeToLiquidator = feeToLiquidator.AddAmount(liquidationResponse.FeeToLiquidator)
+		feeToFund = feeToFund.AddAmount(liquidationResponse.FeeToPerpEcosystemFund)
+
+		remainingPositions := k.getTraderPositions(ctx, traderAddr, quoteDenom)
+		if len(remainingPositions) == 0 {
+			break
+		}
+
+		marginRatio, maintenanceMarginRatio, err := k.calcAccountMarginRatio(
+			ctx, remainingPositions, types.MarginCalculationPriceOption_MAX_PNL)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+		if marginRatio.GTE(maintenanceMarginRatio) {
+			break
+		}
+	}
+
+	return feeToLiquidator, feeToFund, nil
+}
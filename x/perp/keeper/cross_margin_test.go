@@ -0,0 +1,179 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/collections"
+	nibisimapp "github.com/NibiruChain/nibiru/simapp"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+)
+
+func setupCrossMarginTest(t *testing.T) (*nibisimapp.NibiruTestApp, sdk.Context) {
+	nibiruApp, ctx := nibisimapp.NewTestNibiruAppAndContext(true)
+	ctx = ctx.WithBlockTime(time.Now())
+
+	oracle := testutil.AccAddress()
+	nibiruApp.PricefeedKeeper.WhitelistOracles(ctx, []sdk.AccAddress{oracle})
+
+	for _, pair := range []common.AssetPair{common.Pair_BTC_NUSD, common.Pair_ETH_NUSD} {
+		require.NoError(t, nibiruApp.PricefeedKeeper.PostRawPrice(ctx, oracle, pair.String(), sdk.OneDec(), time.Now().Add(time.Hour)))
+		require.NoError(t, nibiruApp.PricefeedKeeper.GatherRawPrices(ctx, pair.BaseDenom(), pair.QuoteDenom()))
+
+		nibiruApp.VpoolKeeper.CreatePool(
+			ctx,
+			pair,
+			/* tradeLimitRatio */ sdk.OneDec(),
+			/* quoteReserve */ sdk.NewDec(1_000_000_000_000),
+			/* baseReserve */ sdk.NewDec(1_000_000_000_000),
+			/* fluctuationLimit */ sdk.OneDec(),
+			/* maxOracleSpreadRatio */ sdk.OneDec(),
+			/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
+			/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+			/* maxOpenInterest */ sdk.ZeroDec(),
+			/* maxPositionSize */ sdk.ZeroDec(),
+		)
+		setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
+			Pair: pair,
+		})
+	}
+
+	return nibiruApp, ctx
+}
+
+func setCrossMarginPosition(
+	nibiruApp *nibisimapp.NibiruTestApp, ctx sdk.Context, trader sdk.AccAddress,
+	pair common.AssetPair, size, openNotional, margin int64,
+) {
+	nibiruApp.PerpKeeper.Positions.Insert(ctx, collections.Join(pair, trader), types.Position{
+		TraderAddress:                   trader.String(),
+		Pair:                            pair,
+		Size_:                           sdk.NewDec(size),
+		Margin:                          sdk.NewDec(margin),
+		OpenNotional:                    sdk.NewDec(openNotional),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	})
+}
+
+func TestSetMarginMode(t *testing.T) {
+	t.Run("cross margin pools the margin of the positions", func(t *testing.T) {
+		nibiruApp, ctx := setupCrossMarginTest(t)
+		trader := testutil.AccAddress()
+		setCrossMarginPosition(nibiruApp, ctx, trader, common.Pair_BTC_NUSD, 100, 100, 1)
+		setCrossMarginPosition(nibiruApp, ctx, trader, common.Pair_ETH_NUSD, 100, 100, 50)
+
+		require.NoError(t, nibiruApp.PerpKeeper.SetMarginMode(ctx, trader, types.MarginMode_CROSS))
+		assert.EqualValues(t, types.MarginMode_CROSS, nibiruApp.PerpKeeper.GetMarginMode(ctx, trader))
+
+		position, err := nibiruApp.PerpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, trader))
+		require.NoError(t, err)
+		marginRatio, err := nibiruApp.PerpKeeper.GetMarginRatio(ctx, position, types.MarginCalculationPriceOption_SPOT)
+		require.NoError(t, err)
+		assert.InDelta(t, 0.255, marginRatio.MustFloat64(), 0.0001)
+
+		marginRatio, maintenanceMarginRatio, err := nibiruApp.PerpKeeper.GetAccountMarginRatio(
+			ctx, trader, common.DenomNUSD, types.MarginCalculationPriceOption_SPOT)
+		require.NoError(t, err)
+		assert.InDelta(t, 0.255, marginRatio.MustFloat64(), 0.0001)
+		assert.EqualValues(t, sdk.MustNewDecFromStr("0.0625"), maintenanceMarginRatio)
+	})
+
+	t.Run("cannot isolate an undercollateralized position", func(t *testing.T) {
+		nibiruApp, ctx := setupCrossMarginTest(t)
+		trader := testutil.AccAddress()
+		setCrossMarginPosition(nibiruApp, ctx, trader, common.Pair_BTC_NUSD, 100, 100, 1)
+		setCrossMarginPosition(nibiruApp, ctx, trader, common.Pair_ETH_NUSD, 100, 100, 50)
+		require.NoError(t, nibiruApp.PerpKeeper.SetMarginMode(ctx, trader, types.MarginMode_CROSS))
+
+		err := nibiruApp.PerpKeeper.SetMarginMode(ctx, trader, types.MarginMode_ISOLATED)
+		require.ErrorIs(t, err, types.ErrMarginRatioTooLow)
+		assert.EqualValues(t, types.MarginMode_CROSS, nibiruApp.PerpKeeper.GetMarginMode(ctx, trader))
+	})
+
+	t.Run("isolate healthy positions", func(t *testing.T) {
+		nibiruApp, ctx := setupCrossMarginTest(t)
+		trader := testutil.AccAddress()
+		setCrossMarginPosition(nibiruApp, ctx, trader, common.Pair_BTC_NUSD, 100, 100, 10)
+		setCrossMarginPosition(nibiruApp, ctx, trader, common.Pair_ETH_NUSD, 100, 100, 50)
+		require.NoError(t, nibiruApp.PerpKeeper.SetMarginMode(ctx, trader, types.MarginMode_CROSS))
+
+		require.NoError(t, nibiruApp.PerpKeeper.SetMarginMode(ctx, trader, types.MarginMode_ISOLATED))
+		assert.EqualValues(t, types.MarginMode_ISOLATED, nibiruApp.PerpKeeper.GetMarginMode(ctx, trader))
+	})
+
+	t.Run("invalid margin mode", func(t *testing.T) {
+		nibiruApp, ctx := setupCrossMarginTest(t)
+
+		err := nibiruApp.PerpKeeper.SetMarginMode(ctx, testutil.AccAddress(), types.MarginMode_MARGIN_MODE_UNSPECIFIED)
+		require.Error(t, err)
+	})
+}
+
+func TestLiquidateCrossMarginAccount(t *testing.T) {
+	testCases := []struct {
+		name        string
+		ethMargin   int64
+		ethIsClosed bool
+	}{
+		{
+			name:        "liquidating the largest loss restores the account",
+			ethMargin:   80,
+			ethIsClosed: false,
+		},
+		{
+			name:        "every position is liquidated",
+			ethMargin:   60,
+			ethIsClosed: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			nibiruApp, ctx := setupCrossMarginTest(t)
+			trader := testutil.AccAddress()
+			liquidator := testutil.AccAddress()
+
+			params := types.DefaultParams()
+			params.WhitelistedLiquidators = []string{liquidator.String()}
+			nibiruApp.PerpKeeper.SetParams(ctx, params)
+
+			startingModuleFunds := sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000_000))
+			require.NoError(t, simapp.FundModuleAccount(
+				nibiruApp.BankKeeper, ctx, types.VaultModuleAccount, startingModuleFunds))
+			require.NoError(t, simapp.FundModuleAccount(
+				nibiruApp.BankKeeper, ctx, types.PerpEFModuleAccount, startingModuleFunds))
+
+			// BTC is underwater by 50, ETH is flat
+			setCrossMarginPosition(nibiruApp, ctx, trader, common.Pair_BTC_NUSD, 1000, 1100, 50)
+			setCrossMarginPosition(nibiruApp, ctx, trader, common.Pair_ETH_NUSD, 100, 100, tc.ethMargin)
+			require.NoError(t, nibiruApp.PerpKeeper.SetMarginMode(ctx, trader, types.MarginMode_CROSS))
+
+			feeToLiquidator, _, err := nibiruApp.PerpKeeper.Liquidate(ctx, liquidator, common.Pair_ETH_NUSD, trader)
+			require.NoError(t, err)
+			assert.True(t, feeToLiquidator.IsPositive())
+
+			_, err = nibiruApp.PerpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, trader))
+			require.ErrorIs(t, err, collections.ErrNotFound)
+
+			ethPosition, err := nibiruApp.PerpKeeper.Positions.Get(ctx, collections.Join(common.Pair_ETH_NUSD, trader))
+			if tc.ethIsClosed {
+				require.ErrorIs(t, err, collections.ErrNotFound)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, ethPosition.Margin.LT(sdk.NewDec(tc.ethMargin)), "ETH margin covers the BTC bad debt")
+
+			_, _, err = nibiruApp.PerpKeeper.Liquidate(ctx, liquidator, common.Pair_ETH_NUSD, trader)
+			require.ErrorIs(t, err, types.ErrMarginHighEnough)
+		})
+	}
+}
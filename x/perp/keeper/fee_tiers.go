@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+)
+
+const (
+	// volumeBucketDuration is the length of the buckets the trader volumes are recorded in.
+	volumeBucketDuration = 24 * time.Hour
+	// volumeWindowBuckets is the number of buckets, including the current one, summed
+	// into the volume that picks the fee tier of a trader.
+	volumeWindowBuckets = 30
+)
+
+// volumeBucket returns the bucket of the trader volumes the block time falls in.
+func volumeBucket(ctx sdk.Context) uint64 {
+	seconds := ctx.BlockTime().Unix()
+	if seconds <= 0 {
+		return 0
+	}
+	return uint64(seconds) / uint64(volumeBucketDuration/time.Second)
+}
+
+// firstVolumeBucket returns the oldest bucket still in the volume window.
+func firstVolumeBucket(ctx sdk.Context) uint64 {
+	current := volumeBucket(ctx)
+	if current < volumeWindowBuckets-1 {
+		return 0
+	}
+	return current - (volumeWindowBuckets - 1)
+}
+
+// GetTraderVolume returns the notional volume traded by the trader over the volume window.
+func (k Keeper) GetTraderVolume(ctx sdk.Context, trader sdk.AccAddress) sdk.Dec {
+	volume := sdk.ZeroDec()
+	rng := collections.PairRange[sdk.AccAddress, uint64]{}.Prefix(trader).StartInclusive(firstVolumeBucket(ctx))
+	for _, bucketVolume := range k.TraderVolumes.Iterate(ctx, rng).Values() {
+		volume = volume.Add(bucketVolume)
+	}
+	return volume
+}
+
+// recordTraderVolume adds the notional to the volume of the trader in the current
+// bucket and prunes the buckets which fell out of the volume window.
+func (k Keeper) recordTraderVolume(ctx sdk.Context, trader sdk.AccAddress, notional sdk.Dec) {
+	if !notional.IsPositive() {
+		return
+	}
+
+	expired := collections.PairRange[sdk.AccAddress, uint64]{}.Prefix(trader).EndExclusive(firstVolumeBucket(ctx))
+	for _, key := range k.TraderVolumes.Iterate(ctx, expired).Keys() {
+		_ = k.TraderVolumes.Delete(ctx, key)
+	}
+
+	key := collections.Join(trader, volumeBucket(ctx))
+	k.TraderVolumes.Insert(ctx, key, k.TraderVolumes.GetOr(ctx, key, sdk.ZeroDec()).Add(notional))
+}
+
+// makerRebate returns the rebate owed to a trader whose trade moved the mark price of the
+// pair from markPriceBefore to markPriceAfter, which is only paid if the trade brought the
+// mark price closer to the index price. Only the part of the trade that opens or increases
+// the position rests as maker, closes and reductions earn no rebate. Without an index price
+// no rebate is owed.
+func (k Keeper) makerRebate(
+	ctx sdk.Context, pair common.AssetPair, markPriceBefore sdk.Dec, markPriceAfter sdk.Dec, positionResp types.PositionResp,
+) sdk.Int {
+	rebateRatio := k.GetParams(ctx).MakerRebateRatio
+	if !rebateRatio.IsPositive() || markPriceBefore.IsNil() {
+		return sdk.ZeroInt()
+	}
+
+	notional := makerNotional(positionResp)
+	if !notional.IsPositive() {
+		return sdk.ZeroInt()
+	}
+
+	indexPrice, err := k.PricefeedKeeper.GetCurrentPrice(ctx, pair.BaseDenom(), pair.QuoteDenom())
+	if err != nil {
+		return sdk.ZeroInt()
+	}
+	if markPriceAfter.Sub(indexPrice.Price).Abs().GTE(markPriceBefore.Sub(indexPrice.Price).Abs()) {
+		return sdk.ZeroInt()
+	}
+
+	return rebateRatio.Mul(notional).TruncateInt()
+}
+
+// makerNotional returns the exchanged notional of the part of a trade that opens or
+// increases the position. A trade reversing the position only counts the size it
+// opens on the other side.
+func makerNotional(positionResp types.PositionResp) sdk.Dec {
+	exchangedSize := positionResp.ExchangedPositionSize
+	if exchangedSize.IsNil() || exchangedSize.IsZero() || positionResp.Position == nil {
+		return sdk.ZeroDec()
+	}
+
+	newSize := positionResp.Position.Size_
+	oldSize := newSize.Sub(exchangedSize)
+	switch {
+	case oldSize.IsZero() || oldSize.IsPositive() == exchangedSize.IsPositive():
+		// opens or increases the position
+		return positionResp.ExchangedNotionalValue.Abs()
+	case newSize.IsZero() || newSize.IsPositive() == oldSize.IsPositive():
+		// closes or reduces the position
+		return sdk.ZeroDec()
+	default:
+		// reverses the position
+		return positionResp.ExchangedNotionalValue.Abs().Mul(newSize.Abs()).Quo(exchangedSize.Abs())
+	}
+}
+
+// payMakerRebate pays the rebate owed for the trade from the fee pool, as far as the fee
+// pool can cover it, and returns the rebate paid.
+func (k Keeper) payMakerRebate(
+	ctx sdk.Context, pair common.AssetPair, trader sdk.AccAddress, markPriceBefore sdk.Dec, markPriceAfter sdk.Dec,
+	positionResp types.PositionResp,
+) (sdk.Int, error) {
+	rebate := k.makerRebate(ctx, pair, markPriceBefore, markPriceAfter, positionResp)
+	if !rebate.IsPositive() {
+		return sdk.ZeroInt(), nil
+	}
+
+	feePoolBalance := k.BankKeeper.GetBalance(
+		ctx, k.AccountKeeper.GetModuleAddress(types.FeePoolModuleAccount), pair.QuoteDenom()).Amount
+	rebate = sdk.MinInt(rebate, feePoolBalance)
+	if !rebate.IsPositive() {
+		return sdk.ZeroInt(), nil
+	}
+
+	rebateCoin := sdk.NewCoin(pair.QuoteDenom(), rebate)
+	if err := k.BankKeeper.SendCoinsFromModuleToAccount(
+		ctx, types.FeePoolModuleAccount, trader, sdk.NewCoins(rebateCoin)); err != nil {
+		return sdk.Int{}, err
+	}
+
+	return rebate, ctx.EventManager().EmitTypedEvent(&types.MakerRebatePaidEvent{
+		Pair:          pair.String(),
+		TraderAddress: trader.String(),
+		Rebate:        rebateCoin,
+		BlockHeight:   ctx.BlockHeight(),
+	})
+}
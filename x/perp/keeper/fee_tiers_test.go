@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+)
+
+func TestFeeTiers(t *testing.T) {
+	pair := common.MustNewAssetPair("xxx:yyy")
+	nibiruApp, ctx := setupOpenInterestPool(t, pair, sdk.ZeroDec(), sdk.ZeroDec())
+	perpKeeper := nibiruApp.PerpKeeper
+
+	params := perpKeeper.GetParams(ctx)
+	params.FeePoolFeeRatio = sdk.MustNewDecFromStr("0.01")
+	params.EcosystemFundFeeRatio = sdk.MustNewDecFromStr("0.01")
+	params.FeeTiers = []types.FeeTier{
+		{MinVolume: sdk.NewDec(1_000), FeePoolFeeRatio: sdk.MustNewDecFromStr("0.005"), EcosystemFundFeeRatio: sdk.MustNewDecFromStr("0.005")},
+		{MinVolume: sdk.NewDec(2_000), FeePoolFeeRatio: sdk.ZeroDec(), EcosystemFundFeeRatio: sdk.MustNewDecFromStr("0.001")},
+	}
+	perpKeeper.SetParams(ctx, params)
+
+	alice := testutil.AccAddress()
+	require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("yyy", 10_000))))
+	feePool := nibiruApp.AccountKeeper.GetModuleAddress(types.FeePoolModuleAccount)
+	perpEF := nibiruApp.AccountKeeper.GetModuleAddress(types.PerpEFModuleAccount)
+	openPositionFees := func(quoteAmt int64) (feeToFeePool int64, feeToEcosystemFund int64) {
+		feePoolBefore := nibiruApp.BankKeeper.GetBalance(ctx, feePool, "yyy").Amount
+		perpEFBefore := nibiruApp.BankKeeper.GetBalance(ctx, perpEF, "yyy").Amount
+		_, err := perpKeeper.OpenPosition(ctx, pair, types.Side_BUY, alice, sdk.NewInt(quoteAmt), sdk.NewDec(5), sdk.ZeroDec())
+		require.NoError(t, err)
+		return nibiruApp.BankKeeper.GetBalance(ctx, feePool, "yyy").Amount.Sub(feePoolBefore).Int64(),
+			nibiruApp.BankKeeper.GetBalance(ctx, perpEF, "yyy").Amount.Sub(perpEFBefore).Int64()
+	}
+
+	t.Log("the base fees apply until the volume reaches the first tier")
+	feeToFeePool, feeToEcosystemFund := openPositionFees(200)
+	assert.EqualValues(t, 10, feeToFeePool)
+	assert.EqualValues(t, 10, feeToEcosystemFund)
+	assert.Equal(t, sdk.NewDec(1_000), perpKeeper.GetTraderVolume(ctx, alice))
+
+	t.Log("the first tier applies once the volume reaches it")
+	feeToFeePool, feeToEcosystemFund = openPositionFees(200)
+	assert.EqualValues(t, 5, feeToFeePool)
+	assert.EqualValues(t, 5, feeToEcosystemFund)
+	assert.Equal(t, sdk.NewDec(2_000), perpKeeper.GetTraderVolume(ctx, alice))
+
+	t.Log("the volume of the previous days counts toward the tier")
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(24 * time.Hour))
+	feeToFeePool, feeToEcosystemFund = openPositionFees(200)
+	assert.EqualValues(t, 0, feeToFeePool)
+	assert.EqualValues(t, 1, feeToEcosystemFund)
+	assert.Equal(t, sdk.NewDec(3_000), perpKeeper.GetTraderVolume(ctx, alice))
+
+	t.Log("the volume older than the window no longer counts and is pruned")
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(30 * 24 * time.Hour))
+	assert.Equal(t, sdk.ZeroDec(), perpKeeper.GetTraderVolume(ctx, alice))
+	feeToFeePool, feeToEcosystemFund = openPositionFees(200)
+	assert.EqualValues(t, 10, feeToFeePool)
+	assert.EqualValues(t, 10, feeToEcosystemFund)
+	assert.Len(t, perpKeeper.TraderVolumes.Iterate(ctx, collections.PairRange[sdk.AccAddress, uint64]{}.Prefix(alice)).Keys(), 1)
+}
+
+func TestMakerRebate(t *testing.T) {
+	pair := common.MustNewAssetPair("xxx:yyy")
+	nibiruApp, ctx := setupOpenInterestPool(t, pair, sdk.ZeroDec(), sdk.ZeroDec())
+	perpKeeper := nibiruApp.PerpKeeper
+
+	params := perpKeeper.GetParams(ctx)
+	params.FeePoolFeeRatio = sdk.MustNewDecFromStr("0.01")
+	params.EcosystemFundFeeRatio = sdk.ZeroDec()
+	params.MakerRebateRatio = sdk.MustNewDecFromStr("0.005")
+	require.NoError(t, params.Validate())
+	perpKeeper.SetParams(ctx, params)
+
+	t.Log("post an index price of 1, the mark price of the pool")
+	oracle := testutil.AccAddress()
+	nibiruApp.PricefeedKeeper.WhitelistOraclesForPairs(ctx, []sdk.AccAddress{oracle}, []common.AssetPair{pair})
+	require.NoError(t, nibiruApp.PricefeedKeeper.PostRawPrice(ctx, oracle, pair.String(), sdk.OneDec(), ctx.BlockTime().Add(time.Hour)))
+	require.NoError(t, nibiruApp.PricefeedKeeper.GatherRawPrices(ctx, pair.BaseDenom(), pair.QuoteDenom()))
+
+	alice, bob := testutil.AccAddress(), testutil.AccAddress()
+	for _, trader := range []sdk.AccAddress{alice, bob} {
+		require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin("yyy", 1_000))))
+	}
+
+	t.Log("a trade moving the mark price away from the index price earns no rebate")
+	_, err := perpKeeper.OpenPosition(ctx, pair, types.Side_BUY, alice, sdk.NewInt(100), sdk.NewDec(5), sdk.ZeroDec())
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewInt(1_000-100-5), nibiruApp.BankKeeper.GetBalance(ctx, alice, "yyy").Amount)
+
+	t.Log("a trade moving the mark price back toward the index price earns a rebate")
+	_, err = perpKeeper.OpenPosition(ctx, pair, types.Side_SELL, bob, sdk.NewInt(40), sdk.NewDec(5), sdk.ZeroDec())
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewInt(1_000-40-2+1), nibiruApp.BankKeeper.GetBalance(ctx, bob, "yyy").Amount)
+	testutil.RequireContainsTypedEvent(t, ctx, &types.MakerRebatePaidEvent{
+		Pair:          pair.String(),
+		TraderAddress: bob.String(),
+		Rebate:        sdk.NewInt64Coin("yyy", 1),
+		BlockHeight:   ctx.BlockHeight(),
+	})
+
+	t.Log("closing a position earns no rebate even if it moves the mark price toward the index price")
+	feePoolBalanceBefore := moduleBalance(nibiruApp, ctx, types.FeePoolModuleAccount, "yyy")
+	resp, err := perpKeeper.ClosePosition(ctx, pair, alice)
+	require.NoError(t, err)
+	closeFee := params.FeePoolFeeRatio.Mul(resp.ExchangedNotionalValue.Abs()).RoundInt()
+	require.True(t, closeFee.IsPositive())
+	assert.Equal(t, feePoolBalanceBefore.Add(closeFee), moduleBalance(nibiruApp, ctx, types.FeePoolModuleAccount, "yyy"))
+}
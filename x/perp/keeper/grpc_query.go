@@ -2,15 +2,19 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"github.com/NibiruChain/nibiru/collections"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/perp/types"
+	vpooltypes "github.com/NibiruChain/nibiru/x/vpool/types"
 )
 
 type queryServer struct {
@@ -95,6 +99,11 @@ func (q queryServer) position(ctx sdk.Context, pair common.AssetPair, trader sdk
 		marginRatioIndex = sdk.Dec{}
 	}
 
+	liquidationPrice, err := q.k.liquidationPrice(ctx, position)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.QueryPositionResponse{
 		Position:         &position,
 		PositionNotional: positionNotional,
@@ -102,6 +111,7 @@ func (q queryServer) position(ctx sdk.Context, pair common.AssetPair, trader sdk
 		MarginRatioMark:  marginRatioMark,
 		MarginRatioIndex: marginRatioIndex,
 		BlockNumber:      ctx.BlockHeight(),
+		LiquidationPrice: liquidationPrice,
 	}, nil
 }
 
@@ -129,23 +139,440 @@ func (q queryServer) FundingRates(
 		return nil, status.Errorf(codes.InvalidArgument, "invalid pair: %s", req.Pair)
 	}
 
-	pairMetadata, err := q.k.PairsMetadata.Get(ctx, assetPair)
-	if err != nil {
+	if _, err := q.k.PairsMetadata.Get(ctx, assetPair); err != nil {
 		return nil, status.Errorf(codes.NotFound, "could not find pair: %s", req.Pair)
 	}
 
+	pagination := req.Pagination
+	mostRecent := pagination == nil
+	if mostRecent {
+		// truncate to most recent 48 funding payments
+		// given 30 minute funding rate calculations, this should give the last 24 hours of funding payments
+		pagination = &query.PageRequest{Limit: 48, Reverse: true}
+	}
+
+	store := prefix.NewStore(
+		ctx.KVStore(q.k.storeKey),
+		append(cumulativePremiumFractionsNamespace.Prefix(), common.AssetPairKeyEncoder.Encode(assetPair)...),
+	)
 	var fundingRates []sdk.Dec
+	pageRes, err := query.Paginate(store, pagination, func(_ []byte, value []byte) error {
+		fundingRates = append(fundingRates, collections.DecValueEncoder.Decode(value))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-	// truncate to most recent 48 funding payments
-	// given 30 minute funding rate calculations, this should give the last 24 hours of funding payments
-	numFundingRates := len(pairMetadata.CumulativePremiumFractions)
-	if numFundingRates >= 48 {
-		fundingRates = pairMetadata.CumulativePremiumFractions[numFundingRates-48 : numFundingRates]
-	} else {
-		fundingRates = pairMetadata.CumulativePremiumFractions
+	if mostRecent {
+		// return the most recent funding rate last
+		for i, j := 0, len(fundingRates)-1; i < j; i, j = i+1, j-1 {
+			fundingRates[i], fundingRates[j] = fundingRates[j], fundingRates[i]
+		}
 	}
 
 	return &types.QueryFundingRatesResponse{
 		CumulativeFundingRates: fundingRates,
+		Pagination:             pageRes,
+	}, nil
+}
+
+func (q queryServer) QueryOrders(
+	goCtx context.Context, req *types.QueryOrdersRequest,
+) (*types.QueryOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	traderAddr, err := sdk.AccAddressFromBech32(req.Trader)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid trader address: %s", req.Trader)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var pairs []common.AssetPair
+	if req.TokenPair != "" {
+		pair, err := common.NewAssetPair(req.TokenPair)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid pair: %s", req.TokenPair)
+		}
+		pairs = append(pairs, pair)
+	} else {
+		for _, pool := range q.k.VpoolKeeper.GetAllPools(ctx) {
+			pairs = append(pairs, pool.Pair)
+		}
+	}
+
+	orders := []types.Order{}
+	for _, pair := range pairs {
+		pairOrders, err := q.k.GetOrders(ctx, pair, traderAddr)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, pairOrders...)
+	}
+
+	return &types.QueryOrdersResponse{Orders: orders}, nil
+}
+
+func (q queryServer) QueryMarginAccount(
+	goCtx context.Context, req *types.QueryMarginAccountRequest,
+) (*types.QueryMarginAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	traderAddr, err := sdk.AccAddressFromBech32(req.Trader)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid trader address: %s", req.Trader)
+	}
+	if err = sdk.ValidateDenom(req.QuoteDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid quote denom: %s", req.QuoteDenom)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	resp := &types.QueryMarginAccountResponse{
+		MarginMode:             q.k.GetMarginMode(ctx, traderAddr),
+		MarginRatioMark:        sdk.ZeroDec(),
+		MaintenanceMarginRatio: sdk.ZeroDec(),
+		FreeCollateral:         sdk.ZeroDec(),
+	}
+
+	positions := q.k.getTraderPositions(ctx, traderAddr, req.QuoteDenom)
+	if resp.MarginMode != types.MarginMode_CROSS || len(positions) == 0 {
+		return resp, nil
+	}
+
+	resp.MarginRatioMark, resp.MaintenanceMarginRatio, err = q.k.calcAccountMarginRatio(
+		ctx, positions, types.MarginCalculationPriceOption_MAX_PNL)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.FreeCollateral, err = q.k.calcAccountFreeCollateral(ctx, positions)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (q queryServer) QueryInsuranceFund(
+	goCtx context.Context, req *types.QueryInsuranceFundRequest,
+) (*types.QueryInsuranceFundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom: %s", req.Denom)
+	}
+
+	assets, shares := q.k.GetInsuranceFund(sdk.UnwrapSDKContext(goCtx), req.Denom)
+
+	sharePrice := sdk.ZeroDec()
+	if shares.IsPositive() {
+		sharePrice = assets.Amount.ToDec().QuoInt(shares.Amount)
+	}
+
+	return &types.QueryInsuranceFundResponse{
+		Assets:     assets,
+		Shares:     shares,
+		SharePrice: sharePrice,
+	}, nil
+}
+
+func (q queryServer) QueryInsuranceFundWithdrawal(
+	goCtx context.Context, req *types.QueryInsuranceFundWithdrawalRequest,
+) (*types.QueryInsuranceFundWithdrawalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	depositor, err := sdk.AccAddressFromBech32(req.Depositor)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid depositor address: %s", req.Depositor)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	withdrawal, err := q.k.InsuranceFundWithdrawals.Get(ctx, collections.Join(depositor, req.Denom))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no pending withdrawal of %s from %s", req.Depositor, req.Denom)
+	}
+
+	return &types.QueryInsuranceFundWithdrawalResponse{
+		Withdrawal: withdrawal,
+		Value:      q.k.GetInsuranceFundSharesValue(ctx, withdrawal.Shares),
+	}, nil
+}
+
+func (q queryServer) QueryOpenInterest(
+	goCtx context.Context, req *types.QueryOpenInterestRequest,
+) (*types.QueryOpenInterestResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	pair, err := common.NewAssetPair(req.TokenPair)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pair: %s", req.TokenPair)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !q.k.VpoolKeeper.ExistsPool(ctx, pair) {
+		return nil, status.Errorf(codes.NotFound, "no vpool for pair %s", pair)
+	}
+
+	maxOpenInterest, maxPositionSize := q.k.VpoolKeeper.GetPositionLimits(ctx, pair)
+	return &types.QueryOpenInterestResponse{
+		OpenInterest:    q.k.GetOpenInterest(ctx, pair),
+		MaxOpenInterest: maxOpenInterest,
+		MaxPositionSize: maxPositionSize,
+	}, nil
+}
+
+func (q queryServer) QueryPositionsByPair(
+	goCtx context.Context, req *types.QueryPositionsByPairRequest,
+) (*types.QueryPositionsByPairResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	pair, err := common.NewAssetPair(req.TokenPair)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pair: %s", req.TokenPair)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the keys of the index under the pair prefix are the primary keys of the positions
+	store := prefix.NewStore(
+		ctx.KVStore(q.k.storeKey),
+		append(positionsByPairNamespace.Prefix(), common.AssetPairKeyEncoder.Encode(pair)...),
+	)
+	primaryKeyEncoder := collections.PairKeyEncoder(common.AssetPairKeyEncoder, collections.AccAddressKeyEncoder)
+	var positions []*types.QueryPositionResponse
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		_, primaryKey := primaryKeyEncoder.Decode(key)
+		position, err := q.position(ctx, primaryKey.K1(), primaryKey.K2())
+		if err != nil {
+			return err
+		}
+		positions = append(positions, position)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryPositionsByPairResponse{
+		Positions:  positions,
+		Pagination: pageRes,
+	}, nil
+}
+
+// defaultLiquidatablePositionsLimit is the number of positions returned by the
+// liquidatable positions query when no limit is given.
+const defaultLiquidatablePositionsLimit = 100
+
+func (q queryServer) QueryLiquidatablePositions(
+	goCtx context.Context, req *types.QueryLiquidatablePositionsRequest,
+) (*types.QueryLiquidatablePositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	pair, err := common.NewAssetPair(req.TokenPair)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pair: %s", req.TokenPair)
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultLiquidatablePositionsLimit
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	positions := []*types.QueryPositionResponse{}
+	iter := q.k.Positions.Indexes.PositionsByPair.ExactMatch(ctx, pair)
+	defer iter.Close()
+	for ; iter.Valid() && uint64(len(positions)) < limit; iter.Next() {
+		primaryKey := iter.PrimaryKey()
+		position, err := q.k.Positions.Get(ctx, primaryKey)
+		if err != nil {
+			return nil, err
+		}
+
+		liquidatable, err := q.k.tryIsLiquidatable(ctx, position)
+		if err != nil {
+			q.k.Logger(ctx).Error("failed to check if position is liquidatable",
+				"pair", pair.String(), "trader", position.TraderAddress, "error", err)
+			continue
+		}
+		if !liquidatable {
+			continue
+		}
+
+		res, err := q.position(ctx, primaryKey.K1(), primaryKey.K2())
+		if err != nil {
+			return nil, err
+		}
+		positions = append(positions, res)
+	}
+
+	return &types.QueryLiquidatablePositionsResponse{Positions: positions}, nil
+}
+
+func (q queryServer) EstimateOpenPosition(
+	goCtx context.Context, req *types.QueryEstimateOpenPositionRequest,
+) (*types.QueryEstimateOpenPositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	pair, err := common.NewAssetPair(req.TokenPair)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pair: %s", req.TokenPair)
+	}
+	traderAddr, err := sdk.AccAddressFromBech32(req.Trader)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.Side != types.Side_BUY && req.Side != types.Side_SELL {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid side: %s", req.Side)
+	}
+	if req.QuoteAssetAmount.IsNil() || !req.QuoteAssetAmount.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "quote asset amount must be positive")
+	}
+	if req.Leverage.IsNil() || !req.Leverage.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "leverage must be positive")
+	}
+	baseAmtLimit := sdk.ZeroInt()
+	if !req.BaseAssetAmountLimit.IsNil() {
+		if req.BaseAssetAmountLimit.IsNegative() {
+			return nil, status.Error(codes.InvalidArgument, "base asset amount limit must not be negative")
+		}
+		baseAmtLimit = req.BaseAssetAmountLimit
+	}
+
+	// the position change runs on a branch of the state that is never written
+	// back, with its own event manager so that queries don't leak events
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	cachedCtx, _ := ctx.CacheContext()
+	cachedCtx = cachedCtx.WithEventManager(sdk.NewEventManager())
+
+	positionResp, err := q.k.OpenPosition(
+		cachedCtx, pair, req.Side, traderAddr, req.QuoteAssetAmount, req.Leverage, baseAmtLimit.ToDec())
+	if err != nil {
+		return &types.QueryEstimateOpenPositionResponse{
+			Failure: estimateFailure(err),
+			Error:   err.Error(),
+		}, nil
+	}
+
+	markPriceBefore, err := q.k.VpoolKeeper.GetMarkPrice(ctx, pair)
+	if err != nil {
+		return nil, err
+	}
+	markPrice, err := q.k.VpoolKeeper.GetMarkPrice(cachedCtx, pair)
+	if err != nil {
+		return nil, err
+	}
+
+	marginRatio, liquidationPrice := sdk.ZeroDec(), sdk.ZeroDec()
+	if !positionResp.Position.Size_.IsZero() {
+		marginRatio, err = q.k.GetMarginRatio(cachedCtx, *positionResp.Position, types.MarginCalculationPriceOption_MAX_PNL)
+		if err != nil {
+			return nil, err
+		}
+		liquidationPrice, err = q.k.liquidationPrice(cachedCtx, *positionResp.Position)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	feeToFeePool, feeToEcosystemFund, feeToInsuranceFund := q.k.calcFees(ctx, pair, traderAddr, positionResp.ExchangedNotionalValue)
+	makerRebate := q.k.makerRebate(ctx, pair, markPriceBefore, markPrice, *positionResp)
+
+	return &types.QueryEstimateOpenPositionResponse{
+		Position:               positionResp.Position,
+		ExchangedPositionSize:  positionResp.ExchangedPositionSize,
+		ExchangedNotionalValue: positionResp.ExchangedNotionalValue,
+		MarkPrice:              markPrice,
+		FeeToFeePool:           sdk.NewCoin(pair.QuoteDenom(), feeToFeePool),
+		FeeToEcosystemFund:     sdk.NewCoin(pair.QuoteDenom(), feeToEcosystemFund),
+		FeeToInsuranceFund:     sdk.NewCoin(pair.QuoteDenom(), feeToInsuranceFund),
+		FundingPayment:         positionResp.FundingPayment,
+		RealizedPnl:            positionResp.RealizedPnl,
+		MarginToVault:          positionResp.MarginToVault,
+		MarginRatio:            marginRatio,
+		LiquidationPrice:       liquidationPrice,
+		MakerRebate:            sdk.NewCoin(pair.QuoteDenom(), makerRebate),
+	}, nil
+}
+
+// estimateFailure maps the error of an estimated position change to the limit it failed.
+func estimateFailure(err error) types.EstimateFailure {
+	switch {
+	case errors.Is(err, vpooltypes.ErrOverTradingLimit):
+		return types.EstimateFailure_TRADE_LIMIT
+	case errors.Is(err, vpooltypes.ErrOverFluctuationLimit):
+		return types.EstimateFailure_FLUCTUATION_LIMIT
+	case errors.Is(err, types.ErrLeverageIsTooHigh):
+		return types.EstimateFailure_MAX_LEVERAGE
+	default:
+		return types.EstimateFailure_OTHER_FAILURE
+	}
+}
+
+func (q queryServer) QueryAutoDeleverageRank(
+	goCtx context.Context, req *types.QueryAutoDeleverageRankRequest,
+) (*types.QueryAutoDeleverageRankResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	pair, err := common.NewAssetPair(req.TokenPair)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pair: %s", req.TokenPair)
+	}
+	traderAddr, err := sdk.AccAddressFromBech32(req.Trader)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	position, err := q.k.Positions.Get(ctx, collections.Join(pair, traderAddr))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	queue := q.k.autoDeleverageQueue(ctx, pair, position.Size_.IsPositive())
+	resp := &types.QueryAutoDeleverageRankResponse{
+		QueueSize: uint64(len(queue)),
+		Score:     sdk.ZeroDec(),
+	}
+	for i, entry := range queue {
+		if entry.position.TraderAddress == position.TraderAddress {
+			resp.Rank = uint64(i + 1)
+			resp.Score = entry.score
+			break
+		}
+	}
+
+	return resp, nil
+}
+
+func (q queryServer) QuerySubaccounts(
+	goCtx context.Context, req *types.QuerySubaccountsRequest,
+) (*types.QuerySubaccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QuerySubaccountsResponse{
+		Subaccounts: q.k.Subaccounts.Iterate(ctx, collections.PairRange[sdk.AccAddress, uint64]{}.Prefix(owner)).Values(),
 	}, nil
 }
@@ -8,13 +8,18 @@ import (
 
 	"github.com/NibiruChain/nibiru/simapp"
 
+	cosmossimapp "github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/nibiru/collections"
 	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/perp/keeper"
 	"github.com/NibiruChain/nibiru/x/perp/types"
+	"github.com/NibiruChain/nibiru/x/vpool"
+	vpooltypes "github.com/NibiruChain/nibiru/x/vpool/types"
 )
 
 func TestQueryPosition(t *testing.T) {
@@ -28,6 +33,7 @@ func TestQueryPosition(t *testing.T) {
 		expectedPositionNotional sdk.Dec
 		expectedUnrealizedPnl    sdk.Dec
 		expectedMarginRatio      sdk.Dec
+		expectedLiquidationPrice sdk.Dec
 	}{
 		{
 			name: "positive PnL",
@@ -45,6 +51,7 @@ func TestQueryPosition(t *testing.T) {
 			expectedPositionNotional: sdk.MustNewDecFromStr("19.999600007999840003"),
 			expectedUnrealizedPnl:    sdk.MustNewDecFromStr("9.999600007999840003"),
 			expectedMarginRatio:      sdk.MustNewDecFromStr("0.549991"),
+			expectedLiquidationPrice: sdk.MustNewDecFromStr("0.96"),
 		},
 		{
 			name: "negative PnL, positive margin ratio",
@@ -62,6 +69,7 @@ func TestQueryPosition(t *testing.T) {
 			expectedPositionNotional: sdk.MustNewDecFromStr("9.99990000099999"),
 			expectedUnrealizedPnl:    sdk.MustNewDecFromStr("-0.00009999900001"),
 			expectedMarginRatio:      sdk.MustNewDecFromStr("0.099991"),
+			expectedLiquidationPrice: sdk.MustNewDecFromStr("0.96"),
 		},
 		{
 			name: "negative PnL, negative margin ratio",
@@ -79,6 +87,7 @@ func TestQueryPosition(t *testing.T) {
 			expectedPositionNotional: sdk.MustNewDecFromStr("4.999950000499995"),
 			expectedUnrealizedPnl:    sdk.MustNewDecFromStr("-5.000049999500005"),
 			expectedMarginRatio:      sdk.MustNewDecFromStr("-0.800018"),
+			expectedLiquidationPrice: sdk.MustNewDecFromStr("0.96"),
 		},
 	}
 
@@ -106,13 +115,13 @@ func TestQueryPosition(t *testing.T) {
 				/* maxOracleSpreadRatio */ sdk.OneDec(),
 				/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+				/* maxOpenInterest */ sdk.ZeroDec(),
+				/* maxPositionSize */ sdk.ZeroDec(),
 			)
 			setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
 				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-				},
 			})
+			setCumulativePremiumFractions(nibiruApp.PerpKeeper, ctx, common.Pair_BTC_NUSD, sdk.ZeroDec())
 
 			t.Log("initialize position")
 			setPosition(*perpKeeper, ctx, *tc.initialPosition)
@@ -134,6 +143,7 @@ func TestQueryPosition(t *testing.T) {
 			assert.Equal(t, tc.expectedPositionNotional, resp.PositionNotional)
 			assert.Equal(t, tc.expectedUnrealizedPnl, resp.UnrealizedPnl)
 			assert.Equal(t, tc.expectedMarginRatio, resp.MarginRatioMark)
+			assert.Equal(t, tc.expectedLiquidationPrice, resp.LiquidationPrice)
 			// assert.Equal(t, tc.expectedMarginRatioIndex, resp.MarginRatioIndex)
 			// TODO https://github.com/NibiruChain/nibiru/issues/809
 		})
@@ -194,13 +204,13 @@ func TestQueryPositions(t *testing.T) {
 				/* maxOracleSpreadRatio */ sdk.OneDec(),
 				/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+				/* maxOpenInterest */ sdk.ZeroDec(),
+				/* maxPositionSize */ sdk.ZeroDec(),
 			)
 			setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
 				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-				},
 			})
+			setCumulativePremiumFractions(nibiruApp.PerpKeeper, ctx, common.Pair_BTC_NUSD, sdk.ZeroDec())
 			vpoolKeeper.CreatePool(
 				ctx,
 				common.Pair_ETH_NUSD,
@@ -211,13 +221,13 @@ func TestQueryPositions(t *testing.T) {
 				/* maxOracleSpreadRatio */ sdk.OneDec(),
 				/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+				/* maxOpenInterest */ sdk.ZeroDec(),
+				/* maxPositionSize */ sdk.ZeroDec(),
 			)
 			setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
 				Pair: common.Pair_ETH_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-				},
 			})
+			setCumulativePremiumFractions(nibiruApp.PerpKeeper, ctx, common.Pair_ETH_NUSD, sdk.ZeroDec())
 			vpoolKeeper.CreatePool(
 				ctx,
 				common.Pair_NIBI_NUSD,
@@ -228,13 +238,13 @@ func TestQueryPositions(t *testing.T) {
 				/* maxOracleSpreadRatio */ sdk.OneDec(),
 				/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+				/* maxOpenInterest */ sdk.ZeroDec(),
+				/* maxPositionSize */ sdk.ZeroDec(),
 			)
 			setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
 				Pair: common.Pair_NIBI_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-				},
 			})
+			setCumulativePremiumFractions(nibiruApp.PerpKeeper, ctx, common.Pair_NIBI_NUSD, sdk.ZeroDec())
 
 			t.Log("initialize position")
 			for _, position := range tc.Positions {
@@ -261,21 +271,19 @@ func TestQueryPositions(t *testing.T) {
 
 func TestQueryFundingRates(t *testing.T) {
 	tests := []struct {
-		name                string
-		initialPairMetadata *types.PairMetadata
+		name                              string
+		initialCumulativePremiumFractions []sdk.Dec
 
 		query *types.QueryFundingRatesRequest
 
 		expectErr            bool
 		expectedFundingRates []sdk.Dec
+		expectNextKey        bool
 	}{
 		{
 			name: "empty string pair",
-			initialPairMetadata: &types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-				},
+			initialCumulativePremiumFractions: []sdk.Dec{
+				sdk.ZeroDec(),
 			},
 			query: &types.QueryFundingRatesRequest{
 				Pair: "",
@@ -284,11 +292,8 @@ func TestQueryFundingRates(t *testing.T) {
 		},
 		{
 			name: "pair metadata not found",
-			initialPairMetadata: &types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-				},
+			initialCumulativePremiumFractions: []sdk.Dec{
+				sdk.ZeroDec(),
 			},
 			query: &types.QueryFundingRatesRequest{
 				Pair: "foo:bar",
@@ -297,11 +302,8 @@ func TestQueryFundingRates(t *testing.T) {
 		},
 		{
 			name: "returns single funding payment",
-			initialPairMetadata: &types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-				},
+			initialCumulativePremiumFractions: []sdk.Dec{
+				sdk.ZeroDec(),
 			},
 			query: &types.QueryFundingRatesRequest{
 				Pair: common.Pair_BTC_NUSD.String(),
@@ -313,59 +315,56 @@ func TestQueryFundingRates(t *testing.T) {
 		},
 		{
 			name: "truncates to 48 funding payments",
-			initialPairMetadata: &types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-					sdk.NewDec(1),
-					sdk.NewDec(2),
-					sdk.NewDec(3),
-					sdk.NewDec(4),
-					sdk.NewDec(5),
-					sdk.NewDec(6),
-					sdk.NewDec(7),
-					sdk.NewDec(8),
-					sdk.NewDec(9),
-					sdk.NewDec(10),
-					sdk.NewDec(11),
-					sdk.NewDec(12),
-					sdk.NewDec(13),
-					sdk.NewDec(14),
-					sdk.NewDec(15),
-					sdk.NewDec(16),
-					sdk.NewDec(17),
-					sdk.NewDec(18),
-					sdk.NewDec(19),
-					sdk.NewDec(20),
-					sdk.NewDec(21),
-					sdk.NewDec(22),
-					sdk.NewDec(23),
-					sdk.NewDec(24),
-					sdk.NewDec(25),
-					sdk.NewDec(26),
-					sdk.NewDec(27),
-					sdk.NewDec(28),
-					sdk.NewDec(29),
-					sdk.NewDec(30),
-					sdk.NewDec(31),
-					sdk.NewDec(32),
-					sdk.NewDec(33),
-					sdk.NewDec(34),
-					sdk.NewDec(35),
-					sdk.NewDec(36),
-					sdk.NewDec(37),
-					sdk.NewDec(38),
-					sdk.NewDec(39),
-					sdk.NewDec(40),
-					sdk.NewDec(41),
-					sdk.NewDec(42),
-					sdk.NewDec(43),
-					sdk.NewDec(44),
-					sdk.NewDec(45),
-					sdk.NewDec(46),
-					sdk.NewDec(47),
-					sdk.NewDec(48),
-				},
+			initialCumulativePremiumFractions: []sdk.Dec{
+				sdk.ZeroDec(),
+				sdk.NewDec(1),
+				sdk.NewDec(2),
+				sdk.NewDec(3),
+				sdk.NewDec(4),
+				sdk.NewDec(5),
+				sdk.NewDec(6),
+				sdk.NewDec(7),
+				sdk.NewDec(8),
+				sdk.NewDec(9),
+				sdk.NewDec(10),
+				sdk.NewDec(11),
+				sdk.NewDec(12),
+				sdk.NewDec(13),
+				sdk.NewDec(14),
+				sdk.NewDec(15),
+				sdk.NewDec(16),
+				sdk.NewDec(17),
+				sdk.NewDec(18),
+				sdk.NewDec(19),
+				sdk.NewDec(20),
+				sdk.NewDec(21),
+				sdk.NewDec(22),
+				sdk.NewDec(23),
+				sdk.NewDec(24),
+				sdk.NewDec(25),
+				sdk.NewDec(26),
+				sdk.NewDec(27),
+				sdk.NewDec(28),
+				sdk.NewDec(29),
+				sdk.NewDec(30),
+				sdk.NewDec(31),
+				sdk.NewDec(32),
+				sdk.NewDec(33),
+				sdk.NewDec(34),
+				sdk.NewDec(35),
+				sdk.NewDec(36),
+				sdk.NewDec(37),
+				sdk.NewDec(38),
+				sdk.NewDec(39),
+				sdk.NewDec(40),
+				sdk.NewDec(41),
+				sdk.NewDec(42),
+				sdk.NewDec(43),
+				sdk.NewDec(44),
+				sdk.NewDec(45),
+				sdk.NewDec(46),
+				sdk.NewDec(47),
+				sdk.NewDec(48),
 			},
 			query: &types.QueryFundingRatesRequest{
 				Pair: common.Pair_BTC_NUSD.String(),
@@ -422,6 +421,42 @@ func TestQueryFundingRates(t *testing.T) {
 				sdk.NewDec(48),
 			},
 		},
+		{
+			name: "paginates funding payments from the oldest epoch",
+			initialCumulativePremiumFractions: []sdk.Dec{
+				sdk.ZeroDec(),
+				sdk.NewDec(1),
+				sdk.NewDec(2),
+			},
+			query: &types.QueryFundingRatesRequest{
+				Pair:       common.Pair_BTC_NUSD.String(),
+				Pagination: &query.PageRequest{Limit: 2},
+			},
+			expectErr: false,
+			expectedFundingRates: []sdk.Dec{
+				sdk.ZeroDec(),
+				sdk.NewDec(1),
+			},
+			expectNextKey: true,
+		},
+		{
+			name: "paginates funding payments from the latest epoch",
+			initialCumulativePremiumFractions: []sdk.Dec{
+				sdk.ZeroDec(),
+				sdk.NewDec(1),
+				sdk.NewDec(2),
+			},
+			query: &types.QueryFundingRatesRequest{
+				Pair:       common.Pair_BTC_NUSD.String(),
+				Pagination: &query.PageRequest{Limit: 3, Reverse: true},
+			},
+			expectErr: false,
+			expectedFundingRates: []sdk.Dec{
+				sdk.NewDec(2),
+				sdk.NewDec(1),
+				sdk.ZeroDec(),
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -432,7 +467,8 @@ func TestQueryFundingRates(t *testing.T) {
 			queryServer := keeper.NewQuerier(nibiruApp.PerpKeeper)
 
 			t.Log("initialize pair metadata")
-			setPairMetadata(nibiruApp.PerpKeeper, ctx, *tc.initialPairMetadata)
+			setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{Pair: common.Pair_BTC_NUSD})
+			setCumulativePremiumFractions(nibiruApp.PerpKeeper, ctx, common.Pair_BTC_NUSD, tc.initialCumulativePremiumFractions...)
 
 			t.Log("query funding payments")
 			resp, err := queryServer.FundingRates(sdk.WrapSDKContext(ctx), tc.query)
@@ -444,7 +480,199 @@ func TestQueryFundingRates(t *testing.T) {
 
 				t.Log("assert response")
 				assert.EqualValues(t, tc.expectedFundingRates, resp.CumulativeFundingRates)
+				if tc.query.Pagination != nil {
+					assert.Equal(t, tc.expectNextKey, resp.Pagination.NextKey != nil)
+				}
 			}
 		})
 	}
 }
+
+func TestQueryPositionsByPair(t *testing.T) {
+	pair := common.MustNewAssetPair("xxx:yyy")
+	nibiruApp, ctx := setupOpenInterestPool(t, pair, sdk.ZeroDec(), sdk.ZeroDec())
+	queryServer := keeper.NewQuerier(nibiruApp.PerpKeeper)
+
+	t.Log("open three positions")
+	for _, side := range []types.Side{types.Side_BUY, types.Side_SELL, types.Side_BUY} {
+		trader := testutil.AccAddress()
+		require.NoError(t, cosmossimapp.FundAccount(nibiruApp.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin("yyy", 1_000))))
+		_, err := nibiruApp.PerpKeeper.OpenPosition(ctx, pair, side, trader, sdk.NewInt(100), sdk.NewDec(5), sdk.ZeroDec())
+		require.NoError(t, err)
+	}
+
+	t.Log("the pair must be valid")
+	_, err := queryServer.QueryPositionsByPair(sdk.WrapSDKContext(ctx), &types.QueryPositionsByPairRequest{TokenPair: "xxx"})
+	require.Error(t, err)
+
+	t.Log("query the first page")
+	resp, err := queryServer.QueryPositionsByPair(sdk.WrapSDKContext(ctx), &types.QueryPositionsByPairRequest{
+		TokenPair:  pair.String(),
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Positions, 2)
+	require.NotNil(t, resp.Pagination.NextKey)
+	for _, position := range resp.Positions {
+		assert.Equal(t, pair, position.Position.Pair)
+		assert.True(t, position.LiquidationPrice.IsPositive())
+	}
+
+	t.Log("query the last page")
+	resp, err = queryServer.QueryPositionsByPair(sdk.WrapSDKContext(ctx), &types.QueryPositionsByPairRequest{
+		TokenPair:  pair.String(),
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Positions, 1)
+	assert.Nil(t, resp.Pagination.NextKey)
+
+	t.Log("a pair without positions has none")
+	resp, err = queryServer.QueryPositionsByPair(sdk.WrapSDKContext(ctx), &types.QueryPositionsByPairRequest{
+		TokenPair: common.Pair_BTC_NUSD.String(),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Positions)
+}
+
+func TestQueryLiquidatablePositions(t *testing.T) {
+	pair := common.MustNewAssetPair("xxx:yyy")
+	nibiruApp, ctx := setupOpenInterestPool(t, pair, sdk.ZeroDec(), sdk.ZeroDec())
+	queryServer := keeper.NewQuerier(nibiruApp.PerpKeeper)
+
+	oracle := testutil.AccAddress()
+	nibiruApp.PricefeedKeeper.WhitelistOraclesForPairs(ctx, []sdk.AccAddress{oracle}, []common.AssetPair{pair})
+	require.NoError(t, nibiruApp.PricefeedKeeper.PostRawPrice(ctx, oracle, pair.String(), sdk.OneDec(), ctx.BlockTime().Add(time.Hour)))
+	require.NoError(t, nibiruApp.PricefeedKeeper.GatherRawPrices(ctx, pair.BaseDenom(), pair.QuoteDenom()))
+
+	t.Log("open a 10x position and a 2x position")
+	alice, bob := testutil.AccAddress(), testutil.AccAddress()
+	for _, trader := range []sdk.AccAddress{alice, bob} {
+		require.NoError(t, cosmossimapp.FundAccount(nibiruApp.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin("yyy", 1_000))))
+	}
+	_, err := nibiruApp.PerpKeeper.OpenPosition(ctx, pair, types.Side_BUY, alice, sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+	_, err = nibiruApp.PerpKeeper.OpenPosition(ctx, pair, types.Side_SELL, bob, sdk.NewInt(100), sdk.NewDec(2), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	resp, err := queryServer.QueryLiquidatablePositions(sdk.WrapSDKContext(ctx), &types.QueryLiquidatablePositionsRequest{
+		TokenPair: pair.String(),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Positions)
+
+	t.Log("raise the maintenance margin ratio over the margin ratio of the 10x position")
+	require.NoError(t, vpool.NewVpoolProposalHandler(nibiruApp.VpoolKeeper)(ctx, &vpooltypes.EditPoolConfigProposal{
+		Title:                  "raise the maintenance margin ratio",
+		Description:            "xxx:yyy got too volatile for 10x",
+		Pair:                   pair.String(),
+		TradeLimitRatio:        sdk.MustNewDecFromStr("0.9"),
+		FluctuationLimitRatio:  sdk.OneDec(),
+		MaxOracleSpreadRatio:   sdk.OneDec(),
+		MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.2"),
+		MaxLeverage:            sdk.NewDec(5),
+		MaxOpenInterest:        sdk.ZeroDec(),
+		MaxPositionSize:        sdk.ZeroDec(),
+	}))
+
+	resp, err = queryServer.QueryLiquidatablePositions(sdk.WrapSDKContext(ctx), &types.QueryLiquidatablePositionsRequest{
+		TokenPair: pair.String(),
+		Limit:     10,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Positions, 1)
+	assert.Equal(t, alice.String(), resp.Positions[0].Position.TraderAddress)
+	assert.True(t, resp.Positions[0].MarginRatioMark.LT(sdk.MustNewDecFromStr("0.2")))
+	assert.True(t, resp.Positions[0].LiquidationPrice.GT(resp.Positions[0].PositionNotional.Quo(resp.Positions[0].Position.Size_)),
+		"the liquidation price of a liquidatable long is above its exit price")
+}
+
+func TestEstimateOpenPosition(t *testing.T) {
+	pair := common.MustNewAssetPair("xxx:yyy")
+	nibiruApp, ctx := setupOpenInterestPool(t, pair, sdk.ZeroDec(), sdk.ZeroDec())
+	queryServer := keeper.NewQuerier(nibiruApp.PerpKeeper)
+
+	params := nibiruApp.PerpKeeper.GetParams(ctx)
+	params.FeePoolFeeRatio = sdk.MustNewDecFromStr("0.01")
+	params.EcosystemFundFeeRatio = sdk.MustNewDecFromStr("0.02")
+	nibiruApp.PerpKeeper.SetParams(ctx, params)
+
+	alice := testutil.AccAddress()
+	require.NoError(t, cosmossimapp.FundAccount(nibiruApp.BankKeeper, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("yyy", 10_000))))
+	_, err := nibiruApp.PerpKeeper.OpenPosition(ctx, pair, types.Side_BUY, alice, sdk.NewInt(100), sdk.NewDec(5), sdk.ZeroDec())
+	require.NoError(t, err)
+	initialPosition, err := nibiruApp.PerpKeeper.Positions.Get(ctx, collections.Join(pair, alice))
+	require.NoError(t, err)
+	initialMarkPrice, err := nibiruApp.VpoolKeeper.GetMarkPrice(ctx, pair)
+	require.NoError(t, err)
+
+	estimate := func(side types.Side, quoteAmt int64, leverage sdk.Dec) *types.QueryEstimateOpenPositionResponse {
+		resp, err := queryServer.EstimateOpenPosition(sdk.WrapSDKContext(ctx), &types.QueryEstimateOpenPositionRequest{
+			TokenPair:            pair.String(),
+			Trader:               alice.String(),
+			Side:                 side,
+			QuoteAssetAmount:     sdk.NewInt(quoteAmt),
+			Leverage:             leverage,
+			BaseAssetAmountLimit: sdk.ZeroInt(),
+		})
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Log("increase the position")
+	resp := estimate(types.Side_BUY, 100, sdk.NewDec(5))
+	assert.Equal(t, types.EstimateFailure_ESTIMATE_FAILURE_UNSPECIFIED, resp.Failure)
+	assert.True(t, resp.ExchangedPositionSize.IsPositive())
+	assert.True(t, resp.Position.Size_.GT(initialPosition.Size_))
+	assert.True(t, resp.MarkPrice.GT(initialMarkPrice))
+	assert.Equal(t, sdk.NewDec(500), resp.ExchangedNotionalValue)
+	assert.Equal(t, sdk.NewDec(100), resp.MarginToVault)
+	assert.True(t, resp.MarginRatio.IsPositive())
+	assert.True(t, resp.LiquidationPrice.IsPositive())
+	assert.True(t, resp.LiquidationPrice.LT(resp.MarkPrice))
+	assert.Equal(t, "5yyy", resp.FeeToFeePool.String())
+	assert.Equal(t, "10yyy", resp.FeeToEcosystemFund.Add(resp.FeeToInsuranceFund).String())
+
+	t.Log("reduce the position")
+	resp = estimate(types.Side_SELL, 20, sdk.NewDec(5))
+	assert.Equal(t, types.EstimateFailure_ESTIMATE_FAILURE_UNSPECIFIED, resp.Failure)
+	assert.True(t, resp.ExchangedPositionSize.IsNegative())
+	assert.True(t, resp.Position.Size_.IsPositive())
+	assert.True(t, resp.Position.Size_.LT(initialPosition.Size_))
+	assert.True(t, resp.MarkPrice.LT(initialMarkPrice))
+
+	t.Log("reverse the position")
+	resp = estimate(types.Side_SELL, 200, sdk.NewDec(5))
+	assert.Equal(t, types.EstimateFailure_ESTIMATE_FAILURE_UNSPECIFIED, resp.Failure)
+	assert.True(t, resp.Position.Size_.IsNegative())
+	assert.True(t, resp.LiquidationPrice.GT(resp.MarkPrice))
+
+	t.Log("the leverage is over the max leverage of the pool")
+	resp = estimate(types.Side_BUY, 10, sdk.NewDec(20))
+	assert.Equal(t, types.EstimateFailure_MAX_LEVERAGE, resp.Failure)
+	assert.NotEmpty(t, resp.Error)
+	assert.Nil(t, resp.Position)
+
+	t.Log("the trade is over the trade limit of the pool")
+	resp = estimate(types.Side_BUY, 100_000, sdk.NewDec(10))
+	assert.Equal(t, types.EstimateFailure_TRADE_LIMIT, resp.Failure)
+
+	t.Log("the trader doesn't have enough funds")
+	resp = estimate(types.Side_BUY, 20_000, sdk.NewDec(5))
+	assert.Equal(t, types.EstimateFailure_OTHER_FAILURE, resp.Failure)
+
+	t.Log("the estimates don't change the state")
+	position, err := nibiruApp.PerpKeeper.Positions.Get(ctx, collections.Join(pair, alice))
+	require.NoError(t, err)
+	assert.Equal(t, initialPosition, position)
+	markPrice, err := nibiruApp.VpoolKeeper.GetMarkPrice(ctx, pair)
+	require.NoError(t, err)
+	assert.Equal(t, initialMarkPrice, markPrice)
+
+	t.Log("the estimate matches the position change once it is submitted")
+	resp = estimate(types.Side_BUY, 100, sdk.NewDec(5))
+	positionResp, err := nibiruApp.PerpKeeper.OpenPosition(ctx, pair, types.Side_BUY, alice, sdk.NewInt(100), sdk.NewDec(5), sdk.ZeroDec())
+	require.NoError(t, err)
+	assert.Equal(t, positionResp.Position.Size_, resp.Position.Size_)
+	assert.Equal(t, positionResp.ExchangedPositionSize, resp.ExchangedPositionSize)
+}
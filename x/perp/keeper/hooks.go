@@ -55,12 +55,13 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ uint64)
 
 		// If there is a previous cumulative funding rate, add onto that one. Otherwise, the funding rate is the first cumulative funding rate.
 		cumulativePremiumFraction := premiumFraction
-		if len(pairMetadata.CumulativePremiumFractions) > 0 {
-			cumulativePremiumFraction = pairMetadata.CumulativePremiumFractions[len(pairMetadata.CumulativePremiumFractions)-1].Add(premiumFraction)
+		fundingEpoch := uint64(0)
+		if latestEpoch, latestCumulativePremiumFraction, found := k.getLatestFundingEpoch(ctx, pairMetadata.Pair); found {
+			cumulativePremiumFraction = latestCumulativePremiumFraction.Add(premiumFraction)
+			fundingEpoch = latestEpoch + 1
 		}
 
-		pairMetadata.CumulativePremiumFractions = append(pairMetadata.CumulativePremiumFractions, cumulativePremiumFraction)
-		k.PairsMetadata.Insert(ctx, pairMetadata.Pair, pairMetadata)
+		k.CumulativePremiumFractions.Insert(ctx, collections.Join(pairMetadata.Pair, fundingEpoch), cumulativePremiumFraction)
 
 		if err = ctx.EventManager().EmitTypedEvent(&types.FundingRateChangedEvent{
 			Pair:                      pairMetadata.Pair.String(),
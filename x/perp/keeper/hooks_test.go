@@ -8,8 +8,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/nibiru/collections"
 	"github.com/NibiruChain/nibiru/x/common"
 	epochtypes "github.com/NibiruChain/nibiru/x/epochs/types"
 	"github.com/NibiruChain/nibiru/x/perp/types"
@@ -106,10 +106,10 @@ func TestEndOfEpochTwapCalculation(t *testing.T) {
 
 			perpKeeper.AfterEpochEnd(ctx, "30 min", 1)
 
-			t.Log("assert PairMetadataState")
-			pair, err := perpKeeper.PairsMetadata.Get(ctx, common.Pair_BTC_NUSD)
-			require.NoError(t, err)
-			assert.Equal(t, tc.expectedCumulativePremiumFractions, pair.CumulativePremiumFractions)
+			t.Log("assert CumulativePremiumFractions state")
+			cumulativePremiumFractions := perpKeeper.CumulativePremiumFractions.Iterate(
+				ctx, collections.PairRange[common.AssetPair, uint64]{}.Prefix(common.Pair_BTC_NUSD)).Values()
+			assert.Equal(t, tc.expectedCumulativePremiumFractions, cumulativePremiumFractions)
 
 			if tc.expectedFundingRateChangedEvent != nil {
 				t.Log("assert FundingRateChangedEvent")
@@ -121,19 +121,24 @@ func TestEndOfEpochTwapCalculation(t *testing.T) {
 
 func initParams(ctx sdk.Context, k Keeper) {
 	k.SetParams(ctx, types.Params{
-		Stopped:                 false,
-		FeePoolFeeRatio:         sdk.MustNewDecFromStr("0.00001"),
-		EcosystemFundFeeRatio:   sdk.MustNewDecFromStr("0.000005"),
-		LiquidationFeeRatio:     sdk.MustNewDecFromStr("0.000007"),
-		PartialLiquidationRatio: sdk.MustNewDecFromStr("0.00001"),
-		FundingRateInterval:     "30 min",
-		TwapLookbackWindow:      15 * time.Minute,
+		Stopped:                  false,
+		FeePoolFeeRatio:          sdk.MustNewDecFromStr("0.00001"),
+		EcosystemFundFeeRatio:    sdk.MustNewDecFromStr("0.000005"),
+		LiquidationFeeRatio:      sdk.MustNewDecFromStr("0.000007"),
+		PartialLiquidationRatio:  sdk.MustNewDecFromStr("0.00001"),
+		FundingRateInterval:      "30 min",
+		TwapLookbackWindow:       15 * time.Minute,
+		InsuranceFundFeeShare:    sdk.ZeroDec(),
+		CircuitBreakerBand:       sdk.ZeroDec(),
+		MaxLiquidatorRewardRatio: sdk.MustNewDecFromStr("0.5"),
+		MakerRebateRatio:         sdk.ZeroDec(),
+		OrderDeposit:             sdk.ZeroInt(),
 	})
 	setPairMetadata(k, ctx, types.PairMetadata{
 		Pair: common.Pair_BTC_NUSD,
-		// start with one entry to ensure we append
-		CumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec()},
 	})
+	// start with one entry to ensure we append
+	setCumulativePremiumFractions(k, ctx, common.Pair_BTC_NUSD, sdk.ZeroDec())
 }
 
 func setMocks(ctx sdk.Context, mocks mockedDependencies, indexPrice sdk.Dec, markPrice sdk.Dec) {
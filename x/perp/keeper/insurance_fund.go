@@ -0,0 +1,218 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+)
+
+/*
+DepositToInsuranceFund deposits quote tokens into the insurance fund of their denom
+and mints shares of the fund to the depositor, priced at the current value of a share.
+
+The insurance fund backs the bad debt of the positions in its quote denom alongside
+the PerpEF, in proportion to their balances, and earns the InsuranceFundFeeShare of
+the ecosystem fund fees. Gains and losses are shared by the depositors pro-rata to
+their shares.
+
+args:
+  - ctx: cosmos-sdk context
+  - depositor: the account the quote tokens are taken from
+  - deposit: the quote tokens to deposit
+
+ret:
+  - shares: the insurance fund shares minted to the depositor
+  - err: error
+*/
+func (k Keeper) DepositToInsuranceFund(
+	ctx sdk.Context, depositor sdk.AccAddress, deposit sdk.Coin,
+) (shares sdk.Coin, err error) {
+	assets, totalShares := k.GetInsuranceFund(ctx, deposit.Denom)
+
+	switch {
+	case totalShares.IsZero():
+		shares = sdk.NewCoin(totalShares.Denom, deposit.Amount)
+	case assets.IsZero():
+		return sdk.Coin{}, types.ErrInsuranceFundInsolvent.Wrap(deposit.Denom)
+	default:
+		shares = sdk.NewCoin(totalShares.Denom, deposit.Amount.Mul(totalShares.Amount).Quo(assets.Amount))
+	}
+	if !shares.IsPositive() {
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "deposit %s is worth less than one share", deposit)
+	}
+
+	if err = k.BankKeeper.SendCoinsFromAccountToModule(
+		ctx, depositor, types.InsuranceFundModuleAccount, sdk.NewCoins(deposit),
+	); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err = k.BankKeeper.MintCoins(ctx, types.InsuranceFundModuleAccount, sdk.NewCoins(shares)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err = k.BankKeeper.SendCoinsFromModuleToAccount(
+		ctx, types.InsuranceFundModuleAccount, depositor, sdk.NewCoins(shares),
+	); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return shares, ctx.EventManager().EmitTypedEvent(&types.InsuranceFundDepositEvent{
+		Depositor: depositor.String(),
+		Deposit:   deposit,
+		Shares:    shares,
+	})
+}
+
+/*
+RequestInsuranceFundWithdrawal escrows insurance fund shares of the depositor and
+adds them to its pending withdrawal from the fund. The cooldown of the withdrawal
+restarts from the current block time.
+
+The escrowed shares keep earning fees and absorbing bad debt until they are redeemed,
+so depositors cannot leave ahead of losses that are about to be realized.
+*/
+func (k Keeper) RequestInsuranceFundWithdrawal(
+	ctx sdk.Context, depositor sdk.AccAddress, shares sdk.Coin,
+) (types.InsuranceFundWithdrawal, error) {
+	quoteDenom, isShare := types.InsuranceFundQuoteDenom(shares.Denom)
+	if !isShare {
+		return types.InsuranceFundWithdrawal{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "not an insurance fund share denom: %s", shares.Denom)
+	}
+
+	if err := k.BankKeeper.SendCoinsFromAccountToModule(
+		ctx, depositor, types.InsuranceFundModuleAccount, sdk.NewCoins(shares),
+	); err != nil {
+		return types.InsuranceFundWithdrawal{}, err
+	}
+
+	withdrawal := k.InsuranceFundWithdrawals.GetOr(ctx, collections.Join(depositor, quoteDenom), types.InsuranceFundWithdrawal{
+		Depositor: depositor.String(),
+		Shares:    sdk.NewCoin(shares.Denom, sdk.ZeroInt()),
+	})
+	withdrawal.Shares = withdrawal.Shares.Add(shares)
+	withdrawal.UnlockTime = ctx.BlockTime().Add(k.GetParams(ctx).InsuranceFundWithdrawalCooldown)
+	k.InsuranceFundWithdrawals.Insert(ctx, collections.Join(depositor, quoteDenom), withdrawal)
+
+	return withdrawal, nil
+}
+
+// WithdrawFromInsuranceFund completes the pending withdrawal of the depositor from the
+// insurance fund of the quote denom once its cooldown is over. The escrowed shares are
+// burned and the depositor is paid their current value.
+func (k Keeper) WithdrawFromInsuranceFund(
+	ctx sdk.Context, depositor sdk.AccAddress, quoteDenom string,
+) (withdrawn sdk.Coin, err error) {
+	withdrawal, err := k.InsuranceFundWithdrawals.Get(ctx, collections.Join(depositor, quoteDenom))
+	if err != nil {
+		return sdk.Coin{}, types.ErrNoInsuranceFundWithdrawal.Wrapf("%s from %s", depositor, quoteDenom)
+	}
+	if ctx.BlockTime().Before(withdrawal.UnlockTime) {
+		return sdk.Coin{}, types.ErrInsuranceFundCooldown.Wrapf("shares can be redeemed from %s", withdrawal.UnlockTime)
+	}
+
+	withdrawn = k.GetInsuranceFundSharesValue(ctx, withdrawal.Shares)
+
+	if err = k.BankKeeper.BurnCoins(ctx, types.InsuranceFundModuleAccount, sdk.NewCoins(withdrawal.Shares)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if withdrawn.IsPositive() {
+		if err = k.BankKeeper.SendCoinsFromModuleToAccount(
+			ctx, types.InsuranceFundModuleAccount, depositor, sdk.NewCoins(withdrawn),
+		); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+	if err = k.InsuranceFundWithdrawals.Delete(ctx, collections.Join(depositor, quoteDenom)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return withdrawn, ctx.EventManager().EmitTypedEvent(&types.InsuranceFundWithdrawEvent{
+		Depositor: depositor.String(),
+		Shares:    withdrawal.Shares,
+		Withdrawn: withdrawn,
+	})
+}
+
+// GetInsuranceFund returns the quote tokens held by the insurance fund of the quote
+// denom and the total supply of its shares, escrowed shares included.
+func (k Keeper) GetInsuranceFund(ctx sdk.Context, quoteDenom string) (assets sdk.Coin, shares sdk.Coin) {
+	assets = k.BankKeeper.GetBalance(ctx, k.AccountKeeper.GetModuleAddress(types.InsuranceFundModuleAccount), quoteDenom)
+	shares = k.BankKeeper.GetSupply(ctx, types.InsuranceFundShareDenom(quoteDenom))
+	return assets, shares
+}
+
+// GetInsuranceFundSharesValue returns the quote tokens the insurance fund shares can
+// currently be redeemed for.
+func (k Keeper) GetInsuranceFundSharesValue(ctx sdk.Context, shares sdk.Coin) sdk.Coin {
+	quoteDenom, _ := types.InsuranceFundQuoteDenom(shares.Denom)
+	assets, totalShares := k.GetInsuranceFund(ctx, quoteDenom)
+	if totalShares.IsZero() {
+		return sdk.NewCoin(quoteDenom, sdk.ZeroInt())
+	}
+	return sdk.NewCoin(quoteDenom, shares.Amount.Mul(assets.Amount).Quo(totalShares.Amount))
+}
+
+// insuranceFundFeeCut returns the feeShare of the ecosystem fund fee that is paid to
+// the insurance fund of the quote denom. Funds without depositors get nothing.
+func (k Keeper) insuranceFundFeeCut(
+	ctx sdk.Context, quoteDenom string, feeShare sdk.Dec, feeToEcosystemFund sdk.Int,
+) sdk.Int {
+	if !feeShare.IsPositive() || !feeToEcosystemFund.IsPositive() {
+		return sdk.ZeroInt()
+	}
+	if k.BankKeeper.GetSupply(ctx, types.InsuranceFundShareDenom(quoteDenom)).IsZero() {
+		return sdk.ZeroInt()
+	}
+	return feeShare.MulInt(feeToEcosystemFund).TruncateInt()
+}
+
+/*
+coverBadDebt sends the bad debt from the insurance fund and the PerpEF to the vault.
+Each of them covers a part proportional to its balance of the denom. If the bad debt
+exceeds their combined balance, both are emptied and the part left uncovered is
+returned, for the callers to auto-deleverage or cut short.
+*/
+func (k Keeper) coverBadDebt(ctx sdk.Context, denom string, badDebt sdk.Int) (uncovered sdk.Int, err error) {
+	insuranceFundBalance := k.BankKeeper.GetBalance(
+		ctx, k.AccountKeeper.GetModuleAddress(types.InsuranceFundModuleAccount), denom,
+	).Amount
+	ecosystemFundBalance := k.BankKeeper.GetBalance(
+		ctx, k.AccountKeeper.GetModuleAddress(types.PerpEFModuleAccount), denom,
+	).Amount
+
+	fromInsuranceFund := sdk.ZeroInt()
+	if insuranceFundBalance.IsPositive() {
+		totalBalance := insuranceFundBalance.Add(ecosystemFundBalance)
+		if badDebt.GTE(totalBalance) {
+			fromInsuranceFund = insuranceFundBalance
+		} else {
+			fromInsuranceFund = badDebt.Mul(insuranceFundBalance).Quo(totalBalance)
+		}
+	}
+
+	if fromInsuranceFund.IsPositive() {
+		if err := k.BankKeeper.SendCoinsFromModuleToModule(
+			ctx,
+			/* from */ types.InsuranceFundModuleAccount,
+			/* to */ types.VaultModuleAccount,
+			sdk.NewCoins(sdk.NewCoin(denom, fromInsuranceFund)),
+		); err != nil {
+			return sdk.Int{}, err
+		}
+	}
+
+	fromEcosystemFund := sdk.MinInt(badDebt.Sub(fromInsuranceFund), ecosystemFundBalance)
+	if fromEcosystemFund.IsPositive() {
+		if err := k.BankKeeper.SendCoinsFromModuleToModule(
+			ctx,
+			/* from */ types.PerpEFModuleAccount,
+			/* to */ types.VaultModuleAccount,
+			sdk.NewCoins(sdk.NewCoin(denom, fromEcosystemFund)),
+		); err != nil {
+			return sdk.Int{}, err
+		}
+	}
+
+	return badDebt.Sub(fromInsuranceFund).Sub(fromEcosystemFund), nil
+}
@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/collections"
+	nibisimapp "github.com/NibiruChain/nibiru/simapp"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+)
+
+func TestInsuranceFundDepositAndWithdraw(t *testing.T) {
+	nibiruApp, ctx := nibisimapp.NewTestNibiruAppAndContext(true)
+	ctx = ctx.WithBlockTime(time.Now())
+	perpKeeper := nibiruApp.PerpKeeper
+	shareDenom := types.InsuranceFundShareDenom(common.DenomNUSD)
+
+	alice, bob := testutil.AccAddress(), testutil.AccAddress()
+	require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000))))
+	require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, bob, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 300))))
+
+	t.Log("the first deposit is priced one share per quote token")
+	shares, err := perpKeeper.DepositToInsuranceFund(ctx, alice, sdk.NewInt64Coin(common.DenomNUSD, 1_000))
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewInt64Coin(shareDenom, 1_000), shares)
+
+	t.Log("the fund earns fees")
+	require.NoError(t, simapp.FundModuleAccount(
+		nibiruApp.BankKeeper, ctx, types.InsuranceFundModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 500))))
+
+	t.Log("later deposits are priced at the current share value")
+	shares, err = perpKeeper.DepositToInsuranceFund(ctx, bob, sdk.NewInt64Coin(common.DenomNUSD, 300))
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewInt64Coin(shareDenom, 200), shares)
+
+	assets, totalShares := perpKeeper.GetInsuranceFund(ctx, common.DenomNUSD)
+	assert.Equal(t, sdk.NewInt64Coin(common.DenomNUSD, 1_800), assets)
+	assert.Equal(t, sdk.NewInt64Coin(shareDenom, 1_200), totalShares)
+
+	t.Log("request a withdrawal, which escrows the shares")
+	withdrawal, err := perpKeeper.RequestInsuranceFundWithdrawal(ctx, alice, sdk.NewInt64Coin(shareDenom, 500))
+	require.NoError(t, err)
+	cooldown := perpKeeper.GetParams(ctx).InsuranceFundWithdrawalCooldown
+	assert.Equal(t, ctx.BlockTime().Add(cooldown), withdrawal.UnlockTime)
+	assert.Equal(t, sdk.NewInt64Coin(shareDenom, 500), nibiruApp.BankKeeper.GetBalance(ctx, alice, shareDenom))
+
+	t.Log("withdrawals cannot complete during the cooldown")
+	_, err = perpKeeper.WithdrawFromInsuranceFund(ctx, alice, common.DenomNUSD)
+	require.ErrorIs(t, err, types.ErrInsuranceFundCooldown)
+
+	t.Log("escrowed shares keep earning fees during the cooldown")
+	require.NoError(t, simapp.FundModuleAccount(
+		nibiruApp.BankKeeper, ctx, types.InsuranceFundModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 600))))
+
+	t.Log("complete the withdrawal after the cooldown")
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(cooldown))
+	withdrawn, err := perpKeeper.WithdrawFromInsuranceFund(ctx, alice, common.DenomNUSD)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewInt64Coin(common.DenomNUSD, 1_000), withdrawn)
+	assert.Equal(t, sdk.NewInt64Coin(common.DenomNUSD, 1_000), nibiruApp.BankKeeper.GetBalance(ctx, alice, common.DenomNUSD))
+
+	assets, totalShares = perpKeeper.GetInsuranceFund(ctx, common.DenomNUSD)
+	assert.Equal(t, sdk.NewInt64Coin(common.DenomNUSD, 1_400), assets)
+	assert.Equal(t, sdk.NewInt64Coin(shareDenom, 700), totalShares)
+
+	_, err = perpKeeper.InsuranceFundWithdrawals.Get(ctx, collections.Join(alice, common.DenomNUSD))
+	require.Error(t, err)
+	_, err = perpKeeper.WithdrawFromInsuranceFund(ctx, alice, common.DenomNUSD)
+	require.ErrorIs(t, err, types.ErrNoInsuranceFundWithdrawal)
+}
+
+func TestInsuranceFundCoversBadDebt(t *testing.T) {
+	tests := []struct {
+		name                 string
+		insuranceFundBalance int64
+		ecosystemFundBalance int64
+		badDebt              int64
+
+		expectedInsuranceFundBalance int64
+		expectedEcosystemFundBalance int64
+	}{
+		{
+			name:                 "bad debt is shared pro-rata to the balances",
+			insuranceFundBalance: 100,
+			ecosystemFundBalance: 300,
+			badDebt:              200,
+
+			expectedInsuranceFundBalance: 50,
+			expectedEcosystemFundBalance: 150,
+		},
+		{
+			name:                 "empty insurance fund",
+			insuranceFundBalance: 0,
+			ecosystemFundBalance: 300,
+			badDebt:              200,
+
+			expectedInsuranceFundBalance: 0,
+			expectedEcosystemFundBalance: 100,
+		},
+		{
+			name:                 "bad debt wipes out both funds",
+			insuranceFundBalance: 100,
+			ecosystemFundBalance: 300,
+			badDebt:              400,
+
+			expectedInsuranceFundBalance: 0,
+			expectedEcosystemFundBalance: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			nibiruApp, ctx := nibisimapp.NewTestNibiruAppAndContext(true)
+			perpKeeper := nibiruApp.PerpKeeper
+
+			if tc.insuranceFundBalance > 0 {
+				depositor := testutil.AccAddress()
+				deposit := sdk.NewInt64Coin(common.DenomNUSD, tc.insuranceFundBalance)
+				require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, depositor, sdk.NewCoins(deposit)))
+				_, err := perpKeeper.DepositToInsuranceFund(ctx, depositor, deposit)
+				require.NoError(t, err)
+			}
+			require.NoError(t, simapp.FundModuleAccount(
+				nibiruApp.BankKeeper, ctx, types.PerpEFModuleAccount,
+				sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, tc.ecosystemFundBalance))))
+
+			t.Log("withdraw more than the vault holds")
+			receiver := testutil.AccAddress()
+			require.NoError(t, perpKeeper.Withdraw(ctx, common.DenomNUSD, receiver, sdk.NewInt(tc.badDebt)))
+			assert.EqualValues(t, tc.badDebt, nibiruApp.BankKeeper.GetBalance(ctx, receiver, common.DenomNUSD).Amount.Int64())
+
+			insuranceFundAssets, _ := perpKeeper.GetInsuranceFund(ctx, common.DenomNUSD)
+			assert.EqualValues(t, tc.expectedInsuranceFundBalance, insuranceFundAssets.Amount.Int64())
+			assert.EqualValues(t, tc.expectedEcosystemFundBalance, nibiruApp.BankKeeper.GetBalance(
+				ctx, nibiruApp.AccountKeeper.GetModuleAddress(types.PerpEFModuleAccount), common.DenomNUSD,
+			).Amount.Int64())
+		})
+	}
+}
+
+func TestDepositToInsolventInsuranceFund(t *testing.T) {
+	nibiruApp, ctx := nibisimapp.NewTestNibiruAppAndContext(true)
+	perpKeeper := nibiruApp.PerpKeeper
+
+	depositor := testutil.AccAddress()
+	require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, depositor, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 200))))
+	_, err := perpKeeper.DepositToInsuranceFund(ctx, depositor, sdk.NewInt64Coin(common.DenomNUSD, 100))
+	require.NoError(t, err)
+
+	t.Log("bad debt wipes out the insurance fund")
+	require.NoError(t, perpKeeper.Withdraw(ctx, common.DenomNUSD, testutil.AccAddress(), sdk.NewInt(100)))
+
+	_, err = perpKeeper.DepositToInsuranceFund(ctx, depositor, sdk.NewInt64Coin(common.DenomNUSD, 100))
+	require.ErrorIs(t, err, types.ErrInsuranceFundInsolvent)
+}
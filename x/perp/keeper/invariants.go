@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+)
+
+// RegisterInvariants registers the perp module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "nonzero-positions", NonZeroPositionsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "pair-metadata-vpools", PairMetadataVpoolsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "open-interest", OpenInterestInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "vault-coverage", VaultCoverageInvariant(k))
+}
+
+// NonZeroPositionsInvariant checks that no stored position has a zero size or a negative margin.
+// Closed positions are deleted and bad debt is realized instead of leaving the margin negative.
+func NonZeroPositionsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, position := range k.Positions.Iterate(ctx, collections.PairRange[common.AssetPair, sdk.AccAddress]{}).Values() {
+			if position.Size_.IsZero() {
+				broken = true
+				msg += fmt.Sprintf("\tposition of %s on %s has a zero size\n", position.TraderAddress, position.Pair)
+			}
+			if position.Margin.IsNegative() {
+				broken = true
+				msg += fmt.Sprintf("\tposition of %s on %s has a negative margin: %s\n", position.TraderAddress, position.Pair, position.Margin)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "nonzero-positions", msg), broken
+	}
+}
+
+// PairMetadataVpoolsInvariant checks that every pair metadata has a matching vpool.
+func PairMetadataVpoolsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, pair := range k.PairsMetadata.Iterate(ctx, collections.Range[common.AssetPair]{}).Keys() {
+			if !k.VpoolKeeper.ExistsPool(ctx, pair) {
+				broken = true
+				msg += fmt.Sprintf("\tpair metadata of %s has no vpool\n", pair)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "pair-metadata-vpools", msg), broken
+	}
+}
+
+// OpenInterestInvariant checks that the open interest tracked for every pair is the sum of the
+// sizes of its positions.
+func OpenInterestInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		expected := make(map[common.AssetPair]types.OpenInterest)
+		for _, position := range k.Positions.Iterate(ctx, collections.PairRange[common.AssetPair, sdk.AccAddress]{}).Values() {
+			openInterest, found := expected[position.Pair]
+			if !found {
+				openInterest = types.OpenInterest{Pair: position.Pair, Long: sdk.ZeroDec(), Short: sdk.ZeroDec()}
+			}
+			if position.Size_.IsPositive() {
+				openInterest.Long = openInterest.Long.Add(position.Size_)
+			} else {
+				openInterest.Short = openInterest.Short.Sub(position.Size_)
+			}
+			expected[position.Pair] = openInterest
+		}
+
+		for _, openInterest := range k.OpenInterests.Iterate(ctx, collections.Range[common.AssetPair]{}).Values() {
+			want, found := expected[openInterest.Pair]
+			if !found {
+				want = types.OpenInterest{Pair: openInterest.Pair, Long: sdk.ZeroDec(), Short: sdk.ZeroDec()}
+			}
+			delete(expected, openInterest.Pair)
+
+			if !openInterest.Long.Equal(want.Long) || !openInterest.Short.Equal(want.Short) {
+				broken = true
+				msg += fmt.Sprintf("\topen interest of %s is long %s short %s, positions sum to long %s short %s\n",
+					openInterest.Pair, openInterest.Long, openInterest.Short, want.Long, want.Short)
+			}
+		}
+		missingPairs := make([]common.AssetPair, 0, len(expected))
+		for pair := range expected {
+			missingPairs = append(missingPairs, pair)
+		}
+		sort.Slice(missingPairs, func(i, j int) bool { return missingPairs[i].String() < missingPairs[j].String() })
+		for _, pair := range missingPairs {
+			broken = true
+			msg += fmt.Sprintf("\tno open interest tracked for %s, positions sum to long %s short %s\n",
+				pair, expected[pair].Long, expected[pair].Short)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "open-interest", msg), broken
+	}
+}
+
+// VaultCoverageInvariant checks that the vault holds, for every quote denom, the sum of
+// the margins of the positions net of the prepaid bad debt, the part of them already
+// paid out of the insurance fund and the PerpEF rather than the vault.
+func VaultCoverageInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		margins := make(map[string]sdk.Dec)
+		for _, position := range k.Positions.Iterate(ctx, collections.PairRange[common.AssetPair, sdk.AccAddress]{}).Values() {
+			denom := position.Pair.QuoteDenom()
+			margin, found := margins[denom]
+			if !found {
+				margin = sdk.ZeroDec()
+			}
+			margins[denom] = margin.Add(position.Margin)
+		}
+
+		denoms := make([]string, 0, len(margins))
+		for denom := range margins {
+			denoms = append(denoms, denom)
+		}
+		sort.Strings(denoms)
+
+		for _, denom := range denoms {
+			prepaidBadDebt := k.PrepaidBadDebt.GetOr(ctx, denom, types.PrepaidBadDebt{
+				Denom:  denom,
+				Amount: sdk.ZeroInt(),
+			}).Amount
+			required := margins[denom].Sub(prepaidBadDebt.ToDec())
+			vaultBalance := k.BankKeeper.GetBalance(ctx, k.AccountKeeper.GetModuleAddress(types.VaultModuleAccount), denom).Amount
+			// the margins are deposited in whole coins
+			if vaultBalance.LT(required.TruncateInt()) {
+				broken = true
+				msg += fmt.Sprintf("\tthe vault holds %s%s, the margins of the positions net of the prepaid bad debt are %s%s\n",
+					vaultBalance, denom, required, denom)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "vault-coverage", msg), broken
+	}
+}
@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/keeper"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+)
+
+func TestInvariants(t *testing.T) {
+	pair := common.MustNewAssetPair("xxx:yyy")
+	nibiruApp, ctx := setupOpenInterestPool(t, pair, sdk.ZeroDec(), sdk.ZeroDec())
+	perpKeeper := nibiruApp.PerpKeeper
+
+	requireInvariantsHold := func(t *testing.T) {
+		t.Helper()
+		for _, invariant := range []sdk.Invariant{
+			keeper.NonZeroPositionsInvariant(perpKeeper),
+			keeper.PairMetadataVpoolsInvariant(perpKeeper),
+			keeper.OpenInterestInvariant(perpKeeper),
+			keeper.VaultCoverageInvariant(perpKeeper),
+		} {
+			msg, broken := invariant(ctx)
+			require.False(t, broken, msg)
+		}
+	}
+
+	t.Log("open, reduce and close positions")
+	alice, bob := testutil.AccAddress(), testutil.AccAddress()
+	for _, trader := range []sdk.AccAddress{alice, bob} {
+		require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin("yyy", 1_000))))
+	}
+	_, err := perpKeeper.OpenPosition(ctx, pair, types.Side_BUY, alice, sdk.NewInt(100), sdk.NewDec(5), sdk.ZeroDec())
+	require.NoError(t, err)
+	_, err = perpKeeper.OpenPosition(ctx, pair, types.Side_SELL, bob, sdk.NewInt(50), sdk.NewDec(5), sdk.ZeroDec())
+	require.NoError(t, err)
+	requireInvariantsHold(t)
+
+	_, err = perpKeeper.OpenPosition(ctx, pair, types.Side_SELL, alice, sdk.NewInt(20), sdk.NewDec(5), sdk.ZeroDec())
+	require.NoError(t, err)
+	_, err = perpKeeper.ClosePosition(ctx, pair, bob)
+	require.NoError(t, err)
+	requireInvariantsHold(t)
+
+	t.Log("a position with a zero size breaks the nonzero positions invariant")
+	perpKeeper.Positions.Insert(ctx, collections.Join(pair, bob), types.ZeroPosition(ctx, pair, bob))
+	_, broken := keeper.NonZeroPositionsInvariant(perpKeeper)(ctx)
+	require.True(t, broken)
+	require.NoError(t, perpKeeper.Positions.Delete(ctx, collections.Join(pair, bob)))
+
+	t.Log("a stale open interest breaks the open interest invariant")
+	require.NoError(t, perpKeeper.OpenInterests.Delete(ctx, pair))
+	_, broken = keeper.OpenInterestInvariant(perpKeeper)(ctx)
+	require.True(t, broken)
+	perpKeeper.RebuildOpenInterests(ctx)
+
+	t.Log("a pair metadata without vpool breaks the pair metadata invariant")
+	setPairMetadata(perpKeeper, ctx, types.PairMetadata{Pair: common.MustNewAssetPair("aaa:bbb")})
+	_, broken = keeper.PairMetadataVpoolsInvariant(perpKeeper)(ctx)
+	require.True(t, broken)
+
+	t.Log("a vault short of the margins of the positions breaks the vault coverage invariant")
+	_, broken = keeper.VaultCoverageInvariant(perpKeeper)(ctx)
+	require.False(t, broken)
+	vaultBalance := nibiruApp.BankKeeper.GetBalance(ctx, nibiruApp.AccountKeeper.GetModuleAddress(types.VaultModuleAccount), "yyy")
+	require.NoError(t, nibiruApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.VaultModuleAccount, bob, sdk.NewCoins(vaultBalance)))
+	_, broken = keeper.VaultCoverageInvariant(perpKeeper)(ctx)
+	require.True(t, broken)
+
+	t.Log("the prepaid bad debt counts towards the margins")
+	position, err := perpKeeper.Positions.Get(ctx, collections.Join(pair, alice))
+	require.NoError(t, err)
+	perpKeeper.IncrementPrepaidBadDebt(ctx, "yyy", position.Margin.Ceil().RoundInt())
+	_, broken = keeper.VaultCoverageInvariant(perpKeeper)(ctx)
+	require.False(t, broken)
+}
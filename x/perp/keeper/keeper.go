@@ -26,9 +26,105 @@ type Keeper struct {
 	VpoolKeeper     types.VpoolKeeper
 	EpochKeeper     types.EpochKeeper
 
-	Positions      collections.Map[collections.Pair[common.AssetPair, sdk.AccAddress], types.Position]
+	Positions      collections.IndexedMap[collections.Pair[common.AssetPair, sdk.AccAddress], types.Position, PositionsIndexes]
 	PairsMetadata  collections.Map[common.AssetPair, types.PairMetadata]
 	PrepaidBadDebt collections.Map[string, types.PrepaidBadDebt]
+	Orders         collections.IndexedMap[uint64, types.Order, OrdersIndexes]
+	OrderID        collections.Sequence
+	// CrossMarginAccounts is the set of traders using cross margin mode.
+	CrossMarginAccounts collections.KeySet[sdk.AccAddress]
+	// CumulativePremiumFractions maps the pair and funding epoch to the cumulative premium fraction.
+	CumulativePremiumFractions collections.Map[collections.Pair[common.AssetPair, uint64], sdk.Dec]
+	// InsuranceFundWithdrawals maps the depositor and quote denom to the pending insurance fund withdrawal.
+	InsuranceFundWithdrawals collections.Map[collections.Pair[sdk.AccAddress, string], types.InsuranceFundWithdrawal]
+	// OpenInterests maps the pair to the total size of its long and short positions.
+	OpenInterests collections.Map[common.AssetPair, types.OpenInterest]
+	// LiquidatableSince maps the pair and trader of a position to the block at which a
+	// non-whitelisted liquidator first found it liquidatable, starting its liquidator priority window.
+	LiquidatableSince collections.Map[collections.Pair[common.AssetPair, sdk.AccAddress], uint64]
+	// PermissionlessLiquidations maps the block height to the number of liquidations made
+	// by non-whitelisted liquidators in the block. Only the current block is kept.
+	PermissionlessLiquidations collections.Map[uint64, uint64]
+	// TraderVolumes maps the trader and day, counted from the unix epoch, to the notional
+	// volume traded that day. Only the days of the fee tier volume window are kept.
+	TraderVolumes collections.Map[collections.Pair[sdk.AccAddress, uint64], sdk.Dec]
+	// Subaccounts maps the owner and id of the subaccounts which have been used to
+	// the subaccount, whose derived address keys its positions.
+	Subaccounts collections.Map[collections.Pair[sdk.AccAddress, uint64], types.Subaccount]
+	// TradingResumes maps the pair, empty for the whole exchange, to the last time the
+	// guardian resumed trading on it.
+	TradingResumes collections.Map[string, types.TradingResume]
+	// SettlementCursors maps the pair of a shut down vpool to the trader of the last
+	// position whose settlement was attempted, the one the next block settles after.
+	SettlementCursors collections.Map[common.AssetPair, sdk.AccAddress]
+	// TradingHalts is the set of pairs, empty for the whole exchange, whose trading was
+	// halted at the end of the last block, which the circuit breaker compares against
+	// the params to record the resumes.
+	TradingHalts collections.KeySet[string]
+}
+
+// cumulativePremiumFractionsNamespace is the namespace of the CumulativePremiumFractions map,
+// which is also read directly by the paginated funding rates query.
+const cumulativePremiumFractionsNamespace collections.Namespace = 7
+
+// positionsByPairNamespace is the namespace of the PositionsByPair index,
+// which is also read directly by the paginated positions by pair query.
+const positionsByPairNamespace collections.Namespace = 12
+
+type PositionsIndexes struct {
+	// PositionsByPair is the index that maps positions to the pair they are open on.
+	PositionsByPair collections.MultiIndex[common.AssetPair, collections.Pair[common.AssetPair, sdk.AccAddress], types.Position]
+}
+
+func (p PositionsIndexes) IndexerList() []collections.Indexer[collections.Pair[common.AssetPair, sdk.AccAddress], types.Position] {
+	return []collections.Indexer[collections.Pair[common.AssetPair, sdk.AccAddress], types.Position]{p.PositionsByPair}
+}
+
+type OrdersIndexes struct {
+	// TraderOrders is the index that maps orders to the pair and trader they belong to.
+	TraderOrders collections.MultiIndex[collections.Pair[common.AssetPair, sdk.AccAddress], uint64, types.Order]
+	// TriggerPrices is the index that maps orders to their pair and signed trigger price.
+	TriggerPrices TriggerPriceIndex
+}
+
+func (o OrdersIndexes) IndexerList() []collections.Indexer[uint64, types.Order] {
+	return []collections.Indexer[uint64, types.Order]{o.TraderOrders, o.TriggerPrices}
+}
+
+// TriggerPriceIndex indexes the orders by their pair, then by their signed trigger price
+// and id, so that the triggered orders of a pair can be ranged over without reading the
+// others. See types.Order.SignedTriggerPrice.
+type TriggerPriceIndex struct {
+	keys collections.KeySet[collections.Pair[common.AssetPair, collections.Pair[sdk.Dec, uint64]]]
+}
+
+func NewTriggerPriceIndex(storeKey sdk.StoreKey, namespace collections.Namespace) TriggerPriceIndex {
+	return TriggerPriceIndex{
+		keys: collections.NewKeySet(
+			storeKey, namespace,
+			collections.PairKeyEncoder(
+				common.AssetPairKeyEncoder,
+				collections.PairKeyEncoder(collections.DecKeyEncoder, collections.Uint64KeyEncoder),
+			),
+		),
+	}
+}
+
+// Insert implements the collections.Indexer interface.
+func (i TriggerPriceIndex) Insert(ctx sdk.Context, orderID uint64, order types.Order) {
+	i.keys.Insert(ctx, collections.Join(order.Pair, collections.Join(order.SignedTriggerPrice(), orderID)))
+}
+
+// Delete implements the collections.Indexer interface.
+func (i TriggerPriceIndex) Delete(ctx sdk.Context, orderID uint64, order types.Order) {
+	i.keys.Delete(ctx, collections.Join(order.Pair, collections.Join(order.SignedTriggerPrice(), orderID)))
+}
+
+// Iterate iterates over the keys of the index in the given range.
+func (i TriggerPriceIndex) Iterate(
+	ctx sdk.Context, rng collections.PairRange[common.AssetPair, collections.Pair[sdk.Dec, uint64]],
+) collections.KeySetIterator[collections.Pair[common.AssetPair, collections.Pair[sdk.Dec, uint64]]] {
+	return i.keys.Iterate(ctx, rng)
 }
 
 // NewKeeper Creates a new x/perp Keeper instance.
@@ -62,13 +158,68 @@ func NewKeeper(
 		PricefeedKeeper: priceKeeper,
 		VpoolKeeper:     vpoolKeeper,
 		EpochKeeper:     epochKeeper,
-		Positions: collections.NewMap(
+		Positions: collections.NewIndexedMap(
 			storeKey, 0,
 			collections.PairKeyEncoder(common.AssetPairKeyEncoder, collections.AccAddressKeyEncoder),
 			collections.ProtoValueEncoder[types.Position](cdc),
-		),
+			PositionsIndexes{
+				PositionsByPair: collections.NewMultiIndex(
+					storeKey, positionsByPairNamespace,
+					common.AssetPairKeyEncoder,
+					collections.PairKeyEncoder(common.AssetPairKeyEncoder, collections.AccAddressKeyEncoder),
+					func(p types.Position) common.AssetPair {
+						return p.Pair
+					},
+				),
+			}),
 		PairsMetadata:  collections.NewMap(storeKey, 1, common.AssetPairKeyEncoder, collections.ProtoValueEncoder[types.PairMetadata](cdc)),
 		PrepaidBadDebt: collections.NewMap(storeKey, 2, collections.StringKeyEncoder, collections.ProtoValueEncoder[types.PrepaidBadDebt](cdc)),
+		Orders: collections.NewIndexedMap(
+			storeKey, 3,
+			collections.Uint64KeyEncoder, collections.ProtoValueEncoder[types.Order](cdc),
+			OrdersIndexes{
+				TraderOrders: collections.NewMultiIndex(
+					storeKey, 4,
+					collections.PairKeyEncoder(common.AssetPairKeyEncoder, collections.AccAddressKeyEncoder),
+					collections.Uint64KeyEncoder,
+					func(o types.Order) collections.Pair[common.AssetPair, sdk.AccAddress] {
+						return collections.Join(o.Pair, sdk.MustAccAddressFromBech32(o.TraderAddress))
+					},
+				),
+				TriggerPrices: NewTriggerPriceIndex(storeKey, 15),
+			}),
+		OrderID:             collections.NewSequence(storeKey, 5),
+		CrossMarginAccounts: collections.NewKeySet(storeKey, 6, collections.AccAddressKeyEncoder),
+		CumulativePremiumFractions: collections.NewMap(
+			storeKey, cumulativePremiumFractionsNamespace,
+			collections.PairKeyEncoder(common.AssetPairKeyEncoder, collections.Uint64KeyEncoder),
+			collections.DecValueEncoder,
+		),
+		InsuranceFundWithdrawals: collections.NewMap(
+			storeKey, 8,
+			collections.PairKeyEncoder(collections.AccAddressKeyEncoder, collections.StringKeyEncoder),
+			collections.ProtoValueEncoder[types.InsuranceFundWithdrawal](cdc),
+		),
+		OpenInterests: collections.NewMap(storeKey, 9, common.AssetPairKeyEncoder, collections.ProtoValueEncoder[types.OpenInterest](cdc)),
+		LiquidatableSince: collections.NewMap(
+			storeKey, 10,
+			collections.PairKeyEncoder(common.AssetPairKeyEncoder, collections.AccAddressKeyEncoder),
+			collections.Uint64ValueEncoder,
+		),
+		PermissionlessLiquidations: collections.NewMap(storeKey, 11, collections.Uint64KeyEncoder, collections.Uint64ValueEncoder),
+		TraderVolumes: collections.NewMap(
+			storeKey, 13,
+			collections.PairKeyEncoder(collections.AccAddressKeyEncoder, collections.Uint64KeyEncoder),
+			collections.DecValueEncoder,
+		),
+		Subaccounts: collections.NewMap(
+			storeKey, 14,
+			collections.PairKeyEncoder(collections.AccAddressKeyEncoder, collections.Uint64KeyEncoder),
+			collections.ProtoValueEncoder[types.Subaccount](cdc),
+		),
+		TradingResumes:    collections.NewMap(storeKey, 16, collections.StringKeyEncoder, collections.ProtoValueEncoder[types.TradingResume](cdc)),
+		SettlementCursors: collections.NewMap(storeKey, 17, common.AssetPairKeyEncoder, collections.AccAddressValueEncoder),
+		TradingHalts:      collections.NewKeySet(storeKey, 18, collections.StringKeyEncoder),
 	}
 }
 
@@ -86,3 +237,19 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.ParamSubspace.SetParamSet(ctx, &params)
 }
+
+// CheckSnapshotRetentionWindows returns an error if the index price or the reserve snapshots
+// are pruned before the TWAP lookback window is over, which would leave the TWAPs short of
+// snapshots. A zero retention window disables pruning.
+func (k Keeper) CheckSnapshotRetentionWindows(ctx sdk.Context) error {
+	twapLookbackWindow := k.GetParams(ctx).TwapLookbackWindow
+	if window := k.PricefeedKeeper.GetSnapshotRetentionWindow(ctx); window != 0 && window < twapLookbackWindow {
+		return fmt.Errorf("price snapshot retention window %s is shorter than the twap lookback window %s",
+			window, twapLookbackWindow)
+	}
+	if window := k.VpoolKeeper.GetSnapshotRetentionWindow(ctx); window != 0 && window < twapLookbackWindow {
+		return fmt.Errorf("reserve snapshot retention window %s is shorter than the twap lookback window %s",
+			window, twapLookbackWindow)
+	}
+	return nil
+}
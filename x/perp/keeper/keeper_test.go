@@ -29,12 +29,22 @@ func TestGetAndSetParams(t *testing.T) {
 			"Get non-default params",
 			func() types.Params {
 				params := types.Params{
-					Stopped:                 true,
-					FeePoolFeeRatio:         sdk.OneDec(),
-					EcosystemFundFeeRatio:   sdk.OneDec(),
-					LiquidationFeeRatio:     sdk.OneDec(),
-					PartialLiquidationRatio: sdk.OneDec(),
-					TwapLookbackWindow:      15 * time.Minute,
+					Stopped:                  true,
+					FeePoolFeeRatio:          sdk.OneDec(),
+					EcosystemFundFeeRatio:    sdk.OneDec(),
+					LiquidationFeeRatio:      sdk.OneDec(),
+					PartialLiquidationRatio:  sdk.OneDec(),
+					TwapLookbackWindow:       15 * time.Minute,
+					InsuranceFundFeeShare:    sdk.ZeroDec(),
+					CircuitBreakerBand:       sdk.ZeroDec(),
+					MaxLiquidatorRewardRatio: sdk.MustNewDecFromStr("0.5"),
+					FeeTiers: []types.FeeTier{{
+						MinVolume:             sdk.NewDec(1_000_000),
+						FeePoolFeeRatio:       sdk.MustNewDecFromStr("0.0005"),
+						EcosystemFundFeeRatio: sdk.MustNewDecFromStr("0.0005"),
+					}},
+					MakerRebateRatio: sdk.MustNewDecFromStr("0.0001"),
+					OrderDeposit:     sdk.NewInt(1_000_000),
 				}
 				return params
 			},
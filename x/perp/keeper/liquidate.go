@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"errors"
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/collections"
@@ -15,6 +18,14 @@ import (
 
 required margin maintenance ratio.
 
+Cross margin accounts are checked and liquidated at the account level, see
+liquidateCrossMarginAccount.
+
+When permissionless liquidations are enabled, any address can liquidate, but
+the whitelisted liquidators get the first blocks after a position is found
+liquidatable: the first attempt of a non-whitelisted liquidator only starts
+this priority window and returns no fee.
+
 args:
   - liquidatorAddr: the liquidator who is executing the liquidation
   - pair: the asset pair
@@ -31,7 +42,9 @@ func (k Keeper) Liquidate(
 	pair common.AssetPair,
 	traderAddr sdk.AccAddress,
 ) (feeToLiquidator sdk.Coin, feeToFund sdk.Coin, err error) {
-	if !k.canLiquidate(ctx, liquidatorAddr) {
+	params := k.GetParams(ctx)
+	isWhitelisted := k.isWhitelistedLiquidator(params, liquidatorAddr)
+	if !isWhitelisted && !params.PermissionlessLiquidations {
 		return sdk.Coin{}, sdk.Coin{}, types.ErrUnauthorized.Wrapf("not allowed to liquidate: %s", traderAddr)
 	}
 	err = k.requireVpool(ctx, pair)
@@ -44,31 +57,27 @@ func (k Keeper) Liquidate(
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
 
-	marginRatio, err := k.GetMarginRatio(
-		ctx,
-		position,
-		types.MarginCalculationPriceOption_MAX_PNL,
-	)
+	liquidatable, err := k.isLiquidatable(ctx, position)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
+	if !liquidatable {
+		return sdk.Coin{}, sdk.Coin{}, types.ErrMarginHighEnough
+	}
 
-	if k.VpoolKeeper.IsOverSpreadLimit(ctx, pair) {
-		marginRatioBasedOnOracle, err := k.GetMarginRatio(
-			ctx, position, types.MarginCalculationPriceOption_INDEX)
+	if !isWhitelisted {
+		windowStarted, err := k.checkPermissionlessLiquidation(ctx, params, liquidatorAddr, position)
 		if err != nil {
 			return sdk.Coin{}, sdk.Coin{}, err
 		}
-
-		marginRatio = sdk.MaxDec(marginRatio, marginRatioBasedOnOracle)
+		if windowStarted {
+			noFee := sdk.NewCoin(pair.QuoteDenom(), sdk.ZeroInt())
+			return noFee, noFee, nil
+		}
 	}
 
-	params := k.GetParams(ctx)
-
-	maintenanceMarginRatio := k.VpoolKeeper.GetMaintenanceMarginRatio(ctx, pair)
-	err = requireMoreMarginRatio(marginRatio, maintenanceMarginRatio, false)
-	if err != nil {
-		return sdk.Coin{}, sdk.Coin{}, types.ErrMarginHighEnough
+	if k.isCrossMarginPosition(ctx, position) {
+		return k.liquidateCrossMarginAccount(ctx, liquidatorAddr, position)
 	}
 
 	marginRatioBasedOnSpot, err := k.GetMarginRatio(
@@ -100,6 +109,107 @@ func (k Keeper) Liquidate(
 	return feeToLiquidator, feeToFund, nil
 }
 
+// isLiquidatable returns whether the margin ratio of the position, at the price most
+// favorable to the trader, is below its maintenance margin ratio.
+func (k Keeper) isLiquidatable(ctx sdk.Context, position types.Position) (bool, error) {
+	marginRatio, err := k.GetMarginRatio(
+		ctx,
+		position,
+		types.MarginCalculationPriceOption_MAX_PNL,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	if k.isOverSpreadLimit(ctx, position) {
+		marginRatioBasedOnOracle, err := k.GetMarginRatio(
+			ctx, position, types.MarginCalculationPriceOption_INDEX)
+		if err != nil {
+			return false, err
+		}
+
+		marginRatio = sdk.MaxDec(marginRatio, marginRatioBasedOnOracle)
+	}
+
+	maintenanceMarginRatio, err := k.getMaintenanceMarginRatio(
+		ctx, position, types.MarginCalculationPriceOption_MAX_PNL)
+	if err != nil {
+		return false, err
+	}
+	return requireMoreMarginRatio(marginRatio, maintenanceMarginRatio, false) == nil, nil
+}
+
+// CountLiquidatablePositions returns the number of open positions on the pair which
+// can be liquidated. Positions whose margin ratio can't be computed are skipped, since
+// it runs when governance edits the config of a vpool and must not halt the chain.
+func (k Keeper) CountLiquidatablePositions(ctx sdk.Context, pair common.AssetPair) (uint64, error) {
+	var count uint64
+	positions := k.Positions.Iterate(ctx, collections.PairRange[common.AssetPair, sdk.AccAddress]{}.Prefix(pair)).Values()
+	for _, position := range positions {
+		liquidatable, err := k.tryIsLiquidatable(ctx, position)
+		if err != nil {
+			k.Logger(ctx).Error("failed to check if position is liquidatable",
+				"pair", pair.String(), "trader", position.TraderAddress, "error", err)
+			continue
+		}
+		if liquidatable {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// tryIsLiquidatable is isLiquidatable for the callers which must not panic, since the
+// spread check panics without an index price.
+func (k Keeper) tryIsLiquidatable(ctx sdk.Context, position types.Position) (liquidatable bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	return k.isLiquidatable(ctx, position)
+}
+
+/*
+liquidationPrice returns the mark price at which the position on its own reaches the
+maintenance margin ratio of its pair, ignoring the price impact of closing it. The
+pending funding payment is taken into account.
+
+For a long position of size s, open notional n and margin m, it solves
+m + s*p - n = mmr * s*p, and for a short position m + n - s*p = mmr * s*p.
+Zero is returned if no positive price does, e.g. for a long position with a margin
+covering its whole open notional.
+*/
+func (k Keeper) liquidationPrice(ctx sdk.Context, position types.Position) (sdk.Dec, error) {
+	if position.Size_.IsZero() {
+		return sdk.Dec{}, types.ErrPositionZero
+	}
+
+	remaining, err := k.CalcRemainMarginWithFundingPayment(ctx, position, sdk.ZeroDec())
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	margin := remaining.Margin.Sub(remaining.BadDebt)
+	maintenanceMarginRatio := k.VpoolKeeper.GetMaintenanceMarginRatio(ctx, position.Pair)
+
+	var price sdk.Dec
+	if position.Size_.IsPositive() {
+		if maintenanceMarginRatio.GTE(sdk.OneDec()) {
+			return sdk.ZeroDec(), nil
+		}
+		price = position.OpenNotional.Sub(margin).
+			Quo(position.Size_.Mul(sdk.OneDec().Sub(maintenanceMarginRatio)))
+	} else {
+		price = position.OpenNotional.Add(margin).
+			Quo(position.Size_.Abs().Mul(sdk.OneDec().Add(maintenanceMarginRatio)))
+	}
+
+	if !price.IsPositive() {
+		return sdk.ZeroDec(), nil
+	}
+	return price, nil
+}
+
 /*
 Fully liquidates a position. It is assumed that the margin ratio has already been
 checked prior to calling this method.
@@ -123,6 +233,11 @@ func (k Keeper) ExecuteFullLiquidation(
 		return types.LiquidateResp{}, err
 	}
 
+	rewardRatio, err := k.liquidatorRewardRatio(ctx, params, *position)
+	if err != nil {
+		return types.LiquidateResp{}, err
+	}
+
 	positionResp, err := k.closePositionEntirely(
 		ctx,
 		/* currentPosition */ *position,
@@ -132,12 +247,13 @@ func (k Keeper) ExecuteFullLiquidation(
 	if err != nil {
 		return types.LiquidateResp{}, err
 	}
+	k.clearLiquidatableSince(ctx, position.Pair, traderAddr)
 
 	remainMargin := positionResp.MarginToVault.Abs()
 
 	feeToLiquidator := params.LiquidationFeeRatio.
 		Mul(positionResp.ExchangedNotionalValue).
-		QuoInt64(2)
+		Mul(rewardRatio)
 	totalBadDebt := positionResp.BadDebt
 
 	if feeToLiquidator.GT(remainMargin) {
@@ -149,15 +265,26 @@ func (k Keeper) ExecuteFullLiquidation(
 		remainMargin = remainMargin.Sub(feeToLiquidator)
 	}
 
-	// Realize bad debt
+	// Cover bad debt with the margin of the rest of a cross margin account
+	if totalBadDebt.IsPositive() && k.isCrossMarginPosition(ctx, *position) {
+		totalBadDebt = k.coverBadDebtWithAccountMargin(ctx, *position, totalBadDebt)
+	}
+
+	// Realize bad debt, auto-deleveraging the other side for what the funds can't cover
 	if totalBadDebt.IsPositive() {
-		if err = k.realizeBadDebt(
+		uncoveredBadDebt, err := k.realizeBadDebt(
 			ctx,
 			position.Pair.QuoteDenom(),
 			totalBadDebt.RoundInt(),
-		); err != nil {
+		)
+		if err != nil {
 			return types.LiquidateResp{}, err
 		}
+		if uncoveredBadDebt.IsPositive() {
+			if err = k.autoDeleverage(ctx, position.Pair, position.Size_, uncoveredBadDebt); err != nil {
+				return types.LiquidateResp{}, err
+			}
+		}
 	}
 
 	feeToPerpEcosystemFund := sdk.ZeroDec()
@@ -249,7 +376,7 @@ func (k Keeper) distributeLiquidateRewards(
 	// Transfer fee from vault to liquidator
 	feeToLiquidator := liquidateResp.FeeToLiquidator
 	if feeToLiquidator.IsPositive() {
-		err = k.Withdraw(ctx, pair.QuoteDenom(), liquidator, feeToLiquidator)
+		_, err = k.withdrawPayout(ctx, pair, liquidator, feeToLiquidator)
 		if err != nil {
 			return err
 		}
@@ -286,6 +413,11 @@ func (k Keeper) ExecutePartialLiquidation(
 		return types.LiquidateResp{}, err
 	}
 
+	rewardRatio, err := k.liquidatorRewardRatio(ctx, params, *currentPosition)
+	if err != nil {
+		return types.LiquidateResp{}, err
+	}
+
 	positionResp, err := k.decreasePosition(
 		/* ctx */ ctx,
 		/* currentPosition */ *currentPosition,
@@ -303,9 +435,10 @@ func (k Keeper) ExecutePartialLiquidation(
 	positionResp.Position.Margin = positionResp.Position.Margin.
 		Sub(liquidationFeeAmount)
 	k.Positions.Insert(ctx, collections.Join(positionResp.Position.Pair, traderAddr), *positionResp.Position)
+	k.clearLiquidatableSince(ctx, positionResp.Position.Pair, traderAddr)
 
 	// Compute splits for the liquidation fee
-	feeToLiquidator := liquidationFeeAmount.QuoInt64(2)
+	feeToLiquidator := liquidationFeeAmount.Mul(rewardRatio)
 	feeToPerpEcosystemFund := liquidationFeeAmount.Sub(feeToLiquidator)
 
 	liquidationResponse := types.LiquidateResp{
@@ -396,9 +529,8 @@ func (k Keeper) MultiLiquidate(ctx sdk.Context, liquidator sdk.AccAddress, posit
 	return resp
 }
 
-func (k Keeper) canLiquidate(ctx sdk.Context, addr sdk.AccAddress) bool {
+func (k Keeper) isWhitelistedLiquidator(params types.Params, addr sdk.AccAddress) bool {
 	addrStr := addr.String()
-	params := k.GetParams(ctx)
 	for _, whitelisted := range params.WhitelistedLiquidators {
 		if addrStr == whitelisted {
 			return true
@@ -406,3 +538,109 @@ func (k Keeper) canLiquidate(ctx sdk.Context, addr sdk.AccAddress) bool {
 	}
 	return false
 }
+
+/*
+checkPermissionlessLiquidation enforces the liquidator priority window and the
+per block cap on a liquidation by a non-whitelisted liquidator.
+
+ret:
+  - windowStarted: true if the position was not known to be liquidatable, in which
+    case the priority window starts now and the position must not be liquidated
+  - err: error if the position is still in its priority window or the cap is reached
+*/
+func (k Keeper) checkPermissionlessLiquidation(
+	ctx sdk.Context, params types.Params, liquidator sdk.AccAddress, position types.Position,
+) (windowStarted bool, err error) {
+	height := uint64(ctx.BlockHeight())
+
+	if params.LiquidatorPriorityBlocks > 0 {
+		trader := sdk.MustAccAddressFromBech32(position.TraderAddress)
+		since, err := k.LiquidatableSince.Get(ctx, collections.Join(position.Pair, trader))
+		if errors.Is(err, collections.ErrNotFound) {
+			k.LiquidatableSince.Insert(ctx, collections.Join(position.Pair, trader), height)
+			return true, ctx.EventManager().EmitTypedEvent(&types.LiquidationPriorityWindowStartedEvent{
+				Pair:                     position.Pair.String(),
+				TraderAddress:            position.TraderAddress,
+				LiquidatorAddress:        liquidator.String(),
+				PermissionlessFromHeight: int64(height + params.LiquidatorPriorityBlocks),
+			})
+		} else if err != nil {
+			return false, err
+		}
+
+		if permissionlessFrom := since + params.LiquidatorPriorityBlocks; height < permissionlessFrom {
+			return false, types.ErrLiquidatorPriorityWindow.Wrapf("until block %d", permissionlessFrom)
+		}
+	}
+
+	count := k.PermissionlessLiquidations.GetOr(ctx, height, 0)
+	if params.MaxPermissionlessLiquidationsPerBlock > 0 && count >= params.MaxPermissionlessLiquidationsPerBlock {
+		return false, types.ErrTooManyLiquidations.Wrapf("max %d per block", params.MaxPermissionlessLiquidationsPerBlock)
+	}
+	k.PermissionlessLiquidations.Insert(ctx, height, count+1)
+
+	return false, nil
+}
+
+// clearLiquidatableSince forgets when the position was found liquidatable, so that a new
+// priority window starts the next time it is.
+func (k Keeper) clearLiquidatableSince(ctx sdk.Context, pair common.AssetPair, trader sdk.AccAddress) {
+	_ = k.LiquidatableSince.Delete(ctx, collections.Join(pair, trader))
+}
+
+/*
+ClearHealthyLiquidatableSince forgets when the positions which are no longer liquidatable
+were found liquidatable, so that a new priority window starts if they become liquidatable
+again. The positions which have been closed since are forgotten as well.
+*/
+func (k Keeper) ClearHealthyLiquidatableSince(ctx sdk.Context) {
+	for _, key := range k.LiquidatableSince.Iterate(ctx, collections.PairRange[common.AssetPair, sdk.AccAddress]{}).Keys() {
+		position, err := k.Positions.Get(ctx, key)
+		if err == nil {
+			liquidatable, err := k.tryIsLiquidatable(ctx, position)
+			if err != nil {
+				k.Logger(ctx).Error("failed to check if position is liquidatable",
+					"pair", key.K1().String(), "trader", key.K2().String(), "error", err)
+				continue
+			}
+			if liquidatable {
+				continue
+			}
+		}
+		k.clearLiquidatableSince(ctx, key.K1(), key.K2())
+	}
+}
+
+// ClearPermissionlessLiquidations drops the count of the permissionless liquidations of the block.
+func (k Keeper) ClearPermissionlessLiquidations(ctx sdk.Context) {
+	_ = k.PermissionlessLiquidations.Delete(ctx, uint64(ctx.BlockHeight()))
+}
+
+/*
+liquidatorRewardRatio returns the share of the liquidation fee paid to the liquidator.
+It is one half for a position at its maintenance margin ratio and grows linearly with
+how far below it the position is, up to the max liquidator reward ratio at a zero
+margin ratio.
+*/
+func (k Keeper) liquidatorRewardRatio(ctx sdk.Context, params types.Params, position types.Position) (sdk.Dec, error) {
+	half := sdk.MustNewDecFromStr("0.5")
+	if !params.MaxLiquidatorRewardRatio.GT(half) {
+		return half, nil
+	}
+
+	marginRatio, err := k.GetMarginRatio(ctx, position, types.MarginCalculationPriceOption_MAX_PNL)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	maintenanceMarginRatio, err := k.getMaintenanceMarginRatio(ctx, position, types.MarginCalculationPriceOption_MAX_PNL)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if !maintenanceMarginRatio.IsPositive() {
+		return half, nil
+	}
+
+	depth := maintenanceMarginRatio.Sub(marginRatio).Quo(maintenanceMarginRatio)
+	depth = sdk.MinDec(sdk.MaxDec(depth, sdk.ZeroDec()), sdk.OneDec())
+	return half.Add(params.MaxLiquidatorRewardRatio.Sub(half).Mul(depth)), nil
+}
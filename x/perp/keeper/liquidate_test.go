@@ -19,6 +19,8 @@ import (
 
 	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/perp/types"
+	"github.com/NibiruChain/nibiru/x/vpool"
+	vpooltypes "github.com/NibiruChain/nibiru/x/vpool/types"
 )
 
 func TestExecuteFullLiquidation(t *testing.T) {
@@ -96,6 +98,8 @@ func TestExecuteFullLiquidation(t *testing.T) {
 				/* maxOracleSpreadRatio */ sdk.MustNewDecFromStr("0.1"),
 				/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+				/* maxOpenInterest */ sdk.ZeroDec(),
+				/* maxPositionSize */ sdk.ZeroDec(),
 			)
 			require.True(t, vpoolKeeper.ExistsPool(ctx, tokenPair))
 			nibiruApp.PricefeedKeeper.ActivePairsStore().Set(ctx, tokenPair, true)
@@ -110,11 +114,23 @@ func TestExecuteFullLiquidation(t *testing.T) {
 				params.PartialLiquidationRatio,
 				"hour",
 				15*time.Minute,
+				params.InsuranceFundFeeShare,
+				params.InsuranceFundWithdrawalCooldown,
+				params.Guardian,
+				params.CircuitBreakerBand,
+				params.CircuitBreakerWindow,
+				params.PermissionlessLiquidations,
+				params.LiquidatorPriorityBlocks,
+				params.MaxPermissionlessLiquidationsPerBlock,
+				params.MaxLiquidatorRewardRatio,
+				params.FeeTiers,
+				params.MakerRebateRatio,
+				params.OrderDeposit,
 			))
 			setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
-				Pair:                       tokenPair,
-				CumulativePremiumFractions: []sdk.Dec{sdk.OneDec()},
+				Pair: tokenPair,
 			})
+			setCumulativePremiumFractions(nibiruApp.PerpKeeper, ctx, tokenPair, sdk.OneDec())
 
 			t.Log("Fund trader account with sufficient quote")
 			var err error
@@ -271,6 +287,8 @@ func TestExecutePartialLiquidation(t *testing.T) {
 				/* maxOracleSpreadRatio */ sdk.MustNewDecFromStr("0.1"),
 				/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+				/* maxOpenInterest */ sdk.ZeroDec(),
+				/* maxPositionSize */ sdk.ZeroDec(),
 			)
 			nibiruApp.PricefeedKeeper.ActivePairsStore().Set(ctx, tokenPair, true)
 			require.True(t, vpoolKeeper.ExistsPool(ctx, tokenPair))
@@ -287,12 +305,24 @@ func TestExecutePartialLiquidation(t *testing.T) {
 				partialLiquidationRatio,
 				"hour",
 				15*time.Minute,
+				params.InsuranceFundFeeShare,
+				params.InsuranceFundWithdrawalCooldown,
+				params.Guardian,
+				params.CircuitBreakerBand,
+				params.CircuitBreakerWindow,
+				params.PermissionlessLiquidations,
+				params.LiquidatorPriorityBlocks,
+				params.MaxPermissionlessLiquidationsPerBlock,
+				params.MaxLiquidatorRewardRatio,
+				params.FeeTiers,
+				params.MakerRebateRatio,
+				params.OrderDeposit,
 			))
 
 			setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
-				Pair:                       tokenPair,
-				CumulativePremiumFractions: []sdk.Dec{sdk.OneDec()},
+				Pair: tokenPair,
 			})
+			setCumulativePremiumFractions(nibiruApp.PerpKeeper, ctx, tokenPair, sdk.OneDec())
 
 			t.Log("Fund trader account with sufficient quote")
 			var err error
@@ -382,3 +412,243 @@ func setPosition(k perpkeeper.Keeper, ctx sdk.Context, pos types.Position) {
 func setPairMetadata(k perpkeeper.Keeper, ctx sdk.Context, pm types.PairMetadata) {
 	k.PairsMetadata.Insert(ctx, pm.Pair, pm)
 }
+
+// setCumulativePremiumFractions records the cumulative premium fractions, in order, as the funding epochs of the pair.
+func setCumulativePremiumFractions(k perpkeeper.Keeper, ctx sdk.Context, pair common.AssetPair, cumulativePremiumFractions ...sdk.Dec) {
+	for epoch, cumulativePremiumFraction := range cumulativePremiumFractions {
+		k.CumulativePremiumFractions.Insert(ctx, collections.Join(pair, uint64(epoch)), cumulativePremiumFraction)
+	}
+}
+
+func TestCountLiquidatablePositions(t *testing.T) {
+	pair := common.MustNewAssetPair("xxx:yyy")
+	nibiruApp, ctx := setupOpenInterestPool(t, pair, sdk.ZeroDec(), sdk.ZeroDec())
+	perpKeeper := nibiruApp.PerpKeeper
+
+	alice, bob := testutilevents.AccAddress(), testutilevents.AccAddress()
+	for _, trader := range []sdk.AccAddress{alice, bob} {
+		require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin("yyy", 1_000))))
+	}
+
+	t.Log("without an index price, the positions are skipped")
+	_, err := perpKeeper.OpenPosition(ctx, pair, types.Side_BUY, alice, sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+	count, err := perpKeeper.CountLiquidatablePositions(ctx, pair)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, count)
+
+	oracle := testutilevents.AccAddress()
+	nibiruApp.PricefeedKeeper.WhitelistOraclesForPairs(ctx, []sdk.AccAddress{oracle}, []common.AssetPair{pair})
+	require.NoError(t, nibiruApp.PricefeedKeeper.PostRawPrice(ctx, oracle, pair.String(), sdk.OneDec(), ctx.BlockTime().Add(time.Hour)))
+	require.NoError(t, nibiruApp.PricefeedKeeper.GatherRawPrices(ctx, pair.BaseDenom(), pair.QuoteDenom()))
+
+	t.Log("open a 2x position next to the 10x one")
+	_, err = perpKeeper.OpenPosition(ctx, pair, types.Side_SELL, bob, sdk.NewInt(100), sdk.NewDec(2), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	count, err = perpKeeper.CountLiquidatablePositions(ctx, pair)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, count)
+
+	t.Log("raise the maintenance margin ratio over the margin ratio of the 10x position")
+	proposal := &vpooltypes.EditPoolConfigProposal{
+		Title:                  "raise the maintenance margin ratio",
+		Description:            "xxx:yyy got too volatile for 10x",
+		Pair:                   pair.String(),
+		TradeLimitRatio:        sdk.MustNewDecFromStr("0.9"),
+		FluctuationLimitRatio:  sdk.OneDec(),
+		MaxOracleSpreadRatio:   sdk.OneDec(),
+		MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.2"),
+		MaxLeverage:            sdk.NewDec(5),
+		MaxOpenInterest:        sdk.ZeroDec(),
+		MaxPositionSize:        sdk.ZeroDec(),
+	}
+	require.NoError(t, vpool.NewVpoolProposalHandler(nibiruApp.VpoolKeeper)(ctx, proposal))
+
+	count, err = perpKeeper.CountLiquidatablePositions(ctx, pair)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+	testutilevents.RequireContainsTypedEvent(t, ctx, &vpooltypes.PoolConfigEditedEvent{
+		Pair:                      pair.String(),
+		MaintenanceMarginRatio:    sdk.MustNewDecFromStr("0.2"),
+		MaxLeverage:               sdk.NewDec(5),
+		LiquidatablePositions:     1,
+		PositionsMadeLiquidatable: 1,
+	})
+
+	t.Log("the 10x position can now be liquidated")
+	liquidator := testutilevents.AccAddress()
+	params := perpKeeper.GetParams(ctx)
+	params.WhitelistedLiquidators = []string{liquidator.String()}
+	perpKeeper.SetParams(ctx, params)
+	_, _, err = perpKeeper.Liquidate(ctx, liquidator, pair, alice)
+	require.NoError(t, err)
+	_, _, err = perpKeeper.Liquidate(ctx, liquidator, pair, bob)
+	require.ErrorIs(t, err, types.ErrMarginHighEnough)
+}
+
+func TestPermissionlessLiquidation(t *testing.T) {
+	pair := common.MustNewAssetPair("xxx:yyy")
+	nibiruApp, ctx := setupOpenInterestPool(t, pair, sdk.ZeroDec(), sdk.ZeroDec())
+	perpKeeper := nibiruApp.PerpKeeper
+
+	oracle := testutilevents.AccAddress()
+	nibiruApp.PricefeedKeeper.WhitelistOraclesForPairs(ctx, []sdk.AccAddress{oracle}, []common.AssetPair{pair})
+	require.NoError(t, nibiruApp.PricefeedKeeper.PostRawPrice(ctx, oracle, pair.String(), sdk.OneDec(), ctx.BlockTime().Add(time.Hour)))
+	require.NoError(t, nibiruApp.PricefeedKeeper.GatherRawPrices(ctx, pair.BaseDenom(), pair.QuoteDenom()))
+
+	t.Log("open two 10x positions and make them liquidatable")
+	alice, bob := testutilevents.AccAddress(), testutilevents.AccAddress()
+	for _, trader := range []sdk.AccAddress{alice, bob} {
+		require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin("yyy", 1_000))))
+		_, err := perpKeeper.OpenPosition(ctx, pair, types.Side_BUY, trader, sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec())
+		require.NoError(t, err)
+	}
+	require.NoError(t, vpool.NewVpoolProposalHandler(nibiruApp.VpoolKeeper)(ctx, &vpooltypes.EditPoolConfigProposal{
+		Title:                  "raise the maintenance margin ratio",
+		Description:            "xxx:yyy got too volatile for 10x",
+		Pair:                   pair.String(),
+		TradeLimitRatio:        sdk.MustNewDecFromStr("0.9"),
+		FluctuationLimitRatio:  sdk.OneDec(),
+		MaxOracleSpreadRatio:   sdk.OneDec(),
+		MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.2"),
+		MaxLeverage:            sdk.NewDec(5),
+		MaxOpenInterest:        sdk.ZeroDec(),
+		MaxPositionSize:        sdk.ZeroDec(),
+	}))
+
+	whitelisted, liquidator := testutilevents.AccAddress(), testutilevents.AccAddress()
+	params := perpKeeper.GetParams(ctx)
+	params.WhitelistedLiquidators = []string{whitelisted.String()}
+	perpKeeper.SetParams(ctx, params)
+
+	t.Log("only the whitelisted liquidators can liquidate by default")
+	_, _, err := perpKeeper.Liquidate(ctx, liquidator, pair, alice)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	params.PermissionlessLiquidations = true
+	params.LiquidatorPriorityBlocks = 2
+	params.MaxPermissionlessLiquidationsPerBlock = 1
+	params.MaxLiquidatorRewardRatio = sdk.OneDec()
+	perpKeeper.SetParams(ctx, params)
+
+	t.Log("the first attempt starts the priority window of the whitelisted liquidators")
+	for _, trader := range []sdk.AccAddress{alice, bob} {
+		feeToLiquidator, feeToFund, err := perpKeeper.Liquidate(ctx, liquidator, pair, trader)
+		require.NoError(t, err)
+		assert.True(t, feeToLiquidator.IsZero())
+		assert.True(t, feeToFund.IsZero())
+	}
+	testutilevents.RequireContainsTypedEvent(t, ctx, &types.LiquidationPriorityWindowStartedEvent{
+		Pair:                     pair.String(),
+		TraderAddress:            alice.String(),
+		LiquidatorAddress:        liquidator.String(),
+		PermissionlessFromHeight: ctx.BlockHeight() + 2,
+	})
+
+	t.Log("the position can't be liquidated permissionlessly within the window")
+	_, _, err = perpKeeper.Liquidate(ctx.WithBlockHeight(ctx.BlockHeight()+1), liquidator, pair, alice)
+	require.ErrorIs(t, err, types.ErrLiquidatorPriorityWindow)
+
+	t.Log("after the window, the liquidator gets more than half of the fee of a deep position")
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 2)
+	feeToLiquidator, feeToFund, err := perpKeeper.Liquidate(ctx, liquidator, pair, alice)
+	require.NoError(t, err)
+	assert.True(t, feeToLiquidator.Amount.GT(feeToFund.Amount),
+		"fee to liquidator %s, fee to fund %s", feeToLiquidator, feeToFund)
+
+	t.Log("the permissionless liquidations are capped per block, but not the whitelisted ones")
+	_, _, err = perpKeeper.Liquidate(ctx, liquidator, pair, bob)
+	require.ErrorIs(t, err, types.ErrTooManyLiquidations)
+	_, _, err = perpKeeper.Liquidate(ctx, whitelisted, pair, bob)
+	require.NoError(t, err)
+
+	t.Log("the cap is reset at the end of the block")
+	perpKeeper.ClearPermissionlessLiquidations(ctx)
+	_, err = perpKeeper.PermissionlessLiquidations.Get(ctx, uint64(ctx.BlockHeight()))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
+
+func TestLiquidatableSinceCleared(t *testing.T) {
+	pair := common.MustNewAssetPair("xxx:yyy")
+	nibiruApp, ctx := setupOpenInterestPool(t, pair, sdk.ZeroDec(), sdk.ZeroDec())
+	perpKeeper := nibiruApp.PerpKeeper
+
+	oracle := testutilevents.AccAddress()
+	nibiruApp.PricefeedKeeper.WhitelistOraclesForPairs(ctx, []sdk.AccAddress{oracle}, []common.AssetPair{pair})
+	require.NoError(t, nibiruApp.PricefeedKeeper.PostRawPrice(ctx, oracle, pair.String(), sdk.OneDec(), ctx.BlockTime().Add(time.Hour)))
+	require.NoError(t, nibiruApp.PricefeedKeeper.GatherRawPrices(ctx, pair.BaseDenom(), pair.QuoteDenom()))
+
+	setMaintenanceMarginRatio := func(maintenanceMarginRatio sdk.Dec) {
+		require.NoError(t, vpool.NewVpoolProposalHandler(nibiruApp.VpoolKeeper)(ctx, &vpooltypes.EditPoolConfigProposal{
+			Title:                  "edit the maintenance margin ratio",
+			Description:            "move the positions in and out of liquidation",
+			Pair:                   pair.String(),
+			TradeLimitRatio:        sdk.MustNewDecFromStr("0.9"),
+			FluctuationLimitRatio:  sdk.OneDec(),
+			MaxOracleSpreadRatio:   sdk.OneDec(),
+			MaintenanceMarginRatio: maintenanceMarginRatio,
+			MaxLeverage:            sdk.NewDec(4),
+			MaxOpenInterest:        sdk.ZeroDec(),
+			MaxPositionSize:        sdk.ZeroDec(),
+		}))
+	}
+
+	whitelisted, liquidator := testutilevents.AccAddress(), testutilevents.AccAddress()
+	params := perpKeeper.GetParams(ctx)
+	params.WhitelistedLiquidators = []string{whitelisted.String()}
+	params.PermissionlessLiquidations = true
+	params.LiquidatorPriorityBlocks = 2
+	perpKeeper.SetParams(ctx, params)
+
+	t.Log("open two 10x positions and make them liquidatable")
+	alice, bob := testutilevents.AccAddress(), testutilevents.AccAddress()
+	for _, trader := range []sdk.AccAddress{alice, bob} {
+		require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin("yyy", 1_000))))
+		_, err := perpKeeper.OpenPosition(ctx, pair, types.Side_BUY, trader, sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec())
+		require.NoError(t, err)
+	}
+	setMaintenanceMarginRatio(sdk.MustNewDecFromStr("0.2"))
+
+	hasLiquidatableSince := func(trader sdk.AccAddress) bool {
+		_, err := perpKeeper.LiquidatableSince.Get(ctx, collections.Join(pair, trader))
+		return err == nil
+	}
+	startWindows := func() {
+		for _, trader := range []sdk.AccAddress{alice, bob} {
+			_, _, err := perpKeeper.Liquidate(ctx, liquidator, pair, trader)
+			require.NoError(t, err)
+			require.True(t, hasLiquidatableSince(trader))
+		}
+	}
+
+	t.Log("the priority windows are forgotten once the positions are healthy again")
+	startWindows()
+	setMaintenanceMarginRatio(sdk.MustNewDecFromStr("0.0625"))
+	perpKeeper.ClearHealthyLiquidatableSince(ctx)
+	for _, trader := range []sdk.AccAddress{alice, bob} {
+		assert.False(t, hasLiquidatableSince(trader))
+	}
+
+	t.Log("a position liquidatable again gets a new priority window")
+	setMaintenanceMarginRatio(sdk.MustNewDecFromStr("0.2"))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	startWindows()
+	perpKeeper.ClearHealthyLiquidatableSince(ctx)
+	for _, trader := range []sdk.AccAddress{alice, bob} {
+		assert.True(t, hasLiquidatableSince(trader))
+	}
+
+	t.Log("a partial liquidation forgets the priority window")
+	_, _, err := perpKeeper.Liquidate(ctx, whitelisted, pair, alice)
+	require.NoError(t, err)
+	_, err = perpKeeper.Positions.Get(ctx, collections.Join(pair, alice))
+	require.NoError(t, err)
+	assert.False(t, hasLiquidatableSince(alice))
+
+	t.Log("closing the position forgets the priority window")
+	setMaintenanceMarginRatio(sdk.MustNewDecFromStr("0.0625"))
+	_, err = perpKeeper.ClosePosition(ctx, pair, bob)
+	require.NoError(t, err)
+	assert.False(t, hasLiquidatableSince(bob))
+}
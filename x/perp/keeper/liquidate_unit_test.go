@@ -104,9 +104,6 @@ func TestLiquidateIntoPartialLiquidation(t *testing.T) {
 			t.Log("set pair metadata")
 			setPairMetadata(perpKeeper, ctx, types.PairMetadata{
 				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-				},
 			})
 
 			t.Log("mock vpool keeper")
@@ -278,9 +275,6 @@ func TestLiquidateIntoFullLiquidation(t *testing.T) {
 			t.Log("set pair metadata")
 			setPairMetadata(perpKeeper, ctx, types.PairMetadata{
 				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-				},
 			})
 
 			t.Log("mock vpool keeper")
@@ -448,9 +442,6 @@ func TestLiquidateIntoFullLiquidationWithBadDebt(t *testing.T) {
 			t.Log("set pair metadata")
 			setPairMetadata(perpKeeper, ctx, types.PairMetadata{
 				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-				},
 			})
 
 			t.Log("mock vpool keeper")
@@ -503,6 +494,7 @@ func TestLiquidateIntoFullLiquidationWithBadDebt(t *testing.T) {
 					sdk.NewCoins(tc.expectedLiquidatorFee),
 				).
 				Return(nil)
+			mockEmptyInsuranceFund(mocks, ctx, common.DenomNUSD)
 			mocks.mockBankKeeper.EXPECT().
 				SendCoinsFromModuleToModule(
 					ctx, types.PerpEFModuleAccount, types.VaultModuleAccount,
@@ -914,6 +906,7 @@ func TestKeeper_ExecuteFullLiquidation(t *testing.T) {
 				).Return(nil)
 			}
 			if tc.expectedLiquidationBadDebt.IsPositive() {
+				mockEmptyInsuranceFund(mocks, ctx, "unusd")
 				mocks.mockBankKeeper.EXPECT().SendCoinsFromModuleToModule(
 					ctx, types.PerpEFModuleAccount, types.VaultModuleAccount,
 					sdk.NewCoins(sdk.NewCoin("unusd", tc.expectedLiquidationBadDebt)),
@@ -926,9 +919,6 @@ func TestKeeper_ExecuteFullLiquidation(t *testing.T) {
 			perpKeeper.SetParams(ctx, newParams)
 			setPairMetadata(perpKeeper, ctx, types.PairMetadata{
 				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(), // zero funding payment for this test case
-				},
 			})
 
 			t.Log("mock vpool")
@@ -1219,9 +1209,6 @@ func TestKeeper_ExecutePartialLiquidation(t *testing.T) {
 			perpKeeper.SetParams(ctx, newParams)
 			setPairMetadata(perpKeeper, ctx, types.PairMetadata{
 				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(), // zero funding payment for this test case
-				},
 			})
 
 			t.Log("mock vpool")
@@ -53,6 +53,7 @@ func (k Keeper) AddMargin(
 	position.LatestCumulativePremiumFraction = remainingMargin.LatestCumulativePremiumFraction
 	position.BlockNumber = ctx.BlockHeight()
 	k.Positions.Insert(ctx, collections.Join(position.Pair, traderAddr), position)
+	k.clearLiquidatableSince(ctx, pair, traderAddr)
 
 	positionNotional, unrealizedPnl, err := k.getPositionNotionalAndUnrealizedPnL(ctx, position, types.PnLCalcOption_SPOT_PRICE)
 	if err != nil {
@@ -71,6 +72,7 @@ func (k Keeper) AddMargin(
 			Margin:                sdk.NewCoin(pair.QuoteDenom(), position.Margin.RoundInt()),
 			PositionNotional:      positionNotional,
 			ExchangedPositionSize: sdk.ZeroDec(),                                 // always zero when adding margin
+			ExchangedNotional:     sdk.ZeroDec(),                                 // always zero when adding margin
 			TransactionFee:        sdk.NewCoin(pair.QuoteDenom(), sdk.ZeroInt()), // always zero when adding margin
 			PositionSize:          position.Size_,
 			RealizedPnl:           sdk.ZeroDec(), // always zero when adding margin
@@ -145,6 +147,7 @@ func (k Keeper) RemoveMargin(
 	}
 
 	k.Positions.Insert(ctx, collections.Join(position.Pair, traderAddr), position)
+	k.clearLiquidatableSince(ctx, pair, traderAddr)
 
 	positionNotional, unrealizedPnl, err := k.getPositionNotionalAndUnrealizedPnL(ctx, position, types.PnLCalcOption_SPOT_PRICE)
 	if err != nil {
@@ -167,6 +170,7 @@ func (k Keeper) RemoveMargin(
 			Margin:                sdk.NewCoin(pair.QuoteDenom(), position.Margin.RoundInt()),
 			PositionNotional:      positionNotional,
 			ExchangedPositionSize: sdk.ZeroDec(),                                 // always zero when removing margin
+			ExchangedNotional:     sdk.ZeroDec(),                                 // always zero when removing margin
 			TransactionFee:        sdk.NewCoin(pair.QuoteDenom(), sdk.ZeroInt()), // always zero when removing margin
 			PositionSize:          position.Size_,
 			RealizedPnl:           sdk.ZeroDec(), // always zero when removing margin
@@ -185,7 +189,8 @@ func (k Keeper) RemoveMargin(
 	return margin, remainingMargin.FundingPayment, position, nil
 }
 
-// GetMarginRatio calculates the MarginRatio from a Position
+// GetMarginRatio calculates the MarginRatio from a Position.
+// For cross margin accounts, the margin ratio of the whole account is returned.
 func (k Keeper) GetMarginRatio(
 	ctx sdk.Context, position types.Position, priceOption types.MarginCalculationPriceOption,
 ) (marginRatio sdk.Dec, err error) {
@@ -193,11 +198,62 @@ func (k Keeper) GetMarginRatio(
 		return sdk.Dec{}, types.ErrPositionZero
 	}
 
-	var (
-		unrealizedPnL    sdk.Dec
-		positionNotional sdk.Dec
+	if k.isCrossMarginPosition(ctx, position) {
+		marginRatio, _, err = k.calcAccountMarginRatio(ctx, k.getAccountPositions(ctx, position), priceOption)
+		return marginRatio, err
+	}
+
+	return k.getIsolatedMarginRatio(ctx, position, priceOption)
+}
+
+// getIsolatedMarginRatio calculates the MarginRatio of a Position on its own,
+// regardless of the margin mode of its trader.
+func (k Keeper) getIsolatedMarginRatio(
+	ctx sdk.Context, position types.Position, priceOption types.MarginCalculationPriceOption,
+) (marginRatio sdk.Dec, err error) {
+	positionNotional, unrealizedPnL, err := k.getMarginCalculationNotionalAndPnL(ctx, position, priceOption)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if positionNotional.IsZero() {
+		// NOTE causes division by zero in margin ratio calculation
+		return sdk.Dec{},
+			fmt.Errorf("margin ratio doesn't make sense with zero position notional")
+	}
+
+	remaining, err := k.CalcRemainMarginWithFundingPayment(
+		ctx,
+		/* oldPosition */ position,
+		/* marginDelta */ unrealizedPnL,
 	)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
 
+	marginRatio = remaining.Margin.Sub(remaining.BadDebt).
+		Quo(positionNotional)
+	return marginRatio, nil
+}
+
+// getMaintenanceMarginRatio returns the maintenance margin ratio the margin
+// ratio of the position is checked against. For cross margin accounts, it is
+// the notional weighted maintenance margin ratio of the whole account.
+func (k Keeper) getMaintenanceMarginRatio(
+	ctx sdk.Context, position types.Position, priceOption types.MarginCalculationPriceOption,
+) (sdk.Dec, error) {
+	if !k.isCrossMarginPosition(ctx, position) {
+		return k.VpoolKeeper.GetMaintenanceMarginRatio(ctx, position.Pair), nil
+	}
+
+	_, maintenanceMarginRatio, err := k.calcAccountMarginRatio(ctx, k.getAccountPositions(ctx, position), priceOption)
+	return maintenanceMarginRatio, err
+}
+
+// getMarginCalculationNotionalAndPnL returns the position notional and
+// unrealized PnL used to compute margin ratios with the given price option.
+func (k Keeper) getMarginCalculationNotionalAndPnL(
+	ctx sdk.Context, position types.Position, priceOption types.MarginCalculationPriceOption,
+) (positionNotional sdk.Dec, unrealizedPnL sdk.Dec, err error) {
 	switch priceOption {
 	case types.MarginCalculationPriceOption_MAX_PNL:
 		positionNotional, unrealizedPnL, err = k.GetPreferencePositionNotionalAndUnrealizedPnL(
@@ -217,29 +273,15 @@ func (k Keeper) GetMarginRatio(
 			position,
 			types.PnLCalcOption_SPOT_PRICE,
 		)
+	default:
+		err = fmt.Errorf("unrecognized margin calculation price option: %s", priceOption)
 	}
 
 	if err != nil {
-		return sdk.Dec{}, err
-	}
-	if positionNotional.IsZero() {
-		// NOTE causes division by zero in margin ratio calculation
-		return sdk.Dec{},
-			fmt.Errorf("margin ratio doesn't make sense with zero position notional")
-	}
-
-	remaining, err := k.CalcRemainMarginWithFundingPayment(
-		ctx,
-		/* oldPosition */ position,
-		/* marginDelta */ unrealizedPnL,
-	)
-	if err != nil {
-		return sdk.Dec{}, err
+		return sdk.Dec{}, sdk.Dec{}, err
 	}
 
-	marginRatio = remaining.Margin.Sub(remaining.BadDebt).
-		Quo(positionNotional)
-	return marginRatio, nil
+	return positionNotional, unrealizedPnL, nil
 }
 
 func (k Keeper) requireVpool(ctx sdk.Context, pair common.AssetPair) (err error) {
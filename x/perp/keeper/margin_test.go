@@ -74,17 +74,17 @@ func TestAddMarginSuccess(t *testing.T) {
 				/* maxOracleSpreadRatio */ sdk.OneDec(), // 100%
 				/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+				/* maxOpenInterest */ sdk.ZeroDec(),
+				/* maxPositionSize */ sdk.ZeroDec(),
 			)
 			require.True(t, vpoolKeeper.ExistsPool(ctx, common.Pair_BTC_NUSD))
 
 			t.Log("set pair metadata")
 			setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
 				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					tc.latestCumulativePremiumFraction,
-				},
 			},
 			)
+			setCumulativePremiumFractions(nibiruApp.PerpKeeper, ctx, common.Pair_BTC_NUSD, tc.latestCumulativePremiumFraction)
 
 			t.Log("establish initial position")
 			setPosition(nibiruApp.PerpKeeper, ctx, tc.initialPosition)
@@ -144,6 +144,8 @@ func TestRemoveMargin(t *testing.T) {
 					/* maxOracleSpreadRatio */ sdk.MustNewDecFromStr("1.0"), // 100%
 					/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
 					/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+					/* maxOpenInterest */ sdk.ZeroDec(),
+					/* maxPositionSize */ sdk.ZeroDec(),
 				)
 
 				removeAmt := sdk.NewInt(5)
@@ -176,6 +178,8 @@ func TestRemoveMargin(t *testing.T) {
 					/* maxOracleSpreadRatio */ sdk.MustNewDecFromStr("0.4"), // 0.9 ratio
 					/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
 					/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+					/* maxOpenInterest */ sdk.ZeroDec(),
+					/* maxPositionSize */ sdk.ZeroDec(),
 				)
 				require.True(t, vpoolKeeper.ExistsPool(ctx, pair))
 
@@ -183,8 +187,7 @@ func TestRemoveMargin(t *testing.T) {
 
 				t.Log("Set vpool defined by pair on PerpKeeper")
 				setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
-					Pair:                       pair,
-					CumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec()},
+					Pair: pair,
 				})
 
 				t.Log("increment block height and time for twap calculation")
@@ -229,6 +232,7 @@ func TestRemoveMargin(t *testing.T) {
 						Margin:                sdk.NewInt64Coin(pair.QuoteDenom(), 54),
 						PositionNotional:      sdk.NewDec(300),
 						ExchangedPositionSize: sdk.ZeroDec(),                                 // always zero when removing margin
+						ExchangedNotional:     sdk.ZeroDec(),                                 // always zero when removing margin
 						TransactionFee:        sdk.NewCoin(pair.QuoteDenom(), sdk.ZeroInt()), // always zero when removing margin
 						PositionSize:          sdk.MustNewDecFromStr("299.910026991902429271"),
 						RealizedPnl:           sdk.ZeroDec(), // always zero when removing margin
@@ -164,9 +164,9 @@ func TestGetMarginRatio(t *testing.T) {
 				Return(tc.newPrice, nil)
 
 			setPairMetadata(perpKeeper, ctx, types.PairMetadata{
-				Pair:                       common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{sdk.OneDec()},
+				Pair: common.Pair_BTC_NUSD,
 			})
+			setCumulativePremiumFractions(perpKeeper, ctx, common.Pair_BTC_NUSD, sdk.OneDec())
 
 			marginRatio, err := perpKeeper.GetMarginRatio(
 				ctx, tc.position, types.MarginCalculationPriceOption_MAX_PNL)
@@ -200,10 +200,11 @@ func TestRemoveMargin(t *testing.T) {
 				t.Log("Set vpool defined by pair on PerpKeeper")
 				setPairMetadata(perpKeeper, ctx, types.PairMetadata{
 					Pair: pair,
-					CumulativePremiumFractions: []sdk.Dec{
-						sdk.ZeroDec(),
-						sdk.MustNewDecFromStr("0.1")},
 				})
+				setCumulativePremiumFractions(perpKeeper, ctx, pair,
+					sdk.ZeroDec(),
+					sdk.MustNewDecFromStr("0.1"),
+				)
 
 				t.Log("Set an underwater position, positive bad debt due to excessive margin request")
 				setPosition(perpKeeper, ctx, types.Position{
@@ -256,6 +257,7 @@ func TestRemoveMargin(t *testing.T) {
 
 				t.Log("mock bank keeper")
 				expectedError := fmt.Errorf("not enough funds in vault module account")
+				mockEmptyInsuranceFund(mocks, ctx, pair.QuoteDenom())
 				mocks.mockBankKeeper.EXPECT().SendCoinsFromModuleToModule(
 					ctx, types.PerpEFModuleAccount, types.VaultModuleAccount, sdk.NewCoins(marginToWithdraw),
 				).Return(expectedError)
@@ -268,9 +270,6 @@ func TestRemoveMargin(t *testing.T) {
 				t.Log("set pair metadata")
 				setPairMetadata(perpKeeper, ctx, types.PairMetadata{
 					Pair: pair,
-					CumulativePremiumFractions: []sdk.Dec{
-						sdk.ZeroDec(),
-					},
 				})
 
 				t.Log("Set position a healthy position that has 0 unrealized funding")
@@ -330,9 +329,6 @@ func TestRemoveMargin(t *testing.T) {
 				t.Log("set pair metadata")
 				setPairMetadata(perpKeeper, ctx, types.PairMetadata{
 					Pair: pair,
-					CumulativePremiumFractions: []sdk.Dec{
-						sdk.ZeroDec(),
-					},
 				})
 
 				t.Log("Set position a healthy position that has 0 unrealized funding")
@@ -368,6 +364,7 @@ func TestRemoveMargin(t *testing.T) {
 						Margin:                sdk.NewInt64Coin(pair.QuoteDenom(), 400),
 						PositionNotional:      sdk.NewDec(1000),
 						ExchangedPositionSize: sdk.ZeroDec(),                                 // always zero when removing margin
+						ExchangedNotional:     sdk.ZeroDec(),                                 // always zero when removing margin
 						TransactionFee:        sdk.NewCoin(pair.QuoteDenom(), sdk.ZeroInt()), // always zero when removing margin
 						PositionSize:          sdk.NewDec(1000),
 						RealizedPnl:           sdk.ZeroDec(), // always zero when removing margin
@@ -403,10 +400,8 @@ func TestRemoveMargin(t *testing.T) {
 				t.Log("set pair metadata")
 				setPairMetadata(perpKeeper, ctx, types.PairMetadata{
 					Pair: pair,
-					CumulativePremiumFractions: []sdk.Dec{
-						sdk.OneDec(),
-					},
 				})
+				setCumulativePremiumFractions(perpKeeper, ctx, pair, sdk.OneDec())
 
 				t.Log("Set position a healthy position that has 0 unrealized funding")
 				setPosition(perpKeeper, ctx, types.Position{
@@ -460,8 +455,7 @@ func TestAddMargin(t *testing.T) {
 
 				t.Log("set pair metadata")
 				setPairMetadata(perpKeeper, ctx, types.PairMetadata{
-					Pair:                       pair,
-					CumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec()},
+					Pair: pair,
 				})
 				mocks.mockVpoolKeeper.EXPECT().ExistsPool(ctx, pair).Return(true)
 
@@ -502,8 +496,7 @@ func TestAddMargin(t *testing.T) {
 
 				t.Log("set pair metadata")
 				setPairMetadata(perpKeeper, ctx, types.PairMetadata{
-					Pair:                       pair,
-					CumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec()},
+					Pair: pair,
 				})
 
 				t.Log("set position")
@@ -544,6 +537,7 @@ func TestAddMargin(t *testing.T) {
 						Margin:                sdk.NewInt64Coin(pair.QuoteDenom(), 600),
 						PositionNotional:      sdk.NewDec(1000),
 						ExchangedPositionSize: sdk.ZeroDec(),                                 // always zero when adding margin
+						ExchangedNotional:     sdk.ZeroDec(),                                 // always zero when adding margin
 						TransactionFee:        sdk.NewCoin(pair.QuoteDenom(), sdk.ZeroInt()), // always zero when adding margin
 						PositionSize:          sdk.NewDec(1000),
 						RealizedPnl:           sdk.ZeroDec(), // always zero when adding margin
@@ -573,9 +567,9 @@ func TestAddMargin(t *testing.T) {
 
 				t.Log("set pair metadata")
 				setPairMetadata(perpKeeper, ctx, types.PairMetadata{
-					Pair:                       pair,
-					CumulativePremiumFractions: []sdk.Dec{sdk.MustNewDecFromStr("0.001")},
+					Pair: pair,
 				})
+				setCumulativePremiumFractions(perpKeeper, ctx, pair, sdk.MustNewDecFromStr("0.001"))
 
 				t.Log("set position")
 				setPosition(perpKeeper, ctx, types.Position{
@@ -614,6 +608,7 @@ func TestAddMargin(t *testing.T) {
 						Margin:                sdk.NewInt64Coin(pair.QuoteDenom(), 599),
 						PositionNotional:      sdk.NewDec(1000),
 						ExchangedPositionSize: sdk.ZeroDec(),                                 // always zero when adding margin
+						ExchangedNotional:     sdk.ZeroDec(),                                 // always zero when adding margin
 						TransactionFee:        sdk.NewCoin(pair.QuoteDenom(), sdk.ZeroInt()), // always zero when adding margin
 						PositionSize:          sdk.NewDec(1000),
 						RealizedPnl:           sdk.ZeroDec(), // always zero when adding margin
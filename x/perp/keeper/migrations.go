@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+)
+
+// Migrator handles the in-place store migrations of the x/perp module.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 moves the cumulative premium fractions out of the pair metadata and
+// into the CumulativePremiumFractions map. The n-th element of the old list becomes
+// the cumulative premium fraction of the n-th funding epoch of the pair.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	for _, pairMetadata := range m.keeper.PairsMetadata.Iterate(ctx, collections.Range[common.AssetPair]{}).Values() {
+		for epoch, cumulativePremiumFraction := range pairMetadata.CumulativePremiumFractions { //nolint:staticcheck // reads the previous layout
+			m.keeper.CumulativePremiumFractions.Insert(ctx, collections.Join(pairMetadata.Pair, uint64(epoch)), cumulativePremiumFraction)
+		}
+
+		pairMetadata.CumulativePremiumFractions = nil //nolint:staticcheck // clears the previous layout
+		m.keeper.PairsMetadata.Insert(ctx, pairMetadata.Pair, pairMetadata)
+	}
+	return nil
+}
+
+// Migrate3to4 sets the insurance fund params, which the previous versions do not have,
+// to their default values.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.migrateParams(ctx)
+	return nil
+}
+
+// Migrate4to5 computes the open interest of every pair from the open positions, which
+// is tracked incrementally from this version on.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	m.keeper.RebuildOpenInterests(ctx)
+	return nil
+}
+
+// Migrate5to6 sets the trading halt params, which the previous versions do not have,
+// to their default values: no guardian, no halted pair and the circuit breaker disabled.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	m.migrateParams(ctx)
+	return nil
+}
+
+// Migrate6to7 sets the permissionless liquidation params to their default values:
+// only the whitelisted liquidators can liquidate, as in the previous versions.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	m.migrateParams(ctx)
+	return nil
+}
+
+// Migrate7to8 builds the index of the positions by pair, which the previous versions
+// do not have, by inserting the open positions again.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	positions := m.keeper.Positions.Iterate(ctx, collections.PairRange[common.AssetPair, sdk.AccAddress]{}).KeyValues()
+	for _, kv := range positions {
+		m.keeper.Positions.Insert(ctx, kv.Key, kv.Value)
+	}
+	return nil
+}
+
+// Migrate8to9 sets the fee tier and maker rebate params to their default values, which keep
+// the flat fees of the previous versions.
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	m.migrateParams(ctx)
+	return nil
+}
+
+// Migrate9to10 sets the order deposit param to its default value, sets a zero deposit on
+// the resting orders, which were placed without one, and builds the index of the orders
+// by trigger price by inserting them again.
+func (m Migrator) Migrate9to10(ctx sdk.Context) error {
+	m.migrateParams(ctx)
+
+	for _, order := range m.keeper.Orders.Iterate(ctx, collections.Range[uint64]{}).Values() {
+		if order.Deposit.IsNil() {
+			order.Deposit = sdk.ZeroInt()
+		}
+		m.keeper.Orders.Insert(ctx, order.Id, order)
+	}
+	return nil
+}
+
+// migrateParams sets the params which the previous version does not have to their
+// default values, keeping the ones it has.
+func (m Migrator) migrateParams(ctx sdk.Context) {
+	params := types.DefaultParams()
+	m.keeper.ParamSubspace.GetParamSetIfExists(ctx, &params)
+	m.keeper.SetParams(ctx, params)
+}
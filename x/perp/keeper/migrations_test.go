@@ -0,0 +1,269 @@
+package keeper
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+)
+
+func TestMigrate2to3(t *testing.T) {
+	perpKeeper, _, ctx := getKeeper(t)
+
+	t.Log("set pair metadata with the previous layout")
+	setPairMetadata(perpKeeper, ctx, types.PairMetadata{
+		Pair:                       common.Pair_BTC_NUSD,
+		CumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec(), sdk.NewDec(1), sdk.NewDec(2)},
+	})
+	setPairMetadata(perpKeeper, ctx, types.PairMetadata{
+		Pair:                       common.Pair_ETH_NUSD,
+		CumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec()},
+	})
+
+	t.Log("migrate the store")
+	require.NoError(t, NewMigrator(perpKeeper).Migrate2to3(ctx))
+
+	t.Log("assert cumulative premium fractions were moved to their funding epochs")
+	assert.EqualValues(t,
+		[]collections.KeyValue[collections.Pair[common.AssetPair, uint64], sdk.Dec]{
+			{Key: collections.Join(common.Pair_BTC_NUSD, uint64(0)), Value: sdk.ZeroDec()},
+			{Key: collections.Join(common.Pair_BTC_NUSD, uint64(1)), Value: sdk.NewDec(1)},
+			{Key: collections.Join(common.Pair_BTC_NUSD, uint64(2)), Value: sdk.NewDec(2)},
+			{Key: collections.Join(common.Pair_ETH_NUSD, uint64(0)), Value: sdk.ZeroDec()},
+		},
+		perpKeeper.CumulativePremiumFractions.Iterate(ctx, collections.PairRange[common.AssetPair, uint64]{}).KeyValues(),
+	)
+
+	latest, err := perpKeeper.getLatestCumulativePremiumFraction(ctx, common.Pair_BTC_NUSD)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(2), latest)
+
+	t.Log("assert pair metadata no longer holds the cumulative premium fractions")
+	for _, pairMetadata := range perpKeeper.PairsMetadata.Iterate(ctx, collections.Range[common.AssetPair]{}).Values() {
+		assert.Empty(t, pairMetadata.CumulativePremiumFractions) //nolint:staticcheck
+		assert.NoError(t, pairMetadata.Validate())
+	}
+}
+
+func TestMigrate3to4(t *testing.T) {
+	perpKeeper, _, ctx := getKeeper(t)
+
+	t.Log("set the params of the previous version")
+	previousParams := types.DefaultParams()
+	previousParams.TwapLookbackWindow = 30 * time.Minute
+	for _, pair := range previousParams.ParamSetPairs() {
+		if string(pair.Key) == "InsuranceFundFeeShare" || string(pair.Key) == "InsuranceFundWithdrawalCooldown" {
+			continue
+		}
+		perpKeeper.ParamSubspace.Set(ctx, pair.Key, reflect.Indirect(reflect.ValueOf(pair.Value)).Interface())
+	}
+
+	t.Log("migrate the store")
+	require.NoError(t, NewMigrator(perpKeeper).Migrate3to4(ctx))
+
+	t.Log("assert the insurance fund params are set and the others are kept")
+	params := perpKeeper.GetParams(ctx)
+	assert.Equal(t, 30*time.Minute, params.TwapLookbackWindow)
+	assert.Equal(t, types.DefaultParams().InsuranceFundFeeShare, params.InsuranceFundFeeShare)
+	assert.Equal(t, types.DefaultParams().InsuranceFundWithdrawalCooldown, params.InsuranceFundWithdrawalCooldown)
+}
+
+func TestMigrate4to5(t *testing.T) {
+	perpKeeper, _, ctx := getKeeper(t)
+
+	t.Log("set positions of the previous version, which doesn't track open interest")
+	for _, position := range []types.Position{
+		{TraderAddress: testutil.AccAddress().String(), Pair: common.Pair_BTC_NUSD, Size_: sdk.NewDec(10)},
+		{TraderAddress: testutil.AccAddress().String(), Pair: common.Pair_BTC_NUSD, Size_: sdk.NewDec(5)},
+		{TraderAddress: testutil.AccAddress().String(), Pair: common.Pair_BTC_NUSD, Size_: sdk.NewDec(-7)},
+		{TraderAddress: testutil.AccAddress().String(), Pair: common.Pair_ETH_NUSD, Size_: sdk.NewDec(-3)},
+	} {
+		perpKeeper.Positions.Insert(ctx, collections.Join(position.Pair, sdk.MustAccAddressFromBech32(position.TraderAddress)), position)
+	}
+
+	t.Log("migrate the store")
+	require.NoError(t, NewMigrator(perpKeeper).Migrate4to5(ctx))
+
+	t.Log("assert the open interest of every pair is computed from its positions")
+	assert.Equal(t,
+		types.OpenInterest{Pair: common.Pair_BTC_NUSD, Long: sdk.NewDec(15), Short: sdk.NewDec(7)},
+		perpKeeper.GetOpenInterest(ctx, common.Pair_BTC_NUSD),
+	)
+	assert.Equal(t,
+		types.OpenInterest{Pair: common.Pair_ETH_NUSD, Long: sdk.ZeroDec(), Short: sdk.NewDec(3)},
+		perpKeeper.GetOpenInterest(ctx, common.Pair_ETH_NUSD),
+	)
+}
+
+func TestMigrate5to6(t *testing.T) {
+	perpKeeper, _, ctx := getKeeper(t)
+
+	t.Log("set params of the previous version, which doesn't have the trading halt params")
+	previousParams := types.DefaultParams()
+	previousParams.Stopped = true
+	for _, pair := range previousParams.ParamSetPairs() {
+		switch string(pair.Key) {
+		case "Guardian", "HaltedPairs", "CircuitBreakerBand", "CircuitBreakerWindow":
+			continue
+		}
+		perpKeeper.ParamSubspace.Set(ctx, pair.Key, reflect.Indirect(reflect.ValueOf(pair.Value)).Interface())
+	}
+
+	t.Log("migrate the store")
+	require.NoError(t, NewMigrator(perpKeeper).Migrate5to6(ctx))
+
+	t.Log("assert the trading halt params are set and the others are kept")
+	params := perpKeeper.GetParams(ctx)
+	assert.True(t, params.Stopped)
+	assert.Empty(t, params.Guardian)
+	assert.Empty(t, params.HaltedPairs)
+	assert.True(t, params.CircuitBreakerBand.IsZero())
+	assert.Equal(t, types.DefaultParams().CircuitBreakerWindow, params.CircuitBreakerWindow)
+}
+
+func TestMigrate6to7(t *testing.T) {
+	perpKeeper, _, ctx := getKeeper(t)
+
+	t.Log("set params of the previous version, which doesn't have the permissionless liquidation params")
+	liquidator := testutil.AccAddress().String()
+	previousParams := types.DefaultParams()
+	previousParams.WhitelistedLiquidators = []string{liquidator}
+	for _, pair := range previousParams.ParamSetPairs() {
+		switch string(pair.Key) {
+		case "PermissionlessLiquidations", "LiquidatorPriorityBlocks",
+			"MaxPermissionlessLiquidationsPerBlock", "MaxLiquidatorRewardRatio":
+			continue
+		}
+		perpKeeper.ParamSubspace.Set(ctx, pair.Key, reflect.Indirect(reflect.ValueOf(pair.Value)).Interface())
+	}
+
+	t.Log("migrate the store")
+	require.NoError(t, NewMigrator(perpKeeper).Migrate6to7(ctx))
+
+	t.Log("assert the permissionless liquidation params are set and the others are kept")
+	params := perpKeeper.GetParams(ctx)
+	assert.Equal(t, []string{liquidator}, params.WhitelistedLiquidators)
+	assert.False(t, params.PermissionlessLiquidations)
+	assert.EqualValues(t, 10, params.LiquidatorPriorityBlocks)
+	assert.EqualValues(t, 50, params.MaxPermissionlessLiquidationsPerBlock)
+	assert.Equal(t, sdk.MustNewDecFromStr("0.5"), params.MaxLiquidatorRewardRatio)
+}
+
+func TestMigrate7to8(t *testing.T) {
+	perpKeeper, _, ctx := getKeeper(t)
+
+	t.Log("set positions without indexing them, as the previous version did")
+	previousPositions := collections.NewMap(
+		perpKeeper.storeKey, 0,
+		collections.PairKeyEncoder(common.AssetPairKeyEncoder, collections.AccAddressKeyEncoder),
+		collections.ProtoValueEncoder[types.Position](perpKeeper.cdc),
+	)
+	alice, bob, carol := testutil.AccAddress(), testutil.AccAddress(), testutil.AccAddress()
+	for _, position := range []struct {
+		pair   common.AssetPair
+		trader sdk.AccAddress
+	}{
+		{common.Pair_BTC_NUSD, alice},
+		{common.Pair_BTC_NUSD, bob},
+		{common.Pair_ETH_NUSD, carol},
+	} {
+		previousPositions.Insert(ctx, collections.Join(position.pair, position.trader), types.Position{
+			TraderAddress:                   position.trader.String(),
+			Pair:                            position.pair,
+			Size_:                           sdk.OneDec(),
+			Margin:                          sdk.OneDec(),
+			OpenNotional:                    sdk.OneDec(),
+			LatestCumulativePremiumFraction: sdk.ZeroDec(),
+		})
+	}
+	assert.Empty(t, perpKeeper.Positions.Indexes.PositionsByPair.ExactMatch(ctx, common.Pair_BTC_NUSD).PrimaryKeys())
+
+	t.Log("migrate the store")
+	require.NoError(t, NewMigrator(perpKeeper).Migrate7to8(ctx))
+
+	t.Log("assert the positions are indexed by pair")
+	assert.ElementsMatch(t,
+		[]collections.Pair[common.AssetPair, sdk.AccAddress]{
+			collections.Join(common.Pair_BTC_NUSD, alice),
+			collections.Join(common.Pair_BTC_NUSD, bob),
+		},
+		perpKeeper.Positions.Indexes.PositionsByPair.ExactMatch(ctx, common.Pair_BTC_NUSD).PrimaryKeys(),
+	)
+	assert.Equal(t,
+		[]collections.Pair[common.AssetPair, sdk.AccAddress]{collections.Join(common.Pair_ETH_NUSD, carol)},
+		perpKeeper.Positions.Indexes.PositionsByPair.ExactMatch(ctx, common.Pair_ETH_NUSD).PrimaryKeys(),
+	)
+}
+
+func TestMigrate8to9(t *testing.T) {
+	perpKeeper, _, ctx := getKeeper(t)
+
+	t.Log("set params of the previous version, which doesn't have the fee tier params")
+	previousParams := types.DefaultParams()
+	previousParams.FeePoolFeeRatio = sdk.MustNewDecFromStr("0.002")
+	for _, pair := range previousParams.ParamSetPairs() {
+		switch string(pair.Key) {
+		case "FeeTiers", "MakerRebateRatio":
+			continue
+		}
+		perpKeeper.ParamSubspace.Set(ctx, pair.Key, reflect.Indirect(reflect.ValueOf(pair.Value)).Interface())
+	}
+
+	t.Log("migrate the store")
+	require.NoError(t, NewMigrator(perpKeeper).Migrate8to9(ctx))
+
+	t.Log("assert the fee tier params are set and the others are kept")
+	params := perpKeeper.GetParams(ctx)
+	assert.Equal(t, sdk.MustNewDecFromStr("0.002"), params.FeePoolFeeRatio)
+	assert.Empty(t, params.FeeTiers)
+	assert.Equal(t, sdk.ZeroDec(), params.MakerRebateRatio)
+}
+
+func TestMigrate9to10(t *testing.T) {
+	perpKeeper, _, ctx := getKeeper(t)
+
+	t.Log("set params of the previous version, which doesn't have the order deposit")
+	previousParams := types.DefaultParams()
+	for _, pair := range previousParams.ParamSetPairs() {
+		if string(pair.Key) == "OrderDeposit" {
+			continue
+		}
+		perpKeeper.ParamSubspace.Set(ctx, pair.Key, reflect.Indirect(reflect.ValueOf(pair.Value)).Interface())
+	}
+
+	t.Log("set an order without deposit nor trigger price index entry")
+	order := types.Order{
+		Id:                   1,
+		Pair:                 common.Pair_BTC_NUSD,
+		TraderAddress:        testutil.AccAddress().String(),
+		OrderType:            types.OrderType_LIMIT,
+		Side:                 types.Side_BUY,
+		TriggerPrice:         sdk.MustNewDecFromStr("0.9"),
+		QuoteAssetAmount:     sdk.NewInt(1000),
+		Leverage:             sdk.NewDec(10),
+		BaseAssetAmountLimit: sdk.ZeroDec(),
+	}
+	perpKeeper.Orders.Insert(ctx, order.Id, order)
+	perpKeeper.Orders.Indexes.TriggerPrices.Delete(ctx, order.Id, order)
+
+	t.Log("migrate the store")
+	require.NoError(t, NewMigrator(perpKeeper).Migrate9to10(ctx))
+
+	t.Log("assert the order deposit param and the order's zero deposit are set")
+	assert.Equal(t, types.DefaultParams().OrderDeposit, perpKeeper.GetParams(ctx).OrderDeposit)
+	migrated, err := perpKeeper.Orders.Get(ctx, order.Id)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.ZeroInt(), migrated.Deposit)
+
+	t.Log("assert the order is indexed by trigger price")
+	assert.Len(t, perpKeeper.Orders.Indexes.TriggerPrices.Iterate(ctx,
+		collections.PairRange[common.AssetPair, collections.Pair[sdk.Dec, uint64]]{}.Prefix(common.Pair_BTC_NUSD)).Keys(), 1)
+}
@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/NibiruChain/nibiru/collections"
 	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/perp/types"
 )
@@ -24,10 +26,11 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 func (m msgServer) RemoveMargin(ctx context.Context, msg *types.MsgRemoveMargin,
 ) (*types.MsgRemoveMarginResponse, error) {
 	// These fields should have already been validated by MsgRemoveMargin.ValidateBasic() prior to being sent to the msgServer.
-	traderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	traderAddr := m.k.subaccountTrader(sdkCtx, sdk.MustAccAddressFromBech32(msg.Sender), msg.SubaccountId)
 	pair := common.MustNewAssetPair(msg.TokenPair)
 
-	marginOut, fundingPayment, position, err := m.k.RemoveMargin(sdk.UnwrapSDKContext(ctx), pair, traderAddr, msg.Margin)
+	marginOut, fundingPayment, position, err := m.k.RemoveMargin(sdkCtx, pair, traderAddr, msg.Margin)
 	if err != nil {
 		return nil, err
 	}
@@ -42,18 +45,20 @@ func (m msgServer) RemoveMargin(ctx context.Context, msg *types.MsgRemoveMargin,
 func (m msgServer) AddMargin(ctx context.Context, msg *types.MsgAddMargin,
 ) (*types.MsgAddMarginResponse, error) {
 	// These fields should have already been validated by MsgAddMargin.ValidateBasic() prior to being sent to the msgServer.
-	traderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	traderAddr := m.k.subaccountTrader(sdkCtx, sdk.MustAccAddressFromBech32(msg.Sender), msg.SubaccountId)
 	pair := common.MustNewAssetPair(msg.TokenPair)
-	return m.k.AddMargin(sdk.UnwrapSDKContext(ctx), pair, traderAddr, msg.Margin)
+	return m.k.AddMargin(sdkCtx, pair, traderAddr, msg.Margin)
 }
 
 func (m msgServer) OpenPosition(goCtx context.Context, req *types.MsgOpenPosition,
 ) (response *types.MsgOpenPositionResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
 	pair := common.MustNewAssetPair(req.TokenPair)
-	traderAddr := sdk.MustAccAddressFromBech32(req.Sender)
+	traderAddr := m.k.subaccountTrader(ctx, sdk.MustAccAddressFromBech32(req.Sender), req.SubaccountId)
 
 	positionResp, err := m.k.OpenPosition(
-		sdk.UnwrapSDKContext(goCtx),
+		ctx,
 		pair,
 		req.Side,
 		traderAddr,
@@ -77,12 +82,27 @@ func (m msgServer) OpenPosition(goCtx context.Context, req *types.MsgOpenPositio
 	}, nil
 }
 
-func (m msgServer) ClosePosition(goCtx context.Context, position *types.MsgClosePosition) (*types.MsgClosePositionResponse, error) {
+func (m msgServer) ClosePosition(goCtx context.Context, msg *types.MsgClosePosition) (*types.MsgClosePositionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	traderAddr := sdk.MustAccAddressFromBech32(position.Sender)
-	tokenPair := common.MustNewAssetPair(position.TokenPair)
+	traderAddr := m.k.subaccountTrader(ctx, sdk.MustAccAddressFromBech32(msg.Sender), msg.SubaccountId)
+	tokenPair := common.MustNewAssetPair(msg.TokenPair)
+
+	baseAmount := msg.GetBaseAssetAmountOrZero()
+	if fraction := msg.GetFractionOrZero(); fraction.IsPositive() {
+		position, err := m.k.Positions.Get(ctx, collections.Join(tokenPair, traderAddr))
+		if err != nil {
+			return nil, err
+		}
+		if fraction.LT(sdk.OneDec()) {
+			baseAmount = position.Size_.Abs().Mul(fraction)
+			if baseAmount.IsZero() {
+				// a zero base amount would close the entire position
+				return nil, fmt.Errorf("fraction %s of the position size %s is too small to close", fraction, position.Size_)
+			}
+		}
+	}
 
-	resp, err := m.k.ClosePosition(ctx, tokenPair, traderAddr)
+	resp, err := m.k.ReducePosition(ctx, tokenPair, traderAddr, baseAmount, msg.GetQuoteAssetAmountLimitOrZero())
 	if err != nil {
 		return nil, err
 	}
@@ -93,6 +113,7 @@ func (m msgServer) ClosePosition(goCtx context.Context, position *types.MsgClose
 		FundingPayment:         resp.FundingPayment,
 		RealizedPnl:            resp.RealizedPnl,
 		MarginToTrader:         resp.MarginToVault.Neg(),
+		Position:               resp.Position,
 	}, nil
 }
 
@@ -148,3 +169,136 @@ func (m msgServer) DonateToEcosystemFund(ctx context.Context, msg *types.MsgDona
 
 	return &types.MsgDonateToEcosystemFundResponse{}, nil
 }
+
+func (m msgServer) PlaceOrder(goCtx context.Context, msg *types.MsgPlaceOrder) (*types.MsgPlaceOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	order, err := m.k.PlaceOrder(
+		ctx,
+		common.MustNewAssetPair(msg.TokenPair),
+		m.k.subaccountTrader(ctx, sdk.MustAccAddressFromBech32(msg.Sender), msg.SubaccountId),
+		msg.OrderType,
+		msg.Side,
+		msg.TriggerPrice,
+		msg.QuoteAssetAmount,
+		msg.Leverage,
+		msg.BaseAssetAmountLimit.ToDec(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPlaceOrderResponse{OrderId: order.Id}, nil
+}
+
+func (m msgServer) CancelOrder(goCtx context.Context, msg *types.MsgCancelOrder) (*types.MsgCancelOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	refund, err := m.k.CancelOrder(
+		ctx,
+		m.k.subaccountTrader(ctx, sdk.MustAccAddressFromBech32(msg.Sender), msg.SubaccountId),
+		msg.OrderId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelOrderResponse{RefundedMargin: refund}, nil
+}
+
+func (m msgServer) SetMarginMode(goCtx context.Context, msg *types.MsgSetMarginMode) (*types.MsgSetMarginModeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.k.SetMarginMode(
+		ctx,
+		m.k.subaccountTrader(ctx, sdk.MustAccAddressFromBech32(msg.Sender), msg.SubaccountId),
+		msg.MarginMode,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetMarginModeResponse{}, nil
+}
+
+func (m msgServer) SettlePosition(goCtx context.Context, msg *types.MsgSettlePosition) (*types.MsgSettlePositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	traderAddr := m.k.subaccountTrader(ctx, sdk.MustAccAddressFromBech32(msg.Sender), msg.SubaccountId)
+
+	position, err := m.k.Positions.Get(ctx, collections.Join(common.MustNewAssetPair(msg.TokenPair), traderAddr))
+	if err != nil {
+		return nil, err
+	}
+
+	settledCoins, err := m.k.SettlePosition(ctx, position)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSettlePositionResponse{SettledCoins: settledCoins}, nil
+}
+
+func (m msgServer) DepositToInsuranceFund(goCtx context.Context, msg *types.MsgDepositToInsuranceFund) (*types.MsgDepositToInsuranceFundResponse, error) {
+	shares, err := m.k.DepositToInsuranceFund(
+		sdk.UnwrapSDKContext(goCtx),
+		sdk.MustAccAddressFromBech32(msg.Sender),
+		msg.Deposit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDepositToInsuranceFundResponse{Shares: shares}, nil
+}
+
+func (m msgServer) RequestInsuranceFundWithdrawal(goCtx context.Context, msg *types.MsgRequestInsuranceFundWithdrawal) (*types.MsgRequestInsuranceFundWithdrawalResponse, error) {
+	withdrawal, err := m.k.RequestInsuranceFundWithdrawal(
+		sdk.UnwrapSDKContext(goCtx),
+		sdk.MustAccAddressFromBech32(msg.Sender),
+		msg.Shares,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRequestInsuranceFundWithdrawalResponse{UnlockTime: withdrawal.UnlockTime}, nil
+}
+
+func (m msgServer) WithdrawFromInsuranceFund(goCtx context.Context, msg *types.MsgWithdrawFromInsuranceFund) (*types.MsgWithdrawFromInsuranceFundResponse, error) {
+	withdrawn, err := m.k.WithdrawFromInsuranceFund(
+		sdk.UnwrapSDKContext(goCtx),
+		sdk.MustAccAddressFromBech32(msg.Sender),
+		msg.Denom,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawFromInsuranceFundResponse{Withdrawn: withdrawn}, nil
+}
+
+func (m msgServer) SetTradingHalt(goCtx context.Context, msg *types.MsgSetTradingHalt) (*types.MsgSetTradingHaltResponse, error) {
+	err := m.k.SetTradingHalt(
+		sdk.UnwrapSDKContext(goCtx),
+		sdk.MustAccAddressFromBech32(msg.Sender),
+		msg.TokenPair,
+		msg.Halted,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetTradingHaltResponse{}, nil
+}
+
+func (m msgServer) TransferSubaccountMargin(
+	goCtx context.Context, msg *types.MsgTransferSubaccountMargin,
+) (*types.MsgTransferSubaccountMarginResponse, error) {
+	if err := m.k.TransferSubaccountMargin(
+		sdk.UnwrapSDKContext(goCtx),
+		sdk.MustAccAddressFromBech32(msg.Sender),
+		msg.FromSubaccountId,
+		msg.ToSubaccountId,
+		msg.Amount,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferSubaccountMarginResponse{}, nil
+}
@@ -84,10 +84,11 @@ func TestMsgServerAddMargin(t *testing.T) {
 				/* maxOracleSpreadRatio */ sdk.OneDec(),
 				/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+				/* maxOpenInterest */ sdk.ZeroDec(),
+				/* maxPositionSize */ sdk.ZeroDec(),
 			)
 			setPairMetadata(app.PerpKeeper, ctx, types.PairMetadata{
-				Pair:                       common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec()},
+				Pair: common.Pair_BTC_NUSD,
 			})
 
 			t.Log("fund trader")
@@ -203,10 +204,11 @@ func TestMsgServerRemoveMargin(t *testing.T) {
 				/* maxOracleSpreadRatio */ sdk.OneDec(),
 				/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+				/* maxOpenInterest */ sdk.ZeroDec(),
+				/* maxPositionSize */ sdk.ZeroDec(),
 			)
 			setPairMetadata(app.PerpKeeper, ctx, types.PairMetadata{
-				Pair:                       common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec()},
+				Pair: common.Pair_BTC_NUSD,
 			})
 
 			t.Log("fund vault")
@@ -290,10 +292,11 @@ func TestMsgServerOpenPosition(t *testing.T) {
 				/* maxOracleSpreadRatio */ sdk.OneDec(),
 				/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+				/* maxOpenInterest */ sdk.ZeroDec(),
+				/* maxPositionSize */ sdk.ZeroDec(),
 			)
 			setPairMetadata(app.PerpKeeper, ctx, types.PairMetadata{
-				Pair:                       common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec()},
+				Pair: common.Pair_BTC_NUSD,
 			})
 
 			traderAddr, err := sdk.AccAddressFromBech32(tc.sender)
@@ -375,10 +378,11 @@ func TestMsgServerClosePosition(t *testing.T) {
 				/* maxOracleSpreadRatio */ sdk.OneDec(),
 				/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+				/* maxOpenInterest */ sdk.ZeroDec(),
+				/* maxPositionSize */ sdk.ZeroDec(),
 			)
 			setPairMetadata(app.PerpKeeper, ctx, types.PairMetadata{
-				Pair:                       common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec()},
+				Pair: common.Pair_BTC_NUSD,
 			})
 
 			t.Log("create position")
@@ -414,6 +418,51 @@ func TestMsgServerClosePosition(t *testing.T) {
 	}
 }
 
+func TestMsgServerClosePositionFraction(t *testing.T) {
+	app, ctx := nibisimapp.NewTestNibiruAppAndContext(true)
+	msgServer := keeper.NewMsgServerImpl(app.PerpKeeper)
+	traderAddr := testutil.AccAddress()
+
+	app.VpoolKeeper.CreatePool(
+		ctx,
+		common.Pair_BTC_NUSD,
+		/* tradeLimitRatio */ sdk.OneDec(),
+		/* quoteAssetReserve */ sdk.NewDec(1_000_000),
+		/* baseAssetReserve */ sdk.NewDec(1_000_000),
+		/* fluctuationLimitRatio */ sdk.OneDec(),
+		/* maxOracleSpreadRatio */ sdk.OneDec(),
+		/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
+		/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+		/* maxOpenInterest */ sdk.ZeroDec(),
+		/* maxPositionSize */ sdk.ZeroDec(),
+	)
+	setPairMetadata(app.PerpKeeper, ctx, types.PairMetadata{
+		Pair: common.Pair_BTC_NUSD,
+	})
+
+	setPosition(app.PerpKeeper, ctx, types.Position{
+		TraderAddress:                   traderAddr.String(),
+		Pair:                            common.Pair_BTC_NUSD,
+		Size_:                           sdk.NewDec(-10),
+		Margin:                          sdk.NewDec(5),
+		OpenNotional:                    sdk.NewDec(10),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+		BlockNumber:                     1,
+	})
+
+	t.Log("close a quarter of the short position")
+	resp, err := msgServer.ClosePosition(sdk.WrapSDKContext(ctx), &types.MsgClosePosition{
+		Sender:                traderAddr.String(),
+		TokenPair:             common.Pair_BTC_NUSD.String(),
+		Fraction:              sdk.MustNewDecFromStr("0.25"),
+		QuoteAssetAmountLimit: sdk.NewDec(3),
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.MustNewDecFromStr("2.500000000000000000"), resp.ExchangedPositionSize)
+	assert.EqualValues(t, sdk.MustNewDecFromStr("-7.500000000000000000"), resp.Position.Size_)
+	assert.True(t, resp.MarginToTrader.IsZero(), "a partial close keeps the margin in the position")
+}
+
 func TestMsgServerLiquidate(t *testing.T) {
 	tests := []struct {
 		name string
@@ -452,10 +501,11 @@ func TestMsgServerLiquidate(t *testing.T) {
 				/* maxOracleSpreadRatio */ sdk.OneDec(),
 				/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+				/* maxOpenInterest */ sdk.ZeroDec(),
+				/* maxPositionSize */ sdk.ZeroDec(),
 			)
 			setPairMetadata(app.PerpKeeper, ctx, types.PairMetadata{
-				Pair:                       common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec()},
+				Pair: common.Pair_BTC_NUSD,
 			})
 			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(time.Now().Add(time.Minute))
 
@@ -527,10 +577,11 @@ func TestMsgServerMultiLiquidate(t *testing.T) {
 		/* maxOracleSpreadRatio */ sdk.OneDec(),
 		/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
 		/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+		/* maxOpenInterest */ sdk.ZeroDec(),
+		/* maxPositionSize */ sdk.ZeroDec(),
 	)
 	setPairMetadata(app.PerpKeeper, ctx, types.PairMetadata{
-		Pair:                       pair,
-		CumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec()},
+		Pair: pair,
 	})
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(time.Now().Add(time.Minute))
 
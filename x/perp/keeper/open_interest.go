@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+)
+
+// GetOpenInterest returns the total size of the long and of the short positions of the pair.
+func (k Keeper) GetOpenInterest(ctx sdk.Context, pair common.AssetPair) types.OpenInterest {
+	return k.OpenInterests.GetOr(ctx, pair, types.OpenInterest{
+		Pair:  pair,
+		Long:  sdk.ZeroDec(),
+		Short: sdk.ZeroDec(),
+	})
+}
+
+// updateOpenInterest replaces the size of a position of the pair, oldSize, with its new
+// size in the open interest of the pair. Positive sizes count toward the long side and
+// negative sizes toward the short side.
+func (k Keeper) updateOpenInterest(ctx sdk.Context, pair common.AssetPair, oldSize sdk.Dec, newSize sdk.Dec) {
+	openInterest := k.GetOpenInterest(ctx, pair)
+	openInterest.Long = openInterest.Long.
+		Sub(sdk.MaxDec(oldSize, sdk.ZeroDec())).
+		Add(sdk.MaxDec(newSize, sdk.ZeroDec()))
+	openInterest.Short = openInterest.Short.
+		Add(sdk.MinDec(oldSize, sdk.ZeroDec())).
+		Sub(sdk.MinDec(newSize, sdk.ZeroDec()))
+	k.OpenInterests.Insert(ctx, pair, openInterest)
+}
+
+/*
+checkPositionLimits checks that a position that grew from oldSize to newSize is within
+the max position size and the max open interest of its pair. It must be called after
+the open interest has been updated with the new size.
+
+Trades that don't grow the position on either side are always allowed, so that traders
+can reduce their exposure on pairs that are over their caps.
+*/
+func (k Keeper) checkPositionLimits(ctx sdk.Context, pair common.AssetPair, oldSize sdk.Dec, newSize sdk.Dec) error {
+	grewLong, grewShort := positionGrew(oldSize, newSize)
+	if !grewLong && !grewShort {
+		return nil
+	}
+
+	maxOpenInterest, maxPositionSize := k.VpoolKeeper.GetPositionLimits(ctx, pair)
+	if maxPositionSize.IsPositive() && newSize.Abs().GT(maxPositionSize) {
+		return types.ErrPositionSizeTooLarge.Wrapf(
+			"position size %s, max position size %s", newSize.Abs(), maxPositionSize)
+	}
+
+	if !maxOpenInterest.IsPositive() {
+		return nil
+	}
+	openInterest := k.GetOpenInterest(ctx, pair)
+	if grewLong && openInterest.Long.GT(maxOpenInterest) {
+		return types.ErrOpenInterestTooHigh.Wrapf(
+			"long open interest %s, max open interest %s", openInterest.Long, maxOpenInterest)
+	}
+	if grewShort && openInterest.Short.GT(maxOpenInterest) {
+		return types.ErrOpenInterestTooHigh.Wrapf(
+			"short open interest %s, max open interest %s", openInterest.Short, maxOpenInterest)
+	}
+	return nil
+}
+
+// positionGrew reports whether going from oldSize to newSize increased the exposure on the long or the short side.
+func positionGrew(oldSize sdk.Dec, newSize sdk.Dec) (grewLong bool, grewShort bool) {
+	grewLong = newSize.IsPositive() && newSize.GT(sdk.MaxDec(oldSize, sdk.ZeroDec()))
+	grewShort = newSize.IsNegative() && newSize.LT(sdk.MinDec(oldSize, sdk.ZeroDec()))
+	return grewLong, grewShort
+}
+
+// RebuildOpenInterests recomputes the open interest of every pair from the positions in state.
+func (k Keeper) RebuildOpenInterests(ctx sdk.Context) {
+	for _, pair := range k.OpenInterests.Iterate(ctx, collections.Range[common.AssetPair]{}).Keys() {
+		if err := k.OpenInterests.Delete(ctx, pair); err != nil {
+			panic(err)
+		}
+	}
+
+	positions := k.Positions.Iterate(ctx, collections.PairRange[common.AssetPair, sdk.AccAddress]{}).Values()
+	for _, position := range positions {
+		k.updateOpenInterest(ctx, position.Pair, sdk.ZeroDec(), position.Size_)
+	}
+}
@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/collections"
+	nibisimapp "github.com/NibiruChain/nibiru/simapp"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/keeper"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+)
+
+// setupOpenInterestPool creates a 1_000_000 x 1_000_000 vpool for the pair with the given
+// open interest and position size caps, and returns the app and context to trade on it.
+func setupOpenInterestPool(
+	t *testing.T, pair common.AssetPair, maxOpenInterest sdk.Dec, maxPositionSize sdk.Dec,
+) (*nibisimapp.NibiruTestApp, sdk.Context) {
+	t.Helper()
+	nibiruApp, ctx := nibisimapp.NewTestNibiruAppAndContext(true)
+	ctx = ctx.WithBlockTime(time.Now())
+
+	nibiruApp.VpoolKeeper.CreatePool(
+		ctx,
+		pair,
+		/* tradeLimitRatio */ sdk.MustNewDecFromStr("0.9"),
+		/* y */ sdk.NewDec(1_000_000),
+		/* x */ sdk.NewDec(1_000_000),
+		/* fluctuationLimit */ sdk.OneDec(),
+		/* maxOracleSpreadRatio */ sdk.OneDec(),
+		/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
+		/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+		maxOpenInterest,
+		maxPositionSize,
+	)
+	nibiruApp.PricefeedKeeper.ActivePairsStore().Set(ctx, pair, true)
+	setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{Pair: pair})
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	return nibiruApp, ctx
+}
+
+// requireOpenInterestMatchesPositions asserts that the tracked open interest of the pair is
+// the sum of the sizes of its positions.
+func requireOpenInterestMatchesPositions(t *testing.T, perpKeeper keeper.Keeper, ctx sdk.Context, pair common.AssetPair) {
+	t.Helper()
+	long, short := sdk.ZeroDec(), sdk.ZeroDec()
+	positions := perpKeeper.Positions.Iterate(ctx, collections.PairRange[common.AssetPair, sdk.AccAddress]{}.Prefix(pair)).Values()
+	for _, position := range positions {
+		if position.Size_.IsPositive() {
+			long = long.Add(position.Size_)
+		} else {
+			short = short.Sub(position.Size_)
+		}
+	}
+
+	openInterest := perpKeeper.GetOpenInterest(ctx, pair)
+	require.Equal(t, long, openInterest.Long)
+	require.Equal(t, short, openInterest.Short)
+}
+
+func TestOpenInterestTracking(t *testing.T) {
+	pair := common.MustNewAssetPair("xxx:yyy")
+	nibiruApp, ctx := setupOpenInterestPool(t, pair, sdk.ZeroDec(), sdk.ZeroDec())
+	perpKeeper := nibiruApp.PerpKeeper
+
+	alice, bob := testutil.AccAddress(), testutil.AccAddress()
+	for _, trader := range []sdk.AccAddress{alice, bob} {
+		require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin("yyy", 1_000))))
+	}
+
+	t.Log("open a long and a short position")
+	_, err := perpKeeper.OpenPosition(ctx, pair, types.Side_BUY, alice, sdk.NewInt(60), sdk.NewDec(5), sdk.ZeroDec())
+	require.NoError(t, err)
+	_, err = perpKeeper.OpenPosition(ctx, pair, types.Side_SELL, bob, sdk.NewInt(40), sdk.NewDec(5), sdk.ZeroDec())
+	require.NoError(t, err)
+	requireOpenInterestMatchesPositions(t, perpKeeper, ctx, pair)
+	assert.True(t, perpKeeper.GetOpenInterest(ctx, pair).Long.IsPositive())
+	assert.True(t, perpKeeper.GetOpenInterest(ctx, pair).Short.IsPositive())
+
+	t.Log("decrease the long position")
+	_, err = perpKeeper.OpenPosition(ctx, pair, types.Side_SELL, alice, sdk.NewInt(20), sdk.NewDec(5), sdk.ZeroDec())
+	require.NoError(t, err)
+	requireOpenInterestMatchesPositions(t, perpKeeper, ctx, pair)
+
+	t.Log("reverse the short position into a long one")
+	_, err = perpKeeper.OpenPosition(ctx, pair, types.Side_BUY, bob, sdk.NewInt(80), sdk.NewDec(5), sdk.ZeroDec())
+	require.NoError(t, err)
+	requireOpenInterestMatchesPositions(t, perpKeeper, ctx, pair)
+	assert.True(t, perpKeeper.GetOpenInterest(ctx, pair).Short.IsZero())
+
+	t.Log("close the positions")
+	_, err = perpKeeper.ClosePosition(ctx, pair, alice)
+	require.NoError(t, err)
+	_, err = perpKeeper.ClosePosition(ctx, pair, bob)
+	require.NoError(t, err)
+	assert.Equal(t,
+		types.OpenInterest{Pair: pair, Long: sdk.ZeroDec(), Short: sdk.ZeroDec()},
+		perpKeeper.GetOpenInterest(ctx, pair),
+	)
+}
+
+func TestOpenInterestLimits(t *testing.T) {
+	pair := common.MustNewAssetPair("xxx:yyy")
+	nibiruApp, ctx := setupOpenInterestPool(t, pair,
+		/* maxOpenInterest */ sdk.NewDec(500),
+		/* maxPositionSize */ sdk.NewDec(350),
+	)
+	perpKeeper := nibiruApp.PerpKeeper
+
+	alice, bob := testutil.AccAddress(), testutil.AccAddress()
+	for _, trader := range []sdk.AccAddress{alice, bob} {
+		require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin("yyy", 1_000))))
+	}
+
+	t.Log("open a long position of ~300 base")
+	_, err := perpKeeper.OpenPosition(ctx, pair, types.Side_BUY, alice, sdk.NewInt(60), sdk.NewDec(5), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	t.Log("increasing the position over the max position size fails")
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = perpKeeper.OpenPosition(cacheCtx, pair, types.Side_BUY, alice, sdk.NewInt(20), sdk.NewDec(5), sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrPositionSizeTooLarge)
+
+	t.Log("another long position that takes the long open interest over the cap fails")
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = perpKeeper.OpenPosition(cacheCtx, pair, types.Side_BUY, bob, sdk.NewInt(50), sdk.NewDec(5), sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrOpenInterestTooHigh)
+
+	t.Log("the short side has its own cap")
+	_, err = perpKeeper.OpenPosition(ctx, pair, types.Side_SELL, bob, sdk.NewInt(50), sdk.NewDec(5), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	t.Log("reversing into a position over the max position size fails")
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = perpKeeper.OpenPosition(cacheCtx, pair, types.Side_SELL, alice, sdk.NewInt(140), sdk.NewDec(5), sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrPositionSizeTooLarge)
+
+	t.Log("positions can always be reduced when the caps are lowered")
+	pool, err := nibiruApp.VpoolKeeper.Pools.Get(ctx, pair)
+	require.NoError(t, err)
+	pool.MaxOpenInterest = sdk.NewDec(100)
+	pool.MaxPositionSize = sdk.NewDec(100)
+	nibiruApp.VpoolKeeper.Pools.Insert(ctx, pair, pool)
+
+	_, err = perpKeeper.OpenPosition(ctx, pair, types.Side_SELL, alice, sdk.NewInt(10), sdk.NewDec(5), sdk.ZeroDec())
+	require.NoError(t, err)
+	requireOpenInterestMatchesPositions(t, perpKeeper, ctx, pair)
+
+	t.Log("query the open interest and its caps")
+	resp, err := keeper.NewQuerier(perpKeeper).QueryOpenInterest(
+		sdk.WrapSDKContext(ctx), &types.QueryOpenInterestRequest{TokenPair: pair.String()})
+	require.NoError(t, err)
+	assert.Equal(t, perpKeeper.GetOpenInterest(ctx, pair), resp.OpenInterest)
+	assert.Equal(t, sdk.NewDec(100), resp.MaxOpenInterest)
+	assert.Equal(t, sdk.NewDec(100), resp.MaxPositionSize)
+}
@@ -0,0 +1,425 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	vpooltypes "github.com/NibiruChain/nibiru/x/vpool/types"
+)
+
+/*
+PlaceOrder stores a resting order that is executed by the EndBlocker once the
+mark price of the pair crosses the trigger price.
+
+Every order escrows the order deposit of the params in the x/perp module
+account until it is filled, cancelled or rejected. LIMIT orders escrow their
+margin next to it. STOP_LOSS and TAKE_PROFIT orders require an open position
+and take their side from it.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair the order executes against
+  - traderAddr: the owner of the order
+  - orderType: LIMIT, STOP_LOSS or TAKE_PROFIT
+  - side: the side of the position to open, only used by LIMIT orders
+  - triggerPrice: the mark price at which the order is executed
+  - quoteAssetAmount: the margin of a LIMIT order
+  - leverage: the leverage of a LIMIT order
+  - baseAmtLimit: the slippage limit of a LIMIT order, in base asset units
+
+ret:
+  - order: the stored order
+  - err: error
+*/
+func (k Keeper) PlaceOrder(
+	ctx sdk.Context,
+	pair common.AssetPair,
+	traderAddr sdk.AccAddress,
+	orderType types.OrderType,
+	side types.Side,
+	triggerPrice sdk.Dec,
+	quoteAssetAmount sdk.Int,
+	leverage sdk.Dec,
+	baseAmtLimit sdk.Dec,
+) (order types.Order, err error) {
+	if err = k.requireVpool(ctx, pair); err != nil {
+		return types.Order{}, err
+	}
+	if k.isPairShutdown(ctx, pair) {
+		return types.Order{}, vpooltypes.ErrPoolShutdown.Wrapf("%s", pair)
+	}
+
+	numOrders := len(k.Orders.Indexes.TraderOrders.ExactMatch(ctx, collections.Join(pair, traderAddr)).PrimaryKeys())
+	if numOrders >= types.MaxOrdersPerTrader {
+		return types.Order{}, sdkerrors.Wrapf(types.ErrTooManyOrders, "trader %s has %d orders on %s", traderAddr, numOrders, pair)
+	}
+
+	order = types.Order{
+		TraderAddress:        traderAddr.String(),
+		Pair:                 pair,
+		OrderType:            orderType,
+		Side:                 side,
+		TriggerPrice:         triggerPrice,
+		QuoteAssetAmount:     sdk.ZeroInt(),
+		Leverage:             sdk.ZeroDec(),
+		BaseAssetAmountLimit: sdk.ZeroDec(),
+		BlockNumber:          ctx.BlockHeight(),
+		Deposit:              k.GetParams(ctx).OrderDeposit,
+	}
+
+	switch orderType {
+	case types.OrderType_LIMIT:
+		if err = k.checkOpenPositionRequirements(ctx, pair, quoteAssetAmount, leverage); err != nil {
+			return types.Order{}, err
+		}
+
+		order.QuoteAssetAmount = quoteAssetAmount
+		order.Leverage = leverage
+		order.BaseAssetAmountLimit = baseAmtLimit
+	case types.OrderType_STOP_LOSS, types.OrderType_TAKE_PROFIT:
+		position, err := k.Positions.Get(ctx, collections.Join(pair, traderAddr))
+		if err != nil {
+			return types.Order{}, err
+		}
+		if position.Size_.IsZero() {
+			return types.Order{}, types.ErrPositionZero
+		}
+
+		order.Side = positionSide(position)
+	default:
+		return types.Order{}, fmt.Errorf("invalid order type: %s", orderType)
+	}
+
+	escrow := sdk.NewCoin(pair.QuoteDenom(), order.QuoteAssetAmount.Add(order.Deposit))
+	if escrow.IsPositive() {
+		if err = k.BankKeeper.SendCoinsFromAccountToModule(
+			ctx,
+			traderAddr,
+			types.ModuleName,
+			sdk.NewCoins(escrow),
+		); err != nil {
+			return types.Order{}, err
+		}
+	}
+
+	order.Id = k.OrderID.Next(ctx)
+	k.Orders.Insert(ctx, order.Id, order)
+
+	return order, nil
+}
+
+// CancelOrder removes a resting order and returns its escrowed margin and deposit to the trader.
+func (k Keeper) CancelOrder(ctx sdk.Context, traderAddr sdk.AccAddress, orderID uint64) (refund sdk.Coin, err error) {
+	order, err := k.Orders.Get(ctx, orderID)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if order.TraderAddress != traderAddr.String() {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrUnauthorized, "order %d is not owned by %s", orderID, traderAddr)
+	}
+
+	if err = k.Orders.Delete(ctx, orderID); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return k.refundOrder(ctx, order)
+}
+
+// GetOrders returns the resting orders of a trader on a pair, sorted by id.
+func (k Keeper) GetOrders(ctx sdk.Context, pair common.AssetPair, traderAddr sdk.AccAddress) ([]types.Order, error) {
+	orderIDs := k.Orders.Indexes.TraderOrders.ExactMatch(ctx, collections.Join(pair, traderAddr)).PrimaryKeys()
+
+	orders := make([]types.Order, len(orderIDs))
+	for i, id := range orderIDs {
+		order, err := k.Orders.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		orders[i] = order
+	}
+
+	return orders, nil
+}
+
+/*
+ExecuteTriggeredOrders executes the resting orders whose trigger price has been
+crossed by the current mark price of their pair, at most
+types.MaxOrdersExecutedPerBlock of them per block. The orders of a pair are
+found through their trigger price index and executed in order of placement.
+
+Each order is executed in its own cached context. Filled orders emit an
+OrderFilledEvent and get their deposit back. Orders that fail to execute are
+removed, their escrowed margin is returned to the trader, their deposit is paid
+to the PerpEF unless the state of the exchange rejected them, see
+releaseRejectedOrder, and an OrderRejectedEvent is emitted. The orders of pairs
+which have been shut down are rejected with their deposit returned. An order
+whose escrow can't be released is logged and left in place.
+*/
+func (k Keeper) ExecuteTriggeredOrders(ctx sdk.Context) {
+	remaining := types.MaxOrdersExecutedPerBlock
+	for _, pool := range k.VpoolKeeper.GetAllPools(ctx) {
+		if remaining <= 0 {
+			return
+		}
+
+		if k.isPairShutdown(ctx, pool.Pair) {
+			remaining -= k.rejectShutdownPairOrders(ctx, pool.Pair, remaining)
+			continue
+		}
+
+		markPrice, err := k.VpoolKeeper.GetMarkPrice(ctx, pool.Pair)
+		if err != nil {
+			k.Logger(ctx).Error("failed to fetch mark price for orders", "pair", pool.Pair.String(), "error", err)
+			continue
+		}
+
+		for _, orderID := range k.triggeredOrderIDs(ctx, pool.Pair, markPrice, remaining) {
+			order, err := k.Orders.Get(ctx, orderID)
+			if err != nil {
+				k.Logger(ctx).Error("failed to get triggered order", "order", orderID, "error", err)
+				continue
+			}
+			remaining--
+
+			// the orders executed before may have moved the mark price back
+			if markPrice, err = k.VpoolKeeper.GetMarkPrice(ctx, order.Pair); err != nil || !order.IsTriggered(markPrice) {
+				continue
+			}
+
+			cachedCtx, commit := ctx.CacheContext()
+			execErr := k.executeOrder(cachedCtx, order)
+			if execErr == nil {
+				ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+				commit()
+			}
+
+			// the order stays in place if it can't be removed or its escrow can't be released
+			cachedCtx, commit = ctx.CacheContext()
+			if err = k.Orders.Delete(cachedCtx, order.Id); err == nil && execErr != nil {
+				err = k.releaseRejectedOrder(cachedCtx, order, execErr)
+			}
+			if err != nil {
+				k.Logger(ctx).Error("failed to remove executed order", "order", order.Id, "error", err)
+				continue
+			}
+			commit()
+
+			if execErr == nil {
+				_ = ctx.EventManager().EmitTypedEvent(&types.OrderFilledEvent{
+					OrderId:       order.Id,
+					Pair:          order.Pair.String(),
+					TraderAddress: order.TraderAddress,
+					OrderType:     order.OrderType,
+					Side:          order.Side,
+					TriggerPrice:  order.TriggerPrice,
+					MarkPrice:     markPrice,
+					BlockHeight:   ctx.BlockHeight(),
+				})
+				continue
+			}
+			k.emitOrderRejected(ctx, order, markPrice, execErr)
+		}
+	}
+}
+
+// releaseRejectedOrder releases the escrow of an order which failed to execute. The
+// deposit is returned along with the margin if the order was rejected because of the
+// state of the exchange, a trading halt, the fluctuation limit or the open interest
+// cap, and paid to the PerpEF otherwise.
+func (k Keeper) releaseRejectedOrder(ctx sdk.Context, order types.Order, reason error) error {
+	for _, protocolErr := range []error{
+		types.ErrTradingHalted,
+		vpooltypes.ErrOverFluctuationLimit,
+		types.ErrOpenInterestTooHigh,
+		vpooltypes.ErrPoolShutdown,
+	} {
+		if errors.Is(reason, protocolErr) {
+			_, err := k.refundOrder(ctx, order)
+			return err
+		}
+	}
+	return k.forfeitOrderDeposit(ctx, order)
+}
+
+/*
+triggeredOrderIDs returns the ids of at most limit orders of the pair which are
+triggered at the mark price, sorted by id.
+
+The orders which trigger when the mark price falls are indexed by their trigger
+price, and are triggered from the mark price upward. The ones which trigger when
+it rises are indexed by their negated trigger price, and are triggered from the
+negated mark price up to zero.
+*/
+func (k Keeper) triggeredOrderIDs(ctx sdk.Context, pair common.AssetPair, markPrice sdk.Dec, limit int) []uint64 {
+	rangeOnFall := collections.PairRange[common.AssetPair, collections.Pair[sdk.Dec, uint64]]{}.
+		Prefix(pair).
+		StartInclusive(collections.Join(markPrice, uint64(0)))
+	rangeOnRise := collections.PairRange[common.AssetPair, collections.Pair[sdk.Dec, uint64]]{}.
+		Prefix(pair).
+		StartInclusive(collections.Join(markPrice.Neg(), uint64(0))).
+		EndExclusive(collections.Join(sdk.ZeroDec(), uint64(0)))
+
+	var ids []uint64
+	for _, rng := range []collections.PairRange[common.AssetPair, collections.Pair[sdk.Dec, uint64]]{rangeOnFall, rangeOnRise} {
+		iter := k.Orders.Indexes.TriggerPrices.Iterate(ctx, rng)
+		for n := 0; iter.Valid() && n < limit; iter.Next() {
+			ids = append(ids, iter.Key().K2().K2())
+			n++
+		}
+		iter.Close()
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+	return ids
+}
+
+// rejectShutdownPairOrders rejects at most limit orders of a pair which has been
+// shut down, returning their escrow in full, and returns the number of orders rejected.
+func (k Keeper) rejectShutdownPairOrders(ctx sdk.Context, pair common.AssetPair, limit int) int {
+	iter := k.Orders.Indexes.TriggerPrices.Iterate(
+		ctx, collections.PairRange[common.AssetPair, collections.Pair[sdk.Dec, uint64]]{}.Prefix(pair))
+	var ids []uint64
+	for ; iter.Valid() && len(ids) < limit; iter.Next() {
+		ids = append(ids, iter.Key().K2().K2())
+	}
+	iter.Close()
+
+	for _, id := range ids {
+		order, err := k.Orders.Get(ctx, id)
+		if err != nil {
+			k.Logger(ctx).Error("failed to get order of shut down pair", "order", id, "error", err)
+			continue
+		}
+
+		// the order stays in place if it can't be removed or refunded
+		cachedCtx, commit := ctx.CacheContext()
+		if err = k.Orders.Delete(cachedCtx, id); err == nil {
+			_, err = k.refundOrder(cachedCtx, order)
+		}
+		if err != nil {
+			k.Logger(ctx).Error("failed to refund order of shut down pair", "order", id, "error", err)
+			continue
+		}
+		commit()
+		k.emitOrderRejected(ctx, order, sdk.ZeroDec(), vpooltypes.ErrPoolShutdown.Wrapf("%s", pair))
+	}
+	return len(ids)
+}
+
+func (k Keeper) emitOrderRejected(ctx sdk.Context, order types.Order, markPrice sdk.Dec, reason error) {
+	_ = ctx.EventManager().EmitTypedEvent(&types.OrderRejectedEvent{
+		OrderId:       order.Id,
+		Pair:          order.Pair.String(),
+		TraderAddress: order.TraderAddress,
+		OrderType:     order.OrderType,
+		Side:          order.Side,
+		TriggerPrice:  order.TriggerPrice,
+		MarkPrice:     markPrice,
+		Reason:        reason.Error(),
+		BlockHeight:   ctx.BlockHeight(),
+	})
+}
+
+// executeOrder opens or closes the position described by the order.
+// The escrow of the order is released to the trader right before opening or
+// closing the position, so the caller must discard ctx if an error is returned.
+func (k Keeper) executeOrder(ctx sdk.Context, order types.Order) error {
+	traderAddr := sdk.MustAccAddressFromBech32(order.TraderAddress)
+
+	if _, err := k.refundOrder(ctx, order); err != nil {
+		return err
+	}
+
+	switch order.OrderType {
+	case types.OrderType_LIMIT:
+		_, err := k.OpenPosition(
+			ctx,
+			order.Pair,
+			order.Side,
+			traderAddr,
+			order.QuoteAssetAmount,
+			order.Leverage,
+			order.BaseAssetAmountLimit,
+		)
+		return err
+	case types.OrderType_STOP_LOSS, types.OrderType_TAKE_PROFIT:
+		position, err := k.Positions.Get(ctx, collections.Join(order.Pair, traderAddr))
+		if err != nil {
+			return err
+		}
+		if position.Size_.IsZero() {
+			return types.ErrPositionZero
+		}
+		if positionSide(position) != order.Side {
+			return types.ErrOrderSideMismatch
+		}
+
+		_, err = k.ClosePosition(ctx, order.Pair, traderAddr)
+		return err
+	default:
+		return fmt.Errorf("invalid order type: %s", order.OrderType)
+	}
+}
+
+// refundOrder returns the margin and the deposit escrowed by the order to its trader.
+func (k Keeper) refundOrder(ctx sdk.Context, order types.Order) (sdk.Coin, error) {
+	refund := sdk.NewCoin(order.Pair.QuoteDenom(), order.QuoteAssetAmount.Add(order.Deposit))
+	if !refund.IsPositive() {
+		return refund, nil
+	}
+
+	if err := k.BankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		types.ModuleName,
+		sdk.MustAccAddressFromBech32(order.TraderAddress),
+		sdk.NewCoins(refund),
+	); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return refund, nil
+}
+
+// forfeitOrderDeposit returns the margin escrowed by a rejected order to its trader
+// and pays its deposit to the PerpEF.
+func (k Keeper) forfeitOrderDeposit(ctx sdk.Context, order types.Order) error {
+	if order.QuoteAssetAmount.IsPositive() {
+		if err := k.BankKeeper.SendCoinsFromModuleToAccount(
+			ctx,
+			types.ModuleName,
+			sdk.MustAccAddressFromBech32(order.TraderAddress),
+			sdk.NewCoins(sdk.NewCoin(order.Pair.QuoteDenom(), order.QuoteAssetAmount)),
+		); err != nil {
+			return err
+		}
+	}
+
+	if order.Deposit.IsPositive() {
+		return k.BankKeeper.SendCoinsFromModuleToModule(
+			ctx,
+			types.ModuleName,
+			types.PerpEFModuleAccount,
+			sdk.NewCoins(sdk.NewCoin(order.Pair.QuoteDenom(), order.Deposit)),
+		)
+	}
+	return nil
+}
+
+// positionSide returns the side of a non-zero position.
+func positionSide(position types.Position) types.Side {
+	if position.Size_.IsPositive() {
+		return types.Side_BUY
+	}
+	return types.Side_SELL
+}
@@ -0,0 +1,330 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/collections"
+	nibisimapp "github.com/NibiruChain/nibiru/simapp"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+)
+
+func setupOrdersTest(t *testing.T) (*nibisimapp.NibiruTestApp, sdk.Context) {
+	nibiruApp, ctx := nibisimapp.NewTestNibiruAppAndContext(true)
+	ctx = ctx.WithBlockTime(time.Now())
+
+	oracle := testutil.AccAddress()
+	nibiruApp.PricefeedKeeper.WhitelistOracles(ctx, []sdk.AccAddress{oracle})
+	require.NoError(t, nibiruApp.PricefeedKeeper.PostRawPrice(ctx, oracle, common.Pair_BTC_NUSD.String(), sdk.OneDec(), time.Now().Add(time.Hour)))
+	require.NoError(t, nibiruApp.PricefeedKeeper.GatherRawPrices(ctx, common.DenomBTC, common.DenomNUSD))
+
+	nibiruApp.VpoolKeeper.CreatePool(
+		ctx,
+		common.Pair_BTC_NUSD,
+		/* tradeLimitRatio */ sdk.OneDec(),
+		/* quoteReserve */ sdk.NewDec(1_000_000_000_000),
+		/* baseReserve */ sdk.NewDec(1_000_000_000_000),
+		/* fluctuationLimit */ sdk.OneDec(),
+		/* maxOracleSpreadRatio */ sdk.OneDec(),
+		/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
+		/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+		/* maxOpenInterest */ sdk.ZeroDec(),
+		/* maxPositionSize */ sdk.ZeroDec(),
+	)
+	setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
+		Pair: common.Pair_BTC_NUSD,
+	})
+
+	params := nibiruApp.PerpKeeper.GetParams(ctx)
+	params.OrderDeposit = sdk.NewInt(10)
+	nibiruApp.PerpKeeper.SetParams(ctx, params)
+
+	return nibiruApp, ctx
+}
+
+func placeLimitOrder(
+	t *testing.T, nibiruApp *nibisimapp.NibiruTestApp, ctx sdk.Context,
+	trader sdk.AccAddress, side types.Side, triggerPrice sdk.Dec,
+) types.Order {
+	order, err := nibiruApp.PerpKeeper.PlaceOrder(
+		ctx,
+		common.Pair_BTC_NUSD,
+		trader,
+		types.OrderType_LIMIT,
+		side,
+		triggerPrice,
+		/* quoteAssetAmount */ sdk.NewInt(1000),
+		/* leverage */ sdk.NewDec(10),
+		/* baseAmtLimit */ sdk.ZeroDec(),
+	)
+	require.NoError(t, err)
+	return order
+}
+
+func TestPlaceOrder(t *testing.T) {
+	t.Run("limit order escrows its margin and deposit", func(t *testing.T) {
+		nibiruApp, ctx := setupOrdersTest(t)
+		trader := testutil.AccAddress()
+		require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1010))))
+
+		order := placeLimitOrder(t, nibiruApp, ctx, trader, types.Side_BUY, sdk.MustNewDecFromStr("0.9"))
+
+		assert.EqualValues(t, 1, order.Id)
+		assert.EqualValues(t, sdk.NewInt(10), order.Deposit)
+		assert.True(t, nibiruApp.BankKeeper.GetBalance(ctx, trader, common.DenomNUSD).IsZero())
+		assert.EqualValues(t, sdk.NewInt(1010), nibiruApp.BankKeeper.GetBalance(
+			ctx, authtypes.NewModuleAddress(types.ModuleName), common.DenomNUSD).Amount)
+
+		orders, err := nibiruApp.PerpKeeper.GetOrders(ctx, common.Pair_BTC_NUSD, trader)
+		require.NoError(t, err)
+		assert.EqualValues(t, []types.Order{order}, orders)
+	})
+
+	t.Run("limit order fails without funds for the deposit", func(t *testing.T) {
+		nibiruApp, ctx := setupOrdersTest(t)
+		trader := testutil.AccAddress()
+		require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1000))))
+
+		_, err := nibiruApp.PerpKeeper.PlaceOrder(
+			ctx, common.Pair_BTC_NUSD, trader, types.OrderType_LIMIT, types.Side_BUY,
+			sdk.OneDec(), sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec())
+		require.Error(t, err)
+	})
+
+	t.Run("limit order fails with leverage above the vpool max", func(t *testing.T) {
+		nibiruApp, ctx := setupOrdersTest(t)
+		trader := testutil.AccAddress()
+		require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1000))))
+
+		_, err := nibiruApp.PerpKeeper.PlaceOrder(
+			ctx, common.Pair_BTC_NUSD, trader, types.OrderType_LIMIT, types.Side_BUY,
+			sdk.OneDec(), sdk.NewInt(1000), sdk.NewDec(20), sdk.ZeroDec())
+		require.ErrorIs(t, err, types.ErrLeverageIsTooHigh)
+	})
+
+	t.Run("stop loss requires an open position", func(t *testing.T) {
+		nibiruApp, ctx := setupOrdersTest(t)
+		trader := testutil.AccAddress()
+
+		_, err := nibiruApp.PerpKeeper.PlaceOrder(
+			ctx, common.Pair_BTC_NUSD, trader, types.OrderType_STOP_LOSS, types.Side_SIDE_UNSPECIFIED,
+			sdk.OneDec(), sdk.ZeroInt(), sdk.ZeroDec(), sdk.ZeroDec())
+		require.ErrorIs(t, err, collections.ErrNotFound)
+	})
+
+	t.Run("stop loss takes the side of the position", func(t *testing.T) {
+		nibiruApp, ctx := setupOrdersTest(t)
+		trader := testutil.AccAddress()
+		require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1030))))
+		_, err := nibiruApp.PerpKeeper.OpenPosition(
+			ctx, common.Pair_BTC_NUSD, types.Side_SELL, trader, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec())
+		require.NoError(t, err)
+
+		order, err := nibiruApp.PerpKeeper.PlaceOrder(
+			ctx, common.Pair_BTC_NUSD, trader, types.OrderType_STOP_LOSS, types.Side_BUY,
+			sdk.MustNewDecFromStr("1.1"), sdk.ZeroInt(), sdk.ZeroDec(), sdk.ZeroDec())
+		require.NoError(t, err)
+		assert.EqualValues(t, types.Side_SELL, order.Side)
+		assert.True(t, order.QuoteAssetAmount.IsZero())
+	})
+
+	t.Run("too many orders", func(t *testing.T) {
+		nibiruApp, ctx := setupOrdersTest(t)
+		trader := testutil.AccAddress()
+		require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, trader,
+			sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1010*(types.MaxOrdersPerTrader+1)))))
+
+		for i := 0; i < types.MaxOrdersPerTrader; i++ {
+			placeLimitOrder(t, nibiruApp, ctx, trader, types.Side_BUY, sdk.MustNewDecFromStr("0.9"))
+		}
+
+		_, err := nibiruApp.PerpKeeper.PlaceOrder(
+			ctx, common.Pair_BTC_NUSD, trader, types.OrderType_LIMIT, types.Side_BUY,
+			sdk.MustNewDecFromStr("0.9"), sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec())
+		require.ErrorIs(t, err, types.ErrTooManyOrders)
+	})
+}
+
+func TestCancelOrder(t *testing.T) {
+	t.Run("refunds the escrowed margin and deposit", func(t *testing.T) {
+		nibiruApp, ctx := setupOrdersTest(t)
+		trader := testutil.AccAddress()
+		require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1010))))
+		order := placeLimitOrder(t, nibiruApp, ctx, trader, types.Side_BUY, sdk.MustNewDecFromStr("0.9"))
+
+		refund, err := nibiruApp.PerpKeeper.CancelOrder(ctx, trader, order.Id)
+		require.NoError(t, err)
+
+		assert.EqualValues(t, sdk.NewInt64Coin(common.DenomNUSD, 1010), refund)
+		assert.EqualValues(t, sdk.NewInt(1010), nibiruApp.BankKeeper.GetBalance(ctx, trader, common.DenomNUSD).Amount)
+		_, err = nibiruApp.PerpKeeper.Orders.Get(ctx, order.Id)
+		require.ErrorIs(t, err, collections.ErrNotFound)
+	})
+
+	t.Run("only the owner can cancel", func(t *testing.T) {
+		nibiruApp, ctx := setupOrdersTest(t)
+		trader := testutil.AccAddress()
+		require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1010))))
+		order := placeLimitOrder(t, nibiruApp, ctx, trader, types.Side_BUY, sdk.MustNewDecFromStr("0.9"))
+
+		_, err := nibiruApp.PerpKeeper.CancelOrder(ctx, testutil.AccAddress(), order.Id)
+		require.ErrorIs(t, err, types.ErrUnauthorized)
+	})
+
+	t.Run("order not found", func(t *testing.T) {
+		nibiruApp, ctx := setupOrdersTest(t)
+
+		_, err := nibiruApp.PerpKeeper.CancelOrder(ctx, testutil.AccAddress(), 1)
+		require.ErrorIs(t, err, collections.ErrNotFound)
+	})
+}
+
+func TestExecuteTriggeredOrders(t *testing.T) {
+	t.Run("fills triggered limit orders only", func(t *testing.T) {
+		nibiruApp, ctx := setupOrdersTest(t)
+		trader := testutil.AccAddress()
+		require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 2040))))
+
+		triggered := placeLimitOrder(t, nibiruApp, ctx, trader, types.Side_BUY, sdk.MustNewDecFromStr("1.1"))
+		resting := placeLimitOrder(t, nibiruApp, ctx, trader, types.Side_BUY, sdk.MustNewDecFromStr("0.9"))
+
+		nibiruApp.PerpKeeper.ExecuteTriggeredOrders(ctx)
+
+		position, err := nibiruApp.PerpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, trader))
+		require.NoError(t, err)
+		assert.EqualValues(t, sdk.NewDec(1000), position.Margin)
+		assert.True(t, position.Size_.IsPositive())
+
+		_, err = nibiruApp.PerpKeeper.Orders.Get(ctx, triggered.Id)
+		require.ErrorIs(t, err, collections.ErrNotFound)
+		_, err = nibiruApp.PerpKeeper.Orders.Get(ctx, resting.Id)
+		require.NoError(t, err)
+
+		testutil.RequireHasTypedEvent(t, ctx, &types.OrderFilledEvent{
+			OrderId:       triggered.Id,
+			Pair:          common.Pair_BTC_NUSD.String(),
+			TraderAddress: trader.String(),
+			OrderType:     types.OrderType_LIMIT,
+			Side:          types.Side_BUY,
+			TriggerPrice:  sdk.MustNewDecFromStr("1.1"),
+			MarkPrice:     sdk.OneDec(),
+			BlockHeight:   ctx.BlockHeight(),
+		})
+	})
+
+	t.Run("rejects a limit order that cannot pay fees and forfeits its deposit", func(t *testing.T) {
+		nibiruApp, ctx := setupOrdersTest(t)
+		trader := testutil.AccAddress()
+		require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1010))))
+		order := placeLimitOrder(t, nibiruApp, ctx, trader, types.Side_SELL, sdk.MustNewDecFromStr("0.9"))
+		perpEFBefore := nibiruApp.BankKeeper.GetBalance(
+			ctx, authtypes.NewModuleAddress(types.PerpEFModuleAccount), common.DenomNUSD).Amount
+
+		nibiruApp.PerpKeeper.ExecuteTriggeredOrders(ctx)
+
+		_, err := nibiruApp.PerpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, trader))
+		require.ErrorIs(t, err, collections.ErrNotFound)
+		_, err = nibiruApp.PerpKeeper.Orders.Get(ctx, order.Id)
+		require.ErrorIs(t, err, collections.ErrNotFound)
+		assert.EqualValues(t, sdk.NewInt(1000), nibiruApp.BankKeeper.GetBalance(ctx, trader, common.DenomNUSD).Amount)
+		assert.EqualValues(t, perpEFBefore.AddRaw(10), nibiruApp.BankKeeper.GetBalance(
+			ctx, authtypes.NewModuleAddress(types.PerpEFModuleAccount), common.DenomNUSD).Amount)
+		testutil.RequireNotHasTypedEvent(t, ctx, &types.OrderFilledEvent{})
+		testutil.RequireNotHasTypedEvent(t, ctx, &types.PositionChangedEvent{})
+
+		var rejected bool
+		for _, ev := range ctx.EventManager().Events() {
+			rejected = rejected || ev.Type == "nibiru.perp.v1.OrderRejectedEvent"
+		}
+		assert.True(t, rejected)
+	})
+
+	t.Run("rejects a limit order on a halted pair and refunds its deposit", func(t *testing.T) {
+		nibiruApp, ctx := setupOrdersTest(t)
+		trader := testutil.AccAddress()
+		require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1010))))
+		order := placeLimitOrder(t, nibiruApp, ctx, trader, types.Side_BUY, sdk.MustNewDecFromStr("1.1"))
+		perpEFBefore := nibiruApp.BankKeeper.GetBalance(
+			ctx, authtypes.NewModuleAddress(types.PerpEFModuleAccount), common.DenomNUSD).Amount
+
+		params := nibiruApp.PerpKeeper.GetParams(ctx)
+		params.HaltedPairs = []string{common.Pair_BTC_NUSD.String()}
+		nibiruApp.PerpKeeper.SetParams(ctx, params)
+		nibiruApp.PerpKeeper.ExecuteTriggeredOrders(ctx)
+
+		_, err := nibiruApp.PerpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, trader))
+		require.ErrorIs(t, err, collections.ErrNotFound)
+		_, err = nibiruApp.PerpKeeper.Orders.Get(ctx, order.Id)
+		require.ErrorIs(t, err, collections.ErrNotFound)
+		assert.EqualValues(t, sdk.NewInt(1010), nibiruApp.BankKeeper.GetBalance(ctx, trader, common.DenomNUSD).Amount)
+		assert.EqualValues(t, perpEFBefore, nibiruApp.BankKeeper.GetBalance(
+			ctx, authtypes.NewModuleAddress(types.PerpEFModuleAccount), common.DenomNUSD).Amount)
+
+		var rejected bool
+		for _, ev := range ctx.EventManager().Events() {
+			rejected = rejected || ev.Type == "nibiru.perp.v1.OrderRejectedEvent"
+		}
+		assert.True(t, rejected)
+	})
+
+	t.Run("take profit closes the position", func(t *testing.T) {
+		nibiruApp, ctx := setupOrdersTest(t)
+		trader := testutil.AccAddress()
+		require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1120))))
+		_, err := nibiruApp.PerpKeeper.OpenPosition(
+			ctx, common.Pair_BTC_NUSD, types.Side_BUY, trader, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec())
+		require.NoError(t, err)
+
+		// mark price is slightly above 1 after the long, so a trigger at 0.9 rests
+		// and a trigger at the current mark price fires.
+		markPrice, err := nibiruApp.VpoolKeeper.GetMarkPrice(ctx, common.Pair_BTC_NUSD)
+		require.NoError(t, err)
+		resting, err := nibiruApp.PerpKeeper.PlaceOrder(
+			ctx, common.Pair_BTC_NUSD, trader, types.OrderType_STOP_LOSS, types.Side_SIDE_UNSPECIFIED,
+			sdk.MustNewDecFromStr("0.9"), sdk.ZeroInt(), sdk.ZeroDec(), sdk.ZeroDec())
+		require.NoError(t, err)
+		triggered, err := nibiruApp.PerpKeeper.PlaceOrder(
+			ctx, common.Pair_BTC_NUSD, trader, types.OrderType_TAKE_PROFIT, types.Side_SIDE_UNSPECIFIED,
+			markPrice, sdk.ZeroInt(), sdk.ZeroDec(), sdk.ZeroDec())
+		require.NoError(t, err)
+
+		nibiruApp.PerpKeeper.ExecuteTriggeredOrders(ctx)
+
+		_, err = nibiruApp.PerpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, trader))
+		require.ErrorIs(t, err, collections.ErrNotFound)
+		_, err = nibiruApp.PerpKeeper.Orders.Get(ctx, triggered.Id)
+		require.ErrorIs(t, err, collections.ErrNotFound)
+
+		// the stop loss is left resting since the mark price never reached it
+		_, err = nibiruApp.PerpKeeper.Orders.Get(ctx, resting.Id)
+		require.NoError(t, err)
+	})
+	t.Run("executes at most the per block cap", func(t *testing.T) {
+		nibiruApp, ctx := setupOrdersTest(t)
+		numOrders := types.MaxOrdersExecutedPerBlock + 1
+		traders := make([]sdk.AccAddress, numOrders)
+		for i := range traders {
+			traders[i] = testutil.AccAddress()
+			require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, traders[i], sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1030))))
+			placeLimitOrder(t, nibiruApp, ctx, traders[i], types.Side_BUY, sdk.NewDec(100))
+		}
+
+		nibiruApp.PerpKeeper.ExecuteTriggeredOrders(ctx)
+
+		// the orders are executed in order of placement, the last one waits for the next block
+		remaining := nibiruApp.PerpKeeper.Orders.Iterate(ctx, collections.Range[uint64]{}).Values()
+		require.Len(t, remaining, 1)
+		assert.Equal(t, traders[numOrders-1].String(), remaining[0].TraderAddress)
+
+		nibiruApp.PerpKeeper.ExecuteTriggeredOrders(ctx)
+		assert.Empty(t, nibiruApp.PerpKeeper.Orders.Iterate(ctx, collections.Range[uint64]{}).Values())
+	})
+}
@@ -4,11 +4,19 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
 
 	"github.com/NibiruChain/nibiru/x/perp/types"
 )
 
-// SettlePosition settles a trader position
+/*
+SettlePosition settles a trader position at the settlement price of its vpool.
+The unpaid funding payments are applied to the margin before it is paid out, and
+the bad debt of an underwater position is realized against the prepaid bad debt,
+the insurance fund and the PerpEF. The payout is withdrawn from the vault like the
+one of a closed position, so a short vault is covered by the same funds.
+Errors if the vpool has not been shut down.
+*/
 func (k Keeper) SettlePosition(
 	ctx sdk.Context,
 	currentPosition types.Position,
@@ -16,55 +24,60 @@ func (k Keeper) SettlePosition(
 	// Validate trader address
 	traderAddr, err := sdk.AccAddressFromBech32(currentPosition.TraderAddress)
 	if err != nil {
-		return sdk.NewCoins(), nil
+		return sdk.NewCoins(), err
 	}
 
 	if currentPosition.Size_.IsZero() {
 		return sdk.NewCoins(), nil
 	}
 
-	err = k.Positions.Delete(ctx, collections.Join(currentPosition.Pair, traderAddr))
+	// run calculations on settled values
+	settlementPrice, err := k.VpoolKeeper.GetSettlementPrice(ctx, currentPosition.Pair)
 	if err != nil {
-		return sdk.NewCoins(), nil
+		return sdk.NewCoins(), err
 	}
 
-	// run calculations on settled values
-	settlementPrice, err := k.VpoolKeeper.GetSettlementPrice(ctx, currentPosition.Pair)
+	err = k.Positions.Delete(ctx, collections.Join(currentPosition.Pair, traderAddr))
 	if err != nil {
-		return sdk.NewCoins(), nil
+		return sdk.NewCoins(), err
 	}
+	k.updateOpenInterest(ctx, currentPosition.Pair, currentPosition.Size_, sdk.ZeroDec())
+	k.clearLiquidatableSince(ctx, currentPosition.Pair, traderAddr)
 
-	settledValue := sdk.ZeroDec()
-	if settlementPrice.IsZero() {
-		settledValue = currentPosition.Margin
-	} else {
+	realizedPnl := sdk.ZeroDec()
+	if !settlementPrice.IsZero() {
 		// openPrice = positionOpenNotional / abs(positionSize)
 		openPrice := currentPosition.OpenNotional.Quo(currentPosition.Size_.Abs())
-		// returnedFund := positionSize * (settlementPrice - openPrice) + positionMargin
-		returnedFund := currentPosition.Size_.Mul(
-			settlementPrice.Sub(openPrice)).Add(currentPosition.Margin)
-		if returnedFund.IsPositive() {
-			settledValue = returnedFund
-		}
+		// realizedPnl := positionSize * (settlementPrice - openPrice)
+		realizedPnl = currentPosition.Size_.Mul(settlementPrice.Sub(openPrice))
 	}
 
-	transferredCoins = sdk.NewCoins(sdk.NewInt64Coin(currentPosition.Pair.QuoteDenom(), 0))
-	settledValueInt := settledValue.RoundInt()
-	if settledValueInt.IsPositive() {
-		toTransfer := sdk.NewCoin(currentPosition.Pair.QuoteDenom(), settledValueInt)
-		transferredCoins = sdk.NewCoins(toTransfer)
+	remaining, err := k.CalcRemainMarginWithFundingPayment(ctx, currentPosition, realizedPnl)
+	if err != nil {
+		return sdk.NewCoins(), err
+	}
+
+	denom := currentPosition.Pair.QuoteDenom()
+	if badDebt := remaining.BadDebt.RoundInt(); badDebt.IsPositive() {
+		uncovered, err := k.realizeBadDebt(ctx, denom, badDebt)
 		if err != nil {
-			panic(err) // NOTE(mercilex): must never happen
+			return sdk.NewCoins(), err
+		}
+		if uncovered.IsPositive() {
+			k.Logger(ctx).Error("bad debt of settled position left uncovered",
+				"pair", currentPosition.Pair.String(), "trader", traderAddr.String(), "uncovered", uncovered)
 		}
-		err = k.BankKeeper.SendCoinsFromModuleToAccount( // NOTE(mercilex): withdraw is not applied here
-			ctx,
-			types.VaultModuleAccount,
-			traderAddr,
-			transferredCoins,
-		)
+	}
+
+	transferredCoins = sdk.NewCoins(sdk.NewInt64Coin(denom, 0))
+	settledValueInt := remaining.Margin.RoundInt()
+	if settledValueInt.IsPositive() {
+		// the payout is cut short by what the funds can't cover
+		paid, err := k.withdrawPayout(ctx, currentPosition.Pair, traderAddr, settledValueInt)
 		if err != nil {
-			panic(err) // NOTE(mercilex): must never happen
+			return sdk.NewCoins(), err
 		}
+		transferredCoins = sdk.NewCoins(sdk.NewCoin(denom, paid))
 	}
 
 	err = ctx.EventManager().EmitTypedEvent(&types.PositionSettledEvent{
@@ -75,3 +88,85 @@ func (k Keeper) SettlePosition(
 
 	return transferredCoins, err
 }
+
+/*
+SettleShutdownPairs settles the open positions of the pairs whose vpool has been
+shut down. At most types.MaxSettlementsPerBlock positions are settled per call,
+the rest are settled in the following blocks.
+
+Each position is settled in its own cached context. A failing settlement is
+logged and skipped, and the settlement of its pair carries on after it, from the
+settlement cursor of the pair, so that it does not hold back the other positions.
+*/
+func (k Keeper) SettleShutdownPairs(ctx sdk.Context) {
+	remaining := types.MaxSettlementsPerBlock
+	for _, pool := range k.VpoolKeeper.GetAllPools(ctx) {
+		if remaining <= 0 {
+			return
+		}
+		if !k.isPairShutdown(ctx, pool.Pair) {
+			continue
+		}
+
+		positions := k.getPairPositions(ctx, pool.Pair, remaining)
+		if len(positions) == 0 {
+			_ = k.SettlementCursors.Delete(ctx, pool.Pair)
+			continue
+		}
+		for _, kv := range positions {
+			position := kv.Value
+			cachedCtx, commit := ctx.CacheContext()
+			if _, err := k.SettlePosition(cachedCtx, position); err != nil {
+				k.Logger(ctx).Error(
+					"failed to settle position",
+					"pair", position.Pair.String(),
+					"trader", position.TraderAddress,
+					"error", err,
+				)
+			} else {
+				ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+				commit()
+			}
+			k.SettlementCursors.Insert(ctx, pool.Pair, kv.Key.K2())
+		}
+		remaining -= len(positions)
+	}
+}
+
+// pairPosition is a position of a pair along with its key.
+type pairPosition = collections.KeyValue[collections.Pair[common.AssetPair, sdk.AccAddress], types.Position]
+
+// getPairPositions returns at most limit positions of the pair, starting after the
+// settlement cursor of the pair and wrapping around to the first position of the pair.
+func (k Keeper) getPairPositions(ctx sdk.Context, pair common.AssetPair, limit int) []pairPosition {
+	rng := collections.PairRange[common.AssetPair, sdk.AccAddress]{}.Prefix(pair)
+	cursor, err := k.SettlementCursors.Get(ctx, pair)
+	if err != nil {
+		return k.iteratePairPositions(ctx, rng, limit)
+	}
+
+	positions := k.iteratePairPositions(ctx, rng.StartExclusive(cursor), limit)
+	return append(positions, k.iteratePairPositions(ctx, rng.EndInclusive(cursor), limit-len(positions))...)
+}
+
+// iteratePairPositions returns at most limit positions in the range.
+func (k Keeper) iteratePairPositions(
+	ctx sdk.Context, rng collections.PairRange[common.AssetPair, sdk.AccAddress], limit int,
+) []pairPosition {
+	var positions []pairPosition
+	if limit <= 0 {
+		return positions
+	}
+	iter := k.Positions.Iterate(ctx, rng)
+	defer iter.Close()
+	for ; iter.Valid() && len(positions) < limit; iter.Next() {
+		positions = append(positions, iter.KeyValue())
+	}
+	return positions
+}
+
+// isPairShutdown returns true if the vpool of the pair has been shut down.
+func (k Keeper) isPairShutdown(ctx sdk.Context, pair common.AssetPair) bool {
+	_, err := k.VpoolKeeper.GetSettlementPrice(ctx, pair)
+	return err == nil
+}
@@ -8,12 +8,14 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/require"
 
 	nibisimapp "github.com/NibiruChain/nibiru/simapp"
 	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/perp/types"
 	"github.com/NibiruChain/nibiru/x/testutil"
+	vpooltypes "github.com/NibiruChain/nibiru/x/vpool/types"
 )
 
 func TestKeeperClosePosition(t *testing.T) {
@@ -36,6 +38,8 @@ func TestKeeperClosePosition(t *testing.T) {
 			/*maxOracleSpreadRatio*/ sdk.MustNewDecFromStr("0.1"),
 			/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
 			/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+			/* maxOpenInterest */ sdk.ZeroDec(),
+			/* maxPositionSize */ sdk.ZeroDec(),
 		)
 		require.True(t, vpoolKeeper.ExistsPool(ctx, pair))
 		nibiruApp.PricefeedKeeper.ActivePairsStore().Set(ctx, pair, true)
@@ -43,10 +47,9 @@ func TestKeeperClosePosition(t *testing.T) {
 		t.Log("Set vpool defined by pair on PerpKeeper")
 		setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
 			Pair: pair,
-			CumulativePremiumFractions: []sdk.Dec{
-				sdk.MustNewDecFromStr("0.2")},
 		},
 		)
+		setCumulativePremiumFractions(nibiruApp.PerpKeeper, ctx, pair, sdk.MustNewDecFromStr("0.2"))
 
 		t.Log("open position for alice - long")
 		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Minute))
@@ -68,9 +71,8 @@ func TestKeeperClosePosition(t *testing.T) {
 		// force funding payments
 		setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
 			Pair: pair,
-			CumulativePremiumFractions: []sdk.Dec{
-				sdk.MustNewDecFromStr("0.3")},
 		})
+		setCumulativePremiumFractions(nibiruApp.PerpKeeper, ctx, pair, sdk.MustNewDecFromStr("0.3"))
 		bob := testutil.AccAddress()
 		err = simapp.FundAccount(nibiruApp.BankKeeper, ctx, bob,
 			sdk.NewCoins(sdk.NewInt64Coin("yyy", 62)))
@@ -105,3 +107,217 @@ func TestKeeperClosePosition(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestSettleShutdownPairs(t *testing.T) {
+	t.Run("settles the positions of a shut down pair in batches", func(t *testing.T) {
+		nibiruApp, ctx := setupOrdersTest(t)
+		require.NoError(t, simapp.FundModuleAccount(
+			nibiruApp.BankKeeper, ctx, types.VaultModuleAccount,
+			sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000_000))))
+
+		var traders []sdk.AccAddress
+		for i := 0; i < types.MaxSettlementsPerBlock+1; i++ {
+			trader := testutil.AccAddress()
+			setCrossMarginPosition(nibiruApp, ctx, trader, common.Pair_BTC_NUSD, 10, 5, 5)
+			traders = append(traders, trader)
+		}
+
+		t.Log("positions are left untouched while the vpool is live")
+		nibiruApp.PerpKeeper.SettleShutdownPairs(ctx)
+		require.Len(t, nibiruApp.PerpKeeper.Positions.Iterate(ctx, collections.PairRange[common.AssetPair, sdk.AccAddress]{}).Values(), len(traders))
+
+		settlementPrice, err := nibiruApp.VpoolKeeper.ShutdownPool(ctx, common.Pair_BTC_NUSD)
+		require.NoError(t, err)
+		require.EqualValues(t, sdk.OneDec(), settlementPrice)
+
+		nibiruApp.PerpKeeper.SettleShutdownPairs(ctx)
+		require.Len(t, nibiruApp.PerpKeeper.Positions.Iterate(ctx, collections.PairRange[common.AssetPair, sdk.AccAddress]{}).Values(), 1)
+
+		nibiruApp.PerpKeeper.SettleShutdownPairs(ctx)
+		require.Empty(t, nibiruApp.PerpKeeper.Positions.Iterate(ctx, collections.PairRange[common.AssetPair, sdk.AccAddress]{}).Values())
+
+		// returned = size * (settlementPrice - openPrice) + margin = 10 * (1 - 0.5) + 5
+		for _, trader := range traders {
+			require.EqualValues(t, sdk.NewInt(10), nibiruApp.BankKeeper.GetBalance(ctx, trader, common.DenomNUSD).Amount)
+		}
+	})
+
+	t.Run("a position failing to settle doesn't hold back the rest of the pair", func(t *testing.T) {
+		nibiruApp, ctx := setupOrdersTest(t)
+		require.NoError(t, simapp.FundModuleAccount(
+			nibiruApp.BankKeeper, ctx, types.VaultModuleAccount,
+			sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000_000))))
+
+		t.Log("the first position of the pair has a trader address that can't be parsed")
+		failing := sdk.AccAddress(make([]byte, 20))
+		nibiruApp.PerpKeeper.Positions.Insert(ctx, collections.Join(common.Pair_BTC_NUSD, failing), types.Position{
+			TraderAddress:                   "invalid",
+			Pair:                            common.Pair_BTC_NUSD,
+			Size_:                           sdk.NewDec(10),
+			Margin:                          sdk.NewDec(5),
+			OpenNotional:                    sdk.NewDec(5),
+			LatestCumulativePremiumFraction: sdk.ZeroDec(),
+		})
+		var traders []sdk.AccAddress
+		for i := 0; i < types.MaxSettlementsPerBlock+1; i++ {
+			trader := testutil.AccAddress()
+			setCrossMarginPosition(nibiruApp, ctx, trader, common.Pair_BTC_NUSD, 10, 5, 5)
+			traders = append(traders, trader)
+		}
+		_, err := nibiruApp.VpoolKeeper.ShutdownPool(ctx, common.Pair_BTC_NUSD)
+		require.NoError(t, err)
+
+		nibiruApp.PerpKeeper.SettleShutdownPairs(ctx)
+		require.Len(t, nibiruApp.PerpKeeper.Positions.Iterate(ctx, collections.PairRange[common.AssetPair, sdk.AccAddress]{}).Values(), 3)
+
+		t.Log("the next block carries on after the failing position rather than retrying it first")
+		nibiruApp.PerpKeeper.SettleShutdownPairs(ctx)
+		positions := nibiruApp.PerpKeeper.Positions.Iterate(ctx, collections.PairRange[common.AssetPair, sdk.AccAddress]{}).Keys()
+		require.Equal(t, []collections.Pair[common.AssetPair, sdk.AccAddress]{collections.Join(common.Pair_BTC_NUSD, failing)}, positions)
+		for _, trader := range traders {
+			require.EqualValues(t, sdk.NewInt(10), nibiruApp.BankKeeper.GetBalance(ctx, trader, common.DenomNUSD).Amount)
+		}
+	})
+
+	t.Run("pays unpaid funding and covers a short vault with the funds", func(t *testing.T) {
+		nibiruApp, ctx := setupOrdersTest(t)
+		setCumulativePremiumFractions(nibiruApp.PerpKeeper, ctx, common.Pair_BTC_NUSD, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.1"))
+		require.NoError(t, simapp.FundModuleAccount(
+			nibiruApp.BankKeeper, ctx, types.VaultModuleAccount,
+			sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 4))))
+		require.NoError(t, simapp.FundModuleAccount(
+			nibiruApp.BankKeeper, ctx, types.PerpEFModuleAccount,
+			sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 3))))
+
+		trader := testutil.AccAddress()
+		setCrossMarginPosition(nibiruApp, ctx, trader, common.Pair_BTC_NUSD, 10, 5, 5)
+		_, err := nibiruApp.VpoolKeeper.ShutdownPool(ctx, common.Pair_BTC_NUSD)
+		require.NoError(t, err)
+
+		nibiruApp.PerpKeeper.SettleShutdownPairs(ctx)
+
+		// returned = size * (settlementPrice - openPrice) + margin - funding = 10 * (1 - 0.5) + 5 - 1,
+		// cut short by the 2 the vault and the PerpEF can't cover
+		require.Empty(t, nibiruApp.PerpKeeper.Positions.Iterate(ctx, collections.PairRange[common.AssetPair, sdk.AccAddress]{}).Values())
+		require.EqualValues(t, sdk.NewInt(7), nibiruApp.BankKeeper.GetBalance(ctx, trader, common.DenomNUSD).Amount)
+		require.EqualValues(t, sdk.NewInt(3), nibiruApp.PerpKeeper.PrepaidBadDebt.GetOr(ctx, common.DenomNUSD, types.PrepaidBadDebt{
+			Denom:  common.DenomNUSD,
+			Amount: sdk.ZeroInt(),
+		}).Amount)
+	})
+
+	t.Run("realizes the bad debt of an underwater position", func(t *testing.T) {
+		nibiruApp, ctx := setupOrdersTest(t)
+		require.NoError(t, simapp.FundModuleAccount(
+			nibiruApp.BankKeeper, ctx, types.PerpEFModuleAccount,
+			sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 5))))
+
+		trader := testutil.AccAddress()
+		setCrossMarginPosition(nibiruApp, ctx, trader, common.Pair_BTC_NUSD, 10, 20, 5)
+		_, err := nibiruApp.VpoolKeeper.ShutdownPool(ctx, common.Pair_BTC_NUSD)
+		require.NoError(t, err)
+
+		nibiruApp.PerpKeeper.SettleShutdownPairs(ctx)
+
+		// bad debt = -(size * (settlementPrice - openPrice) + margin) = -(10 * (1 - 2) + 5)
+		require.Empty(t, nibiruApp.PerpKeeper.Positions.Iterate(ctx, collections.PairRange[common.AssetPair, sdk.AccAddress]{}).Values())
+		require.True(t, nibiruApp.BankKeeper.GetBalance(ctx, trader, common.DenomNUSD).IsZero())
+		require.True(t, nibiruApp.BankKeeper.GetBalance(
+			ctx, authtypes.NewModuleAddress(types.PerpEFModuleAccount), common.DenomNUSD).IsZero())
+		require.EqualValues(t, sdk.NewInt(5), nibiruApp.BankKeeper.GetBalance(
+			ctx, authtypes.NewModuleAddress(types.VaultModuleAccount), common.DenomNUSD).Amount)
+	})
+
+	t.Run("rejects new orders on a shut down pair", func(t *testing.T) {
+		nibiruApp, ctx := setupOrdersTest(t)
+		trader := testutil.AccAddress()
+		require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1010))))
+		order := placeLimitOrder(t, nibiruApp, ctx, trader, types.Side_BUY, sdk.MustNewDecFromStr("0.9"))
+
+		_, err := nibiruApp.VpoolKeeper.ShutdownPool(ctx, common.Pair_BTC_NUSD)
+		require.NoError(t, err)
+
+		nibiruApp.PerpKeeper.ExecuteTriggeredOrders(ctx)
+		_, err = nibiruApp.PerpKeeper.Orders.Get(ctx, order.Id)
+		require.ErrorIs(t, err, collections.ErrNotFound)
+		require.EqualValues(t, sdk.NewInt(1010), nibiruApp.BankKeeper.GetBalance(ctx, trader, common.DenomNUSD).Amount)
+
+		_, err = nibiruApp.PerpKeeper.PlaceOrder(
+			ctx,
+			common.Pair_BTC_NUSD,
+			trader,
+			types.OrderType_LIMIT,
+			types.Side_BUY,
+			sdk.MustNewDecFromStr("0.9"),
+			/* quoteAssetAmount */ sdk.NewInt(1000),
+			/* leverage */ sdk.NewDec(10),
+			/* baseAmtLimit */ sdk.ZeroDec(),
+		)
+		require.ErrorIs(t, err, vpooltypes.ErrPoolShutdown)
+	})
+}
+
+func TestReducePosition(t *testing.T) {
+	pair := common.MustNewAssetPair("xxx:yyy")
+	nibiruApp, ctx := setupOpenInterestPool(t, pair, sdk.ZeroDec(), sdk.ZeroDec())
+	perpKeeper := nibiruApp.PerpKeeper
+
+	alice, bob := testutil.AccAddress(), testutil.AccAddress()
+	for _, trader := range []sdk.AccAddress{alice, bob} {
+		require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin("yyy", 1_000))))
+	}
+	long, err := perpKeeper.OpenPosition(ctx, pair, types.Side_BUY, alice, sdk.NewInt(100), sdk.NewDec(5), sdk.ZeroDec())
+	require.NoError(t, err)
+	short, err := perpKeeper.OpenPosition(ctx, pair, types.Side_SELL, bob, sdk.NewInt(100), sdk.NewDec(5), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	t.Log("the quote received closing a long must not be below the limit")
+	baseAmount := long.Position.Size_.QuoInt64(2)
+	_, err = perpKeeper.ReducePosition(ctx, pair, alice, baseAmount, sdk.NewDec(1_000))
+	require.ErrorIs(t, err, vpooltypes.ErrAssetFailsUserLimit)
+
+	t.Log("close half of the long")
+	resp, err := perpKeeper.ReducePosition(ctx, pair, alice, baseAmount, sdk.NewDec(200))
+	require.NoError(t, err)
+	require.True(t, resp.Position.Size_.IsPositive())
+	require.True(t, resp.Position.Size_.Sub(long.Position.Size_.Sub(baseAmount)).Abs().LT(sdk.MustNewDecFromStr("0.000001")),
+		"remaining size %s", resp.Position.Size_)
+	position, err := perpKeeper.Positions.Get(ctx, collections.Join(pair, alice))
+	require.NoError(t, err)
+	require.Equal(t, resp.Position.Size_, position.Size_)
+	testutil.RequireContainsTypedEvent(t, ctx, &types.PositionChangedEvent{
+		Pair:                  pair.String(),
+		TraderAddress:         alice.String(),
+		Margin:                sdk.NewCoin(pair.QuoteDenom(), resp.Position.Margin.RoundInt()),
+		PositionNotional:      resp.PositionNotional,
+		ExchangedPositionSize: resp.ExchangedPositionSize,
+		ExchangedNotional:     resp.ExchangedNotionalValue,
+		TransactionFee:        sdk.NewInt64Coin(pair.QuoteDenom(), 0),
+		PositionSize:          resp.Position.Size_,
+		RealizedPnl:           resp.RealizedPnl,
+		UnrealizedPnlAfter:    resp.UnrealizedPnlAfter,
+		BadDebt:               sdk.NewInt64Coin(pair.QuoteDenom(), 0),
+		LiquidationPenalty:    sdk.ZeroDec(),
+		MarkPrice:             mustGetMarkPrice(t, nibiruApp, ctx, pair),
+		FundingPayment:        resp.FundingPayment,
+		BlockHeight:           ctx.BlockHeight(),
+		BlockTimeMs:           ctx.BlockTime().UnixMilli(),
+	})
+
+	t.Log("the quote paid closing a short must not be above the limit")
+	_, err = perpKeeper.ReducePosition(ctx, pair, bob, short.Position.Size_.Abs().QuoInt64(2), sdk.NewDec(100))
+	require.ErrorIs(t, err, vpooltypes.ErrAssetFailsUserLimit)
+
+	t.Log("closing more than the size closes the short without flipping it")
+	resp, err = perpKeeper.ReducePosition(ctx, pair, bob, short.Position.Size_.Abs().MulInt64(2), sdk.ZeroDec())
+	require.NoError(t, err)
+	require.True(t, resp.Position.Size_.IsZero())
+	_, err = perpKeeper.Positions.Get(ctx, collections.Join(pair, bob))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
+
+func mustGetMarkPrice(t *testing.T, nibiruApp *nibisimapp.NibiruTestApp, ctx sdk.Context, pair common.AssetPair) sdk.Dec {
+	markPrice, err := nibiruApp.VpoolKeeper.GetMarkPrice(ctx, pair)
+	require.NoError(t, err)
+	return markPrice
+}
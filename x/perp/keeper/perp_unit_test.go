@@ -6,11 +6,14 @@ import (
 	"github.com/NibiruChain/nibiru/x/testutil"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/nibiru/collections"
 	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/perp/types"
+	vpooltypes "github.com/NibiruChain/nibiru/x/vpool/types"
 )
 
 func TestSettlePosition(t *testing.T) {
@@ -24,12 +27,14 @@ func TestSettlePosition(t *testing.T) {
 			GetSettlementPrice(gomock.Eq(ctx), gomock.Eq(pair)).
 			Return(sdk.ZeroDec(), error(nil))
 
+		mockVaultBalance(dep, ctx, "UST", 1_000_000)
 		dep.mockBankKeeper.EXPECT().
 			SendCoinsFromModuleToAccount(
 				ctx, types.VaultModuleAccount, traderAddr,
 				sdk.NewCoins(sdk.NewCoin("UST", sdk.NewInt(100))),
 			).
 			Return(error(nil))
+		setCumulativePremiumFractions(k, ctx, pair, sdk.ZeroDec())
 
 		pos := types.Position{
 			TraderAddress: traderAddr.String(),
@@ -37,6 +42,8 @@ func TestSettlePosition(t *testing.T) {
 			Size_:         sdk.NewDec(10),
 			Margin:        sdk.NewDec(100),
 			OpenNotional:  sdk.NewDec(1000),
+
+			LatestCumulativePremiumFraction: sdk.ZeroDec(),
 		}
 		setPosition(k, ctx, pos)
 
@@ -58,10 +65,12 @@ func TestSettlePosition(t *testing.T) {
 			GetSettlementPrice(ctx, pair).
 			Return(sdk.NewDec(1000), error(nil))
 
+		mockVaultBalance(dep, ctx, "UST", 1_000_000)
 		dep.mockBankKeeper.EXPECT().
 			SendCoinsFromModuleToAccount(
 				ctx, types.VaultModuleAccount, traderAddr, sdk.NewCoins(sdk.NewCoin("UST", sdk.NewInt(99_100)))).
 			Return(error(nil))
+		setCumulativePremiumFractions(k, ctx, pair, sdk.ZeroDec())
 
 		// this means that the user
 		// has bought 100 contracts
@@ -79,6 +88,8 @@ func TestSettlePosition(t *testing.T) {
 			Size_:         sdk.NewDec(100),
 			Margin:        sdk.NewDec(100),
 			OpenNotional:  sdk.NewDec(1000),
+
+			LatestCumulativePremiumFraction: sdk.ZeroDec(),
 		}
 		setPosition(k, ctx, pos)
 
@@ -104,4 +115,38 @@ func TestSettlePosition(t *testing.T) {
 		require.NoError(t, err)
 		require.Len(t, coins, 0)
 	})
+
+	t.Run("vpool not shut down", func(t *testing.T) {
+		k, dep, ctx := getKeeper(t)
+		traderAddr := testutil.AccAddress()
+		pair := common.MustNewAssetPair("LUNA:UST")
+
+		dep.mockVpoolKeeper.
+			EXPECT().
+			GetSettlementPrice(ctx, pair).
+			Return(sdk.Dec{}, vpooltypes.ErrPoolNotShutdown)
+
+		pos := types.Position{
+			TraderAddress: traderAddr.String(),
+			Pair:          pair,
+			Size_:         sdk.NewDec(100),
+			Margin:        sdk.NewDec(100),
+			OpenNotional:  sdk.NewDec(1000),
+
+			LatestCumulativePremiumFraction: sdk.ZeroDec(),
+		}
+		setPosition(k, ctx, pos)
+
+		_, err := k.SettlePosition(ctx, pos)
+		require.ErrorIs(t, err, vpooltypes.ErrPoolNotShutdown)
+
+		_, err = k.Positions.Get(ctx, collections.Join(pair, traderAddr))
+		require.NoError(t, err)
+	})
+}
+
+func mockVaultBalance(dep mockedDependencies, ctx sdk.Context, denom string, amount int64) {
+	vaultAddr := authtypes.NewModuleAddress(types.VaultModuleAccount)
+	dep.mockAccountKeeper.EXPECT().GetModuleAddress(types.VaultModuleAccount).Return(vaultAddr)
+	dep.mockBankKeeper.EXPECT().GetBalance(ctx, vaultAddr, denom).Return(sdk.NewInt64Coin(denom, amount))
 }
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	vpooltypes "github.com/NibiruChain/nibiru/x/vpool/types"
+)
+
+var _ vpooltypes.PositionsKeeper = Keeper{}
+
+// GetNetPositionSize returns the long open interest of the pair minus its short open interest.
+func (k Keeper) GetNetPositionSize(ctx sdk.Context, pair common.AssetPair) sdk.Dec {
+	openInterest := k.GetOpenInterest(ctx, pair)
+	return openInterest.Long.Sub(openInterest.Short)
+}
+
+/*
+PayRepegCost settles the cost of a vpool repeg between the PerpEF and the vault, which
+holds the margin and the PnL of the traders. A positive cost, rounded up, is sent from
+the PerpEF to the vault. A negative cost is a profit, which is sent, rounded down, from
+the vault to the PerpEF.
+*/
+func (k Keeper) PayRepegCost(ctx sdk.Context, pair common.AssetPair, cost sdk.Dec) error {
+	if cost.IsPositive() {
+		amount := cost.Ceil().TruncateInt()
+		ecosystemFundBalance := k.BankKeeper.GetBalance(
+			ctx, k.AccountKeeper.GetModuleAddress(types.PerpEFModuleAccount), pair.QuoteDenom(),
+		).Amount
+		if ecosystemFundBalance.LT(amount) {
+			return types.ErrRepegCostTooHigh.Wrapf(
+				"repeg cost %s%s, perp ecosystem fund balance %s%s",
+				amount, pair.QuoteDenom(), ecosystemFundBalance, pair.QuoteDenom())
+		}
+		return k.BankKeeper.SendCoinsFromModuleToModule(
+			ctx,
+			/* from */ types.PerpEFModuleAccount,
+			/* to */ types.VaultModuleAccount,
+			sdk.NewCoins(sdk.NewCoin(pair.QuoteDenom(), amount)),
+		)
+	}
+
+	profit := cost.Neg().TruncateInt()
+	if !profit.IsPositive() {
+		return nil
+	}
+	return k.BankKeeper.SendCoinsFromModuleToModule(
+		ctx,
+		/* from */ types.VaultModuleAccount,
+		/* to */ types.PerpEFModuleAccount,
+		sdk.NewCoins(sdk.NewCoin(pair.QuoteDenom(), profit)),
+	)
+}
@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nibisimapp "github.com/NibiruChain/nibiru/simapp"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+	"github.com/NibiruChain/nibiru/x/vpool"
+	vpooltypes "github.com/NibiruChain/nibiru/x/vpool/types"
+)
+
+func TestRepegPoolProposal(t *testing.T) {
+	pair := common.MustNewAssetPair("xxx:yyy")
+	proposal := &vpooltypes.RepegPoolProposal{
+		Title:       "repeg xxx:yyy",
+		Description: "the mark price drifted from the index price",
+		Pair:        pair.String(),
+		PegPrice:    sdk.NewDec(2),
+		KMultiplier: sdk.OneDec(),
+	}
+
+	setup := func(t *testing.T) (*nibisimapp.NibiruTestApp, sdk.Context) {
+		nibiruApp, ctx := setupOpenInterestPool(t, pair, sdk.ZeroDec(), sdk.ZeroDec())
+		alice, bob := testutil.AccAddress(), testutil.AccAddress()
+		for _, trader := range []sdk.AccAddress{alice, bob} {
+			require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin("yyy", 1_000))))
+		}
+
+		_, err := nibiruApp.PerpKeeper.OpenPosition(ctx, pair, types.Side_BUY, alice, sdk.NewInt(200), sdk.NewDec(5), sdk.ZeroDec())
+		require.NoError(t, err)
+		_, err = nibiruApp.PerpKeeper.OpenPosition(ctx, pair, types.Side_SELL, bob, sdk.NewInt(100), sdk.NewDec(5), sdk.ZeroDec())
+		require.NoError(t, err)
+		return nibiruApp, ctx
+	}
+
+	t.Run("the perp ecosystem fund pays the repeg of a net long market", func(t *testing.T) {
+		nibiruApp, ctx := setup(t)
+		require.NoError(t, simapp.FundModuleAccount(
+			nibiruApp.BankKeeper, ctx, types.PerpEFModuleAccount, sdk.NewCoins(sdk.NewInt64Coin("yyy", 10_000))))
+		netPositionSize := nibiruApp.PerpKeeper.GetNetPositionSize(ctx, pair)
+		require.True(t, netPositionSize.IsPositive())
+		vaultBalanceBefore := moduleBalance(nibiruApp, ctx, types.VaultModuleAccount, "yyy")
+		ecosystemFundBalanceBefore := moduleBalance(nibiruApp, ctx, types.PerpEFModuleAccount, "yyy")
+
+		require.NoError(t, vpool.NewVpoolProposalHandler(nibiruApp.VpoolKeeper)(ctx, proposal))
+
+		pool, err := nibiruApp.VpoolKeeper.Pools.Get(ctx, pair)
+		require.NoError(t, err)
+		assert.Equal(t, sdk.NewDec(2), pool.GetMarkPrice())
+
+		paid := ecosystemFundBalanceBefore.Sub(moduleBalance(nibiruApp, ctx, types.PerpEFModuleAccount, "yyy"))
+		assert.True(t, paid.IsPositive())
+		assert.Equal(t, vaultBalanceBefore.Add(paid), moduleBalance(nibiruApp, ctx, types.VaultModuleAccount, "yyy"))
+	})
+
+	t.Run("the repeg fails if the perp ecosystem fund cannot pay for it", func(t *testing.T) {
+		nibiruApp, ctx := setup(t)
+
+		err := vpool.NewVpoolProposalHandler(nibiruApp.VpoolKeeper)(ctx, proposal)
+		require.ErrorIs(t, err, types.ErrRepegCostTooHigh)
+
+		pool, err := nibiruApp.VpoolKeeper.Pools.Get(ctx, pair)
+		require.NoError(t, err)
+		assert.True(t, pool.GetMarkPrice().LT(sdk.NewDec(2)))
+	})
+
+	t.Run("the vault credits the perp ecosystem fund for a profitable repeg", func(t *testing.T) {
+		nibiruApp, ctx := setup(t)
+		lowerPeg := *proposal
+		lowerPeg.PegPrice = sdk.MustNewDecFromStr("0.5")
+		ecosystemFundBalanceBefore := moduleBalance(nibiruApp, ctx, types.PerpEFModuleAccount, "yyy")
+
+		require.NoError(t, vpool.NewVpoolProposalHandler(nibiruApp.VpoolKeeper)(ctx, &lowerPeg))
+		assert.True(t, moduleBalance(nibiruApp, ctx, types.PerpEFModuleAccount, "yyy").GT(ecosystemFundBalanceBefore))
+	})
+}
+
+func TestRepegEnabledByParamChangeProposal(t *testing.T) {
+	pair := common.MustNewAssetPair("xxx:yyy")
+	nibiruApp, ctx := setupOpenInterestPool(t, pair, sdk.ZeroDec(), sdk.ZeroDec())
+
+	t.Log("the index price moves to 2 while the mark price stays at 1")
+	oracle := testutil.AccAddress()
+	nibiruApp.PricefeedKeeper.WhitelistOraclesForPairs(ctx, []sdk.AccAddress{oracle}, []common.AssetPair{pair})
+	require.NoError(t, nibiruApp.PricefeedKeeper.PostRawPrice(ctx, oracle, pair.String(), sdk.NewDec(2), ctx.BlockTime().Add(time.Hour)))
+	require.NoError(t, nibiruApp.PricefeedKeeper.GatherRawPrices(ctx, pair.BaseDenom(), pair.QuoteDenom()))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Minute))
+
+	t.Log("repegging is disabled at genesis")
+	nibiruApp.VpoolKeeper.Hooks().AfterEpochEnd(ctx, vpooltypes.DefaultRepegEpochIdentifier, 1)
+	pool, err := nibiruApp.VpoolKeeper.Pools.Get(ctx, pair)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.OneDec(), pool.GetMarkPrice())
+
+	t.Log("governance enables repegging")
+	require.NoError(t, params.NewParamChangeProposalHandler(nibiruApp.ParamsKeeper)(ctx, &paramsproposal.ParameterChangeProposal{
+		Title:       "enable repegging",
+		Description: "repeg the vpools drifting more than 10% from their index twap",
+		Changes: []paramsproposal.ParamChange{
+			paramsproposal.NewParamChange(vpooltypes.ModuleName, string(vpooltypes.KeyRepegSpreadRatio), `"0.1"`),
+		},
+	}))
+	require.True(t, nibiruApp.VpoolKeeper.GetParams(ctx).IsRepegEnabled())
+
+	t.Log("the next repeg epoch repegs the pool to its index twap")
+	nibiruApp.VpoolKeeper.Hooks().AfterEpochEnd(ctx, vpooltypes.DefaultRepegEpochIdentifier, 2)
+	pool, err = nibiruApp.VpoolKeeper.Pools.Get(ctx, pair)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(2), pool.GetMarkPrice())
+}
+
+func moduleBalance(nibiruApp *nibisimapp.NibiruTestApp, ctx sdk.Context, moduleName string, denom string) sdk.Int {
+	return nibiruApp.BankKeeper.GetBalance(ctx, nibiruApp.AccountKeeper.GetModuleAddress(moduleName), denom).Amount
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+)
+
+// subaccountTrader returns the address trading for the subaccount of the owner, the
+// owner itself for subaccount 0, and registers the subaccount the first time it is used.
+func (k Keeper) subaccountTrader(ctx sdk.Context, owner sdk.AccAddress, id uint64) sdk.AccAddress {
+	if id == 0 {
+		return owner
+	}
+
+	key := collections.Join(owner, id)
+	subaccount, err := k.Subaccounts.Get(ctx, key)
+	if err != nil {
+		subaccount = types.NewSubaccount(owner, id)
+		k.Subaccounts.Insert(ctx, key, subaccount)
+	}
+	return sdk.MustAccAddressFromBech32(subaccount.Address)
+}
+
+/*
+TransferSubaccountMargin moves coins between two subaccounts of the owner, from
+which they are used as margin by the positions of the receiving subaccount.
+Subaccount 0 is the owner itself, so margin is deposited into a subaccount by
+transferring it from 0, and withdrawn by transferring it back to 0.
+
+args:
+  - ctx: cosmos-sdk context
+  - owner: the owner of both subaccounts
+  - fromID: the subaccount sending the coins
+  - toID: the subaccount receiving the coins
+  - amount: the coins to transfer
+
+ret:
+  - err: error
+*/
+func (k Keeper) TransferSubaccountMargin(
+	ctx sdk.Context, owner sdk.AccAddress, fromID uint64, toID uint64, amount sdk.Coin,
+) (err error) {
+	if fromID == toID {
+		return types.ErrInvalidSubaccount.Wrapf("cannot transfer from subaccount %d to itself", fromID)
+	}
+
+	if err = k.BankKeeper.SendCoins(
+		ctx,
+		/* from */ k.subaccountTrader(ctx, owner, fromID),
+		/* to */ k.subaccountTrader(ctx, owner, toID),
+		sdk.NewCoins(amount),
+	); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.SubaccountMarginTransferredEvent{
+		Owner:            owner.String(),
+		FromSubaccountId: fromID,
+		ToSubaccountId:   toID,
+		Amount:           amount,
+		BlockHeight:      ctx.BlockHeight(),
+	})
+}
@@ -0,0 +1,289 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	perpkeeper "github.com/NibiruChain/nibiru/x/perp/keeper"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+)
+
+func TestSubaccounts(t *testing.T) {
+	pair := common.MustNewAssetPair("xxx:yyy")
+	nibiruApp, ctx := setupOpenInterestPool(t, pair, sdk.ZeroDec(), sdk.ZeroDec())
+	perpKeeper := nibiruApp.PerpKeeper
+	msgServer := perpkeeper.NewMsgServerImpl(perpKeeper)
+	queryServer := perpkeeper.NewQuerier(perpKeeper)
+
+	owner := testutil.AccAddress()
+	require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, owner, sdk.NewCoins(sdk.NewInt64Coin("yyy", 1_000))))
+	sub1, sub2 := types.SubaccountAddress(owner, 1), types.SubaccountAddress(owner, 2)
+	assert.Equal(t, owner, types.SubaccountAddress(owner, 0))
+	assert.NotEqual(t, sub1, sub2)
+
+	t.Log("a subaccount trades on its own balance only")
+	failedTxCtx, _ := ctx.CacheContext()
+	_, err := msgServer.OpenPosition(sdk.WrapSDKContext(failedTxCtx), &types.MsgOpenPosition{
+		Sender:               owner.String(),
+		TokenPair:            pair.String(),
+		Side:                 types.Side_BUY,
+		QuoteAssetAmount:     sdk.NewInt(100),
+		Leverage:             sdk.NewDec(2),
+		BaseAssetAmountLimit: sdk.ZeroInt(),
+		SubaccountId:         1,
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	t.Log("fund the subaccounts from the owner")
+	for id, amount := range map[uint64]int64{1: 300, 2: 200} {
+		_, err = msgServer.TransferSubaccountMargin(sdk.WrapSDKContext(ctx), &types.MsgTransferSubaccountMargin{
+			Sender:         owner.String(),
+			ToSubaccountId: id,
+			Amount:         sdk.NewInt64Coin("yyy", amount),
+		})
+		require.NoError(t, err)
+	}
+	testutil.RequireContainsTypedEvent(t, ctx, &types.SubaccountMarginTransferredEvent{
+		Owner:            owner.String(),
+		FromSubaccountId: 0,
+		ToSubaccountId:   1,
+		Amount:           sdk.NewInt64Coin("yyy", 300),
+		BlockHeight:      ctx.BlockHeight(),
+	})
+	assert.Equal(t, sdk.NewInt64Coin("yyy", 500), nibiruApp.BankKeeper.GetBalance(ctx, owner, "yyy"))
+	assert.Equal(t, sdk.NewInt64Coin("yyy", 300), nibiruApp.BankKeeper.GetBalance(ctx, sub1, "yyy"))
+
+	t.Log("the owner and its subaccounts hold opposite positions on the same pair")
+	for id, side := range map[uint64]types.Side{0: types.Side_BUY, 1: types.Side_BUY, 2: types.Side_SELL} {
+		_, err = msgServer.OpenPosition(sdk.WrapSDKContext(ctx), &types.MsgOpenPosition{
+			Sender:               owner.String(),
+			TokenPair:            pair.String(),
+			Side:                 side,
+			QuoteAssetAmount:     sdk.NewInt(100),
+			Leverage:             sdk.NewDec(2),
+			BaseAssetAmountLimit: sdk.ZeroInt(),
+			SubaccountId:         id,
+		})
+		require.NoError(t, err)
+	}
+	for trader, long := range map[string]bool{owner.String(): true, sub1.String(): true, sub2.String(): false} {
+		position, err := perpKeeper.Positions.Get(ctx, collections.Join(pair, sdk.MustAccAddressFromBech32(trader)))
+		require.NoError(t, err)
+		assert.Equal(t, trader, position.TraderAddress)
+		assert.Equal(t, sdk.NewDec(100), position.Margin)
+		assert.Equal(t, long, position.Size_.IsPositive())
+	}
+	assert.Equal(t, sdk.NewInt64Coin("yyy", 200), nibiruApp.BankKeeper.GetBalance(ctx, sub1, "yyy"))
+
+	t.Log("closing the position of a subaccount pays the subaccount")
+	_, err = msgServer.ClosePosition(sdk.WrapSDKContext(ctx), &types.MsgClosePosition{
+		Sender:       owner.String(),
+		TokenPair:    pair.String(),
+		SubaccountId: 2,
+	})
+	require.NoError(t, err)
+	_, err = perpKeeper.Positions.Get(ctx, collections.Join(pair, sub2))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	_, err = perpKeeper.Positions.Get(ctx, collections.Join(pair, owner))
+	require.NoError(t, err)
+
+	t.Log("the margin is withdrawn by transferring it back to the owner")
+	balance := nibiruApp.BankKeeper.GetBalance(ctx, sub2, "yyy")
+	_, err = msgServer.TransferSubaccountMargin(sdk.WrapSDKContext(ctx), &types.MsgTransferSubaccountMargin{
+		Sender:           owner.String(),
+		FromSubaccountId: 2,
+		Amount:           balance,
+	})
+	require.NoError(t, err)
+	assert.True(t, nibiruApp.BankKeeper.GetBalance(ctx, sub2, "yyy").IsZero())
+
+	t.Log("the used subaccounts are listed by owner")
+	resp, err := queryServer.QuerySubaccounts(sdk.WrapSDKContext(ctx), &types.QuerySubaccountsRequest{Owner: owner.String()})
+	require.NoError(t, err)
+	assert.Equal(t, []types.Subaccount{types.NewSubaccount(owner, 1), types.NewSubaccount(owner, 2)}, resp.Subaccounts)
+}
+
+func TestTradingAuthorization(t *testing.T) {
+	pair := common.MustNewAssetPair("xxx:yyy")
+	nibiruApp, ctx := setupOpenInterestPool(t, pair, sdk.ZeroDec(), sdk.ZeroDec())
+
+	owner, bot := testutil.AccAddress(), testutil.AccAddress()
+	require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, types.SubaccountAddress(owner, 1), sdk.NewCoins(sdk.NewInt64Coin("yyy", 1_000))))
+
+	t.Log("the owner grants the bot to trade on subaccount 1 up to 5x and 1000 notional")
+	for _, msg := range []sdk.Msg{&types.MsgOpenPosition{}, &types.MsgClosePosition{}} {
+		authorization := types.NewTradingAuthorization(sdk.MsgTypeURL(msg), sdk.NewDec(5), sdk.NewInt(1_000))
+		authorization.SubaccountIds = []uint64{1}
+		require.NoError(t, nibiruApp.AuthzKeeper.SaveGrant(ctx, bot, owner, authorization, ctx.BlockTime().Add(time.Hour)))
+	}
+
+	openPosition := func(quoteAmount int64, leverage int64) *types.MsgOpenPosition {
+		return &types.MsgOpenPosition{
+			Sender:               owner.String(),
+			TokenPair:            pair.String(),
+			Side:                 types.Side_BUY,
+			QuoteAssetAmount:     sdk.NewInt(quoteAmount),
+			Leverage:             sdk.NewDec(leverage),
+			BaseAssetAmountLimit: sdk.ZeroInt(),
+			SubaccountId:         1,
+		}
+	}
+
+	t.Log("the bot trades within the caps")
+	_, err := nibiruApp.AuthzKeeper.DispatchActions(ctx, bot, []sdk.Msg{openPosition(100, 5)})
+	require.NoError(t, err)
+	position, err := nibiruApp.PerpKeeper.Positions.Get(ctx, collections.Join(pair, types.SubaccountAddress(owner, 1)))
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(100), position.Margin)
+
+	t.Log("the bot can't go over the caps")
+	_, err = nibiruApp.AuthzKeeper.DispatchActions(ctx, bot, []sdk.Msg{openPosition(50, 6)})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = nibiruApp.AuthzKeeper.DispatchActions(ctx, bot, []sdk.Msg{openPosition(101, 5)})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	t.Log("the bot can't stack positions past the notional cap")
+	_, err = nibiruApp.AuthzKeeper.DispatchActions(ctx, bot, []sdk.Msg{openPosition(100, 5)})
+	require.NoError(t, err)
+	authorization, _ := nibiruApp.AuthzKeeper.GetCleanAuthorization(ctx, bot, owner, sdk.MsgTypeURL(&types.MsgOpenPosition{}))
+	assert.Nil(t, authorization)
+	_, err = nibiruApp.AuthzKeeper.DispatchActions(ctx, bot, []sdk.Msg{openPosition(1, 1)})
+	require.Error(t, err)
+	position, err = nibiruApp.PerpKeeper.Positions.Get(ctx, collections.Join(pair, types.SubaccountAddress(owner, 1)))
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(200), position.Margin)
+
+	t.Log("the bot can't take funds out")
+	_, err = nibiruApp.AuthzKeeper.DispatchActions(ctx, bot, []sdk.Msg{&types.MsgRemoveMargin{
+		Sender:       owner.String(),
+		TokenPair:    pair.String(),
+		Margin:       sdk.NewInt64Coin("yyy", 10),
+		SubaccountId: 1,
+	}})
+	require.Error(t, err)
+	_, err = nibiruApp.AuthzKeeper.DispatchActions(ctx, bot, []sdk.Msg{&types.MsgTransferSubaccountMargin{
+		Sender:           owner.String(),
+		FromSubaccountId: 1,
+		ToSubaccountId:   0,
+		Amount:           sdk.NewInt64Coin("yyy", 10),
+	}})
+	require.Error(t, err)
+
+	t.Log("the bot closes the position")
+	_, err = nibiruApp.AuthzKeeper.DispatchActions(ctx, bot, []sdk.Msg{&types.MsgClosePosition{
+		Sender:       owner.String(),
+		TokenPair:    pair.String(),
+		SubaccountId: 1,
+	}})
+	require.NoError(t, err)
+	_, err = nibiruApp.PerpKeeper.Positions.Get(ctx, collections.Join(pair, types.SubaccountAddress(owner, 1)))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
+
+func TestSubaccountOrdersAndCrossMargin(t *testing.T) {
+	pair := common.MustNewAssetPair("xxx:yyy")
+	nibiruApp, ctx := setupOpenInterestPool(t, pair, sdk.ZeroDec(), sdk.ZeroDec())
+	perpKeeper := nibiruApp.PerpKeeper
+	msgServer := perpkeeper.NewMsgServerImpl(perpKeeper)
+
+	owner := testutil.AccAddress()
+	sub1 := types.SubaccountAddress(owner, 1)
+	require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, sub1, sdk.NewCoins(sdk.NewInt64Coin("yyy", 2_000_000))))
+
+	t.Log("subaccount 1 switches to cross margin, the owner stays isolated")
+	_, err := msgServer.SetMarginMode(sdk.WrapSDKContext(ctx), &types.MsgSetMarginMode{
+		Sender:       owner.String(),
+		MarginMode:   types.MarginMode_CROSS,
+		SubaccountId: 1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, types.MarginMode_CROSS, perpKeeper.GetMarginMode(ctx, sub1))
+	assert.Equal(t, types.MarginMode_ISOLATED, perpKeeper.GetMarginMode(ctx, owner))
+
+	t.Log("subaccount 1 places a limit order escrowing its own balance")
+	resp, err := msgServer.PlaceOrder(sdk.WrapSDKContext(ctx), &types.MsgPlaceOrder{
+		Sender:               owner.String(),
+		TokenPair:            pair.String(),
+		OrderType:            types.OrderType_LIMIT,
+		Side:                 types.Side_BUY,
+		TriggerPrice:         sdk.MustNewDecFromStr("0.5"),
+		QuoteAssetAmount:     sdk.NewInt(100),
+		Leverage:             sdk.NewDec(2),
+		BaseAssetAmountLimit: sdk.ZeroInt(),
+		SubaccountId:         1,
+	})
+	require.NoError(t, err)
+	order, err := perpKeeper.Orders.Get(ctx, resp.OrderId)
+	require.NoError(t, err)
+	assert.Equal(t, sub1.String(), order.TraderAddress)
+	balanceAfterPlacing := nibiruApp.BankKeeper.GetBalance(ctx, sub1, "yyy")
+	assert.Equal(t, sdk.NewInt(2_000_000).Sub(perpKeeper.GetParams(ctx).OrderDeposit).SubRaw(100), balanceAfterPlacing.Amount)
+
+	t.Log("the order can only be cancelled from the subaccount which placed it")
+	_, err = msgServer.CancelOrder(sdk.WrapSDKContext(ctx), &types.MsgCancelOrder{
+		Sender:  owner.String(),
+		OrderId: resp.OrderId,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = msgServer.CancelOrder(sdk.WrapSDKContext(ctx), &types.MsgCancelOrder{
+		Sender:       owner.String(),
+		OrderId:      resp.OrderId,
+		SubaccountId: 1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewInt64Coin("yyy", 2_000_000), nibiruApp.BankKeeper.GetBalance(ctx, sub1, "yyy"))
+}
+
+func TestSubaccountSettlePosition(t *testing.T) {
+	pair := common.MustNewAssetPair("xxx:yyy")
+	nibiruApp, ctx := setupOpenInterestPool(t, pair, sdk.ZeroDec(), sdk.ZeroDec())
+	perpKeeper := nibiruApp.PerpKeeper
+	msgServer := perpkeeper.NewMsgServerImpl(perpKeeper)
+
+	owner := testutil.AccAddress()
+	sub1 := types.SubaccountAddress(owner, 1)
+	require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, sub1, sdk.NewCoins(sdk.NewInt64Coin("yyy", 100))))
+
+	t.Log("subaccount 1 opens a position on a pair which is then shut down")
+	_, err := msgServer.OpenPosition(sdk.WrapSDKContext(ctx), &types.MsgOpenPosition{
+		Sender:               owner.String(),
+		TokenPair:            pair.String(),
+		Side:                 types.Side_BUY,
+		QuoteAssetAmount:     sdk.NewInt(100),
+		Leverage:             sdk.NewDec(2),
+		BaseAssetAmountLimit: sdk.ZeroInt(),
+		SubaccountId:         1,
+	})
+	require.NoError(t, err)
+	_, err = nibiruApp.VpoolKeeper.ShutdownPool(ctx, pair)
+	require.NoError(t, err)
+
+	t.Log("the owner has no position of its own to settle")
+	failedTxCtx, _ := ctx.CacheContext()
+	_, err = msgServer.SettlePosition(sdk.WrapSDKContext(failedTxCtx), &types.MsgSettlePosition{
+		Sender:    owner.String(),
+		TokenPair: pair.String(),
+	})
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	t.Log("settling the position of the subaccount pays the subaccount")
+	resp, err := msgServer.SettlePosition(sdk.WrapSDKContext(ctx), &types.MsgSettlePosition{
+		Sender:       owner.String(),
+		TokenPair:    pair.String(),
+		SubaccountId: 1,
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.SettledCoins.AmountOf("yyy").IsPositive())
+	assert.Equal(t, resp.SettledCoins.AmountOf("yyy"), nibiruApp.BankKeeper.GetBalance(ctx, sub1, "yyy").Amount)
+	_, err = perpKeeper.Positions.Get(ctx, collections.Join(pair, sub1))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
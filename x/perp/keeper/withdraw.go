@@ -2,14 +2,17 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/perp/types"
 )
 
 /*
 Withdraws coins from the vault to the receiver.
 If the total amount of coins to withdraw is greater than the vault's amount, then
-withdraw the shortage from the PerpEF and mark it as prepaid bad debt.
+withdraw the shortage from the insurance fund and the PerpEF and mark it as prepaid bad debt.
+If they can't cover it either, the withdrawal fails with ErrInsufficientFunds.
 
 Prepaid bad debt will count towards realized bad debt from negative PnL positions
 when those are closed/liquidated.
@@ -28,8 +31,71 @@ func (k Keeper) Withdraw(
 	receiver sdk.AccAddress,
 	amountToWithdraw sdk.Int,
 ) (err error) {
+	uncovered, err := k.coverVaultShortage(ctx, denom, amountToWithdraw)
+	if err != nil {
+		return err
+	}
+	if uncovered.IsPositive() {
+		return sdkerrors.ErrInsufficientFunds.Wrapf(
+			"the vault, the insurance fund and the PerpEF are short of %s%s", uncovered, denom)
+	}
+	return k.sendFromVault(ctx, denom, receiver, amountToWithdraw)
+}
+
+/*
+withdrawPayout withdraws the payout of a closed position or of a liquidation on the
+pair like Withdraw does, except that it doesn't fail on what the vault, the insurance
+fund and the PerpEF can't cover, so that the position change goes through.
+
+The receiver is cut short by the uncovered amount. It isn't auto-deleveraged from the
+other positions of the pair: reducing their margins brings no coins into the vault, so
+the receiver would be cut short all the same and the loss charged twice.
+Returns the amount actually paid.
+*/
+func (k Keeper) withdrawPayout(
+	ctx sdk.Context,
+	pair common.AssetPair,
+	receiver sdk.AccAddress,
+	amountToWithdraw sdk.Int,
+) (paid sdk.Int, err error) {
+	denom := pair.QuoteDenom()
+	uncovered, err := k.coverVaultShortage(ctx, denom, amountToWithdraw)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	if uncovered.IsPositive() {
+		k.Logger(ctx).Error("funds exhausted, cutting the withdrawal short",
+			"pair", pair.String(), "receiver", receiver.String(), "uncovered", uncovered)
+		amountToWithdraw = amountToWithdraw.Sub(uncovered)
+		if err = ctx.EventManager().EmitTypedEvent(&types.PositionAutoDeleveragedEvent{
+			Pair:                  pair.String(),
+			TraderAddress:         receiver.String(),
+			ExchangedPositionSize: sdk.ZeroDec(),
+			RealizedPnl:           sdk.ZeroDec(),
+			Haircut:               sdk.NewCoin(denom, uncovered),
+			MarkPrice:             sdk.ZeroDec(),
+			BlockHeight:           ctx.BlockHeight(),
+		}); err != nil {
+			return sdk.Int{}, err
+		}
+	}
+
 	if !amountToWithdraw.IsPositive() {
-		return nil
+		return sdk.ZeroInt(), nil
+	}
+	if err = k.sendFromVault(ctx, denom, receiver, amountToWithdraw); err != nil {
+		return sdk.Int{}, err
+	}
+	return amountToWithdraw, nil
+}
+
+// coverVaultShortage withdraws from the insurance fund and the PerpEF what the vault
+// lacks to pay out the amount, and records it as prepaid bad debt. Returns the part of
+// the shortage they couldn't cover.
+func (k Keeper) coverVaultShortage(ctx sdk.Context, denom string, amountToWithdraw sdk.Int) (uncovered sdk.Int, err error) {
+	if !amountToWithdraw.IsPositive() {
+		return sdk.ZeroInt(), nil
 	}
 
 	vaultQuoteBalance := k.BankKeeper.GetBalance(
@@ -37,32 +103,34 @@ func (k Keeper) Withdraw(
 		k.AccountKeeper.GetModuleAddress(types.VaultModuleAccount),
 		denom,
 	)
-	if vaultQuoteBalance.Amount.LT(amountToWithdraw) {
-		// if withdraw amount is larger than entire balance of vault
-		// means this trader's profit comes from other under collateral position's future loss
-		// and the balance of entire vault is not enough
-		// need money from PerpEF to pay first, and record this prepaidBadDebt
-		shortage := amountToWithdraw.Sub(vaultQuoteBalance.Amount)
-		k.IncrementPrepaidBadDebt(ctx, denom, shortage)
-		if err := k.BankKeeper.SendCoinsFromModuleToModule(
-			ctx,
-			types.PerpEFModuleAccount,
-			types.VaultModuleAccount,
-			sdk.NewCoins(
-				sdk.NewCoin(denom, shortage),
-			),
-		); err != nil {
-			return err
-		}
+	if vaultQuoteBalance.Amount.GTE(amountToWithdraw) {
+		return sdk.ZeroInt(), nil
+	}
+
+	// if withdraw amount is larger than entire balance of vault
+	// means this trader's profit comes from other under collateral position's future loss
+	// and the balance of entire vault is not enough
+	// need money from PerpEF to pay first, and record this prepaidBadDebt
+	shortage := amountToWithdraw.Sub(vaultQuoteBalance.Amount)
+	uncovered, err = k.coverBadDebt(ctx, denom, shortage)
+	if err != nil {
+		return sdk.Int{}, err
 	}
+	k.IncrementPrepaidBadDebt(ctx, denom, shortage.Sub(uncovered))
+	return uncovered, nil
+}
 
-	// Transfer from Vault to receiver
+// sendFromVault transfers the amount from the vault to the receiver.
+func (k Keeper) sendFromVault(ctx sdk.Context, denom string, receiver sdk.AccAddress, amount sdk.Int) error {
+	if !amount.IsPositive() {
+		return nil
+	}
 	return k.BankKeeper.SendCoinsFromModuleToAccount(
 		ctx,
 		/* from */ types.VaultModuleAccount,
 		/* to */ receiver,
 		sdk.NewCoins(
-			sdk.NewCoin(denom, amountToWithdraw),
+			sdk.NewCoin(denom, amount),
 		),
 	)
 }
@@ -73,10 +141,11 @@ Prepaid bad debt accrues when more coins are withdrawn from the vault than the
 vault contains, so we "credit" ourselves with prepaid bad debt.
 
 Then, when bad debt is actually realized (by closing underwater positions), we
-can consume the credit we have built before withdrawing more from the ecosystem fund.
+can consume the credit we have built before withdrawing more from the insurance fund
+and the ecosystem fund. Returns the bad debt none of them could cover.
 */
 func (k Keeper) realizeBadDebt(ctx sdk.Context, denom string, badDebtToRealize sdk.Int) (
-	err error,
+	uncovered sdk.Int, err error,
 ) {
 	prepaidBadDebtBalance := k.PrepaidBadDebt.GetOr(ctx, denom, types.PrepaidBadDebt{
 		Denom:  denom,
@@ -94,19 +163,10 @@ func (k Keeper) realizeBadDebt(ctx sdk.Context, denom string, badDebtToRealize s
 			Amount: sdk.ZeroInt(),
 		})
 
-		return k.BankKeeper.SendCoinsFromModuleToModule(ctx,
-			/*from=*/ types.PerpEFModuleAccount,
-			/*to=*/ types.VaultModuleAccount,
-			sdk.NewCoins(
-				sdk.NewCoin(
-					denom,
-					badDebtToRealize.Sub(prepaidBadDebtBalance),
-				),
-			),
-		)
+		return k.coverBadDebt(ctx, denom, badDebtToRealize.Sub(prepaidBadDebtBalance))
 	}
 
-	return nil
+	return sdk.ZeroInt(), nil
 }
 
 // IncrementPrepaidBadDebt increases the bad debt for the provided denom.
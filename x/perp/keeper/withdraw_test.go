@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/perp/types"
 )
 
@@ -77,6 +78,7 @@ func TestWithdraw(t *testing.T) {
 				sdk.NewCoins(sdk.NewInt64Coin(denom, tc.amountToWithdraw)),
 			).Return(nil)
 			if tc.expectedPerpEFWithdrawal > 0 {
+				mockEmptyInsuranceFund(mocks, ctx, denom)
 				mocks.mockBankKeeper.EXPECT().SendCoinsFromModuleToModule(
 					ctx, types.PerpEFModuleAccount, types.VaultModuleAccount,
 					sdk.NewCoins(sdk.NewInt64Coin(denom, tc.expectedPerpEFWithdrawal)),
@@ -98,6 +100,43 @@ func TestWithdraw(t *testing.T) {
 	}
 }
 
+func TestWithdrawPayoutFundsExhausted(t *testing.T) {
+	perpKeeper, mocks, ctx := getKeeper(t)
+	receiver := testutil.AccAddress()
+	denom := "NUSD"
+
+	t.Log("the vault holds 5 and the PerpEF 2 of the 10 to withdraw")
+	vaultAddr := authtypes.NewModuleAddress(types.VaultModuleAccount)
+	mocks.mockAccountKeeper.EXPECT().GetModuleAddress(types.VaultModuleAccount).Return(vaultAddr)
+	mocks.mockBankKeeper.EXPECT().GetBalance(ctx, vaultAddr, denom).Return(sdk.NewInt64Coin(denom, 5))
+	mockBadDebtFunds(mocks, ctx, denom, 0, 2)
+	mocks.mockBankKeeper.EXPECT().SendCoinsFromModuleToModule(
+		ctx, types.PerpEFModuleAccount, types.VaultModuleAccount,
+		sdk.NewCoins(sdk.NewInt64Coin(denom, 2)),
+	).Return(nil)
+
+	t.Log("the payout is cut short by the 3 left uncovered")
+	mocks.mockBankKeeper.EXPECT().SendCoinsFromModuleToAccount(
+		ctx, types.VaultModuleAccount, receiver,
+		sdk.NewCoins(sdk.NewInt64Coin(denom, 7)),
+	).Return(nil)
+	_, err := perpKeeper.withdrawPayout(ctx, common.AssetPair{Token0: "BTC", Token1: denom}, receiver, sdk.NewInt(10))
+	require.NoError(t, err)
+
+	prepaidBadDebt, err := perpKeeper.PrepaidBadDebt.Get(ctx, denom)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, prepaidBadDebt.Amount.Int64())
+	testutil.RequireContainsTypedEvent(t, ctx, &types.PositionAutoDeleveragedEvent{
+		Pair:                  "BTC:NUSD",
+		TraderAddress:         receiver.String(),
+		ExchangedPositionSize: sdk.ZeroDec(),
+		RealizedPnl:           sdk.ZeroDec(),
+		Haircut:               sdk.NewInt64Coin(denom, 3),
+		MarkPrice:             sdk.ZeroDec(),
+		BlockHeight:           ctx.BlockHeight(),
+	})
+}
+
 func TestRealizeBadDebt(t *testing.T) {
 	tests := []struct {
 		name                  string
@@ -146,6 +185,7 @@ func TestRealizeBadDebt(t *testing.T) {
 
 			if tc.expectedPerpEFWithdrawal > 0 {
 				t.Log("mock bank keeper")
+				mockEmptyInsuranceFund(mocks, ctx, denom)
 				mocks.mockBankKeeper.EXPECT().SendCoinsFromModuleToModule(
 					ctx, types.PerpEFModuleAccount, types.VaultModuleAccount,
 					sdk.NewCoins(sdk.NewInt64Coin(denom, tc.expectedPerpEFWithdrawal)),
@@ -159,8 +199,9 @@ func TestRealizeBadDebt(t *testing.T) {
 			})
 
 			t.Log("execute withdrawal")
-			err := perpKeeper.realizeBadDebt(ctx, denom, sdk.NewInt(tc.badDebtToRealize))
+			uncovered, err := perpKeeper.realizeBadDebt(ctx, denom, sdk.NewInt(tc.badDebtToRealize))
 			require.NoError(t, err)
+			assert.True(t, uncovered.IsZero())
 
 			t.Log("assert new prepaid bad debt")
 			prepaidBadDebt, err := perpKeeper.PrepaidBadDebt.Get(ctx, denom)
@@ -185,3 +226,26 @@ func TestIncrementDecrementBadDebt(t *testing.T) {
 	bd = k.DecrementPrepaidBadDebt(ctx, "unibi", sdk.NewInt(2000))
 	require.Equal(t, sdk.ZeroInt(), bd)
 }
+
+// mockEmptyInsuranceFund expects the insurance fund and PerpEF balances of the denom to be
+// read when bad debt is covered, and returns an empty insurance fund and a PerpEF holding
+// enough to cover it.
+func mockEmptyInsuranceFund(mocks mockedDependencies, ctx sdk.Context, denom string) {
+	mockBadDebtFunds(mocks, ctx, denom, 0, 1_000_000_000)
+}
+
+// mockBadDebtFunds expects the insurance fund and PerpEF balances of the denom to be read
+// when bad debt is covered, and returns the given balances.
+func mockBadDebtFunds(mocks mockedDependencies, ctx sdk.Context, denom string, insuranceFund int64, ecosystemFund int64) {
+	insuranceFundAddr := authtypes.NewModuleAddress(types.InsuranceFundModuleAccount)
+	mocks.mockAccountKeeper.EXPECT().GetModuleAddress(types.InsuranceFundModuleAccount).
+		Return(insuranceFundAddr)
+	mocks.mockBankKeeper.EXPECT().GetBalance(ctx, insuranceFundAddr, denom).
+		Return(sdk.NewInt64Coin(denom, insuranceFund))
+
+	ecosystemFundAddr := authtypes.NewModuleAddress(types.PerpEFModuleAccount)
+	mocks.mockAccountKeeper.EXPECT().GetModuleAddress(types.PerpEFModuleAccount).
+		Return(ecosystemFundAddr)
+	mocks.mockBankKeeper.EXPECT().GetBalance(ctx, ecosystemFundAddr, denom).
+		Return(sdk.NewInt64Coin(denom, ecosystemFund))
+}
@@ -0,0 +1,139 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/NibiruChain/nibiru/x/common"
+)
+
+var _ authz.Authorization = &TradingAuthorization{}
+
+// isTradingMsgTypeURL tells whether a TradingAuthorization can grant the msg.
+// None of the granted msgs takes funds out of the granter's accounts.
+func isTradingMsgTypeURL(msgTypeURL string) bool {
+	switch msgTypeURL {
+	case sdk.MsgTypeURL(&MsgOpenPosition{}),
+		sdk.MsgTypeURL(&MsgClosePosition{}),
+		sdk.MsgTypeURL(&MsgAddMargin{}):
+		return true
+	default:
+		return false
+	}
+}
+
+// NewTradingAuthorization creates a TradingAuthorization of the msg type,
+// on any pair and any subaccount.
+func NewTradingAuthorization(msgTypeURL string, maxLeverage sdk.Dec, maxNotional sdk.Int) *TradingAuthorization {
+	return &TradingAuthorization{
+		MsgTypeUrl:  msgTypeURL,
+		MaxLeverage: maxLeverage,
+		MaxNotional: maxNotional,
+	}
+}
+
+// MsgTypeURL implements authz.Authorization.
+func (a TradingAuthorization) MsgTypeURL() string {
+	return a.MsgTypeUrl
+}
+
+// Accept implements authz.Authorization. It accepts the msgs on the allowed
+// pairs and subaccounts, and the positions opened within the leverage and
+// notional caps. Like the spend limit of a SendAuthorization, the notional
+// cap is used up by the positions opened, and the authorization is deleted
+// once nothing is left of it.
+func (a TradingAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	if sdk.MsgTypeURL(msg) != a.MsgTypeUrl {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrapf("type mismatch: %s", sdk.MsgTypeURL(msg))
+	}
+
+	var tokenPair string
+	var subaccountID uint64
+	usedNotional := sdk.ZeroInt()
+	switch msg := msg.(type) {
+	case *MsgOpenPosition:
+		if msg.Leverage.GT(a.MaxLeverage) {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf(
+				"leverage %s is over the granted max leverage %s", msg.Leverage, a.MaxLeverage)
+		}
+		notional := msg.Leverage.MulInt(msg.QuoteAssetAmount).Ceil().TruncateInt()
+		if a.MaxNotional.IsPositive() {
+			if notional.GT(a.MaxNotional) {
+				return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf(
+					"notional %s is over the granted notional left %s", notional, a.MaxNotional)
+			}
+			usedNotional = notional
+		}
+		tokenPair, subaccountID = msg.TokenPair, msg.SubaccountId
+	case *MsgClosePosition:
+		tokenPair, subaccountID = msg.TokenPair, msg.SubaccountId
+	case *MsgAddMargin:
+		tokenPair, subaccountID = msg.TokenPair, msg.SubaccountId
+	default:
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrapf("type mismatch: %s", sdk.MsgTypeURL(msg))
+	}
+
+	if !a.allowsPair(tokenPair) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("pair %s is not granted", tokenPair)
+	}
+	if !a.allowsSubaccount(subaccountID) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("subaccount %d is not granted", subaccountID)
+	}
+
+	if !usedNotional.IsPositive() {
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+	notionalLeft := a.MaxNotional.Sub(usedNotional)
+	if notionalLeft.IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+	updated := a
+	updated.MaxNotional = notionalLeft
+	return authz.AcceptResponse{Accept: true, Updated: &updated}, nil
+}
+
+// ValidateBasic implements authz.Authorization.
+func (a TradingAuthorization) ValidateBasic() error {
+	if !isTradingMsgTypeURL(a.MsgTypeUrl) {
+		return sdkerrors.ErrInvalidType.Wrapf("%s can't be granted by a trading authorization", a.MsgTypeUrl)
+	}
+	if a.MaxLeverage.IsNil() || !a.MaxLeverage.IsPositive() {
+		return fmt.Errorf("max leverage must be positive, got %s", a.MaxLeverage)
+	}
+	if a.MaxNotional.IsNil() || a.MaxNotional.IsNegative() {
+		return fmt.Errorf("max notional must not be negative, got %s", a.MaxNotional)
+	}
+	for _, tokenPair := range a.TokenPairs {
+		if _, err := common.NewAssetPair(tokenPair); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a TradingAuthorization) allowsPair(tokenPair string) bool {
+	if len(a.TokenPairs) == 0 {
+		return true
+	}
+	for _, allowed := range a.TokenPairs {
+		if allowed == tokenPair {
+			return true
+		}
+	}
+	return false
+}
+
+func (a TradingAuthorization) allowsSubaccount(id uint64) bool {
+	if len(a.SubaccountIds) == 0 {
+		return true
+	}
+	for _, allowed := range a.SubaccountIds {
+		if allowed == id {
+			return true
+		}
+	}
+	return false
+}
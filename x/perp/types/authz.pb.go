@@ -0,0 +1,598 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: perp/v1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TradingAuthorization lets the grantee open positions, close positions and add
+// margin on behalf of the granter and its subaccounts, within a leverage and a
+// notional cap. It never lets the grantee take funds out.
+type TradingAuthorization struct {
+	// type URL of the granted msg, one of MsgOpenPosition, MsgClosePosition and
+	// MsgAddMargin
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// maximum leverage of the positions opened by the grantee
+	MaxLeverage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_leverage,json=maxLeverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_leverage"`
+	// notional, the quote amount times the leverage, the grantee can still open
+	// across all its position changes. Each position opened uses it up, and the
+	// authorization is deleted once it reaches zero. Zero for no cap.
+	MaxNotional github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=max_notional,json=maxNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_notional"`
+	// pairs the grantee can trade, all of them if empty
+	TokenPairs []string `protobuf:"bytes,4,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs,omitempty"`
+	// subaccounts of the granter the grantee can trade on, all of them if empty
+	SubaccountIds []uint64 `protobuf:"varint,5,rep,packed,name=subaccount_ids,json=subaccountIds,proto3" json:"subaccount_ids,omitempty"`
+}
+
+func (m *TradingAuthorization) Reset()         { *m = TradingAuthorization{} }
+func (m *TradingAuthorization) String() string { return proto.CompactTextString(m) }
+func (*TradingAuthorization) ProtoMessage()    {}
+func (*TradingAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58a015930f356eb7, []int{0}
+}
+func (m *TradingAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradingAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradingAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TradingAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradingAuthorization.Merge(m, src)
+}
+func (m *TradingAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradingAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradingAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradingAuthorization proto.InternalMessageInfo
+
+func (m *TradingAuthorization) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *TradingAuthorization) GetTokenPairs() []string {
+	if m != nil {
+		return m.TokenPairs
+	}
+	return nil
+}
+
+func (m *TradingAuthorization) GetSubaccountIds() []uint64 {
+	if m != nil {
+		return m.SubaccountIds
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*TradingAuthorization)(nil), "nibiru.perp.v1.TradingAuthorization")
+}
+
+func init() { proto.RegisterFile("perp/v1/authz.proto", fileDescriptor_58a015930f356eb7) }
+
+var fileDescriptor_58a015930f356eb7 = []byte{
+	// 348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xcd, 0x4a, 0xeb, 0x40,
+	0x1c, 0xc5, 0x93, 0xb6, 0xf7, 0xc2, 0x4d, 0x3f, 0xe0, 0xc6, 0x2e, 0x62, 0x17, 0x69, 0x10, 0x94,
+	0x22, 0x34, 0xa1, 0xb8, 0x73, 0x67, 0xed, 0xa6, 0x20, 0x45, 0x4b, 0xdd, 0xb8, 0x09, 0x93, 0x64,
+	0x48, 0x86, 0x26, 0x33, 0x61, 0x3e, 0x4a, 0xdb, 0xa7, 0xf0, 0x61, 0xdc, 0xf8, 0x06, 0x5d, 0x16,
+	0x57, 0xe2, 0xa2, 0x48, 0xfb, 0x22, 0x32, 0x93, 0x88, 0xba, 0x74, 0x35, 0x33, 0xe7, 0xfc, 0xf9,
+	0xcd, 0x99, 0x39, 0xc6, 0x51, 0x0e, 0x69, 0xee, 0x2d, 0x06, 0x1e, 0x10, 0x3c, 0x59, 0xbb, 0x39,
+	0x25, 0x9c, 0x98, 0x2d, 0x8c, 0x02, 0x44, 0x85, 0x2b, 0x3d, 0x77, 0x31, 0xe8, 0xb4, 0x63, 0x12,
+	0x13, 0x65, 0x79, 0x72, 0x57, 0x4c, 0x75, 0x8e, 0x43, 0xc2, 0x32, 0xc2, 0xfc, 0xc2, 0x28, 0x0e,
+	0x85, 0x75, 0xf2, 0x5c, 0x31, 0xda, 0x33, 0x0a, 0x22, 0x84, 0xe3, 0x2b, 0xc1, 0x13, 0x42, 0xd1,
+	0x1a, 0x70, 0x44, 0xb0, 0xe9, 0x18, 0x8d, 0x8c, 0xc5, 0x3e, 0x5f, 0xe5, 0xd0, 0x17, 0x34, 0xb5,
+	0x74, 0x47, 0xef, 0xfd, 0x9b, 0x1a, 0x19, 0x8b, 0x67, 0xab, 0x1c, 0xde, 0xd3, 0xd4, 0xbc, 0x33,
+	0x1a, 0x19, 0x58, 0xfa, 0x29, 0x5c, 0x40, 0x0a, 0x62, 0x68, 0x55, 0xe4, 0xc4, 0xd0, 0xdd, 0xec,
+	0xba, 0xda, 0xdb, 0xae, 0x7b, 0x16, 0x23, 0x9e, 0x88, 0xc0, 0x0d, 0x49, 0x56, 0xde, 0x58, 0x2e,
+	0x7d, 0x16, 0xcd, 0x3d, 0x89, 0x65, 0xee, 0x08, 0x86, 0xd3, 0x7a, 0x06, 0x96, 0x37, 0x25, 0xe2,
+	0x13, 0x89, 0x89, 0x8c, 0x00, 0x52, 0xab, 0xfa, 0x6b, 0xe4, 0x18, 0x73, 0x85, 0x9c, 0x94, 0x08,
+	0xb3, 0x6b, 0xd4, 0x39, 0x99, 0x43, 0xec, 0xe7, 0x00, 0x51, 0x66, 0xd5, 0x9c, 0xaa, 0x7c, 0x86,
+	0x92, 0x6e, 0xa5, 0x62, 0x9e, 0x1a, 0x2d, 0x26, 0x02, 0x10, 0x86, 0x44, 0x60, 0xee, 0xa3, 0x88,
+	0x59, 0x7f, 0x9c, 0x6a, 0xaf, 0x36, 0x6d, 0x7e, 0xa9, 0xe3, 0x88, 0x5d, 0xfe, 0x7f, 0x79, 0xea,
+	0x37, 0x7f, 0x7c, 0xd1, 0x70, 0xb4, 0xd9, 0xdb, 0xfa, 0x76, 0x6f, 0xeb, 0xef, 0x7b, 0x5b, 0x7f,
+	0x3c, 0xd8, 0xda, 0xf6, 0x60, 0x6b, 0xaf, 0x07, 0x5b, 0x7b, 0x38, 0xff, 0x96, 0x74, 0xa2, 0x1a,
+	0xba, 0x4e, 0x00, 0xc2, 0x5e, 0xd1, 0x96, 0xb7, 0xf4, 0x54, 0x97, 0x2a, 0x71, 0xf0, 0x57, 0x15,
+	0x71, 0xf1, 0x31, 0x00, 0xb3, 0xce, 0xb9, 0x31, 0xe0, 0x01, 0x00, 0x00,
+}
+
+func (m *TradingAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradingAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradingAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubaccountIds) > 0 {
+		dAtA2 := make([]byte, len(m.SubaccountIds)*10)
+		var j1 int
+		for _, num := range m.SubaccountIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintAuthz(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TokenPairs) > 0 {
+		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenPairs[iNdEx])
+			copy(dAtA[i:], m.TokenPairs[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.TokenPairs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.MaxNotional.Size()
+		i -= size
+		if _, err := m.MaxNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthz(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxLeverage.Size()
+		i -= size
+		if _, err := m.MaxLeverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthz(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TradingAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = m.MaxLeverage.Size()
+	n += 1 + l + sovAuthz(uint64(l))
+	l = m.MaxNotional.Size()
+	n += 1 + l + sovAuthz(uint64(l))
+	if len(m.TokenPairs) > 0 {
+		for _, s := range m.TokenPairs {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.SubaccountIds) > 0 {
+		l = 0
+		for _, e := range m.SubaccountIds {
+			l += sovAuthz(uint64(e))
+		}
+		n += 1 + sovAuthz(uint64(l)) + l
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TradingAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradingAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradingAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxLeverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPairs = append(m.TokenPairs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SubaccountIds = append(m.SubaccountIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SubaccountIds) == 0 {
+					m.SubaccountIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuthz
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SubaccountIds = append(m.SubaccountIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
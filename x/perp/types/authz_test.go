@@ -0,0 +1,162 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/testutil"
+)
+
+func TestTradingAuthorization_ValidateBasic(t *testing.T) {
+	openPosition := sdk.MsgTypeURL(&MsgOpenPosition{})
+
+	cases := map[string]struct {
+		authorization *TradingAuthorization
+		wantErr       bool
+	}{
+		"open position": {
+			authorization: NewTradingAuthorization(openPosition, sdk.NewDec(5), sdk.NewInt(1_000)),
+		},
+		"close position without a notional cap": {
+			authorization: NewTradingAuthorization(sdk.MsgTypeURL(&MsgClosePosition{}), sdk.NewDec(5), sdk.ZeroInt()),
+		},
+		"remove margin can't be granted": {
+			authorization: NewTradingAuthorization(sdk.MsgTypeURL(&MsgRemoveMargin{}), sdk.NewDec(5), sdk.ZeroInt()),
+			wantErr:       true,
+		},
+		"subaccount transfers can't be granted": {
+			authorization: NewTradingAuthorization(sdk.MsgTypeURL(&MsgTransferSubaccountMargin{}), sdk.NewDec(5), sdk.ZeroInt()),
+			wantErr:       true,
+		},
+		"zero max leverage": {
+			authorization: NewTradingAuthorization(openPosition, sdk.ZeroDec(), sdk.ZeroInt()),
+			wantErr:       true,
+		},
+		"negative max notional": {
+			authorization: NewTradingAuthorization(openPosition, sdk.NewDec(5), sdk.NewInt(-1)),
+			wantErr:       true,
+		},
+		"invalid pair": {
+			authorization: &TradingAuthorization{
+				MsgTypeUrl:  openPosition,
+				MaxLeverage: sdk.NewDec(5),
+				MaxNotional: sdk.ZeroInt(),
+				TokenPairs:  []string{"ubtc"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.authorization.ValidateBasic()
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTradingAuthorization_Accept(t *testing.T) {
+	granter := testutil.AccAddress().String()
+	authorization := &TradingAuthorization{
+		MsgTypeUrl:    sdk.MsgTypeURL(&MsgOpenPosition{}),
+		MaxLeverage:   sdk.NewDec(5),
+		MaxNotional:   sdk.NewInt(1_000),
+		TokenPairs:    []string{"ubtc:unusd"},
+		SubaccountIds: []uint64{1, 2},
+	}
+	openPosition := func(quoteAmount int64, leverage int64, tokenPair string, subaccountID uint64) *MsgOpenPosition {
+		return &MsgOpenPosition{
+			Sender:           granter,
+			TokenPair:        tokenPair,
+			Side:             Side_BUY,
+			QuoteAssetAmount: sdk.NewInt(quoteAmount),
+			Leverage:         sdk.NewDec(leverage),
+			SubaccountId:     subaccountID,
+		}
+	}
+
+	cases := map[string]struct {
+		msg              sdk.Msg
+		wantErr          error
+		wantNotionalLeft sdk.Int
+		wantDelete       bool
+	}{
+		"within the caps": {
+			msg:              openPosition(100, 5, "ubtc:unusd", 1),
+			wantNotionalLeft: sdk.NewInt(500),
+		},
+		"uses up the notional": {
+			msg:        openPosition(200, 5, "ubtc:unusd", 1),
+			wantDelete: true,
+		},
+		"close position under an open position grant": {
+			msg:     &MsgClosePosition{Sender: granter, TokenPair: "ubtc:unusd", SubaccountId: 1},
+			wantErr: sdkerrors.ErrInvalidType,
+		},
+		"over the max leverage": {
+			msg:     openPosition(100, 6, "ubtc:unusd", 1),
+			wantErr: sdkerrors.ErrUnauthorized,
+		},
+		"over the max notional": {
+			msg:     openPosition(201, 5, "ubtc:unusd", 1),
+			wantErr: sdkerrors.ErrUnauthorized,
+		},
+		"pair not granted": {
+			msg:     openPosition(100, 1, "ueth:unusd", 1),
+			wantErr: sdkerrors.ErrUnauthorized,
+		},
+		"subaccount not granted": {
+			msg:     openPosition(100, 1, "ubtc:unusd", 0),
+			wantErr: sdkerrors.ErrUnauthorized,
+		},
+		"other msg type": {
+			msg: &MsgRemoveMargin{
+				Sender:    granter,
+				TokenPair: "ubtc:unusd",
+				Margin:    sdk.NewInt64Coin("unusd", 100),
+			},
+			wantErr: sdkerrors.ErrInvalidType,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp, err := authorization.Accept(sdk.Context{}, tc.msg)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, resp.Accept)
+			assert.Equal(t, tc.wantDelete, resp.Delete)
+			if tc.wantNotionalLeft.IsNil() {
+				assert.Nil(t, resp.Updated)
+				return
+			}
+			require.NotNil(t, resp.Updated)
+			assert.Equal(t, tc.wantNotionalLeft, resp.Updated.(*TradingAuthorization).MaxNotional)
+		})
+	}
+
+	t.Run("close position doesn't use up the notional", func(t *testing.T) {
+		closeAuthorization := *authorization
+		closeAuthorization.MsgTypeUrl = sdk.MsgTypeURL(&MsgClosePosition{})
+		resp, err := closeAuthorization.Accept(sdk.Context{}, &MsgClosePosition{
+			Sender:       granter,
+			TokenPair:    "ubtc:unusd",
+			SubaccountId: 1,
+		})
+		require.NoError(t, err)
+		assert.True(t, resp.Accept)
+		assert.False(t, resp.Delete)
+		assert.Nil(t, resp.Updated)
+	})
+}
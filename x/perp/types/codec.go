@@ -5,6 +5,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
@@ -12,6 +13,16 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddMargin{}, "perp/add_margin", nil)
 	cdc.RegisterConcrete(&MsgLiquidate{}, "perp/liquidate", nil)
 	cdc.RegisterConcrete(&MsgClosePosition{}, "perp/close_position", nil)
+	cdc.RegisterConcrete(&MsgPlaceOrder{}, "perp/place_order", nil)
+	cdc.RegisterConcrete(&MsgCancelOrder{}, "perp/cancel_order", nil)
+	cdc.RegisterConcrete(&MsgSetMarginMode{}, "perp/set_margin_mode", nil)
+	cdc.RegisterConcrete(&MsgSettlePosition{}, "perp/settle_position", nil)
+	cdc.RegisterConcrete(&MsgDepositToInsuranceFund{}, "perp/deposit_to_insurance_fund", nil)
+	cdc.RegisterConcrete(&MsgRequestInsuranceFundWithdrawal{}, "perp/request_insurance_fund_withdrawal", nil)
+	cdc.RegisterConcrete(&MsgWithdrawFromInsuranceFund{}, "perp/withdraw_from_insurance_fund", nil)
+	cdc.RegisterConcrete(&MsgSetTradingHalt{}, "perp/set_trading_halt", nil)
+	cdc.RegisterConcrete(&MsgTransferSubaccountMargin{}, "perp/transfer_subaccount_margin", nil)
+	cdc.RegisterConcrete(&TradingAuthorization{}, "perp/TradingAuthorization", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -24,6 +35,19 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgOpenPosition{},
 		&MsgClosePosition{},
 		&MsgMultiLiquidate{},
+		&MsgPlaceOrder{},
+		&MsgCancelOrder{},
+		&MsgSetMarginMode{},
+		&MsgSettlePosition{},
+		&MsgDepositToInsuranceFund{},
+		&MsgRequestInsuranceFundWithdrawal{},
+		&MsgWithdrawFromInsuranceFund{},
+		&MsgSetTradingHalt{},
+		&MsgTransferSubaccountMargin{},
+	)
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&TradingAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
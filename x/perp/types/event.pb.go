@@ -66,6 +66,9 @@ type PositionChangedEvent struct {
 	BlockHeight int64 `protobuf:"varint,14,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 	// The block time in unix milliseconds at which this position was changed.
 	BlockTimeMs int64 `protobuf:"varint,15,opt,name=block_time_ms,json=blockTimeMs,proto3" json:"block_time_ms,omitempty"`
+	// quote amount exchanged by the position change, less than the position
+	// notional for a partial close.
+	ExchangedNotional github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=exchanged_notional,json=exchangedNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_notional"`
 }
 
 func (m *PositionChangedEvent) Reset()         { *m = PositionChangedEvent{} }
@@ -420,443 +423,897 @@ func (m *FundingRateChangedEvent) GetBlockTimeMs() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*PositionChangedEvent)(nil), "nibiru.perp.v1.PositionChangedEvent")
-	proto.RegisterType((*PositionLiquidatedEvent)(nil), "nibiru.perp.v1.PositionLiquidatedEvent")
-	proto.RegisterType((*PositionSettledEvent)(nil), "nibiru.perp.v1.PositionSettledEvent")
-	proto.RegisterType((*FundingRateChangedEvent)(nil), "nibiru.perp.v1.FundingRateChangedEvent")
+// Emitted when a resting order is triggered and executed.
+type OrderFilledEvent struct {
+	// Identifier of the order.
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// Identifier for the virtual pool of the order.
+	Pair string `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	// Owner of the order.
+	TraderAddress string    `protobuf:"bytes,3,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	OrderType     OrderType `protobuf:"varint,4,opt,name=order_type,json=orderType,proto3,enum=nibiru.perp.v1.OrderType" json:"order_type,omitempty"`
+	Side          Side      `protobuf:"varint,5,opt,name=side,proto3,enum=nibiru.perp.v1.Side" json:"side,omitempty"`
+	// The mark price at which the order was set to trigger.
+	TriggerPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=trigger_price,json=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price"`
+	// The mark price that triggered the order.
+	MarkPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=mark_price,json=markPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mark_price"`
+	// The block number at which the order was filled.
+	BlockHeight int64 `protobuf:"varint,8,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func init() { proto.RegisterFile("perp/v1/event.proto", fileDescriptor_19b7f9ebcf2fdb5b) }
-
-var fileDescriptor_19b7f9ebcf2fdb5b = []byte{
-	// 973 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0xdd, 0x6e, 0xdb, 0x36,
-	0x14, 0xc7, 0xe3, 0xc6, 0x4b, 0x1c, 0xfa, 0x23, 0x0b, 0xe3, 0x34, 0x6a, 0x57, 0x38, 0x9e, 0xb0,
-	0x0d, 0xc1, 0x80, 0x4a, 0xc8, 0x76, 0xd7, 0xbb, 0x7c, 0x34, 0xc8, 0x45, 0xdb, 0xb9, 0x4a, 0x80,
-	0x01, 0x1b, 0x30, 0x8d, 0x92, 0x8e, 0x65, 0x22, 0x12, 0xa9, 0x8a, 0x94, 0x11, 0xe7, 0x09, 0x76,
-	0xb9, 0xe7, 0xd8, 0x93, 0xf4, 0x62, 0x17, 0xc5, 0xae, 0x86, 0x62, 0xc8, 0x86, 0xe4, 0x0d, 0xf6,
-	0x04, 0x83, 0x44, 0xc9, 0x1f, 0x09, 0x90, 0xb4, 0x8a, 0x77, 0x25, 0xfb, 0x1c, 0xf1, 0x77, 0x0e,
-	0x8f, 0x78, 0xfe, 0x24, 0xd1, 0x7a, 0x04, 0x71, 0x64, 0x0e, 0x77, 0x4c, 0x18, 0x02, 0x93, 0x46,
-	0x14, 0x73, 0xc9, 0x71, 0x8b, 0x51, 0x87, 0xc6, 0x89, 0x91, 0xfa, 0x8c, 0xe1, 0xce, 0xe3, 0xb6,
-	0xcf, 0x7d, 0x9e, 0xb9, 0xcc, 0xf4, 0x97, 0x7a, 0xeb, 0xf1, 0x13, 0x9f, 0x73, 0x3f, 0x00, 0x93,
-	0x44, 0xd4, 0x24, 0x8c, 0x71, 0x49, 0x24, 0xe5, 0x4c, 0xe4, 0xde, 0x8e, 0xcb, 0x45, 0xc8, 0x85,
-	0xe9, 0x10, 0x01, 0xe6, 0x70, 0xc7, 0x01, 0x49, 0x76, 0x4c, 0x97, 0x53, 0x96, 0xfb, 0xd7, 0x5d,
-	0x1e, 0x86, 0x9c, 0x99, 0xea, 0x51, 0x18, 0x8b, 0x6c, 0x84, 0x24, 0x12, 0x94, 0x51, 0x7f, 0x5f,
-	0x43, 0xed, 0x1e, 0x17, 0x34, 0xa5, 0xef, 0x0f, 0x08, 0xf3, 0xc1, 0x7b, 0x9e, 0x26, 0x8b, 0x31,
-	0xaa, 0x46, 0x84, 0xc6, 0x5a, 0xa5, 0x5b, 0xd9, 0x5e, 0xb1, 0xb2, 0xdf, 0xf8, 0x4b, 0xd4, 0x92,
-	0x31, 0xf1, 0x20, 0xb6, 0x89, 0xe7, 0xc5, 0x20, 0x84, 0xf6, 0x20, 0xf3, 0x36, 0x95, 0x75, 0x57,
-	0x19, 0xf1, 0x11, 0x5a, 0x0a, 0x49, 0xec, 0x53, 0xa6, 0x2d, 0x76, 0x2b, 0xdb, 0xf5, 0x6f, 0x1e,
-	0x19, 0x2a, 0x5d, 0x23, 0x4d, 0xd7, 0xc8, 0xd3, 0x35, 0xf6, 0x39, 0x65, 0x7b, 0x1b, 0x6f, 0x2f,
-	0xb6, 0x16, 0xfe, 0xbd, 0xd8, 0x6a, 0x8e, 0x48, 0x18, 0x3c, 0xd3, 0xd5, 0x30, 0xdd, 0xca, 0xc7,
-	0xe3, 0x1f, 0xd1, 0x5a, 0x94, 0x27, 0x67, 0x33, 0x9e, 0x3e, 0x48, 0xa0, 0x55, 0xd3, 0x98, 0x7b,
-	0x46, 0x3a, 0xf2, 0xfd, 0xc5, 0xd6, 0x57, 0x3e, 0x95, 0x83, 0xc4, 0x31, 0x5c, 0x1e, 0x9a, 0x79,
-	0x55, 0xd4, 0xe3, 0xa9, 0xf0, 0x4e, 0x4d, 0x39, 0x8a, 0x40, 0x18, 0x07, 0xe0, 0x5a, 0x9f, 0x16,
-	0xa0, 0x57, 0x39, 0x07, 0xf7, 0xd1, 0x26, 0x9c, 0xb9, 0x6a, 0xce, 0xf6, 0x38, 0x8c, 0xa0, 0xe7,
-	0xa0, 0x7d, 0x52, 0x2a, 0xc4, 0xc6, 0x18, 0x57, 0x54, 0xf4, 0x98, 0x9e, 0x03, 0x76, 0xd0, 0xaa,
-	0x8c, 0x09, 0x13, 0xc4, 0xcd, 0x02, 0xf4, 0x01, 0xb4, 0xa5, 0xbb, 0xea, 0xd2, 0xc9, 0xeb, 0xf2,
-	0x50, 0xd5, 0xe5, 0xda, 0x78, 0xdd, 0x6a, 0x4d, 0x59, 0x0e, 0x01, 0xf0, 0x31, 0x6a, 0xce, 0xce,
-	0x60, 0xb9, 0xd4, 0x0c, 0x1a, 0xd1, 0x74, 0xe2, 0xaf, 0x51, 0x23, 0x06, 0x12, 0xd0, 0xf3, 0xb4,
-	0x3e, 0x2c, 0xd0, 0x6a, 0xa5, 0x98, 0xf5, 0x82, 0xd1, 0x63, 0x01, 0xfe, 0x19, 0xb5, 0x13, 0x36,
-	0x0d, 0xb5, 0x49, 0x5f, 0x42, 0xac, 0xad, 0x94, 0x42, 0xe3, 0x09, 0xab, 0xc7, 0x82, 0xdd, 0x94,
-	0x84, 0x9f, 0xa1, 0x9a, 0x43, 0x3c, 0xdb, 0x03, 0x47, 0x6a, 0xe8, 0xae, 0x32, 0x57, 0xd3, 0x80,
-	0xd6, 0xb2, 0x43, 0xbc, 0x03, 0x70, 0x24, 0xb6, 0xd1, 0x7a, 0x40, 0xdf, 0x24, 0xd4, 0xcb, 0x9a,
-	0xcd, 0x8e, 0x80, 0x91, 0x40, 0x8e, 0xb4, 0x7a, 0xb9, 0xe4, 0xa6, 0x50, 0x3d, 0x45, 0xc2, 0x2f,
-	0x11, 0x0a, 0x49, 0x7c, 0x6a, 0x47, 0x31, 0x75, 0x41, 0x6b, 0x94, 0xe2, 0xae, 0xa4, 0x84, 0x5e,
-	0x0a, 0xc0, 0xdf, 0xa3, 0xd5, 0x7e, 0xc2, 0x3c, 0xca, 0x7c, 0x3b, 0x22, 0xa3, 0x10, 0x98, 0xd4,
-	0x9a, 0xa5, 0x98, 0xad, 0x1c, 0xd3, 0x53, 0x14, 0xfc, 0x39, 0x6a, 0x38, 0x01, 0x77, 0x4f, 0xed,
-	0x01, 0x50, 0x7f, 0x20, 0xb5, 0x56, 0xb7, 0xb2, 0xbd, 0x68, 0xd5, 0x33, 0xdb, 0x51, 0x66, 0xc2,
-	0x3a, 0x6a, 0xaa, 0x57, 0x24, 0x0d, 0xc1, 0x0e, 0x85, 0xb6, 0x3a, 0xf5, 0xce, 0x09, 0x0d, 0xe1,
-	0xa5, 0xd0, 0xff, 0xa8, 0xa1, 0xcd, 0xa2, 0x15, 0x5e, 0xe4, 0xd5, 0x98, 0x83, 0xbe, 0x78, 0xe8,
-	0xe1, 0xa4, 0x71, 0xdf, 0x24, 0x5c, 0x82, 0x4d, 0x42, 0x9e, 0x30, 0x99, 0xe9, 0xcd, 0xc7, 0xcf,
-	0xbe, 0x3d, 0xa6, 0xbd, 0x4e, 0x61, 0xbb, 0x19, 0xeb, 0x36, 0x79, 0xa8, 0xce, 0x53, 0x1e, 0x9e,
-	0xa2, 0xf1, 0x4a, 0xe1, 0x93, 0x89, 0x67, 0x0a, 0x64, 0xad, 0x4d, 0x3c, 0xc5, 0xe4, 0x7d, 0xb4,
-	0xd6, 0x07, 0xb0, 0x25, 0xb7, 0x27, 0xbe, 0xbb, 0xf5, 0xa4, 0x9b, 0xeb, 0x89, 0xa6, 0xf4, 0xe4,
-	0x06, 0x41, 0xb7, 0x56, 0xfb, 0x00, 0x27, 0xfc, 0xc5, 0xd8, 0x82, 0x63, 0xb4, 0x91, 0xbf, 0x06,
-	0x2e, 0x17, 0x23, 0x21, 0x21, 0xb4, 0xd3, 0x65, 0x92, 0x49, 0xcb, 0xad, 0xc1, 0xbe, 0xc8, 0x83,
-	0x3d, 0x99, 0x09, 0x36, 0x4b, 0xd1, 0x2d, 0x9c, 0x05, 0x7c, 0x5e, 0x58, 0x0f, 0x13, 0xe6, 0xcd,
-	0x34, 0x6f, 0xed, 0x23, 0x9b, 0x77, 0xb2, 0xeb, 0xac, 0xfc, 0x1f, 0xbb, 0x0e, 0x9a, 0xd3, 0xae,
-	0x73, 0x43, 0xa9, 0xeb, 0x73, 0x50, 0xea, 0x13, 0xd4, 0x9c, 0x91, 0xc2, 0x92, 0xd2, 0x32, 0x0b,
-	0xb9, 0xa6, 0x56, 0xcd, 0xfb, 0xaa, 0xd5, 0x9c, 0x44, 0xe5, 0xaf, 0xca, 0xe4, 0xc4, 0x72, 0x0c,
-	0x52, 0x06, 0x73, 0x50, 0x94, 0x5f, 0x2a, 0xa8, 0x29, 0x14, 0xcb, 0x4e, 0x8f, 0x51, 0x42, 0x5b,
-	0xec, 0x2e, 0xde, 0xbe, 0x86, 0x8e, 0xf2, 0x35, 0xd4, 0x56, 0x6b, 0x68, 0x66, 0xb4, 0xfe, 0xdb,
-	0xdf, 0x5b, 0xdb, 0x1f, 0x50, 0xa0, 0x14, 0x24, 0xac, 0x46, 0x3e, 0x36, 0xfb, 0xa7, 0xff, 0x5e,
-	0x45, 0x9b, 0x87, 0x4a, 0x8d, 0x2d, 0x22, 0xe1, 0xce, 0x33, 0xd9, 0xec, 0x47, 0x7a, 0x70, 0xdf,
-	0x8f, 0xf4, 0x1d, 0xaa, 0x53, 0xe6, 0xc1, 0x59, 0xce, 0x2b, 0x27, 0xa8, 0x28, 0x43, 0x28, 0xe0,
-	0x4f, 0x68, 0x3d, 0x20, 0x12, 0x84, 0xb4, 0x8b, 0xad, 0x2a, 0x26, 0xb2, 0xac, 0x84, 0xae, 0x29,
-	0xd4, 0x54, 0x7d, 0x52, 0x99, 0xce, 0xf9, 0x51, 0x0c, 0x21, 0x4d, 0x42, 0xbb, 0x1f, 0xab, 0x73,
-	0x51, 0xd9, 0x53, 0x9c, 0xc2, 0xf5, 0x14, 0xed, 0x30, 0x87, 0x61, 0x86, 0x3e, 0x73, 0x93, 0x30,
-	0x09, 0x88, 0xa4, 0x43, 0xb8, 0x19, 0x6b, 0xa9, 0x54, 0xac, 0x47, 0x13, 0xe4, 0xf5, 0x78, 0xd7,
-	0xbb, 0x65, 0xf9, 0x03, 0xba, 0xa5, 0x76, 0xa3, 0x5b, 0xf6, 0x0e, 0xde, 0x5e, 0x76, 0x2a, 0xef,
-	0x2e, 0x3b, 0x95, 0x7f, 0x2e, 0x3b, 0x95, 0x5f, 0xaf, 0x3a, 0x0b, 0xef, 0xae, 0x3a, 0x0b, 0x7f,
-	0x5e, 0x75, 0x16, 0x7e, 0xf8, 0x7a, 0x2a, 0xc7, 0x57, 0xd9, 0x95, 0x64, 0x7f, 0x40, 0x28, 0x33,
-	0xd5, 0xf5, 0xc4, 0x3c, 0x33, 0xb3, 0xeb, 0x42, 0x96, 0xab, 0xb3, 0x94, 0x5d, 0x16, 0xbe, 0xfd,
-	0x2f, 0x00, 0x00, 0xff, 0xff, 0xfe, 0x43, 0xae, 0x8e, 0xd1, 0x0c, 0x00, 0x00,
+func (m *OrderFilledEvent) Reset()         { *m = OrderFilledEvent{} }
+func (m *OrderFilledEvent) String() string { return proto.CompactTextString(m) }
+func (*OrderFilledEvent) ProtoMessage()    {}
+func (*OrderFilledEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b7f9ebcf2fdb5b, []int{4}
 }
-
-func (m *PositionChangedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OrderFilledEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderFilledEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderFilledEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *OrderFilledEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderFilledEvent.Merge(m, src)
+}
+func (m *OrderFilledEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderFilledEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderFilledEvent.DiscardUnknown(m)
 }
 
-func (m *PositionChangedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_OrderFilledEvent proto.InternalMessageInfo
+
+func (m *OrderFilledEvent) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
 }
 
-func (m *PositionChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockTimeMs != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
-		i--
-		dAtA[i] = 0x78
+func (m *OrderFilledEvent) GetPair() string {
+	if m != nil {
+		return m.Pair
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x70
+	return ""
+}
+
+func (m *OrderFilledEvent) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
 	}
-	{
-		size := m.FundingPayment.Size()
-		i -= size
-		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *OrderFilledEvent) GetOrderType() OrderType {
+	if m != nil {
+		return m.OrderType
 	}
-	i--
-	dAtA[i] = 0x6a
-	{
-		size := m.MarkPrice.Size()
-		i -= size
-		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return OrderType_ORDER_TYPE_UNSPECIFIED
+}
+
+func (m *OrderFilledEvent) GetSide() Side {
+	if m != nil {
+		return m.Side
 	}
-	i--
-	dAtA[i] = 0x62
-	{
-		size := m.LiquidationPenalty.Size()
-		i -= size
-		if _, err := m.LiquidationPenalty.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return Side_SIDE_UNSPECIFIED
+}
+
+func (m *OrderFilledEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size, err := m.BadDebt.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+// Emitted when a resting order is triggered but fails to execute. Rejected
+// orders are removed and their escrowed margin is returned to the trader.
+type OrderRejectedEvent struct {
+	// Identifier of the order.
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// Identifier for the virtual pool of the order.
+	Pair string `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	// Owner of the order.
+	TraderAddress string    `protobuf:"bytes,3,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	OrderType     OrderType `protobuf:"varint,4,opt,name=order_type,json=orderType,proto3,enum=nibiru.perp.v1.OrderType" json:"order_type,omitempty"`
+	Side          Side      `protobuf:"varint,5,opt,name=side,proto3,enum=nibiru.perp.v1.Side" json:"side,omitempty"`
+	// The mark price at which the order was set to trigger.
+	TriggerPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=trigger_price,json=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price"`
+	// The mark price that triggered the order.
+	MarkPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=mark_price,json=markPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mark_price"`
+	// The reason the order could not be executed.
+	Reason string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The block number at which the order was rejected.
+	BlockHeight int64 `protobuf:"varint,9,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *OrderRejectedEvent) Reset()         { *m = OrderRejectedEvent{} }
+func (m *OrderRejectedEvent) String() string { return proto.CompactTextString(m) }
+func (*OrderRejectedEvent) ProtoMessage()    {}
+func (*OrderRejectedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b7f9ebcf2fdb5b, []int{5}
+}
+func (m *OrderRejectedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderRejectedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderRejectedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.UnrealizedPnlAfter.Size()
-		i -= size
-		if _, err := m.UnrealizedPnlAfter.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+}
+func (m *OrderRejectedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderRejectedEvent.Merge(m, src)
+}
+func (m *OrderRejectedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderRejectedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderRejectedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderRejectedEvent proto.InternalMessageInfo
+
+func (m *OrderRejectedEvent) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
 	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size := m.RealizedPnl.Size()
-		i -= size
-		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *OrderRejectedEvent) GetPair() string {
+	if m != nil {
+		return m.Pair
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.PositionSize.Size()
-		i -= size
-		if _, err := m.PositionSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *OrderRejectedEvent) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size, err := m.TransactionFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *OrderRejectedEvent) GetOrderType() OrderType {
+	if m != nil {
+		return m.OrderType
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.ExchangedPositionSize.Size()
-		i -= size
-		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return OrderType_ORDER_TYPE_UNSPECIFIED
+}
+
+func (m *OrderRejectedEvent) GetSide() Side {
+	if m != nil {
+		return m.Side
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.PositionNotional.Size()
-		i -= size
-		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return Side_SIDE_UNSPECIFIED
+}
+
+func (m *OrderRejectedEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+	return ""
+}
+
+func (m *OrderRejectedEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// Emitted when a trader switches between isolated and cross margin.
+type MarginModeChangedEvent struct {
+	// Owner of the margin account.
+	TraderAddress string `protobuf:"bytes,1,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	// The margin mode after the change.
+	MarginMode MarginMode `protobuf:"varint,2,opt,name=margin_mode,json=marginMode,proto3,enum=nibiru.perp.v1.MarginMode" json:"margin_mode,omitempty"`
+	// The block number at which the margin mode changed.
+	BlockHeight int64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *MarginModeChangedEvent) Reset()         { *m = MarginModeChangedEvent{} }
+func (m *MarginModeChangedEvent) String() string { return proto.CompactTextString(m) }
+func (*MarginModeChangedEvent) ProtoMessage()    {}
+func (*MarginModeChangedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b7f9ebcf2fdb5b, []int{6}
+}
+func (m *MarginModeChangedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarginModeChangedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarginModeChangedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.TraderAddress) > 0 {
-		i -= len(m.TraderAddress)
-		copy(dAtA[i:], m.TraderAddress)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Pair) > 0 {
-		i -= len(m.Pair)
-		copy(dAtA[i:], m.Pair)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MarginModeChangedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarginModeChangedEvent.Merge(m, src)
+}
+func (m *MarginModeChangedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarginModeChangedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarginModeChangedEvent.DiscardUnknown(m)
 }
 
-func (m *PositionLiquidatedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MarginModeChangedEvent proto.InternalMessageInfo
+
+func (m *MarginModeChangedEvent) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PositionLiquidatedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MarginModeChangedEvent) GetMarginMode() MarginMode {
+	if m != nil {
+		return m.MarginMode
+	}
+	return MarginMode_MARGIN_MODE_UNSPECIFIED
 }
 
-func (m *PositionLiquidatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockTimeMs != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
-		i--
-		dAtA[i] = 0x78
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x70
+func (m *MarginModeChangedEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	{
-		size := m.MarkPrice.Size()
-		i -= size
-		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+// Emitted when quote tokens are deposited into the insurance fund.
+type InsuranceFundDepositEvent struct {
+	Depositor string `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// quote tokens deposited.
+	Deposit types.Coin `protobuf:"bytes,2,opt,name=deposit,proto3" json:"deposit"`
+	// insurance fund shares minted to the depositor.
+	Shares types.Coin `protobuf:"bytes,3,opt,name=shares,proto3" json:"shares"`
+}
+
+func (m *InsuranceFundDepositEvent) Reset()         { *m = InsuranceFundDepositEvent{} }
+func (m *InsuranceFundDepositEvent) String() string { return proto.CompactTextString(m) }
+func (*InsuranceFundDepositEvent) ProtoMessage()    {}
+func (*InsuranceFundDepositEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b7f9ebcf2fdb5b, []int{7}
+}
+func (m *InsuranceFundDepositEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceFundDepositEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsuranceFundDepositEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x6a
-	{
-		size := m.UnrealizedPnl.Size()
-		i -= size
-		if _, err := m.UnrealizedPnl.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+}
+func (m *InsuranceFundDepositEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceFundDepositEvent.Merge(m, src)
+}
+func (m *InsuranceFundDepositEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceFundDepositEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceFundDepositEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsuranceFundDepositEvent proto.InternalMessageInfo
+
+func (m *InsuranceFundDepositEvent) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
 	}
-	i--
-	dAtA[i] = 0x62
-	{
-		size := m.PositionSize.Size()
-		i -= size
-		if _, err := m.PositionSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *InsuranceFundDepositEvent) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
 	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.PositionNotional.Size()
-		i -= size
-		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return types.Coin{}
+}
+
+func (m *InsuranceFundDepositEvent) GetShares() types.Coin {
+	if m != nil {
+		return m.Shares
 	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+	return types.Coin{}
+}
+
+// Emitted when insurance fund shares are redeemed for quote tokens.
+type InsuranceFundWithdrawEvent struct {
+	Depositor string `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// insurance fund shares burned.
+	Shares types.Coin `protobuf:"bytes,2,opt,name=shares,proto3" json:"shares"`
+	// quote tokens paid to the depositor.
+	Withdrawn types.Coin `protobuf:"bytes,3,opt,name=withdrawn,proto3" json:"withdrawn"`
+}
+
+func (m *InsuranceFundWithdrawEvent) Reset()         { *m = InsuranceFundWithdrawEvent{} }
+func (m *InsuranceFundWithdrawEvent) String() string { return proto.CompactTextString(m) }
+func (*InsuranceFundWithdrawEvent) ProtoMessage()    {}
+func (*InsuranceFundWithdrawEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b7f9ebcf2fdb5b, []int{8}
+}
+func (m *InsuranceFundWithdrawEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceFundWithdrawEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsuranceFundWithdrawEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size, err := m.BadDebt.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+}
+func (m *InsuranceFundWithdrawEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceFundWithdrawEvent.Merge(m, src)
+}
+func (m *InsuranceFundWithdrawEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceFundWithdrawEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceFundWithdrawEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsuranceFundWithdrawEvent proto.InternalMessageInfo
+
+func (m *InsuranceFundWithdrawEvent) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size, err := m.FeeToEcosystemFund.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *InsuranceFundWithdrawEvent) GetShares() types.Coin {
+	if m != nil {
+		return m.Shares
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size, err := m.FeeToLiquidator.MarshalToSizedBuffer(dAtA[:i])
+	return types.Coin{}
+}
+
+func (m *InsuranceFundWithdrawEvent) GetWithdrawn() types.Coin {
+	if m != nil {
+		return m.Withdrawn
+	}
+	return types.Coin{}
+}
+
+// Emitted when trading is halted or resumed on a pair or on the whole exchange.
+type TradingHaltChangedEvent struct {
+	// pair halted or resumed, empty for the whole exchange.
+	Pair   string `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	Halted bool   `protobuf:"varint,2,opt,name=halted,proto3" json:"halted,omitempty"`
+	// who changed the halt: the guardian address or "circuit_breaker".
+	ChangedBy string `protobuf:"bytes,3,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	// The block number at which the halt changed.
+	BlockHeight int64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *TradingHaltChangedEvent) Reset()         { *m = TradingHaltChangedEvent{} }
+func (m *TradingHaltChangedEvent) String() string { return proto.CompactTextString(m) }
+func (*TradingHaltChangedEvent) ProtoMessage()    {}
+func (*TradingHaltChangedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b7f9ebcf2fdb5b, []int{9}
+}
+func (m *TradingHaltChangedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradingHaltChangedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradingHaltChangedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.LiquidatorAddress) > 0 {
-		i -= len(m.LiquidatorAddress)
-		copy(dAtA[i:], m.LiquidatorAddress)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.LiquidatorAddress)))
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	{
-		size := m.ExchangedPositionSize.Size()
-		i -= size
-		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+}
+func (m *TradingHaltChangedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradingHaltChangedEvent.Merge(m, src)
+}
+func (m *TradingHaltChangedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradingHaltChangedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradingHaltChangedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradingHaltChangedEvent proto.InternalMessageInfo
+
+func (m *TradingHaltChangedEvent) GetPair() string {
+	if m != nil {
+		return m.Pair
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.ExchangedQuoteAmount.Size()
-		i -= size
-		if _, err := m.ExchangedQuoteAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *TradingHaltChangedEvent) GetHalted() bool {
+	if m != nil {
+		return m.Halted
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.TraderAddress) > 0 {
-		i -= len(m.TraderAddress)
-		copy(dAtA[i:], m.TraderAddress)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
-		i--
-		dAtA[i] = 0x12
+	return false
+}
+
+func (m *TradingHaltChangedEvent) GetChangedBy() string {
+	if m != nil {
+		return m.ChangedBy
 	}
-	if len(m.Pair) > 0 {
-		i -= len(m.Pair)
-		copy(dAtA[i:], m.Pair)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *TradingHaltChangedEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *PositionSettledEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// Emitted when a non-whitelisted liquidator finds a position liquidatable,
+// opening the window during which only the whitelisted liquidators can liquidate it.
+type LiquidationPriorityWindowStartedEvent struct {
+	Pair          string `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	TraderAddress string `protobuf:"bytes,2,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	// liquidator who found the position liquidatable.
+	LiquidatorAddress string `protobuf:"bytes,3,opt,name=liquidator_address,json=liquidatorAddress,proto3" json:"liquidator_address,omitempty"`
+	// first block at which any liquidator can liquidate the position.
+	PermissionlessFromHeight int64 `protobuf:"varint,4,opt,name=permissionless_from_height,json=permissionlessFromHeight,proto3" json:"permissionless_from_height,omitempty"`
+}
+
+func (m *LiquidationPriorityWindowStartedEvent) Reset()         { *m = LiquidationPriorityWindowStartedEvent{} }
+func (m *LiquidationPriorityWindowStartedEvent) String() string { return proto.CompactTextString(m) }
+func (*LiquidationPriorityWindowStartedEvent) ProtoMessage()    {}
+func (*LiquidationPriorityWindowStartedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b7f9ebcf2fdb5b, []int{10}
+}
+func (m *LiquidationPriorityWindowStartedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidationPriorityWindowStartedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidationPriorityWindowStartedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *LiquidationPriorityWindowStartedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidationPriorityWindowStartedEvent.Merge(m, src)
+}
+func (m *LiquidationPriorityWindowStartedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidationPriorityWindowStartedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidationPriorityWindowStartedEvent.DiscardUnknown(m)
 }
 
-func (m *PositionSettledEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_LiquidationPriorityWindowStartedEvent proto.InternalMessageInfo
+
+func (m *LiquidationPriorityWindowStartedEvent) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
 }
 
-func (m *PositionSettledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SettledCoins) > 0 {
-		for iNdEx := len(m.SettledCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SettledCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *LiquidationPriorityWindowStartedEvent) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
+	}
+	return ""
+}
+
+func (m *LiquidationPriorityWindowStartedEvent) GetLiquidatorAddress() string {
+	if m != nil {
+		return m.LiquidatorAddress
+	}
+	return ""
+}
+
+func (m *LiquidationPriorityWindowStartedEvent) GetPermissionlessFromHeight() int64 {
+	if m != nil {
+		return m.PermissionlessFromHeight
+	}
+	return 0
+}
+
+// Emitted when a trader is paid a rebate for moving the mark price toward the
+// index price.
+type MakerRebatePaidEvent struct {
+	Pair          string `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	TraderAddress string `protobuf:"bytes,2,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	// rebate paid from the fee pool to the trader.
+	Rebate types.Coin `protobuf:"bytes,3,opt,name=rebate,proto3" json:"rebate"`
+	// The block number at which the rebate was paid.
+	BlockHeight int64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *MakerRebatePaidEvent) Reset()         { *m = MakerRebatePaidEvent{} }
+func (m *MakerRebatePaidEvent) String() string { return proto.CompactTextString(m) }
+func (*MakerRebatePaidEvent) ProtoMessage()    {}
+func (*MakerRebatePaidEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b7f9ebcf2fdb5b, []int{11}
+}
+func (m *MakerRebatePaidEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MakerRebatePaidEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MakerRebatePaidEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.TraderAddress) > 0 {
-		i -= len(m.TraderAddress)
-		copy(dAtA[i:], m.TraderAddress)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MakerRebatePaidEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MakerRebatePaidEvent.Merge(m, src)
+}
+func (m *MakerRebatePaidEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MakerRebatePaidEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MakerRebatePaidEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MakerRebatePaidEvent proto.InternalMessageInfo
+
+func (m *MakerRebatePaidEvent) GetPair() string {
+	if m != nil {
+		return m.Pair
 	}
-	if len(m.Pair) > 0 {
-		i -= len(m.Pair)
-		copy(dAtA[i:], m.Pair)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MakerRebatePaidEvent) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *FundingRateChangedEvent) Marshal() (dAtA []byte, err error) {
+func (m *MakerRebatePaidEvent) GetRebate() types.Coin {
+	if m != nil {
+		return m.Rebate
+	}
+	return types.Coin{}
+}
+
+func (m *MakerRebatePaidEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// Emitted when a position is reduced to cover a bad debt the insurance fund and
+// the ecosystem fund can't cover, or when a payout from the vault is cut short.
+type PositionAutoDeleveragedEvent struct {
+	// pair of the reduced position, empty if a payout was cut short.
+	Pair          string `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	TraderAddress string `protobuf:"bytes,2,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	// signed change of the position size, zero if a payout was cut short.
+	ExchangedPositionSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=exchanged_position_size,json=exchangedPositionSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_position_size"`
+	// PnL realized by the reduction, before the haircut.
+	RealizedPnl github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=realized_pnl,json=realizedPnl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"realized_pnl"`
+	// profit taken from the trader to cover the bad debt.
+	Haircut types.Coin `protobuf:"bytes,5,opt,name=haircut,proto3" json:"haircut"`
+	// rank of the position in the queue, starting at 1. Zero if a payout was
+	// cut short.
+	Rank uint64 `protobuf:"varint,6,opt,name=rank,proto3" json:"rank,omitempty"`
+	// Mark price of the pair after the reduction.
+	MarkPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=mark_price,json=markPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mark_price"`
+	// The block number at which the position was reduced.
+	BlockHeight int64 `protobuf:"varint,8,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *PositionAutoDeleveragedEvent) Reset()         { *m = PositionAutoDeleveragedEvent{} }
+func (m *PositionAutoDeleveragedEvent) String() string { return proto.CompactTextString(m) }
+func (*PositionAutoDeleveragedEvent) ProtoMessage()    {}
+func (*PositionAutoDeleveragedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b7f9ebcf2fdb5b, []int{12}
+}
+func (m *PositionAutoDeleveragedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionAutoDeleveragedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionAutoDeleveragedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PositionAutoDeleveragedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionAutoDeleveragedEvent.Merge(m, src)
+}
+func (m *PositionAutoDeleveragedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionAutoDeleveragedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionAutoDeleveragedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PositionAutoDeleveragedEvent proto.InternalMessageInfo
+
+func (m *PositionAutoDeleveragedEvent) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *PositionAutoDeleveragedEvent) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
+	}
+	return ""
+}
+
+func (m *PositionAutoDeleveragedEvent) GetHaircut() types.Coin {
+	if m != nil {
+		return m.Haircut
+	}
+	return types.Coin{}
+}
+
+func (m *PositionAutoDeleveragedEvent) GetRank() uint64 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *PositionAutoDeleveragedEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// Emitted when margin is moved between two subaccounts of an owner.
+type SubaccountMarginTransferredEvent struct {
+	Owner            string     `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	FromSubaccountId uint64     `protobuf:"varint,2,opt,name=from_subaccount_id,json=fromSubaccountId,proto3" json:"from_subaccount_id,omitempty"`
+	ToSubaccountId   uint64     `protobuf:"varint,3,opt,name=to_subaccount_id,json=toSubaccountId,proto3" json:"to_subaccount_id,omitempty"`
+	Amount           types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	// The block number at which the margin was transferred.
+	BlockHeight int64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *SubaccountMarginTransferredEvent) Reset()         { *m = SubaccountMarginTransferredEvent{} }
+func (m *SubaccountMarginTransferredEvent) String() string { return proto.CompactTextString(m) }
+func (*SubaccountMarginTransferredEvent) ProtoMessage()    {}
+func (*SubaccountMarginTransferredEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b7f9ebcf2fdb5b, []int{13}
+}
+func (m *SubaccountMarginTransferredEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubaccountMarginTransferredEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubaccountMarginTransferredEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubaccountMarginTransferredEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubaccountMarginTransferredEvent.Merge(m, src)
+}
+func (m *SubaccountMarginTransferredEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubaccountMarginTransferredEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubaccountMarginTransferredEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubaccountMarginTransferredEvent proto.InternalMessageInfo
+
+func (m *SubaccountMarginTransferredEvent) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *SubaccountMarginTransferredEvent) GetFromSubaccountId() uint64 {
+	if m != nil {
+		return m.FromSubaccountId
+	}
+	return 0
+}
+
+func (m *SubaccountMarginTransferredEvent) GetToSubaccountId() uint64 {
+	if m != nil {
+		return m.ToSubaccountId
+	}
+	return 0
+}
+
+func (m *SubaccountMarginTransferredEvent) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *SubaccountMarginTransferredEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PositionChangedEvent)(nil), "nibiru.perp.v1.PositionChangedEvent")
+	proto.RegisterType((*PositionLiquidatedEvent)(nil), "nibiru.perp.v1.PositionLiquidatedEvent")
+	proto.RegisterType((*PositionSettledEvent)(nil), "nibiru.perp.v1.PositionSettledEvent")
+	proto.RegisterType((*FundingRateChangedEvent)(nil), "nibiru.perp.v1.FundingRateChangedEvent")
+	proto.RegisterType((*OrderFilledEvent)(nil), "nibiru.perp.v1.OrderFilledEvent")
+	proto.RegisterType((*OrderRejectedEvent)(nil), "nibiru.perp.v1.OrderRejectedEvent")
+	proto.RegisterType((*MarginModeChangedEvent)(nil), "nibiru.perp.v1.MarginModeChangedEvent")
+	proto.RegisterType((*InsuranceFundDepositEvent)(nil), "nibiru.perp.v1.InsuranceFundDepositEvent")
+	proto.RegisterType((*InsuranceFundWithdrawEvent)(nil), "nibiru.perp.v1.InsuranceFundWithdrawEvent")
+	proto.RegisterType((*TradingHaltChangedEvent)(nil), "nibiru.perp.v1.TradingHaltChangedEvent")
+	proto.RegisterType((*LiquidationPriorityWindowStartedEvent)(nil), "nibiru.perp.v1.LiquidationPriorityWindowStartedEvent")
+	proto.RegisterType((*MakerRebatePaidEvent)(nil), "nibiru.perp.v1.MakerRebatePaidEvent")
+	proto.RegisterType((*PositionAutoDeleveragedEvent)(nil), "nibiru.perp.v1.PositionAutoDeleveragedEvent")
+	proto.RegisterType((*SubaccountMarginTransferredEvent)(nil), "nibiru.perp.v1.SubaccountMarginTransferredEvent")
+}
+
+func init() { proto.RegisterFile("perp/v1/event.proto", fileDescriptor_19b7f9ebcf2fdb5b) }
+
+var fileDescriptor_19b7f9ebcf2fdb5b = []byte{
+	// 1553 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4d, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xcf, 0xc6, 0x8e, 0x5f, 0x9e, 0xc4, 0x4e, 0xb2, 0x71, 0x93, 0x4d, 0xfe, 0xf9, 0x3b, 0xf9,
+	0x5b, 0xff, 0xa2, 0x08, 0x51, 0x5b, 0x09, 0x87, 0xd2, 0x02, 0x87, 0xa4, 0x69, 0x94, 0x4a, 0x4d,
+	0xeb, 0x6e, 0x22, 0x55, 0x02, 0xc1, 0x32, 0xde, 0x1d, 0xdb, 0x43, 0x76, 0x67, 0xdc, 0xd9, 0x71,
+	0x52, 0xf7, 0x0b, 0xc0, 0x11, 0x89, 0x0f, 0xc0, 0x1d, 0x89, 0x0b, 0x37, 0xbe, 0x41, 0x0f, 0x48,
+	0x54, 0x48, 0x48, 0x08, 0xa1, 0x82, 0xda, 0x2b, 0x27, 0x3e, 0x01, 0xda, 0x99, 0x5d, 0xbf, 0x86,
+	0xc4, 0x75, 0x0c, 0x5c, 0x38, 0xed, 0xce, 0xdb, 0xef, 0x79, 0xe6, 0x79, 0xf9, 0x3d, 0x33, 0x03,
+	0x0b, 0x0d, 0xcc, 0x1b, 0xa5, 0x93, 0xcd, 0x12, 0x3e, 0xc1, 0x54, 0x14, 0x1b, 0x9c, 0x09, 0xa6,
+	0x67, 0x29, 0xa9, 0x10, 0xde, 0x2c, 0x06, 0x63, 0xc5, 0x93, 0xcd, 0x95, 0x5c, 0x8d, 0xd5, 0x98,
+	0x1c, 0x2a, 0x05, 0x7f, 0x6a, 0xd6, 0xca, 0x6a, 0x8d, 0xb1, 0x9a, 0x8b, 0x4b, 0xa8, 0x41, 0x4a,
+	0x88, 0x52, 0x26, 0x90, 0x20, 0x8c, 0xfa, 0xe1, 0x68, 0xde, 0x66, 0xbe, 0xc7, 0xfc, 0x52, 0x05,
+	0xf9, 0xb8, 0x74, 0xb2, 0x59, 0xc1, 0x02, 0x6d, 0x96, 0x6c, 0x46, 0x68, 0x38, 0xbe, 0x60, 0x33,
+	0xcf, 0x63, 0xb4, 0xa4, 0x3e, 0x51, 0x67, 0xa4, 0x8d, 0x2f, 0x90, 0xc0, 0xaa, 0xb3, 0xf0, 0x4d,
+	0x1a, 0x72, 0x65, 0xe6, 0x93, 0x00, 0xfd, 0x56, 0x1d, 0xd1, 0x1a, 0x76, 0x6e, 0x07, 0xca, 0xea,
+	0x3a, 0xc4, 0x1b, 0x88, 0x70, 0x43, 0x5b, 0xd7, 0x36, 0xd2, 0xa6, 0xfc, 0xd7, 0xaf, 0x42, 0x56,
+	0x70, 0xe4, 0x60, 0x6e, 0x21, 0xc7, 0xe1, 0xd8, 0xf7, 0x8d, 0x49, 0x39, 0x9a, 0x51, 0xbd, 0xdb,
+	0xaa, 0x53, 0xdf, 0x87, 0x84, 0x87, 0x78, 0x8d, 0x50, 0x23, 0xb6, 0xae, 0x6d, 0x4c, 0x6f, 0x2d,
+	0x17, 0x95, 0xba, 0xc5, 0x40, 0xdd, 0x62, 0xa8, 0x6e, 0xf1, 0x16, 0x23, 0x74, 0xe7, 0xca, 0xd3,
+	0xe7, 0x6b, 0x13, 0xbf, 0x3f, 0x5f, 0xcb, 0xb4, 0x90, 0xe7, 0xde, 0x2c, 0xa8, 0x65, 0x05, 0x33,
+	0x5c, 0xaf, 0xbf, 0x0f, 0xf3, 0x8d, 0x50, 0x39, 0x8b, 0xb2, 0xe0, 0x83, 0x5c, 0x23, 0x1e, 0xc8,
+	0xdc, 0x29, 0x06, 0x2b, 0x7f, 0x7a, 0xbe, 0xf6, 0x5a, 0x8d, 0x88, 0x7a, 0xb3, 0x52, 0xb4, 0x99,
+	0x57, 0x0a, 0xad, 0xa2, 0x3e, 0xd7, 0x7c, 0xe7, 0xb8, 0x24, 0x5a, 0x0d, 0xec, 0x17, 0x77, 0xb1,
+	0x6d, 0xce, 0x45, 0x40, 0xf7, 0x42, 0x1c, 0xbd, 0x0a, 0x4b, 0xf8, 0xb1, 0xad, 0xf6, 0x6c, 0xb5,
+	0xc5, 0xf8, 0xe4, 0x09, 0x36, 0xa6, 0x46, 0x12, 0x71, 0xa5, 0x0d, 0x17, 0x59, 0xf4, 0x90, 0x3c,
+	0xc1, 0x7a, 0x05, 0x66, 0x05, 0x47, 0xd4, 0x47, 0xb6, 0x14, 0x50, 0xc5, 0xd8, 0x48, 0x5c, 0x64,
+	0x97, 0x7c, 0x68, 0x97, 0x45, 0x65, 0x97, 0xbe, 0xf5, 0x05, 0x33, 0xdb, 0xd5, 0xb3, 0x87, 0xb1,
+	0x7e, 0x08, 0x99, 0xde, 0x1d, 0x24, 0x47, 0xda, 0xc1, 0x4c, 0xa3, 0x5b, 0xf1, 0x07, 0x30, 0xc3,
+	0x31, 0x72, 0xc9, 0x93, 0xc0, 0x3e, 0xd4, 0x35, 0x52, 0x23, 0x61, 0x4e, 0x47, 0x18, 0x65, 0xea,
+	0xea, 0x1f, 0x41, 0xae, 0x49, 0xbb, 0x41, 0x2d, 0x54, 0x15, 0x98, 0x1b, 0xe9, 0x91, 0xa0, 0xf5,
+	0x0e, 0x56, 0x99, 0xba, 0xdb, 0x01, 0x92, 0x7e, 0x13, 0x52, 0x15, 0xe4, 0x58, 0x0e, 0xae, 0x08,
+	0x03, 0x2e, 0x32, 0x73, 0x3c, 0x10, 0x68, 0x26, 0x2b, 0xc8, 0xd9, 0xc5, 0x15, 0xa1, 0x5b, 0xb0,
+	0xe0, 0x92, 0x47, 0x4d, 0xe2, 0xc8, 0x64, 0xb3, 0x1a, 0x98, 0x22, 0x57, 0xb4, 0x8c, 0xe9, 0xd1,
+	0x94, 0xeb, 0x82, 0x2a, 0x2b, 0x24, 0xfd, 0x00, 0xc0, 0x43, 0xfc, 0xd8, 0x6a, 0x70, 0x62, 0x63,
+	0x63, 0x66, 0x24, 0xdc, 0x74, 0x80, 0x50, 0x0e, 0x00, 0xf4, 0x87, 0x30, 0x5b, 0x6d, 0x52, 0x87,
+	0xd0, 0x9a, 0xd5, 0x40, 0x2d, 0x0f, 0x53, 0x61, 0x64, 0x46, 0xc2, 0xcc, 0x86, 0x30, 0x65, 0x85,
+	0xa2, 0xff, 0x0f, 0x66, 0x2a, 0x2e, 0xb3, 0x8f, 0xad, 0x3a, 0x26, 0xb5, 0xba, 0x30, 0xb2, 0xeb,
+	0xda, 0x46, 0xcc, 0x9c, 0x96, 0x7d, 0xfb, 0xb2, 0x4b, 0x2f, 0x40, 0x46, 0x4d, 0x11, 0xc4, 0xc3,
+	0x96, 0xe7, 0x1b, 0xb3, 0x5d, 0x73, 0x8e, 0x88, 0x87, 0x0f, 0x7c, 0xfd, 0x03, 0xd0, 0x3b, 0x19,
+	0xd6, 0xce, 0xdf, 0xb9, 0x91, 0x54, 0x9c, 0x6f, 0x23, 0x45, 0x09, 0x5c, 0xf8, 0x3e, 0x05, 0x4b,
+	0x51, 0xa6, 0xdd, 0x0d, 0x8d, 0x3d, 0x06, 0xfa, 0x72, 0x60, 0xb1, 0xa3, 0xf5, 0xa3, 0x26, 0x13,
+	0xd8, 0x42, 0x1e, 0x6b, 0x52, 0x61, 0xc4, 0x46, 0xd2, 0x3c, 0xd7, 0x46, 0x7b, 0x10, 0x80, 0x6d,
+	0x4b, 0xac, 0xf3, 0xd8, 0x27, 0x3e, 0x4e, 0xf6, 0xb9, 0x06, 0xed, 0x40, 0x64, 0x9d, 0x8d, 0x4b,
+	0x82, 0x33, 0xe7, 0x3b, 0x23, 0xd1, 0xe6, 0x6b, 0x30, 0x5f, 0xc5, 0xd8, 0x12, 0xcc, 0xea, 0x8c,
+	0x5d, 0x4c, 0x57, 0xeb, 0x21, 0x5d, 0x19, 0x8a, 0xae, 0x06, 0x10, 0x0a, 0xe6, 0x6c, 0x15, 0xe3,
+	0x23, 0x76, 0xb7, 0xdd, 0xa3, 0x73, 0xb8, 0x12, 0x4e, 0xc3, 0x36, 0xf3, 0x5b, 0xbe, 0xc0, 0x9e,
+	0x15, 0x44, 0xa1, 0x91, 0xbc, 0x48, 0xd8, 0xff, 0x43, 0x61, 0xab, 0x3d, 0xc2, 0x7a, 0x51, 0x0a,
+	0xa6, 0x2e, 0x05, 0xde, 0x8e, 0x7a, 0xf7, 0x9a, 0xd4, 0xe9, 0xe1, 0x86, 0xd4, 0x2b, 0x72, 0x43,
+	0xa7, 0xa8, 0xa5, 0xff, 0x8a, 0xa2, 0x06, 0x63, 0x2a, 0x6a, 0x03, 0x85, 0x60, 0x7a, 0x0c, 0x85,
+	0xe0, 0x08, 0x32, 0x3d, 0x4c, 0x3b, 0x22, 0x73, 0xf5, 0x82, 0xf4, 0x91, 0x61, 0xe6, 0xb2, 0x64,
+	0x38, 0x1e, 0xce, 0x2a, 0xfc, 0xac, 0x75, 0x0e, 0x44, 0x87, 0x58, 0x08, 0x77, 0x0c, 0x8c, 0xf2,
+	0xa9, 0x06, 0x19, 0x5f, 0x61, 0x59, 0xc1, 0x29, 0xcd, 0x37, 0x62, 0xeb, 0xb1, 0xf3, 0x63, 0x68,
+	0x3f, 0x8c, 0xa1, 0x9c, 0x8a, 0xa1, 0x9e, 0xd5, 0x85, 0x2f, 0x7f, 0x59, 0xdb, 0x18, 0xc2, 0x40,
+	0x01, 0x90, 0x6f, 0xce, 0x84, 0x6b, 0x65, 0xab, 0xf0, 0x6d, 0x1c, 0x96, 0xf6, 0x14, 0xd9, 0x9b,
+	0x48, 0xe0, 0x0b, 0x8f, 0x7c, 0xbd, 0x4e, 0x9a, 0xbc, 0xac, 0x93, 0xee, 0xc3, 0x34, 0xa1, 0x0e,
+	0x7e, 0x1c, 0xe2, 0x8d, 0x46, 0xa8, 0x20, 0x21, 0x14, 0xe0, 0x87, 0xb0, 0xe0, 0x22, 0x81, 0x7d,
+	0x61, 0x45, 0x95, 0x90, 0x23, 0x31, 0x2a, 0x85, 0xce, 0x2b, 0xa8, 0x2e, 0xfb, 0x04, 0x34, 0x1d,
+	0xe2, 0x37, 0x38, 0xf6, 0x48, 0xd3, 0xb3, 0xaa, 0x5c, 0x1d, 0xbb, 0x46, 0x3d, 0x24, 0x2a, 0xb8,
+	0xb2, 0x42, 0xdb, 0x0b, 0xc1, 0x74, 0x0a, 0xff, 0xb1, 0x9b, 0x5e, 0xd3, 0x45, 0x82, 0x9c, 0xe0,
+	0x41, 0x59, 0x89, 0x91, 0x64, 0x2d, 0x77, 0x20, 0xfb, 0xe5, 0xf5, 0x67, 0x4b, 0x72, 0x88, 0x6c,
+	0x49, 0x0d, 0x66, 0xcb, 0xe7, 0x31, 0x98, 0xbb, 0xcf, 0x1d, 0xcc, 0xf7, 0x88, 0xdb, 0xce, 0x94,
+	0x65, 0x48, 0xb1, 0xa0, 0xcf, 0x22, 0x8e, 0x8c, 0xa5, 0xb8, 0x99, 0x94, 0xed, 0x3b, 0x4e, 0x3b,
+	0xc4, 0x26, 0xcf, 0x4d, 0xa2, 0xd8, 0x59, 0x49, 0xf4, 0x16, 0x80, 0x42, 0x0d, 0xf6, 0x27, 0x1d,
+	0x9c, 0xdd, 0x5a, 0x2e, 0xf6, 0x5e, 0xa6, 0x8a, 0x52, 0x97, 0xa3, 0x56, 0x03, 0x9b, 0x69, 0x16,
+	0xfd, 0xea, 0x1b, 0x10, 0xf7, 0x89, 0xa3, 0x4e, 0xf5, 0xd9, 0xad, 0x5c, 0xff, 0x9a, 0x43, 0xe2,
+	0x60, 0x53, 0xce, 0x08, 0xd8, 0x53, 0x70, 0x52, 0xab, 0x61, 0x1e, 0x06, 0xe8, 0x68, 0x76, 0x9f,
+	0x09, 0x41, 0x54, 0x88, 0xf6, 0xa6, 0x50, 0x72, 0xdc, 0x3c, 0x97, 0x1a, 0xf0, 0x5c, 0xe1, 0xab,
+	0x18, 0xe8, 0xd2, 0x12, 0x26, 0xfe, 0x18, 0xdb, 0xe2, 0x5f, 0xbf, 0xfc, 0x1d, 0x7e, 0x59, 0x84,
+	0x04, 0xc7, 0xc8, 0x67, 0x54, 0xdd, 0x93, 0xcc, 0xb0, 0x35, 0xe0, 0xaf, 0xf4, 0xa0, 0xbf, 0xbe,
+	0xd0, 0x60, 0xf1, 0x40, 0x1e, 0x0e, 0x0e, 0x98, 0xd3, 0xcb, 0xc9, 0x83, 0x4e, 0xd0, 0xce, 0x72,
+	0xc2, 0xdb, 0x30, 0xad, 0x4e, 0x17, 0x96, 0xc7, 0x1c, 0xc5, 0xd3, 0xd9, 0xad, 0x95, 0x7e, 0x8b,
+	0x76, 0x64, 0x98, 0xe0, 0xb5, 0xff, 0x07, 0x34, 0x8c, 0x9d, 0x11, 0x51, 0x1a, 0x2c, 0xdf, 0xa1,
+	0x7e, 0x93, 0x23, 0x6a, 0xe3, 0x80, 0x1f, 0x77, 0xb1, 0x3c, 0x21, 0x28, 0x25, 0x57, 0x21, 0xed,
+	0xa8, 0x36, 0x8b, 0xaa, 0x47, 0xa7, 0x43, 0xbf, 0x01, 0xc9, 0xb0, 0x21, 0xf5, 0x1a, 0xe6, 0xd0,
+	0x15, 0xce, 0xd7, 0xaf, 0x43, 0xc2, 0xaf, 0x23, 0x8e, 0x7d, 0x23, 0x36, 0xdc, 0xca, 0x70, 0x7a,
+	0xe1, 0x6b, 0x0d, 0x56, 0x7a, 0xf4, 0x7d, 0x48, 0x44, 0xdd, 0xe1, 0xe8, 0x74, 0x18, 0x85, 0x3b,
+	0x52, 0x27, 0x5f, 0x49, 0xaa, 0xfe, 0x2e, 0xa4, 0x4f, 0x43, 0x39, 0x74, 0x58, 0x8d, 0x3b, 0x2b,
+	0x0a, 0x9f, 0x68, 0xb0, 0x74, 0xc4, 0x51, 0x50, 0x7b, 0xf6, 0x91, 0x2b, 0x2e, 0xac, 0xcd, 0x8b,
+	0x90, 0xa8, 0x23, 0x57, 0x60, 0x47, 0xea, 0x99, 0x32, 0xc3, 0x96, 0xfe, 0x5f, 0x80, 0xe8, 0x62,
+	0x51, 0x69, 0x85, 0x49, 0x9b, 0x0e, 0x7b, 0x76, 0x5a, 0x03, 0xee, 0x8e, 0x0f, 0xba, 0xfb, 0x3b,
+	0x0d, 0xae, 0xde, 0xed, 0xba, 0xbe, 0x72, 0xc2, 0x38, 0x11, 0xad, 0x87, 0x84, 0x3a, 0xec, 0xf4,
+	0x50, 0x20, 0x3e, 0x8e, 0x7b, 0xd6, 0xd9, 0x37, 0x93, 0xd8, 0x9f, 0xdd, 0x4c, 0xde, 0x81, 0x95,
+	0x06, 0xe6, 0x1e, 0xf1, 0x7d, 0xc2, 0xa8, 0x8b, 0x7d, 0xdf, 0xaa, 0x72, 0xe6, 0xf5, 0x6e, 0xc2,
+	0xe8, 0x9d, 0xb1, 0xc7, 0x99, 0xd7, 0x09, 0xe0, 0xdc, 0x01, 0x3a, 0x0e, 0x28, 0xb1, 0x82, 0x04,
+	0x2e, 0x23, 0x72, 0xf9, 0x0d, 0x5c, 0x0f, 0x32, 0x3e, 0x40, 0x1b, 0x3a, 0x3a, 0xd5, 0xf4, 0x61,
+	0x3c, 0xf0, 0x43, 0x0c, 0x56, 0xa3, 0x63, 0xe8, 0x76, 0x53, 0xb0, 0x5d, 0xec, 0xe2, 0x13, 0xcc,
+	0xd1, 0x38, 0xde, 0xe7, 0xce, 0xb9, 0x7a, 0xc6, 0xc6, 0x79, 0xf5, 0xec, 0x7f, 0x3f, 0x8a, 0x5f,
+	0xfe, 0xfd, 0xe8, 0x06, 0x24, 0xeb, 0x88, 0x70, 0xbb, 0x29, 0x8c, 0xa9, 0xe1, 0x6c, 0x1e, 0xcd,
+	0x0f, 0x0c, 0xc6, 0x11, 0x3d, 0x96, 0xa5, 0x23, 0x6e, 0xca, 0xff, 0x7f, 0xa0, 0x34, 0xff, 0xa6,
+	0xc1, 0xfa, 0x61, 0xb3, 0x82, 0x6c, 0x3b, 0x78, 0x05, 0x50, 0x84, 0x7c, 0x14, 0xbc, 0xe5, 0x55,
+	0x31, 0xe7, 0x91, 0x6f, 0x73, 0x30, 0xc5, 0x4e, 0x29, 0x8e, 0x9c, 0xab, 0x1a, 0xfa, 0x1b, 0xa0,
+	0xcb, 0x88, 0xf7, 0xdb, 0xcb, 0x83, 0x42, 0x3e, 0x29, 0xb7, 0x33, 0x17, 0x8c, 0x74, 0x70, 0xef,
+	0x38, 0xfa, 0x06, 0xcc, 0x09, 0xd6, 0x37, 0x37, 0x26, 0xe7, 0x66, 0x05, 0xeb, 0x99, 0x79, 0x1d,
+	0x12, 0xe1, 0xfb, 0x46, 0x7c, 0xc8, 0x30, 0x56, 0xd3, 0x07, 0xb6, 0x3b, 0x35, 0xb0, 0xdd, 0x9d,
+	0xdd, 0xa7, 0x2f, 0xf2, 0xda, 0xb3, 0x17, 0x79, 0xed, 0xd7, 0x17, 0x79, 0xed, 0xb3, 0x97, 0xf9,
+	0x89, 0x67, 0x2f, 0xf3, 0x13, 0x3f, 0xbe, 0xcc, 0x4f, 0xbc, 0xf7, 0x7a, 0x97, 0x79, 0xef, 0xc9,
+	0x32, 0x75, 0xab, 0x8e, 0x08, 0x2d, 0xa9, 0x92, 0x55, 0x7a, 0x5c, 0x92, 0xaf, 0xd5, 0xd2, 0xcc,
+	0x95, 0x84, 0x7c, 0xab, 0x7e, 0xf3, 0x8f, 0x01, 0x00, 0x8c, 0x48, 0xd6, 0xbf, 0x50, 0x17, 0x00,
+	0x00,
+}
+
+func (m *PositionChangedEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -866,248 +1323,2982 @@ func (m *FundingRateChangedEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FundingRateChangedEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *PositionChangedEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FundingRateChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PositionChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ExchangedNotional.Size()
+		i -= size
+		if _, err := m.ExchangedNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
 	if m.BlockTimeMs != 0 {
 		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x78
 	}
 	if m.BlockHeight != 0 {
 		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x70
 	}
 	{
-		size := m.CumulativePremiumFraction.Size()
+		size := m.FundingPayment.Size()
 		i -= size
-		if _, err := m.CumulativePremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x32
+	dAtA[i] = 0x6a
 	{
-		size := m.LatestPremiumFraction.Size()
+		size := m.MarkPrice.Size()
 		i -= size
-		if _, err := m.LatestPremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
+	dAtA[i] = 0x62
 	{
-		size := m.LatestFundingRate.Size()
+		size := m.LiquidationPenalty.Size()
 		i -= size
-		if _, err := m.LatestFundingRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.LiquidationPenalty.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
+	dAtA[i] = 0x5a
 	{
-		size := m.IndexPrice.Size()
+		size, err := m.BadDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
 		i -= size
-		if _, err := m.IndexPrice.MarshalTo(dAtA[i:]); err != nil {
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.UnrealizedPnlAfter.Size()
+		i -= size
+		if _, err := m.UnrealizedPnlAfter.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x4a
 	{
-		size := m.MarkPrice.Size()
+		size := m.RealizedPnl.Size()
 		i -= size
-		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Pair) > 0 {
-		i -= len(m.Pair)
-		copy(dAtA[i:], m.Pair)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+	dAtA[i] = 0x42
+	{
+		size := m.PositionSize.Size()
+		i -= size
+		if _, err := m.PositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.TransactionFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.ExchangedPositionSize.Size()
+		i -= size
+		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PositionNotional.Size()
+		i -= size
+		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PositionLiquidatedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *PositionChangedEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PositionLiquidatedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionLiquidatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Pair)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.TraderAddress)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x78
 	}
-	l = m.Margin.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.PositionNotional.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.ExchangedPositionSize.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.TransactionFee.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.PositionSize.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.RealizedPnl.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.UnrealizedPnlAfter.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.BadDebt.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.LiquidationPenalty.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.MarkPrice.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.FundingPayment.Size()
-	n += 1 + l + sovEvent(uint64(l))
 	if m.BlockHeight != 0 {
-		n += 1 + sovEvent(uint64(m.BlockHeight))
-	}
-	if m.BlockTimeMs != 0 {
-		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x70
 	}
-	return n
-}
-
-func (m *PositionLiquidatedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size := m.MarkPrice.Size()
+		i -= size
+		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.Pair)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.UnrealizedPnl.Size()
+		i -= size
+		if _, err := m.UnrealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	l = len(m.TraderAddress)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.PositionSize.Size()
+		i -= size
+		if _, err := m.PositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	l = m.ExchangedQuoteAmount.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.ExchangedPositionSize.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.LiquidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.PositionNotional.Size()
+		i -= size
+		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	l = m.FeeToLiquidator.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.FeeToEcosystemFund.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.BadDebt.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.Margin.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.PositionNotional.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.PositionSize.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.UnrealizedPnl.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.MarkPrice.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvent(uint64(m.BlockHeight))
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	if m.BlockTimeMs != 0 {
-		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.BadDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *PositionSettledEvent) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.FeeToEcosystemFund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.Pair)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.FeeToLiquidator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	l = len(m.TraderAddress)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i--
+	dAtA[i] = 0x32
+	if len(m.LiquidatorAddress) > 0 {
+		i -= len(m.LiquidatorAddress)
+		copy(dAtA[i:], m.LiquidatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.LiquidatorAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.ExchangedPositionSize.Size()
+		i -= size
+		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ExchangedQuoteAmount.Size()
+		i -= size
+		if _, err := m.ExchangedQuoteAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionSettledEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionSettledEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionSettledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if len(m.SettledCoins) > 0 {
-		for _, e := range m.SettledCoins {
-			l = e.Size()
-			n += 1 + l + sovEvent(uint64(l))
+		for iNdEx := len(m.SettledCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettledCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundingRateChangedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingRateChangedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingRateChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.CumulativePremiumFraction.Size()
+		i -= size
+		if _, err := m.CumulativePremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.LatestPremiumFraction.Size()
+		i -= size
+		if _, err := m.LatestPremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.LatestFundingRate.Size()
+		i -= size
+		if _, err := m.LatestFundingRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.IndexPrice.Size()
+		i -= size
+		if _, err := m.IndexPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MarkPrice.Size()
+		i -= size
+		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderFilledEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderFilledEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderFilledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MarkPrice.Size()
+		i -= size
+		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TriggerPrice.Size()
+		i -= size
+		if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Side != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OrderType != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderRejectedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderRejectedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderRejectedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size := m.MarkPrice.Size()
+		i -= size
+		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TriggerPrice.Size()
+		i -= size
+		if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Side != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OrderType != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarginModeChangedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarginModeChangedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarginModeChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MarginMode != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MarginMode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsuranceFundDepositEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceFundDepositEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceFundDepositEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsuranceFundWithdrawEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceFundWithdrawEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceFundWithdrawEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Withdrawn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TradingHaltChangedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradingHaltChangedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradingHaltChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChangedBy) > 0 {
+		i -= len(m.ChangedBy)
+		copy(dAtA[i:], m.ChangedBy)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ChangedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidationPriorityWindowStartedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidationPriorityWindowStartedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidationPriorityWindowStartedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PermissionlessFromHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PermissionlessFromHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.LiquidatorAddress) > 0 {
+		i -= len(m.LiquidatorAddress)
+		copy(dAtA[i:], m.LiquidatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.LiquidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MakerRebatePaidEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MakerRebatePaidEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MakerRebatePaidEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Rebate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionAutoDeleveragedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionAutoDeleveragedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionAutoDeleveragedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MarkPrice.Size()
+		i -= size
+		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Rank != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Rank))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Haircut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RealizedPnl.Size()
+		i -= size
+		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ExchangedPositionSize.Size()
+		i -= size
+		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubaccountMarginTransferredEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubaccountMarginTransferredEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubaccountMarginTransferredEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ToSubaccountId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ToSubaccountId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromSubaccountId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.FromSubaccountId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PositionChangedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Margin.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.PositionNotional.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ExchangedPositionSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.TransactionFee.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.PositionSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.RealizedPnl.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.UnrealizedPnlAfter.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.BadDebt.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.LiquidationPenalty.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.FundingPayment.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	}
+	l = m.ExchangedNotional.Size()
+	n += 2 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *PositionLiquidatedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.ExchangedQuoteAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ExchangedPositionSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.LiquidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.FeeToLiquidator.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.FeeToEcosystemFund.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.BadDebt.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Margin.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.PositionNotional.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.PositionSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.UnrealizedPnl.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	}
+	return n
+}
+
+func (m *PositionSettledEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.SettledCoins) > 0 {
+		for _, e := range m.SettledCoins {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FundingRateChangedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.IndexPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.LatestFundingRate.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.LatestPremiumFraction.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.CumulativePremiumFraction.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	}
+	return n
+}
+
+func (m *OrderFilledEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvent(uint64(m.OrderId))
+	}
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.OrderType != 0 {
+		n += 1 + sovEvent(uint64(m.OrderType))
+	}
+	if m.Side != 0 {
+		n += 1 + sovEvent(uint64(m.Side))
+	}
+	l = m.TriggerPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *OrderRejectedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvent(uint64(m.OrderId))
+	}
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.OrderType != 0 {
+		n += 1 + sovEvent(uint64(m.OrderType))
+	}
+	if m.Side != 0 {
+		n += 1 + sovEvent(uint64(m.Side))
+	}
+	l = m.TriggerPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *MarginModeChangedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.MarginMode != 0 {
+		n += 1 + sovEvent(uint64(m.MarginMode))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *InsuranceFundDepositEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Shares.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *InsuranceFundWithdrawEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Withdrawn.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *TradingHaltChangedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Halted {
+		n += 2
+	}
+	l = len(m.ChangedBy)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *LiquidationPriorityWindowStartedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.LiquidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PermissionlessFromHeight != 0 {
+		n += 1 + sovEvent(uint64(m.PermissionlessFromHeight))
+	}
+	return n
+}
+
+func (m *MakerRebatePaidEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Rebate.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *PositionAutoDeleveragedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.ExchangedPositionSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.RealizedPnl.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Haircut.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.Rank != 0 {
+		n += 1 + sovEvent(uint64(m.Rank))
+	}
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *SubaccountMarginTransferredEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.FromSubaccountId != 0 {
+		n += 1 + sovEvent(uint64(m.FromSubaccountId))
+	}
+	if m.ToSubaccountId != 0 {
+		n += 1 + sovEvent(uint64(m.ToSubaccountId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionChangedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransactionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnlAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnrealizedPnlAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+			}
+			m.BlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionLiquidatedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionLiquidatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedQuoteAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedQuoteAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToLiquidator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeToLiquidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToEcosystemFund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeToEcosystemFund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnrealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+			}
+			m.BlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionSettledEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionSettledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettledCoins = append(m.SettledCoins, types.Coin{})
+			if err := m.SettledCoins[len(m.SettledCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingRateChangedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingRateChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IndexPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestFundingRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestFundingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestPremiumFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestPremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePremiumFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+			}
+			m.BlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *FundingRateChangedEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Pair)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = m.MarkPrice.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.IndexPrice.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.LatestFundingRate.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.LatestPremiumFraction.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.CumulativePremiumFraction.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvent(uint64(m.BlockHeight))
-	}
-	if m.BlockTimeMs != 0 {
-		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
+func (m *OrderFilledEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1130,13 +4321,32 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionChangedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrderFilledEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrderFilledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
@@ -1168,7 +4378,7 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.Pair = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
 			}
@@ -1200,11 +4410,11 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.TraderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
 			}
-			var msglen int
+			m.OrderType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1214,28 +4424,33 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.OrderType |= OrderType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
 			}
-			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1263,13 +4478,13 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1297,15 +4512,15 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransactionFee", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var msglen int
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1315,30 +4530,66 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TransactionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderRejectedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
 			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionSize", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderRejectedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderRejectedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1348,29 +4599,14 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1398,13 +4634,11 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnlAfter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1432,15 +4666,13 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnrealizedPnlAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
 			}
-			var msglen int
+			m.OrderType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1450,28 +4682,33 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.OrderType |= OrderType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
 			}
-			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 11:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPenalty", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1499,11 +4736,11 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidationPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
 			}
@@ -1537,9 +4774,9 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1567,11 +4804,9 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 9:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
@@ -1590,25 +4825,6 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
-			}
-			m.BlockTimeMs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockTimeMs |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -1630,7 +4846,7 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
+func (m *MarginModeChangedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1653,15 +4869,15 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionLiquidatedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarginModeChangedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionLiquidatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarginModeChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1689,11 +4905,99 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pair = string(dAtA[iNdEx:postIndex])
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginMode", wireType)
+			}
+			m.MarginMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarginMode |= MarginMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsuranceFundDepositEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsuranceFundDepositEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsuranceFundDepositEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1721,13 +5025,13 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedQuoteAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1737,31 +5041,30 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedQuoteAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1771,29 +5074,78 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsuranceFundWithdrawEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsuranceFundWithdrawEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsuranceFundWithdrawEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1821,11 +5173,11 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeToLiquidator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1852,13 +5204,13 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeeToLiquidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeToEcosystemFund", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1885,15 +5237,65 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeeToEcosystemFund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Withdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TradingHaltChangedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradingHaltChangedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradingHaltChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1903,30 +5305,29 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1936,28 +5337,15 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+			m.Halted = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1985,15 +5373,13 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChangedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionSize", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2003,29 +5389,64 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidationPriorityWindowStartedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
 			}
-			iNdEx = postIndex
-		case 12:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidationPriorityWindowStartedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidationPriorityWindowStartedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2053,13 +5474,11 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnrealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2087,15 +5506,13 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorAddress", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2105,16 +5522,29 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionlessFromHeight", wireType)
 			}
-			m.BlockTimeMs = 0
+			m.PermissionlessFromHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2124,7 +5554,7 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockTimeMs |= int64(b&0x7F) << shift
+				m.PermissionlessFromHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2150,7 +5580,7 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
+func (m *MakerRebatePaidEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2173,10 +5603,10 @@ func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionSettledEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MakerRebatePaidEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionSettledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MakerRebatePaidEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2245,7 +5675,7 @@ func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettledCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rebate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2272,11 +5702,29 @@ func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SettledCoins = append(m.SettledCoins, types.Coin{})
-			if err := m.SettledCoins[len(m.SettledCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rebate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -2298,7 +5746,7 @@ func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
+func (m *PositionAutoDeleveragedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2321,10 +5769,10 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FundingRateChangedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: PositionAutoDeleveragedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FundingRateChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PositionAutoDeleveragedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2361,7 +5809,7 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2389,13 +5837,11 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2423,13 +5869,13 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IndexPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestFundingRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2457,15 +5903,15 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LatestFundingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestPremiumFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Haircut", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2475,29 +5921,47 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LatestPremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Haircut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rank", wireType)
+			}
+			m.Rank = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rank |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePremiumFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2525,11 +5989,11 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
@@ -2548,11 +6012,93 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubaccountMarginTransferredEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubaccountMarginTransferredEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubaccountMarginTransferredEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSubaccountId", wireType)
 			}
-			m.BlockTimeMs = 0
+			m.FromSubaccountId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2562,7 +6108,78 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockTimeMs |= int64(b&0x7F) << shift
+				m.FromSubaccountId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToSubaccountId", wireType)
+			}
+			m.ToSubaccountId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToSubaccountId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
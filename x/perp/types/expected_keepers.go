@@ -31,6 +31,7 @@ type AccountKeeper interface {
 type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(
 		ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string,
 		amt sdk.Coins,
@@ -41,7 +42,9 @@ type BankKeeper interface {
 		ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress,
 		amt sdk.Coins,
 	) error
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 }
 
 type PricefeedKeeper interface {
@@ -49,6 +52,7 @@ type PricefeedKeeper interface {
 	GatherRawPrices(ctx sdk.Context, token0 string, token1 string) error
 	IsActivePair(ctx sdk.Context, pairID string) bool
 	GetCurrentTWAP(ctx sdk.Context, token0 string, token1 string) (sdk.Dec, error)
+	GetSnapshotRetentionWindow(ctx sdk.Context) time.Duration
 }
 
 type VpoolKeeper interface {
@@ -108,8 +112,11 @@ type VpoolKeeper interface {
 	IsOverSpreadLimit(ctx sdk.Context, pair common.AssetPair) bool
 	GetMaintenanceMarginRatio(ctx sdk.Context, pair common.AssetPair) sdk.Dec
 	GetMaxLeverage(ctx sdk.Context, pair common.AssetPair) sdk.Dec
+	GetPositionLimits(ctx sdk.Context, pair common.AssetPair) (maxOpenInterest sdk.Dec, maxPositionSize sdk.Dec)
 	ExistsPool(ctx sdk.Context, pair common.AssetPair) bool
 	GetSettlementPrice(ctx sdk.Context, pair common.AssetPair) (sdk.Dec, error)
+	GetMarkPriceRange(ctx sdk.Context, pair common.AssetPair, lookbackInterval time.Duration) (low sdk.Dec, high sdk.Dec, err error)
+	GetSnapshotRetentionWindow(ctx sdk.Context) time.Duration
 }
 
 type EpochKeeper interface {
@@ -2,15 +2,22 @@ package types
 
 import (
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:          DefaultParams(),
-		PairMetadata:    []PairMetadata{},
-		Positions:       []Position{},
-		PrepaidBadDebts: []PrepaidBadDebt{},
+		Params:                     DefaultParams(),
+		PairMetadata:               []PairMetadata{},
+		Positions:                  []Position{},
+		PrepaidBadDebts:            []PrepaidBadDebt{},
+		Orders:                     []Order{},
+		CrossMarginAccounts:        []string{},
+		CumulativePremiumFractions: []CumulativePremiumFraction{},
+		InsuranceFundWithdrawals:   []InsuranceFundWithdrawal{},
+		Subaccounts:                []Subaccount{},
 	}
 }
 
@@ -21,8 +28,23 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	crossMarginAccounts := make(map[string]struct{}, len(gs.CrossMarginAccounts))
+	for i, trader := range gs.CrossMarginAccounts {
+		if _, err := sdk.AccAddressFromBech32(trader); err != nil {
+			return fmt.Errorf("malformed cross margin account %s at index %d: %w", trader, i, err)
+		}
+		if _, duplicate := crossMarginAccounts[trader]; duplicate {
+			return fmt.Errorf("duplicate cross margin account %s at index %d", trader, i)
+		}
+		crossMarginAccounts[trader] = struct{}{}
+	}
+
 	for i, pos := range gs.Positions {
-		if err := pos.Validate(); err != nil {
+		validate := pos.Validate
+		if _, isCrossMargin := crossMarginAccounts[pos.TraderAddress]; isCrossMargin {
+			validate = pos.ValidateCrossMargin
+		}
+		if err := validate(); err != nil {
 			return fmt.Errorf("malformed genesis position %s at index %d: %w", &pos, i, err)
 		}
 	}
@@ -33,11 +55,57 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	fundingEpochs := make(map[string]struct{}, len(gs.CumulativePremiumFractions))
+	for i, f := range gs.CumulativePremiumFractions {
+		if err := f.Validate(); err != nil {
+			return fmt.Errorf("malformed cumulative premium fraction %s at index %d: %w", &f, i, err)
+		}
+		fundingEpoch := fmt.Sprintf("%s/%d", f.Pair, f.Epoch)
+		if _, duplicate := fundingEpochs[fundingEpoch]; duplicate {
+			return fmt.Errorf("duplicate cumulative premium fraction for pair %s and epoch %d at index %d", f.Pair, f.Epoch, i)
+		}
+		fundingEpochs[fundingEpoch] = struct{}{}
+	}
+
 	for i, m := range gs.PrepaidBadDebts {
 		if err := m.Validate(); err != nil {
 			return fmt.Errorf("malformed prepaid bad debt %s at index %d: %w", m, i, err)
 		}
 	}
 
+	orderIDs := make(map[uint64]struct{}, len(gs.Orders))
+	for i, o := range gs.Orders {
+		if err := o.Validate(); err != nil {
+			return fmt.Errorf("malformed genesis order %s at index %d: %w", &o, i, err)
+		}
+		if _, duplicate := orderIDs[o.Id]; duplicate {
+			return fmt.Errorf("duplicate genesis order id %d at index %d", o.Id, i)
+		}
+		orderIDs[o.Id] = struct{}{}
+	}
+
+	withdrawals := make(map[string]struct{}, len(gs.InsuranceFundWithdrawals))
+	for i, w := range gs.InsuranceFundWithdrawals {
+		if err := w.Validate(); err != nil {
+			return fmt.Errorf("malformed insurance fund withdrawal %s at index %d: %w", &w, i, err)
+		}
+		withdrawal := w.Depositor + "/" + w.Shares.Denom
+		if _, duplicate := withdrawals[withdrawal]; duplicate {
+			return fmt.Errorf("duplicate insurance fund withdrawal of %s from %s at index %d", w.Depositor, w.Shares.Denom, i)
+		}
+		withdrawals[withdrawal] = struct{}{}
+	}
+
+	subaccounts := make(map[string]struct{}, len(gs.Subaccounts))
+	for i, s := range gs.Subaccounts {
+		if err := s.Validate(); err != nil {
+			return fmt.Errorf("malformed subaccount %s at index %d: %w", &s, i, err)
+		}
+		if _, duplicate := subaccounts[s.Address]; duplicate {
+			return fmt.Errorf("duplicate subaccount %d of %s at index %d", s.Id, s.Owner, i)
+		}
+		subaccounts[s.Address] = struct{}{}
+	}
+
 	return nil
 }
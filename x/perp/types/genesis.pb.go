@@ -32,6 +32,12 @@ type GenesisState struct {
 	PairMetadata    []PairMetadata   `protobuf:"bytes,2,rep,name=pair_metadata,json=pairMetadata,proto3" json:"pair_metadata"`
 	Positions       []Position       `protobuf:"bytes,3,rep,name=positions,proto3" json:"positions"`
 	PrepaidBadDebts []PrepaidBadDebt `protobuf:"bytes,4,rep,name=prepaid_bad_debts,json=prepaidBadDebts,proto3" json:"prepaid_bad_debts"`
+	Orders          []Order          `protobuf:"bytes,5,rep,name=orders,proto3" json:"orders"`
+	// addresses of the traders that opted into cross margin mode
+	CrossMarginAccounts        []string                    `protobuf:"bytes,6,rep,name=cross_margin_accounts,json=crossMarginAccounts,proto3" json:"cross_margin_accounts,omitempty"`
+	CumulativePremiumFractions []CumulativePremiumFraction `protobuf:"bytes,7,rep,name=cumulative_premium_fractions,json=cumulativePremiumFractions,proto3" json:"cumulative_premium_fractions"`
+	InsuranceFundWithdrawals   []InsuranceFundWithdrawal   `protobuf:"bytes,8,rep,name=insurance_fund_withdrawals,json=insuranceFundWithdrawals,proto3" json:"insurance_fund_withdrawals"`
+	Subaccounts                []Subaccount                `protobuf:"bytes,9,rep,name=subaccounts,proto3" json:"subaccounts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -95,6 +101,41 @@ func (m *GenesisState) GetPrepaidBadDebts() []PrepaidBadDebt {
 	return nil
 }
 
+func (m *GenesisState) GetOrders() []Order {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCrossMarginAccounts() []string {
+	if m != nil {
+		return m.CrossMarginAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCumulativePremiumFractions() []CumulativePremiumFraction {
+	if m != nil {
+		return m.CumulativePremiumFractions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInsuranceFundWithdrawals() []InsuranceFundWithdrawal {
+	if m != nil {
+		return m.InsuranceFundWithdrawals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSubaccounts() []Subaccount {
+	if m != nil {
+		return m.Subaccounts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "nibiru.perp.v1.GenesisState")
 }
@@ -102,29 +143,39 @@ func init() {
 func init() { proto.RegisterFile("perp/v1/genesis.proto", fileDescriptor_24e163498ed621a8) }
 
 var fileDescriptor_24e163498ed621a8 = []byte{
-	// 348 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xcf, 0x6a, 0x2a, 0x31,
-	0x14, 0xc6, 0x67, 0x54, 0x84, 0x3b, 0x7a, 0xef, 0xa5, 0xd3, 0x3f, 0x0c, 0x22, 0xa9, 0x74, 0x25,
-	0x5d, 0x4c, 0x18, 0xdb, 0x65, 0x57, 0x56, 0x70, 0xd5, 0x22, 0xed, 0xae, 0x1b, 0x39, 0x99, 0x09,
-	0x63, 0xa0, 0x93, 0x13, 0x26, 0x51, 0xda, 0xb7, 0xe8, 0x0b, 0xf4, 0x7d, 0x5c, 0xba, 0xec, 0xaa,
-	0x14, 0x7d, 0x91, 0x62, 0x26, 0xd2, 0x8a, 0xab, 0x84, 0xef, 0xfb, 0xce, 0x2f, 0x5f, 0x4e, 0x70,
-	0xaa, 0x78, 0xa9, 0xe8, 0x22, 0xa1, 0x39, 0x97, 0x5c, 0x0b, 0x1d, 0xab, 0x12, 0x0d, 0x86, 0xff,
-	0xa4, 0x60, 0xa2, 0x9c, 0xc7, 0x5b, 0x37, 0x5e, 0x24, 0x9d, 0x93, 0x1c, 0x73, 0xb4, 0x16, 0xdd,
-	0xde, 0xaa, 0x54, 0xa7, 0x9b, 0x23, 0xe6, 0xcf, 0x9c, 0x82, 0x12, 0x14, 0xa4, 0x44, 0x03, 0x46,
-	0xa0, 0x74, 0x8c, 0x0e, 0x49, 0x51, 0x17, 0xa8, 0x29, 0x03, 0xcd, 0xe9, 0x22, 0x61, 0xdc, 0x40,
-	0x42, 0x53, 0x14, 0xd2, 0xf9, 0xc7, 0x29, 0x16, 0x05, 0x4a, 0x5a, 0x1d, 0x3b, 0x71, 0xd7, 0x47,
-	0x1b, 0x30, 0xbc, 0x12, 0x2f, 0xde, 0x6b, 0x41, 0x7b, 0x5c, 0xf5, 0x7b, 0xdc, 0xca, 0xe1, 0x75,
-	0xd0, 0x54, 0x50, 0x42, 0xa1, 0x23, 0xbf, 0xe7, 0xf7, 0x5b, 0x83, 0xb3, 0x78, 0xbf, 0x6f, 0x3c,
-	0xb1, 0xee, 0xb0, 0xb1, 0xfc, 0x3c, 0xf7, 0x1e, 0x5c, 0x36, 0x1c, 0x07, 0x7f, 0x15, 0x88, 0x72,
-	0x5a, 0x70, 0x03, 0x19, 0x18, 0x88, 0x6a, 0xbd, 0x7a, 0xbf, 0x35, 0xe8, 0x1e, 0x0e, 0x8b, 0xf2,
-	0xce, 0x65, 0x1c, 0xa2, 0xad, 0x7e, 0x69, 0xe1, 0x4d, 0xf0, 0x47, 0xa1, 0x16, 0xf6, 0xb3, 0x51,
-	0xdd, 0x42, 0xa2, 0x03, 0x88, 0x0b, 0x38, 0xc0, 0xcf, 0x40, 0x38, 0x09, 0x8e, 0x54, 0xc9, 0x15,
-	0x88, 0x6c, 0xca, 0x20, 0x9b, 0x66, 0x9c, 0x19, 0x1d, 0x35, 0x2c, 0x85, 0x1c, 0x50, 0xaa, 0xe0,
-	0x10, 0xb2, 0x11, 0x67, 0xc6, 0xb1, 0xfe, 0xab, 0x3d, 0x55, 0x0f, 0x47, 0xcb, 0x35, 0xf1, 0x57,
-	0x6b, 0xe2, 0x7f, 0xad, 0x89, 0xff, 0xb6, 0x21, 0xde, 0x6a, 0x43, 0xbc, 0x8f, 0x0d, 0xf1, 0x9e,
-	0x2e, 0x73, 0x61, 0x66, 0x73, 0x16, 0xa7, 0x58, 0xd0, 0x7b, 0x8b, 0xbe, 0x9d, 0x81, 0x90, 0xb4,
-	0x7a, 0x86, 0xbe, 0x50, 0xbb, 0x6e, 0xf3, 0xaa, 0xb8, 0x66, 0x4d, 0xbb, 0xec, 0xab, 0xef, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x20, 0xf0, 0x8f, 0x0d, 0x13, 0x02, 0x00, 0x00,
+	// 508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x6f, 0x12, 0x41,
+	0x18, 0xc6, 0x41, 0x5a, 0x94, 0xa1, 0x6a, 0xdc, 0x8a, 0xd9, 0x6c, 0xc8, 0x4a, 0xbc, 0x88, 0x1e,
+	0x76, 0x02, 0xf5, 0xe8, 0x45, 0xda, 0xb4, 0xf1, 0x50, 0x25, 0xed, 0xc1, 0xc4, 0xcb, 0xe6, 0xdd,
+	0xd9, 0xe9, 0x32, 0x91, 0x9d, 0x19, 0xe7, 0x0f, 0xd5, 0x6f, 0xe1, 0xe7, 0xf0, 0x93, 0xf4, 0xd8,
+	0xa3, 0x27, 0x63, 0xe0, 0x8b, 0x18, 0x66, 0x07, 0x6c, 0xc1, 0x9e, 0x20, 0xef, 0xf3, 0x3c, 0xbf,
+	0xe7, 0xdd, 0x37, 0x83, 0x3a, 0x92, 0x2a, 0x89, 0x67, 0x03, 0x5c, 0x50, 0x4e, 0x35, 0xd3, 0x89,
+	0x54, 0xc2, 0x88, 0xe0, 0x11, 0x67, 0x19, 0x53, 0x36, 0x59, 0xaa, 0xc9, 0x6c, 0x10, 0x3d, 0x2d,
+	0x44, 0x21, 0x9c, 0x84, 0x97, 0xff, 0x2a, 0x57, 0xd4, 0x2d, 0x84, 0x28, 0xa6, 0x14, 0x83, 0x64,
+	0x18, 0x38, 0x17, 0x06, 0x0c, 0x13, 0xdc, 0x33, 0xa2, 0x98, 0x08, 0x5d, 0x0a, 0x8d, 0x33, 0xd0,
+	0x14, 0xcf, 0x06, 0x19, 0x35, 0x30, 0xc0, 0x44, 0x30, 0xee, 0xf5, 0x7d, 0x22, 0xca, 0x52, 0x70,
+	0x5c, 0xfd, 0xac, 0x86, 0xab, 0x7d, 0xb4, 0x01, 0x43, 0xab, 0xe1, 0x8b, 0x9f, 0xbb, 0x68, 0xef,
+	0xa4, 0xda, 0xef, 0x7c, 0x39, 0x0e, 0xde, 0xa0, 0xa6, 0x04, 0x05, 0xa5, 0x0e, 0xeb, 0xbd, 0x7a,
+	0xbf, 0x3d, 0x7c, 0x96, 0xdc, 0xde, 0x37, 0x19, 0x3b, 0x75, 0xb4, 0x73, 0xf5, 0xfb, 0x79, 0xed,
+	0xcc, 0x7b, 0x83, 0x13, 0xf4, 0x50, 0x02, 0x53, 0x69, 0x49, 0x0d, 0xe4, 0x60, 0x20, 0xbc, 0xd7,
+	0x6b, 0xf4, 0xdb, 0xc3, 0xee, 0x76, 0x98, 0xa9, 0x53, 0xef, 0xf1, 0x88, 0x3d, 0x79, 0x63, 0x16,
+	0xbc, 0x45, 0x2d, 0x29, 0x34, 0x73, 0x1f, 0x1b, 0x36, 0x1c, 0x24, 0xdc, 0x82, 0x78, 0x83, 0x07,
+	0xfc, 0x0b, 0x04, 0x63, 0xf4, 0x44, 0x2a, 0x2a, 0x81, 0xe5, 0x69, 0x06, 0x79, 0x9a, 0xd3, 0xcc,
+	0xe8, 0x70, 0xc7, 0x51, 0xe2, 0x2d, 0x4a, 0x65, 0x1c, 0x41, 0x7e, 0x44, 0x33, 0xe3, 0x59, 0x8f,
+	0xe5, 0xad, 0xa9, 0x0e, 0x0e, 0x50, 0x53, 0xa8, 0x9c, 0x2a, 0x1d, 0xee, 0x3a, 0x4c, 0x67, 0x13,
+	0xf3, 0x71, 0xa9, 0xae, 0xae, 0x51, 0x59, 0x83, 0x21, 0xea, 0x10, 0x25, 0xb4, 0x4e, 0x4b, 0x50,
+	0x05, 0xe3, 0x29, 0x10, 0x22, 0x2c, 0x37, 0x3a, 0x6c, 0xf6, 0x1a, 0xfd, 0xd6, 0xd9, 0xbe, 0x13,
+	0x4f, 0x9d, 0xf6, 0xce, 0x4b, 0xc1, 0x57, 0xd4, 0x25, 0xb6, 0xb4, 0x53, 0x30, 0x6c, 0x46, 0x53,
+	0xa9, 0x68, 0xc9, 0x6c, 0x99, 0x5e, 0x28, 0x20, 0xd5, 0x2d, 0xee, 0xbb, 0xfa, 0x57, 0x9b, 0xf5,
+	0x87, 0xeb, 0xcc, 0xb8, 0x8a, 0x1c, 0xfb, 0x84, 0x5f, 0x29, 0x22, 0x77, 0x19, 0x74, 0xf0, 0x05,
+	0x45, 0x8c, 0x6b, 0xab, 0x80, 0x13, 0x9a, 0x5e, 0x58, 0x9e, 0xa7, 0x97, 0xcc, 0x4c, 0x72, 0x05,
+	0x97, 0x30, 0xd5, 0xe1, 0x03, 0x57, 0xf8, 0x72, 0xb3, 0xf0, 0xfd, 0x2a, 0x71, 0x6c, 0x79, 0xfe,
+	0x69, 0xed, 0xf7, 0x75, 0x21, 0xfb, 0xbf, 0xac, 0x83, 0x11, 0x6a, 0x6b, 0x9b, 0xad, 0x2f, 0xd1,
+	0x72, 0xf4, 0x68, 0x93, 0x7e, 0xbe, 0xb6, 0x78, 0xe0, 0xcd, 0xd0, 0xe8, 0xe8, 0x6a, 0x1e, 0xd7,
+	0xaf, 0xe7, 0x71, 0xfd, 0xcf, 0x3c, 0xae, 0xff, 0x58, 0xc4, 0xb5, 0xeb, 0x45, 0x5c, 0xfb, 0xb5,
+	0x88, 0x6b, 0x9f, 0x5f, 0x17, 0xcc, 0x4c, 0x6c, 0x96, 0x10, 0x51, 0xe2, 0x0f, 0x0e, 0x79, 0x38,
+	0x01, 0xc6, 0x71, 0x85, 0xc7, 0xdf, 0xb0, 0x7b, 0xfb, 0xe6, 0xbb, 0xa4, 0x3a, 0x6b, 0xba, 0x97,
+	0x7f, 0xf0, 0x77, 0x00, 0xad, 0xae, 0x4a, 0x8d, 0xa0, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -147,6 +198,71 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Subaccounts) > 0 {
+		for iNdEx := len(m.Subaccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subaccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.InsuranceFundWithdrawals) > 0 {
+		for iNdEx := len(m.InsuranceFundWithdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InsuranceFundWithdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.CumulativePremiumFractions) > 0 {
+		for iNdEx := len(m.CumulativePremiumFractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CumulativePremiumFractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.CrossMarginAccounts) > 0 {
+		for iNdEx := len(m.CrossMarginAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CrossMarginAccounts[iNdEx])
+			copy(dAtA[i:], m.CrossMarginAccounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.CrossMarginAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.PrepaidBadDebts) > 0 {
 		for iNdEx := len(m.PrepaidBadDebts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -239,6 +355,36 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CrossMarginAccounts) > 0 {
+		for _, s := range m.CrossMarginAccounts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CumulativePremiumFractions) > 0 {
+		for _, e := range m.CumulativePremiumFractions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InsuranceFundWithdrawals) > 0 {
+		for _, e := range m.InsuranceFundWithdrawals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Subaccounts) > 0 {
+		for _, e := range m.Subaccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -412,6 +558,174 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossMarginAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrossMarginAccounts = append(m.CrossMarginAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePremiumFractions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CumulativePremiumFractions = append(m.CumulativePremiumFractions, CumulativePremiumFraction{})
+			if err := m.CumulativePremiumFractions[len(m.CumulativePremiumFractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFundWithdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InsuranceFundWithdrawals = append(m.InsuranceFundWithdrawals, InsuranceFundWithdrawal{})
+			if err := m.InsuranceFundWithdrawals[len(m.InsuranceFundWithdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subaccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subaccounts = append(m.Subaccounts, Subaccount{})
+			if err := m.Subaccounts[len(m.Subaccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
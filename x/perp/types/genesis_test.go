@@ -22,8 +22,14 @@ func TestGenesisState_Validate(t *testing.T) {
 				Params: DefaultParams(),
 				PairMetadata: []PairMetadata{
 					{
-						Pair:                       common.MustNewAssetPair("pair1:pair2"),
-						CumulativePremiumFractions: []sdk.Dec{sdk.MustNewDecFromStr("0.1")},
+						Pair: common.MustNewAssetPair("pair1:pair2"),
+					},
+				},
+				CumulativePremiumFractions: []CumulativePremiumFraction{
+					{
+						Pair:  common.MustNewAssetPair("pair1:pair2"),
+						Epoch: 0,
+						Value: sdk.MustNewDecFromStr("0.1"),
 					},
 				},
 				Positions: []Position{
@@ -67,6 +73,31 @@ func TestGenesisState_Validate(t *testing.T) {
 			wantErr: true,
 		},
 
+		"cross margin position without margin": {
+			g: func() *GenesisState {
+				trader := testutil.AccAddress().String()
+				return &GenesisState{
+					Params:              DefaultParams(),
+					CrossMarginAccounts: []string{trader},
+					Positions: []Position{
+						{
+							TraderAddress:                   trader,
+							Pair:                            common.MustNewAssetPair("valid:pair"),
+							Size_:                           sdk.MustNewDecFromStr("1000"),
+							Margin:                          sdk.ZeroDec(),
+							OpenNotional:                    sdk.MustNewDecFromStr("1000"),
+							LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("1"),
+						},
+					},
+				}
+			}(),
+			wantErr: false,
+		},
+		"bad cross margin account": {
+			g:       &GenesisState{Params: DefaultParams(), CrossMarginAccounts: []string{"foobar"}},
+			wantErr: true,
+		},
+
 		"bad prepaid bad debt": {
 			g: &GenesisState{Params: DefaultParams(), PrepaidBadDebts: []PrepaidBadDebt{{
 				Denom:  ":invalid:Denom",
@@ -74,6 +105,170 @@ func TestGenesisState_Validate(t *testing.T) {
 			}}},
 			wantErr: true,
 		},
+
+		"bad cumulative premium fraction": {
+			g: &GenesisState{Params: DefaultParams(), CumulativePremiumFractions: []CumulativePremiumFraction{{
+				Pair: common.Pair_BTC_NUSD,
+			}}},
+			wantErr: true,
+		},
+
+		"insurance fund withdrawal of a non share denom": {
+			g: &GenesisState{Params: DefaultParams(), InsuranceFundWithdrawals: []InsuranceFundWithdrawal{{
+				Depositor: testutil.AccAddress().String(),
+				Shares:    sdk.NewInt64Coin("unusd", 10),
+			}}},
+			wantErr: true,
+		},
+
+		"duplicate insurance fund withdrawal": {
+			g: func() *GenesisState {
+				depositor := testutil.AccAddress().String()
+				withdrawal := InsuranceFundWithdrawal{
+					Depositor: depositor,
+					Shares:    sdk.NewInt64Coin(InsuranceFundShareDenom("unusd"), 10),
+				}
+				return &GenesisState{Params: DefaultParams(), InsuranceFundWithdrawals: []InsuranceFundWithdrawal{withdrawal, withdrawal}}
+			}(),
+			wantErr: true,
+		},
+
+		"subaccount with a wrong address": {
+			g: &GenesisState{Params: DefaultParams(), Subaccounts: []Subaccount{{
+				Owner:   testutil.AccAddress().String(),
+				Id:      1,
+				Address: testutil.AccAddress().String(),
+			}}},
+			wantErr: true,
+		},
+
+		"duplicate subaccount": {
+			g: func() *GenesisState {
+				subaccount := NewSubaccount(testutil.AccAddress(), 1)
+				return &GenesisState{Params: DefaultParams(), Subaccounts: []Subaccount{subaccount, subaccount}}
+			}(),
+			wantErr: true,
+		},
+
+		"invalid guardian": {
+			g: func() *GenesisState {
+				params := DefaultParams()
+				params.Guardian = "foobar"
+				return &GenesisState{Params: params}
+			}(),
+			wantErr: true,
+		},
+
+		"invalid halted pair": {
+			g: func() *GenesisState {
+				params := DefaultParams()
+				params.HaltedPairs = []string{"ubtc"}
+				return &GenesisState{Params: params}
+			}(),
+			wantErr: true,
+		},
+
+		"negative circuit breaker band": {
+			g: func() *GenesisState {
+				params := DefaultParams()
+				params.CircuitBreakerBand = sdk.NewDec(-1)
+				return &GenesisState{Params: params}
+			}(),
+			wantErr: true,
+		},
+
+		"max liquidator reward ratio below one half": {
+			g: func() *GenesisState {
+				params := DefaultParams()
+				params.MaxLiquidatorRewardRatio = sdk.MustNewDecFromStr("0.4")
+				return &GenesisState{Params: params}
+			}(),
+			wantErr: true,
+		},
+
+		"max liquidator reward ratio above one": {
+			g: func() *GenesisState {
+				params := DefaultParams()
+				params.MaxLiquidatorRewardRatio = sdk.MustNewDecFromStr("1.1")
+				return &GenesisState{Params: params}
+			}(),
+			wantErr: true,
+		},
+
+		"fee tiers": {
+			g: func() *GenesisState {
+				params := DefaultParams()
+				params.FeeTiers = []FeeTier{
+					{MinVolume: sdk.NewDec(1_000), FeePoolFeeRatio: sdk.MustNewDecFromStr("0.0005"), EcosystemFundFeeRatio: sdk.MustNewDecFromStr("0.0005")},
+					{MinVolume: sdk.NewDec(10_000), FeePoolFeeRatio: sdk.ZeroDec(), EcosystemFundFeeRatio: sdk.MustNewDecFromStr("0.0005")},
+				}
+				params.MakerRebateRatio = sdk.MustNewDecFromStr("0.0001")
+				return &GenesisState{Params: params}
+			}(),
+			wantErr: false,
+		},
+
+		"fee tiers not sorted by min volume": {
+			g: func() *GenesisState {
+				params := DefaultParams()
+				params.FeeTiers = []FeeTier{
+					{MinVolume: sdk.NewDec(10_000), FeePoolFeeRatio: sdk.ZeroDec(), EcosystemFundFeeRatio: sdk.ZeroDec()},
+					{MinVolume: sdk.NewDec(1_000), FeePoolFeeRatio: sdk.ZeroDec(), EcosystemFundFeeRatio: sdk.ZeroDec()},
+				}
+				return &GenesisState{Params: params}
+			}(),
+			wantErr: true,
+		},
+
+		"fee tier ratio above one": {
+			g: func() *GenesisState {
+				params := DefaultParams()
+				params.FeeTiers = []FeeTier{
+					{MinVolume: sdk.NewDec(1_000), FeePoolFeeRatio: sdk.NewDec(2), EcosystemFundFeeRatio: sdk.ZeroDec()},
+				}
+				return &GenesisState{Params: params}
+			}(),
+			wantErr: true,
+		},
+
+		"maker rebate ratio not below the base taker fee ratio": {
+			g: func() *GenesisState {
+				params := DefaultParams()
+				params.MakerRebateRatio = params.FeePoolFeeRatio.Add(params.EcosystemFundFeeRatio)
+				return &GenesisState{Params: params}
+			}(),
+			wantErr: true,
+		},
+
+		"maker rebate ratio not below the taker fee ratio of a fee tier": {
+			g: func() *GenesisState {
+				params := DefaultParams()
+				params.FeeTiers = []FeeTier{
+					{MinVolume: sdk.NewDec(1_000), FeePoolFeeRatio: sdk.MustNewDecFromStr("0.0005"), EcosystemFundFeeRatio: sdk.MustNewDecFromStr("0.0005")},
+					{MinVolume: sdk.NewDec(10_000), FeePoolFeeRatio: sdk.ZeroDec(), EcosystemFundFeeRatio: sdk.MustNewDecFromStr("0.0001")},
+				}
+				params.MakerRebateRatio = sdk.MustNewDecFromStr("0.0001")
+				return &GenesisState{Params: params}
+			}(),
+			wantErr: true,
+		},
+
+		"negative maker rebate ratio": {
+			g: func() *GenesisState {
+				params := DefaultParams()
+				params.MakerRebateRatio = sdk.NewDec(-1)
+				return &GenesisState{Params: params}
+			}(),
+			wantErr: true,
+		},
+
+		"duplicate cumulative premium fraction": {
+			g: &GenesisState{Params: DefaultParams(), CumulativePremiumFractions: []CumulativePremiumFraction{
+				{Pair: common.Pair_BTC_NUSD, Epoch: 1, Value: sdk.OneDec()},
+				{Pair: common.Pair_BTC_NUSD, Epoch: 1, Value: sdk.ZeroDec()},
+			}},
+			wantErr: true,
+		},
 	}
 
 	for name, tc := range cases {
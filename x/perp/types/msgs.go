@@ -15,6 +15,15 @@ var _ sdk.Msg = &MsgLiquidate{}
 var _ sdk.Msg = &MsgOpenPosition{}
 var _ sdk.Msg = &MsgClosePosition{}
 var _ sdk.Msg = &MsgMultiLiquidate{}
+var _ sdk.Msg = &MsgPlaceOrder{}
+var _ sdk.Msg = &MsgCancelOrder{}
+var _ sdk.Msg = &MsgSetMarginMode{}
+var _ sdk.Msg = &MsgSettlePosition{}
+var _ sdk.Msg = &MsgDepositToInsuranceFund{}
+var _ sdk.Msg = &MsgRequestInsuranceFundWithdrawal{}
+var _ sdk.Msg = &MsgWithdrawFromInsuranceFund{}
+var _ sdk.Msg = &MsgSetTradingHalt{}
+var _ sdk.Msg = &MsgTransferSubaccountMargin{}
 
 // MsgRemoveMargin
 
@@ -203,9 +212,46 @@ func (m MsgClosePosition) ValidateBasic() error {
 	if _, err := common.NewAssetPair(m.TokenPair); err != nil {
 		return err
 	}
+
+	baseAssetAmount, fraction := m.GetBaseAssetAmountOrZero(), m.GetFractionOrZero()
+	if baseAssetAmount.IsNegative() {
+		return fmt.Errorf("base asset amount must not be negative")
+	}
+	if fraction.IsNegative() || fraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("fraction must be between zero and one")
+	}
+	if baseAssetAmount.IsPositive() && fraction.IsPositive() {
+		return fmt.Errorf("only one of the base asset amount and the fraction can be set")
+	}
+	if m.GetQuoteAssetAmountLimitOrZero().IsNegative() {
+		return fmt.Errorf("quote asset amount limit must not be negative")
+	}
 	return nil
 }
 
+// GetBaseAssetAmountOrZero returns the base asset amount, zero if it isn't set.
+func (m MsgClosePosition) GetBaseAssetAmountOrZero() sdk.Dec {
+	return decOrZero(m.BaseAssetAmount)
+}
+
+// GetFractionOrZero returns the fraction, zero if it isn't set.
+func (m MsgClosePosition) GetFractionOrZero() sdk.Dec {
+	return decOrZero(m.Fraction)
+}
+
+// GetQuoteAssetAmountLimitOrZero returns the quote asset amount limit, zero if it isn't set.
+func (m MsgClosePosition) GetQuoteAssetAmountLimitOrZero() sdk.Dec {
+	return decOrZero(m.QuoteAssetAmountLimit)
+}
+
+// decOrZero returns zero for the decimals left unset in the messages of the previous versions.
+func decOrZero(d sdk.Dec) sdk.Dec {
+	if d.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return d
+}
+
 func (m MsgClosePosition) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
 }
@@ -244,3 +290,287 @@ func (m MsgDonateToEcosystemFund) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{signer}
 }
+
+// MsgPlaceOrder
+
+func (m MsgPlaceOrder) Route() string { return RouterKey }
+func (m MsgPlaceOrder) Type() string  { return "place_order_msg" }
+
+func (m MsgPlaceOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if _, err := common.NewAssetPair(m.TokenPair); err != nil {
+		return err
+	}
+	if m.TriggerPrice.IsNil() || !m.TriggerPrice.IsPositive() {
+		return fmt.Errorf("trigger price must be positive")
+	}
+	if m.BaseAssetAmountLimit.IsNil() || m.BaseAssetAmountLimit.IsNegative() {
+		return fmt.Errorf("base asset amount limit must not be negative")
+	}
+
+	switch m.OrderType {
+	case OrderType_LIMIT:
+		if m.Side != Side_SELL && m.Side != Side_BUY {
+			return fmt.Errorf("invalid side")
+		}
+		if m.Leverage.IsNil() || !m.Leverage.IsPositive() {
+			return fmt.Errorf("leverage must always be greater than zero")
+		}
+		if m.QuoteAssetAmount.IsNil() || !m.QuoteAssetAmount.IsPositive() {
+			return fmt.Errorf("quote asset amount must be always greater than zero")
+		}
+	case OrderType_STOP_LOSS, OrderType_TAKE_PROFIT:
+	default:
+		return fmt.Errorf("invalid order type")
+	}
+
+	return nil
+}
+
+func (m MsgPlaceOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgPlaceOrder) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgCancelOrder
+
+func (m MsgCancelOrder) Route() string { return RouterKey }
+func (m MsgCancelOrder) Type() string  { return "cancel_order_msg" }
+
+func (m MsgCancelOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+func (m MsgCancelOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgCancelOrder) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgSetMarginMode
+
+func (m MsgSetMarginMode) Route() string { return RouterKey }
+func (m MsgSetMarginMode) Type() string  { return "set_margin_mode_msg" }
+
+func (m MsgSetMarginMode) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if m.MarginMode != MarginMode_ISOLATED && m.MarginMode != MarginMode_CROSS {
+		return fmt.Errorf("invalid margin mode: %s", m.MarginMode)
+	}
+	return nil
+}
+
+func (m MsgSetMarginMode) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetMarginMode) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgSettlePosition
+
+func (m MsgSettlePosition) Route() string { return RouterKey }
+func (m MsgSettlePosition) Type() string  { return "settle_position_msg" }
+
+func (m MsgSettlePosition) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if _, err := common.NewAssetPair(m.TokenPair); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m MsgSettlePosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSettlePosition) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgDepositToInsuranceFund
+
+func (m MsgDepositToInsuranceFund) Route() string { return RouterKey }
+func (m MsgDepositToInsuranceFund) Type() string  { return "deposit_to_insurance_fund_msg" }
+
+func (m MsgDepositToInsuranceFund) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := m.Deposit.Validate(); err != nil {
+		return err
+	}
+	if !m.Deposit.IsPositive() {
+		return fmt.Errorf("deposit must be positive: %s", m.Deposit)
+	}
+	if _, isShare := InsuranceFundQuoteDenom(m.Deposit.Denom); isShare {
+		return fmt.Errorf("insurance fund shares cannot be deposited: %s", m.Deposit.Denom)
+	}
+	return nil
+}
+
+func (m MsgDepositToInsuranceFund) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgDepositToInsuranceFund) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgRequestInsuranceFundWithdrawal
+
+func (m MsgRequestInsuranceFundWithdrawal) Route() string { return RouterKey }
+func (m MsgRequestInsuranceFundWithdrawal) Type() string {
+	return "request_insurance_fund_withdrawal_msg"
+}
+
+func (m MsgRequestInsuranceFundWithdrawal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := m.Shares.Validate(); err != nil {
+		return err
+	}
+	if !m.Shares.IsPositive() {
+		return fmt.Errorf("shares must be positive: %s", m.Shares)
+	}
+	if _, isShare := InsuranceFundQuoteDenom(m.Shares.Denom); !isShare {
+		return fmt.Errorf("not an insurance fund share denom: %s", m.Shares.Denom)
+	}
+	return nil
+}
+
+func (m MsgRequestInsuranceFundWithdrawal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRequestInsuranceFundWithdrawal) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgWithdrawFromInsuranceFund
+
+func (m MsgWithdrawFromInsuranceFund) Route() string { return RouterKey }
+func (m MsgWithdrawFromInsuranceFund) Type() string  { return "withdraw_from_insurance_fund_msg" }
+
+func (m MsgWithdrawFromInsuranceFund) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m MsgWithdrawFromInsuranceFund) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgWithdrawFromInsuranceFund) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgSetTradingHalt
+
+func (m MsgSetTradingHalt) Route() string { return RouterKey }
+func (m MsgSetTradingHalt) Type() string  { return "set_trading_halt_msg" }
+
+func (m MsgSetTradingHalt) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	// an empty token pair halts the whole exchange
+	if m.TokenPair == "" {
+		return nil
+	}
+	if _, err := common.NewAssetPair(m.TokenPair); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m MsgSetTradingHalt) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetTradingHalt) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgTransferSubaccountMargin
+
+func (m MsgTransferSubaccountMargin) Route() string { return RouterKey }
+func (m MsgTransferSubaccountMargin) Type() string  { return "transfer_subaccount_margin_msg" }
+
+func (m MsgTransferSubaccountMargin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if m.FromSubaccountId == m.ToSubaccountId {
+		return ErrInvalidSubaccount.Wrapf("cannot transfer from subaccount %d to itself", m.FromSubaccountId)
+	}
+	if !m.Amount.IsValid() || !m.Amount.IsPositive() {
+		return fmt.Errorf("invalid amount: %s", m.Amount.String())
+	}
+	return nil
+}
+
+func (m MsgTransferSubaccountMargin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTransferSubaccountMargin) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
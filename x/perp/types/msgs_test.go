@@ -267,6 +267,73 @@ func TestMsgOpenPosition_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgClosePosition_ValidateBasic(t *testing.T) {
+	cases := map[string]struct {
+		msg         *MsgClosePosition
+		expectedErr error
+	}{
+		"entire position": {
+			msg:         &MsgClosePosition{Sender: testutil.AccAddress().String(), TokenPair: "ubtc:unusd"},
+			expectedErr: nil,
+		},
+		"base asset amount with a quote limit": {
+			msg: &MsgClosePosition{
+				Sender:                testutil.AccAddress().String(),
+				TokenPair:             "ubtc:unusd",
+				BaseAssetAmount:       sdk.NewDec(10),
+				QuoteAssetAmountLimit: sdk.NewDec(100),
+			},
+			expectedErr: nil,
+		},
+		"fraction": {
+			msg:         &MsgClosePosition{Sender: testutil.AccAddress().String(), TokenPair: "ubtc:unusd", Fraction: sdk.MustNewDecFromStr("0.5")},
+			expectedErr: nil,
+		},
+		"invalid address": {
+			msg:         &MsgClosePosition{Sender: "foobar", TokenPair: "ubtc:unusd"},
+			expectedErr: fmt.Errorf("decoding bech32 failed"),
+		},
+		"invalid pair": {
+			msg:         &MsgClosePosition{Sender: testutil.AccAddress().String(), TokenPair: "ubtc"},
+			expectedErr: common.ErrInvalidTokenPair,
+		},
+		"negative base asset amount": {
+			msg:         &MsgClosePosition{Sender: testutil.AccAddress().String(), TokenPair: "ubtc:unusd", BaseAssetAmount: sdk.NewDec(-1)},
+			expectedErr: fmt.Errorf("base asset amount must not be negative"),
+		},
+		"fraction above one": {
+			msg:         &MsgClosePosition{Sender: testutil.AccAddress().String(), TokenPair: "ubtc:unusd", Fraction: sdk.NewDec(2)},
+			expectedErr: fmt.Errorf("fraction must be between zero and one"),
+		},
+		"both base asset amount and fraction": {
+			msg: &MsgClosePosition{
+				Sender:          testutil.AccAddress().String(),
+				TokenPair:       "ubtc:unusd",
+				BaseAssetAmount: sdk.NewDec(10),
+				Fraction:        sdk.MustNewDecFromStr("0.5"),
+			},
+			expectedErr: fmt.Errorf("only one of the base asset amount and the fraction can be set"),
+		},
+		"negative quote limit": {
+			msg:         &MsgClosePosition{Sender: testutil.AccAddress().String(), TokenPair: "ubtc:unusd", QuoteAssetAmountLimit: sdk.NewDec(-1)},
+			expectedErr: fmt.Errorf("quote asset amount limit must not be negative"),
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		name := name
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr != nil {
+				require.ErrorContains(t, err, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestMsgLiquidate_ValidateBasic(t *testing.T) {
 	type test struct {
 		msg     *MsgLiquidate
@@ -387,3 +454,282 @@ func TestMsgMultiLiquidate_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgPlaceOrder_ValidateBasic(t *testing.T) {
+	type test struct {
+		msg         *MsgPlaceOrder
+		expectedErr error
+	}
+
+	cases := map[string]test{
+		"ok limit": {
+			msg: &MsgPlaceOrder{
+				Sender:               testutil.AccAddress().String(),
+				TokenPair:            "NIBI:NUSD",
+				OrderType:            OrderType_LIMIT,
+				Side:                 Side_BUY,
+				TriggerPrice:         sdk.NewDec(10),
+				QuoteAssetAmount:     sdk.NewInt(100),
+				Leverage:             sdk.NewDec(5),
+				BaseAssetAmountLimit: sdk.ZeroInt(),
+			},
+			expectedErr: nil,
+		},
+		"ok stop loss": {
+			msg: &MsgPlaceOrder{
+				Sender:               testutil.AccAddress().String(),
+				TokenPair:            "NIBI:NUSD",
+				OrderType:            OrderType_STOP_LOSS,
+				TriggerPrice:         sdk.NewDec(10),
+				BaseAssetAmountLimit: sdk.ZeroInt(),
+			},
+			expectedErr: nil,
+		},
+		"stop loss without base asset amount limit": {
+			msg: &MsgPlaceOrder{
+				Sender:       testutil.AccAddress().String(),
+				TokenPair:    "NIBI:NUSD",
+				OrderType:    OrderType_STOP_LOSS,
+				TriggerPrice: sdk.NewDec(10),
+			},
+			expectedErr: fmt.Errorf("base asset amount limit must not be negative"),
+		},
+		"invalid address": {
+			msg: &MsgPlaceOrder{
+				Sender:       "foobar",
+				TokenPair:    "NIBI:NUSD",
+				OrderType:    OrderType_TAKE_PROFIT,
+				TriggerPrice: sdk.NewDec(10),
+			},
+			expectedErr: fmt.Errorf("decoding bech32 failed"),
+		},
+		"invalid token pair": {
+			msg: &MsgPlaceOrder{
+				Sender:       testutil.AccAddress().String(),
+				TokenPair:    "NIBI-NUSD",
+				OrderType:    OrderType_TAKE_PROFIT,
+				TriggerPrice: sdk.NewDec(10),
+			},
+			expectedErr: common.ErrInvalidTokenPair,
+		},
+		"invalid trigger price": {
+			msg: &MsgPlaceOrder{
+				Sender:       testutil.AccAddress().String(),
+				TokenPair:    "NIBI:NUSD",
+				OrderType:    OrderType_TAKE_PROFIT,
+				TriggerPrice: sdk.ZeroDec(),
+			},
+			expectedErr: fmt.Errorf("trigger price must be positive"),
+		},
+		"invalid order type": {
+			msg: &MsgPlaceOrder{
+				Sender:               testutil.AccAddress().String(),
+				TokenPair:            "NIBI:NUSD",
+				OrderType:            OrderType_ORDER_TYPE_UNSPECIFIED,
+				TriggerPrice:         sdk.NewDec(10),
+				BaseAssetAmountLimit: sdk.ZeroInt(),
+			},
+			expectedErr: fmt.Errorf("invalid order type"),
+		},
+		"limit with invalid side": {
+			msg: &MsgPlaceOrder{
+				Sender:               testutil.AccAddress().String(),
+				TokenPair:            "NIBI:NUSD",
+				OrderType:            OrderType_LIMIT,
+				Side:                 Side_SIDE_UNSPECIFIED,
+				TriggerPrice:         sdk.NewDec(10),
+				QuoteAssetAmount:     sdk.NewInt(100),
+				Leverage:             sdk.NewDec(5),
+				BaseAssetAmountLimit: sdk.ZeroInt(),
+			},
+			expectedErr: fmt.Errorf("invalid side"),
+		},
+		"limit with invalid leverage": {
+			msg: &MsgPlaceOrder{
+				Sender:               testutil.AccAddress().String(),
+				TokenPair:            "NIBI:NUSD",
+				OrderType:            OrderType_LIMIT,
+				Side:                 Side_SELL,
+				TriggerPrice:         sdk.NewDec(10),
+				QuoteAssetAmount:     sdk.NewInt(100),
+				Leverage:             sdk.ZeroDec(),
+				BaseAssetAmountLimit: sdk.ZeroInt(),
+			},
+			expectedErr: fmt.Errorf("leverage must always be greater than zero"),
+		},
+		"limit with invalid quote amount": {
+			msg: &MsgPlaceOrder{
+				Sender:               testutil.AccAddress().String(),
+				TokenPair:            "NIBI:NUSD",
+				OrderType:            OrderType_LIMIT,
+				Side:                 Side_SELL,
+				TriggerPrice:         sdk.NewDec(10),
+				QuoteAssetAmount:     sdk.ZeroInt(),
+				Leverage:             sdk.NewDec(5),
+				BaseAssetAmountLimit: sdk.ZeroInt(),
+			},
+			expectedErr: fmt.Errorf("quote asset amount must be always greater than zero"),
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		name := name
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr != nil {
+				require.ErrorContains(t, err, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgSetMarginMode_ValidateBasic(t *testing.T) {
+	cases := map[string]struct {
+		msg         *MsgSetMarginMode
+		expectedErr error
+	}{
+		"ok cross": {
+			msg:         &MsgSetMarginMode{Sender: testutil.AccAddress().String(), MarginMode: MarginMode_CROSS},
+			expectedErr: nil,
+		},
+		"ok isolated": {
+			msg:         &MsgSetMarginMode{Sender: testutil.AccAddress().String(), MarginMode: MarginMode_ISOLATED},
+			expectedErr: nil,
+		},
+		"invalid address": {
+			msg:         &MsgSetMarginMode{Sender: "foobar", MarginMode: MarginMode_CROSS},
+			expectedErr: fmt.Errorf("decoding bech32 failed"),
+		},
+		"invalid margin mode": {
+			msg:         &MsgSetMarginMode{Sender: testutil.AccAddress().String(), MarginMode: MarginMode_MARGIN_MODE_UNSPECIFIED},
+			expectedErr: fmt.Errorf("invalid margin mode"),
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		name := name
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr != nil {
+				require.ErrorContains(t, err, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgDepositToInsuranceFund_ValidateBasic(t *testing.T) {
+	cases := map[string]struct {
+		msg         *MsgDepositToInsuranceFund
+		expectedErr error
+	}{
+		"ok": {
+			msg:         &MsgDepositToInsuranceFund{Sender: testutil.AccAddress().String(), Deposit: sdk.NewInt64Coin("unusd", 100)},
+			expectedErr: nil,
+		},
+		"invalid address": {
+			msg:         &MsgDepositToInsuranceFund{Sender: "foobar", Deposit: sdk.NewInt64Coin("unusd", 100)},
+			expectedErr: fmt.Errorf("decoding bech32 failed"),
+		},
+		"zero deposit": {
+			msg:         &MsgDepositToInsuranceFund{Sender: testutil.AccAddress().String(), Deposit: sdk.NewInt64Coin("unusd", 0)},
+			expectedErr: fmt.Errorf("deposit must be positive"),
+		},
+		"share deposit": {
+			msg:         &MsgDepositToInsuranceFund{Sender: testutil.AccAddress().String(), Deposit: sdk.NewInt64Coin(InsuranceFundShareDenom("unusd"), 100)},
+			expectedErr: fmt.Errorf("insurance fund shares cannot be deposited"),
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		name := name
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr != nil {
+				require.ErrorContains(t, err, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgRequestInsuranceFundWithdrawal_ValidateBasic(t *testing.T) {
+	cases := map[string]struct {
+		msg         *MsgRequestInsuranceFundWithdrawal
+		expectedErr error
+	}{
+		"ok": {
+			msg:         &MsgRequestInsuranceFundWithdrawal{Sender: testutil.AccAddress().String(), Shares: sdk.NewInt64Coin(InsuranceFundShareDenom("unusd"), 100)},
+			expectedErr: nil,
+		},
+		"invalid address": {
+			msg:         &MsgRequestInsuranceFundWithdrawal{Sender: "foobar", Shares: sdk.NewInt64Coin(InsuranceFundShareDenom("unusd"), 100)},
+			expectedErr: fmt.Errorf("decoding bech32 failed"),
+		},
+		"zero shares": {
+			msg:         &MsgRequestInsuranceFundWithdrawal{Sender: testutil.AccAddress().String(), Shares: sdk.NewInt64Coin(InsuranceFundShareDenom("unusd"), 0)},
+			expectedErr: fmt.Errorf("shares must be positive"),
+		},
+		"not a share denom": {
+			msg:         &MsgRequestInsuranceFundWithdrawal{Sender: testutil.AccAddress().String(), Shares: sdk.NewInt64Coin("unusd", 100)},
+			expectedErr: fmt.Errorf("not an insurance fund share denom"),
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		name := name
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr != nil {
+				require.ErrorContains(t, err, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgSetTradingHalt_ValidateBasic(t *testing.T) {
+	cases := map[string]struct {
+		msg         *MsgSetTradingHalt
+		expectedErr error
+	}{
+		"ok": {
+			msg:         &MsgSetTradingHalt{Sender: testutil.AccAddress().String(), TokenPair: "ubtc:unusd", Halted: true},
+			expectedErr: nil,
+		},
+		"whole exchange": {
+			msg:         &MsgSetTradingHalt{Sender: testutil.AccAddress().String(), Halted: true},
+			expectedErr: nil,
+		},
+		"invalid address": {
+			msg:         &MsgSetTradingHalt{Sender: "foobar", TokenPair: "ubtc:unusd"},
+			expectedErr: fmt.Errorf("decoding bech32 failed"),
+		},
+		"invalid pair": {
+			msg:         &MsgSetTradingHalt{Sender: testutil.AccAddress().String(), TokenPair: "ubtc"},
+			expectedErr: common.ErrInvalidTokenPair,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		name := name
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr != nil {
+				require.ErrorContains(t, err, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/NibiruChain/nibiru/x/common"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -58,6 +60,71 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 			&p.WhitelistedLiquidators,
 			validateAddress,
 		),
+		paramtypes.NewParamSetPair(
+			[]byte("InsuranceFundFeeShare"),
+			&p.InsuranceFundFeeShare,
+			validatePercentageRatio,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("InsuranceFundWithdrawalCooldown"),
+			&p.InsuranceFundWithdrawalCooldown,
+			validateInsuranceFundWithdrawalCooldown,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("Guardian"),
+			&p.Guardian,
+			validateGuardian,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("HaltedPairs"),
+			&p.HaltedPairs,
+			validateHaltedPairs,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("CircuitBreakerBand"),
+			&p.CircuitBreakerBand,
+			validateCircuitBreakerBand,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("CircuitBreakerWindow"),
+			&p.CircuitBreakerWindow,
+			validateCircuitBreakerWindow,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("PermissionlessLiquidations"),
+			&p.PermissionlessLiquidations,
+			validatePermissionlessLiquidations,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("LiquidatorPriorityBlocks"),
+			&p.LiquidatorPriorityBlocks,
+			validateUint64,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("MaxPermissionlessLiquidationsPerBlock"),
+			&p.MaxPermissionlessLiquidationsPerBlock,
+			validateUint64,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("MaxLiquidatorRewardRatio"),
+			&p.MaxLiquidatorRewardRatio,
+			validateMaxLiquidatorRewardRatio,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("FeeTiers"),
+			&p.FeeTiers,
+			validateFeeTiers,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("MakerRebateRatio"),
+			&p.MakerRebateRatio,
+			validatePercentageRatio,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("OrderDeposit"),
+			&p.OrderDeposit,
+			validateOrderDeposit,
+		),
 	}
 }
 
@@ -70,6 +137,18 @@ func NewParams(
 	partialLiquidationRatio sdk.Dec,
 	fundingRateInterval string,
 	twapLookbackWindow time.Duration,
+	insuranceFundFeeShare sdk.Dec,
+	insuranceFundWithdrawalCooldown time.Duration,
+	guardian string,
+	circuitBreakerBand sdk.Dec,
+	circuitBreakerWindow time.Duration,
+	permissionlessLiquidations bool,
+	liquidatorPriorityBlocks uint64,
+	maxPermissionlessLiquidationsPerBlock uint64,
+	maxLiquidatorRewardRatio sdk.Dec,
+	feeTiers []FeeTier,
+	makerRebateRatio sdk.Dec,
+	orderDeposit sdk.Int,
 ) Params {
 	return Params{
 		Stopped:                 stopped,
@@ -79,6 +158,23 @@ func NewParams(
 		PartialLiquidationRatio: partialLiquidationRatio,
 		FundingRateInterval:     fundingRateInterval,
 		TwapLookbackWindow:      twapLookbackWindow,
+
+		InsuranceFundFeeShare:           insuranceFundFeeShare,
+		InsuranceFundWithdrawalCooldown: insuranceFundWithdrawalCooldown,
+
+		Guardian:             guardian,
+		CircuitBreakerBand:   circuitBreakerBand,
+		CircuitBreakerWindow: circuitBreakerWindow,
+
+		PermissionlessLiquidations:            permissionlessLiquidations,
+		LiquidatorPriorityBlocks:              liquidatorPriorityBlocks,
+		MaxPermissionlessLiquidationsPerBlock: maxPermissionlessLiquidationsPerBlock,
+		MaxLiquidatorRewardRatio:              maxLiquidatorRewardRatio,
+
+		FeeTiers:         feeTiers,
+		MakerRebateRatio: makerRebateRatio,
+
+		OrderDeposit: orderDeposit,
 	}
 }
 
@@ -92,6 +188,18 @@ func DefaultParams() Params {
 		/* partialLiquidationRatio */ sdk.MustNewDecFromStr("0.25"),
 		/* epochIdentifier */ "30 min",
 		/* twapLookbackWindow */ 15*time.Minute,
+		/* insuranceFundFeeShare */ sdk.MustNewDecFromStr("0.5"),
+		/* insuranceFundWithdrawalCooldown */ 7*24*time.Hour,
+		/* guardian */ "",
+		/* circuitBreakerBand */ sdk.ZeroDec(), // disabled
+		/* circuitBreakerWindow */ 15*time.Minute,
+		/* permissionlessLiquidations */ false,
+		/* liquidatorPriorityBlocks */ 10,
+		/* maxPermissionlessLiquidationsPerBlock */ 50,
+		/* maxLiquidatorRewardRatio */ sdk.MustNewDecFromStr("0.5"), // flat half of the liquidation fee
+		/* feeTiers */ nil, // flat fees
+		/* makerRebateRatio */ sdk.ZeroDec(), // no rebates
+		/* orderDeposit */ sdk.NewInt(1_000_000),
 	)
 }
 
@@ -117,7 +225,109 @@ func (p *Params) Validate() error {
 		return err
 	}
 
-	return validatePercentageRatio(p.EcosystemFundFeeRatio)
+	err = validatePercentageRatio(p.EcosystemFundFeeRatio)
+	if err != nil {
+		return err
+	}
+
+	err = validatePercentageRatio(p.InsuranceFundFeeShare)
+	if err != nil {
+		return err
+	}
+
+	err = validateInsuranceFundWithdrawalCooldown(p.InsuranceFundWithdrawalCooldown)
+	if err != nil {
+		return err
+	}
+
+	err = validateGuardian(p.Guardian)
+	if err != nil {
+		return err
+	}
+
+	err = validateHaltedPairs(p.HaltedPairs)
+	if err != nil {
+		return err
+	}
+
+	err = validateCircuitBreakerBand(p.CircuitBreakerBand)
+	if err != nil {
+		return err
+	}
+
+	err = validateCircuitBreakerWindow(p.CircuitBreakerWindow)
+	if err != nil {
+		return err
+	}
+
+	err = validateMaxLiquidatorRewardRatio(p.MaxLiquidatorRewardRatio)
+	if err != nil {
+		return err
+	}
+
+	err = validateFeeTiers(p.FeeTiers)
+	if err != nil {
+		return err
+	}
+
+	err = validatePercentageRatio(p.MakerRebateRatio)
+	if err != nil {
+		return err
+	}
+
+	err = p.validateMakerRebateBelowFees()
+	if err != nil {
+		return err
+	}
+
+	return validateOrderDeposit(p.OrderDeposit)
+}
+
+// validateMakerRebateBelowFees checks that the maker rebate is strictly below the taker fee
+// of the base fees and of every fee tier, so that no trader earns more in rebates than it
+// pays in fees.
+func (p Params) validateMakerRebateBelowFees() error {
+	if !p.MakerRebateRatio.IsPositive() {
+		return nil
+	}
+	if takerFeeRatio := p.FeePoolFeeRatio.Add(p.EcosystemFundFeeRatio); p.MakerRebateRatio.GTE(takerFeeRatio) {
+		return fmt.Errorf("maker rebate ratio %s must be below the base taker fee ratio %s",
+			p.MakerRebateRatio, takerFeeRatio)
+	}
+	for idx, tier := range p.FeeTiers {
+		if takerFeeRatio := tier.FeePoolFeeRatio.Add(tier.EcosystemFundFeeRatio); p.MakerRebateRatio.GTE(takerFeeRatio) {
+			return fmt.Errorf("maker rebate ratio %s must be below the taker fee ratio %s of fee tier %d",
+				p.MakerRebateRatio, takerFeeRatio, idx)
+		}
+	}
+	return nil
+}
+
+// FeeRatios returns the fee pool and ecosystem fund fee ratios of a trader with the given
+// notional volume: the ones of the highest fee tier it reaches, or the base ones.
+func (p Params) FeeRatios(volume sdk.Dec) (feePoolFeeRatio sdk.Dec, ecosystemFundFeeRatio sdk.Dec) {
+	feePoolFeeRatio, ecosystemFundFeeRatio = p.FeePoolFeeRatio, p.EcosystemFundFeeRatio
+	for _, tier := range p.FeeTiers {
+		if volume.LT(tier.MinVolume) {
+			break
+		}
+		feePoolFeeRatio, ecosystemFundFeeRatio = tier.FeePoolFeeRatio, tier.EcosystemFundFeeRatio
+	}
+	return feePoolFeeRatio, ecosystemFundFeeRatio
+}
+
+// IsTradingHalted returns whether trading is halted on the pair, either on its own
+// or together with the whole exchange.
+func (p Params) IsTradingHalted(pair common.AssetPair) bool {
+	if p.Stopped {
+		return true
+	}
+	for _, haltedPair := range p.HaltedPairs {
+		if haltedPair == pair.String() {
+			return true
+		}
+	}
+	return false
 }
 
 func validatePercentageRatio(i interface{}) error {
@@ -187,3 +397,129 @@ func validateAddress(i interface{}) error {
 	}
 	return nil
 }
+
+func validateInsuranceFundWithdrawalCooldown(i interface{}) error {
+	val, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if val < 0 {
+		return fmt.Errorf("insurance fund withdrawal cooldown must not be negative, current value is %s", val.String())
+	}
+	return nil
+}
+
+func validateGuardian(i interface{}) error {
+	val, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// no guardian leaves the halts to governance
+	if val == "" {
+		return nil
+	}
+	_, err := sdk.AccAddressFromBech32(val)
+	return err
+}
+
+func validateHaltedPairs(i interface{}) error {
+	val, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	for _, pair := range val {
+		if _, err := common.NewAssetPair(pair); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateCircuitBreakerBand(i interface{}) error {
+	val, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if val.IsNil() {
+		return fmt.Errorf("invalid nil decimal")
+	}
+	if val.IsNegative() {
+		return fmt.Errorf("circuit breaker band is negative: %s", val)
+	}
+	return nil
+}
+
+func validateCircuitBreakerWindow(i interface{}) error {
+	val, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if val < 0 {
+		return fmt.Errorf("circuit breaker window must not be negative, current value is %s", val.String())
+	}
+	return nil
+}
+
+func validatePermissionlessLiquidations(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateUint64(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxLiquidatorRewardRatio(i interface{}) error {
+	val, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if val.IsNil() {
+		return fmt.Errorf("invalid nil decimal")
+	}
+	if val.LT(sdk.MustNewDecFromStr("0.5")) || val.GT(sdk.OneDec()) {
+		return fmt.Errorf("max liquidator reward ratio must be between 0.5 and 1: %s", val)
+	}
+	return nil
+}
+
+func validateFeeTiers(i interface{}) error {
+	val, ok := i.([]FeeTier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	for idx, tier := range val {
+		if tier.MinVolume.IsNil() || tier.MinVolume.IsNegative() {
+			return fmt.Errorf("fee tier %d: min volume must not be negative: %s", idx, tier.MinVolume)
+		}
+		if idx > 0 && tier.MinVolume.LTE(val[idx-1].MinVolume) {
+			return fmt.Errorf("fee tier %d: min volume %s must be above the one of the previous tier %s",
+				idx, tier.MinVolume, val[idx-1].MinVolume)
+		}
+		if err := validatePercentageRatio(tier.FeePoolFeeRatio); err != nil {
+			return fmt.Errorf("fee tier %d: fee pool fee ratio: %w", idx, err)
+		}
+		if err := validatePercentageRatio(tier.EcosystemFundFeeRatio); err != nil {
+			return fmt.Errorf("fee tier %d: ecosystem fund fee ratio: %w", idx, err)
+		}
+	}
+	return nil
+}
+
+func validateOrderDeposit(i interface{}) error {
+	val, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if val.IsNil() || val.IsNegative() {
+		return fmt.Errorf("order deposit must not be negative: %s", val)
+	}
+	return nil
+}
@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -30,6 +32,46 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// EstimateFailure identifies the check an estimated position change fails.
+type EstimateFailure int32
+
+const (
+	// the position change succeeds
+	EstimateFailure_ESTIMATE_FAILURE_UNSPECIFIED EstimateFailure = 0
+	// the swap moves the reserves of the vpool past its trade limit ratio
+	EstimateFailure_TRADE_LIMIT EstimateFailure = 1
+	// the mark price moves past the fluctuation limit ratio of the vpool
+	EstimateFailure_FLUCTUATION_LIMIT EstimateFailure = 2
+	// the leverage is above the max leverage of the vpool
+	EstimateFailure_MAX_LEVERAGE EstimateFailure = 3
+	// any other failure, see the error
+	EstimateFailure_OTHER_FAILURE EstimateFailure = 4
+)
+
+var EstimateFailure_name = map[int32]string{
+	0: "ESTIMATE_FAILURE_UNSPECIFIED",
+	1: "TRADE_LIMIT",
+	2: "FLUCTUATION_LIMIT",
+	3: "MAX_LEVERAGE",
+	4: "OTHER_FAILURE",
+}
+
+var EstimateFailure_value = map[string]int32{
+	"ESTIMATE_FAILURE_UNSPECIFIED": 0,
+	"TRADE_LIMIT":                  1,
+	"FLUCTUATION_LIMIT":            2,
+	"MAX_LEVERAGE":                 3,
+	"OTHER_FAILURE":                4,
+}
+
+func (x EstimateFailure) String() string {
+	return proto.EnumName(EstimateFailure_name, int32(x))
+}
+
+func (EstimateFailure) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8212d8958be09421, []int{0}
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -274,6 +316,9 @@ type QueryPositionResponse struct {
 	MarginRatioIndex github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=margin_ratio_index,json=marginRatioIndex,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"margin_ratio_index"`
 	// BlockNumber is current block number at the time of query.
 	BlockNumber int64 `protobuf:"varint,7,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// mark price at which the position reaches its maintenance margin ratio,
+	// ignoring the price impact of closing it. Zero if no positive price does.
+	LiquidationPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=liquidation_price,json=liquidationPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_price"`
 }
 
 func (m *QueryPositionResponse) Reset()         { *m = QueryPositionResponse{} }
@@ -326,6 +371,9 @@ func (m *QueryPositionResponse) GetBlockNumber() int64 {
 type QueryFundingRatesRequest struct {
 	// the pair to query for
 	Pair string `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	// pagination over the funding epochs of the pair, oldest first unless
+	// reversed. Defaults to the 48 most recent funding epochs.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryFundingRatesRequest) Reset()         { *m = QueryFundingRatesRequest{} }
@@ -368,10 +416,18 @@ func (m *QueryFundingRatesRequest) GetPair() string {
 	return ""
 }
 
+func (m *QueryFundingRatesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryFundingRatesResponse struct {
-	// a historical list of cumulative funding rates, with the most recent one
-	// last
+	// a historical list of cumulative funding rates, in the order of the
+	// pagination (by default the most recent one last)
 	CumulativeFundingRates []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,rep,name=cumulative_funding_rates,json=cumulativeFundingRates,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_funding_rates"`
+	Pagination             *query.PageResponse                      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryFundingRatesResponse) Reset()         { *m = QueryFundingRatesResponse{} }
@@ -407,779 +463,6628 @@ func (m *QueryFundingRatesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryFundingRatesResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "nibiru.perp.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "nibiru.perp.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryPositionsRequest)(nil), "nibiru.perp.v1.QueryPositionsRequest")
-	proto.RegisterType((*QueryPositionsResponse)(nil), "nibiru.perp.v1.QueryPositionsResponse")
-	proto.RegisterType((*QueryPositionRequest)(nil), "nibiru.perp.v1.QueryPositionRequest")
-	proto.RegisterType((*QueryPositionResponse)(nil), "nibiru.perp.v1.QueryPositionResponse")
-	proto.RegisterType((*QueryFundingRatesRequest)(nil), "nibiru.perp.v1.QueryFundingRatesRequest")
-	proto.RegisterType((*QueryFundingRatesResponse)(nil), "nibiru.perp.v1.QueryFundingRatesResponse")
+func (m *QueryFundingRatesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("perp/v1/query.proto", fileDescriptor_8212d8958be09421) }
-
-var fileDescriptor_8212d8958be09421 = []byte{
-	// 674 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x5d, 0x4f, 0xd4, 0x40,
-	0x14, 0xdd, 0xb2, 0xb0, 0xca, 0xe5, 0x43, 0x19, 0x60, 0xad, 0x2b, 0x14, 0xac, 0x4a, 0x56, 0x13,
-	0xdb, 0x80, 0xfe, 0x02, 0x20, 0x26, 0xc6, 0x40, 0xb0, 0x89, 0x2f, 0xa8, 0x69, 0x66, 0x77, 0xc7,
-	0x32, 0xd9, 0x76, 0xa6, 0x4c, 0x5b, 0x02, 0xfa, 0x60, 0x62, 0x62, 0x7c, 0x35, 0xf1, 0x4f, 0xf1,
-	0x48, 0xe2, 0x8b, 0xf1, 0x81, 0x18, 0xf0, 0x7f, 0x68, 0x3a, 0xd3, 0x2e, 0xed, 0xba, 0x01, 0xe4,
-	0xa9, 0xb3, 0x77, 0xce, 0x3d, 0xe7, 0xdc, 0xdb, 0x7b, 0xbb, 0x30, 0x1d, 0x12, 0x11, 0xda, 0x7b,
-	0xcb, 0xf6, 0x6e, 0x42, 0xc4, 0x81, 0x15, 0x0a, 0x1e, 0x73, 0x34, 0xc9, 0x68, 0x8b, 0x8a, 0xc4,
-	0x4a, 0xef, 0xac, 0xbd, 0xe5, 0xc6, 0x8c, 0xc7, 0x3d, 0x2e, 0xaf, 0xec, 0xf4, 0xa4, 0x50, 0x8d,
-	0x39, 0x8f, 0x73, 0xcf, 0x27, 0x36, 0x0e, 0xa9, 0x8d, 0x19, 0xe3, 0x31, 0x8e, 0x29, 0x67, 0x51,
-	0x76, 0xdb, 0x23, 0x8e, 0x62, 0x1c, 0x13, 0x15, 0x34, 0x67, 0x00, 0xbd, 0x4c, 0x75, 0xb6, 0xb0,
-	0xc0, 0x41, 0xe4, 0x90, 0xdd, 0x84, 0x44, 0xb1, 0xf9, 0x02, 0xa6, 0x4b, 0xd1, 0x28, 0xe4, 0x2c,
-	0x22, 0xe8, 0x29, 0xd4, 0x42, 0x19, 0xd1, 0xb5, 0x45, 0xad, 0x39, 0xb6, 0x52, 0xb7, 0xca, 0xb6,
-	0x2c, 0x85, 0x5f, 0x1d, 0x3e, 0x3c, 0x5e, 0xa8, 0x38, 0x19, 0xd6, 0xb4, 0x61, 0x56, 0x91, 0xf1,
-	0x88, 0x4a, 0x3f, 0x99, 0x0a, 0xaa, 0x43, 0x2d, 0x16, 0xb8, 0x43, 0x84, 0xa4, 0x1b, 0x75, 0xb2,
-	0x5f, 0xe6, 0x5b, 0xa8, 0xf7, 0x27, 0x64, 0x06, 0xd6, 0x60, 0x34, 0xcc, 0x83, 0xba, 0xb6, 0x58,
-	0x6d, 0x8e, 0xad, 0x3c, 0xe8, 0xf7, 0x50, 0x4a, 0xcd, 0x33, 0x9d, 0xb3, 0x3c, 0x73, 0x03, 0x66,
-	0xfa, 0x30, 0xca, 0xce, 0x3c, 0x40, 0xcc, 0xbb, 0x84, 0xb9, 0x21, 0xa6, 0xb9, 0xa5, 0x51, 0x19,
-	0xd9, 0xc2, 0x54, 0x14, 0xdc, 0x0e, 0x95, 0xdc, 0x1e, 0x57, 0xfb, 0xea, 0x2b, 0xb4, 0xeb, 0x7a,
-	0xae, 0x9a, 0x35, 0x4c, 0xff, 0xa7, 0x61, 0x79, 0x4e, 0x0f, 0x89, 0x5e, 0xc3, 0x54, 0x7e, 0x76,
-	0x19, 0x4f, 0x1f, 0xd8, 0x57, 0x92, 0xab, 0x56, 0xda, 0xd7, 0x9f, 0xc7, 0x0b, 0x4b, 0x1e, 0x8d,
-	0x77, 0x92, 0x96, 0xd5, 0xe6, 0x81, 0xdd, 0xe6, 0x51, 0xc0, 0xa3, 0xec, 0xf1, 0x38, 0xea, 0x74,
-	0xed, 0xf8, 0x20, 0x24, 0x91, 0xb5, 0x4e, 0xda, 0xce, 0xcd, 0x9c, 0x68, 0x33, 0xe3, 0x41, 0xaf,
-	0x60, 0x32, 0x61, 0x82, 0x60, 0x9f, 0xbe, 0x27, 0x1d, 0x37, 0x64, 0xbe, 0x5e, 0xbd, 0x12, 0xf3,
-	0xc4, 0x19, 0xcb, 0x16, 0xf3, 0xd1, 0x36, 0x4c, 0x05, 0x58, 0x78, 0x94, 0xb9, 0x22, 0x1d, 0x39,
-	0x37, 0xc0, 0xa2, 0xab, 0x0f, 0x5f, 0x89, 0xf9, 0x86, 0x22, 0x72, 0x52, 0x9e, 0x0d, 0x2c, 0xba,
-	0xe8, 0x0d, 0xa0, 0x12, 0x37, 0x65, 0x1d, 0xb2, 0xaf, 0x8f, 0x5c, 0xad, 0x21, 0x05, 0xf2, 0xe7,
-	0x29, 0x0f, 0xba, 0x0b, 0xe3, 0x2d, 0x9f, 0xb7, 0xbb, 0x2e, 0x4b, 0x82, 0x16, 0x11, 0xfa, 0xb5,
-	0x45, 0xad, 0x59, 0x75, 0xc6, 0x64, 0x6c, 0x53, 0x86, 0x4c, 0x0b, 0x74, 0xf9, 0x7e, 0x9f, 0x25,
-	0xac, 0x43, 0x99, 0xe7, 0xe0, 0x98, 0xf4, 0x46, 0x18, 0xc1, 0x70, 0x61, 0x5a, 0xe4, 0xd9, 0xfc,
-	0xac, 0xc1, 0xed, 0x01, 0x09, 0xd9, 0x50, 0xec, 0x80, 0xde, 0x4e, 0x82, 0xc4, 0xc7, 0x31, 0xdd,
-	0x23, 0xee, 0x3b, 0x05, 0x49, 0x4b, 0x23, 0x6a, 0xa2, 0xff, 0xbf, 0xa8, 0xfa, 0x19, 0x5f, 0x51,
-	0x71, 0xe5, 0x4f, 0x15, 0x46, 0xa4, 0x0f, 0xc4, 0xa0, 0xa6, 0x36, 0x13, 0x99, 0x83, 0xb7, 0xa5,
-	0xb8, 0xfc, 0x8d, 0x7b, 0xe7, 0x62, 0x54, 0x19, 0xe6, 0x9d, 0x4f, 0xdf, 0x7f, 0x7f, 0x1b, 0x9a,
-	0x45, 0xd3, 0xb6, 0x02, 0xdb, 0xf2, 0xe3, 0xa2, 0x36, 0x1e, 0x7d, 0x80, 0x89, 0xd2, 0x46, 0xa0,
-	0xfb, 0x17, 0x2c, 0xa9, 0x12, 0xbe, 0xdc, 0x2a, 0x9b, 0xf3, 0x52, 0xfa, 0x16, 0x9a, 0x2d, 0x4b,
-	0xe7, 0x5a, 0x1f, 0x61, 0xb2, 0xfc, 0xf5, 0x40, 0xe7, 0xf3, 0xf6, 0xea, 0x5e, 0xba, 0x08, 0x96,
-	0xe9, 0x1b, 0x52, 0x5f, 0x47, 0xf5, 0x81, 0xfa, 0x11, 0xfa, 0xa2, 0xc1, 0x78, 0xf1, 0x45, 0xa0,
-	0xe6, 0x40, 0xe2, 0x01, 0xe3, 0xd4, 0x78, 0x78, 0x09, 0x64, 0xe6, 0xc2, 0x94, 0x2e, 0xe6, 0x50,
-	0xa3, 0xe4, 0xa2, 0x34, 0x4f, 0xab, 0xeb, 0x87, 0x27, 0x86, 0x76, 0x74, 0x62, 0x68, 0xbf, 0x4e,
-	0x0c, 0xed, 0xeb, 0xa9, 0x51, 0x39, 0x3a, 0x35, 0x2a, 0x3f, 0x4e, 0x8d, 0xca, 0xf6, 0xa3, 0xc2,
-	0x6c, 0x6d, 0xca, 0xfc, 0xb5, 0x1d, 0x4c, 0x59, 0xce, 0xb5, 0xaf, 0xd8, 0xe4, 0x8c, 0xb5, 0x6a,
-	0xf2, 0x9f, 0xe2, 0xc9, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x79, 0x3f, 0x58, 0xeb, 0x99, 0x06,
-	0x00, 0x00,
+type QueryOrdersRequest struct {
+	Trader string `protobuf:"bytes,1,opt,name=trader,proto3" json:"trader,omitempty"`
+	// the pair to query for, leave empty to query the orders on every pair
+	TokenPair string `protobuf:"bytes,2,opt,name=token_pair,json=tokenPair,proto3" json:"token_pair,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryOrdersRequest) Reset()         { *m = QueryOrdersRequest{} }
+func (m *QueryOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOrdersRequest) ProtoMessage()    {}
+func (*QueryOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8212d8958be09421, []int{8}
+}
+func (m *QueryOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrdersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrdersRequest.Merge(m, src)
+}
+func (m *QueryOrdersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrdersRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryOrdersRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the x/perp module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	QueryPosition(ctx context.Context, in *QueryPositionRequest, opts ...grpc.CallOption) (*QueryPositionResponse, error)
-	QueryPositions(ctx context.Context, in *QueryPositionsRequest, opts ...grpc.CallOption) (*QueryPositionsResponse, error)
-	FundingRates(ctx context.Context, in *QueryFundingRatesRequest, opts ...grpc.CallOption) (*QueryFundingRatesResponse, error)
+func (m *QueryOrdersRequest) GetTrader() string {
+	if m != nil {
+		return m.Trader
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryOrdersRequest) GetTokenPair() string {
+	if m != nil {
+		return m.TokenPair
+	}
+	return ""
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+type QueryOrdersResponse struct {
+	Orders []Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryOrdersResponse) Reset()         { *m = QueryOrdersResponse{} }
+func (m *QueryOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOrdersResponse) ProtoMessage()    {}
+func (*QueryOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8212d8958be09421, []int{9}
+}
+func (m *QueryOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrdersResponse.Merge(m, src)
+}
+func (m *QueryOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrdersResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryPosition(ctx context.Context, in *QueryPositionRequest, opts ...grpc.CallOption) (*QueryPositionResponse, error) {
-	out := new(QueryPositionResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Query/QueryPosition", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOrdersResponse proto.InternalMessageInfo
+
+func (m *QueryOrdersResponse) GetOrders() []Order {
+	if m != nil {
+		return m.Orders
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QueryPositions(ctx context.Context, in *QueryPositionsRequest, opts ...grpc.CallOption) (*QueryPositionsResponse, error) {
-	out := new(QueryPositionsResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Query/QueryPositions", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryMarginAccountRequest struct {
+	Trader string `protobuf:"bytes,1,opt,name=trader,proto3" json:"trader,omitempty"`
+	// the quote denom of the positions backed by the account
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+}
+
+func (m *QueryMarginAccountRequest) Reset()         { *m = QueryMarginAccountRequest{} }
+func (m *QueryMarginAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMarginAccountRequest) ProtoMessage()    {}
+func (*QueryMarginAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8212d8958be09421, []int{10}
+}
+func (m *QueryMarginAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarginAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarginAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryMarginAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarginAccountRequest.Merge(m, src)
+}
+func (m *QueryMarginAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarginAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarginAccountRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) FundingRates(ctx context.Context, in *QueryFundingRatesRequest, opts ...grpc.CallOption) (*QueryFundingRatesResponse, error) {
-	out := new(QueryFundingRatesResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Query/FundingRates", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMarginAccountRequest proto.InternalMessageInfo
+
+func (m *QueryMarginAccountRequest) GetTrader() string {
+	if m != nil {
+		return m.Trader
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the x/perp module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	QueryPosition(context.Context, *QueryPositionRequest) (*QueryPositionResponse, error)
-	QueryPositions(context.Context, *QueryPositionsRequest) (*QueryPositionsResponse, error)
-	FundingRates(context.Context, *QueryFundingRatesRequest) (*QueryFundingRatesResponse, error)
+func (m *QueryMarginAccountRequest) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryMarginAccountResponse struct {
+	MarginMode MarginMode `protobuf:"varint,1,opt,name=margin_mode,json=marginMode,proto3,enum=nibiru.perp.v1.MarginMode" json:"margin_mode,omitempty"`
+	// margin ratio of the account based on the mark price, mark TWAP. Only
+	// set for cross margin accounts with open positions.
+	MarginRatioMark github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=margin_ratio_mark,json=marginRatioMark,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"margin_ratio_mark"`
+	// notional weighted maintenance margin ratio of the account's positions.
+	MaintenanceMarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=maintenance_margin_ratio,json=maintenanceMarginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maintenance_margin_ratio"`
+	// collateral that can be removed without the account going under its
+	// maintenance margin requirement.
+	FreeCollateral github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=free_collateral,json=freeCollateral,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"free_collateral"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryMarginAccountResponse) Reset()         { *m = QueryMarginAccountResponse{} }
+func (m *QueryMarginAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMarginAccountResponse) ProtoMessage()    {}
+func (*QueryMarginAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8212d8958be09421, []int{11}
 }
-func (*UnimplementedQueryServer) QueryPosition(ctx context.Context, req *QueryPositionRequest) (*QueryPositionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPosition not implemented")
+func (m *QueryMarginAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueryPositions(ctx context.Context, req *QueryPositionsRequest) (*QueryPositionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPositions not implemented")
+func (m *QueryMarginAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarginAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) FundingRates(ctx context.Context, req *QueryFundingRatesRequest) (*QueryFundingRatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FundingRates not implemented")
+func (m *QueryMarginAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarginAccountResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryMarginAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarginAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarginAccountResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryMarginAccountResponse proto.InternalMessageInfo
+
+func (m *QueryMarginAccountResponse) GetMarginMode() MarginMode {
+	if m != nil {
+		return m.MarginMode
 	}
-	return interceptor(ctx, in, info, handler)
+	return MarginMode_MARGIN_MODE_UNSPECIFIED
 }
 
-func _Query_QueryPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPositionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type QueryInsuranceFundRequest struct {
+	// the quote denom of the insurance fund
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryInsuranceFundRequest) Reset()         { *m = QueryInsuranceFundRequest{} }
+func (m *QueryInsuranceFundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInsuranceFundRequest) ProtoMessage()    {}
+func (*QueryInsuranceFundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8212d8958be09421, []int{12}
+}
+func (m *QueryInsuranceFundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInsuranceFundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInsuranceFundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPosition(ctx, in)
+}
+func (m *QueryInsuranceFundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInsuranceFundRequest.Merge(m, src)
+}
+func (m *QueryInsuranceFundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInsuranceFundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInsuranceFundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInsuranceFundRequest proto.InternalMessageInfo
+
+func (m *QueryInsuranceFundRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v1.Query/QueryPosition",
+	return ""
+}
+
+type QueryInsuranceFundResponse struct {
+	// quote tokens backing the shares of the fund
+	Assets types.Coin `protobuf:"bytes,1,opt,name=assets,proto3" json:"assets"`
+	// total supply of the shares of the fund, including the escrowed ones
+	Shares types.Coin `protobuf:"bytes,2,opt,name=shares,proto3" json:"shares"`
+	// quote tokens redeemed per share, zero if the fund has no shares
+	SharePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=share_price,json=sharePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"share_price"`
+}
+
+func (m *QueryInsuranceFundResponse) Reset()         { *m = QueryInsuranceFundResponse{} }
+func (m *QueryInsuranceFundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInsuranceFundResponse) ProtoMessage()    {}
+func (*QueryInsuranceFundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8212d8958be09421, []int{13}
+}
+func (m *QueryInsuranceFundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInsuranceFundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInsuranceFundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPosition(ctx, req.(*QueryPositionRequest))
+}
+func (m *QueryInsuranceFundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInsuranceFundResponse.Merge(m, src)
+}
+func (m *QueryInsuranceFundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInsuranceFundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInsuranceFundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInsuranceFundResponse proto.InternalMessageInfo
+
+func (m *QueryInsuranceFundResponse) GetAssets() types.Coin {
+	if m != nil {
+		return m.Assets
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Query_QueryPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPositionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryInsuranceFundResponse) GetShares() types.Coin {
+	if m != nil {
+		return m.Shares
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPositions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v1.Query/QueryPositions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPositions(ctx, req.(*QueryPositionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Query_FundingRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFundingRatesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FundingRates(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v1.Query/FundingRates",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FundingRates(ctx, req.(*QueryFundingRatesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryInsuranceFundWithdrawalRequest struct {
+	Depositor string `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// the quote denom of the insurance fund
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "nibiru.perp.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "QueryPosition",
-			Handler:    _Query_QueryPosition_Handler,
-		},
-		{
-			MethodName: "QueryPositions",
-			Handler:    _Query_QueryPositions_Handler,
-		},
-		{
-			MethodName: "FundingRates",
-			Handler:    _Query_FundingRates_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "perp/v1/query.proto",
+func (m *QueryInsuranceFundWithdrawalRequest) Reset()         { *m = QueryInsuranceFundWithdrawalRequest{} }
+func (m *QueryInsuranceFundWithdrawalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInsuranceFundWithdrawalRequest) ProtoMessage()    {}
+func (*QueryInsuranceFundWithdrawalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8212d8958be09421, []int{14}
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryInsuranceFundWithdrawalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInsuranceFundWithdrawalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInsuranceFundWithdrawalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryInsuranceFundWithdrawalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInsuranceFundWithdrawalRequest.Merge(m, src)
+}
+func (m *QueryInsuranceFundWithdrawalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInsuranceFundWithdrawalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInsuranceFundWithdrawalRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryInsuranceFundWithdrawalRequest proto.InternalMessageInfo
+
+func (m *QueryInsuranceFundWithdrawalRequest) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryInsuranceFundWithdrawalRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryInsuranceFundWithdrawalResponse struct {
+	Withdrawal InsuranceFundWithdrawal `protobuf:"bytes,1,opt,name=withdrawal,proto3" json:"withdrawal"`
+	// quote tokens the escrowed shares are currently worth
+	Value types.Coin `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryInsuranceFundWithdrawalResponse) Reset()         { *m = QueryInsuranceFundWithdrawalResponse{} }
+func (m *QueryInsuranceFundWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInsuranceFundWithdrawalResponse) ProtoMessage()    {}
+func (*QueryInsuranceFundWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8212d8958be09421, []int{15}
+}
+func (m *QueryInsuranceFundWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInsuranceFundWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInsuranceFundWithdrawalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryPositionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryInsuranceFundWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInsuranceFundWithdrawalResponse.Merge(m, src)
 }
-
-func (m *QueryPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryInsuranceFundWithdrawalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInsuranceFundWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInsuranceFundWithdrawalResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Trader) > 0 {
-		i -= len(m.Trader)
-		copy(dAtA[i:], m.Trader)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Trader)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryInsuranceFundWithdrawalResponse proto.InternalMessageInfo
+
+func (m *QueryInsuranceFundWithdrawalResponse) GetWithdrawal() InsuranceFundWithdrawal {
+	if m != nil {
+		return m.Withdrawal
 	}
-	return len(dAtA) - i, nil
+	return InsuranceFundWithdrawal{}
 }
 
-func (m *QueryPositionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryInsuranceFundWithdrawalResponse) GetValue() types.Coin {
+	if m != nil {
+		return m.Value
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryOpenInterestRequest struct {
+	TokenPair string `protobuf:"bytes,1,opt,name=token_pair,json=tokenPair,proto3" json:"token_pair,omitempty"`
 }
 
-func (m *QueryPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Positions) > 0 {
-		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryOpenInterestRequest) Reset()         { *m = QueryOpenInterestRequest{} }
+func (m *QueryOpenInterestRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOpenInterestRequest) ProtoMessage()    {}
+func (*QueryOpenInterestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8212d8958be09421, []int{16}
+}
+func (m *QueryOpenInterestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOpenInterestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOpenInterestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryOpenInterestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOpenInterestRequest.Merge(m, src)
+}
+func (m *QueryOpenInterestRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOpenInterestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOpenInterestRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPositionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOpenInterestRequest proto.InternalMessageInfo
+
+func (m *QueryOpenInterestRequest) GetTokenPair() string {
+	if m != nil {
+		return m.TokenPair
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPositionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryOpenInterestResponse struct {
+	OpenInterest OpenInterest `protobuf:"bytes,1,opt,name=open_interest,json=openInterest,proto3" json:"open_interest"`
+	// cap on the open interest of each side, zero if uncapped
+	MaxOpenInterest github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_open_interest,json=maxOpenInterest,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_open_interest"`
+	// cap on the size of a single position, zero if uncapped
+	MaxPositionSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_position_size,json=maxPositionSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_position_size"`
 }
 
-func (m *QueryPositionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Trader) > 0 {
-		i -= len(m.Trader)
-		copy(dAtA[i:], m.Trader)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Trader)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.TokenPair) > 0 {
-		i -= len(m.TokenPair)
-		copy(dAtA[i:], m.TokenPair)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenPair)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryOpenInterestResponse) Reset()         { *m = QueryOpenInterestResponse{} }
+func (m *QueryOpenInterestResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOpenInterestResponse) ProtoMessage()    {}
+func (*QueryOpenInterestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8212d8958be09421, []int{17}
+}
+func (m *QueryOpenInterestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOpenInterestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOpenInterestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryOpenInterestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOpenInterestResponse.Merge(m, src)
+}
+func (m *QueryOpenInterestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOpenInterestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOpenInterestResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPositionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOpenInterestResponse proto.InternalMessageInfo
+
+func (m *QueryOpenInterestResponse) GetOpenInterest() OpenInterest {
+	if m != nil {
+		return m.OpenInterest
 	}
-	return dAtA[:n], nil
+	return OpenInterest{}
 }
 
-func (m *QueryPositionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPositionsByPairRequest struct {
+	TokenPair string `protobuf:"bytes,1,opt,name=token_pair,json=tokenPair,proto3" json:"token_pair,omitempty"`
+	// pagination over the positions of the pair, ordered by trader address
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
-		i--
-		dAtA[i] = 0x38
-	}
-	{
-		size := m.MarginRatioIndex.Size()
-		i -= size
-		if _, err := m.MarginRatioIndex.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryPositionsByPairRequest) Reset()         { *m = QueryPositionsByPairRequest{} }
+func (m *QueryPositionsByPairRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionsByPairRequest) ProtoMessage()    {}
+func (*QueryPositionsByPairRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8212d8958be09421, []int{18}
+}
+func (m *QueryPositionsByPairRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionsByPairRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionsByPairRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.MarginRatioMark.Size()
-		i -= size
-		if _, err := m.MarginRatioMark.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+}
+func (m *QueryPositionsByPairRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionsByPairRequest.Merge(m, src)
+}
+func (m *QueryPositionsByPairRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionsByPairRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionsByPairRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPositionsByPairRequest proto.InternalMessageInfo
+
+func (m *QueryPositionsByPairRequest) GetTokenPair() string {
+	if m != nil {
+		return m.TokenPair
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.UnrealizedPnl.Size()
-		i -= size
-		if _, err := m.UnrealizedPnl.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *QueryPositionsByPairRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.PositionNotional.Size()
-		i -= size
-		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return nil
+}
+
+type QueryPositionsByPairResponse struct {
+	Positions  []*QueryPositionResponse `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	Pagination *query.PageResponse      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPositionsByPairResponse) Reset()         { *m = QueryPositionsByPairResponse{} }
+func (m *QueryPositionsByPairResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionsByPairResponse) ProtoMessage()    {}
+func (*QueryPositionsByPairResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8212d8958be09421, []int{19}
+}
+func (m *QueryPositionsByPairResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionsByPairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionsByPairResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.Position != nil {
-		{
-			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryPositionsByPairResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionsByPairResponse.Merge(m, src)
+}
+func (m *QueryPositionsByPairResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionsByPairResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionsByPairResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPositionsByPairResponse proto.InternalMessageInfo
+
+func (m *QueryPositionsByPairResponse) GetPositions() []*QueryPositionResponse {
+	if m != nil {
+		return m.Positions
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryFundingRatesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPositionsByPairResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFundingRatesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryLiquidatablePositionsRequest struct {
+	TokenPair string `protobuf:"bytes,1,opt,name=token_pair,json=tokenPair,proto3" json:"token_pair,omitempty"`
+	// maximum number of positions returned, defaults to 100
+	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (m *QueryFundingRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pair) > 0 {
-		i -= len(m.Pair)
-		copy(dAtA[i:], m.Pair)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Pair)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryLiquidatablePositionsRequest) Reset()         { *m = QueryLiquidatablePositionsRequest{} }
+func (m *QueryLiquidatablePositionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidatablePositionsRequest) ProtoMessage()    {}
+func (*QueryLiquidatablePositionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8212d8958be09421, []int{20}
+}
+func (m *QueryLiquidatablePositionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidatablePositionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidatablePositionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryLiquidatablePositionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidatablePositionsRequest.Merge(m, src)
+}
+func (m *QueryLiquidatablePositionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidatablePositionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidatablePositionsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFundingRatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLiquidatablePositionsRequest proto.InternalMessageInfo
+
+func (m *QueryLiquidatablePositionsRequest) GetTokenPair() string {
+	if m != nil {
+		return m.TokenPair
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryFundingRatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryLiquidatablePositionsRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
 }
 
-func (m *QueryFundingRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CumulativeFundingRates) > 0 {
-		for iNdEx := len(m.CumulativeFundingRates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.CumulativeFundingRates[iNdEx].Size()
-				i -= size
-				if _, err := m.CumulativeFundingRates[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+type QueryLiquidatablePositionsResponse struct {
+	Positions []*QueryPositionResponse `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+}
+
+func (m *QueryLiquidatablePositionsResponse) Reset()         { *m = QueryLiquidatablePositionsResponse{} }
+func (m *QueryLiquidatablePositionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidatablePositionsResponse) ProtoMessage()    {}
+func (*QueryLiquidatablePositionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8212d8958be09421, []int{21}
+}
+func (m *QueryLiquidatablePositionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidatablePositionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidatablePositionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryLiquidatablePositionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidatablePositionsResponse.Merge(m, src)
+}
+func (m *QueryLiquidatablePositionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidatablePositionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidatablePositionsResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryLiquidatablePositionsResponse proto.InternalMessageInfo
+
+func (m *QueryLiquidatablePositionsResponse) GetPositions() []*QueryPositionResponse {
+	if m != nil {
+		return m.Positions
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+type QueryEstimateOpenPositionRequest struct {
+	TokenPair            string                                 `protobuf:"bytes,1,opt,name=token_pair,json=tokenPair,proto3" json:"token_pair,omitempty"`
+	Trader               string                                 `protobuf:"bytes,2,opt,name=trader,proto3" json:"trader,omitempty"`
+	Side                 Side                                   `protobuf:"varint,3,opt,name=side,proto3,enum=nibiru.perp.v1.Side" json:"side,omitempty"`
+	QuoteAssetAmount     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=quote_asset_amount,json=quoteAssetAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"quote_asset_amount"`
+	Leverage             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=leverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"leverage"`
+	BaseAssetAmountLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=base_asset_amount_limit,json=baseAssetAmountLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_asset_amount_limit"`
+}
+
+func (m *QueryEstimateOpenPositionRequest) Reset()         { *m = QueryEstimateOpenPositionRequest{} }
+func (m *QueryEstimateOpenPositionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateOpenPositionRequest) ProtoMessage()    {}
+func (*QueryEstimateOpenPositionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8212d8958be09421, []int{22}
+}
+func (m *QueryEstimateOpenPositionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateOpenPositionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateOpenPositionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryEstimateOpenPositionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateOpenPositionRequest.Merge(m, src)
+}
+func (m *QueryEstimateOpenPositionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateOpenPositionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateOpenPositionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryEstimateOpenPositionRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateOpenPositionRequest) GetTokenPair() string {
+	if m != nil {
+		return m.TokenPair
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEstimateOpenPositionRequest) GetTrader() string {
+	if m != nil {
+		return m.Trader
 	}
-	var l int
-	_ = l
-	l = len(m.Trader)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QueryEstimateOpenPositionRequest) GetSide() Side {
+	if m != nil {
+		return m.Side
 	}
-	return n
+	return Side_SIDE_UNSPECIFIED
 }
 
-func (m *QueryPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Positions) > 0 {
-		for _, e := range m.Positions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+type QueryEstimateOpenPositionResponse struct {
+	// the position after the change, with a zero size if it was closed
+	Position *Position `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	// the base amount exchanged, negative when selling
+	ExchangedPositionSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchanged_position_size,json=exchangedPositionSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_position_size"`
+	// the quote amount exchanged
+	ExchangedNotionalValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=exchanged_notional_value,json=exchangedNotionalValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_notional_value"`
+	// mark price of the vpool after the change
+	MarkPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=mark_price,json=markPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mark_price"`
+	// the fees paid by the trader, on top of the margin
+	FeeToFeePool       types.Coin `protobuf:"bytes,5,opt,name=fee_to_fee_pool,json=feeToFeePool,proto3" json:"fee_to_fee_pool"`
+	FeeToEcosystemFund types.Coin `protobuf:"bytes,6,opt,name=fee_to_ecosystem_fund,json=feeToEcosystemFund,proto3" json:"fee_to_ecosystem_fund"`
+	FeeToInsuranceFund types.Coin `protobuf:"bytes,7,opt,name=fee_to_insurance_fund,json=feeToInsuranceFund,proto3" json:"fee_to_insurance_fund"`
+	// the funding payment applied to the existing position
+	FundingPayment github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=funding_payment,json=fundingPayment,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_payment"`
+	// the PnL realized by reducing or reversing the position
+	RealizedPnl github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=realized_pnl,json=realizedPnl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"realized_pnl"`
+	// the margin the trader gives to the vault, negative if the vault pays
+	// the trader
+	MarginToVault github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=margin_to_vault,json=marginToVault,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"margin_to_vault"`
+	// margin ratio of the position after the change, based on the mark price
+	// and mark TWAP. Zero if the position was closed.
+	MarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=margin_ratio,json=marginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"margin_ratio"`
+	// liquidation price of the position after the change. Zero if the position
+	// was closed.
+	LiquidationPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=liquidation_price,json=liquidationPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_price"`
+	// the check failed by the position change, if any. The other fields are
+	// left empty when it fails.
+	Failure EstimateFailure `protobuf:"varint,13,opt,name=failure,proto3,enum=nibiru.perp.v1.EstimateFailure" json:"failure,omitempty"`
+	// the error of the failed position change
+	Error string `protobuf:"bytes,14,opt,name=error,proto3" json:"error,omitempty"`
+	// the rebate paid back to the trader if the change moves the mark price
+	// toward the index price
+	MakerRebate types.Coin `protobuf:"bytes,15,opt,name=maker_rebate,json=makerRebate,proto3" json:"maker_rebate"`
+}
+
+func (m *QueryEstimateOpenPositionResponse) Reset()         { *m = QueryEstimateOpenPositionResponse{} }
+func (m *QueryEstimateOpenPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateOpenPositionResponse) ProtoMessage()    {}
+func (*QueryEstimateOpenPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8212d8958be09421, []int{23}
+}
+func (m *QueryEstimateOpenPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateOpenPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateOpenPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryEstimateOpenPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateOpenPositionResponse.Merge(m, src)
+}
+func (m *QueryEstimateOpenPositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateOpenPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateOpenPositionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPositionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryEstimateOpenPositionResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateOpenPositionResponse) GetPosition() *Position {
+	if m != nil {
+		return m.Position
 	}
-	var l int
-	_ = l
-	l = len(m.TokenPair)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *QueryEstimateOpenPositionResponse) GetFeeToFeePool() types.Coin {
+	if m != nil {
+		return m.FeeToFeePool
 	}
-	l = len(m.Trader)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return types.Coin{}
+}
+
+func (m *QueryEstimateOpenPositionResponse) GetFeeToEcosystemFund() types.Coin {
+	if m != nil {
+		return m.FeeToEcosystemFund
 	}
-	return n
+	return types.Coin{}
 }
 
-func (m *QueryPositionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEstimateOpenPositionResponse) GetFeeToInsuranceFund() types.Coin {
+	if m != nil {
+		return m.FeeToInsuranceFund
 	}
-	var l int
-	_ = l
-	if m.Position != nil {
-		l = m.Position.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return types.Coin{}
+}
+
+func (m *QueryEstimateOpenPositionResponse) GetFailure() EstimateFailure {
+	if m != nil {
+		return m.Failure
 	}
-	l = m.PositionNotional.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.UnrealizedPnl.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.MarginRatioMark.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.MarginRatioIndex.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.BlockNumber != 0 {
-		n += 1 + sovQuery(uint64(m.BlockNumber))
+	return EstimateFailure_ESTIMATE_FAILURE_UNSPECIFIED
+}
+
+func (m *QueryEstimateOpenPositionResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return n
+	return ""
 }
 
-func (m *QueryFundingRatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEstimateOpenPositionResponse) GetMakerRebate() types.Coin {
+	if m != nil {
+		return m.MakerRebate
 	}
-	var l int
-	_ = l
-	l = len(m.Pair)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return types.Coin{}
+}
+
+type QueryAutoDeleverageRankRequest struct {
+	TokenPair string `protobuf:"bytes,1,opt,name=token_pair,json=tokenPair,proto3" json:"token_pair,omitempty"`
+	Trader    string `protobuf:"bytes,2,opt,name=trader,proto3" json:"trader,omitempty"`
+}
+
+func (m *QueryAutoDeleverageRankRequest) Reset()         { *m = QueryAutoDeleverageRankRequest{} }
+func (m *QueryAutoDeleverageRankRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoDeleverageRankRequest) ProtoMessage()    {}
+func (*QueryAutoDeleverageRankRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8212d8958be09421, []int{24}
+}
+func (m *QueryAutoDeleverageRankRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoDeleverageRankRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoDeleverageRankRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryAutoDeleverageRankRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoDeleverageRankRequest.Merge(m, src)
+}
+func (m *QueryAutoDeleverageRankRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoDeleverageRankRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoDeleverageRankRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFundingRatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryAutoDeleverageRankRequest proto.InternalMessageInfo
+
+func (m *QueryAutoDeleverageRankRequest) GetTokenPair() string {
+	if m != nil {
+		return m.TokenPair
 	}
-	var l int
-	_ = l
-	if len(m.CumulativeFundingRates) > 0 {
-		for _, e := range m.CumulativeFundingRates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QueryAutoDeleverageRankRequest) GetTrader() string {
+	if m != nil {
+		return m.Trader
+	}
+	return ""
+}
+
+type QueryAutoDeleverageRankResponse struct {
+	// rank of the position in the queue of its side, starting at 1. Zero if the
+	// position isn't profitable, and so isn't in the queue.
+	Rank uint64 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	// number of positions in the queue of the side of the position
+	QueueSize uint64 `protobuf:"varint,2,opt,name=queue_size,json=queueSize,proto3" json:"queue_size,omitempty"`
+	// the unrealized PnL of the position times its effective leverage, which
+	// ranks the queue
+	Score github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=score,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"score"`
+}
+
+func (m *QueryAutoDeleverageRankResponse) Reset()         { *m = QueryAutoDeleverageRankResponse{} }
+func (m *QueryAutoDeleverageRankResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoDeleverageRankResponse) ProtoMessage()    {}
+func (*QueryAutoDeleverageRankResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8212d8958be09421, []int{25}
+}
+func (m *QueryAutoDeleverageRankResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoDeleverageRankResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoDeleverageRankResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryAutoDeleverageRankResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoDeleverageRankResponse.Merge(m, src)
+}
+func (m *QueryAutoDeleverageRankResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoDeleverageRankResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoDeleverageRankResponse.DiscardUnknown(m)
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+var xxx_messageInfo_QueryAutoDeleverageRankResponse proto.InternalMessageInfo
+
+func (m *QueryAutoDeleverageRankResponse) GetRank() uint64 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryAutoDeleverageRankResponse) GetQueueSize() uint64 {
+	if m != nil {
+		return m.QueueSize
+	}
+	return 0
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+
+type QuerySubaccountsRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *QuerySubaccountsRequest) Reset()         { *m = QuerySubaccountsRequest{} }
+func (m *QuerySubaccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySubaccountsRequest) ProtoMessage()    {}
+func (*QuerySubaccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8212d8958be09421, []int{26}
+}
+func (m *QuerySubaccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubaccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubaccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySubaccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubaccountsRequest.Merge(m, src)
+}
+func (m *QuerySubaccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubaccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubaccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubaccountsRequest proto.InternalMessageInfo
+
+func (m *QuerySubaccountsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+type QuerySubaccountsResponse struct {
+	Subaccounts []Subaccount `protobuf:"bytes,1,rep,name=subaccounts,proto3" json:"subaccounts"`
+}
+
+func (m *QuerySubaccountsResponse) Reset()         { *m = QuerySubaccountsResponse{} }
+func (m *QuerySubaccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubaccountsResponse) ProtoMessage()    {}
+func (*QuerySubaccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8212d8958be09421, []int{27}
+}
+func (m *QuerySubaccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubaccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubaccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySubaccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubaccountsResponse.Merge(m, src)
+}
+func (m *QuerySubaccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubaccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubaccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubaccountsResponse proto.InternalMessageInfo
+
+func (m *QuerySubaccountsResponse) GetSubaccounts() []Subaccount {
+	if m != nil {
+		return m.Subaccounts
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("nibiru.perp.v1.EstimateFailure", EstimateFailure_name, EstimateFailure_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "nibiru.perp.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "nibiru.perp.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryPositionsRequest)(nil), "nibiru.perp.v1.QueryPositionsRequest")
+	proto.RegisterType((*QueryPositionsResponse)(nil), "nibiru.perp.v1.QueryPositionsResponse")
+	proto.RegisterType((*QueryPositionRequest)(nil), "nibiru.perp.v1.QueryPositionRequest")
+	proto.RegisterType((*QueryPositionResponse)(nil), "nibiru.perp.v1.QueryPositionResponse")
+	proto.RegisterType((*QueryFundingRatesRequest)(nil), "nibiru.perp.v1.QueryFundingRatesRequest")
+	proto.RegisterType((*QueryFundingRatesResponse)(nil), "nibiru.perp.v1.QueryFundingRatesResponse")
+	proto.RegisterType((*QueryOrdersRequest)(nil), "nibiru.perp.v1.QueryOrdersRequest")
+	proto.RegisterType((*QueryOrdersResponse)(nil), "nibiru.perp.v1.QueryOrdersResponse")
+	proto.RegisterType((*QueryMarginAccountRequest)(nil), "nibiru.perp.v1.QueryMarginAccountRequest")
+	proto.RegisterType((*QueryMarginAccountResponse)(nil), "nibiru.perp.v1.QueryMarginAccountResponse")
+	proto.RegisterType((*QueryInsuranceFundRequest)(nil), "nibiru.perp.v1.QueryInsuranceFundRequest")
+	proto.RegisterType((*QueryInsuranceFundResponse)(nil), "nibiru.perp.v1.QueryInsuranceFundResponse")
+	proto.RegisterType((*QueryInsuranceFundWithdrawalRequest)(nil), "nibiru.perp.v1.QueryInsuranceFundWithdrawalRequest")
+	proto.RegisterType((*QueryInsuranceFundWithdrawalResponse)(nil), "nibiru.perp.v1.QueryInsuranceFundWithdrawalResponse")
+	proto.RegisterType((*QueryOpenInterestRequest)(nil), "nibiru.perp.v1.QueryOpenInterestRequest")
+	proto.RegisterType((*QueryOpenInterestResponse)(nil), "nibiru.perp.v1.QueryOpenInterestResponse")
+	proto.RegisterType((*QueryPositionsByPairRequest)(nil), "nibiru.perp.v1.QueryPositionsByPairRequest")
+	proto.RegisterType((*QueryPositionsByPairResponse)(nil), "nibiru.perp.v1.QueryPositionsByPairResponse")
+	proto.RegisterType((*QueryLiquidatablePositionsRequest)(nil), "nibiru.perp.v1.QueryLiquidatablePositionsRequest")
+	proto.RegisterType((*QueryLiquidatablePositionsResponse)(nil), "nibiru.perp.v1.QueryLiquidatablePositionsResponse")
+	proto.RegisterType((*QueryEstimateOpenPositionRequest)(nil), "nibiru.perp.v1.QueryEstimateOpenPositionRequest")
+	proto.RegisterType((*QueryEstimateOpenPositionResponse)(nil), "nibiru.perp.v1.QueryEstimateOpenPositionResponse")
+	proto.RegisterType((*QueryAutoDeleverageRankRequest)(nil), "nibiru.perp.v1.QueryAutoDeleverageRankRequest")
+	proto.RegisterType((*QueryAutoDeleverageRankResponse)(nil), "nibiru.perp.v1.QueryAutoDeleverageRankResponse")
+	proto.RegisterType((*QuerySubaccountsRequest)(nil), "nibiru.perp.v1.QuerySubaccountsRequest")
+	proto.RegisterType((*QuerySubaccountsResponse)(nil), "nibiru.perp.v1.QuerySubaccountsResponse")
+}
+
+func init() { proto.RegisterFile("perp/v1/query.proto", fileDescriptor_8212d8958be09421) }
+
+var fileDescriptor_8212d8958be09421 = []byte{
+	// 2100 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x4b, 0x4f, 0x23, 0xd9,
+	0x15, 0xa6, 0xc0, 0xd0, 0xcd, 0x31, 0xcf, 0xdb, 0x3c, 0xaa, 0xdd, 0xb4, 0xa1, 0x8b, 0x19, 0x9a,
+	0x66, 0x12, 0x3b, 0xd0, 0x1d, 0x45, 0xa3, 0xac, 0x0c, 0x98, 0x8e, 0x67, 0x78, 0x4d, 0x61, 0xba,
+	0x27, 0x93, 0x47, 0xe9, 0xda, 0xbe, 0x98, 0x12, 0xe5, 0xba, 0xa6, 0x1e, 0x34, 0x74, 0x16, 0x89,
+	0xa2, 0x48, 0xb3, 0xc9, 0x62, 0xa2, 0x64, 0x93, 0x28, 0xdb, 0x48, 0x59, 0xcc, 0x22, 0x52, 0x56,
+	0x51, 0xfe, 0xc0, 0xac, 0xa2, 0x91, 0xb2, 0x89, 0xb2, 0x98, 0x44, 0xdd, 0xf9, 0x11, 0x59, 0x8e,
+	0xee, 0xa3, 0xec, 0x2a, 0x53, 0x18, 0x53, 0xc3, 0x0a, 0xfb, 0xfa, 0x9c, 0xef, 0x7c, 0xf7, 0xdc,
+	0x73, 0xcf, 0xe3, 0x02, 0xf7, 0x9a, 0xc4, 0x69, 0xe6, 0xcf, 0x56, 0xf3, 0xa7, 0x3e, 0x71, 0x2e,
+	0x72, 0x4d, 0x87, 0x7a, 0x14, 0x8d, 0xd9, 0x66, 0xc5, 0x74, 0xfc, 0x1c, 0xfb, 0x2d, 0x77, 0xb6,
+	0x9a, 0x99, 0xaa, 0xd3, 0x3a, 0xe5, 0x3f, 0xe5, 0xd9, 0x27, 0x21, 0x95, 0xc9, 0x56, 0xa9, 0xdb,
+	0xa0, 0x6e, 0xbe, 0x82, 0x5d, 0x92, 0x3f, 0x5b, 0xad, 0x10, 0x0f, 0xaf, 0xe6, 0xab, 0xd4, 0xb4,
+	0xe5, 0xef, 0x73, 0x75, 0x4a, 0xeb, 0x16, 0xc9, 0xe3, 0xa6, 0x99, 0xc7, 0xb6, 0x4d, 0x3d, 0xec,
+	0x99, 0xd4, 0x76, 0xe5, 0xaf, 0x2b, 0x61, 0x6d, 0x6e, 0xbc, 0x85, 0xd1, 0xc4, 0x75, 0xd3, 0xe6,
+	0xc2, 0x52, 0xb6, 0x45, 0xd2, 0xf5, 0xb0, 0x47, 0xc4, 0xa2, 0x36, 0x05, 0xe8, 0x23, 0xa6, 0xb6,
+	0x8f, 0x1d, 0xdc, 0x70, 0x75, 0x72, 0xea, 0x13, 0xd7, 0xd3, 0x3e, 0x84, 0x7b, 0x91, 0x55, 0xb7,
+	0x49, 0x6d, 0x97, 0xa0, 0x67, 0x30, 0xd4, 0xe4, 0x2b, 0xaa, 0xb2, 0xa0, 0x2c, 0xa7, 0xd7, 0x66,
+	0x72, 0xd1, 0x2d, 0xe6, 0x84, 0xfc, 0x7a, 0xea, 0x8b, 0xaf, 0xe6, 0xfb, 0x74, 0x29, 0xab, 0xe5,
+	0x61, 0x5a, 0x80, 0x51, 0xd7, 0xe4, 0xdc, 0xa5, 0x15, 0x34, 0x03, 0x43, 0x9e, 0x83, 0x6b, 0xc4,
+	0xe1, 0x70, 0xc3, 0xba, 0xfc, 0xa6, 0xfd, 0x04, 0x66, 0x3a, 0x15, 0x24, 0x81, 0x0d, 0x18, 0x6e,
+	0x06, 0x8b, 0xaa, 0xb2, 0x30, 0xb0, 0x9c, 0x5e, 0x7b, 0xb7, 0x93, 0x43, 0x44, 0x35, 0xd0, 0xd4,
+	0xdb, 0x7a, 0xda, 0x0e, 0x4c, 0x75, 0xc8, 0x08, 0x3a, 0x0f, 0x01, 0x3c, 0x7a, 0x42, 0x6c, 0xa3,
+	0x89, 0xcd, 0x80, 0xd2, 0x30, 0x5f, 0xd9, 0xc7, 0xa6, 0x13, 0x62, 0xdb, 0x1f, 0x61, 0xfb, 0xf7,
+	0x14, 0x4c, 0xc7, 0xda, 0x44, 0xcf, 0xe0, 0x6e, 0x60, 0x55, 0x3a, 0x4c, 0xbd, 0xe4, 0xb0, 0x40,
+	0xa7, 0x25, 0x89, 0x7e, 0x04, 0x93, 0xc1, 0x67, 0xc3, 0xa6, 0xec, 0x0f, 0xb6, 0x84, 0xc9, 0xf5,
+	0x1c, 0xf3, 0xeb, 0xbf, 0xbf, 0x9a, 0x5f, 0xaa, 0x9b, 0xde, 0xb1, 0x5f, 0xc9, 0x55, 0x69, 0x23,
+	0x2f, 0x03, 0x40, 0xfc, 0xf9, 0xb6, 0x5b, 0x3b, 0xc9, 0x7b, 0x17, 0x4d, 0xe2, 0xe6, 0x36, 0x49,
+	0x55, 0x9f, 0x08, 0x80, 0x76, 0x25, 0x0e, 0x3a, 0x84, 0x31, 0xdf, 0x76, 0x08, 0xb6, 0xcc, 0xd7,
+	0xa4, 0x66, 0x34, 0x6d, 0x4b, 0x1d, 0x48, 0x84, 0x3c, 0xda, 0x46, 0xd9, 0xb7, 0x2d, 0xf4, 0x09,
+	0x4c, 0x36, 0xb0, 0x53, 0x37, 0x6d, 0xc3, 0x61, 0x11, 0x67, 0x34, 0xb0, 0x73, 0xa2, 0xa6, 0x12,
+	0x21, 0x8f, 0x0b, 0x20, 0x9d, 0xe1, 0xec, 0x60, 0xe7, 0x04, 0xfd, 0x18, 0x50, 0x04, 0xdb, 0xb4,
+	0x6b, 0xe4, 0x5c, 0x1d, 0x4c, 0xe6, 0x90, 0x10, 0x78, 0x89, 0xe1, 0xa0, 0x47, 0x30, 0x52, 0xb1,
+	0x68, 0xf5, 0xc4, 0xb0, 0xfd, 0x46, 0x85, 0x38, 0xea, 0x9d, 0x05, 0x65, 0x79, 0x40, 0x4f, 0xf3,
+	0xb5, 0x5d, 0xbe, 0xc4, 0x0e, 0xc4, 0x32, 0x4f, 0x7d, 0xb3, 0x86, 0xf9, 0x99, 0x34, 0x1d, 0xb3,
+	0x4a, 0xd4, 0xbb, 0xc9, 0xec, 0x87, 0x80, 0xf6, 0x19, 0x8e, 0x76, 0x06, 0x2a, 0x0f, 0x9e, 0x2d,
+	0xdf, 0xae, 0x99, 0x76, 0x5d, 0xc7, 0x1e, 0x69, 0xdd, 0x0f, 0x04, 0xa9, 0x50, 0x28, 0xf2, 0xcf,
+	0x68, 0x0b, 0xa0, 0x7d, 0xb1, 0x79, 0x58, 0xa4, 0xd7, 0x96, 0x72, 0xc2, 0x58, 0x8e, 0x65, 0x81,
+	0x9c, 0x48, 0x41, 0x32, 0x0b, 0xe4, 0xf6, 0x71, 0x9d, 0x48, 0x3c, 0x3d, 0xa4, 0xa9, 0xfd, 0x43,
+	0x81, 0xfb, 0x31, 0x86, 0x65, 0xe4, 0x1e, 0x83, 0x5a, 0xf5, 0x1b, 0xbe, 0x85, 0x3d, 0xf3, 0x8c,
+	0x18, 0x47, 0x42, 0x84, 0xf9, 0x9f, 0x88, 0x6b, 0x77, 0xf3, 0x9d, 0xcf, 0xb4, 0xf1, 0xc2, 0x16,
+	0xd1, 0xf3, 0x98, 0xfd, 0x3c, 0xbe, 0x76, 0x3f, 0xf2, 0x52, 0x87, 0x37, 0xf4, 0xa1, 0x4c, 0x64,
+	0x7b, 0x4e, 0x8d, 0x38, 0xd7, 0xa5, 0x98, 0x8e, 0xbb, 0xde, 0xdf, 0x71, 0xd7, 0xb5, 0x0f, 0xe0,
+	0x5e, 0x04, 0x4c, 0xba, 0xe5, 0x29, 0x0c, 0x51, 0xbe, 0x22, 0x73, 0xcf, 0x74, 0xe7, 0x75, 0xe6,
+	0xf2, 0x41, 0xfa, 0x13, 0xa2, 0x5a, 0x59, 0x3a, 0x7a, 0x87, 0x87, 0x5e, 0xa1, 0x5a, 0xa5, 0xbe,
+	0xed, 0x5d, 0xc7, 0x6f, 0x1e, 0xd2, 0xa7, 0x3e, 0xf5, 0x88, 0x51, 0x23, 0x36, 0x6d, 0x48, 0x82,
+	0xc0, 0x97, 0x36, 0xd9, 0x8a, 0xf6, 0xff, 0x7e, 0xc8, 0xc4, 0xc1, 0x4a, 0xa6, 0xdf, 0x87, 0xb4,
+	0xbc, 0x34, 0x0d, 0x5a, 0x23, 0x1c, 0x7c, 0x6c, 0x2d, 0xd3, 0x49, 0x57, 0xe8, 0xee, 0xd0, 0x1a,
+	0xd1, 0xa1, 0xd1, 0xfa, 0x1c, 0x7f, 0x9b, 0xfb, 0x6f, 0xe7, 0x36, 0x1f, 0x83, 0xda, 0xc0, 0xa6,
+	0xed, 0x11, 0x1b, 0xdb, 0x55, 0x62, 0x84, 0xed, 0x24, 0x4c, 0x45, 0x33, 0x21, 0xbc, 0x9d, 0xb6,
+	0x35, 0xf4, 0x12, 0xc6, 0x8f, 0x1c, 0x42, 0x8c, 0x2a, 0xb5, 0x2c, 0xec, 0x11, 0x07, 0x5b, 0x09,
+	0x33, 0xd2, 0x18, 0x83, 0xd9, 0x68, 0xa1, 0x68, 0xab, 0xf2, 0x40, 0x4b, 0xb6, 0xeb, 0x3b, 0xcc,
+	0x2a, 0x0b, 0xe8, 0xe0, 0x40, 0xa7, 0x60, 0x50, 0x1c, 0x99, 0x38, 0x4f, 0xf1, 0x45, 0xfb, 0x8f,
+	0x02, 0x99, 0x38, 0x1d, 0x79, 0x5a, 0xdf, 0x83, 0x21, 0xec, 0xba, 0xc4, 0x0b, 0xea, 0xea, 0xfd,
+	0xc8, 0x05, 0x08, 0x42, 0x7f, 0x83, 0x9a, 0x76, 0x10, 0x5b, 0x42, 0x9c, 0x29, 0xba, 0xc7, 0xd8,
+	0x21, 0xae, 0xda, 0xdf, 0xa3, 0xa2, 0x10, 0x47, 0x7b, 0x90, 0xe6, 0x9f, 0x64, 0x36, 0x4b, 0xe6,
+	0x79, 0xe0, 0x10, 0x22, 0x8f, 0xfd, 0x10, 0x16, 0x2f, 0x6f, 0xf0, 0xa5, 0xe9, 0x1d, 0xd7, 0x1c,
+	0xfc, 0x0a, 0x5b, 0x81, 0x7b, 0xe6, 0x60, 0xb8, 0x46, 0x78, 0x55, 0xa2, 0xad, 0x12, 0xdb, 0x5a,
+	0x68, 0x3b, 0xaf, 0x3f, 0xec, 0xbc, 0xcf, 0x15, 0x78, 0xa7, 0x3b, 0xb6, 0x74, 0xe3, 0x0e, 0xc0,
+	0xab, 0xd6, 0xaa, 0x74, 0xe5, 0xe3, 0xce, 0x98, 0xbf, 0x02, 0x44, 0xfa, 0x27, 0x04, 0x80, 0xbe,
+	0x0b, 0x83, 0x67, 0xd8, 0xf2, 0x49, 0xaf, 0xbe, 0x15, 0xd2, 0xda, 0xfb, 0x32, 0xa3, 0xef, 0x35,
+	0x89, 0x5d, 0xb2, 0x3d, 0xe2, 0xb0, 0xd4, 0xdb, 0x53, 0x8b, 0xa1, 0xfd, 0xb1, 0x1f, 0xee, 0xc7,
+	0xe8, 0xca, 0xed, 0x3d, 0x87, 0x51, 0xda, 0x24, 0xb6, 0x61, 0xca, 0x1f, 0xe4, 0x0e, 0xe7, 0x2e,
+	0x25, 0xa1, 0x90, 0xb2, 0xa4, 0x36, 0x42, 0x43, 0x6b, 0xe2, 0x7e, 0x9f, 0x1b, 0x51, 0xb0, 0xc4,
+	0xf7, 0xfb, 0x7c, 0x2f, 0x06, 0xbb, 0xd5, 0xc1, 0xb8, 0xe6, 0xeb, 0xa4, 0xe1, 0xc5, 0xb0, 0x83,
+	0xfe, 0xe8, 0xc0, 0x7c, 0x4d, 0xb4, 0x5f, 0x29, 0xf0, 0x20, 0xda, 0x18, 0xae, 0x5f, 0x30, 0xbf,
+	0xf5, 0xd8, 0xc0, 0xdd, 0x56, 0xe9, 0xfc, 0x5c, 0x81, 0xb9, 0x78, 0x1a, 0xb7, 0xd8, 0xa5, 0xde,
+	0x5e, 0x61, 0xfc, 0x18, 0x1e, 0x71, 0x63, 0xdb, 0xb2, 0xf5, 0xc0, 0x15, 0x8b, 0x5c, 0x6a, 0xc5,
+	0xaf, 0x71, 0xdd, 0x14, 0x0c, 0x5a, 0x66, 0xc3, 0x14, 0x51, 0x92, 0xd2, 0xc5, 0x17, 0xcd, 0x04,
+	0xad, 0x1b, 0xf2, 0x6d, 0xf6, 0xec, 0xbf, 0x1b, 0x80, 0x05, 0x2e, 0x54, 0x74, 0x3d, 0xb3, 0x81,
+	0x3d, 0xc2, 0x82, 0xee, 0x76, 0x1a, 0x78, 0xb4, 0x0c, 0x29, 0xd7, 0xac, 0x89, 0x28, 0x1d, 0x5b,
+	0x9b, 0xea, 0xe4, 0x76, 0x60, 0xd6, 0x88, 0xce, 0x25, 0x58, 0x2b, 0x2a, 0xaa, 0x32, 0x4f, 0xbf,
+	0x06, 0x6e, 0xb0, 0x9a, 0x9b, 0xa0, 0xaa, 0x94, 0x6c, 0x4f, 0x9f, 0xe0, 0x48, 0x05, 0x06, 0x54,
+	0xe0, 0x38, 0xe8, 0x03, 0xb8, 0x6b, 0x91, 0x33, 0xe2, 0xe0, 0x3a, 0x49, 0xd8, 0xde, 0xb6, 0xf4,
+	0x11, 0x81, 0x59, 0x16, 0x23, 0x11, 0xa2, 0x86, 0x38, 0xc2, 0xa1, 0x44, 0x74, 0xa7, 0x18, 0x5c,
+	0x88, 0xed, 0x36, 0x8f, 0x80, 0xbf, 0x0e, 0xc3, 0xa3, 0x2e, 0xc7, 0xf2, 0x8d, 0xe6, 0xa0, 0x23,
+	0x98, 0x25, 0xe7, 0xd5, 0x63, 0x6c, 0xd7, 0xd9, 0xa4, 0x12, 0xc9, 0x27, 0xc9, 0x72, 0xd5, 0x74,
+	0x0b, 0x2e, 0x9c, 0x55, 0x58, 0x47, 0xd2, 0xb6, 0x13, 0x0c, 0x5c, 0x86, 0xc8, 0xfc, 0x09, 0x3b,
+	0x92, 0x16, 0x5e, 0x30, 0x77, 0xbd, 0x60, 0x68, 0xac, 0x3e, 0xb1, 0x56, 0x4a, 0xd6, 0xdc, 0x64,
+	0xcd, 0xc8, 0x30, 0x43, 0xe0, 0x25, 0x17, 0x6d, 0xc1, 0xf8, 0x11, 0x21, 0x86, 0x47, 0x0d, 0xf6,
+	0xa7, 0x49, 0xa9, 0xa5, 0x0e, 0xf6, 0x56, 0xa9, 0x46, 0x8e, 0x08, 0x29, 0xd3, 0x2d, 0x42, 0xf6,
+	0x29, 0xb5, 0x90, 0x0e, 0xd3, 0x12, 0x87, 0x54, 0xa9, 0x7b, 0xe1, 0x7a, 0xa4, 0xc1, 0x5b, 0x7e,
+	0x75, 0xa8, 0x37, 0x34, 0xc4, 0xd1, 0x8a, 0x81, 0x2e, 0xab, 0xab, 0x21, 0x4c, 0x33, 0xa8, 0xb7,
+	0x02, 0xf3, 0xce, 0x4d, 0x30, 0x23, 0xb5, 0x9a, 0x37, 0x74, 0x72, 0x12, 0x69, 0xe2, 0x8b, 0x06,
+	0xb1, 0xbd, 0x84, 0x53, 0xd8, 0x98, 0x84, 0xd9, 0x17, 0x28, 0xe8, 0x23, 0x18, 0x89, 0x8c, 0xc4,
+	0xc3, 0x89, 0x50, 0xd3, 0xe1, 0x81, 0xf8, 0x05, 0xc8, 0xce, 0x97, 0xb9, 0xe0, 0x0c, 0xfb, 0x96,
+	0xa7, 0x42, 0xb2, 0x41, 0x5b, 0xc0, 0x94, 0xe9, 0x0b, 0x06, 0xc2, 0xa8, 0x46, 0x5a, 0xe6, 0x74,
+	0x32, 0xaa, 0xa1, 0xae, 0x3c, 0x7e, 0xbc, 0x1d, 0xb9, 0x9d, 0xf1, 0x16, 0xbd, 0x0f, 0x77, 0x8e,
+	0xb0, 0x69, 0xf9, 0x0e, 0x51, 0x47, 0x79, 0x7a, 0x9d, 0xef, 0xbc, 0xf9, 0x41, 0xe6, 0xd8, 0x12,
+	0x62, 0x7a, 0x20, 0xcf, 0x6a, 0x0e, 0x71, 0x1c, 0xea, 0xa8, 0x63, 0xa2, 0x19, 0xe4, 0x5f, 0xd0,
+	0x3a, 0x73, 0xc0, 0x09, 0x71, 0x0c, 0x87, 0x54, 0xb0, 0x47, 0xd4, 0xf1, 0xde, 0xe2, 0x29, 0xcd,
+	0x95, 0x74, 0xae, 0xa3, 0xbd, 0x84, 0x2c, 0x4f, 0x5a, 0x05, 0xdf, 0xa3, 0x9b, 0x24, 0xc8, 0x99,
+	0x3a, 0xb6, 0x4f, 0xbe, 0xe1, 0x53, 0xd0, 0x1f, 0x14, 0x98, 0xbf, 0x12, 0x59, 0x26, 0x43, 0x04,
+	0x29, 0x07, 0xdb, 0x27, 0x1c, 0x34, 0xa5, 0xf3, 0xcf, 0xcc, 0xdc, 0xa9, 0x4f, 0x7c, 0xd2, 0xce,
+	0x6e, 0x29, 0x7d, 0x98, 0xaf, 0xf0, 0x0c, 0xb5, 0x09, 0x83, 0x6e, 0x95, 0x3a, 0x49, 0xd3, 0x91,
+	0x50, 0xd6, 0xf2, 0x30, 0xcb, 0xb9, 0x1d, 0xf8, 0x15, 0x2c, 0xa6, 0x45, 0x37, 0x34, 0xb4, 0xd0,
+	0x57, 0x76, 0x6b, 0x08, 0x15, 0x5f, 0xb4, 0x9f, 0x82, 0x7a, 0x59, 0x41, 0xee, 0x62, 0x1d, 0xd2,
+	0x6e, 0x7b, 0x59, 0x96, 0xf5, 0x4b, 0xf3, 0x65, 0x5b, 0x33, 0x38, 0x86, 0x90, 0xd2, 0xca, 0x2f,
+	0x14, 0x18, 0xef, 0x38, 0x7d, 0xb4, 0x00, 0x73, 0xc5, 0x83, 0x72, 0x69, 0xa7, 0x50, 0x2e, 0x1a,
+	0x5b, 0x85, 0xd2, 0xf6, 0xa1, 0x5e, 0x34, 0x0e, 0x77, 0x0f, 0xf6, 0x8b, 0x1b, 0xa5, 0xad, 0x52,
+	0x71, 0x73, 0xa2, 0x0f, 0x8d, 0x43, 0xba, 0xac, 0x17, 0x36, 0x8b, 0xc6, 0x76, 0x69, 0xa7, 0x54,
+	0x9e, 0x50, 0xd0, 0x34, 0x4c, 0x6e, 0x6d, 0x1f, 0x6e, 0x94, 0x0f, 0x0b, 0xe5, 0xd2, 0xde, 0xae,
+	0x5c, 0xee, 0x47, 0x13, 0x30, 0xb2, 0x53, 0xf8, 0xd8, 0xd8, 0x2e, 0xbe, 0x28, 0xea, 0x85, 0xe7,
+	0xc5, 0x89, 0x01, 0x34, 0x09, 0xa3, 0x7b, 0xe5, 0x1f, 0x14, 0xf5, 0x00, 0x78, 0x22, 0xb5, 0xf6,
+	0x9b, 0x09, 0x18, 0xe4, 0x7b, 0x44, 0x36, 0x0c, 0x89, 0xc7, 0x4b, 0xa4, 0xc5, 0x37, 0x27, 0xe1,
+	0xf7, 0xd1, 0xcc, 0x62, 0x57, 0x19, 0xe1, 0x23, 0xed, 0xc1, 0x2f, 0xff, 0xf9, 0xbf, 0xdf, 0xf6,
+	0x4f, 0xa3, 0x7b, 0x79, 0x21, 0x9c, 0x67, 0xc2, 0x79, 0xf1, 0x28, 0x8a, 0x7e, 0x06, 0xa3, 0x91,
+	0xa6, 0x07, 0xbd, 0x73, 0x4d, 0x4f, 0x24, 0x0c, 0xf7, 0xd6, 0x39, 0x69, 0x0f, 0xb9, 0xe9, 0x59,
+	0x34, 0x1d, 0x35, 0x1d, 0xd8, 0xfa, 0x39, 0x8c, 0x45, 0xf4, 0x5c, 0xd4, 0x1d, 0xb7, 0xb5, 0xef,
+	0xa5, 0xeb, 0xc4, 0xa4, 0xfd, 0x2c, 0xb7, 0xaf, 0xa2, 0x99, 0x58, 0xfb, 0x2e, 0xfa, 0x54, 0x81,
+	0x91, 0xc8, 0x33, 0xd0, 0x72, 0x2c, 0x70, 0xcc, 0xa3, 0x58, 0xe6, 0x49, 0x0f, 0x92, 0x92, 0x85,
+	0xc6, 0x59, 0xcc, 0xa1, 0x4c, 0x84, 0x45, 0xe4, 0x35, 0x0b, 0xb9, 0x90, 0x0e, 0xbd, 0xf4, 0x5c,
+	0x71, 0xf8, 0x91, 0x37, 0xa5, 0xcc, 0x62, 0x57, 0x99, 0xae, 0x87, 0x2f, 0x9e, 0x84, 0xd0, 0x67,
+	0x8a, 0x7c, 0xac, 0x8a, 0x3c, 0xde, 0xa0, 0xf8, 0xad, 0xc5, 0xbd, 0x1b, 0x65, 0x56, 0x7a, 0x11,
+	0x95, 0x54, 0x16, 0x39, 0x95, 0x87, 0xe8, 0x41, 0x84, 0x8a, 0x2c, 0x23, 0xf2, 0x36, 0xb6, 0x29,
+	0x45, 0x6b, 0x6e, 0x3c, 0xa5, 0xb8, 0x97, 0x8f, 0xcc, 0x4a, 0x2f, 0xa2, 0x5d, 0x29, 0x45, 0x5b,
+	0x05, 0xf4, 0xb7, 0x60, 0xce, 0xba, 0x62, 0x64, 0x47, 0x4f, 0xaf, 0xb7, 0x78, 0xe9, 0x05, 0x22,
+	0xf3, 0xec, 0x66, 0x4a, 0x92, 0x70, 0x8e, 0x13, 0x5e, 0x46, 0x4b, 0x5d, 0x08, 0x1b, 0xa1, 0xb7,
+	0x83, 0x5f, 0x2b, 0x30, 0x79, 0x69, 0x92, 0xbf, 0x22, 0xca, 0x63, 0x1e, 0x0a, 0x32, 0x4f, 0x7a,
+	0x90, 0xec, 0x1a, 0xe5, 0x91, 0xe1, 0x1e, 0xfd, 0x5e, 0x81, 0xa9, 0xb8, 0x91, 0x15, 0xbd, 0xd7,
+	0xfd, 0x42, 0x47, 0xe6, 0xeb, 0xcc, 0xb7, 0x7a, 0x13, 0x96, 0xbc, 0x96, 0x38, 0xaf, 0x05, 0x94,
+	0x8d, 0xcf, 0x01, 0x46, 0xe5, 0x82, 0x57, 0x57, 0xf4, 0x97, 0xe0, 0x6d, 0x2c, 0x76, 0x8c, 0x44,
+	0xab, 0xb1, 0x46, 0xbb, 0x0d, 0xb3, 0x99, 0xb5, 0x9b, 0xa8, 0x48, 0xb6, 0xef, 0x71, 0xb6, 0xef,
+	0xa2, 0xc5, 0x08, 0x5b, 0x2b, 0xa4, 0x63, 0xb4, 0xd3, 0xd7, 0x9f, 0x15, 0x98, 0x8a, 0x9b, 0x78,
+	0xd0, 0x77, 0x62, 0x2d, 0x77, 0x99, 0x59, 0x33, 0xab, 0x37, 0xd0, 0xe8, 0x4a, 0x95, 0x48, 0x15,
+	0xf1, 0xac, 0xd3, 0x4a, 0xf5, 0x7f, 0x52, 0x60, 0xf6, 0x8a, 0x96, 0x04, 0xe5, 0x62, 0x6d, 0x5f,
+	0xd9, 0x15, 0x65, 0xf2, 0x3d, 0xcb, 0x4b, 0xa6, 0x4f, 0x38, 0xd3, 0x45, 0xf4, 0x28, 0xc2, 0x14,
+	0xfb, 0x1e, 0x35, 0x6a, 0x2d, 0x0d, 0x83, 0xb7, 0x40, 0x9f, 0x2a, 0x30, 0xd1, 0xd9, 0x6d, 0xa0,
+	0xc7, 0xb1, 0x06, 0x2f, 0x37, 0x30, 0x99, 0xe5, 0xeb, 0x05, 0x25, 0xa5, 0x05, 0x4e, 0x29, 0x83,
+	0xd4, 0x08, 0xa5, 0x50, 0x5b, 0xb2, 0xbe, 0xf9, 0xc5, 0x9b, 0xac, 0xf2, 0xe5, 0x9b, 0xac, 0xf2,
+	0xdf, 0x37, 0x59, 0xe5, 0xb3, 0xb7, 0xd9, 0xbe, 0x2f, 0xdf, 0x66, 0xfb, 0xfe, 0xf5, 0x36, 0xdb,
+	0xf7, 0xc9, 0x4a, 0xa8, 0xe1, 0xda, 0xe5, 0xda, 0x1b, 0xc7, 0xd8, 0xb4, 0x03, 0xa4, 0x73, 0x81,
+	0xc5, 0x1b, 0xaf, 0xca, 0x10, 0xff, 0xf7, 0xea, 0xd3, 0xaf, 0x07, 0x00, 0xa9, 0xae, 0x04, 0x53,
+	0x1a, 0x1e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the x/perp module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	QueryPosition(ctx context.Context, in *QueryPositionRequest, opts ...grpc.CallOption) (*QueryPositionResponse, error)
+	QueryPositions(ctx context.Context, in *QueryPositionsRequest, opts ...grpc.CallOption) (*QueryPositionsResponse, error)
+	FundingRates(ctx context.Context, in *QueryFundingRatesRequest, opts ...grpc.CallOption) (*QueryFundingRatesResponse, error)
+	QueryOrders(ctx context.Context, in *QueryOrdersRequest, opts ...grpc.CallOption) (*QueryOrdersResponse, error)
+	QueryMarginAccount(ctx context.Context, in *QueryMarginAccountRequest, opts ...grpc.CallOption) (*QueryMarginAccountResponse, error)
+	QueryInsuranceFund(ctx context.Context, in *QueryInsuranceFundRequest, opts ...grpc.CallOption) (*QueryInsuranceFundResponse, error)
+	QueryInsuranceFundWithdrawal(ctx context.Context, in *QueryInsuranceFundWithdrawalRequest, opts ...grpc.CallOption) (*QueryInsuranceFundWithdrawalResponse, error)
+	QueryOpenInterest(ctx context.Context, in *QueryOpenInterestRequest, opts ...grpc.CallOption) (*QueryOpenInterestResponse, error)
+	QueryPositionsByPair(ctx context.Context, in *QueryPositionsByPairRequest, opts ...grpc.CallOption) (*QueryPositionsByPairResponse, error)
+	QueryLiquidatablePositions(ctx context.Context, in *QueryLiquidatablePositionsRequest, opts ...grpc.CallOption) (*QueryLiquidatablePositionsResponse, error)
+	// EstimateOpenPosition previews opening, increasing, reducing or reversing
+	// a position without committing it.
+	EstimateOpenPosition(ctx context.Context, in *QueryEstimateOpenPositionRequest, opts ...grpc.CallOption) (*QueryEstimateOpenPositionResponse, error)
+	// QueryAutoDeleverageRank returns the rank of a position in the queue of
+	// the positions reduced first when a bad debt can't be covered by the funds.
+	QueryAutoDeleverageRank(ctx context.Context, in *QueryAutoDeleverageRankRequest, opts ...grpc.CallOption) (*QueryAutoDeleverageRankResponse, error)
+	// QuerySubaccounts returns the subaccounts of an owner which have been used
+	// to trade or received margin, with their addresses.
+	QuerySubaccounts(ctx context.Context, in *QuerySubaccountsRequest, opts ...grpc.CallOption) (*QuerySubaccountsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryPosition(ctx context.Context, in *QueryPositionRequest, opts ...grpc.CallOption) (*QueryPositionResponse, error) {
+	out := new(QueryPositionResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Query/QueryPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryPositions(ctx context.Context, in *QueryPositionsRequest, opts ...grpc.CallOption) (*QueryPositionsResponse, error) {
+	out := new(QueryPositionsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Query/QueryPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FundingRates(ctx context.Context, in *QueryFundingRatesRequest, opts ...grpc.CallOption) (*QueryFundingRatesResponse, error) {
+	out := new(QueryFundingRatesResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Query/FundingRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryOrders(ctx context.Context, in *QueryOrdersRequest, opts ...grpc.CallOption) (*QueryOrdersResponse, error) {
+	out := new(QueryOrdersResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Query/QueryOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryMarginAccount(ctx context.Context, in *QueryMarginAccountRequest, opts ...grpc.CallOption) (*QueryMarginAccountResponse, error) {
+	out := new(QueryMarginAccountResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Query/QueryMarginAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryInsuranceFund(ctx context.Context, in *QueryInsuranceFundRequest, opts ...grpc.CallOption) (*QueryInsuranceFundResponse, error) {
+	out := new(QueryInsuranceFundResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Query/QueryInsuranceFund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryInsuranceFundWithdrawal(ctx context.Context, in *QueryInsuranceFundWithdrawalRequest, opts ...grpc.CallOption) (*QueryInsuranceFundWithdrawalResponse, error) {
+	out := new(QueryInsuranceFundWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Query/QueryInsuranceFundWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryOpenInterest(ctx context.Context, in *QueryOpenInterestRequest, opts ...grpc.CallOption) (*QueryOpenInterestResponse, error) {
+	out := new(QueryOpenInterestResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Query/QueryOpenInterest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryPositionsByPair(ctx context.Context, in *QueryPositionsByPairRequest, opts ...grpc.CallOption) (*QueryPositionsByPairResponse, error) {
+	out := new(QueryPositionsByPairResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Query/QueryPositionsByPair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryLiquidatablePositions(ctx context.Context, in *QueryLiquidatablePositionsRequest, opts ...grpc.CallOption) (*QueryLiquidatablePositionsResponse, error) {
+	out := new(QueryLiquidatablePositionsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Query/QueryLiquidatablePositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateOpenPosition(ctx context.Context, in *QueryEstimateOpenPositionRequest, opts ...grpc.CallOption) (*QueryEstimateOpenPositionResponse, error) {
+	out := new(QueryEstimateOpenPositionResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Query/EstimateOpenPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryAutoDeleverageRank(ctx context.Context, in *QueryAutoDeleverageRankRequest, opts ...grpc.CallOption) (*QueryAutoDeleverageRankResponse, error) {
+	out := new(QueryAutoDeleverageRankResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Query/QueryAutoDeleverageRank", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuerySubaccounts(ctx context.Context, in *QuerySubaccountsRequest, opts ...grpc.CallOption) (*QuerySubaccountsResponse, error) {
+	out := new(QuerySubaccountsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Query/QuerySubaccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the x/perp module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	QueryPosition(context.Context, *QueryPositionRequest) (*QueryPositionResponse, error)
+	QueryPositions(context.Context, *QueryPositionsRequest) (*QueryPositionsResponse, error)
+	FundingRates(context.Context, *QueryFundingRatesRequest) (*QueryFundingRatesResponse, error)
+	QueryOrders(context.Context, *QueryOrdersRequest) (*QueryOrdersResponse, error)
+	QueryMarginAccount(context.Context, *QueryMarginAccountRequest) (*QueryMarginAccountResponse, error)
+	QueryInsuranceFund(context.Context, *QueryInsuranceFundRequest) (*QueryInsuranceFundResponse, error)
+	QueryInsuranceFundWithdrawal(context.Context, *QueryInsuranceFundWithdrawalRequest) (*QueryInsuranceFundWithdrawalResponse, error)
+	QueryOpenInterest(context.Context, *QueryOpenInterestRequest) (*QueryOpenInterestResponse, error)
+	QueryPositionsByPair(context.Context, *QueryPositionsByPairRequest) (*QueryPositionsByPairResponse, error)
+	QueryLiquidatablePositions(context.Context, *QueryLiquidatablePositionsRequest) (*QueryLiquidatablePositionsResponse, error)
+	// EstimateOpenPosition previews opening, increasing, reducing or reversing
+	// a position without committing it.
+	EstimateOpenPosition(context.Context, *QueryEstimateOpenPositionRequest) (*QueryEstimateOpenPositionResponse, error)
+	// QueryAutoDeleverageRank returns the rank of a position in the queue of
+	// the positions reduced first when a bad debt can't be covered by the funds.
+	QueryAutoDeleverageRank(context.Context, *QueryAutoDeleverageRankRequest) (*QueryAutoDeleverageRankResponse, error)
+	// QuerySubaccounts returns the subaccounts of an owner which have been used
+	// to trade or received margin, with their addresses.
+	QuerySubaccounts(context.Context, *QuerySubaccountsRequest) (*QuerySubaccountsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) QueryPosition(ctx context.Context, req *QueryPositionRequest) (*QueryPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPosition not implemented")
+}
+func (*UnimplementedQueryServer) QueryPositions(ctx context.Context, req *QueryPositionsRequest) (*QueryPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPositions not implemented")
+}
+func (*UnimplementedQueryServer) FundingRates(ctx context.Context, req *QueryFundingRatesRequest) (*QueryFundingRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundingRates not implemented")
+}
+func (*UnimplementedQueryServer) QueryOrders(ctx context.Context, req *QueryOrdersRequest) (*QueryOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryOrders not implemented")
+}
+func (*UnimplementedQueryServer) QueryMarginAccount(ctx context.Context, req *QueryMarginAccountRequest) (*QueryMarginAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryMarginAccount not implemented")
+}
+func (*UnimplementedQueryServer) QueryInsuranceFund(ctx context.Context, req *QueryInsuranceFundRequest) (*QueryInsuranceFundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryInsuranceFund not implemented")
+}
+func (*UnimplementedQueryServer) QueryInsuranceFundWithdrawal(ctx context.Context, req *QueryInsuranceFundWithdrawalRequest) (*QueryInsuranceFundWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryInsuranceFundWithdrawal not implemented")
+}
+func (*UnimplementedQueryServer) QueryOpenInterest(ctx context.Context, req *QueryOpenInterestRequest) (*QueryOpenInterestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryOpenInterest not implemented")
+}
+func (*UnimplementedQueryServer) QueryPositionsByPair(ctx context.Context, req *QueryPositionsByPairRequest) (*QueryPositionsByPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPositionsByPair not implemented")
+}
+func (*UnimplementedQueryServer) QueryLiquidatablePositions(ctx context.Context, req *QueryLiquidatablePositionsRequest) (*QueryLiquidatablePositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryLiquidatablePositions not implemented")
+}
+func (*UnimplementedQueryServer) EstimateOpenPosition(ctx context.Context, req *QueryEstimateOpenPositionRequest) (*QueryEstimateOpenPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateOpenPosition not implemented")
+}
+func (*UnimplementedQueryServer) QueryAutoDeleverageRank(ctx context.Context, req *QueryAutoDeleverageRankRequest) (*QueryAutoDeleverageRankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAutoDeleverageRank not implemented")
+}
+func (*UnimplementedQueryServer) QuerySubaccounts(ctx context.Context, req *QuerySubaccountsRequest) (*QuerySubaccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySubaccounts not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Query/QueryPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPosition(ctx, req.(*QueryPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Query/QueryPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPositions(ctx, req.(*QueryPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FundingRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFundingRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FundingRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Query/FundingRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FundingRates(ctx, req.(*QueryFundingRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Query/QueryOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryOrders(ctx, req.(*QueryOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryMarginAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarginAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryMarginAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Query/QueryMarginAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryMarginAccount(ctx, req.(*QueryMarginAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryInsuranceFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInsuranceFundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryInsuranceFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Query/QueryInsuranceFund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryInsuranceFund(ctx, req.(*QueryInsuranceFundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryInsuranceFundWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInsuranceFundWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryInsuranceFundWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Query/QueryInsuranceFundWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryInsuranceFundWithdrawal(ctx, req.(*QueryInsuranceFundWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryOpenInterest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOpenInterestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryOpenInterest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Query/QueryOpenInterest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryOpenInterest(ctx, req.(*QueryOpenInterestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPositionsByPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionsByPairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPositionsByPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Query/QueryPositionsByPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPositionsByPair(ctx, req.(*QueryPositionsByPairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryLiquidatablePositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidatablePositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryLiquidatablePositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Query/QueryLiquidatablePositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryLiquidatablePositions(ctx, req.(*QueryLiquidatablePositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateOpenPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateOpenPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateOpenPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Query/EstimateOpenPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateOpenPosition(ctx, req.(*QueryEstimateOpenPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryAutoDeleverageRank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoDeleverageRankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAutoDeleverageRank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Query/QueryAutoDeleverageRank",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAutoDeleverageRank(ctx, req.(*QueryAutoDeleverageRankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuerySubaccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubaccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySubaccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Query/QuerySubaccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySubaccounts(ctx, req.(*QuerySubaccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "nibiru.perp.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "QueryPosition",
+			Handler:    _Query_QueryPosition_Handler,
+		},
+		{
+			MethodName: "QueryPositions",
+			Handler:    _Query_QueryPositions_Handler,
+		},
+		{
+			MethodName: "FundingRates",
+			Handler:    _Query_FundingRates_Handler,
+		},
+		{
+			MethodName: "QueryOrders",
+			Handler:    _Query_QueryOrders_Handler,
+		},
+		{
+			MethodName: "QueryMarginAccount",
+			Handler:    _Query_QueryMarginAccount_Handler,
+		},
+		{
+			MethodName: "QueryInsuranceFund",
+			Handler:    _Query_QueryInsuranceFund_Handler,
+		},
+		{
+			MethodName: "QueryInsuranceFundWithdrawal",
+			Handler:    _Query_QueryInsuranceFundWithdrawal_Handler,
+		},
+		{
+			MethodName: "QueryOpenInterest",
+			Handler:    _Query_QueryOpenInterest_Handler,
+		},
+		{
+			MethodName: "QueryPositionsByPair",
+			Handler:    _Query_QueryPositionsByPair_Handler,
+		},
+		{
+			MethodName: "QueryLiquidatablePositions",
+			Handler:    _Query_QueryLiquidatablePositions_Handler,
+		},
+		{
+			MethodName: "EstimateOpenPosition",
+			Handler:    _Query_EstimateOpenPosition_Handler,
+		},
+		{
+			MethodName: "QueryAutoDeleverageRank",
+			Handler:    _Query_QueryAutoDeleverageRank_Handler,
+		},
+		{
+			MethodName: "QuerySubaccounts",
+			Handler:    _Query_QuerySubaccounts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "perp/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenPair) > 0 {
+		i -= len(m.TokenPair)
+		copy(dAtA[i:], m.TokenPair)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LiquidationPrice.Size()
+		i -= size
+		if _, err := m.LiquidationPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.MarginRatioIndex.Size()
+		i -= size
+		if _, err := m.MarginRatioIndex.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MarginRatioMark.Size()
+		i -= size
+		if _, err := m.MarginRatioMark.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.UnrealizedPnl.Size()
+		i -= size
+		if _, err := m.UnrealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.PositionNotional.Size()
+		i -= size
+		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Position != nil {
+		{
+			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundingRatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundingRatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundingRatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundingRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CumulativeFundingRates) > 0 {
+		for iNdEx := len(m.CumulativeFundingRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CumulativeFundingRates[iNdEx].Size()
+				i -= size
+				if _, err := m.CumulativeFundingRates[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenPair) > 0 {
+		i -= len(m.TokenPair)
+		copy(dAtA[i:], m.TokenPair)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenPair)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarginAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarginAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarginAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarginAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarginAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarginAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FreeCollateral.Size()
+		i -= size
+		if _, err := m.FreeCollateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaintenanceMarginRatio.Size()
+		i -= size
+		if _, err := m.MaintenanceMarginRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MarginRatioMark.Size()
+		i -= size
+		if _, err := m.MarginRatioMark.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MarginMode != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarginMode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInsuranceFundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInsuranceFundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInsuranceFundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInsuranceFundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInsuranceFundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInsuranceFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SharePrice.Size()
+		i -= size
+		if _, err := m.SharePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Assets.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInsuranceFundWithdrawalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInsuranceFundWithdrawalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInsuranceFundWithdrawalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInsuranceFundWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInsuranceFundWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInsuranceFundWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Withdrawal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOpenInterestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOpenInterestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOpenInterestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenPair) > 0 {
+		i -= len(m.TokenPair)
+		copy(dAtA[i:], m.TokenPair)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOpenInterestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOpenInterestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOpenInterestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxPositionSize.Size()
+		i -= size
+		if _, err := m.MaxPositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxOpenInterest.Size()
+		i -= size
+		if _, err := m.MaxOpenInterest.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.OpenInterest.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionsByPairRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionsByPairRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionsByPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenPair) > 0 {
+		i -= len(m.TokenPair)
+		copy(dAtA[i:], m.TokenPair)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionsByPairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionsByPairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionsByPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidatablePositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidatablePositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidatablePositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenPair) > 0 {
+		i -= len(m.TokenPair)
+		copy(dAtA[i:], m.TokenPair)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidatablePositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidatablePositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidatablePositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateOpenPositionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateOpenPositionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateOpenPositionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BaseAssetAmountLimit.Size()
+		i -= size
+		if _, err := m.BaseAssetAmountLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Leverage.Size()
+		i -= size
+		if _, err := m.Leverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.QuoteAssetAmount.Size()
+		i -= size
+		if _, err := m.QuoteAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Side != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenPair) > 0 {
+		i -= len(m.TokenPair)
+		copy(dAtA[i:], m.TokenPair)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateOpenPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateOpenPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateOpenPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MakerRebate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Failure != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Failure))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.LiquidationPrice.Size()
+		i -= size
+		if _, err := m.LiquidationPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.MarginRatio.Size()
+		i -= size
+		if _, err := m.MarginRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.MarginToVault.Size()
+		i -= size
+		if _, err := m.MarginToVault.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.RealizedPnl.Size()
+		i -= size
+		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.FundingPayment.Size()
+		i -= size
+		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.FeeToInsuranceFund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.FeeToEcosystemFund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.FeeToFeePool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MarkPrice.Size()
+		i -= size
+		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ExchangedNotionalValue.Size()
+		i -= size
+		if _, err := m.ExchangedNotionalValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ExchangedPositionSize.Size()
+		i -= size
+		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Position != nil {
+		{
+			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoDeleverageRankRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoDeleverageRankRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoDeleverageRankRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenPair) > 0 {
+		i -= len(m.TokenPair)
+		copy(dAtA[i:], m.TokenPair)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoDeleverageRankResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoDeleverageRankResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoDeleverageRankResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.QueueSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QueueSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Rank != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Rank))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubaccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubaccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubaccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubaccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubaccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubaccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Subaccounts) > 0 {
+		for iNdEx := len(m.Subaccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subaccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPositionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenPair)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Position != nil {
+		l = m.Position.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.PositionNotional.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.UnrealizedPnl.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MarginRatioMark.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MarginRatioIndex.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = m.LiquidationPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFundingRatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFundingRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CumulativeFundingRates) > 0 {
+		for _, e := range m.CumulativeFundingRates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenPair)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMarginAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMarginAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarginMode != 0 {
+		n += 1 + sovQuery(uint64(m.MarginMode))
+	}
+	l = m.MarginRatioMark.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaintenanceMarginRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FreeCollateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInsuranceFundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInsuranceFundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Assets.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Shares.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SharePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInsuranceFundWithdrawalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInsuranceFundWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Withdrawal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Value.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOpenInterestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenPair)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOpenInterestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OpenInterest.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxOpenInterest.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxPositionSize.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPositionsByPairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenPair)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPositionsByPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidatablePositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenPair)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryLiquidatablePositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEstimateOpenPositionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenPair)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Side != 0 {
+		n += 1 + sovQuery(uint64(m.Side))
+	}
+	l = m.QuoteAssetAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Leverage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BaseAssetAmountLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimateOpenPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Position != nil {
+		l = m.Position.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ExchangedPositionSize.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ExchangedNotionalValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FeeToFeePool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FeeToEcosystemFund.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FeeToInsuranceFund.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FundingPayment.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RealizedPnl.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MarginToVault.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MarginRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LiquidationPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Failure != 0 {
+		n += 1 + sovQuery(uint64(m.Failure))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.MakerRebate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAutoDeleverageRankRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenPair)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoDeleverageRankResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rank != 0 {
+		n += 1 + sovQuery(uint64(m.Rank))
+	}
+	if m.QueueSize != 0 {
+		n += 1 + sovQuery(uint64(m.QueueSize))
+	}
+	l = m.Score.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySubaccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySubaccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subaccounts) > 0 {
+		for _, e := range m.Subaccounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, &QueryPositionResponse{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Position == nil {
+				m.Position = &Position{}
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnrealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginRatioMark", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarginRatioMark.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginRatioIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarginRatioIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundingRatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundingRatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundingRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundingRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundingRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundingRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeFundingRates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.CumulativeFundingRates = append(m.CumulativeFundingRates, v)
+			if err := m.CumulativeFundingRates[len(m.CumulativeFundingRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarginAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarginAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarginAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarginAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarginAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarginAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginMode", wireType)
+			}
+			m.MarginMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarginMode |= MarginMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginRatioMark", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarginRatioMark.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeCollateral", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FreeCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInsuranceFundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInsuranceFundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInsuranceFundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInsuranceFundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInsuranceFundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInsuranceFundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInsuranceFundWithdrawalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInsuranceFundWithdrawalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInsuranceFundWithdrawalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInsuranceFundWithdrawalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInsuranceFundWithdrawalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInsuranceFundWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Withdrawal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOpenInterestRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOpenInterestRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOpenInterestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOpenInterestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOpenInterestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOpenInterestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenInterest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OpenInterest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenInterest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOpenInterest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionsByPairRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionsByPairRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionsByPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionsByPairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionsByPairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionsByPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, &QueryPositionResponse{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidatablePositionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidatablePositionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidatablePositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidatablePositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidatablePositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidatablePositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, &QueryPositionResponse{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateOpenPositionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateOpenPositionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateOpenPositionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Leverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetAmountLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAssetAmountLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateOpenPositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateOpenPositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateOpenPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Position == nil {
+				m.Position = &Position{}
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotionalValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedNotionalValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToFeePool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.FeeToFeePool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToEcosystemFund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.FeeToEcosystemFund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToInsuranceFund", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1206,63 +7111,149 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FeeToInsuranceFund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPositionsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginToVault", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.MarginToVault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPositionsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1290,61 +7281,64 @@ func (m *QueryPositionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trader = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.LiquidationPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.Failure = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failure |= EstimateFailure(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPositionsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPositionsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerRebate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1371,8 +7365,7 @@ func (m *QueryPositionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Positions = append(m.Positions, &QueryPositionResponse{})
-			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MakerRebate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1397,7 +7390,7 @@ func (m *QueryPositionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPositionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoDeleverageRankRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1420,10 +7413,10 @@ func (m *QueryPositionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPositionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoDeleverageRankRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPositionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoDeleverageRankRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1511,7 +7504,7 @@ func (m *QueryPositionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPositionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoDeleverageRankResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1534,17 +7527,17 @@ func (m *QueryPositionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPositionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoDeleverageRankResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoDeleverageRankResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rank", wireType)
 			}
-			var msglen int
+			m.Rank = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1554,33 +7547,16 @@ func (m *QueryPositionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Rank |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Position == nil {
-				m.Position = &Position{}
-			}
-			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueSize", wireType)
 			}
-			var stringLen uint64
+			m.QueueSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1590,97 +7566,14 @@ func (m *QueryPositionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.QueueSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnl", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.UnrealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarginRatioMark", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MarginRatioMark.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarginRatioIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1708,29 +7601,10 @@ func (m *QueryPositionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarginRatioIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
-			}
-			m.BlockNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockNumber |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1752,7 +7626,7 @@ func (m *QueryPositionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFundingRatesRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySubaccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1775,15 +7649,15 @@ func (m *QueryFundingRatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFundingRatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySubaccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFundingRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySubaccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1811,7 +7685,7 @@ func (m *QueryFundingRatesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pair = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1834,7 +7708,7 @@ func (m *QueryFundingRatesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFundingRatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySubaccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1857,17 +7731,17 @@ func (m *QueryFundingRatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFundingRatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySubaccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFundingRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySubaccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeFundingRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subaccounts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1877,25 +7751,23 @@ func (m *QueryFundingRatesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.CumulativeFundingRates = append(m.CumulativeFundingRates, v)
-			if err := m.CumulativeFundingRates[len(m.CumulativeFundingRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Subaccounts = append(m.Subaccounts, Subaccount{})
+			if err := m.Subaccounts[len(m.Subaccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7,6 +7,16 @@ import (
 )
 
 func (m *Position) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateCrossMargin validates a position of a cross margin account. Its margin
+// can be zero, as it may have been used to cover the losses of the rest of the account.
+func (m *Position) ValidateCrossMargin() error {
+	return m.validate(true)
+}
+
+func (m *Position) validate(allowZeroMargin bool) error {
 	if _, err := sdk.AccAddressFromBech32(m.TraderAddress); err != nil {
 		return err
 	}
@@ -19,7 +29,7 @@ func (m *Position) Validate() error {
 		return fmt.Errorf("zero size")
 	}
 
-	if m.Margin.IsNegative() || m.Margin.IsZero() {
+	if m.Margin.IsNegative() || (m.Margin.IsZero() && !allowZeroMargin) {
 		return fmt.Errorf("margin <= 0")
 	}
 
@@ -39,10 +49,20 @@ func (m *PairMetadata) Validate() error {
 		return err
 	}
 
-	for i, pf := range m.CumulativePremiumFractions {
-		if pf.IsNil() {
-			return fmt.Errorf("invalid cumulative funding rate at index: %d", i)
-		}
+	if len(m.CumulativePremiumFractions) > 0 { //nolint:staticcheck // rejects the previous layout
+		return fmt.Errorf("cumulative premium fractions are not stored in the pair metadata anymore")
+	}
+
+	return nil
+}
+
+func (m *CumulativePremiumFraction) Validate() error {
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+
+	if m.Value.IsNil() {
+		return fmt.Errorf("invalid nil cumulative premium fraction")
 	}
 
 	return nil
@@ -54,3 +74,105 @@ func (m *PrepaidBadDebt) Validate() error {
 		Amount: m.Amount,
 	}.Validate()
 }
+
+func (m *InsuranceFundWithdrawal) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.Depositor); err != nil {
+		return err
+	}
+
+	if err := m.Shares.Validate(); err != nil {
+		return err
+	}
+
+	if !m.Shares.IsPositive() {
+		return fmt.Errorf("shares must be positive")
+	}
+
+	if _, isShare := InsuranceFundQuoteDenom(m.Shares.Denom); !isShare {
+		return fmt.Errorf("not an insurance fund share denom: %s", m.Shares.Denom)
+	}
+
+	return nil
+}
+
+func (m *Order) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.TraderAddress); err != nil {
+		return err
+	}
+
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+
+	if m.Side != Side_BUY && m.Side != Side_SELL {
+		return fmt.Errorf("invalid side")
+	}
+
+	if m.TriggerPrice.IsNil() || !m.TriggerPrice.IsPositive() {
+		return fmt.Errorf("trigger price must be positive")
+	}
+
+	switch m.OrderType {
+	case OrderType_LIMIT:
+		if m.QuoteAssetAmount.IsNil() || !m.QuoteAssetAmount.IsPositive() {
+			return fmt.Errorf("quote asset amount must be positive")
+		}
+		if m.Leverage.IsNil() || !m.Leverage.IsPositive() {
+			return fmt.Errorf("leverage must be positive")
+		}
+		if m.BaseAssetAmountLimit.IsNil() || m.BaseAssetAmountLimit.IsNegative() {
+			return fmt.Errorf("base asset amount limit must not be negative")
+		}
+	case OrderType_STOP_LOSS, OrderType_TAKE_PROFIT:
+	default:
+		return fmt.Errorf("invalid order type")
+	}
+
+	if m.Deposit.IsNil() || m.Deposit.IsNegative() {
+		return fmt.Errorf("deposit must not be negative")
+	}
+
+	if m.BlockNumber < 0 {
+		return fmt.Errorf("invalid block number")
+	}
+
+	return nil
+}
+
+/*
+IsTriggered returns true if the order should be executed at the given mark price.
+
+  - LIMIT BUY, STOP_LOSS on a long: triggers when the mark price falls to the trigger price.
+  - LIMIT SELL, STOP_LOSS on a short: triggers when the mark price rises to the trigger price.
+  - TAKE_PROFIT on a long: triggers when the mark price rises to the trigger price.
+  - TAKE_PROFIT on a short: triggers when the mark price falls to the trigger price.
+*/
+func (m *Order) IsTriggered(markPrice sdk.Dec) bool {
+	if m.TriggersOnRise() {
+		return markPrice.GTE(m.TriggerPrice)
+	}
+	return markPrice.LTE(m.TriggerPrice)
+}
+
+// TriggersOnRise returns whether the order triggers when the mark price rises to its
+// trigger price, rather than when it falls to it.
+func (m *Order) TriggersOnRise() bool {
+	triggersOnRise := m.Side == Side_SELL
+	if m.OrderType == OrderType_TAKE_PROFIT {
+		triggersOnRise = !triggersOnRise
+	}
+	return triggersOnRise
+}
+
+/*
+SignedTriggerPrice returns the trigger price of the order, negated if the order triggers
+when the mark price rises to it. An order is triggered when its signed trigger price is
+at or above the mark price signed the same way, so the triggered orders of a pair sit in
+two ranges of the signed trigger prices, one for each sign.
+*/
+func (m *Order) SignedTriggerPrice() sdk.Dec {
+	if m.TriggersOnRise() {
+		return m.TriggerPrice.Neg()
+	}
+	return m.TriggerPrice
+}
@@ -6,13 +6,14 @@ package types
 import (
 	fmt "fmt"
 	common "github.com/NibiruChain/nibiru/x/common"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
 	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -59,6 +60,74 @@ func (Side) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_0416b6ef16ef80be, []int{0}
 }
 
+type OrderType int32
+
+const (
+	OrderType_ORDER_TYPE_UNSPECIFIED OrderType = 0
+	// LIMIT opens or increases a position once the mark price reaches the
+	// trigger price.
+	OrderType_LIMIT OrderType = 1
+	// STOP_LOSS closes a position once the mark price moves against it past the
+	// trigger price.
+	OrderType_STOP_LOSS OrderType = 2
+	// TAKE_PROFIT closes a position once the mark price moves in its favor past
+	// the trigger price.
+	OrderType_TAKE_PROFIT OrderType = 3
+)
+
+var OrderType_name = map[int32]string{
+	0: "ORDER_TYPE_UNSPECIFIED",
+	1: "LIMIT",
+	2: "STOP_LOSS",
+	3: "TAKE_PROFIT",
+}
+
+var OrderType_value = map[string]int32{
+	"ORDER_TYPE_UNSPECIFIED": 0,
+	"LIMIT":                  1,
+	"STOP_LOSS":              2,
+	"TAKE_PROFIT":            3,
+}
+
+func (x OrderType) String() string {
+	return proto.EnumName(OrderType_name, int32(x))
+}
+
+func (OrderType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{1}
+}
+
+type MarginMode int32
+
+const (
+	MarginMode_MARGIN_MODE_UNSPECIFIED MarginMode = 0
+	// ISOLATED backs every position with its own margin only.
+	MarginMode_ISOLATED MarginMode = 1
+	// CROSS pools the margin of all positions sharing a quote denom, so margin
+	// ratio checks and liquidations happen at the account level.
+	MarginMode_CROSS MarginMode = 2
+)
+
+var MarginMode_name = map[int32]string{
+	0: "MARGIN_MODE_UNSPECIFIED",
+	1: "ISOLATED",
+	2: "CROSS",
+}
+
+var MarginMode_value = map[string]int32{
+	"MARGIN_MODE_UNSPECIFIED": 0,
+	"ISOLATED":                1,
+	"CROSS":                   2,
+}
+
+func (x MarginMode) String() string {
+	return proto.EnumName(MarginMode_name, int32(x))
+}
+
+func (MarginMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{2}
+}
+
 type PnLCalcOption int32
 
 const (
@@ -87,7 +156,7 @@ func (x PnLCalcOption) String() string {
 }
 
 func (PnLCalcOption) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_0416b6ef16ef80be, []int{1}
+	return fileDescriptor_0416b6ef16ef80be, []int{3}
 }
 
 type PnLPreferenceOption int32
@@ -115,7 +184,7 @@ func (x PnLPreferenceOption) String() string {
 }
 
 func (PnLPreferenceOption) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_0416b6ef16ef80be, []int{2}
+	return fileDescriptor_0416b6ef16ef80be, []int{4}
 }
 
 type MarginCalculationPriceOption int32
@@ -146,7 +215,7 @@ func (x MarginCalculationPriceOption) String() string {
 }
 
 func (MarginCalculationPriceOption) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_0416b6ef16ef80be, []int{3}
+	return fileDescriptor_0416b6ef16ef80be, []int{5}
 }
 
 type Params struct {
@@ -170,6 +239,54 @@ type Params struct {
 	// whitelisted_liquidators defines the list of addresses
 	// which are allowed to liquidate a position.
 	WhitelistedLiquidators []string `protobuf:"bytes,9,rep,name=whitelisted_liquidators,json=whitelistedLiquidators,proto3" json:"whitelisted_liquidators,omitempty"`
+	// InsuranceFundFeeShare is the share of the ecosystem fund fees that is paid
+	// to the depositors of the insurance fund of the quote denom, if it has any.
+	InsuranceFundFeeShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=insurance_fund_fee_share,json=insuranceFundFeeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"insurance_fund_fee_share"`
+	// amount of time insurance fund shares stay at risk after their withdrawal
+	// is requested, before they can be redeemed.
+	InsuranceFundWithdrawalCooldown time.Duration `protobuf:"bytes,11,opt,name=insurance_fund_withdrawal_cooldown,json=insuranceFundWithdrawalCooldown,proto3,stdduration" json:"insurance_fund_withdrawal_cooldown,omitempty" yaml:"insurance_fund_withdrawal_cooldown"`
+	// guardian is the address, e.g. a multisig, allowed to halt and resume
+	// trading on single pairs or on the whole exchange, next to governance.
+	Guardian string `protobuf:"bytes,12,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// halted_pairs are the pairs on which trading is halted: positions can be
+	// closed and margin added, but not opened, increased or liquidated.
+	HaltedPairs []string `protobuf:"bytes,13,rep,name=halted_pairs,json=haltedPairs,proto3" json:"halted_pairs,omitempty"`
+	// circuit_breaker_band is the largest move of the mark price of a pair,
+	// relative to its lowest mark price within the circuit breaker window,
+	// over which trading on the pair is halted. Zero disables the circuit breaker.
+	CircuitBreakerBand github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=circuit_breaker_band,json=circuitBreakerBand,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"circuit_breaker_band"`
+	// circuit_breaker_window is how far back the mark prices of the reserve
+	// snapshots are checked by the circuit breaker.
+	CircuitBreakerWindow time.Duration `protobuf:"bytes,15,opt,name=circuit_breaker_window,json=circuitBreakerWindow,proto3,stdduration" json:"circuit_breaker_window,omitempty" yaml:"circuit_breaker_window"`
+	// permissionless_liquidations lets any address liquidate positions, not
+	// only the whitelisted liquidators.
+	PermissionlessLiquidations bool `protobuf:"varint,16,opt,name=permissionless_liquidations,json=permissionlessLiquidations,proto3" json:"permissionless_liquidations,omitempty"`
+	// liquidator_priority_blocks is the number of blocks after a position is
+	// first found liquidatable during which only the whitelisted liquidators can
+	// liquidate it. Zero disables the priority window.
+	LiquidatorPriorityBlocks uint64 `protobuf:"varint,17,opt,name=liquidator_priority_blocks,json=liquidatorPriorityBlocks,proto3" json:"liquidator_priority_blocks,omitempty"`
+	// max_permissionless_liquidations_per_block caps the number of liquidations
+	// by non-whitelisted liquidators in a single block. Zero disables the cap.
+	MaxPermissionlessLiquidationsPerBlock uint64 `protobuf:"varint,18,opt,name=max_permissionless_liquidations_per_block,json=maxPermissionlessLiquidationsPerBlock,proto3" json:"max_permissionless_liquidations_per_block,omitempty"`
+	// max_liquidator_reward_ratio is the share of the liquidation fee paid to
+	// the liquidator of a position whose margin ratio is zero. The share grows
+	// linearly from one half, at the maintenance margin ratio, up to it.
+	MaxLiquidatorRewardRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,19,opt,name=max_liquidator_reward_ratio,json=maxLiquidatorRewardRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_liquidator_reward_ratio"`
+	// fee_tiers replace the fee pool and ecosystem fund fee ratios of the
+	// traders whose notional volume over the last 30 days reaches their min
+	// volume. They are sorted by increasing min volume.
+	FeeTiers []FeeTier `protobuf:"bytes,20,rep,name=fee_tiers,json=feeTiers,proto3" json:"fee_tiers"`
+	// maker_rebate_ratio is the share of the exchanged notional paid back from
+	// the fee pool to the traders whose trade opens or increases a position and
+	// moves the mark price toward the index price. It must be below the taker
+	// fee ratio of every fee tier. Zero disables the rebates.
+	MakerRebateRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,21,opt,name=maker_rebate_ratio,json=makerRebateRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maker_rebate_ratio"`
+	// order_deposit is the amount of the quote denom of the pair escrowed with
+	// every resting order, on top of the margin of a LIMIT order. It is returned
+	// when the order is filled or cancelled, and paid to the PerpEF when the
+	// order fails to execute, unless a trading halt, the fluctuation limit or the
+	// open interest cap rejected it.
+	OrderDeposit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,22,opt,name=order_deposit,json=orderDeposit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"order_deposit"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -233,10 +350,109 @@ func (m *Params) GetWhitelistedLiquidators() []string {
 	return nil
 }
 
+func (m *Params) GetInsuranceFundWithdrawalCooldown() time.Duration {
+	if m != nil {
+		return m.InsuranceFundWithdrawalCooldown
+	}
+	return 0
+}
+
+func (m *Params) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+func (m *Params) GetHaltedPairs() []string {
+	if m != nil {
+		return m.HaltedPairs
+	}
+	return nil
+}
+
+func (m *Params) GetCircuitBreakerWindow() time.Duration {
+	if m != nil {
+		return m.CircuitBreakerWindow
+	}
+	return 0
+}
+
+func (m *Params) GetPermissionlessLiquidations() bool {
+	if m != nil {
+		return m.PermissionlessLiquidations
+	}
+	return false
+}
+
+func (m *Params) GetLiquidatorPriorityBlocks() uint64 {
+	if m != nil {
+		return m.LiquidatorPriorityBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPermissionlessLiquidationsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPermissionlessLiquidationsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetFeeTiers() []FeeTier {
+	if m != nil {
+		return m.FeeTiers
+	}
+	return nil
+}
+
+// FeeTier is a step of the trading fee schedule.
+type FeeTier struct {
+	// min_volume is the notional volume over the last 30 days, in quote units,
+	// from which the tier applies.
+	MinVolume             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=min_volume,json=minVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_volume"`
+	FeePoolFeeRatio       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=fee_pool_fee_ratio,json=feePoolFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_pool_fee_ratio"`
+	EcosystemFundFeeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=ecosystem_fund_fee_ratio,json=ecosystemFundFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ecosystem_fund_fee_ratio"`
+}
+
+func (m *FeeTier) Reset()         { *m = FeeTier{} }
+func (m *FeeTier) String() string { return proto.CompactTextString(m) }
+func (*FeeTier) ProtoMessage()    {}
+func (*FeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{1}
+}
+func (m *FeeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeTier.Merge(m, src)
+}
+func (m *FeeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeTier proto.InternalMessageInfo
+
 // Position identifies and records information on a user's position on one of
 // the virtual liquidity pools.
 type Position struct {
-	// address identifies the address owner of this position
+	// address identifies the address owner of this position, the derived
+	// address of the subaccount for the positions of a subaccount
 	TraderAddress string `protobuf:"bytes,1,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
 	// pair identifies the pair associated with this position
 	Pair common.AssetPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair"`
@@ -258,7 +474,7 @@ func (m *Position) Reset()         { *m = Position{} }
 func (m *Position) String() string { return proto.CompactTextString(m) }
 func (*Position) ProtoMessage()    {}
 func (*Position) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0416b6ef16ef80be, []int{1}
+	return fileDescriptor_0416b6ef16ef80be, []int{2}
 }
 func (m *Position) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -308,27 +524,29 @@ func (m *Position) GetBlockNumber() int64 {
 	return 0
 }
 
-type PairMetadata struct {
-	Pair common.AssetPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair"`
-	// The historical list of cumulative premium fractions for a given pair.
-	// Calculated once per epoch.
-	// A premium fraction is the difference between mark and index, divided by the number of payments per day.
-	// (mark - index) / # payments in a day
-	CumulativePremiumFractions []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,rep,name=cumulative_premium_fractions,json=cumulativePremiumFractions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_premium_fractions"`
+// Subaccount is a numbered account of an owner, trading on its own positions
+// with its own balance. Its address is derived from the owner and the id, so
+// that its positions are keyed on the pair, the owner and the id.
+type Subaccount struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// id of the subaccount, from 1. Subaccount 0 is the owner itself.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// address holding the balance and the positions of the subaccount
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *PairMetadata) Reset()         { *m = PairMetadata{} }
-func (m *PairMetadata) String() string { return proto.CompactTextString(m) }
-func (*PairMetadata) ProtoMessage()    {}
-func (*PairMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0416b6ef16ef80be, []int{2}
+func (m *Subaccount) Reset()         { *m = Subaccount{} }
+func (m *Subaccount) String() string { return proto.CompactTextString(m) }
+func (*Subaccount) ProtoMessage()    {}
+func (*Subaccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{3}
 }
-func (m *PairMetadata) XXX_Unmarshal(b []byte) error {
+func (m *Subaccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PairMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Subaccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PairMetadata.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Subaccount.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -338,42 +556,82 @@ func (m *PairMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *PairMetadata) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PairMetadata.Merge(m, src)
+func (m *Subaccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Subaccount.Merge(m, src)
 }
-func (m *PairMetadata) XXX_Size() int {
+func (m *Subaccount) XXX_Size() int {
 	return m.Size()
 }
-func (m *PairMetadata) XXX_DiscardUnknown() {
-	xxx_messageInfo_PairMetadata.DiscardUnknown(m)
+func (m *Subaccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_Subaccount.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PairMetadata proto.InternalMessageInfo
+var xxx_messageInfo_Subaccount proto.InternalMessageInfo
 
-func (m *PairMetadata) GetPair() common.AssetPair {
+func (m *Subaccount) GetOwner() string {
 	if m != nil {
-		return m.Pair
+		return m.Owner
 	}
-	return common.AssetPair{}
+	return ""
 }
 
-type PrepaidBadDebt struct {
-	Denom  string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+func (m *Subaccount) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *PrepaidBadDebt) Reset()         { *m = PrepaidBadDebt{} }
-func (m *PrepaidBadDebt) String() string { return proto.CompactTextString(m) }
-func (*PrepaidBadDebt) ProtoMessage()    {}
-func (*PrepaidBadDebt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0416b6ef16ef80be, []int{3}
+func (m *Subaccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
-func (m *PrepaidBadDebt) XXX_Unmarshal(b []byte) error {
+
+// Order is a resting conditional order that is executed against the vpool in
+// the EndBlocker once the mark price crosses its trigger price.
+type Order struct {
+	// id is the unique identifier of the order.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// trader_address is the owner of the order.
+	TraderAddress string `protobuf:"bytes,2,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	// pair identifies the vpool the order executes against.
+	Pair common.AssetPair `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair"`
+	// order_type specifies how the order is executed once triggered.
+	OrderType OrderType `protobuf:"varint,4,opt,name=order_type,json=orderType,proto3,enum=nibiru.perp.v1.OrderType" json:"order_type,omitempty"`
+	// side is the direction of the position the order opens (LIMIT) or of the
+	// position it protects (STOP_LOSS, TAKE_PROFIT).
+	Side Side `protobuf:"varint,5,opt,name=side,proto3,enum=nibiru.perp.v1.Side" json:"side,omitempty"`
+	// trigger_price is the mark price at which the order is executed.
+	TriggerPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=trigger_price,json=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price"`
+	// quote_asset_amount is the margin held in escrow for a LIMIT order.
+	// Always zero for STOP_LOSS and TAKE_PROFIT orders.
+	QuoteAssetAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=quote_asset_amount,json=quoteAssetAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"quote_asset_amount"`
+	// leverage applied to the margin of a LIMIT order.
+	Leverage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=leverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"leverage"`
+	// base_asset_amount_limit is the slippage limit of a LIMIT order, in base
+	// asset units.
+	BaseAssetAmountLimit github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=base_asset_amount_limit,json=baseAssetAmountLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_asset_amount_limit"`
+	// block_number is the block at which the order was placed.
+	BlockNumber int64 `protobuf:"varint,10,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// deposit is the amount of the quote denom held in escrow until the order
+	// is filled, cancelled or rejected.
+	Deposit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=deposit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"deposit"`
+}
+
+func (m *Order) Reset()         { *m = Order{} }
+func (m *Order) String() string { return proto.CompactTextString(m) }
+func (*Order) ProtoMessage()    {}
+func (*Order) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{4}
+}
+func (m *Order) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PrepaidBadDebt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Order) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PrepaidBadDebt.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Order.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -383,62 +641,79 @@ func (m *PrepaidBadDebt) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *PrepaidBadDebt) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PrepaidBadDebt.Merge(m, src)
+func (m *Order) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Order.Merge(m, src)
 }
-func (m *PrepaidBadDebt) XXX_Size() int {
+func (m *Order) XXX_Size() int {
 	return m.Size()
 }
-func (m *PrepaidBadDebt) XXX_DiscardUnknown() {
-	xxx_messageInfo_PrepaidBadDebt.DiscardUnknown(m)
+func (m *Order) XXX_DiscardUnknown() {
+	xxx_messageInfo_Order.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PrepaidBadDebt proto.InternalMessageInfo
+var xxx_messageInfo_Order proto.InternalMessageInfo
 
-func (m *PrepaidBadDebt) GetDenom() string {
+func (m *Order) GetId() uint64 {
 	if m != nil {
-		return m.Denom
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Order) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
 	}
 	return ""
 }
 
-type PositionResp struct {
-	Position *Position `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
-	// The amount of quote assets exchanged.
-	ExchangedNotionalValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchanged_notional_value,json=exchangedNotionalValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_notional_value"`
-	// The amount of base assets exchanged.
-	ExchangedPositionSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=exchanged_position_size,json=exchangedPositionSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_position_size"`
-	// The amount of bad debt accrued during this position change.
-	// Measured in absolute value of quote units.
-	// If greater than zero, then the position change event will likely fail.
-	BadDebt github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=bad_debt,json=badDebt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bad_debt"`
-	// The funding payment applied on this position change.
-	FundingPayment github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=funding_payment,json=fundingPayment,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_payment"`
-	// The amount of PnL realized on this position changed, measured in quote
-	// units.
-	RealizedPnl github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=realized_pnl,json=realizedPnl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"realized_pnl"`
-	// The unrealized PnL in the position after the position change.
-	UnrealizedPnlAfter github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=unrealized_pnl_after,json=unrealizedPnlAfter,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"unrealized_pnl_after"`
-	// The amount of margin the trader has to give to the vault.
-	// A negative value means the vault pays the trader.
-	MarginToVault github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=margin_to_vault,json=marginToVault,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"margin_to_vault"`
-	// The position's notional value after the position change, measured in quote
-	// units.
-	PositionNotional github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=position_notional,json=positionNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"position_notional"`
+func (m *Order) GetPair() common.AssetPair {
+	if m != nil {
+		return m.Pair
+	}
+	return common.AssetPair{}
 }
 
-func (m *PositionResp) Reset()         { *m = PositionResp{} }
-func (m *PositionResp) String() string { return proto.CompactTextString(m) }
-func (*PositionResp) ProtoMessage()    {}
-func (*PositionResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0416b6ef16ef80be, []int{4}
+func (m *Order) GetOrderType() OrderType {
+	if m != nil {
+		return m.OrderType
+	}
+	return OrderType_ORDER_TYPE_UNSPECIFIED
 }
-func (m *PositionResp) XXX_Unmarshal(b []byte) error {
+
+func (m *Order) GetSide() Side {
+	if m != nil {
+		return m.Side
+	}
+	return Side_SIDE_UNSPECIFIED
+}
+
+func (m *Order) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+type PairMetadata struct {
+	Pair common.AssetPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair"`
+	// Deprecated: cumulative premium fractions are stored per funding epoch,
+	// see CumulativePremiumFraction. Only read by the store migration.
+	CumulativePremiumFractions []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,rep,name=cumulative_premium_fractions,json=cumulativePremiumFractions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_premium_fractions"` // Deprecated: Do not use.
+}
+
+func (m *PairMetadata) Reset()         { *m = PairMetadata{} }
+func (m *PairMetadata) String() string { return proto.CompactTextString(m) }
+func (*PairMetadata) ProtoMessage()    {}
+func (*PairMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{5}
+}
+func (m *PairMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PositionResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PairMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PositionResp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PairMetadata.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -448,50 +723,48 @@ func (m *PositionResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *PositionResp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PositionResp.Merge(m, src)
+func (m *PairMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairMetadata.Merge(m, src)
 }
-func (m *PositionResp) XXX_Size() int {
+func (m *PairMetadata) XXX_Size() int {
 	return m.Size()
 }
-func (m *PositionResp) XXX_DiscardUnknown() {
-	xxx_messageInfo_PositionResp.DiscardUnknown(m)
+func (m *PairMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairMetadata.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PositionResp proto.InternalMessageInfo
+var xxx_messageInfo_PairMetadata proto.InternalMessageInfo
 
-func (m *PositionResp) GetPosition() *Position {
+func (m *PairMetadata) GetPair() common.AssetPair {
 	if m != nil {
-		return m.Position
+		return m.Pair
 	}
-	return nil
+	return common.AssetPair{}
 }
 
-type LiquidateResp struct {
-	// Amount of bad debt created by the liquidation event
-	BadDebt github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=bad_debt,json=badDebt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bad_debt"`
-	// Fee paid to the liquidator
-	FeeToLiquidator github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=fee_to_liquidator,json=feeToLiquidator,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fee_to_liquidator"`
-	// Fee paid to the Perp EF fund
-	FeeToPerpEcosystemFund github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=fee_to_perp_ecosystem_fund,json=feeToPerpEcosystemFund,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fee_to_perp_ecosystem_fund"`
-	// Address of the liquidator
-	Liquidator string `protobuf:"bytes,4,opt,name=liquidator,proto3" json:"liquidator,omitempty"`
-	// Position response from the close or open reverse position
-	PositionResp *PositionResp `protobuf:"bytes,5,opt,name=position_resp,json=positionResp,proto3" json:"position_resp,omitempty"`
+// CumulativePremiumFraction is the cumulative premium fraction of a pair at
+// the end of a funding epoch. Calculated once per funding epoch.
+// A premium fraction is the difference between mark and index, divided by the number of payments per day.
+// (mark - index) / # payments in a day
+type CumulativePremiumFraction struct {
+	Pair common.AssetPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair"`
+	// epoch is the index of the funding epoch of the pair, starting from zero.
+	Epoch uint64                                 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Value github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"value"`
 }
 
-func (m *LiquidateResp) Reset()         { *m = LiquidateResp{} }
-func (m *LiquidateResp) String() string { return proto.CompactTextString(m) }
-func (*LiquidateResp) ProtoMessage()    {}
-func (*LiquidateResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0416b6ef16ef80be, []int{5}
+func (m *CumulativePremiumFraction) Reset()         { *m = CumulativePremiumFraction{} }
+func (m *CumulativePremiumFraction) String() string { return proto.CompactTextString(m) }
+func (*CumulativePremiumFraction) ProtoMessage()    {}
+func (*CumulativePremiumFraction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{6}
 }
-func (m *LiquidateResp) XXX_Unmarshal(b []byte) error {
+func (m *CumulativePremiumFraction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LiquidateResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CumulativePremiumFraction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LiquidateResp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CumulativePremiumFraction.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -501,401 +774,545 @@ func (m *LiquidateResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *LiquidateResp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LiquidateResp.Merge(m, src)
+func (m *CumulativePremiumFraction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CumulativePremiumFraction.Merge(m, src)
 }
-func (m *LiquidateResp) XXX_Size() int {
+func (m *CumulativePremiumFraction) XXX_Size() int {
 	return m.Size()
 }
-func (m *LiquidateResp) XXX_DiscardUnknown() {
-	xxx_messageInfo_LiquidateResp.DiscardUnknown(m)
+func (m *CumulativePremiumFraction) XXX_DiscardUnknown() {
+	xxx_messageInfo_CumulativePremiumFraction.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LiquidateResp proto.InternalMessageInfo
+var xxx_messageInfo_CumulativePremiumFraction proto.InternalMessageInfo
 
-func (m *LiquidateResp) GetLiquidator() string {
+func (m *CumulativePremiumFraction) GetPair() common.AssetPair {
 	if m != nil {
-		return m.Liquidator
+		return m.Pair
 	}
-	return ""
+	return common.AssetPair{}
 }
 
-func (m *LiquidateResp) GetPositionResp() *PositionResp {
+func (m *CumulativePremiumFraction) GetEpoch() uint64 {
 	if m != nil {
-		return m.PositionResp
+		return m.Epoch
 	}
-	return nil
+	return 0
 }
 
-func init() {
-	proto.RegisterEnum("nibiru.perp.v1.Side", Side_name, Side_value)
-	proto.RegisterEnum("nibiru.perp.v1.PnLCalcOption", PnLCalcOption_name, PnLCalcOption_value)
-	proto.RegisterEnum("nibiru.perp.v1.PnLPreferenceOption", PnLPreferenceOption_name, PnLPreferenceOption_value)
-	proto.RegisterEnum("nibiru.perp.v1.MarginCalculationPriceOption", MarginCalculationPriceOption_name, MarginCalculationPriceOption_value)
-	proto.RegisterType((*Params)(nil), "nibiru.perp.v1.Params")
-	proto.RegisterType((*Position)(nil), "nibiru.perp.v1.Position")
-	proto.RegisterType((*PairMetadata)(nil), "nibiru.perp.v1.PairMetadata")
-	proto.RegisterType((*PrepaidBadDebt)(nil), "nibiru.perp.v1.PrepaidBadDebt")
-	proto.RegisterType((*PositionResp)(nil), "nibiru.perp.v1.PositionResp")
-	proto.RegisterType((*LiquidateResp)(nil), "nibiru.perp.v1.LiquidateResp")
+// OpenInterest is the total size of the open positions of a pair on each side,
+// in base asset units.
+type OpenInterest struct {
+	Pair common.AssetPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair"`
+	// long is the sum of the sizes of the long positions.
+	Long github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=long,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"long"`
+	// short is the sum of the absolute sizes of the short positions.
+	Short github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=short,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"short"`
 }
 
-func init() { proto.RegisterFile("perp/v1/state.proto", fileDescriptor_0416b6ef16ef80be) }
-
-var fileDescriptor_0416b6ef16ef80be = []byte{
-	// 1265 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0xcf, 0x6f, 0xdb, 0x36,
-	0x14, 0xc7, 0xa3, 0x38, 0x3f, 0x5f, 0x7e, 0xd4, 0x65, 0x92, 0xd6, 0x4d, 0x0b, 0x3b, 0x33, 0xb0,
-	0x21, 0xc8, 0x36, 0x0b, 0xc9, 0x06, 0x0c, 0xd8, 0xcd, 0xbf, 0x52, 0x18, 0x70, 0x1c, 0x4d, 0x4e,
-	0xd3, 0xae, 0x3d, 0x68, 0x94, 0x44, 0x3b, 0x6c, 0x24, 0x52, 0x95, 0x28, 0xa7, 0xe9, 0xce, 0xbb,
-	0xef, 0x34, 0xec, 0x0f, 0xd8, 0x9f, 0xb0, 0xf3, 0xce, 0x3d, 0xf6, 0x38, 0xec, 0x90, 0x0d, 0x0d,
-	0xb0, 0xc3, 0x8e, 0xfb, 0x0b, 0x06, 0x52, 0xb2, 0xe2, 0x15, 0xe9, 0x80, 0x68, 0x27, 0x8b, 0x7c,
-	0xe4, 0xe7, 0xbd, 0x47, 0x7e, 0x1f, 0x49, 0xc3, 0x5a, 0x40, 0xc2, 0x40, 0x1f, 0xed, 0xea, 0x91,
-	0xc0, 0x82, 0xd4, 0x82, 0x90, 0x0b, 0x8e, 0x56, 0x19, 0xb5, 0x69, 0x18, 0xd7, 0xa4, 0xad, 0x36,
-	0xda, 0xdd, 0x5c, 0x1f, 0xf2, 0x21, 0x57, 0x26, 0x5d, 0x7e, 0x25, 0xa3, 0x36, 0xcb, 0x0e, 0x8f,
-	0x7c, 0x1e, 0xe9, 0x36, 0x8e, 0x88, 0x3e, 0xda, 0xb5, 0x89, 0xc0, 0xbb, 0xba, 0xc3, 0x29, 0x4b,
-	0xed, 0xf7, 0x12, 0xbb, 0x95, 0x4c, 0x4c, 0x1a, 0xe3, 0xa9, 0x43, 0xce, 0x87, 0x1e, 0xd1, 0x55,
-	0xcb, 0x8e, 0x07, 0xba, 0x1b, 0x87, 0x58, 0x50, 0x3e, 0x9e, 0xba, 0xe6, 0x70, 0xdf, 0xe7, 0x4c,
-	0x4f, 0x7e, 0x92, 0xce, 0xea, 0x2f, 0xb3, 0x30, 0x67, 0xe0, 0x10, 0xfb, 0x11, 0x2a, 0xc1, 0x7c,
-	0x24, 0x78, 0x10, 0x10, 0xb7, 0xa4, 0x6d, 0x69, 0xdb, 0x0b, 0xe6, 0xb8, 0x89, 0x9e, 0x01, 0x1a,
-	0x10, 0x62, 0x05, 0x9c, 0x7b, 0x96, 0xfc, 0x50, 0xd8, 0x52, 0x61, 0x4b, 0xdb, 0x5e, 0x6c, 0xd4,
-	0x5e, 0x5f, 0x54, 0xa6, 0x7e, 0xbb, 0xa8, 0x7c, 0x34, 0xa4, 0xe2, 0x24, 0xb6, 0x6b, 0x0e, 0xf7,
-	0xd3, 0xb0, 0xd2, 0x9f, 0x4f, 0x23, 0xf7, 0x54, 0x17, 0xe7, 0x01, 0x89, 0x6a, 0x2d, 0xe2, 0x98,
-	0xb7, 0x06, 0x84, 0x18, 0x9c, 0x7b, 0xfb, 0x84, 0x98, 0x12, 0x83, 0x86, 0x50, 0x22, 0x0e, 0x8f,
-	0xce, 0x23, 0x41, 0x7c, 0x6b, 0x10, 0x33, 0x77, 0xc2, 0xc5, 0x4c, 0x2e, 0x17, 0x1b, 0x19, 0x6f,
-	0x3f, 0x66, 0x6e, 0xe6, 0xc8, 0x86, 0x0d, 0x8f, 0xbe, 0x88, 0xa9, 0xab, 0x16, 0x65, 0xc2, 0xcb,
-	0x6c, 0x2e, 0x2f, 0x6b, 0x13, 0xb0, 0xcc, 0xc7, 0x73, 0xb8, 0x17, 0xe0, 0x50, 0x50, 0xec, 0x59,
-	0x93, 0xbe, 0x12, 0x3f, 0x73, 0xb9, 0xfc, 0xdc, 0x4d, 0x81, 0xdd, 0x2b, 0x5e, 0xe2, 0x6b, 0x0f,
-	0x36, 0xe4, 0x72, 0x51, 0x36, 0x94, 0x7c, 0x62, 0x51, 0x26, 0x48, 0x38, 0xc2, 0x5e, 0x69, 0x5e,
-	0xfa, 0x31, 0xd7, 0x52, 0xa3, 0x89, 0x05, 0xe9, 0xa4, 0x26, 0xf4, 0x83, 0x06, 0xeb, 0xe2, 0x0c,
-	0x07, 0x96, 0xc7, 0xf9, 0xa9, 0x8d, 0x9d, 0x53, 0xeb, 0x8c, 0x32, 0x97, 0x9f, 0x95, 0x16, 0xb6,
-	0xb4, 0xed, 0xa5, 0xbd, 0x7b, 0xb5, 0x44, 0x43, 0xb5, 0xb1, 0x86, 0x6a, 0xad, 0x54, 0x43, 0x8d,
-	0x8e, 0x0c, 0xfb, 0xaf, 0x8b, 0x4a, 0xf9, 0xba, 0xe9, 0x9f, 0x70, 0x9f, 0x0a, 0xe2, 0x07, 0xe2,
-	0xfc, 0xef, 0x8b, 0xca, 0xfd, 0x73, 0xec, 0x7b, 0x5f, 0x56, 0xaf, 0x1b, 0x57, 0xfd, 0xf1, 0xf7,
-	0x8a, 0x66, 0x22, 0x69, 0xea, 0xa6, 0x96, 0xc7, 0xca, 0x80, 0xbe, 0x80, 0xbb, 0x67, 0x27, 0x54,
-	0x10, 0x8f, 0x46, 0x82, 0xb8, 0xd9, 0xe2, 0xf1, 0x30, 0x2a, 0x2d, 0x6e, 0x15, 0xb6, 0x17, 0xcd,
-	0x3b, 0x13, 0xe6, 0xee, 0x95, 0xb5, 0xfa, 0x67, 0x01, 0x16, 0x0c, 0x1e, 0x51, 0x19, 0x24, 0xfa,
-	0x10, 0x56, 0x45, 0x88, 0x5d, 0x12, 0x5a, 0xd8, 0x75, 0x43, 0x12, 0x45, 0x4a, 0xc9, 0x8b, 0xe6,
-	0x4a, 0xd2, 0x5b, 0x4f, 0x3a, 0xd1, 0x1e, 0xcc, 0x04, 0x98, 0x86, 0xa5, 0x69, 0x95, 0x74, 0xa9,
-	0x96, 0x56, 0x66, 0x5a, 0x18, 0xf5, 0x28, 0x22, 0xc2, 0xc0, 0x34, 0x6c, 0xcc, 0xc8, 0x9c, 0x4d,
-	0x35, 0x16, 0x35, 0x60, 0x26, 0xa2, 0xaf, 0x48, 0x4e, 0xd5, 0xab, 0xb9, 0x68, 0x1f, 0xe6, 0x7c,
-	0x1c, 0x0e, 0x29, 0xcb, 0x29, 0xec, 0x74, 0x36, 0xea, 0xc3, 0x0a, 0x0f, 0x08, 0xb3, 0x18, 0x97,
-	0x59, 0x63, 0x2f, 0xa7, 0x82, 0x97, 0x25, 0xa4, 0x97, 0x32, 0xd0, 0xb7, 0x50, 0xf5, 0xb0, 0x20,
-	0x91, 0xb0, 0x9c, 0xd8, 0x8f, 0x3d, 0x2c, 0xe8, 0x88, 0x58, 0x41, 0x48, 0x7c, 0x1a, 0xfb, 0xd6,
-	0x20, 0xc4, 0x8e, 0x1c, 0x97, 0x53, 0xc3, 0x95, 0x84, 0xdc, 0xcc, 0xc0, 0x46, 0xc2, 0xdd, 0x4f,
-	0xb1, 0xe8, 0x03, 0x58, 0xb6, 0x3d, 0xee, 0x9c, 0x5a, 0x2c, 0xf6, 0x6d, 0x12, 0x2a, 0x09, 0x17,
-	0xcc, 0x25, 0xd5, 0xd7, 0x53, 0x5d, 0xd5, 0x9f, 0x35, 0x58, 0x96, 0xbb, 0x72, 0x40, 0x04, 0x76,
-	0xb1, 0xc0, 0xd9, 0x2e, 0x6a, 0x37, 0xd8, 0xc5, 0x00, 0x1e, 0xfc, 0x47, 0x76, 0x51, 0x69, 0x5a,
-	0x6a, 0xed, 0xc6, 0xe9, 0x6d, 0x3a, 0xef, 0x4b, 0x2c, 0xaa, 0x32, 0x58, 0x35, 0x42, 0x12, 0x60,
-	0xea, 0x36, 0xb0, 0xdb, 0x22, 0xb6, 0x40, 0xeb, 0x30, 0xeb, 0x12, 0xc6, 0xfd, 0x54, 0x9b, 0x49,
-	0x43, 0x6a, 0x03, 0xfb, 0x3c, 0x66, 0x42, 0xa9, 0xf2, 0x66, 0x31, 0x74, 0x98, 0x30, 0xd3, 0xd9,
-	0xd5, 0x9f, 0xe6, 0x60, 0x79, 0x5c, 0x0f, 0x26, 0x89, 0x02, 0xf4, 0x39, 0x2c, 0x04, 0x69, 0xfb,
-	0xdd, 0xa5, 0x4a, 0xaf, 0xa2, 0x5a, 0x36, 0x3e, 0x1b, 0x89, 0x4e, 0xa0, 0x44, 0x5e, 0x3a, 0x27,
-	0x98, 0x0d, 0x89, 0x9b, 0xe9, 0xcc, 0x1a, 0x61, 0x2f, 0x26, 0x39, 0x02, 0x94, 0x8b, 0x74, 0x27,
-	0xe3, 0x8d, 0x25, 0x77, 0x2c, 0x69, 0x68, 0x00, 0x77, 0xaf, 0x3c, 0x8d, 0xfd, 0x5b, 0xff, 0xa3,
-	0xd6, 0x36, 0x32, 0xdc, 0x38, 0xaf, 0xbe, 0x2c, 0xbe, 0x0e, 0x2c, 0xd8, 0xd8, 0xb5, 0x5c, 0x62,
-	0x8b, 0x9c, 0xe5, 0x37, 0x6f, 0xa7, 0x3b, 0xf8, 0x18, 0x6e, 0x8d, 0x4f, 0xde, 0x00, 0x9f, 0xfb,
-	0x84, 0x89, 0x9c, 0x15, 0xb8, 0x9a, 0x62, 0x8c, 0x84, 0x82, 0xbe, 0x82, 0xe5, 0x90, 0x60, 0x8f,
-	0xbe, 0x92, 0x4b, 0xc1, 0xbc, 0x9c, 0xd5, 0xb6, 0x34, 0x66, 0x18, 0xcc, 0x43, 0xdf, 0xc0, 0x7a,
-	0xcc, 0x26, 0xa1, 0x16, 0x1e, 0x88, 0xb4, 0xc2, 0x6e, 0x8e, 0x46, 0x57, 0x2c, 0x83, 0x79, 0x75,
-	0x49, 0x42, 0xc7, 0x70, 0x2b, 0x39, 0x97, 0x2c, 0xc1, 0xad, 0x11, 0x8e, 0x3d, 0xa1, 0x6e, 0x93,
-	0x9b, 0xc3, 0x57, 0x12, 0xcc, 0x11, 0x3f, 0x96, 0x10, 0xf4, 0x0c, 0x6e, 0x67, 0x72, 0xc8, 0x4e,
-	0xba, 0xc5, 0x5c, 0xe4, 0xe2, 0x18, 0x34, 0x96, 0x5e, 0xf5, 0xbb, 0x02, 0xac, 0x8c, 0xaf, 0x11,
-	0xa2, 0xea, 0x64, 0x52, 0x1f, 0x5a, 0xae, 0x12, 0xcc, 0xf4, 0xf1, 0x14, 0x6e, 0xcb, 0xd7, 0x85,
-	0xe0, 0x13, 0xf7, 0x58, 0xce, 0xb2, 0x96, 0xcf, 0xa5, 0x23, 0x7e, 0x75, 0xe1, 0xa1, 0xe7, 0xb0,
-	0x99, 0xb2, 0x65, 0xf5, 0x5a, 0xff, 0x7e, 0x3a, 0xe5, 0xa8, 0x18, 0xe9, 0xe4, 0x8e, 0x72, 0x62,
-	0x90, 0x30, 0x68, 0x4f, 0xbe, 0x9c, 0x50, 0x19, 0x60, 0x22, 0x01, 0x55, 0x34, 0xe6, 0x44, 0x0f,
-	0xaa, 0xc3, 0x4a, 0xb6, 0x43, 0x21, 0x89, 0x02, 0x55, 0x05, 0x4b, 0x7b, 0x0f, 0xde, 0x7b, 0xbe,
-	0x90, 0x28, 0x30, 0x97, 0x83, 0x89, 0xd6, 0x8e, 0x0e, 0x33, 0x7d, 0xea, 0x12, 0xb4, 0x0e, 0xc5,
-	0x7e, 0xa7, 0xd5, 0xb6, 0x1e, 0xf5, 0xfa, 0x46, 0xbb, 0xd9, 0xd9, 0xef, 0xb4, 0x5b, 0xc5, 0x29,
-	0x34, 0x0f, 0x85, 0xc6, 0xa3, 0xaf, 0x8b, 0x1a, 0x5a, 0x80, 0x99, 0x7e, 0xbb, 0xdb, 0x2d, 0x4e,
-	0xef, 0x1c, 0xc3, 0x8a, 0xc1, 0xba, 0x4d, 0xec, 0x39, 0x87, 0x81, 0x3a, 0xa9, 0x2a, 0x70, 0xdf,
-	0xe8, 0x75, 0xad, 0x66, 0xbd, 0xdb, 0xb4, 0x0e, 0x8d, 0xa3, 0xce, 0x61, 0xef, 0x1d, 0xc8, 0x2a,
-	0x40, 0xdf, 0x38, 0x3c, 0xb2, 0x0c, 0xb3, 0xd3, 0x6c, 0x27, 0xac, 0xa3, 0xc7, 0x75, 0xa3, 0x38,
-	0x8d, 0x00, 0xe6, 0x0e, 0xcd, 0x7a, 0xb3, 0xdb, 0x2e, 0x16, 0x76, 0x1e, 0xc2, 0x9a, 0xc1, 0xba,
-	0x46, 0x48, 0x06, 0x24, 0x24, 0xcc, 0x21, 0x29, 0xbd, 0x0c, 0x9b, 0x92, 0x6e, 0x98, 0xed, 0xfd,
-	0xb6, 0xd9, 0xee, 0x35, 0xaf, 0x89, 0xf0, 0xa0, 0xfe, 0xa4, 0xa8, 0xa9, 0x8f, 0x4e, 0xaf, 0x38,
-	0xbd, 0xf3, 0x02, 0x1e, 0x1c, 0x28, 0x1d, 0xcb, 0x18, 0xd5, 0xad, 0xc0, 0x99, 0x11, 0xd2, 0x8c,
-	0xa8, 0xc3, 0xc7, 0x07, 0x75, 0xf3, 0x61, 0xa7, 0xa7, 0x42, 0x7e, 0xd4, 0xad, 0xab, 0x90, 0x55,
-	0x70, 0xd7, 0xc7, 0x2f, 0x73, 0x37, 0x0e, 0x8f, 0x8a, 0x1a, 0x5a, 0x84, 0xd9, 0x4e, 0xaf, 0xd5,
-	0x7e, 0x52, 0x9c, 0x46, 0x4b, 0x30, 0x7f, 0x50, 0x7f, 0x62, 0x19, 0xbd, 0x6e, 0xb1, 0xd0, 0x68,
-	0xbd, 0x7e, 0x5b, 0xd6, 0xde, 0xbc, 0x2d, 0x6b, 0x7f, 0xbc, 0x2d, 0x6b, 0xdf, 0x5f, 0x96, 0xa7,
-	0xde, 0x5c, 0x96, 0xa7, 0x7e, 0xbd, 0x2c, 0x4f, 0x3d, 0xdd, 0x99, 0x50, 0x40, 0x4f, 0x6d, 0x4a,
-	0xf3, 0x04, 0x53, 0xa6, 0x27, 0x1b, 0xa4, 0xbf, 0xd4, 0xd5, 0x3f, 0x15, 0xa5, 0x04, 0x7b, 0x4e,
-	0x3d, 0xfa, 0x3e, 0xfb, 0x27, 0x00, 0x00, 0xff, 0xff, 0xd0, 0x0f, 0x54, 0x26, 0xbe, 0x0c, 0x00,
-	0x00,
+func (m *OpenInterest) Reset()         { *m = OpenInterest{} }
+func (m *OpenInterest) String() string { return proto.CompactTextString(m) }
+func (*OpenInterest) ProtoMessage()    {}
+func (*OpenInterest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{7}
+}
+func (m *OpenInterest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OpenInterest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OpenInterest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OpenInterest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenInterest.Merge(m, src)
+}
+func (m *OpenInterest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OpenInterest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenInterest.DiscardUnknown(m)
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OpenInterest proto.InternalMessageInfo
+
+func (m *OpenInterest) GetPair() common.AssetPair {
+	if m != nil {
+		return m.Pair
 	}
-	return dAtA[:n], nil
+	return common.AssetPair{}
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TradingResume is the last time the guardian resumed trading on a pair, or on
+// the whole exchange if the pair is empty. The circuit breaker ignores the
+// reserve snapshots taken before it.
+type TradingResume struct {
+	Pair        string    `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	BlockHeight int64     `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Time        time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WhitelistedLiquidators) > 0 {
-		for iNdEx := len(m.WhitelistedLiquidators) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.WhitelistedLiquidators[iNdEx])
-			copy(dAtA[i:], m.WhitelistedLiquidators[iNdEx])
-			i = encodeVarintState(dAtA, i, uint64(len(m.WhitelistedLiquidators[iNdEx])))
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapLookbackWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapLookbackWindow):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintState(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x42
-	if len(m.FundingRateInterval) > 0 {
-		i -= len(m.FundingRateInterval)
-		copy(dAtA[i:], m.FundingRateInterval)
-		i = encodeVarintState(dAtA, i, uint64(len(m.FundingRateInterval)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	{
-		size := m.PartialLiquidationRatio.Size()
-		i -= size
-		if _, err := m.PartialLiquidationRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.LiquidationFeeRatio.Size()
-		i -= size
-		if _, err := m.LiquidationFeeRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.EcosystemFundFeeRatio.Size()
-		i -= size
-		if _, err := m.EcosystemFundFeeRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *TradingResume) Reset()         { *m = TradingResume{} }
+func (m *TradingResume) String() string { return proto.CompactTextString(m) }
+func (*TradingResume) ProtoMessage()    {}
+func (*TradingResume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{8}
+}
+func (m *TradingResume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradingResume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradingResume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.FeePoolFeeRatio.Size()
-		i -= size
-		if _, err := m.FeePoolFeeRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+}
+func (m *TradingResume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradingResume.Merge(m, src)
+}
+func (m *TradingResume) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradingResume) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradingResume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradingResume proto.InternalMessageInfo
+
+func (m *TradingResume) GetPair() string {
+	if m != nil {
+		return m.Pair
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.Stopped {
-		i--
-		if m.Stopped {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *TradingResume) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Position) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TradingResume) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *Position) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// InsuranceFundWithdrawal is a pending withdrawal from the insurance fund.
+// The shares are escrowed by the insurance fund until they are redeemed, so
+// they keep earning fees and absorbing bad debt during the cooldown.
+type InsuranceFundWithdrawal struct {
+	Depositor string `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// insurance fund shares to redeem, the share denom identifies the fund.
+	Shares types.Coin `protobuf:"bytes,2,opt,name=shares,proto3" json:"shares"`
+	// time from which the shares can be redeemed.
+	UnlockTime time.Time `protobuf:"bytes,3,opt,name=unlock_time,json=unlockTime,proto3,stdtime" json:"unlock_time"`
 }
 
-func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockNumber != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.BlockNumber))
-		i--
-		dAtA[i] = 0x38
-	}
-	{
-		size := m.LatestCumulativePremiumFraction.Size()
-		i -= size
-		if _, err := m.LatestCumulativePremiumFraction.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.OpenNotional.Size()
-		i -= size
-		if _, err := m.OpenNotional.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.Margin.Size()
-		i -= size
-		if _, err := m.Margin.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.Size_.Size()
-		i -= size
-		if _, err := m.Size_.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+func (m *InsuranceFundWithdrawal) Reset()         { *m = InsuranceFundWithdrawal{} }
+func (m *InsuranceFundWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*InsuranceFundWithdrawal) ProtoMessage()    {}
+func (*InsuranceFundWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{9}
+}
+func (m *InsuranceFundWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceFundWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsuranceFundWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintState(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.TraderAddress) > 0 {
-		i -= len(m.TraderAddress)
-		copy(dAtA[i:], m.TraderAddress)
-		i = encodeVarintState(dAtA, i, uint64(len(m.TraderAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *InsuranceFundWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceFundWithdrawal.Merge(m, src)
+}
+func (m *InsuranceFundWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceFundWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceFundWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsuranceFundWithdrawal proto.InternalMessageInfo
+
+func (m *InsuranceFundWithdrawal) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *PairMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *InsuranceFundWithdrawal) GetShares() types.Coin {
+	if m != nil {
+		return m.Shares
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *PairMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *InsuranceFundWithdrawal) GetUnlockTime() time.Time {
+	if m != nil {
+		return m.UnlockTime
+	}
+	return time.Time{}
 }
 
-func (m *PairMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CumulativePremiumFractions) > 0 {
-		for iNdEx := len(m.CumulativePremiumFractions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.CumulativePremiumFractions[iNdEx].Size()
-				i -= size
-				if _, err := m.CumulativePremiumFractions[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintState(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+type PrepaidBadDebt struct {
+	Denom  string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *PrepaidBadDebt) Reset()         { *m = PrepaidBadDebt{} }
+func (m *PrepaidBadDebt) String() string { return proto.CompactTextString(m) }
+func (*PrepaidBadDebt) ProtoMessage()    {}
+func (*PrepaidBadDebt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{10}
+}
+func (m *PrepaidBadDebt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrepaidBadDebt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrepaidBadDebt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	{
-		size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *PrepaidBadDebt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrepaidBadDebt.Merge(m, src)
+}
+func (m *PrepaidBadDebt) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrepaidBadDebt) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrepaidBadDebt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrepaidBadDebt proto.InternalMessageInfo
+
+func (m *PrepaidBadDebt) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type PositionResp struct {
+	Position *Position `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	// The amount of quote assets exchanged.
+	ExchangedNotionalValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchanged_notional_value,json=exchangedNotionalValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_notional_value"`
+	// The amount of base assets exchanged.
+	ExchangedPositionSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=exchanged_position_size,json=exchangedPositionSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_position_size"`
+	// The amount of bad debt accrued during this position change.
+	// Measured in absolute value of quote units.
+	// If greater than zero, then the position change event will likely fail.
+	BadDebt github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=bad_debt,json=badDebt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bad_debt"`
+	// The funding payment applied on this position change.
+	FundingPayment github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=funding_payment,json=fundingPayment,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_payment"`
+	// The amount of PnL realized on this position changed, measured in quote
+	// units.
+	RealizedPnl github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=realized_pnl,json=realizedPnl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"realized_pnl"`
+	// The unrealized PnL in the position after the position change.
+	UnrealizedPnlAfter github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=unrealized_pnl_after,json=unrealizedPnlAfter,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"unrealized_pnl_after"`
+	// The amount of margin the trader has to give to the vault.
+	// A negative value means the vault pays the trader.
+	MarginToVault github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=margin_to_vault,json=marginToVault,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"margin_to_vault"`
+	// The position's notional value after the position change, measured in quote
+	// units.
+	PositionNotional github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=position_notional,json=positionNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"position_notional"`
+}
+
+func (m *PositionResp) Reset()         { *m = PositionResp{} }
+func (m *PositionResp) String() string { return proto.CompactTextString(m) }
+func (*PositionResp) ProtoMessage()    {}
+func (*PositionResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{11}
+}
+func (m *PositionResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintState(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *PositionResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionResp.Merge(m, src)
+}
+func (m *PositionResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionResp.DiscardUnknown(m)
 }
 
-func (m *PrepaidBadDebt) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PositionResp proto.InternalMessageInfo
+
+func (m *PositionResp) GetPosition() *Position {
+	if m != nil {
+		return m.Position
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PrepaidBadDebt) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type LiquidateResp struct {
+	// Amount of bad debt created by the liquidation event
+	BadDebt github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=bad_debt,json=badDebt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bad_debt"`
+	// Fee paid to the liquidator
+	FeeToLiquidator github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=fee_to_liquidator,json=feeToLiquidator,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fee_to_liquidator"`
+	// Fee paid to the Perp EF fund
+	FeeToPerpEcosystemFund github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=fee_to_perp_ecosystem_fund,json=feeToPerpEcosystemFund,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fee_to_perp_ecosystem_fund"`
+	// Address of the liquidator
+	Liquidator string `protobuf:"bytes,4,opt,name=liquidator,proto3" json:"liquidator,omitempty"`
+	// Position response from the close or open reverse position
+	PositionResp *PositionResp `protobuf:"bytes,5,opt,name=position_resp,json=positionResp,proto3" json:"position_resp,omitempty"`
 }
 
-func (m *PrepaidBadDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *LiquidateResp) Reset()         { *m = LiquidateResp{} }
+func (m *LiquidateResp) String() string { return proto.CompactTextString(m) }
+func (*LiquidateResp) ProtoMessage()    {}
+func (*LiquidateResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{12}
+}
+func (m *LiquidateResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidateResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidateResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *LiquidateResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidateResp.Merge(m, src)
+}
+func (m *LiquidateResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidateResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidateResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidateResp proto.InternalMessageInfo
+
+func (m *LiquidateResp) GetLiquidator() string {
+	if m != nil {
+		return m.Liquidator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *PositionResp) Marshal() (dAtA []byte, err error) {
+func (m *LiquidateResp) GetPositionResp() *PositionResp {
+	if m != nil {
+		return m.PositionResp
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("nibiru.perp.v1.Side", Side_name, Side_value)
+	proto.RegisterEnum("nibiru.perp.v1.OrderType", OrderType_name, OrderType_value)
+	proto.RegisterEnum("nibiru.perp.v1.MarginMode", MarginMode_name, MarginMode_value)
+	proto.RegisterEnum("nibiru.perp.v1.PnLCalcOption", PnLCalcOption_name, PnLCalcOption_value)
+	proto.RegisterEnum("nibiru.perp.v1.PnLPreferenceOption", PnLPreferenceOption_name, PnLPreferenceOption_value)
+	proto.RegisterEnum("nibiru.perp.v1.MarginCalculationPriceOption", MarginCalculationPriceOption_name, MarginCalculationPriceOption_value)
+	proto.RegisterType((*Params)(nil), "nibiru.perp.v1.Params")
+	proto.RegisterType((*FeeTier)(nil), "nibiru.perp.v1.FeeTier")
+	proto.RegisterType((*Position)(nil), "nibiru.perp.v1.Position")
+	proto.RegisterType((*Subaccount)(nil), "nibiru.perp.v1.Subaccount")
+	proto.RegisterType((*Order)(nil), "nibiru.perp.v1.Order")
+	proto.RegisterType((*PairMetadata)(nil), "nibiru.perp.v1.PairMetadata")
+	proto.RegisterType((*CumulativePremiumFraction)(nil), "nibiru.perp.v1.CumulativePremiumFraction")
+	proto.RegisterType((*OpenInterest)(nil), "nibiru.perp.v1.OpenInterest")
+	proto.RegisterType((*TradingResume)(nil), "nibiru.perp.v1.TradingResume")
+	proto.RegisterType((*InsuranceFundWithdrawal)(nil), "nibiru.perp.v1.InsuranceFundWithdrawal")
+	proto.RegisterType((*PrepaidBadDebt)(nil), "nibiru.perp.v1.PrepaidBadDebt")
+	proto.RegisterType((*PositionResp)(nil), "nibiru.perp.v1.PositionResp")
+	proto.RegisterType((*LiquidateResp)(nil), "nibiru.perp.v1.LiquidateResp")
+}
+
+func init() { proto.RegisterFile("perp/v1/state.proto", fileDescriptor_0416b6ef16ef80be) }
+
+var fileDescriptor_0416b6ef16ef80be = []byte{
+	// 2162 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xf7, 0x92, 0xfa, 0x41, 0x3e, 0x4a, 0x32, 0x33, 0x92, 0xa5, 0x95, 0xec, 0xaf, 0xa4, 0x2f,
+	0x81, 0x14, 0x8a, 0x9a, 0x92, 0xb5, 0x5a, 0x20, 0x41, 0x50, 0xa0, 0x20, 0x29, 0x2a, 0x61, 0x4b,
+	0x91, 0xdb, 0x25, 0x63, 0x3b, 0x49, 0x81, 0xed, 0x90, 0x3b, 0x22, 0x27, 0xde, 0xdd, 0x59, 0xcf,
+	0x0e, 0x25, 0x2b, 0xbd, 0xf4, 0xd2, 0x7b, 0x4e, 0x45, 0x4f, 0x39, 0xf5, 0x52, 0xf4, 0xde, 0xfe,
+	0x0b, 0x39, 0xb4, 0x40, 0x8e, 0x45, 0x0f, 0x6e, 0x61, 0x03, 0x3d, 0xf4, 0x54, 0xf4, 0x2f, 0x28,
+	0x66, 0x76, 0x76, 0x45, 0xc9, 0xb2, 0x0d, 0x6d, 0xdb, 0x93, 0x76, 0x7e, 0xbc, 0xcf, 0x7b, 0xf3,
+	0xe6, 0xf3, 0x7e, 0x0c, 0x05, 0xab, 0x21, 0xe1, 0x61, 0xed, 0xf4, 0x7e, 0x2d, 0x12, 0x58, 0x90,
+	0x6a, 0xc8, 0x99, 0x60, 0x68, 0x25, 0xa0, 0x43, 0xca, 0xa7, 0x55, 0xb9, 0x56, 0x3d, 0xbd, 0xbf,
+	0xb5, 0x36, 0x66, 0x63, 0xa6, 0x96, 0x6a, 0xf2, 0x2b, 0xde, 0xb5, 0xb5, 0x3d, 0x62, 0x91, 0xcf,
+	0xa2, 0xda, 0x10, 0x47, 0xa4, 0x76, 0x7a, 0x7f, 0x48, 0x04, 0xbe, 0x5f, 0x1b, 0x31, 0x1a, 0xe8,
+	0xf5, 0xcd, 0x78, 0xdd, 0x89, 0x05, 0xe3, 0x41, 0x22, 0x3a, 0x66, 0x6c, 0xec, 0x91, 0x9a, 0x1a,
+	0x0d, 0xa7, 0x27, 0x35, 0x77, 0xca, 0xb1, 0xa0, 0x2c, 0x11, 0xdd, 0xb9, 0xba, 0x2e, 0xa8, 0x4f,
+	0x22, 0x81, 0xfd, 0x50, 0x6f, 0x58, 0x1d, 0x31, 0xdf, 0x67, 0x41, 0x2d, 0xfe, 0x13, 0x4f, 0x56,
+	0xfe, 0xb9, 0x02, 0x0b, 0x16, 0xe6, 0xd8, 0x8f, 0x90, 0x09, 0x8b, 0x91, 0x60, 0x61, 0x48, 0x5c,
+	0xd3, 0xd8, 0x35, 0xf6, 0x0a, 0x76, 0x32, 0x44, 0x9f, 0x01, 0x3a, 0x21, 0xc4, 0x09, 0x19, 0xf3,
+	0x1c, 0xf9, 0xa1, 0xf4, 0x9a, 0xf9, 0x5d, 0x63, 0xaf, 0xd8, 0xa8, 0x7e, 0xfd, 0x6c, 0xe7, 0xd6,
+	0x5f, 0x9e, 0xed, 0x7c, 0x6b, 0x4c, 0xc5, 0x64, 0x3a, 0xac, 0x8e, 0x98, 0xaf, 0xed, 0xd6, 0x7f,
+	0xbe, 0x13, 0xb9, 0x8f, 0x6b, 0xe2, 0x3c, 0x24, 0x51, 0xf5, 0x90, 0x8c, 0xec, 0xdb, 0x27, 0x84,
+	0x58, 0x8c, 0x79, 0x47, 0x84, 0xd8, 0x12, 0x06, 0x8d, 0xc1, 0x24, 0x23, 0x16, 0x9d, 0x47, 0x82,
+	0xf8, 0xce, 0xc9, 0x34, 0x70, 0x67, 0x54, 0xcc, 0x65, 0x52, 0x71, 0x27, 0xc5, 0x3b, 0x9a, 0x06,
+	0x6e, 0xaa, 0x68, 0x08, 0x77, 0x3c, 0xfa, 0x64, 0x4a, 0x5d, 0x39, 0x0a, 0x66, 0xb4, 0xcc, 0x67,
+	0xd2, 0xb2, 0x3a, 0x03, 0x96, 0xea, 0xf8, 0x1c, 0x36, 0x43, 0xcc, 0x05, 0xc5, 0x9e, 0x33, 0xab,
+	0x2b, 0xd6, 0xb3, 0x90, 0x49, 0xcf, 0x86, 0x06, 0xec, 0x5c, 0xe0, 0xc5, 0xba, 0x0e, 0xe0, 0x8e,
+	0x74, 0x17, 0x0d, 0xc6, 0x12, 0x9f, 0x38, 0x34, 0x10, 0x84, 0x9f, 0x62, 0xcf, 0x5c, 0x94, 0x7a,
+	0xec, 0x55, 0xbd, 0x68, 0x63, 0x41, 0xda, 0x7a, 0x09, 0xfd, 0xca, 0x80, 0x35, 0x71, 0x86, 0x43,
+	0xc7, 0x63, 0xec, 0xf1, 0x10, 0x8f, 0x1e, 0x3b, 0x67, 0x34, 0x70, 0xd9, 0x99, 0x59, 0xd8, 0x35,
+	0xf6, 0x4a, 0x07, 0x9b, 0xd5, 0x98, 0x44, 0xd5, 0x84, 0x44, 0xd5, 0x43, 0x4d, 0xb2, 0x46, 0x5b,
+	0x9a, 0xfd, 0x8f, 0x67, 0x3b, 0xdb, 0xd7, 0x89, 0xbf, 0xcb, 0x7c, 0x2a, 0x88, 0x1f, 0x8a, 0xf3,
+	0x7f, 0x3d, 0xdb, 0xb9, 0x7b, 0x8e, 0x7d, 0xef, 0x83, 0xca, 0x75, 0xfb, 0x2a, 0xbf, 0xfe, 0xeb,
+	0x8e, 0x61, 0x23, 0xb9, 0xd4, 0xd1, 0x2b, 0x0f, 0xd5, 0x02, 0x7a, 0x0f, 0x36, 0xce, 0x26, 0x54,
+	0x10, 0x8f, 0x46, 0x82, 0xb8, 0xa9, 0xf3, 0x18, 0x8f, 0xcc, 0xe2, 0x6e, 0x7e, 0xaf, 0x68, 0xaf,
+	0xcf, 0x2c, 0x77, 0x2e, 0x56, 0x25, 0x7d, 0x68, 0x10, 0x4d, 0x39, 0x0e, 0x46, 0xe4, 0x82, 0x3e,
+	0xd1, 0x04, 0x73, 0x62, 0x42, 0x36, 0xfa, 0xa4, 0x78, 0x9a, 0x3e, 0x7d, 0x09, 0x86, 0xfe, 0x64,
+	0x40, 0xe5, 0x8a, 0xa6, 0x33, 0x2a, 0x26, 0x2e, 0xc7, 0x67, 0xd8, 0x73, 0x46, 0x8c, 0x79, 0x2e,
+	0x3b, 0x0b, 0xcc, 0xd2, 0x9b, 0x1c, 0x49, 0xb4, 0x23, 0xdf, 0x7d, 0x33, 0xd8, 0x25, 0xb7, 0xbe,
+	0x13, 0xbb, 0xf5, 0xcd, 0x52, 0xb1, 0x93, 0x77, 0x2e, 0x9d, 0xe2, 0x61, 0xba, 0xad, 0xa9, 0x77,
+	0xa1, 0x2d, 0x28, 0x8c, 0xa7, 0x98, 0xbb, 0x14, 0x07, 0xe6, 0x92, 0x62, 0x4c, 0x3a, 0x46, 0xff,
+	0x0f, 0x4b, 0x13, 0xec, 0xc9, 0x8b, 0x08, 0x31, 0xe5, 0x91, 0xb9, 0xac, 0xae, 0xa0, 0x14, 0xcf,
+	0x59, 0x72, 0x0a, 0xfd, 0x0c, 0xd6, 0x46, 0x94, 0x8f, 0xa6, 0x54, 0x38, 0x43, 0x4e, 0xf0, 0x63,
+	0xc2, 0x9d, 0x21, 0x0e, 0x5c, 0x73, 0x25, 0x93, 0xcf, 0x91, 0xc6, 0x6a, 0xc4, 0x50, 0x0d, 0x1c,
+	0xb8, 0xe8, 0x2b, 0x03, 0xd6, 0xaf, 0xaa, 0xd0, 0x6c, 0xbd, 0xfd, 0x26, 0x27, 0x1f, 0x6b, 0x27,
+	0xef, 0x5e, 0x0f, 0x70, 0xc9, 0xb1, 0xff, 0x17, 0x3b, 0xf6, 0xfa, 0x9d, 0xb1, 0x33, 0xd7, 0x2e,
+	0x9b, 0xa7, 0x39, 0xfb, 0x43, 0xb8, 0x1b, 0x12, 0xee, 0xd3, 0x28, 0xa2, 0x2c, 0xf0, 0x48, 0x14,
+	0xcd, 0xc6, 0x7c, 0x64, 0x96, 0x55, 0x12, 0xdd, 0xba, 0xbc, 0x65, 0x26, 0x8a, 0x23, 0xf4, 0x03,
+	0xd8, 0xba, 0x20, 0xba, 0x13, 0x72, 0xca, 0x38, 0x15, 0xe7, 0xce, 0xd0, 0x63, 0xa3, 0xc7, 0x91,
+	0xf9, 0xd6, 0xae, 0xb1, 0x37, 0x67, 0x9b, 0x17, 0x3b, 0x2c, 0xbd, 0xa1, 0xa1, 0xd6, 0xd1, 0x23,
+	0x78, 0xc7, 0xc7, 0x4f, 0x9d, 0xd7, 0x98, 0x20, 0xd7, 0x62, 0x34, 0x13, 0x29, 0xb0, 0xb7, 0x7d,
+	0xfc, 0xd4, 0x7a, 0xa5, 0x3d, 0x16, 0xe1, 0x0a, 0x1a, 0xf9, 0x70, 0x57, 0x22, 0xcf, 0xd8, 0xc6,
+	0xc9, 0x19, 0xe6, 0xae, 0xce, 0x63, 0xab, 0x99, 0xae, 0xd8, 0xf4, 0xf1, 0xd3, 0x8b, 0xc0, 0xb5,
+	0x15, 0x60, 0x9c, 0xc8, 0x3e, 0x80, 0xa2, 0x8c, 0x59, 0x41, 0x09, 0x8f, 0xcc, 0xb5, 0xdd, 0xfc,
+	0x5e, 0xe9, 0x60, 0xa3, 0x7a, 0xb9, 0x9c, 0x56, 0x8f, 0x08, 0x19, 0x50, 0xc2, 0x1b, 0x73, 0x52,
+	0xab, 0x5d, 0x38, 0x89, 0x87, 0x11, 0xfa, 0x29, 0x20, 0x5f, 0x5d, 0x17, 0x27, 0x43, 0x99, 0x04,
+	0x63, 0x0b, 0xef, 0x64, 0xb2, 0xb0, 0xac, 0x90, 0x6c, 0x05, 0x14, 0x5b, 0xd6, 0x87, 0x65, 0xc6,
+	0x5d, 0xc2, 0x1d, 0x97, 0x84, 0x2c, 0xa2, 0xc2, 0x5c, 0xbf, 0x31, 0x70, 0x3b, 0x10, 0xf6, 0x92,
+	0x02, 0x39, 0x8c, 0x31, 0x2a, 0x5f, 0xe5, 0x60, 0x51, 0x1f, 0x07, 0x1d, 0x03, 0xf8, 0x34, 0x70,
+	0x4e, 0x99, 0x37, 0xf5, 0x89, 0x69, 0xdc, 0x18, 0x5d, 0x9a, 0x5d, 0xf4, 0x69, 0xf0, 0x40, 0x01,
+	0xbc, 0xa2, 0x50, 0xe7, 0xfe, 0xf7, 0x85, 0x3a, 0xff, 0x5f, 0x2c, 0xd4, 0x95, 0xbf, 0xe7, 0xa1,
+	0x60, 0x49, 0x57, 0x51, 0x16, 0xa0, 0xb7, 0x61, 0x45, 0x70, 0x2c, 0xef, 0x00, 0xbb, 0x2e, 0x27,
+	0x51, 0x14, 0x7b, 0xc9, 0x5e, 0x8e, 0x67, 0xeb, 0xf1, 0x24, 0x3a, 0x80, 0x39, 0x99, 0xaa, 0xd4,
+	0x59, 0x4b, 0x07, 0x66, 0x42, 0x1f, 0xdd, 0xeb, 0xd4, 0xa3, 0x88, 0x08, 0x99, 0xb7, 0x34, 0x7f,
+	0xd4, 0x5e, 0xd4, 0x80, 0xb9, 0x88, 0x7e, 0x41, 0x32, 0x1a, 0xaf, 0x64, 0xd1, 0x11, 0x2c, 0xf8,
+	0x98, 0x8f, 0x69, 0x90, 0xb1, 0x57, 0xd1, 0xd2, 0x8a, 0x69, 0x21, 0x09, 0x9c, 0x80, 0xc9, 0x53,
+	0x63, 0x2f, 0x63, 0x53, 0xb2, 0x24, 0x41, 0xba, 0x1a, 0x03, 0xfd, 0x1c, 0x2a, 0x1e, 0x16, 0x24,
+	0x12, 0xce, 0x68, 0xea, 0x4f, 0x3d, 0x2c, 0xe8, 0x29, 0x71, 0x42, 0x4e, 0x7c, 0x3a, 0xf5, 0x9d,
+	0x13, 0x8e, 0x47, 0x72, 0x5f, 0xc6, 0xb6, 0x64, 0x27, 0x46, 0x6e, 0xa6, 0xc0, 0x56, 0x8c, 0x7b,
+	0xa4, 0x61, 0x65, 0x0d, 0x51, 0xa9, 0xc7, 0x09, 0xa6, 0xfe, 0x90, 0x70, 0xd5, 0x95, 0xe4, 0xed,
+	0x92, 0x9a, 0xeb, 0xaa, 0xa9, 0x4a, 0x07, 0xa0, 0x3f, 0x1d, 0xe2, 0xd1, 0x88, 0x4d, 0x03, 0x81,
+	0xd6, 0x60, 0x9e, 0x9d, 0x05, 0x84, 0xeb, 0x0b, 0x8e, 0x07, 0x68, 0x05, 0x72, 0xd4, 0x55, 0xd7,
+	0x3a, 0x67, 0xe7, 0xa8, 0x2b, 0xbb, 0xd4, 0x84, 0x08, 0xea, 0xde, 0xec, 0x64, 0x58, 0xf9, 0xed,
+	0x3c, 0xcc, 0xf7, 0xb8, 0x9b, 0xca, 0x18, 0xa9, 0xcc, 0xcb, 0x1c, 0xca, 0xbd, 0x8e, 0x43, 0xf9,
+	0x1b, 0x70, 0xe8, 0x7d, 0x80, 0x38, 0x43, 0x48, 0xcf, 0x28, 0x0e, 0xac, 0x1c, 0x6c, 0x5e, 0x4d,
+	0x5e, 0xca, 0xaa, 0xc1, 0x79, 0x48, 0xec, 0x22, 0x4b, 0x3e, 0xd1, 0x9e, 0x64, 0x9f, 0x4b, 0xd4,
+	0x45, 0xaf, 0x1c, 0xac, 0x5d, 0x95, 0xe9, 0x53, 0x97, 0xd8, 0x6a, 0x87, 0xe4, 0x86, 0xe0, 0x74,
+	0x3c, 0x26, 0xaa, 0x46, 0x8c, 0x48, 0xc6, 0x1b, 0x5b, 0xd2, 0x20, 0x96, 0xc4, 0x90, 0x89, 0xf3,
+	0xc9, 0x94, 0x09, 0xe2, 0x60, 0x79, 0x2e, 0x07, 0xfb, 0xf2, 0x0e, 0xcc, 0xc5, 0x1b, 0x23, 0xcb,
+	0xfc, 0x56, 0x56, 0x48, 0xca, 0x41, 0x75, 0x85, 0x83, 0x7e, 0x04, 0x05, 0x8f, 0x9c, 0x12, 0x8e,
+	0xc7, 0xc4, 0x2c, 0xdc, 0x18, 0x53, 0x5a, 0x9b, 0xca, 0x23, 0x02, 0x1b, 0xf2, 0xb9, 0x74, 0xc9,
+	0x50, 0xc7, 0xa3, 0x3e, 0x15, 0x66, 0x31, 0x13, 0xf4, 0x9a, 0x84, 0x9b, 0xb1, 0xb6, 0x23, 0xb1,
+	0x5e, 0xe2, 0x2b, 0xbc, 0xc4, 0x57, 0xf4, 0x11, 0x2c, 0x26, 0x85, 0xa0, 0x94, 0xc9, 0x51, 0x89,
+	0x78, 0xe5, 0xf7, 0x06, 0x2c, 0x49, 0x2e, 0x1d, 0x13, 0x81, 0x5d, 0x2c, 0x70, 0xca, 0x3d, 0xe3,
+	0x06, 0xdc, 0xe3, 0x70, 0xef, 0x35, 0x71, 0x2d, 0x49, 0x9e, 0xdf, 0x2b, 0x36, 0xbe, 0x7b, 0x33,
+	0xef, 0x98, 0x86, 0xbd, 0x35, 0x7a, 0x55, 0x50, 0x47, 0x95, 0xdf, 0x19, 0xb0, 0xf9, 0xea, 0x98,
+	0xcf, 0x72, 0x8a, 0x35, 0x98, 0x27, 0x21, 0x1b, 0x4d, 0x74, 0x8c, 0xc7, 0x03, 0x74, 0x08, 0xf3,
+	0xa7, 0xd8, 0x9b, 0x66, 0x4d, 0xce, 0xb1, 0x70, 0xe5, 0x8f, 0x06, 0x2c, 0xf5, 0x42, 0x12, 0xa8,
+	0xf7, 0x0f, 0x89, 0x44, 0x26, 0x03, 0x1b, 0x30, 0xe7, 0xb1, 0x60, 0x9c, 0xb1, 0x8c, 0x2a, 0x59,
+	0x79, 0x9c, 0x68, 0xc2, 0xb8, 0xc8, 0x7a, 0x1c, 0x25, 0x5c, 0xf9, 0x85, 0x01, 0xcb, 0x03, 0x8e,
+	0xd5, 0xab, 0x8e, 0x44, 0xb2, 0xe0, 0xa3, 0x99, 0xf3, 0x14, 0xb5, 0xbd, 0x29, 0x91, 0x27, 0x84,
+	0x8e, 0x27, 0xc2, 0xcc, 0xcd, 0x10, 0xf9, 0x23, 0x35, 0x85, 0xde, 0x87, 0x39, 0x41, 0x7d, 0xa2,
+	0x33, 0xdd, 0xd6, 0x4b, 0x7d, 0xf4, 0x20, 0xf9, 0xe9, 0xa0, 0x51, 0x90, 0x96, 0x7e, 0x29, 0x7b,
+	0x60, 0x25, 0x51, 0xf9, 0x83, 0x01, 0x1b, 0xed, 0xeb, 0x5f, 0x16, 0xe8, 0x1e, 0x14, 0x35, 0xbf,
+	0x59, 0x62, 0xd1, 0xc5, 0x04, 0x7a, 0x0f, 0x16, 0xd4, 0xab, 0x2c, 0xd2, 0x35, 0x7a, 0xb3, 0x1a,
+	0x1f, 0xb5, 0x2a, 0xa3, 0xb1, 0xaa, 0x7f, 0x0b, 0xa9, 0x36, 0x19, 0x0d, 0xb4, 0xf7, 0xf5, 0x76,
+	0xd4, 0x82, 0xd2, 0x34, 0x50, 0x07, 0xba, 0xb1, 0xcd, 0x10, 0x0b, 0xca, 0xa5, 0x4a, 0x00, 0x2b,
+	0x16, 0x27, 0x21, 0xa6, 0x6e, 0x03, 0xbb, 0x87, 0x64, 0xa8, 0x0a, 0x8e, 0x4b, 0x02, 0xe6, 0x27,
+	0x05, 0x47, 0x0d, 0x64, 0x45, 0xd7, 0xc9, 0x30, 0x97, 0x29, 0xc6, 0xb5, 0x74, 0xe5, 0x37, 0x0b,
+	0xb0, 0x94, 0x74, 0x31, 0x36, 0x89, 0x42, 0xf4, 0x7d, 0x28, 0x84, 0x7a, 0x7c, 0x95, 0x7f, 0x49,
+	0xd2, 0x4f, 0xf7, 0xa7, 0x3b, 0xd1, 0x04, 0x4c, 0xf2, 0x74, 0x34, 0xc1, 0xc1, 0x98, 0xb8, 0x69,
+	0x77, 0xe0, 0xc4, 0xb1, 0x91, 0x8d, 0x91, 0xeb, 0x29, 0x5e, 0xd2, 0x28, 0x3c, 0x90, 0x68, 0xe8,
+	0x04, 0x36, 0x2e, 0x34, 0x25, 0xfa, 0x9d, 0xff, 0xa0, 0x43, 0xba, 0x93, 0xc2, 0x25, 0xe7, 0xea,
+	0xcb, 0x96, 0xa9, 0x0d, 0x85, 0x21, 0x76, 0x1d, 0x97, 0x0c, 0x45, 0xc6, 0xa6, 0x69, 0x71, 0xa8,
+	0x6f, 0xf0, 0x21, 0xdc, 0x4e, 0x7e, 0x02, 0x09, 0xf1, 0xb9, 0x4f, 0x02, 0x91, 0xb1, 0x6f, 0x5a,
+	0xd1, 0x30, 0x56, 0x8c, 0x82, 0x7e, 0x02, 0x4b, 0x9c, 0x60, 0x8f, 0x7e, 0x21, 0x5d, 0x11, 0x78,
+	0x19, 0x2b, 0x6e, 0x29, 0xc1, 0xb0, 0x02, 0x4f, 0x3e, 0x98, 0xa7, 0xc1, 0x2c, 0xa8, 0x83, 0x4f,
+	0x84, 0xee, 0x8b, 0x32, 0x3c, 0x98, 0x2f, 0xb0, 0xac, 0xc0, 0xab, 0x4b, 0x24, 0xf4, 0x00, 0x6e,
+	0xc7, 0xdd, 0xa4, 0x23, 0x98, 0x73, 0x8a, 0xa7, 0x9e, 0xc8, 0x58, 0x7b, 0x97, 0x63, 0x98, 0x01,
+	0x7b, 0x20, 0x41, 0xd0, 0x67, 0xf0, 0x56, 0x4a, 0x87, 0xb4, 0x3f, 0xcd, 0x56, 0x7a, 0xcb, 0x09,
+	0x50, 0x42, 0xbd, 0xca, 0x2f, 0xf3, 0xb0, 0x9c, 0x3c, 0x0b, 0x89, 0x8a, 0x93, 0x59, 0x7e, 0x18,
+	0xd9, 0xca, 0x6c, 0xc2, 0x8f, 0x4f, 0xe1, 0x2d, 0xf5, 0xb2, 0x64, 0x33, 0x6f, 0xd9, 0x8c, 0x61,
+	0x2d, 0x9f, 0x43, 0x03, 0x76, 0xf1, 0x80, 0x45, 0x9f, 0xc3, 0x96, 0xc6, 0x96, 0xd1, 0xeb, 0x5c,
+	0x7e, 0x1a, 0x99, 0xf9, 0x4c, 0x4a, 0xd6, 0x95, 0x12, 0x8b, 0xf0, 0xb0, 0x35, 0xfb, 0x32, 0x42,
+	0xdb, 0x00, 0x33, 0x07, 0x50, 0x41, 0x63, 0xcf, 0xcc, 0xa0, 0x3a, 0x2c, 0xa7, 0x37, 0xc4, 0x49,
+	0x14, 0xaa, 0x28, 0x28, 0x1d, 0xdc, 0x7b, 0x65, 0x7e, 0x21, 0x51, 0x68, 0x2f, 0x85, 0x33, 0xa3,
+	0xfd, 0x1a, 0xcc, 0xc9, 0x96, 0x13, 0xad, 0x41, 0xb9, 0xdf, 0x3e, 0x6c, 0x39, 0x1f, 0x77, 0xfb,
+	0x56, 0xab, 0xd9, 0x3e, 0x6a, 0xb7, 0x0e, 0xcb, 0xb7, 0xd0, 0x22, 0xe4, 0x1b, 0x1f, 0x7f, 0x52,
+	0x36, 0x50, 0x01, 0xe6, 0xfa, 0xad, 0x4e, 0xa7, 0x9c, 0xdb, 0xb7, 0xa1, 0x98, 0xf6, 0xb5, 0x68,
+	0x0b, 0xd6, 0x7b, 0xf6, 0x61, 0xcb, 0x76, 0x06, 0x9f, 0x58, 0x57, 0x65, 0x8b, 0x30, 0xdf, 0x69,
+	0x1f, 0xb7, 0x07, 0x65, 0x03, 0x2d, 0x43, 0xb1, 0x3f, 0xe8, 0x59, 0x4e, 0xa7, 0xd7, 0xef, 0x97,
+	0x73, 0xe8, 0x36, 0x94, 0x06, 0xf5, 0x1f, 0xb7, 0x1c, 0xcb, 0xee, 0x1d, 0xb5, 0x07, 0xe5, 0xfc,
+	0x7e, 0x03, 0xe0, 0x58, 0x51, 0xef, 0x98, 0xb9, 0x04, 0xdd, 0x85, 0x8d, 0xe3, 0xba, 0xfd, 0x61,
+	0xbb, 0xeb, 0x1c, 0xf7, 0x5e, 0xb2, 0x68, 0x09, 0x0a, 0xed, 0x7e, 0xaf, 0x53, 0x1f, 0xb4, 0x0e,
+	0xcb, 0x86, 0xd4, 0xd1, 0xb4, 0x15, 0xe8, 0xfe, 0x03, 0x58, 0xb6, 0x82, 0x4e, 0x13, 0x7b, 0xa3,
+	0x5e, 0xa8, 0x32, 0xe8, 0x0e, 0xdc, 0xb5, 0xba, 0x1d, 0xa7, 0x59, 0xef, 0x34, 0x9d, 0x9e, 0x35,
+	0x68, 0xf7, 0xba, 0x57, 0xa0, 0x56, 0x00, 0xfa, 0x56, 0x6f, 0xe0, 0x58, 0x76, 0xbb, 0xd9, 0x8a,
+	0xcf, 0x38, 0x78, 0x58, 0xb7, 0xca, 0x39, 0x04, 0xb0, 0xd0, 0xb3, 0xeb, 0xcd, 0x4e, 0xab, 0x9c,
+	0xdf, 0xff, 0x10, 0x56, 0xad, 0xa0, 0x63, 0x71, 0x72, 0x42, 0x38, 0x09, 0x46, 0x44, 0xa3, 0x6f,
+	0xc3, 0x96, 0x44, 0xb7, 0xec, 0xd6, 0x51, 0xcb, 0x6e, 0x75, 0x9b, 0xd7, 0x78, 0xee, 0xb8, 0xfe,
+	0xa8, 0x6c, 0xa8, 0x8f, 0x76, 0xb7, 0x9c, 0xdb, 0x7f, 0x02, 0xf7, 0xe2, 0x43, 0x4a, 0x1b, 0x55,
+	0x23, 0xc5, 0x02, 0xd5, 0x93, 0x6b, 0xc4, 0x1a, 0x7c, 0x5b, 0x1f, 0x5b, 0x9a, 0xfc, 0x71, 0xa7,
+	0xae, 0x4c, 0x56, 0xc6, 0x5d, 0x6f, 0xbf, 0xbc, 0x13, 0xab, 0x37, 0x88, 0xdd, 0xd0, 0xee, 0x1e,
+	0xb6, 0x1e, 0x95, 0x73, 0xa8, 0x04, 0x8b, 0xc7, 0xf5, 0x47, 0x8e, 0xd5, 0xed, 0x94, 0xf3, 0x8d,
+	0xc3, 0xaf, 0x9f, 0x6f, 0x1b, 0xdf, 0x3c, 0xdf, 0x36, 0xfe, 0xf6, 0x7c, 0xdb, 0xf8, 0xf2, 0xc5,
+	0xf6, 0xad, 0x6f, 0x5e, 0x6c, 0xdf, 0xfa, 0xf3, 0x8b, 0xed, 0x5b, 0x9f, 0xee, 0xcf, 0x30, 0xb3,
+	0xab, 0xc8, 0xd2, 0x9c, 0x60, 0x1a, 0xd4, 0x62, 0xe2, 0xd4, 0x9e, 0xd6, 0xd4, 0xff, 0x3a, 0x14,
+	0x43, 0x87, 0x0b, 0xaa, 0xd6, 0x7e, 0xef, 0xdf, 0x03, 0x00, 0xe2, 0xae, 0xfd, 0xa9, 0x00, 0x19,
+	0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -905,112 +1322,224 @@ func (m *PositionResp) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PositionResp) MarshalTo(dAtA []byte) (int, error) {
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PositionResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.PositionNotional.Size()
+		size := m.OrderDeposit.Size()
 		i -= size
-		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.OrderDeposit.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintState(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x4a
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
 	{
-		size := m.MarginToVault.Size()
+		size := m.MakerRebateRatio.Size()
 		i -= size
-		if _, err := m.MarginToVault.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MakerRebateRatio.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintState(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x42
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if len(m.FeeTiers) > 0 {
+		for iNdEx := len(m.FeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
 	{
-		size := m.UnrealizedPnlAfter.Size()
+		size := m.MaxLiquidatorRewardRatio.Size()
 		i -= size
-		if _, err := m.UnrealizedPnlAfter.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxLiquidatorRewardRatio.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintState(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.RealizedPnl.Size()
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if m.MaxPermissionlessLiquidationsPerBlock != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MaxPermissionlessLiquidationsPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.LiquidatorPriorityBlocks != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.LiquidatorPriorityBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.PermissionlessLiquidations {
+		i--
+		if m.PermissionlessLiquidations {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CircuitBreakerWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CircuitBreakerWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintState(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.CircuitBreakerBand.Size()
 		i -= size
-		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.CircuitBreakerBand.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintState(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x32
+	dAtA[i] = 0x72
+	if len(m.HaltedPairs) > 0 {
+		for iNdEx := len(m.HaltedPairs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.HaltedPairs[iNdEx])
+			copy(dAtA[i:], m.HaltedPairs[iNdEx])
+			i = encodeVarintState(dAtA, i, uint64(len(m.HaltedPairs[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x62
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.InsuranceFundWithdrawalCooldown, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.InsuranceFundWithdrawalCooldown):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintState(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x5a
 	{
-		size := m.FundingPayment.Size()
+		size := m.InsuranceFundFeeShare.Size()
 		i -= size
-		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.InsuranceFundFeeShare.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintState(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
+	dAtA[i] = 0x52
+	if len(m.WhitelistedLiquidators) > 0 {
+		for iNdEx := len(m.WhitelistedLiquidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WhitelistedLiquidators[iNdEx])
+			copy(dAtA[i:], m.WhitelistedLiquidators[iNdEx])
+			i = encodeVarintState(dAtA, i, uint64(len(m.WhitelistedLiquidators[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapLookbackWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapLookbackWindow):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintState(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x42
+	if len(m.FundingRateInterval) > 0 {
+		i -= len(m.FundingRateInterval)
+		copy(dAtA[i:], m.FundingRateInterval)
+		i = encodeVarintState(dAtA, i, uint64(len(m.FundingRateInterval)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	{
-		size := m.BadDebt.Size()
+		size := m.PartialLiquidationRatio.Size()
 		i -= size
-		if _, err := m.BadDebt.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.PartialLiquidationRatio.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintState(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
+	dAtA[i] = 0x32
 	{
-		size := m.ExchangedPositionSize.Size()
+		size := m.LiquidationFeeRatio.Size()
 		i -= size
-		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.LiquidationFeeRatio.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintState(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x2a
 	{
-		size := m.ExchangedNotionalValue.Size()
+		size := m.EcosystemFundFeeRatio.Size()
 		i -= size
-		if _, err := m.ExchangedNotionalValue.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.EcosystemFundFeeRatio.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintState(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if m.Position != nil {
-		{
-			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintState(dAtA, i, uint64(size))
+	dAtA[i] = 0x22
+	{
+		size := m.FeePoolFeeRatio.Size()
+		i -= size
+		if _, err := m.FeePoolFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Stopped {
 		i--
-		dAtA[i] = 0xa
+		if m.Stopped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LiquidateResp) Marshal() (dAtA []byte, err error) {
+func (m *FeeTier) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1020,39 +1549,20 @@ func (m *LiquidateResp) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LiquidateResp) MarshalTo(dAtA []byte) (int, error) {
+func (m *FeeTier) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LiquidateResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PositionResp != nil {
-		{
-			size, err := m.PositionResp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintState(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Liquidator) > 0 {
-		i -= len(m.Liquidator)
-		copy(dAtA[i:], m.Liquidator)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Liquidator)))
-		i--
-		dAtA[i] = 0x22
-	}
 	{
-		size := m.FeeToPerpEcosystemFund.Size()
+		size := m.EcosystemFundFeeRatio.Size()
 		i -= size
-		if _, err := m.FeeToPerpEcosystemFund.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.EcosystemFundFeeRatio.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintState(dAtA, i, uint64(size))
@@ -1060,9 +1570,9 @@ func (m *LiquidateResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x1a
 	{
-		size := m.FeeToLiquidator.Size()
+		size := m.FeePoolFeeRatio.Size()
 		i -= size
-		if _, err := m.FeeToLiquidator.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.FeePoolFeeRatio.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintState(dAtA, i, uint64(size))
@@ -1070,9 +1580,9 @@ func (m *LiquidateResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x12
 	{
-		size := m.BadDebt.Size()
+		size := m.MinVolume.Size()
 		i -= size
-		if _, err := m.BadDebt.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MinVolume.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintState(dAtA, i, uint64(size))
@@ -1082,199 +1592,2413 @@ func (m *LiquidateResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintState(dAtA []byte, offset int, v uint64) int {
-	offset -= sovState(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Position) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *Position) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Stopped {
-		n += 2
+	if m.BlockNumber != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = m.FeePoolFeeRatio.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.EcosystemFundFeeRatio.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.LiquidationFeeRatio.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.PartialLiquidationRatio.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = len(m.FundingRateInterval)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	{
+		size := m.LatestCumulativePremiumFraction.Size()
+		i -= size
+		if _, err := m.LatestCumulativePremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapLookbackWindow)
-	n += 1 + l + sovState(uint64(l))
-	if len(m.WhitelistedLiquidators) > 0 {
-		for _, s := range m.WhitelistedLiquidators {
-			l = len(s)
-			n += 1 + l + sovState(uint64(l))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.OpenNotional.Size()
+		i -= size
+		if _, err := m.OpenNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintState(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Margin.Size()
+		i -= size
+		if _, err := m.Margin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Size_.Size()
+		i -= size
+		if _, err := m.Size_.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Position) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Subaccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Subaccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Subaccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TraderAddress)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.Pair.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.Size_.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.Margin.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.OpenNotional.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.LatestCumulativePremiumFraction.Size()
-	n += 1 + l + sovState(uint64(l))
-	if m.BlockNumber != 0 {
-		n += 1 + sovState(uint64(m.BlockNumber))
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PairMetadata) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Order) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Order) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Pair.Size()
-	n += 1 + l + sovState(uint64(l))
-	if len(m.CumulativePremiumFractions) > 0 {
-		for _, e := range m.CumulativePremiumFractions {
-			l = e.Size()
-			n += 1 + l + sovState(uint64(l))
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintState(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *PrepaidBadDebt) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x5a
+	if m.BlockNumber != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x50
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	{
+		size := m.BaseAssetAmountLimit.Size()
+		i -= size
+		if _, err := m.BaseAssetAmountLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovState(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Leverage.Size()
+		i -= size
+		if _, err := m.Leverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.QuoteAssetAmount.Size()
+		i -= size
+		if _, err := m.QuoteAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TriggerPrice.Size()
+		i -= size
+		if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Side != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OrderType != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PositionResp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PairMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PairMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Position != nil {
-		l = m.Position.Size()
-		n += 1 + l + sovState(uint64(l))
+	if len(m.CumulativePremiumFractions) > 0 {
+		for iNdEx := len(m.CumulativePremiumFractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CumulativePremiumFractions[iNdEx].Size()
+				i -= size
+				if _, err := m.CumulativePremiumFractions[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = m.ExchangedNotionalValue.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.ExchangedPositionSize.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.BadDebt.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.FundingPayment.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.RealizedPnl.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.UnrealizedPnlAfter.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.MarginToVault.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.PositionNotional.Size()
-	n += 1 + l + sovState(uint64(l))
-	return n
+	{
+		size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidateResp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CumulativePremiumFraction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CumulativePremiumFraction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CumulativePremiumFraction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.BadDebt.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.FeeToLiquidator.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.FeeToPerpEcosystemFund.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = len(m.Liquidator)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
 	}
-	if m.PositionResp != nil {
-		l = m.PositionResp.Size()
-		n += 1 + l + sovState(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if m.Epoch != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	{
+		size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func sovState(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *OpenInterest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozState(x uint64) (n int) {
-	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *OpenInterest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+
+func (m *OpenInterest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Short.Size()
+		i -= size
+		if _, err := m.Short.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Long.Size()
+		i -= size
+		if _, err := m.Long.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TradingResume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradingResume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradingResume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintState(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1a
+	if m.BlockHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsuranceFundWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceFundWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceFundWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnlockTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintState(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrepaidBadDebt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrepaidBadDebt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrepaidBadDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PositionNotional.Size()
+		i -= size
+		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MarginToVault.Size()
+		i -= size
+		if _, err := m.MarginToVault.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.UnrealizedPnlAfter.Size()
+		i -= size
+		if _, err := m.UnrealizedPnlAfter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.RealizedPnl.Size()
+		i -= size
+		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.FundingPayment.Size()
+		i -= size
+		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BadDebt.Size()
+		i -= size
+		if _, err := m.BadDebt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ExchangedPositionSize.Size()
+		i -= size
+		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ExchangedNotionalValue.Size()
+		i -= size
+		if _, err := m.ExchangedNotionalValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Position != nil {
+		{
+			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidateResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidateResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidateResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionResp != nil {
+		{
+			size, err := m.PositionResp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Liquidator) > 0 {
+		i -= len(m.Liquidator)
+		copy(dAtA[i:], m.Liquidator)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Liquidator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.FeeToPerpEcosystemFund.Size()
+		i -= size
+		if _, err := m.FeeToPerpEcosystemFund.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.FeeToLiquidator.Size()
+		i -= size
+		if _, err := m.FeeToLiquidator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BadDebt.Size()
+		i -= size
+		if _, err := m.BadDebt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintState(dAtA []byte, offset int, v uint64) int {
+	offset -= sovState(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Stopped {
+		n += 2
+	}
+	l = m.FeePoolFeeRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.EcosystemFundFeeRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.LiquidationFeeRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.PartialLiquidationRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = len(m.FundingRateInterval)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapLookbackWindow)
+	n += 1 + l + sovState(uint64(l))
+	if len(m.WhitelistedLiquidators) > 0 {
+		for _, s := range m.WhitelistedLiquidators {
+			l = len(s)
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	l = m.InsuranceFundFeeShare.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.InsuranceFundWithdrawalCooldown)
+	n += 1 + l + sovState(uint64(l))
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if len(m.HaltedPairs) > 0 {
+		for _, s := range m.HaltedPairs {
+			l = len(s)
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	l = m.CircuitBreakerBand.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CircuitBreakerWindow)
+	n += 1 + l + sovState(uint64(l))
+	if m.PermissionlessLiquidations {
+		n += 3
+	}
+	if m.LiquidatorPriorityBlocks != 0 {
+		n += 2 + sovState(uint64(m.LiquidatorPriorityBlocks))
+	}
+	if m.MaxPermissionlessLiquidationsPerBlock != 0 {
+		n += 2 + sovState(uint64(m.MaxPermissionlessLiquidationsPerBlock))
+	}
+	l = m.MaxLiquidatorRewardRatio.Size()
+	n += 2 + l + sovState(uint64(l))
+	if len(m.FeeTiers) > 0 {
+		for _, e := range m.FeeTiers {
+			l = e.Size()
+			n += 2 + l + sovState(uint64(l))
+		}
+	}
+	l = m.MakerRebateRatio.Size()
+	n += 2 + l + sovState(uint64(l))
+	l = m.OrderDeposit.Size()
+	n += 2 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *FeeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinVolume.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.FeePoolFeeRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.EcosystemFundFeeRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *Position) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Size_.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Margin.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.OpenNotional.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.LatestCumulativePremiumFraction.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.BlockNumber != 0 {
+		n += 1 + sovState(uint64(m.BlockNumber))
+	}
+	return n
+}
+
+func (m *Subaccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *Order) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.OrderType != 0 {
+		n += 1 + sovState(uint64(m.OrderType))
+	}
+	if m.Side != 0 {
+		n += 1 + sovState(uint64(m.Side))
+	}
+	l = m.TriggerPrice.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.QuoteAssetAmount.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Leverage.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.BaseAssetAmountLimit.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.BlockNumber != 0 {
+		n += 1 + sovState(uint64(m.BlockNumber))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *PairMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	if len(m.CumulativePremiumFractions) > 0 {
+		for _, e := range m.CumulativePremiumFractions {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CumulativePremiumFraction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.Epoch != 0 {
+		n += 1 + sovState(uint64(m.Epoch))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *OpenInterest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Long.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Short.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *TradingResume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovState(uint64(m.BlockHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *InsuranceFundWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UnlockTime)
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *PrepaidBadDebt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *PositionResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Position != nil {
+		l = m.Position.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.ExchangedNotionalValue.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.ExchangedPositionSize.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.BadDebt.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.FundingPayment.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.RealizedPnl.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.UnrealizedPnlAfter.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.MarginToVault.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.PositionNotional.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *LiquidateResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BadDebt.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.FeeToLiquidator.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.FeeToPerpEcosystemFund.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = len(m.Liquidator)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.PositionResp != nil {
+		l = m.PositionResp.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func sovState(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozState(x uint64) (n int) {
+	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stopped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stopped = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePoolFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePoolFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemFundFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EcosystemFundFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialLiquidationRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PartialLiquidationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRateInterval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingRateInterval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapLookbackWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TwapLookbackWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedLiquidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistedLiquidators = append(m.WhitelistedLiquidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFundFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InsuranceFundFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFundWithdrawalCooldown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.InsuranceFundWithdrawalCooldown, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedPairs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HaltedPairs = append(m.HaltedPairs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitBreakerBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CircuitBreakerWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionlessLiquidations", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PermissionlessLiquidations = bool(v != 0)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorPriorityBlocks", wireType)
+			}
+			m.LiquidatorPriorityBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidatorPriorityBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPermissionlessLiquidationsPerBlock", wireType)
+			}
+			m.MaxPermissionlessLiquidationsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPermissionlessLiquidationsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLiquidatorRewardRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxLiquidatorRewardRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTiers = append(m.FeeTiers, FeeTier{})
+			if err := m.FeeTiers[len(m.FeeTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerRebateRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerRebateRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePoolFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePoolFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemFundFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EcosystemFundFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Position) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Size_.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OpenNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestCumulativePremiumFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestCumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Subaccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Subaccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Subaccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Order) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowState
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stopped", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
 			}
-			var v int
+			m.OrderType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1284,15 +4008,33 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.OrderType |= OrderType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Stopped = bool(v != 0)
-		case 3:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeePoolFeeRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1320,13 +4062,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeePoolFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemFundFeeRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1354,13 +4096,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EcosystemFundFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QuoteAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationFeeRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1388,13 +4130,183 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidationFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Leverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartialLiquidationRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetAmountLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAssetAmountLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePremiumFractions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1419,18 +4331,70 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.CumulativePremiumFractions = append(m.CumulativePremiumFractions, v)
+			if err := m.CumulativePremiumFractions[len(m.CumulativePremiumFractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CumulativePremiumFraction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PartialLiquidationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CumulativePremiumFraction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CumulativePremiumFraction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingRateInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1440,29 +4404,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FundingRateInterval = string(dAtA[iNdEx:postIndex])
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TwapLookbackWindow", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var msglen int
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1472,28 +4437,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TwapLookbackWindow, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedLiquidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1521,7 +4472,9 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WhitelistedLiquidators = append(m.WhitelistedLiquidators, string(dAtA[iNdEx:postIndex]))
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1544,7 +4497,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Position) Unmarshal(dAtA []byte) error {
+func (m *OpenInterest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1567,17 +4520,17 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+			return fmt.Errorf("proto: OpenInterest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OpenInterest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1587,29 +4540,30 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Long", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1619,28 +4573,29 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Long.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Short", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1668,13 +4623,63 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Size_.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Short.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TradingResume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradingResume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradingResume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1702,15 +4707,13 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenNotional", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1720,31 +4723,16 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.OpenNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestCumulativePremiumFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1754,45 +4742,25 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LatestCumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
-			}
-			m.BlockNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockNumber |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -1814,7 +4782,7 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PairMetadata) Unmarshal(dAtA []byte) error {
+func (m *InsuranceFundWithdrawal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1837,15 +4805,47 @@ func (m *PairMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PairMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: InsuranceFundWithdrawal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PairMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InsuranceFundWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1872,15 +4872,15 @@ func (m *PairMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePremiumFractions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1890,25 +4890,22 @@ func (m *PairMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.CumulativePremiumFractions = append(m.CumulativePremiumFractions, v)
-			if err := m.CumulativePremiumFractions[len(m.CumulativePremiumFractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UnlockTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6,6 +6,7 @@ import (
 	"github.com/NibiruChain/nibiru/x/testutil"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
 
 	"github.com/NibiruChain/nibiru/x/common"
 )
@@ -107,8 +108,7 @@ func TestPairMetadata_Validate(t *testing.T) {
 	cases := map[string]test{
 		"success": {
 			p: &PairMetadata{
-				Pair:                       common.MustNewAssetPair("pair1:pair2"),
-				CumulativePremiumFractions: []sdk.Dec{sdk.MustNewDecFromStr("0.1")},
+				Pair: common.MustNewAssetPair("pair1:pair2"),
 			},
 		},
 
@@ -117,10 +117,10 @@ func TestPairMetadata_Validate(t *testing.T) {
 			wantErr: true,
 		},
 
-		"invalid cumulative funding rate": {
+		"deprecated cumulative premium fractions": {
 			p: &PairMetadata{
 				Pair:                       common.MustNewAssetPair("pair1:pair2"),
-				CumulativePremiumFractions: []sdk.Dec{{}},
+				CumulativePremiumFractions: []sdk.Dec{sdk.MustNewDecFromStr("0.1")},
 			},
 			wantErr: true,
 		},
@@ -139,6 +139,35 @@ func TestPairMetadata_Validate(t *testing.T) {
 	}
 }
 
+func TestOrder_IsTriggered(t *testing.T) {
+	type test struct {
+		orderType OrderType
+		side      Side
+		fallsTo   bool // whether the order triggers when the mark price falls to the trigger price
+	}
+
+	cases := map[string]test{
+		"limit buy":         {orderType: OrderType_LIMIT, side: Side_BUY, fallsTo: true},
+		"limit sell":        {orderType: OrderType_LIMIT, side: Side_SELL, fallsTo: false},
+		"stop loss long":    {orderType: OrderType_STOP_LOSS, side: Side_BUY, fallsTo: true},
+		"stop loss short":   {orderType: OrderType_STOP_LOSS, side: Side_SELL, fallsTo: false},
+		"take profit long":  {orderType: OrderType_TAKE_PROFIT, side: Side_BUY, fallsTo: false},
+		"take profit short": {orderType: OrderType_TAKE_PROFIT, side: Side_SELL, fallsTo: true},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			order := &Order{OrderType: tc.orderType, Side: tc.side, TriggerPrice: sdk.NewDec(10)}
+
+			require.True(t, order.IsTriggered(sdk.NewDec(10)))
+			require.Equal(t, tc.fallsTo, order.IsTriggered(sdk.NewDec(9)))
+			require.Equal(t, !tc.fallsTo, order.IsTriggered(sdk.NewDec(11)))
+			require.Equal(t, !tc.fallsTo, order.SignedTriggerPrice().IsNegative())
+		})
+	}
+}
+
 func BenchmarkPosition_Validate(b *testing.B) {
 	t := &Position{
 		TraderAddress:                   testutil.AccAddress().String(),
@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// SubaccountAddress returns the address holding the balance and the positions of
+// a subaccount of the owner. Subaccount 0 is the owner itself, the others are
+// derived from the owner and the id, so no one holds their keys.
+func SubaccountAddress(owner sdk.AccAddress, id uint64) sdk.AccAddress {
+	if id == 0 {
+		return owner
+	}
+	return address.Derive(owner, append([]byte(ModuleName+"/subaccount/"), sdk.Uint64ToBigEndian(id)...))
+}
+
+// NewSubaccount returns the subaccount of the owner with the given id.
+func NewSubaccount(owner sdk.AccAddress, id uint64) Subaccount {
+	return Subaccount{
+		Owner:   owner.String(),
+		Id:      id,
+		Address: SubaccountAddress(owner, id).String(),
+	}
+}
+
+// Validate checks that the address of the subaccount is the one derived from
+// its owner and id.
+func (s Subaccount) Validate() error {
+	owner, err := sdk.AccAddressFromBech32(s.Owner)
+	if err != nil {
+		return err
+	}
+	if s.Id == 0 {
+		return ErrInvalidSubaccount.Wrapf("subaccount 0 of %s is the owner itself", s.Owner)
+	}
+	if want := SubaccountAddress(owner, s.Id).String(); s.Address != want {
+		return ErrInvalidSubaccount.Wrapf("subaccount %d of %s has address %s, not %s", s.Id, s.Owner, want, s.Address)
+	}
+	return nil
+}
@@ -11,19 +11,23 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -36,6 +40,8 @@ type MsgRemoveMargin struct {
 	Sender    string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	TokenPair string     `protobuf:"bytes,2,opt,name=token_pair,json=tokenPair,proto3" json:"token_pair,omitempty"`
 	Margin    types.Coin `protobuf:"bytes,3,opt,name=margin,proto3" json:"margin"`
+	// subaccount of the sender holding the position, 0 for the sender itself
+	SubaccountId uint64 `protobuf:"varint,4,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
 }
 
 func (m *MsgRemoveMargin) Reset()         { *m = MsgRemoveMargin{} }
@@ -92,6 +98,13 @@ func (m *MsgRemoveMargin) GetMargin() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgRemoveMargin) GetSubaccountId() uint64 {
+	if m != nil {
+		return m.SubaccountId
+	}
+	return 0
+}
+
 type MsgRemoveMarginResponse struct {
 	// tokens transferred back to the trader
 	MarginOut types.Coin `protobuf:"bytes,1,opt,name=margin_out,json=marginOut,proto3" json:"margin_out"`
@@ -153,6 +166,8 @@ type MsgAddMargin struct {
 	Sender    string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	TokenPair string     `protobuf:"bytes,2,opt,name=token_pair,json=tokenPair,proto3" json:"token_pair,omitempty"`
 	Margin    types.Coin `protobuf:"bytes,3,opt,name=margin,proto3" json:"margin"`
+	// subaccount of the sender holding the position, 0 for the sender itself
+	SubaccountId uint64 `protobuf:"varint,4,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
 }
 
 func (m *MsgAddMargin) Reset()         { *m = MsgAddMargin{} }
@@ -209,6 +224,13 @@ func (m *MsgAddMargin) GetMargin() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgAddMargin) GetSubaccountId() uint64 {
+	if m != nil {
+		return m.SubaccountId
+	}
+	return 0
+}
+
 type MsgAddMarginResponse struct {
 	FundingPayment github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=funding_payment,json=fundingPayment,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_payment"`
 	Position       *Position                              `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
@@ -615,6 +637,8 @@ type MsgOpenPosition struct {
 	QuoteAssetAmount     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=quote_asset_amount,json=quoteAssetAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"quote_asset_amount"`
 	Leverage             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=leverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"leverage"`
 	BaseAssetAmountLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=base_asset_amount_limit,json=baseAssetAmountLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_asset_amount_limit"`
+	// subaccount of the sender holding the position, 0 for the sender itself
+	SubaccountId uint64 `protobuf:"varint,7,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
 }
 
 func (m *MsgOpenPosition) Reset()         { *m = MsgOpenPosition{} }
@@ -671,6 +695,13 @@ func (m *MsgOpenPosition) GetSide() Side {
 	return Side_SIDE_UNSPECIFIED
 }
 
+func (m *MsgOpenPosition) GetSubaccountId() uint64 {
+	if m != nil {
+		return m.SubaccountId
+	}
+	return 0
+}
+
 type MsgOpenPositionResponse struct {
 	Position *Position `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
 	// The amount of quote assets exchanged.
@@ -733,6 +764,17 @@ func (m *MsgOpenPositionResponse) GetPosition() *Position {
 type MsgClosePosition struct {
 	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	TokenPair string `protobuf:"bytes,2,opt,name=token_pair,json=tokenPair,proto3" json:"token_pair,omitempty"`
+	// base size of the position to close. Leave it and the fraction empty to
+	// close the entire position.
+	BaseAssetAmount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=base_asset_amount,json=baseAssetAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_asset_amount"`
+	// fraction of the position size to close, in (0, 1]. Can't be set along
+	// with the base asset amount.
+	Fraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=fraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fraction"`
+	// minimum quote received closing a long position, or maximum quote paid
+	// closing a short position. Zero for no limit.
+	QuoteAssetAmountLimit github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=quote_asset_amount_limit,json=quoteAssetAmountLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quote_asset_amount_limit"`
+	// subaccount of the sender holding the position, 0 for the sender itself
+	SubaccountId uint64 `protobuf:"varint,6,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
 }
 
 func (m *MsgClosePosition) Reset()         { *m = MsgClosePosition{} }
@@ -782,6 +824,13 @@ func (m *MsgClosePosition) GetTokenPair() string {
 	return ""
 }
 
+func (m *MsgClosePosition) GetSubaccountId() uint64 {
+	if m != nil {
+		return m.SubaccountId
+	}
+	return 0
+}
+
 type MsgClosePositionResponse struct {
 	// The amount of quote assets exchanged.
 	ExchangedNotionalValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=exchanged_notional_value,json=exchangedNotionalValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_notional_value"`
@@ -794,6 +843,8 @@ type MsgClosePositionResponse struct {
 	// The amount of margin the trader receives after closing the position, from the vault.
 	// Should never be negative.
 	MarginToTrader github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=margin_to_trader,json=marginToTrader,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"margin_to_trader"`
+	// The remaining position, with a zero size if it was closed entirely.
+	Position *Position `protobuf:"bytes,8,opt,name=position,proto3" json:"position,omitempty"`
 }
 
 func (m *MsgClosePositionResponse) Reset()         { *m = MsgClosePositionResponse{} }
@@ -829,6 +880,13 @@ func (m *MsgClosePositionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClosePositionResponse proto.InternalMessageInfo
 
+func (m *MsgClosePositionResponse) GetPosition() *Position {
+	if m != nil {
+		return m.Position
+	}
+	return nil
+}
+
 type MsgDonateToEcosystemFund struct {
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	// donation to the EF
@@ -918,1545 +976,5844 @@ func (m *MsgDonateToEcosystemFundResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDonateToEcosystemFundResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgRemoveMargin)(nil), "nibiru.perp.v1.MsgRemoveMargin")
-	proto.RegisterType((*MsgRemoveMarginResponse)(nil), "nibiru.perp.v1.MsgRemoveMarginResponse")
-	proto.RegisterType((*MsgAddMargin)(nil), "nibiru.perp.v1.MsgAddMargin")
-	proto.RegisterType((*MsgAddMarginResponse)(nil), "nibiru.perp.v1.MsgAddMarginResponse")
-	proto.RegisterType((*MsgLiquidate)(nil), "nibiru.perp.v1.MsgLiquidate")
-	proto.RegisterType((*MsgLiquidateResponse)(nil), "nibiru.perp.v1.MsgLiquidateResponse")
-	proto.RegisterType((*MsgMultiLiquidate)(nil), "nibiru.perp.v1.MsgMultiLiquidate")
-	proto.RegisterType((*MsgMultiLiquidate_MultiLiquidation)(nil), "nibiru.perp.v1.MsgMultiLiquidate.MultiLiquidation")
-	proto.RegisterType((*MsgMultiLiquidateResponse)(nil), "nibiru.perp.v1.MsgMultiLiquidateResponse")
-	proto.RegisterType((*MsgMultiLiquidateResponse_MultiLiquidateResponse)(nil), "nibiru.perp.v1.MsgMultiLiquidateResponse.MultiLiquidateResponse")
-	proto.RegisterType((*MsgOpenPosition)(nil), "nibiru.perp.v1.MsgOpenPosition")
-	proto.RegisterType((*MsgOpenPositionResponse)(nil), "nibiru.perp.v1.MsgOpenPositionResponse")
-	proto.RegisterType((*MsgClosePosition)(nil), "nibiru.perp.v1.MsgClosePosition")
-	proto.RegisterType((*MsgClosePositionResponse)(nil), "nibiru.perp.v1.MsgClosePositionResponse")
-	proto.RegisterType((*MsgDonateToEcosystemFund)(nil), "nibiru.perp.v1.MsgDonateToEcosystemFund")
-	proto.RegisterType((*MsgDonateToEcosystemFundResponse)(nil), "nibiru.perp.v1.MsgDonateToEcosystemFundResponse")
+type MsgPlaceOrder struct {
+	Sender    string    `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TokenPair string    `protobuf:"bytes,2,opt,name=token_pair,json=tokenPair,proto3" json:"token_pair,omitempty"`
+	OrderType OrderType `protobuf:"varint,3,opt,name=order_type,json=orderType,proto3,enum=nibiru.perp.v1.OrderType" json:"order_type,omitempty"`
+	// side of the position to open. Only used by LIMIT orders, STOP_LOSS and
+	// TAKE_PROFIT orders take the side of the trader's open position.
+	Side         Side                                   `protobuf:"varint,4,opt,name=side,proto3,enum=nibiru.perp.v1.Side" json:"side,omitempty"`
+	TriggerPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=trigger_price,json=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price"`
+	// margin escrowed until a LIMIT order fills or is cancelled.
+	QuoteAssetAmount     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=quote_asset_amount,json=quoteAssetAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"quote_asset_amount"`
+	Leverage             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=leverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"leverage"`
+	BaseAssetAmountLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=base_asset_amount_limit,json=baseAssetAmountLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_asset_amount_limit"`
+	// subaccount of the sender placing the order, 0 for the sender itself
+	SubaccountId uint64 `protobuf:"varint,9,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+}
+
+func (m *MsgPlaceOrder) Reset()         { *m = MsgPlaceOrder{} }
+func (m *MsgPlaceOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceOrder) ProtoMessage()    {}
+func (*MsgPlaceOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28f06b306d51dcfb, []int{14}
+}
+func (m *MsgPlaceOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceOrder.Merge(m, src)
+}
+func (m *MsgPlaceOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceOrder.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("perp/v1/tx.proto", fileDescriptor_28f06b306d51dcfb) }
+var xxx_messageInfo_MsgPlaceOrder proto.InternalMessageInfo
 
-var fileDescriptor_28f06b306d51dcfb = []byte{
-	// 1248 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x98, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0xc7, 0xe3, 0x6c, 0x9a, 0x26, 0x2f, 0x69, 0x92, 0x9a, 0x74, 0xe3, 0x9a, 0x74, 0x13, 0x4c,
-	0xa1, 0x01, 0xa9, 0x36, 0x09, 0x48, 0x48, 0x1c, 0x10, 0x49, 0x0b, 0x6a, 0xa1, 0x69, 0x83, 0x1b,
-	0x05, 0x44, 0x41, 0x66, 0xb2, 0x9e, 0x38, 0x56, 0xbd, 0x33, 0xae, 0x67, 0xbc, 0x6a, 0xaa, 0x0a,
-	0x41, 0x2b, 0x71, 0x46, 0x70, 0xe4, 0x86, 0xc4, 0xff, 0xc1, 0xb1, 0x27, 0x54, 0x89, 0x0b, 0xe2,
-	0x50, 0xa1, 0xb6, 0x07, 0xce, 0xfd, 0x0b, 0xd0, 0x8c, 0x7f, 0xac, 0xbd, 0x71, 0xb2, 0xdb, 0x6d,
-	0x0b, 0xa7, 0xac, 0x67, 0xde, 0x7c, 0xde, 0x77, 0xde, 0x7b, 0x7e, 0x33, 0x0e, 0xcc, 0x84, 0x38,
-	0x0a, 0xad, 0xf6, 0xb2, 0xc5, 0x6f, 0x9a, 0x61, 0x44, 0x39, 0x55, 0xa7, 0x88, 0xbf, 0xed, 0x47,
-	0xb1, 0x29, 0x26, 0xcc, 0xf6, 0xb2, 0x3e, 0xef, 0x51, 0xea, 0x05, 0xd8, 0x42, 0xa1, 0x6f, 0x21,
-	0x42, 0x28, 0x47, 0xdc, 0xa7, 0x84, 0x25, 0xd6, 0x7a, 0xa3, 0x49, 0x59, 0x8b, 0x32, 0x6b, 0x1b,
-	0x31, 0x6c, 0xb5, 0x97, 0xb7, 0x31, 0x47, 0xcb, 0x56, 0x93, 0xfa, 0x24, 0x9d, 0x9f, 0xf5, 0xa8,
-	0x47, 0xe5, 0x4f, 0x4b, 0xfc, 0x4a, 0x47, 0x5f, 0xca, 0xbc, 0x32, 0x8e, 0x38, 0x4e, 0x06, 0x8d,
-	0xef, 0x14, 0x98, 0x5e, 0x67, 0x9e, 0x8d, 0x5b, 0xb4, 0x8d, 0xd7, 0x51, 0xe4, 0xf9, 0x44, 0xad,
-	0xc3, 0x28, 0xc3, 0xc4, 0xc5, 0x91, 0xa6, 0x2c, 0x2a, 0x4b, 0xe3, 0x76, 0xfa, 0xa4, 0x9e, 0x02,
-	0xe0, 0xf4, 0x3a, 0x26, 0x4e, 0x88, 0xfc, 0x48, 0x1b, 0x96, 0x73, 0xe3, 0x72, 0x64, 0x03, 0xf9,
-	0x91, 0xfa, 0x2e, 0x8c, 0xb6, 0x24, 0x40, 0xab, 0x2d, 0x2a, 0x4b, 0x13, 0x2b, 0x27, 0xcd, 0x44,
-	0xa6, 0x29, 0x64, 0x9a, 0xa9, 0x4c, 0xf3, 0x1c, 0xf5, 0xc9, 0xda, 0xc8, 0xbd, 0x07, 0x0b, 0x43,
-	0x76, 0x6a, 0x6e, 0xfc, 0xa3, 0xc0, 0x5c, 0x97, 0x06, 0x1b, 0xb3, 0x90, 0x12, 0x86, 0xd5, 0xf7,
-	0x01, 0x12, 0x2b, 0x87, 0xc6, 0x5c, 0xea, 0xe9, 0x03, 0x3c, 0x9e, 0x2c, 0xb9, 0x12, 0x73, 0xf5,
-	0x33, 0x98, 0xde, 0x89, 0x89, 0xeb, 0x13, 0xcf, 0x09, 0xd1, 0x5e, 0x0b, 0x13, 0x9e, 0x08, 0x5f,
-	0x33, 0x85, 0xe5, 0x5f, 0x0f, 0x16, 0x5e, 0xf7, 0x7c, 0xbe, 0x1b, 0x6f, 0x9b, 0x4d, 0xda, 0xb2,
-	0xd2, 0xb0, 0x26, 0x7f, 0xce, 0x32, 0xf7, 0xba, 0xc5, 0xf7, 0x42, 0xcc, 0xcc, 0xf3, 0xb8, 0x69,
-	0x4f, 0xa5, 0x98, 0x8d, 0x84, 0xa2, 0xbe, 0x03, 0x63, 0x21, 0x65, 0xbe, 0x48, 0x4b, 0xba, 0x5f,
-	0xcd, 0x2c, 0x27, 0xd1, 0xdc, 0x48, 0xe7, 0xed, 0xdc, 0xd2, 0xf8, 0x06, 0x26, 0xd7, 0x99, 0xb7,
-	0xea, 0xba, 0xff, 0x53, 0xa8, 0x7f, 0x55, 0x60, 0xb6, 0x28, 0x20, 0x8f, 0x73, 0x45, 0x9c, 0x94,
-	0xe7, 0x1e, 0xa7, 0xe1, 0xbe, 0xe3, 0xf4, 0x95, 0x8c, 0xd3, 0x25, 0xff, 0x46, 0xec, 0xbb, 0x88,
-	0xe3, 0x41, 0xe3, 0x54, 0x87, 0x51, 0x1e, 0x21, 0xb1, 0xac, 0x96, 0x2c, 0x4b, 0x9e, 0x8c, 0xdf,
-	0x92, 0x30, 0xe4, 0xfc, 0x3c, 0x0c, 0x9f, 0xc0, 0xf1, 0x1d, 0x8c, 0x1d, 0x4e, 0x9d, 0x20, 0x9d,
-	0xa3, 0x51, 0xbf, 0x55, 0x37, 0xbd, 0x83, 0xf1, 0x26, 0xbd, 0x94, 0xaf, 0x53, 0xaf, 0x81, 0x9e,
-	0xc2, 0xc4, 0x4e, 0x1d, 0xdc, 0xa4, 0x6c, 0x8f, 0x71, 0xdc, 0x72, 0x44, 0x88, 0xd2, 0x60, 0xf4,
-	0xa4, 0xd6, 0x25, 0x75, 0x03, 0x47, 0xe1, 0x87, 0xd9, 0xfa, 0x8f, 0x62, 0xe2, 0x1a, 0xbf, 0x2b,
-	0x70, 0x7c, 0x9d, 0x79, 0xeb, 0x71, 0xc0, 0xfd, 0xde, 0x71, 0xda, 0x82, 0xc9, 0x6c, 0x43, 0xa2,
-	0x8f, 0x68, 0xc3, 0x8b, 0xb5, 0xa5, 0x89, 0x95, 0x95, 0xee, 0x4c, 0xec, 0x03, 0x9a, 0xa5, 0x47,
-	0x91, 0xa3, 0x12, 0x47, 0xbf, 0x08, 0x33, 0xdd, 0x16, 0x83, 0xe6, 0xe4, 0xe7, 0x61, 0x38, 0xb9,
-	0xcf, 0x7f, 0x9e, 0x98, 0x18, 0x4e, 0x14, 0x1c, 0x3b, 0x51, 0x3a, 0xce, 0x34, 0x45, 0xee, 0xe4,
-	0x83, 0x9e, 0x3b, 0xc9, 0x48, 0x66, 0xf5, 0xb0, 0x3d, 0x5b, 0xc0, 0x67, 0x83, 0x4c, 0xff, 0x5e,
-	0x81, 0xfa, 0x01, 0x8a, 0xea, 0x70, 0x04, 0x47, 0x51, 0x5a, 0x1e, 0xe3, 0x17, 0x86, 0xec, 0xe4,
-	0x51, 0xbd, 0x00, 0x13, 0x05, 0x54, 0x9a, 0xe6, 0xd3, 0x15, 0xfa, 0xf6, 0x21, 0x2f, 0x0c, 0xd9,
-	0xc5, 0xa5, 0x6b, 0x00, 0x63, 0xd9, 0x3e, 0x8d, 0xbb, 0x35, 0xd9, 0xa7, 0xaf, 0x84, 0x98, 0x64,
-	0xaf, 0xcb, 0xa0, 0x2f, 0xc5, 0x12, 0x8c, 0x30, 0xdf, 0xc5, 0x32, 0xfc, 0x53, 0x2b, 0xb3, 0xdd,
-	0xca, 0xae, 0xfa, 0x2e, 0xb6, 0xa5, 0x85, 0xfa, 0x25, 0xa8, 0x37, 0x62, 0xca, 0xb1, 0x83, 0x18,
-	0xc3, 0xdc, 0x41, 0x2d, 0x1a, 0x13, 0xae, 0x8d, 0x3c, 0x75, 0x5f, 0xb8, 0x48, 0xb8, 0x3d, 0x23,
-	0x49, 0xab, 0x02, 0xb4, 0x2a, 0x39, 0xea, 0xc7, 0x30, 0x16, 0xe0, 0x36, 0x8e, 0x90, 0x87, 0xb5,
-	0x23, 0x03, 0xf5, 0x9a, 0x7c, 0xbd, 0x8a, 0x61, 0x4e, 0xbc, 0x40, 0x25, 0xa1, 0x4e, 0xe0, 0xb7,
-	0x7c, 0xae, 0x8d, 0x0e, 0x24, 0x77, 0x56, 0xe0, 0x0a, 0x6a, 0x2f, 0x09, 0x96, 0xf1, 0xf8, 0x88,
-	0x3c, 0xa9, 0x8a, 0x59, 0xc8, 0xeb, 0xa1, 0xd8, 0xe8, 0x94, 0x7e, 0x1b, 0x9d, 0xba, 0x0b, 0x1a,
-	0xbe, 0xd9, 0xdc, 0x45, 0xc4, 0xc3, 0xae, 0x43, 0xa8, 0x18, 0x43, 0x81, 0xd3, 0x46, 0x41, 0x8c,
-	0x07, 0x3c, 0xa8, 0xea, 0x39, 0xef, 0x72, 0x8a, 0xdb, 0x12, 0x34, 0x75, 0x07, 0xe6, 0x3a, 0x9e,
-	0x32, 0xff, 0x0e, 0xf3, 0x6f, 0x25, 0x95, 0xf0, 0xf4, 0x8e, 0x4e, 0xe4, 0xb8, 0x6c, 0x5f, 0x57,
-	0xfd, 0x5b, 0x95, 0x27, 0xc9, 0xc8, 0x73, 0x39, 0x49, 0x3e, 0x85, 0xc9, 0x08, 0xa3, 0xc0, 0xbf,
-	0x25, 0xf4, 0x93, 0x60, 0xc0, 0x9a, 0x99, 0xc8, 0x18, 0x1b, 0x24, 0x50, 0xbf, 0x86, 0xd9, 0x98,
-	0x14, 0xa1, 0x0e, 0xda, 0xe1, 0x38, 0x1a, 0xa0, 0x66, 0x04, 0x5a, 0xed, 0xb0, 0x36, 0x48, 0xb0,
-	0x2a, 0x48, 0xea, 0x16, 0x4c, 0xa7, 0xf7, 0x17, 0x4e, 0x9d, 0x36, 0x8a, 0x03, 0xae, 0x1d, 0x1d,
-	0x08, 0x7e, 0x2c, 0xc1, 0x6c, 0xd2, 0x2d, 0x01, 0x51, 0xaf, 0xc1, 0xf1, 0x3c, 0x87, 0x59, 0xd9,
-	0x68, 0x63, 0x03, 0x91, 0x67, 0x32, 0x50, 0x56, 0x2f, 0x86, 0xe8, 0xea, 0xcc, 0x3b, 0x17, 0x50,
-	0x86, 0x9f, 0xb1, 0xd9, 0x18, 0x4f, 0x6a, 0xa0, 0x75, 0xb3, 0xf2, 0x57, 0xe6, 0xb0, 0xe2, 0x57,
-	0xfe, 0xab, 0xe2, 0x1f, 0x7e, 0xc1, 0xc5, 0x5f, 0x7b, 0x21, 0xc5, 0x3f, 0xf2, 0xec, 0xc5, 0xff,
-	0x39, 0xcc, 0x74, 0x4a, 0x33, 0x3d, 0x92, 0x07, 0xab, 0xcd, 0xa9, 0xac, 0x36, 0x37, 0x93, 0xa3,
-	0xfc, 0x8e, 0x22, 0x93, 0x7e, 0x9e, 0x12, 0xc4, 0xf1, 0x26, 0x2d, 0x5d, 0x5c, 0x0e, 0x2c, 0xa4,
-	0xcb, 0x30, 0xe6, 0x8a, 0x05, 0x9d, 0x43, 0xf3, 0x90, 0xbb, 0xd1, 0x9c, 0x50, 0xf8, 0xe4, 0xc1,
-	0xc2, 0xf4, 0x1e, 0x6a, 0x05, 0xef, 0x19, 0xd9, 0x42, 0xc3, 0xce, 0x19, 0x86, 0x01, 0x8b, 0x07,
-	0x69, 0xc8, 0x0a, 0x70, 0xe5, 0xc7, 0xa3, 0x50, 0x5b, 0x67, 0x9e, 0x7a, 0x1b, 0x26, 0x4b, 0x5f,
-	0x40, 0x0b, 0x15, 0xc7, 0x75, 0xd1, 0x40, 0x3f, 0xd3, 0xc3, 0x20, 0xf3, 0x60, 0x18, 0x77, 0xfe,
-	0x78, 0xfc, 0xd3, 0xf0, 0xbc, 0xa1, 0x5b, 0xc9, 0x02, 0x4b, 0x7e, 0x84, 0x45, 0xd2, 0xd4, 0x49,
-	0xc2, 0xa6, 0x86, 0x30, 0xde, 0xf9, 0x22, 0x98, 0xaf, 0x20, 0xe7, 0xb3, 0xfa, 0xe9, 0xc3, 0x66,
-	0x73, 0xa7, 0x0b, 0xd2, 0xe9, 0x49, 0x63, 0xae, 0xe4, 0x14, 0xb9, 0x6e, 0xe6, 0x91, 0xc2, 0x78,
-	0xe7, 0xce, 0x38, 0x7f, 0xd8, 0xdd, 0x44, 0xef, 0xeb, 0xe6, 0x62, 0x34, 0xa4, 0x47, 0xcd, 0xa8,
-	0x97, 0x3c, 0x06, 0xb9, 0x8f, 0xbb, 0x0a, 0x4c, 0x75, 0x5d, 0x55, 0x5f, 0xe9, 0x79, 0x65, 0xd3,
-	0xdf, 0xe8, 0xfb, 0x56, 0x67, 0xbc, 0x2a, 0x05, 0x9c, 0x32, 0x5e, 0x2e, 0x09, 0x68, 0x09, 0xe3,
-	0x8e, 0x8a, 0xdb, 0x30, 0x59, 0xba, 0x40, 0x55, 0xa5, 0xb9, 0x68, 0x50, 0x99, 0xe6, 0xaa, 0xc3,
-	0xff, 0x80, 0x34, 0xd3, 0x50, 0xf4, 0xc5, 0xcc, 0xdb, 0xb7, 0x0a, 0x1c, 0x2b, 0xf7, 0xd4, 0xc5,
-	0x0a, 0x7c, 0xc9, 0x42, 0x5f, 0xea, 0x65, 0xd1, 0x23, 0x00, 0x4d, 0x61, 0xdb, 0x91, 0xf0, 0x8b,
-	0x02, 0x27, 0xaa, 0xdf, 0xca, 0x2a, 0x47, 0x95, 0x96, 0xfa, 0x5b, 0xfd, 0x5a, 0xe6, 0xd2, 0xce,
-	0x4a, 0x69, 0x67, 0x8c, 0xd7, 0x4a, 0xd2, 0xe4, 0x8b, 0x2a, 0xbf, 0x8e, 0xca, 0x1f, 0x46, 0x6b,
-	0xe7, 0xef, 0x3d, 0x6c, 0x28, 0xf7, 0x1f, 0x36, 0x94, 0xbf, 0x1f, 0x36, 0x94, 0x1f, 0x1e, 0x35,
-	0x86, 0xee, 0x3f, 0x6a, 0x0c, 0xfd, 0xf9, 0xa8, 0x31, 0xf4, 0xc5, 0x9b, 0x85, 0x7e, 0x74, 0x59,
-	0xa2, 0xce, 0xed, 0x22, 0x9f, 0x64, 0xd8, 0x9b, 0x09, 0x58, 0xf6, 0xa5, 0xed, 0x51, 0xf9, 0xff,
-	0x8d, 0xb7, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x15, 0x1f, 0xee, 0xa8, 0x6c, 0x11, 0x00, 0x00,
+func (m *MsgPlaceOrder) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgPlaceOrder) GetTokenPair() string {
+	if m != nil {
+		return m.TokenPair
+	}
+	return ""
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgPlaceOrder) GetOrderType() OrderType {
+	if m != nil {
+		return m.OrderType
+	}
+	return OrderType_ORDER_TYPE_UNSPECIFIED
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	RemoveMargin(ctx context.Context, in *MsgRemoveMargin, opts ...grpc.CallOption) (*MsgRemoveMarginResponse, error)
-	AddMargin(ctx context.Context, in *MsgAddMargin, opts ...grpc.CallOption) (*MsgAddMarginResponse, error)
-	// Liquidate is a transaction that allows the caller to fully or partially
-	// liquidate an existing position.
-	Liquidate(ctx context.Context, in *MsgLiquidate, opts ...grpc.CallOption) (*MsgLiquidateResponse, error)
-	MultiLiquidate(ctx context.Context, in *MsgMultiLiquidate, opts ...grpc.CallOption) (*MsgMultiLiquidateResponse, error)
-	OpenPosition(ctx context.Context, in *MsgOpenPosition, opts ...grpc.CallOption) (*MsgOpenPositionResponse, error)
-	ClosePosition(ctx context.Context, in *MsgClosePosition, opts ...grpc.CallOption) (*MsgClosePositionResponse, error)
-	DonateToEcosystemFund(ctx context.Context, in *MsgDonateToEcosystemFund, opts ...grpc.CallOption) (*MsgDonateToEcosystemFundResponse, error)
+func (m *MsgPlaceOrder) GetSide() Side {
+	if m != nil {
+		return m.Side
+	}
+	return Side_SIDE_UNSPECIFIED
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgPlaceOrder) GetSubaccountId() uint64 {
+	if m != nil {
+		return m.SubaccountId
+	}
+	return 0
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+type MsgPlaceOrderResponse struct {
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
 
-func (c *msgClient) RemoveMargin(ctx context.Context, in *MsgRemoveMargin, opts ...grpc.CallOption) (*MsgRemoveMarginResponse, error) {
-	out := new(MsgRemoveMarginResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Msg/RemoveMargin", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgPlaceOrderResponse) Reset()         { *m = MsgPlaceOrderResponse{} }
+func (m *MsgPlaceOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceOrderResponse) ProtoMessage()    {}
+func (*MsgPlaceOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28f06b306d51dcfb, []int{15}
+}
+func (m *MsgPlaceOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgPlaceOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceOrderResponse.Merge(m, src)
+}
+func (m *MsgPlaceOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceOrderResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddMargin(ctx context.Context, in *MsgAddMargin, opts ...grpc.CallOption) (*MsgAddMarginResponse, error) {
-	out := new(MsgAddMarginResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Msg/AddMargin", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgPlaceOrderResponse proto.InternalMessageInfo
+
+func (m *MsgPlaceOrderResponse) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) Liquidate(ctx context.Context, in *MsgLiquidate, opts ...grpc.CallOption) (*MsgLiquidateResponse, error) {
-	out := new(MsgLiquidateResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Msg/Liquidate", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgCancelOrder struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	OrderId uint64 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// subaccount of the sender which placed the order, 0 for the sender itself
+	SubaccountId uint64 `protobuf:"varint,3,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
 }
 
-func (c *msgClient) MultiLiquidate(ctx context.Context, in *MsgMultiLiquidate, opts ...grpc.CallOption) (*MsgMultiLiquidateResponse, error) {
-	out := new(MsgMultiLiquidateResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Msg/MultiLiquidate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCancelOrder) Reset()         { *m = MsgCancelOrder{} }
+func (m *MsgCancelOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelOrder) ProtoMessage()    {}
+func (*MsgCancelOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28f06b306d51dcfb, []int{16}
+}
+func (m *MsgCancelOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCancelOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelOrder.Merge(m, src)
+}
+func (m *MsgCancelOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelOrder.DiscardUnknown(m)
 }
 
-func (c *msgClient) OpenPosition(ctx context.Context, in *MsgOpenPosition, opts ...grpc.CallOption) (*MsgOpenPositionResponse, error) {
-	out := new(MsgOpenPositionResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Msg/OpenPosition", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelOrder proto.InternalMessageInfo
+
+func (m *MsgCancelOrder) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ClosePosition(ctx context.Context, in *MsgClosePosition, opts ...grpc.CallOption) (*MsgClosePositionResponse, error) {
-	out := new(MsgClosePositionResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Msg/ClosePosition", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCancelOrder) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) DonateToEcosystemFund(ctx context.Context, in *MsgDonateToEcosystemFund, opts ...grpc.CallOption) (*MsgDonateToEcosystemFundResponse, error) {
-	out := new(MsgDonateToEcosystemFundResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Msg/DonateToEcosystemFund", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCancelOrder) GetSubaccountId() uint64 {
+	if m != nil {
+		return m.SubaccountId
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	RemoveMargin(context.Context, *MsgRemoveMargin) (*MsgRemoveMarginResponse, error)
-	AddMargin(context.Context, *MsgAddMargin) (*MsgAddMarginResponse, error)
-	// Liquidate is a transaction that allows the caller to fully or partially
-	// liquidate an existing position.
-	Liquidate(context.Context, *MsgLiquidate) (*MsgLiquidateResponse, error)
-	MultiLiquidate(context.Context, *MsgMultiLiquidate) (*MsgMultiLiquidateResponse, error)
-	OpenPosition(context.Context, *MsgOpenPosition) (*MsgOpenPositionResponse, error)
-	ClosePosition(context.Context, *MsgClosePosition) (*MsgClosePositionResponse, error)
-	DonateToEcosystemFund(context.Context, *MsgDonateToEcosystemFund) (*MsgDonateToEcosystemFundResponse, error)
+type MsgCancelOrderResponse struct {
+	// margin released from escrow back to the trader
+	RefundedMargin types.Coin `protobuf:"bytes,1,opt,name=refunded_margin,json=refundedMargin,proto3" json:"refunded_margin"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgCancelOrderResponse) Reset()         { *m = MsgCancelOrderResponse{} }
+func (m *MsgCancelOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelOrderResponse) ProtoMessage()    {}
+func (*MsgCancelOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28f06b306d51dcfb, []int{17}
+}
+func (m *MsgCancelOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelOrderResponse.Merge(m, src)
+}
+func (m *MsgCancelOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelOrderResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedMsgServer) RemoveMargin(ctx context.Context, req *MsgRemoveMargin) (*MsgRemoveMarginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveMargin not implemented")
+var xxx_messageInfo_MsgCancelOrderResponse proto.InternalMessageInfo
+
+func (m *MsgCancelOrderResponse) GetRefundedMargin() types.Coin {
+	if m != nil {
+		return m.RefundedMargin
+	}
+	return types.Coin{}
 }
-func (*UnimplementedMsgServer) AddMargin(ctx context.Context, req *MsgAddMargin) (*MsgAddMarginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddMargin not implemented")
+
+type MsgSetMarginMode struct {
+	Sender     string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	MarginMode MarginMode `protobuf:"varint,2,opt,name=margin_mode,json=marginMode,proto3,enum=nibiru.perp.v1.MarginMode" json:"margin_mode,omitempty"`
+	// subaccount of the sender whose margin mode is set, 0 for the sender itself
+	SubaccountId uint64 `protobuf:"varint,3,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
 }
-func (*UnimplementedMsgServer) Liquidate(ctx context.Context, req *MsgLiquidate) (*MsgLiquidateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Liquidate not implemented")
+
+func (m *MsgSetMarginMode) Reset()         { *m = MsgSetMarginMode{} }
+func (m *MsgSetMarginMode) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMarginMode) ProtoMessage()    {}
+func (*MsgSetMarginMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28f06b306d51dcfb, []int{18}
 }
-func (*UnimplementedMsgServer) MultiLiquidate(ctx context.Context, req *MsgMultiLiquidate) (*MsgMultiLiquidateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MultiLiquidate not implemented")
+func (m *MsgSetMarginMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) OpenPosition(ctx context.Context, req *MsgOpenPosition) (*MsgOpenPositionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OpenPosition not implemented")
+func (m *MsgSetMarginMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMarginMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ClosePosition(ctx context.Context, req *MsgClosePosition) (*MsgClosePositionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClosePosition not implemented")
+func (m *MsgSetMarginMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMarginMode.Merge(m, src)
 }
-func (*UnimplementedMsgServer) DonateToEcosystemFund(ctx context.Context, req *MsgDonateToEcosystemFund) (*MsgDonateToEcosystemFundResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DonateToEcosystemFund not implemented")
+func (m *MsgSetMarginMode) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMarginMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMarginMode.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgSetMarginMode proto.InternalMessageInfo
+
+func (m *MsgSetMarginMode) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func _Msg_RemoveMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveMargin)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSetMarginMode) GetMarginMode() MarginMode {
+	if m != nil {
+		return m.MarginMode
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveMargin(ctx, in)
+	return MarginMode_MARGIN_MODE_UNSPECIFIED
+}
+
+func (m *MsgSetMarginMode) GetSubaccountId() uint64 {
+	if m != nil {
+		return m.SubaccountId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v1.Msg/RemoveMargin",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveMargin(ctx, req.(*MsgRemoveMargin))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_AddMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddMargin)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddMargin(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v1.Msg/AddMargin",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddMargin(ctx, req.(*MsgAddMargin))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgSetMarginModeResponse struct {
 }
 
-func _Msg_Liquidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLiquidate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Liquidate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v1.Msg/Liquidate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Liquidate(ctx, req.(*MsgLiquidate))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgSetMarginModeResponse) Reset()         { *m = MsgSetMarginModeResponse{} }
+func (m *MsgSetMarginModeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMarginModeResponse) ProtoMessage()    {}
+func (*MsgSetMarginModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28f06b306d51dcfb, []int{19}
 }
-
-func _Msg_MultiLiquidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMultiLiquidate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MultiLiquidate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v1.Msg/MultiLiquidate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MultiLiquidate(ctx, req.(*MsgMultiLiquidate))
+func (m *MsgSetMarginModeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMarginModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMarginModeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetMarginModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMarginModeResponse.Merge(m, src)
+}
+func (m *MsgSetMarginModeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMarginModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMarginModeResponse.DiscardUnknown(m)
 }
 
-func _Msg_OpenPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgOpenPosition)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).OpenPosition(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v1.Msg/OpenPosition",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).OpenPosition(ctx, req.(*MsgOpenPosition))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgSetMarginModeResponse proto.InternalMessageInfo
+
+type MsgSettlePosition struct {
+	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TokenPair string `protobuf:"bytes,2,opt,name=token_pair,json=tokenPair,proto3" json:"token_pair,omitempty"`
+	// subaccount of the sender holding the position, 0 for the sender itself
+	SubaccountId uint64 `protobuf:"varint,3,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
 }
 
-func _Msg_ClosePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClosePosition)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClosePosition(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v1.Msg/ClosePosition",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClosePosition(ctx, req.(*MsgClosePosition))
+func (m *MsgSettlePosition) Reset()         { *m = MsgSettlePosition{} }
+func (m *MsgSettlePosition) String() string { return proto.CompactTextString(m) }
+func (*MsgSettlePosition) ProtoMessage()    {}
+func (*MsgSettlePosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28f06b306d51dcfb, []int{20}
+}
+func (m *MsgSettlePosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSettlePosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSettlePosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSettlePosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSettlePosition.Merge(m, src)
+}
+func (m *MsgSettlePosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSettlePosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSettlePosition.DiscardUnknown(m)
 }
 
-func _Msg_DonateToEcosystemFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDonateToEcosystemFund)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DonateToEcosystemFund(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v1.Msg/DonateToEcosystemFund",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DonateToEcosystemFund(ctx, req.(*MsgDonateToEcosystemFund))
+var xxx_messageInfo_MsgSettlePosition proto.InternalMessageInfo
+
+func (m *MsgSettlePosition) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "nibiru.perp.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RemoveMargin",
-			Handler:    _Msg_RemoveMargin_Handler,
-		},
-		{
-			MethodName: "AddMargin",
-			Handler:    _Msg_AddMargin_Handler,
-		},
-		{
-			MethodName: "Liquidate",
-			Handler:    _Msg_Liquidate_Handler,
-		},
-		{
-			MethodName: "MultiLiquidate",
-			Handler:    _Msg_MultiLiquidate_Handler,
-		},
-		{
-			MethodName: "OpenPosition",
-			Handler:    _Msg_OpenPosition_Handler,
-		},
-		{
-			MethodName: "ClosePosition",
-			Handler:    _Msg_ClosePosition_Handler,
-		},
-		{
-			MethodName: "DonateToEcosystemFund",
-			Handler:    _Msg_DonateToEcosystemFund_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "perp/v1/tx.proto",
+func (m *MsgSettlePosition) GetTokenPair() string {
+	if m != nil {
+		return m.TokenPair
+	}
+	return ""
 }
 
-func (m *MsgRemoveMargin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSettlePosition) GetSubaccountId() uint64 {
+	if m != nil {
+		return m.SubaccountId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgRemoveMargin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSettlePositionResponse struct {
+	SettledCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=settled_coins,json=settledCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"settled_coins"`
 }
 
-func (m *MsgRemoveMargin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSettlePositionResponse) Reset()         { *m = MsgSettlePositionResponse{} }
+func (m *MsgSettlePositionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSettlePositionResponse) ProtoMessage()    {}
+func (*MsgSettlePositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28f06b306d51dcfb, []int{21}
+}
+func (m *MsgSettlePositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSettlePositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSettlePositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.TokenPair) > 0 {
-		i -= len(m.TokenPair)
-		copy(dAtA[i:], m.TokenPair)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenPair)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSettlePositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSettlePositionResponse.Merge(m, src)
+}
+func (m *MsgSettlePositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSettlePositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSettlePositionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveMarginResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSettlePositionResponse proto.InternalMessageInfo
+
+func (m *MsgSettlePositionResponse) GetSettledCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SettledCoins
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgRemoveMarginResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgDepositToInsuranceFund struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// quote tokens to deposit
+	Deposit types.Coin `protobuf:"bytes,2,opt,name=deposit,proto3" json:"deposit"`
 }
 
-func (m *MsgRemoveMarginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Position != nil {
-		{
-			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size := m.FundingPayment.Size()
-		i -= size
-		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.MarginOut.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgDepositToInsuranceFund) Reset()         { *m = MsgDepositToInsuranceFund{} }
+func (m *MsgDepositToInsuranceFund) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositToInsuranceFund) ProtoMessage()    {}
+func (*MsgDepositToInsuranceFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28f06b306d51dcfb, []int{22}
+}
+func (m *MsgDepositToInsuranceFund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositToInsuranceFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositToInsuranceFund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgDepositToInsuranceFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositToInsuranceFund.Merge(m, src)
+}
+func (m *MsgDepositToInsuranceFund) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositToInsuranceFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositToInsuranceFund.DiscardUnknown(m)
 }
 
-func (m *MsgAddMargin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDepositToInsuranceFund proto.InternalMessageInfo
+
+func (m *MsgDepositToInsuranceFund) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAddMargin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDepositToInsuranceFund) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return types.Coin{}
 }
 
-func (m *MsgAddMargin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+type MsgDepositToInsuranceFundResponse struct {
+	// insurance fund shares minted to the sender
+	Shares types.Coin `protobuf:"bytes,1,opt,name=shares,proto3" json:"shares"`
+}
+
+func (m *MsgDepositToInsuranceFundResponse) Reset()         { *m = MsgDepositToInsuranceFundResponse{} }
+func (m *MsgDepositToInsuranceFundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositToInsuranceFundResponse) ProtoMessage()    {}
+func (*MsgDepositToInsuranceFundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28f06b306d51dcfb, []int{23}
+}
+func (m *MsgDepositToInsuranceFundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositToInsuranceFundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositToInsuranceFundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.TokenPair) > 0 {
-		i -= len(m.TokenPair)
-		copy(dAtA[i:], m.TokenPair)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenPair)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgDepositToInsuranceFundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositToInsuranceFundResponse.Merge(m, src)
+}
+func (m *MsgDepositToInsuranceFundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositToInsuranceFundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositToInsuranceFundResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddMarginResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDepositToInsuranceFundResponse proto.InternalMessageInfo
+
+func (m *MsgDepositToInsuranceFundResponse) GetShares() types.Coin {
+	if m != nil {
+		return m.Shares
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgAddMarginResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgRequestInsuranceFundWithdrawal struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// insurance fund shares to redeem, added to any pending withdrawal of the
+	// sender from the same fund.
+	Shares types.Coin `protobuf:"bytes,2,opt,name=shares,proto3" json:"shares"`
 }
 
-func (m *MsgAddMarginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Position != nil {
-		{
-			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size := m.FundingPayment.Size()
-		i -= size
-		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgRequestInsuranceFundWithdrawal) Reset()         { *m = MsgRequestInsuranceFundWithdrawal{} }
+func (m *MsgRequestInsuranceFundWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestInsuranceFundWithdrawal) ProtoMessage()    {}
+func (*MsgRequestInsuranceFundWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28f06b306d51dcfb, []int{24}
+}
+func (m *MsgRequestInsuranceFundWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestInsuranceFundWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestInsuranceFundWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgLiquidate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRequestInsuranceFundWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestInsuranceFundWithdrawal.Merge(m, src)
 }
-
-func (m *MsgLiquidate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRequestInsuranceFundWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestInsuranceFundWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestInsuranceFundWithdrawal.DiscardUnknown(m)
 }
 
-func (m *MsgLiquidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Trader) > 0 {
-		i -= len(m.Trader)
-		copy(dAtA[i:], m.Trader)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Trader)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.TokenPair) > 0 {
-		i -= len(m.TokenPair)
-		copy(dAtA[i:], m.TokenPair)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenPair)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgRequestInsuranceFundWithdrawal proto.InternalMessageInfo
+
+func (m *MsgRequestInsuranceFundWithdrawal) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgLiquidateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRequestInsuranceFundWithdrawal) GetShares() types.Coin {
+	if m != nil {
+		return m.Shares
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgLiquidateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgRequestInsuranceFundWithdrawalResponse struct {
+	// time from which the pending withdrawal can be completed
+	UnlockTime time.Time `protobuf:"bytes,1,opt,name=unlock_time,json=unlockTime,proto3,stdtime" json:"unlock_time"`
 }
 
-func (m *MsgLiquidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.FeeToPerpEcosystemFund.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.FeeToLiquidator.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRequestInsuranceFundWithdrawalResponse) Reset() {
+	*m = MsgRequestInsuranceFundWithdrawalResponse{}
+}
+func (m *MsgRequestInsuranceFundWithdrawalResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgRequestInsuranceFundWithdrawalResponse) ProtoMessage() {}
+func (*MsgRequestInsuranceFundWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28f06b306d51dcfb, []int{25}
+}
+func (m *MsgRequestInsuranceFundWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestInsuranceFundWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestInsuranceFundWithdrawalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgRequestInsuranceFundWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestInsuranceFundWithdrawalResponse.Merge(m, src)
+}
+func (m *MsgRequestInsuranceFundWithdrawalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestInsuranceFundWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestInsuranceFundWithdrawalResponse.DiscardUnknown(m)
 }
 
-func (m *MsgMultiLiquidate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRequestInsuranceFundWithdrawalResponse proto.InternalMessageInfo
+
+func (m *MsgRequestInsuranceFundWithdrawalResponse) GetUnlockTime() time.Time {
+	if m != nil {
+		return m.UnlockTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *MsgMultiLiquidate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgWithdrawFromInsuranceFund struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// quote denom of the insurance fund
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *MsgMultiLiquidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Liquidations) > 0 {
-		for iNdEx := len(m.Liquidations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Liquidations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgWithdrawFromInsuranceFund) Reset()         { *m = MsgWithdrawFromInsuranceFund{} }
+func (m *MsgWithdrawFromInsuranceFund) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawFromInsuranceFund) ProtoMessage()    {}
+func (*MsgWithdrawFromInsuranceFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28f06b306d51dcfb, []int{26}
+}
+func (m *MsgWithdrawFromInsuranceFund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawFromInsuranceFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawFromInsuranceFund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgWithdrawFromInsuranceFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawFromInsuranceFund.Merge(m, src)
+}
+func (m *MsgWithdrawFromInsuranceFund) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawFromInsuranceFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawFromInsuranceFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawFromInsuranceFund proto.InternalMessageInfo
+
+func (m *MsgWithdrawFromInsuranceFund) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgMultiLiquidate_MultiLiquidation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawFromInsuranceFund) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgMultiLiquidate_MultiLiquidation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgWithdrawFromInsuranceFundResponse struct {
+	// quote tokens paid to the sender
+	Withdrawn types.Coin `protobuf:"bytes,1,opt,name=withdrawn,proto3" json:"withdrawn"`
 }
 
-func (m *MsgMultiLiquidate_MultiLiquidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Trader) > 0 {
-		i -= len(m.Trader)
-		copy(dAtA[i:], m.Trader)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Trader)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.TokenPair) > 0 {
-		i -= len(m.TokenPair)
-		copy(dAtA[i:], m.TokenPair)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenPair)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgWithdrawFromInsuranceFundResponse) Reset()         { *m = MsgWithdrawFromInsuranceFundResponse{} }
+func (m *MsgWithdrawFromInsuranceFundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawFromInsuranceFundResponse) ProtoMessage()    {}
+func (*MsgWithdrawFromInsuranceFundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28f06b306d51dcfb, []int{27}
+}
+func (m *MsgWithdrawFromInsuranceFundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawFromInsuranceFundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawFromInsuranceFundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgWithdrawFromInsuranceFundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawFromInsuranceFundResponse.Merge(m, src)
+}
+func (m *MsgWithdrawFromInsuranceFundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawFromInsuranceFundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawFromInsuranceFundResponse.DiscardUnknown(m)
 }
 
-func (m *MsgMultiLiquidateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgWithdrawFromInsuranceFundResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawFromInsuranceFundResponse) GetWithdrawn() types.Coin {
+	if m != nil {
+		return m.Withdrawn
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgMultiLiquidateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSetTradingHalt struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// pair to halt or resume, empty for the whole exchange
+	TokenPair string `protobuf:"bytes,2,opt,name=token_pair,json=tokenPair,proto3" json:"token_pair,omitempty"`
+	Halted    bool   `protobuf:"varint,3,opt,name=halted,proto3" json:"halted,omitempty"`
 }
 
-func (m *MsgMultiLiquidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LiquidationResponses) > 0 {
-		for iNdEx := len(m.LiquidationResponses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LiquidationResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *MsgSetTradingHalt) Reset()         { *m = MsgSetTradingHalt{} }
+func (m *MsgSetTradingHalt) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTradingHalt) ProtoMessage()    {}
+func (*MsgSetTradingHalt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28f06b306d51dcfb, []int{28}
+}
+func (m *MsgSetTradingHalt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTradingHalt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTradingHalt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetTradingHalt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTradingHalt.Merge(m, src)
+}
+func (m *MsgSetTradingHalt) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTradingHalt) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTradingHalt.DiscardUnknown(m)
 }
 
-func (m *MsgMultiLiquidateResponse_MultiLiquidateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetTradingHalt proto.InternalMessageInfo
+
+func (m *MsgSetTradingHalt) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgMultiLiquidateResponse_MultiLiquidateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetTradingHalt) GetTokenPair() string {
+	if m != nil {
+		return m.TokenPair
+	}
+	return ""
 }
 
-func (m *MsgMultiLiquidateResponse_MultiLiquidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Response != nil {
-		{
-			size := m.Response.Size()
-			i -= size
-			if _, err := m.Response.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+func (m *MsgSetTradingHalt) GetHalted() bool {
+	if m != nil {
+		return m.Halted
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *MsgMultiLiquidateResponse_MultiLiquidateResponse_Error) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSetTradingHaltResponse struct {
 }
 
-func (m *MsgMultiLiquidateResponse_MultiLiquidateResponse_Error) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.Error)
-	copy(dAtA[i:], m.Error)
-	i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *MsgSetTradingHaltResponse) Reset()         { *m = MsgSetTradingHaltResponse{} }
+func (m *MsgSetTradingHaltResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTradingHaltResponse) ProtoMessage()    {}
+func (*MsgSetTradingHaltResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28f06b306d51dcfb, []int{29}
 }
-func (m *MsgMultiLiquidateResponse_MultiLiquidateResponse_Liquidation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetTradingHaltResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgMultiLiquidateResponse_MultiLiquidateResponse_Liquidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Liquidation != nil {
-		{
-			size, err := m.Liquidation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgSetTradingHaltResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTradingHaltResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *MsgOpenPosition) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetTradingHaltResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTradingHaltResponse.Merge(m, src)
+}
+func (m *MsgSetTradingHaltResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTradingHaltResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTradingHaltResponse.DiscardUnknown(m)
 }
 
-func (m *MsgOpenPosition) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetTradingHaltResponse proto.InternalMessageInfo
+
+type MsgTransferSubaccountMargin struct {
+	Sender           string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	FromSubaccountId uint64     `protobuf:"varint,2,opt,name=from_subaccount_id,json=fromSubaccountId,proto3" json:"from_subaccount_id,omitempty"`
+	ToSubaccountId   uint64     `protobuf:"varint,3,opt,name=to_subaccount_id,json=toSubaccountId,proto3" json:"to_subaccount_id,omitempty"`
+	Amount           types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *MsgOpenPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.BaseAssetAmountLimit.Size()
-		i -= size
-		if _, err := m.BaseAssetAmountLimit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgTransferSubaccountMargin) Reset()         { *m = MsgTransferSubaccountMargin{} }
+func (m *MsgTransferSubaccountMargin) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferSubaccountMargin) ProtoMessage()    {}
+func (*MsgTransferSubaccountMargin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28f06b306d51dcfb, []int{30}
+}
+func (m *MsgTransferSubaccountMargin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferSubaccountMargin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferSubaccountMargin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.Leverage.Size()
-		i -= size
-		if _, err := m.Leverage.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+}
+func (m *MsgTransferSubaccountMargin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferSubaccountMargin.Merge(m, src)
+}
+func (m *MsgTransferSubaccountMargin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferSubaccountMargin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferSubaccountMargin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferSubaccountMargin proto.InternalMessageInfo
+
+func (m *MsgTransferSubaccountMargin) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.QuoteAssetAmount.Size()
-		i -= size
-		if _, err := m.QuoteAssetAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.Side != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Side))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.TokenPair) > 0 {
-		i -= len(m.TokenPair)
-		copy(dAtA[i:], m.TokenPair)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenPair)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgTransferSubaccountMargin) GetFromSubaccountId() uint64 {
+	if m != nil {
+		return m.FromSubaccountId
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MsgTransferSubaccountMargin) GetToSubaccountId() uint64 {
+	if m != nil {
+		return m.ToSubaccountId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgOpenPositionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTransferSubaccountMargin) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgOpenPositionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgTransferSubaccountMarginResponse struct {
 }
 
-func (m *MsgOpenPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.PositionNotional.Size()
-		i -= size
-		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.MarginToVault.Size()
-		i -= size
-		if _, err := m.MarginToVault.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.UnrealizedPnlAfter.Size()
-		i -= size
-		if _, err := m.UnrealizedPnlAfter.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.RealizedPnl.Size()
-		i -= size
-		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.FundingPayment.Size()
-		i -= size
-		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.ExchangedPositionSize.Size()
-		i -= size
-		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.ExchangedNotionalValue.Size()
-		i -= size
-		if _, err := m.ExchangedNotionalValue.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.Position != nil {
-		{
-			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgTransferSubaccountMarginResponse) Reset()         { *m = MsgTransferSubaccountMarginResponse{} }
+func (m *MsgTransferSubaccountMarginResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferSubaccountMarginResponse) ProtoMessage()    {}
+func (*MsgTransferSubaccountMarginResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28f06b306d51dcfb, []int{31}
+}
+func (m *MsgTransferSubaccountMarginResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferSubaccountMarginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferSubaccountMarginResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgTransferSubaccountMarginResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferSubaccountMarginResponse.Merge(m, src)
+}
+func (m *MsgTransferSubaccountMarginResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferSubaccountMarginResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferSubaccountMarginResponse.DiscardUnknown(m)
 }
 
-func (m *MsgClosePosition) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgTransferSubaccountMarginResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRemoveMargin)(nil), "nibiru.perp.v1.MsgRemoveMargin")
+	proto.RegisterType((*MsgRemoveMarginResponse)(nil), "nibiru.perp.v1.MsgRemoveMarginResponse")
+	proto.RegisterType((*MsgAddMargin)(nil), "nibiru.perp.v1.MsgAddMargin")
+	proto.RegisterType((*MsgAddMarginResponse)(nil), "nibiru.perp.v1.MsgAddMarginResponse")
+	proto.RegisterType((*MsgLiquidate)(nil), "nibiru.perp.v1.MsgLiquidate")
+	proto.RegisterType((*MsgLiquidateResponse)(nil), "nibiru.perp.v1.MsgLiquidateResponse")
+	proto.RegisterType((*MsgMultiLiquidate)(nil), "nibiru.perp.v1.MsgMultiLiquidate")
+	proto.RegisterType((*MsgMultiLiquidate_MultiLiquidation)(nil), "nibiru.perp.v1.MsgMultiLiquidate.MultiLiquidation")
+	proto.RegisterType((*MsgMultiLiquidateResponse)(nil), "nibiru.perp.v1.MsgMultiLiquidateResponse")
+	proto.RegisterType((*MsgMultiLiquidateResponse_MultiLiquidateResponse)(nil), "nibiru.perp.v1.MsgMultiLiquidateResponse.MultiLiquidateResponse")
+	proto.RegisterType((*MsgOpenPosition)(nil), "nibiru.perp.v1.MsgOpenPosition")
+	proto.RegisterType((*MsgOpenPositionResponse)(nil), "nibiru.perp.v1.MsgOpenPositionResponse")
+	proto.RegisterType((*MsgClosePosition)(nil), "nibiru.perp.v1.MsgClosePosition")
+	proto.RegisterType((*MsgClosePositionResponse)(nil), "nibiru.perp.v1.MsgClosePositionResponse")
+	proto.RegisterType((*MsgDonateToEcosystemFund)(nil), "nibiru.perp.v1.MsgDonateToEcosystemFund")
+	proto.RegisterType((*MsgDonateToEcosystemFundResponse)(nil), "nibiru.perp.v1.MsgDonateToEcosystemFundResponse")
+	proto.RegisterType((*MsgPlaceOrder)(nil), "nibiru.perp.v1.MsgPlaceOrder")
+	proto.RegisterType((*MsgPlaceOrderResponse)(nil), "nibiru.perp.v1.MsgPlaceOrderResponse")
+	proto.RegisterType((*MsgCancelOrder)(nil), "nibiru.perp.v1.MsgCancelOrder")
+	proto.RegisterType((*MsgCancelOrderResponse)(nil), "nibiru.perp.v1.MsgCancelOrderResponse")
+	proto.RegisterType((*MsgSetMarginMode)(nil), "nibiru.perp.v1.MsgSetMarginMode")
+	proto.RegisterType((*MsgSetMarginModeResponse)(nil), "nibiru.perp.v1.MsgSetMarginModeResponse")
+	proto.RegisterType((*MsgSettlePosition)(nil), "nibiru.perp.v1.MsgSettlePosition")
+	proto.RegisterType((*MsgSettlePositionResponse)(nil), "nibiru.perp.v1.MsgSettlePositionResponse")
+	proto.RegisterType((*MsgDepositToInsuranceFund)(nil), "nibiru.perp.v1.MsgDepositToInsuranceFund")
+	proto.RegisterType((*MsgDepositToInsuranceFundResponse)(nil), "nibiru.perp.v1.MsgDepositToInsuranceFundResponse")
+	proto.RegisterType((*MsgRequestInsuranceFundWithdrawal)(nil), "nibiru.perp.v1.MsgRequestInsuranceFundWithdrawal")
+	proto.RegisterType((*MsgRequestInsuranceFundWithdrawalResponse)(nil), "nibiru.perp.v1.MsgRequestInsuranceFundWithdrawalResponse")
+	proto.RegisterType((*MsgWithdrawFromInsuranceFund)(nil), "nibiru.perp.v1.MsgWithdrawFromInsuranceFund")
+	proto.RegisterType((*MsgWithdrawFromInsuranceFundResponse)(nil), "nibiru.perp.v1.MsgWithdrawFromInsuranceFundResponse")
+	proto.RegisterType((*MsgSetTradingHalt)(nil), "nibiru.perp.v1.MsgSetTradingHalt")
+	proto.RegisterType((*MsgSetTradingHaltResponse)(nil), "nibiru.perp.v1.MsgSetTradingHaltResponse")
+	proto.RegisterType((*MsgTransferSubaccountMargin)(nil), "nibiru.perp.v1.MsgTransferSubaccountMargin")
+	proto.RegisterType((*MsgTransferSubaccountMarginResponse)(nil), "nibiru.perp.v1.MsgTransferSubaccountMarginResponse")
 }
 
-func (m *MsgClosePosition) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("perp/v1/tx.proto", fileDescriptor_28f06b306d51dcfb) }
+
+var fileDescriptor_28f06b306d51dcfb = []byte{
+	// 2152 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0xdc, 0xc6,
+	0x15, 0x17, 0x57, 0xeb, 0xf5, 0xea, 0x49, 0x5a, 0xc9, 0xac, 0x3e, 0x28, 0x5a, 0x5e, 0xc9, 0xe3,
+	0xaf, 0x55, 0x93, 0xec, 0x5a, 0x4a, 0x50, 0x37, 0x2d, 0x5a, 0xd4, 0xb2, 0x13, 0x48, 0xad, 0xd7,
+	0x56, 0x29, 0xc1, 0x29, 0x92, 0x14, 0x2c, 0xb5, 0x1c, 0x51, 0x84, 0xb9, 0x9c, 0x35, 0x39, 0x2b,
+	0x5b, 0x46, 0x0e, 0x6d, 0xfa, 0x71, 0x6a, 0x81, 0x00, 0x3d, 0xf6, 0xd6, 0xa2, 0x41, 0x50, 0xa0,
+	0x97, 0x02, 0xbd, 0xf7, 0x14, 0xe4, 0x54, 0x04, 0xe8, 0xa5, 0xe8, 0xc1, 0x29, 0xec, 0x1c, 0x7a,
+	0xe8, 0xa9, 0xff, 0x40, 0x8b, 0x19, 0x92, 0xb3, 0xe4, 0x2e, 0x77, 0x97, 0x5a, 0x47, 0x45, 0x4e,
+	0xbb, 0xe4, 0xbc, 0xf7, 0x7b, 0xbf, 0x99, 0xf7, 0xc1, 0x37, 0x0f, 0x66, 0x5b, 0xd8, 0x6b, 0xd5,
+	0x8e, 0xd6, 0x6b, 0xf4, 0x71, 0xb5, 0xe5, 0x11, 0x4a, 0xe4, 0x92, 0x6b, 0xef, 0xdb, 0x5e, 0xbb,
+	0xca, 0x16, 0xaa, 0x47, 0xeb, 0xea, 0xb2, 0x45, 0x88, 0xe5, 0xe0, 0x9a, 0xd1, 0xb2, 0x6b, 0x86,
+	0xeb, 0x12, 0x6a, 0x50, 0x9b, 0xb8, 0x7e, 0x20, 0xad, 0x96, 0x1b, 0xc4, 0x6f, 0x12, 0xbf, 0xb6,
+	0x6f, 0xf8, 0xb8, 0x76, 0xb4, 0xbe, 0x8f, 0xa9, 0xb1, 0x5e, 0x6b, 0x10, 0xdb, 0x0d, 0xd7, 0xe7,
+	0x2c, 0x62, 0x11, 0xfe, 0xb7, 0xc6, 0xfe, 0x85, 0x6f, 0x57, 0x42, 0x4c, 0xfe, 0xb4, 0xdf, 0x3e,
+	0xa8, 0x51, 0xbb, 0x89, 0x7d, 0x6a, 0x34, 0x5b, 0xa1, 0xc0, 0x57, 0x22, 0x5a, 0x3e, 0x35, 0x28,
+	0x0e, 0x5e, 0xa2, 0x0f, 0x25, 0x98, 0xa9, 0xfb, 0x96, 0x86, 0x9b, 0xe4, 0x08, 0xd7, 0x0d, 0xcf,
+	0xb2, 0x5d, 0x79, 0x01, 0x0a, 0x3e, 0x76, 0x4d, 0xec, 0x29, 0xd2, 0xaa, 0x54, 0x99, 0xd0, 0xc2,
+	0x27, 0xf9, 0x02, 0x00, 0x25, 0x0f, 0xb0, 0xab, 0xb7, 0x0c, 0xdb, 0x53, 0x72, 0x7c, 0x6d, 0x82,
+	0xbf, 0xd9, 0x31, 0x6c, 0x4f, 0xbe, 0x01, 0x85, 0x26, 0x07, 0x50, 0xc6, 0x57, 0xa5, 0xca, 0xe4,
+	0xc6, 0x52, 0x35, 0xd8, 0x47, 0x95, 0xed, 0xa3, 0x1a, 0xee, 0xa3, 0x7a, 0x8b, 0xd8, 0xee, 0x66,
+	0xfe, 0x93, 0xa7, 0x2b, 0x63, 0x5a, 0x28, 0x2e, 0x5f, 0x82, 0x69, 0xbf, 0xbd, 0x6f, 0x34, 0x1a,
+	0xa4, 0xed, 0x52, 0xdd, 0x36, 0x95, 0xfc, 0xaa, 0x54, 0xc9, 0x6b, 0x53, 0x9d, 0x97, 0xdb, 0x26,
+	0xfa, 0x97, 0x04, 0x8b, 0x5d, 0x44, 0x35, 0xec, 0xb7, 0x88, 0xeb, 0x63, 0xf9, 0xdb, 0x00, 0x01,
+	0x94, 0x4e, 0xda, 0x54, 0x91, 0xb2, 0x59, 0x9f, 0x08, 0x54, 0xee, 0xb5, 0xa9, 0xfc, 0x16, 0xcc,
+	0x1c, 0xb4, 0x5d, 0xd3, 0x76, 0x2d, 0xbd, 0x65, 0x1c, 0x37, 0xb1, 0x4b, 0x83, 0xdd, 0x6d, 0x56,
+	0x99, 0xe4, 0x3f, 0x9e, 0xae, 0x5c, 0xb5, 0x6c, 0x7a, 0xd8, 0xde, 0xaf, 0x36, 0x48, 0xb3, 0x16,
+	0x3a, 0x27, 0xf8, 0x79, 0xc5, 0x37, 0x1f, 0xd4, 0xe8, 0x71, 0x0b, 0xfb, 0xd5, 0xdb, 0xb8, 0xa1,
+	0x95, 0x42, 0x98, 0x9d, 0x00, 0x45, 0x7e, 0x0d, 0x8a, 0x2d, 0xe2, 0xdb, 0xcc, 0xb9, 0xe1, 0xa1,
+	0x28, 0xd5, 0x64, 0x28, 0x54, 0x77, 0xc2, 0x75, 0x4d, 0x48, 0xa2, 0xdf, 0x49, 0x30, 0x55, 0xf7,
+	0xad, 0x9b, 0xa6, 0xf9, 0x65, 0x76, 0xc8, 0xef, 0x25, 0x98, 0x8b, 0xb3, 0x14, 0xde, 0x48, 0x39,
+	0x4d, 0xe9, 0x0b, 0x3f, 0xcd, 0x5c, 0xe6, 0xd3, 0xfc, 0x21, 0x3f, 0xcc, 0x3b, 0xf6, 0xc3, 0xb6,
+	0x6d, 0x1a, 0x14, 0x8f, 0x7a, 0x98, 0x0b, 0x50, 0xa0, 0x9e, 0xc1, 0xd4, 0xc6, 0x03, 0xb5, 0xe0,
+	0x09, 0xfd, 0x25, 0x38, 0x06, 0x81, 0x2f, 0x8e, 0xe1, 0x7b, 0x70, 0xee, 0x00, 0x63, 0x9d, 0x12,
+	0xdd, 0x09, 0xd7, 0x88, 0x97, 0x35, 0x36, 0x67, 0x0e, 0x30, 0xde, 0x23, 0x77, 0x84, 0x9e, 0xfc,
+	0x0e, 0xa8, 0x21, 0x18, 0xdb, 0xa9, 0x8e, 0x1b, 0xc4, 0x3f, 0xf6, 0x29, 0x6e, 0xea, 0xec, 0x88,
+	0x94, 0x5c, 0x36, 0xd4, 0x05, 0x8e, 0xba, 0x83, 0xbd, 0xd6, 0x1b, 0x91, 0xfe, 0x9b, 0x6d, 0xd7,
+	0x44, 0x7f, 0x95, 0xe0, 0x5c, 0xdd, 0xb7, 0xea, 0x6d, 0x87, 0xda, 0xc3, 0xcf, 0xe9, 0x3e, 0x4c,
+	0x45, 0x1b, 0xb2, 0x89, 0xeb, 0x2b, 0xb9, 0xd5, 0xf1, 0xca, 0xe4, 0xc6, 0x46, 0xb7, 0x27, 0x7a,
+	0x00, 0xab, 0x89, 0x47, 0xe6, 0xa3, 0x04, 0x8e, 0xba, 0x0d, 0xb3, 0xdd, 0x12, 0xa3, 0xfa, 0xe4,
+	0x37, 0x39, 0x58, 0xea, 0xb1, 0x2f, 0x1c, 0xd3, 0x86, 0xf9, 0x98, 0x61, 0xdd, 0x0b, 0xdf, 0xfb,
+	0x8a, 0xc4, 0x77, 0xf2, 0x9d, 0xa1, 0x3b, 0x89, 0x90, 0xaa, 0xe9, 0xaf, 0xb5, 0xb9, 0x18, 0x7c,
+	0xf4, 0xd2, 0x57, 0x7f, 0x21, 0xc1, 0x42, 0x1f, 0x46, 0x0b, 0x70, 0x06, 0x7b, 0x5e, 0x18, 0x1e,
+	0x13, 0x5b, 0x63, 0x5a, 0xf0, 0x28, 0x6f, 0xc1, 0x64, 0x0c, 0x2a, 0x74, 0xf3, 0xe5, 0x14, 0x7e,
+	0x3d, 0x90, 0x5b, 0x63, 0x5a, 0x5c, 0x75, 0x13, 0xa0, 0x18, 0xed, 0x13, 0x7d, 0x34, 0xce, 0x4b,
+	0xfe, 0xbd, 0x16, 0x76, 0xa3, 0x74, 0x19, 0x35, 0x29, 0x2a, 0x90, 0xf7, 0x6d, 0x13, 0xf3, 0xe3,
+	0x2f, 0x6d, 0xcc, 0x75, 0x33, 0xdb, 0xb5, 0x4d, 0xac, 0x71, 0x09, 0xf9, 0x5d, 0x90, 0x1f, 0xb6,
+	0x09, 0xc5, 0xba, 0xe1, 0xfb, 0x98, 0xea, 0x46, 0x93, 0x55, 0x11, 0x25, 0x7f, 0xe2, 0xba, 0xb0,
+	0xed, 0x52, 0x6d, 0x96, 0x23, 0xdd, 0x64, 0x40, 0x37, 0x39, 0x8e, 0xfc, 0x5d, 0x28, 0x3a, 0xf8,
+	0x08, 0x7b, 0x86, 0x85, 0x95, 0x33, 0x23, 0xd5, 0x1a, 0xa1, 0x2f, 0x63, 0x58, 0x64, 0x09, 0x94,
+	0x20, 0xaa, 0x3b, 0x76, 0xd3, 0xa6, 0x4a, 0x61, 0x24, 0xba, 0x73, 0x0c, 0x2e, 0xc6, 0xf6, 0x0e,
+	0xc3, 0xea, 0xad, 0xb1, 0x67, 0x53, 0x6a, 0xec, 0xe7, 0x67, 0x60, 0xb1, 0xcb, 0x55, 0x22, 0x68,
+	0xe2, 0xd5, 0x50, 0xca, 0x5a, 0x0d, 0xe5, 0x43, 0x50, 0xf0, 0xe3, 0xc6, 0xa1, 0xe1, 0x5a, 0xd8,
+	0xd4, 0x5d, 0xc2, 0xde, 0x19, 0x8e, 0x7e, 0x64, 0x38, 0x6d, 0x3c, 0xe2, 0x37, 0x6f, 0x41, 0xe0,
+	0xdd, 0x0d, 0xe1, 0xee, 0x33, 0x34, 0xf9, 0x00, 0x16, 0x3b, 0x96, 0x22, 0xfb, 0xba, 0x6f, 0x3f,
+	0x09, 0xc2, 0xe5, 0xe4, 0x86, 0xe6, 0x05, 0x5c, 0xb4, 0xaf, 0x5d, 0xfb, 0x49, 0xea, 0xe7, 0x26,
+	0xff, 0x85, 0x7c, 0x6e, 0xbe, 0x0f, 0x53, 0x1e, 0x36, 0x1c, 0xfb, 0x09, 0xe3, 0xef, 0x3a, 0x23,
+	0x06, 0xd6, 0x64, 0x84, 0xb1, 0xe3, 0x3a, 0xf2, 0x8f, 0x60, 0xae, 0xed, 0xc6, 0x41, 0x75, 0xe3,
+	0x80, 0x62, 0x4f, 0x29, 0x8c, 0x04, 0x2d, 0x77, 0xb0, 0x76, 0x5c, 0xe7, 0x26, 0x43, 0x92, 0xef,
+	0xc3, 0x4c, 0xd8, 0x0a, 0x51, 0xa2, 0x1f, 0x19, 0x6d, 0x87, 0x2a, 0x67, 0x47, 0x02, 0x9f, 0x0e,
+	0x60, 0xf6, 0xc8, 0x7d, 0x06, 0x22, 0xbf, 0x03, 0xe7, 0x84, 0x0f, 0xa3, 0xb0, 0x51, 0x8a, 0x23,
+	0x21, 0xcf, 0x46, 0x40, 0x51, 0xbc, 0xa0, 0xff, 0xe6, 0x60, 0xb6, 0xee, 0x5b, 0xb7, 0x1c, 0xe2,
+	0xe3, 0x17, 0x2d, 0x49, 0x6f, 0xc3, 0xb9, 0x9e, 0xf4, 0x1d, 0x31, 0xe0, 0x66, 0xba, 0x12, 0x97,
+	0x95, 0x99, 0x03, 0xcf, 0x68, 0xf0, 0x94, 0x1b, 0x2d, 0xc6, 0x84, 0xbe, 0x6c, 0x81, 0xd2, 0x5b,
+	0x10, 0xc3, 0x3a, 0x33, 0x5a, 0xa4, 0xcd, 0x77, 0x97, 0xc5, 0x3e, 0x85, 0xa6, 0x90, 0x52, 0x68,
+	0x3e, 0xca, 0x83, 0xd2, 0xed, 0x01, 0x51, 0x69, 0x06, 0xd5, 0x0c, 0xe9, 0xff, 0x55, 0x33, 0x72,
+	0xa7, 0x5c, 0x33, 0xc6, 0x4f, 0xa5, 0x66, 0xe4, 0x5f, 0xbc, 0x66, 0xfc, 0x00, 0x66, 0x3b, 0x19,
+	0x1d, 0xb6, 0x3b, 0xa3, 0xa5, 0x74, 0x29, 0x4a, 0xe9, 0x3d, 0x8e, 0x92, 0xf8, 0x82, 0x14, 0x33,
+	0xf7, 0xd3, 0xef, 0x4b, 0x3c, 0x54, 0x6e, 0x13, 0xd7, 0xa0, 0x78, 0x8f, 0x24, 0x5a, 0xc9, 0xbe,
+	0x49, 0x7b, 0x17, 0x8a, 0x26, 0x53, 0xe8, 0xb4, 0x31, 0x03, 0xba, 0xd5, 0x45, 0xb6, 0xaf, 0xff,
+	0x3c, 0x5d, 0x99, 0x39, 0x36, 0x9a, 0xce, 0x37, 0x50, 0xa4, 0x88, 0x34, 0x81, 0x81, 0x10, 0xac,
+	0xf6, 0xe3, 0x10, 0x85, 0x2d, 0xfa, 0x73, 0x1e, 0xa6, 0xeb, 0xbe, 0xb5, 0xe3, 0x18, 0x0d, 0x7c,
+	0xcf, 0x63, 0x2c, 0x46, 0x2c, 0x29, 0x5f, 0x07, 0x20, 0x4c, 0x5f, 0x67, 0x47, 0x19, 0xf6, 0x3a,
+	0x4b, 0xdd, 0x27, 0xc5, 0x2d, 0xec, 0x1d, 0xb7, 0xb0, 0x36, 0x41, 0xa2, 0xbf, 0xa2, 0x3f, 0xca,
+	0x0f, 0xed, 0x8f, 0x76, 0x61, 0x9a, 0x7a, 0xb6, 0x65, 0x61, 0x4f, 0x6f, 0x79, 0x76, 0x63, 0xd4,
+	0x36, 0x66, 0x2a, 0x04, 0xd9, 0x61, 0x18, 0x7d, 0x9a, 0xae, 0xc2, 0x29, 0x34, 0x5d, 0x67, 0x4f,
+	0xaf, 0xe9, 0x2a, 0x9e, 0x66, 0xd3, 0x35, 0x91, 0x52, 0x0b, 0x37, 0x60, 0x3e, 0x11, 0x36, 0xa2,
+	0x0e, 0x2e, 0x41, 0x31, 0x88, 0x03, 0xdb, 0xe4, 0x01, 0x94, 0xd7, 0xce, 0xf2, 0xe7, 0x6d, 0x13,
+	0x1d, 0x42, 0x89, 0x95, 0x4f, 0xc3, 0x6d, 0x60, 0x67, 0x70, 0xac, 0xc5, 0x41, 0x72, 0x09, 0x90,
+	0x5e, 0x76, 0xe3, 0x29, 0xec, 0xf6, 0x61, 0x21, 0x69, 0x49, 0xd0, 0xdb, 0x82, 0x19, 0x0f, 0xb3,
+	0x7a, 0x84, 0x4d, 0x3d, 0xbc, 0xf7, 0x67, 0xbc, 0x6e, 0x96, 0x22, 0xbd, 0xe0, 0x26, 0x8f, 0x7e,
+	0x29, 0xf1, 0xef, 0xf1, 0x2e, 0xa6, 0xc1, 0x8b, 0x3a, 0x31, 0xfb, 0xdf, 0x07, 0xbf, 0x09, 0x93,
+	0x61, 0x7d, 0x6a, 0x12, 0x33, 0xa8, 0xd3, 0xa5, 0x0d, 0xb5, 0xe7, 0x92, 0x22, 0x80, 0x34, 0x68,
+	0x8a, 0xff, 0xd9, 0xb6, 0xac, 0x82, 0xd2, 0xcd, 0x46, 0x24, 0x39, 0xe1, 0x57, 0xd7, 0x5d, 0x4c,
+	0xa9, 0xf3, 0xc2, 0xad, 0x43, 0x26, 0x32, 0xbf, 0x92, 0x60, 0xa9, 0xc7, 0xa2, 0xf0, 0x41, 0x0b,
+	0xa6, 0x7d, 0xbe, 0x62, 0xea, 0x0d, 0x62, 0xbb, 0xd1, 0x9d, 0x72, 0x80, 0x07, 0xae, 0x33, 0x0f,
+	0xfc, 0xe1, 0xb3, 0x95, 0x4a, 0x86, 0xc0, 0x66, 0x0a, 0xbe, 0x36, 0x15, 0x5a, 0xe0, 0x4f, 0xc8,
+	0xe5, 0x74, 0x6e, 0x63, 0x5e, 0x9f, 0xf7, 0xc8, 0xb6, 0xeb, 0xb7, 0x3d, 0x16, 0x1c, 0x03, 0xcb,
+	0xf1, 0xeb, 0x70, 0xd6, 0x0c, 0x34, 0xb2, 0xce, 0x0e, 0x22, 0x79, 0xf4, 0x2e, 0x5c, 0xec, 0x6b,
+	0x4f, 0x1c, 0xc3, 0x0d, 0x28, 0xf8, 0x87, 0x86, 0xc7, 0xef, 0xd4, 0xd9, 0x26, 0x4f, 0x81, 0x38,
+	0xa2, 0x1c, 0x5d, 0xc3, 0x0f, 0xdb, 0xd8, 0xa7, 0x09, 0xec, 0xb7, 0x6c, 0x7a, 0x68, 0x7a, 0xc6,
+	0x23, 0xc3, 0xe9, 0xbb, 0xab, 0x8e, 0xd5, 0xdc, 0xc9, 0xac, 0x7a, 0xb0, 0x36, 0xd4, 0xaa, 0xd8,
+	0xdb, 0x1b, 0x30, 0xd9, 0x76, 0x1d, 0xd2, 0x78, 0xa0, 0xb3, 0x01, 0x6b, 0xb8, 0x41, 0xb5, 0x1a,
+	0x4c, 0x5f, 0xab, 0xd1, 0xf4, 0xb5, 0xba, 0x17, 0x4d, 0x5f, 0x37, 0x8b, 0xcc, 0xd6, 0x07, 0x9f,
+	0xad, 0x48, 0x1a, 0x04, 0x8a, 0x6c, 0x09, 0xdd, 0x81, 0xe5, 0xba, 0x6f, 0x45, 0xf8, 0x6f, 0x7a,
+	0xa4, 0x99, 0xcd, 0x75, 0x73, 0x70, 0xc6, 0xc4, 0x2e, 0x69, 0x86, 0xe1, 0x1b, 0x3c, 0x20, 0x0c,
+	0x97, 0x07, 0xa1, 0x09, 0xf2, 0xdf, 0x82, 0x89, 0x47, 0xa1, 0x50, 0xe6, 0xea, 0xd0, 0xd1, 0x40,
+	0xfb, 0x51, 0xb6, 0xb1, 0x0e, 0xc2, 0x76, 0xad, 0x2d, 0xc3, 0xa1, 0x2f, 0x30, 0x50, 0x3b, 0x34,
+	0x1c, 0x8a, 0x83, 0x34, 0x2b, 0x6a, 0xe1, 0x13, 0x3a, 0x0f, 0x4b, 0x3d, 0x36, 0x44, 0xba, 0x7f,
+	0x2c, 0xc1, 0xf9, 0xba, 0x6f, 0xed, 0x79, 0x86, 0xeb, 0x1f, 0x60, 0x6f, 0x57, 0x64, 0xe6, 0x90,
+	0x49, 0xe9, 0xcb, 0x20, 0x1f, 0x78, 0xa4, 0xa9, 0x27, 0xf3, 0x3b, 0xa8, 0xbf, 0xb3, 0x6c, 0x65,
+	0x37, 0x96, 0xe3, 0x72, 0x05, 0x66, 0x29, 0xd1, 0xd3, 0x6a, 0x41, 0x89, 0x92, 0x84, 0xe4, 0x0d,
+	0x28, 0xc4, 0x46, 0x19, 0x59, 0x42, 0x2e, 0x10, 0x47, 0x57, 0xe0, 0xd2, 0x80, 0x7d, 0x44, 0xfb,
+	0xdd, 0xf8, 0xf7, 0x39, 0x18, 0xaf, 0xfb, 0x96, 0xfc, 0x1e, 0x4c, 0x25, 0x46, 0xf4, 0x2b, 0x29,
+	0x43, 0xa0, 0xb8, 0x80, 0x7a, 0x6d, 0x88, 0x80, 0x38, 0x51, 0xf4, 0xfe, 0xdf, 0x3e, 0xff, 0x75,
+	0x6e, 0x19, 0xa9, 0xb5, 0x40, 0xa1, 0xc6, 0x14, 0x6a, 0x1e, 0x17, 0x0d, 0x3f, 0x23, 0x72, 0x0b,
+	0x26, 0x3a, 0xc3, 0xe8, 0xe5, 0x14, 0x64, 0xb1, 0xaa, 0x5e, 0x1e, 0xb4, 0x2a, 0x8c, 0xae, 0x70,
+	0xa3, 0x4b, 0x68, 0x31, 0x61, 0xd4, 0x30, 0xa3, 0x0f, 0x97, 0x4c, 0x60, 0xa2, 0x33, 0x89, 0x5c,
+	0x1e, 0x34, 0xf1, 0x52, 0x33, 0xcd, 0xc3, 0x50, 0x99, 0x5b, 0x54, 0xd0, 0x42, 0xc2, 0xa2, 0x23,
+	0x6c, 0xfc, 0x54, 0x82, 0x52, 0xd7, 0x00, 0xf4, 0xe2, 0xd0, 0x41, 0xa0, 0xba, 0x96, 0x79, 0x56,
+	0x88, 0x2e, 0x71, 0x02, 0x17, 0xd0, 0xf9, 0x04, 0x81, 0x26, 0x13, 0xee, 0xb0, 0x78, 0x0f, 0xa6,
+	0x12, 0x63, 0xb9, 0x34, 0x37, 0xc7, 0x05, 0xd4, 0x6b, 0x43, 0x04, 0x86, 0xb8, 0x99, 0xb4, 0x58,
+	0x7a, 0x46, 0xd6, 0x7e, 0x2c, 0xc1, 0x74, 0xf2, 0x0e, 0xbe, 0x9a, 0x02, 0x9f, 0x90, 0x50, 0x2b,
+	0xc3, 0x24, 0x86, 0x1c, 0x40, 0x83, 0xc9, 0x76, 0x28, 0xfc, 0x56, 0x82, 0xf9, 0xf4, 0x9b, 0x45,
+	0x9a, 0xa1, 0x54, 0x49, 0xf5, 0x7a, 0x56, 0x49, 0x41, 0xed, 0x15, 0x4e, 0xed, 0x1a, 0xba, 0x92,
+	0xa0, 0xc6, 0x2f, 0x1b, 0x7c, 0xe6, 0x9e, 0x1c, 0xb7, 0xcb, 0x14, 0x20, 0x76, 0xa9, 0xb8, 0x90,
+	0x62, 0xae, 0xb3, 0xac, 0x5e, 0x19, 0xb8, 0x2c, 0x28, 0xac, 0x72, 0x0a, 0x2a, 0x52, 0x12, 0x14,
+	0x5a, 0x4c, 0x50, 0xe7, 0xfd, 0xa1, 0xfc, 0x18, 0x26, 0xe3, 0xfd, 0x65, 0x39, 0xed, 0xe0, 0x3b,
+	0xeb, 0xea, 0xd5, 0xc1, 0xeb, 0xc2, 0xf0, 0x45, 0x6e, 0xf8, 0x3c, 0x5a, 0x4a, 0xba, 0x85, 0x4b,
+	0x86, 0x96, 0x7f, 0x22, 0xc1, 0x74, 0xb2, 0x17, 0x4c, 0x8b, 0x8b, 0x84, 0x84, 0x5a, 0x19, 0x26,
+	0x21, 0x08, 0x5c, 0xe6, 0x04, 0xca, 0x68, 0x39, 0x41, 0x80, 0x5d, 0x03, 0x62, 0x6d, 0xa5, 0xfc,
+	0x33, 0x09, 0x4a, 0x5d, 0x5d, 0xde, 0xc5, 0x74, 0x13, 0x31, 0x11, 0x75, 0x6d, 0xa8, 0xc8, 0x70,
+	0x1a, 0xd4, 0x89, 0xc5, 0xe7, 0x87, 0x12, 0x2c, 0xf4, 0xe9, 0xb5, 0xd2, 0x6c, 0xa5, 0x8b, 0xaa,
+	0xeb, 0x99, 0x45, 0x05, 0xbd, 0x2a, 0xa7, 0x57, 0x41, 0x57, 0x93, 0x21, 0x1a, 0x28, 0xb1, 0x18,
+	0xb5, 0x23, 0xb5, 0x20, 0x46, 0x3f, 0x96, 0xa0, 0x3c, 0xa4, 0x8d, 0x5a, 0x4f, 0xfd, 0x44, 0x0c,
+	0x52, 0x51, 0x5f, 0x3f, 0xb1, 0x8a, 0xd8, 0xc0, 0xd7, 0xf8, 0x06, 0xae, 0xa3, 0x6a, 0xd7, 0x77,
+	0x86, 0x2b, 0x77, 0xb1, 0xd7, 0x1f, 0x75, 0x58, 0xfe, 0x49, 0x82, 0xa5, 0xfe, 0x5d, 0xd2, 0xcb,
+	0x29, 0x84, 0xfa, 0x4a, 0xab, 0xaf, 0x9d, 0x44, 0x5a, 0x30, 0x5f, 0xe7, 0xcc, 0x5f, 0x42, 0x6b,
+	0x09, 0xe6, 0x11, 0x45, 0x9d, 0xf7, 0x15, 0x5d, 0xa7, 0xff, 0xf3, 0x20, 0x5a, 0xe3, 0x5d, 0x52,
+	0x9f, 0x68, 0x8d, 0x89, 0xa8, 0x6b, 0x43, 0x45, 0x04, 0xa7, 0x2b, 0x9c, 0xd3, 0x0a, 0xba, 0xd0,
+	0x93, 0x34, 0x34, 0x90, 0xd6, 0x59, 0x37, 0x25, 0xff, 0x51, 0x02, 0xa5, 0x6f, 0xaf, 0xf4, 0x52,
+	0x8a, 0xb9, 0x7e, 0xc2, 0xea, 0xab, 0x27, 0x10, 0x16, 0x2c, 0x6b, 0x9c, 0xe5, 0x1a, 0xba, 0x96,
+	0x60, 0x49, 0x43, 0xb5, 0x78, 0x83, 0x15, 0xa4, 0xfa, 0xe6, 0xed, 0x4f, 0x9e, 0x95, 0xa5, 0x4f,
+	0x9f, 0x95, 0xa5, 0x7f, 0x3e, 0x2b, 0x4b, 0x1f, 0x3c, 0x2f, 0x8f, 0x7d, 0xfa, 0xbc, 0x3c, 0xf6,
+	0xf7, 0xe7, 0xe5, 0xb1, 0xb7, 0xbf, 0x1a, 0xbb, 0x1e, 0xdd, 0xe5, 0x60, 0xb7, 0x0e, 0x0d, 0xdb,
+	0x8d, 0x80, 0x1f, 0x87, 0xd0, 0xec, 0x9a, 0xb4, 0x5f, 0xe0, 0x4d, 0xf8, 0xab, 0xff, 0x1b, 0x00,
+	0x9b, 0x94, 0xe8, 0xe2, 0x88, 0x21, 0x00, 0x00,
 }
 
-func (m *MsgClosePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenPair) > 0 {
-		i -= len(m.TokenPair)
-		copy(dAtA[i:], m.TokenPair)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenPair)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	RemoveMargin(ctx context.Context, in *MsgRemoveMargin, opts ...grpc.CallOption) (*MsgRemoveMarginResponse, error)
+	AddMargin(ctx context.Context, in *MsgAddMargin, opts ...grpc.CallOption) (*MsgAddMarginResponse, error)
+	// Liquidate is a transaction that allows the caller to fully or partially
+	// liquidate an existing position.
+	Liquidate(ctx context.Context, in *MsgLiquidate, opts ...grpc.CallOption) (*MsgLiquidateResponse, error)
+	MultiLiquidate(ctx context.Context, in *MsgMultiLiquidate, opts ...grpc.CallOption) (*MsgMultiLiquidateResponse, error)
+	OpenPosition(ctx context.Context, in *MsgOpenPosition, opts ...grpc.CallOption) (*MsgOpenPositionResponse, error)
+	ClosePosition(ctx context.Context, in *MsgClosePosition, opts ...grpc.CallOption) (*MsgClosePositionResponse, error)
+	DonateToEcosystemFund(ctx context.Context, in *MsgDonateToEcosystemFund, opts ...grpc.CallOption) (*MsgDonateToEcosystemFundResponse, error)
+	// PlaceOrder places a resting limit, stop-loss or take-profit order that is
+	// executed once the mark price crosses its trigger price.
+	PlaceOrder(ctx context.Context, in *MsgPlaceOrder, opts ...grpc.CallOption) (*MsgPlaceOrderResponse, error)
+	CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error)
+	// SetMarginMode switches the trader between isolated and cross margin.
+	// Switching back to isolated margin requires every open position to meet its
+	// own maintenance margin ratio.
+	SetMarginMode(ctx context.Context, in *MsgSetMarginMode, opts ...grpc.CallOption) (*MsgSetMarginModeResponse, error)
+	// SettlePosition settles the position of the trader on a pair whose vpool
+	// has been shut down, at the settlement price of the vpool.
+	SettlePosition(ctx context.Context, in *MsgSettlePosition, opts ...grpc.CallOption) (*MsgSettlePositionResponse, error)
+	// DepositToInsuranceFund deposits quote tokens into the insurance fund of
+	// their denom, in exchange for shares of the fund.
+	DepositToInsuranceFund(ctx context.Context, in *MsgDepositToInsuranceFund, opts ...grpc.CallOption) (*MsgDepositToInsuranceFundResponse, error)
+	// RequestInsuranceFundWithdrawal escrows insurance fund shares and starts
+	// their withdrawal cooldown.
+	RequestInsuranceFundWithdrawal(ctx context.Context, in *MsgRequestInsuranceFundWithdrawal, opts ...grpc.CallOption) (*MsgRequestInsuranceFundWithdrawalResponse, error)
+	// WithdrawFromInsuranceFund redeems the escrowed shares of a withdrawal whose
+	// cooldown is over, at the current value of the shares.
+	WithdrawFromInsuranceFund(ctx context.Context, in *MsgWithdrawFromInsuranceFund, opts ...grpc.CallOption) (*MsgWithdrawFromInsuranceFundResponse, error)
+	// SetTradingHalt halts or resumes trading on a pair, or on every pair if no
+	// pair is given. Only the guardian of the params can send it.
+	SetTradingHalt(ctx context.Context, in *MsgSetTradingHalt, opts ...grpc.CallOption) (*MsgSetTradingHaltResponse, error)
+	// TransferSubaccountMargin moves quote tokens between two subaccounts of the
+	// sender. Subaccount 0 is the account of the sender itself.
+	TransferSubaccountMargin(ctx context.Context, in *MsgTransferSubaccountMargin, opts ...grpc.CallOption) (*MsgTransferSubaccountMarginResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RemoveMargin(ctx context.Context, in *MsgRemoveMargin, opts ...grpc.CallOption) (*MsgRemoveMarginResponse, error) {
+	out := new(MsgRemoveMarginResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Msg/RemoveMargin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgClosePositionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddMargin(ctx context.Context, in *MsgAddMargin, opts ...grpc.CallOption) (*MsgAddMarginResponse, error) {
+	out := new(MsgAddMarginResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Msg/AddMargin", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgClosePositionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Liquidate(ctx context.Context, in *MsgLiquidate, opts ...grpc.CallOption) (*MsgLiquidateResponse, error) {
+	out := new(MsgLiquidateResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Msg/Liquidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgClosePositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MarginToTrader.Size()
-		i -= size
-		if _, err := m.MarginToTrader.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.RealizedPnl.Size()
-		i -= size
-		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.FundingPayment.Size()
-		i -= size
-		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.ExchangedPositionSize.Size()
-		i -= size
-		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.ExchangedNotionalValue.Size()
-		i -= size
-		if _, err := m.ExchangedNotionalValue.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) MultiLiquidate(ctx context.Context, in *MsgMultiLiquidate, opts ...grpc.CallOption) (*MsgMultiLiquidateResponse, error) {
+	out := new(MsgMultiLiquidateResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Msg/MultiLiquidate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDonateToEcosystemFund) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) OpenPosition(ctx context.Context, in *MsgOpenPosition, opts ...grpc.CallOption) (*MsgOpenPositionResponse, error) {
+	out := new(MsgOpenPositionResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Msg/OpenPosition", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDonateToEcosystemFund) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgDonateToEcosystemFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Donation.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) ClosePosition(ctx context.Context, in *MsgClosePosition, opts ...grpc.CallOption) (*MsgClosePositionResponse, error) {
+	out := new(MsgClosePositionResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Msg/ClosePosition", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDonateToEcosystemFundResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DonateToEcosystemFund(ctx context.Context, in *MsgDonateToEcosystemFund, opts ...grpc.CallOption) (*MsgDonateToEcosystemFundResponse, error) {
+	out := new(MsgDonateToEcosystemFundResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Msg/DonateToEcosystemFund", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDonateToEcosystemFundResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) PlaceOrder(ctx context.Context, in *MsgPlaceOrder, opts ...grpc.CallOption) (*MsgPlaceOrderResponse, error) {
+	out := new(MsgPlaceOrderResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Msg/PlaceOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDonateToEcosystemFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error) {
+	out := new(MsgCancelOrderResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Msg/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) SetMarginMode(ctx context.Context, in *MsgSetMarginMode, opts ...grpc.CallOption) (*MsgSetMarginModeResponse, error) {
+	out := new(MsgSetMarginModeResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Msg/SetMarginMode", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgRemoveMargin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TokenPair)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+func (c *msgClient) SettlePosition(ctx context.Context, in *MsgSettlePosition, opts ...grpc.CallOption) (*MsgSettlePositionResponse, error) {
+	out := new(MsgSettlePositionResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Msg/SettlePosition", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Margin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgRemoveMarginResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.MarginOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.FundingPayment.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Position != nil {
-		l = m.Position.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) DepositToInsuranceFund(ctx context.Context, in *MsgDepositToInsuranceFund, opts ...grpc.CallOption) (*MsgDepositToInsuranceFundResponse, error) {
+	out := new(MsgDepositToInsuranceFundResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Msg/DepositToInsuranceFund", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgAddMargin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TokenPair)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) RequestInsuranceFundWithdrawal(ctx context.Context, in *MsgRequestInsuranceFundWithdrawal, opts ...grpc.CallOption) (*MsgRequestInsuranceFundWithdrawalResponse, error) {
+	out := new(MsgRequestInsuranceFundWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Msg/RequestInsuranceFundWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Margin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgAddMarginResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.FundingPayment.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Position != nil {
-		l = m.Position.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) WithdrawFromInsuranceFund(ctx context.Context, in *MsgWithdrawFromInsuranceFund, opts ...grpc.CallOption) (*MsgWithdrawFromInsuranceFundResponse, error) {
+	out := new(MsgWithdrawFromInsuranceFundResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Msg/WithdrawFromInsuranceFund", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgLiquidate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TokenPair)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Trader)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) SetTradingHalt(ctx context.Context, in *MsgSetTradingHalt, opts ...grpc.CallOption) (*MsgSetTradingHaltResponse, error) {
+	out := new(MsgSetTradingHaltResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Msg/SetTradingHalt", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgLiquidateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) TransferSubaccountMargin(ctx context.Context, in *MsgTransferSubaccountMargin, opts ...grpc.CallOption) (*MsgTransferSubaccountMarginResponse, error) {
+	out := new(MsgTransferSubaccountMarginResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Msg/TransferSubaccountMargin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.FeeToLiquidator.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.FeeToPerpEcosystemFund.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgMultiLiquidate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Liquidations) > 0 {
-		for _, e := range m.Liquidations {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	RemoveMargin(context.Context, *MsgRemoveMargin) (*MsgRemoveMarginResponse, error)
+	AddMargin(context.Context, *MsgAddMargin) (*MsgAddMarginResponse, error)
+	// Liquidate is a transaction that allows the caller to fully or partially
+	// liquidate an existing position.
+	Liquidate(context.Context, *MsgLiquidate) (*MsgLiquidateResponse, error)
+	MultiLiquidate(context.Context, *MsgMultiLiquidate) (*MsgMultiLiquidateResponse, error)
+	OpenPosition(context.Context, *MsgOpenPosition) (*MsgOpenPositionResponse, error)
+	ClosePosition(context.Context, *MsgClosePosition) (*MsgClosePositionResponse, error)
+	DonateToEcosystemFund(context.Context, *MsgDonateToEcosystemFund) (*MsgDonateToEcosystemFundResponse, error)
+	// PlaceOrder places a resting limit, stop-loss or take-profit order that is
+	// executed once the mark price crosses its trigger price.
+	PlaceOrder(context.Context, *MsgPlaceOrder) (*MsgPlaceOrderResponse, error)
+	CancelOrder(context.Context, *MsgCancelOrder) (*MsgCancelOrderResponse, error)
+	// SetMarginMode switches the trader between isolated and cross margin.
+	// Switching back to isolated margin requires every open position to meet its
+	// own maintenance margin ratio.
+	SetMarginMode(context.Context, *MsgSetMarginMode) (*MsgSetMarginModeResponse, error)
+	// SettlePosition settles the position of the trader on a pair whose vpool
+	// has been shut down, at the settlement price of the vpool.
+	SettlePosition(context.Context, *MsgSettlePosition) (*MsgSettlePositionResponse, error)
+	// DepositToInsuranceFund deposits quote tokens into the insurance fund of
+	// their denom, in exchange for shares of the fund.
+	DepositToInsuranceFund(context.Context, *MsgDepositToInsuranceFund) (*MsgDepositToInsuranceFundResponse, error)
+	// RequestInsuranceFundWithdrawal escrows insurance fund shares and starts
+	// their withdrawal cooldown.
+	RequestInsuranceFundWithdrawal(context.Context, *MsgRequestInsuranceFundWithdrawal) (*MsgRequestInsuranceFundWithdrawalResponse, error)
+	// WithdrawFromInsuranceFund redeems the escrowed shares of a withdrawal whose
+	// cooldown is over, at the current value of the shares.
+	WithdrawFromInsuranceFund(context.Context, *MsgWithdrawFromInsuranceFund) (*MsgWithdrawFromInsuranceFundResponse, error)
+	// SetTradingHalt halts or resumes trading on a pair, or on every pair if no
+	// pair is given. Only the guardian of the params can send it.
+	SetTradingHalt(context.Context, *MsgSetTradingHalt) (*MsgSetTradingHaltResponse, error)
+	// TransferSubaccountMargin moves quote tokens between two subaccounts of the
+	// sender. Subaccount 0 is the account of the sender itself.
+	TransferSubaccountMargin(context.Context, *MsgTransferSubaccountMargin) (*MsgTransferSubaccountMarginResponse, error)
 }
 
-func (m *MsgMultiLiquidate_MultiLiquidation) Size() (n int) {
-	if m == nil {
-		return 0
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RemoveMargin(ctx context.Context, req *MsgRemoveMargin) (*MsgRemoveMarginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMargin not implemented")
+}
+func (*UnimplementedMsgServer) AddMargin(ctx context.Context, req *MsgAddMargin) (*MsgAddMarginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMargin not implemented")
+}
+func (*UnimplementedMsgServer) Liquidate(ctx context.Context, req *MsgLiquidate) (*MsgLiquidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Liquidate not implemented")
+}
+func (*UnimplementedMsgServer) MultiLiquidate(ctx context.Context, req *MsgMultiLiquidate) (*MsgMultiLiquidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiLiquidate not implemented")
+}
+func (*UnimplementedMsgServer) OpenPosition(ctx context.Context, req *MsgOpenPosition) (*MsgOpenPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenPosition not implemented")
+}
+func (*UnimplementedMsgServer) ClosePosition(ctx context.Context, req *MsgClosePosition) (*MsgClosePositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosePosition not implemented")
+}
+func (*UnimplementedMsgServer) DonateToEcosystemFund(ctx context.Context, req *MsgDonateToEcosystemFund) (*MsgDonateToEcosystemFundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DonateToEcosystemFund not implemented")
+}
+func (*UnimplementedMsgServer) PlaceOrder(ctx context.Context, req *MsgPlaceOrder) (*MsgPlaceOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelOrder(ctx context.Context, req *MsgCancelOrder) (*MsgCancelOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (*UnimplementedMsgServer) SetMarginMode(ctx context.Context, req *MsgSetMarginMode) (*MsgSetMarginModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMarginMode not implemented")
+}
+func (*UnimplementedMsgServer) SettlePosition(ctx context.Context, req *MsgSettlePosition) (*MsgSettlePositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettlePosition not implemented")
+}
+func (*UnimplementedMsgServer) DepositToInsuranceFund(ctx context.Context, req *MsgDepositToInsuranceFund) (*MsgDepositToInsuranceFundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositToInsuranceFund not implemented")
+}
+func (*UnimplementedMsgServer) RequestInsuranceFundWithdrawal(ctx context.Context, req *MsgRequestInsuranceFundWithdrawal) (*MsgRequestInsuranceFundWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestInsuranceFundWithdrawal not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawFromInsuranceFund(ctx context.Context, req *MsgWithdrawFromInsuranceFund) (*MsgWithdrawFromInsuranceFundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFromInsuranceFund not implemented")
+}
+func (*UnimplementedMsgServer) SetTradingHalt(ctx context.Context, req *MsgSetTradingHalt) (*MsgSetTradingHaltResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTradingHalt not implemented")
+}
+func (*UnimplementedMsgServer) TransferSubaccountMargin(ctx context.Context, req *MsgTransferSubaccountMargin) (*MsgTransferSubaccountMarginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferSubaccountMargin not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RemoveMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMargin)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.TokenPair)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMargin(ctx, in)
 	}
-	l = len(m.Trader)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Msg/RemoveMargin",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMargin(ctx, req.(*MsgRemoveMargin))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMultiLiquidateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AddMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddMargin)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.LiquidationResponses) > 0 {
-		for _, e := range m.LiquidationResponses {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).AddMargin(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Msg/AddMargin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddMargin(ctx, req.(*MsgAddMargin))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMultiLiquidateResponse_MultiLiquidateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_Liquidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidate)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Response != nil {
-		n += m.Response.Size()
+	if interceptor == nil {
+		return srv.(MsgServer).Liquidate(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Msg/Liquidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Liquidate(ctx, req.(*MsgLiquidate))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMultiLiquidateResponse_MultiLiquidateResponse_Error) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_MultiLiquidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMultiLiquidate)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Error)
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-func (m *MsgMultiLiquidateResponse_MultiLiquidateResponse_Liquidation) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).MultiLiquidate(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.Liquidation != nil {
-		l = m.Liquidation.Size()
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Msg/MultiLiquidate",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MultiLiquidate(ctx, req.(*MsgMultiLiquidate))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (m *MsgOpenPosition) Size() (n int) {
-	if m == nil {
-		return 0
+
+func _Msg_OpenPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOpenPosition)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).OpenPosition(ctx, in)
 	}
-	l = len(m.TokenPair)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Msg/OpenPosition",
 	}
-	if m.Side != 0 {
-		n += 1 + sovTx(uint64(m.Side))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OpenPosition(ctx, req.(*MsgOpenPosition))
 	}
-	l = m.QuoteAssetAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Leverage.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.BaseAssetAmountLimit.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgOpenPositionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ClosePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClosePosition)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Position != nil {
-		l = m.Position.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ClosePosition(ctx, in)
 	}
-	l = m.ExchangedNotionalValue.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ExchangedPositionSize.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.FundingPayment.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.RealizedPnl.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.UnrealizedPnlAfter.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MarginToVault.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.PositionNotional.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Msg/ClosePosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClosePosition(ctx, req.(*MsgClosePosition))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClosePosition) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DonateToEcosystemFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDonateToEcosystemFund)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).DonateToEcosystemFund(ctx, in)
 	}
-	l = len(m.TokenPair)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Msg/DonateToEcosystemFund",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DonateToEcosystemFund(ctx, req.(*MsgDonateToEcosystemFund))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClosePositionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_PlaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Msg/PlaceOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceOrder(ctx, req.(*MsgPlaceOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Msg/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelOrder(ctx, req.(*MsgCancelOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMarginMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMarginMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMarginMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Msg/SetMarginMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMarginMode(ctx, req.(*MsgSetMarginMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SettlePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSettlePosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SettlePosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Msg/SettlePosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SettlePosition(ctx, req.(*MsgSettlePosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositToInsuranceFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositToInsuranceFund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositToInsuranceFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Msg/DepositToInsuranceFund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositToInsuranceFund(ctx, req.(*MsgDepositToInsuranceFund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestInsuranceFundWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestInsuranceFundWithdrawal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestInsuranceFundWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Msg/RequestInsuranceFundWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestInsuranceFundWithdrawal(ctx, req.(*MsgRequestInsuranceFundWithdrawal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawFromInsuranceFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawFromInsuranceFund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawFromInsuranceFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Msg/WithdrawFromInsuranceFund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawFromInsuranceFund(ctx, req.(*MsgWithdrawFromInsuranceFund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTradingHalt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTradingHalt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTradingHalt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Msg/SetTradingHalt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTradingHalt(ctx, req.(*MsgSetTradingHalt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferSubaccountMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferSubaccountMargin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferSubaccountMargin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Msg/TransferSubaccountMargin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferSubaccountMargin(ctx, req.(*MsgTransferSubaccountMargin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "nibiru.perp.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RemoveMargin",
+			Handler:    _Msg_RemoveMargin_Handler,
+		},
+		{
+			MethodName: "AddMargin",
+			Handler:    _Msg_AddMargin_Handler,
+		},
+		{
+			MethodName: "Liquidate",
+			Handler:    _Msg_Liquidate_Handler,
+		},
+		{
+			MethodName: "MultiLiquidate",
+			Handler:    _Msg_MultiLiquidate_Handler,
+		},
+		{
+			MethodName: "OpenPosition",
+			Handler:    _Msg_OpenPosition_Handler,
+		},
+		{
+			MethodName: "ClosePosition",
+			Handler:    _Msg_ClosePosition_Handler,
+		},
+		{
+			MethodName: "DonateToEcosystemFund",
+			Handler:    _Msg_DonateToEcosystemFund_Handler,
+		},
+		{
+			MethodName: "PlaceOrder",
+			Handler:    _Msg_PlaceOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _Msg_CancelOrder_Handler,
+		},
+		{
+			MethodName: "SetMarginMode",
+			Handler:    _Msg_SetMarginMode_Handler,
+		},
+		{
+			MethodName: "SettlePosition",
+			Handler:    _Msg_SettlePosition_Handler,
+		},
+		{
+			MethodName: "DepositToInsuranceFund",
+			Handler:    _Msg_DepositToInsuranceFund_Handler,
+		},
+		{
+			MethodName: "RequestInsuranceFundWithdrawal",
+			Handler:    _Msg_RequestInsuranceFundWithdrawal_Handler,
+		},
+		{
+			MethodName: "WithdrawFromInsuranceFund",
+			Handler:    _Msg_WithdrawFromInsuranceFund_Handler,
+		},
+		{
+			MethodName: "SetTradingHalt",
+			Handler:    _Msg_SetTradingHalt_Handler,
+		},
+		{
+			MethodName: "TransferSubaccountMargin",
+			Handler:    _Msg_TransferSubaccountMargin_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "perp/v1/tx.proto",
+}
+
+func (m *MsgRemoveMargin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMargin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMargin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ExchangedNotionalValue.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ExchangedPositionSize.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.FundingPayment.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.RealizedPnl.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MarginToTrader.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if m.SubaccountId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubaccountId))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TokenPair) > 0 {
+		i -= len(m.TokenPair)
+		copy(dAtA[i:], m.TokenPair)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenPair)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDonateToEcosystemFund) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveMarginResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMarginResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMarginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Position != nil {
+		{
+			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.FundingPayment.Size()
+		i -= size
+		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.MarginOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddMargin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMargin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMargin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubaccountId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubaccountId))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TokenPair) > 0 {
+		i -= len(m.TokenPair)
+		copy(dAtA[i:], m.TokenPair)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenPair)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddMarginResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMarginResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMarginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Position != nil {
+		{
+			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.FundingPayment.Size()
+		i -= size
+		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenPair) > 0 {
+		i -= len(m.TokenPair)
+		copy(dAtA[i:], m.TokenPair)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenPair)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeToPerpEcosystemFund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.FeeToLiquidator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiLiquidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiLiquidate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiLiquidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Liquidations) > 0 {
+		for iNdEx := len(m.Liquidations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquidations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiLiquidate_MultiLiquidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiLiquidate_MultiLiquidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiLiquidate_MultiLiquidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenPair) > 0 {
+		i -= len(m.TokenPair)
+		copy(dAtA[i:], m.TokenPair)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenPair)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiLiquidateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiLiquidateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiLiquidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LiquidationResponses) > 0 {
+		for iNdEx := len(m.LiquidationResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidationResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiLiquidateResponse_MultiLiquidateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiLiquidateResponse_MultiLiquidateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiLiquidateResponse_MultiLiquidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Response != nil {
+		{
+			size := m.Response.Size()
+			i -= size
+			if _, err := m.Response.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiLiquidateResponse_MultiLiquidateResponse_Error) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiLiquidateResponse_MultiLiquidateResponse_Error) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Error)
+	copy(dAtA[i:], m.Error)
+	i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+func (m *MsgMultiLiquidateResponse_MultiLiquidateResponse_Liquidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiLiquidateResponse_MultiLiquidateResponse_Liquidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Liquidation != nil {
+		{
+			size, err := m.Liquidation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MsgOpenPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubaccountId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubaccountId))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.BaseAssetAmountLimit.Size()
+		i -= size
+		if _, err := m.BaseAssetAmountLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Leverage.Size()
+		i -= size
+		if _, err := m.Leverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.QuoteAssetAmount.Size()
+		i -= size
+		if _, err := m.QuoteAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Side != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenPair) > 0 {
+		i -= len(m.TokenPair)
+		copy(dAtA[i:], m.TokenPair)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenPair)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PositionNotional.Size()
+		i -= size
+		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MarginToVault.Size()
+		i -= size
+		if _, err := m.MarginToVault.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.UnrealizedPnlAfter.Size()
+		i -= size
+		if _, err := m.UnrealizedPnlAfter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.RealizedPnl.Size()
+		i -= size
+		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.FundingPayment.Size()
+		i -= size
+		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ExchangedPositionSize.Size()
+		i -= size
+		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ExchangedNotionalValue.Size()
+		i -= size
+		if _, err := m.ExchangedNotionalValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Position != nil {
+		{
+			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClosePosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClosePosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClosePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubaccountId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubaccountId))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.QuoteAssetAmountLimit.Size()
+		i -= size
+		if _, err := m.QuoteAssetAmountLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BaseAssetAmount.Size()
+		i -= size
+		if _, err := m.BaseAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TokenPair) > 0 {
+		i -= len(m.TokenPair)
+		copy(dAtA[i:], m.TokenPair)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenPair)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClosePositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClosePositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClosePositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Position != nil {
+		{
+			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size := m.MarginToTrader.Size()
+		i -= size
+		if _, err := m.MarginToTrader.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.RealizedPnl.Size()
+		i -= size
+		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FundingPayment.Size()
+		i -= size
+		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ExchangedPositionSize.Size()
+		i -= size
+		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ExchangedNotionalValue.Size()
+		i -= size
+		if _, err := m.ExchangedNotionalValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDonateToEcosystemFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDonateToEcosystemFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDonateToEcosystemFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Donation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDonateToEcosystemFundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDonateToEcosystemFundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDonateToEcosystemFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubaccountId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubaccountId))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.BaseAssetAmountLimit.Size()
+		i -= size
+		if _, err := m.BaseAssetAmountLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Leverage.Size()
+		i -= size
+		if _, err := m.Leverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.QuoteAssetAmount.Size()
+		i -= size
+		if _, err := m.QuoteAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TriggerPrice.Size()
+		i -= size
+		if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Side != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.OrderType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenPair) > 0 {
+		i -= len(m.TokenPair)
+		copy(dAtA[i:], m.TokenPair)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenPair)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubaccountId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubaccountId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RefundedMargin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMarginMode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMarginMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMarginMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubaccountId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubaccountId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MarginMode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarginMode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMarginModeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMarginModeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMarginModeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSettlePosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSettlePosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSettlePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubaccountId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubaccountId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenPair) > 0 {
+		i -= len(m.TokenPair)
+		copy(dAtA[i:], m.TokenPair)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenPair)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSettlePositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSettlePositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSettlePositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SettledCoins) > 0 {
+		for iNdEx := len(m.SettledCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettledCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositToInsuranceFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositToInsuranceFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositToInsuranceFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositToInsuranceFundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositToInsuranceFundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositToInsuranceFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestInsuranceFundWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestInsuranceFundWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestInsuranceFundWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestInsuranceFundWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestInsuranceFundWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestInsuranceFundWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnlockTime):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintTx(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFromInsuranceFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFromInsuranceFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFromInsuranceFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFromInsuranceFundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFromInsuranceFundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFromInsuranceFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Withdrawn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTradingHalt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTradingHalt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTradingHalt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenPair) > 0 {
+		i -= len(m.TokenPair)
+		copy(dAtA[i:], m.TokenPair)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenPair)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTradingHaltResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTradingHaltResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTradingHaltResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferSubaccountMargin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferSubaccountMargin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferSubaccountMargin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ToSubaccountId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ToSubaccountId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromSubaccountId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FromSubaccountId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferSubaccountMarginResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferSubaccountMarginResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferSubaccountMarginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRemoveMargin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenPair)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Margin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.SubaccountId != 0 {
+		n += 1 + sovTx(uint64(m.SubaccountId))
+	}
+	return n
+}
+
+func (m *MsgRemoveMarginResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MarginOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.FundingPayment.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Position != nil {
+		l = m.Position.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddMargin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenPair)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Margin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.SubaccountId != 0 {
+		n += 1 + sovTx(uint64(m.SubaccountId))
+	}
+	return n
+}
+
+func (m *MsgAddMarginResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FundingPayment.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Position != nil {
+		l = m.Position.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLiquidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenPair)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLiquidateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeToLiquidator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.FeeToPerpEcosystemFund.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMultiLiquidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Liquidations) > 0 {
+		for _, e := range m.Liquidations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMultiLiquidate_MultiLiquidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenPair)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMultiLiquidateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidationResponses) > 0 {
+		for _, e := range m.LiquidationResponses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMultiLiquidateResponse_MultiLiquidateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Response != nil {
+		n += m.Response.Size()
+	}
+	return n
+}
+
+func (m *MsgMultiLiquidateResponse_MultiLiquidateResponse_Error) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+func (m *MsgMultiLiquidateResponse_MultiLiquidateResponse_Liquidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Liquidation != nil {
+		l = m.Liquidation.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *MsgOpenPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenPair)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Side != 0 {
+		n += 1 + sovTx(uint64(m.Side))
+	}
+	l = m.QuoteAssetAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Leverage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.BaseAssetAmountLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.SubaccountId != 0 {
+		n += 1 + sovTx(uint64(m.SubaccountId))
+	}
+	return n
+}
+
+func (m *MsgOpenPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Position != nil {
+		l = m.Position.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ExchangedNotionalValue.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExchangedPositionSize.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.FundingPayment.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RealizedPnl.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.UnrealizedPnlAfter.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MarginToVault.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.PositionNotional.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgClosePosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenPair)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BaseAssetAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Fraction.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.QuoteAssetAmountLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.SubaccountId != 0 {
+		n += 1 + sovTx(uint64(m.SubaccountId))
+	}
+	return n
+}
+
+func (m *MsgClosePositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExchangedNotionalValue.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExchangedPositionSize.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.FundingPayment.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RealizedPnl.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MarginToTrader.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Position != nil {
+		l = m.Position.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDonateToEcosystemFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Donation.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDonateToEcosystemFundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPlaceOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenPair)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OrderType != 0 {
+		n += 1 + sovTx(uint64(m.OrderType))
+	}
+	if m.Side != 0 {
+		n += 1 + sovTx(uint64(m.Side))
+	}
+	l = m.TriggerPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.QuoteAssetAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Leverage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.BaseAssetAmountLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.SubaccountId != 0 {
+		n += 1 + sovTx(uint64(m.SubaccountId))
+	}
+	return n
+}
+
+func (m *MsgPlaceOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *MsgCancelOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	if m.SubaccountId != 0 {
+		n += 1 + sovTx(uint64(m.SubaccountId))
+	}
+	return n
+}
+
+func (m *MsgCancelOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RefundedMargin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetMarginMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarginMode != 0 {
+		n += 1 + sovTx(uint64(m.MarginMode))
+	}
+	if m.SubaccountId != 0 {
+		n += 1 + sovTx(uint64(m.SubaccountId))
+	}
+	return n
+}
+
+func (m *MsgSetMarginModeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSettlePosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenPair)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SubaccountId != 0 {
+		n += 1 + sovTx(uint64(m.SubaccountId))
+	}
+	return n
+}
+
+func (m *MsgSettlePositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SettledCoins) > 0 {
+		for _, e := range m.SettledCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDepositToInsuranceFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDepositToInsuranceFundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Shares.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRequestInsuranceFundWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRequestInsuranceFundWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UnlockTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawFromInsuranceFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawFromInsuranceFundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Withdrawn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetTradingHalt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenPair)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Halted {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetTradingHaltResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferSubaccountMargin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FromSubaccountId != 0 {
+		n += 1 + sovTx(uint64(m.FromSubaccountId))
+	}
+	if m.ToSubaccountId != 0 {
+		n += 1 + sovTx(uint64(m.ToSubaccountId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTransferSubaccountMarginResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRemoveMargin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMargin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMargin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			m.SubaccountId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubaccountId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMarginResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMarginResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMarginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarginOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Position == nil {
+				m.Position = &Position{}
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMargin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMargin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMargin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			m.SubaccountId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubaccountId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMarginResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMarginResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMarginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Position == nil {
+				m.Position = &Position{}
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToLiquidator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeToLiquidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToPerpEcosystemFund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeToPerpEcosystemFund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiLiquidate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiLiquidate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiLiquidate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liquidations = append(m.Liquidations, &MsgMultiLiquidate_MultiLiquidation{})
+			if err := m.Liquidations[len(m.Liquidations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiLiquidate_MultiLiquidation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiLiquidation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiLiquidation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiLiquidateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiLiquidateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiLiquidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidationResponses = append(m.LiquidationResponses, &MsgMultiLiquidateResponse_MultiLiquidateResponse{})
+			if err := m.LiquidationResponses[len(m.LiquidationResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiLiquidateResponse_MultiLiquidateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiLiquidateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiLiquidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Response = &MsgMultiLiquidateResponse_MultiLiquidateResponse_Error{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgLiquidateResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Response = &MsgMultiLiquidateResponse_MultiLiquidateResponse_Liquidation{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Leverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetAmountLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAssetAmountLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			m.SubaccountId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubaccountId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenPositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenPositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Position == nil {
+				m.Position = &Position{}
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotionalValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedNotionalValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnlAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnrealizedPnlAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginToVault", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarginToVault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Donation.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgDonateToEcosystemFundResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgRemoveMargin) Unmarshal(dAtA []byte) error {
+func (m *MsgClosePosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2479,10 +6836,10 @@ func (m *MsgRemoveMargin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMargin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClosePosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMargin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClosePosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2551,9 +6908,9 @@ func (m *MsgRemoveMargin) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2563,25 +6920,113 @@ func (m *MsgRemoveMargin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BaseAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetAmountLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteAssetAmountLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			m.SubaccountId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubaccountId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2603,7 +7048,7 @@ func (m *MsgRemoveMargin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMarginResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClosePositionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2626,17 +7071,17 @@ func (m *MsgRemoveMarginResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMarginResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClosePositionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMarginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClosePositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarginOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotionalValue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2646,28 +7091,29 @@ func (m *MsgRemoveMarginResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarginOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExchangedNotionalValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2695,15 +7141,15 @@ func (m *MsgRemoveMarginResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2713,81 +7159,29 @@ func (m *MsgRemoveMarginResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Position == nil {
-				m.Position = &Position{}
-			}
-			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgAddMargin) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMargin: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMargin: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2815,11 +7209,13 @@ func (m *MsgAddMargin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginToTrader", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2847,11 +7243,13 @@ func (m *MsgAddMargin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenPair = string(dAtA[iNdEx:postIndex])
+			if err := m.MarginToTrader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2878,7 +7276,10 @@ func (m *MsgAddMargin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Position == nil {
+				m.Position = &Position{}
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2903,7 +7304,7 @@ func (m *MsgAddMargin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddMarginResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDonateToEcosystemFund) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2926,15 +7327,15 @@ func (m *MsgAddMarginResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMarginResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDonateToEcosystemFund: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMarginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDonateToEcosystemFund: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2962,13 +7363,11 @@ func (m *MsgAddMarginResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Donation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2995,10 +7394,7 @@ func (m *MsgAddMarginResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Position == nil {
-				m.Position = &Position{}
-			}
-			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Donation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3023,7 +7419,7 @@ func (m *MsgAddMarginResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
+func (m *MsgDonateToEcosystemFundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3046,10 +7442,60 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDonateToEcosystemFundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDonateToEcosystemFundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3117,8 +7563,46 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 			m.TokenPair = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= OrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3146,63 +7630,49 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trader = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetAmount", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.QuoteAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidateResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeToLiquidator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3212,30 +7682,31 @@ func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeeToLiquidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Leverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeToPerpEcosystemFund", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetAmountLimit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3245,25 +7716,45 @@ func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeeToPerpEcosystemFund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BaseAssetAmountLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			m.SubaccountId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubaccountId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3285,7 +7776,7 @@ func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiLiquidate) Unmarshal(dAtA []byte) error {
+func (m *MsgPlaceOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3308,49 +7799,17 @@ func (m *MsgMultiLiquidate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiLiquidate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPlaceOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiLiquidate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPlaceOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidations", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
-			var msglen int
+			m.OrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3360,26 +7819,11 @@ func (m *MsgMultiLiquidate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.OrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Liquidations = append(m.Liquidations, &MsgMultiLiquidate_MultiLiquidation{})
-			if err := m.Liquidations[len(m.Liquidations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3401,7 +7845,7 @@ func (m *MsgMultiLiquidate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiLiquidate_MultiLiquidation) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3424,15 +7868,15 @@ func (m *MsgMultiLiquidate_MultiLiquidation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiLiquidation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiLiquidation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3460,13 +7904,13 @@ func (m *MsgMultiLiquidate_MultiLiquidation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenPair = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
-			var stringLen uint64
+			m.OrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3476,24 +7920,30 @@ func (m *MsgMultiLiquidate_MultiLiquidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.SubaccountId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubaccountId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Trader = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3515,7 +7965,7 @@ func (m *MsgMultiLiquidate_MultiLiquidation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiLiquidateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3538,15 +7988,15 @@ func (m *MsgMultiLiquidateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiLiquidateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiLiquidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationResponses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedMargin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3573,8 +8023,7 @@ func (m *MsgMultiLiquidateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidationResponses = append(m.LiquidationResponses, &MsgMultiLiquidateResponse_MultiLiquidateResponse{})
-			if err := m.LiquidationResponses[len(m.LiquidationResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RefundedMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3599,7 +8048,7 @@ func (m *MsgMultiLiquidateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiLiquidateResponse_MultiLiquidateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMarginMode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3622,15 +8071,15 @@ func (m *MsgMultiLiquidateResponse_MultiLiquidateResponse) Unmarshal(dAtA []byte
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiLiquidateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMarginMode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiLiquidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMarginMode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3658,13 +8107,13 @@ func (m *MsgMultiLiquidateResponse_MultiLiquidateResponse) Unmarshal(dAtA []byte
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Response = &MsgMultiLiquidateResponse_MultiLiquidateResponse_Error{string(dAtA[iNdEx:postIndex])}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidation", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginMode", wireType)
 			}
-			var msglen int
+			m.MarginMode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3674,27 +8123,30 @@ func (m *MsgMultiLiquidateResponse_MultiLiquidateResponse) Unmarshal(dAtA []byte
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MarginMode |= MarginMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
-			v := &MsgLiquidateResponse{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SubaccountId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubaccountId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Response = &MsgMultiLiquidateResponse_MultiLiquidateResponse_Liquidation{v}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3716,7 +8168,7 @@ func (m *MsgMultiLiquidateResponse_MultiLiquidateResponse) Unmarshal(dAtA []byte
 	}
 	return nil
 }
-func (m *MsgOpenPosition) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMarginModeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3739,132 +8191,65 @@ func (m *MsgOpenPosition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOpenPosition: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMarginModeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOpenPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMarginModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenPair = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
-			}
-			m.Side = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Side |= Side(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSettlePosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.QuoteAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSettlePosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSettlePosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3892,13 +8277,11 @@ func (m *MsgOpenPosition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Leverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetAmountLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3926,10 +8309,27 @@ func (m *MsgOpenPosition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseAssetAmountLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenPair = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			m.SubaccountId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubaccountId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3951,7 +8351,7 @@ func (m *MsgOpenPosition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOpenPositionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSettlePositionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3974,15 +8374,15 @@ func (m *MsgOpenPositionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOpenPositionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSettlePositionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOpenPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSettlePositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4009,16 +8409,64 @@ func (m *MsgOpenPositionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Position == nil {
-				m.Position = &Position{}
-			}
-			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SettledCoins = append(m.SettledCoins, types.Coin{})
+			if err := m.SettledCoins[len(m.SettledCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositToInsuranceFund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositToInsuranceFund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositToInsuranceFund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotionalValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4046,15 +8494,13 @@ func (m *MsgOpenPositionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedNotionalValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4064,31 +8510,80 @@ func (m *MsgOpenPositionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositToInsuranceFundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositToInsuranceFundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositToInsuranceFundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4098,29 +8593,78 @@ func (m *MsgOpenPositionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestInsuranceFundWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestInsuranceFundWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestInsuranceFundWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4148,15 +8692,13 @@ func (m *MsgOpenPositionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnlAfter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4166,65 +8708,80 @@ func (m *MsgOpenPositionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnrealizedPnlAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarginToVault", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestInsuranceFundWithdrawalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarginToVault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 8:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestInsuranceFundWithdrawalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestInsuranceFundWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4234,23 +8791,22 @@ func (m *MsgOpenPositionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UnlockTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4275,7 +8831,7 @@ func (m *MsgOpenPositionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClosePosition) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawFromInsuranceFund) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4298,10 +8854,10 @@ func (m *MsgClosePosition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClosePosition: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawFromInsuranceFund: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClosePosition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawFromInsuranceFund: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4338,7 +8894,7 @@ func (m *MsgClosePosition) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4366,7 +8922,7 @@ func (m *MsgClosePosition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenPair = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4389,7 +8945,7 @@ func (m *MsgClosePosition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClosePositionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawFromInsuranceFundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4412,17 +8968,17 @@ func (m *MsgClosePositionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClosePositionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawFromInsuranceFundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClosePositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawFromInsuranceFundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotionalValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawn", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4432,63 +8988,78 @@ func (m *MsgClosePositionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedNotionalValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Withdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTradingHalt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTradingHalt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTradingHalt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4516,13 +9087,11 @@ func (m *MsgClosePositionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4550,15 +9119,13 @@ func (m *MsgClosePositionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenPair = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarginToTrader", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4568,26 +9135,62 @@ func (m *MsgClosePositionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			m.Halted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarginToTrader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTradingHaltResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTradingHaltResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTradingHaltResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4609,7 +9212,7 @@ func (m *MsgClosePositionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDonateToEcosystemFund) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferSubaccountMargin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4632,10 +9235,10 @@ func (m *MsgDonateToEcosystemFund) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDonateToEcosystemFund: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferSubaccountMargin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDonateToEcosystemFund: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferSubaccountMargin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4671,8 +9274,46 @@ func (m *MsgDonateToEcosystemFund) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSubaccountId", wireType)
+			}
+			m.FromSubaccountId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromSubaccountId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToSubaccountId", wireType)
+			}
+			m.ToSubaccountId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToSubaccountId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Donation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4699,7 +9340,7 @@ func (m *MsgDonateToEcosystemFund) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Donation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4724,7 +9365,7 @@ func (m *MsgDonateToEcosystemFund) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDonateToEcosystemFundResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferSubaccountMarginResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4747,10 +9388,10 @@ func (m *MsgDonateToEcosystemFundResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDonateToEcosystemFundResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferSubaccountMarginResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDonateToEcosystemFundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferSubaccountMarginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
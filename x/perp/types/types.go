@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -14,8 +15,40 @@ const (
 	VaultModuleAccount   = "vault"
 	PerpEFModuleAccount  = "perp_ef"
 	FeePoolModuleAccount = "fee_pool"
+	// InsuranceFundModuleAccount holds the quote tokens deposited into the insurance
+	// funds and the shares escrowed by pending withdrawals. It mints and burns the shares.
+	InsuranceFundModuleAccount = "insurance_fund"
 )
 
+// InsuranceFundShareDenom returns the denom of the shares of the insurance fund of
+// the quote denom.
+func InsuranceFundShareDenom(quoteDenom string) string {
+	return insuranceFundSharePrefix + quoteDenom
+}
+
+// InsuranceFundQuoteDenom returns the quote denom of the insurance fund the share
+// denom belongs to, or false if it is not an insurance fund share denom.
+func InsuranceFundQuoteDenom(shareDenom string) (string, bool) {
+	if !strings.HasPrefix(shareDenom, insuranceFundSharePrefix) || len(shareDenom) == len(insuranceFundSharePrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(shareDenom, insuranceFundSharePrefix), true
+}
+
+const insuranceFundSharePrefix = ModuleName + "/insurance/"
+
+// MaxOrdersPerTrader is the maximum number of resting orders a trader can have
+// on a single pair.
+const MaxOrdersPerTrader = 10
+
+// MaxOrdersExecutedPerBlock is the maximum number of triggered orders executed or
+// rejected by the EndBlocker in a single block. The others wait for the next blocks.
+const MaxOrdersExecutedPerBlock = 100
+
+// MaxSettlementsPerBlock is the maximum number of positions of shut down pairs
+// settled by the EndBlocker in a single block.
+const MaxSettlementsPerBlock = 100
+
 // x/perp module sentinel errors
 var (
 	ErrMarginHighEnough                  = sdkerrors.Register(ModuleName, 1, "margin is higher than required maintenance margin ratio")
@@ -27,6 +60,19 @@ var (
 	ErrMarginRatioTooLow                 = sdkerrors.Register(ModuleName, 7, "margin ratio did not meet maintenance margin ratio")
 	ErrLeverageIsTooHigh                 = sdkerrors.Register(ModuleName, 8, "leverage cannot be higher than vpool parameter")
 	ErrUnauthorized                      = sdkerrors.Register(ModuleName, 9, "operation not authorized")
+	ErrTooManyOrders                     = sdkerrors.Register(ModuleName, 10, "too many open orders for the pair")
+	ErrOrderSideMismatch                 = sdkerrors.Register(ModuleName, 11, "order side does not match the position side")
+	ErrInsuranceFundInsolvent            = sdkerrors.Register(ModuleName, 12, "insurance fund has shares but no assets left")
+	ErrNoInsuranceFundWithdrawal         = sdkerrors.Register(ModuleName, 13, "no pending insurance fund withdrawal")
+	ErrInsuranceFundCooldown             = sdkerrors.Register(ModuleName, 14, "insurance fund withdrawal is still in its cooldown")
+	ErrPositionSizeTooLarge              = sdkerrors.Register(ModuleName, 15, "position size exceeds the max position size of the pair")
+	ErrOpenInterestTooHigh               = sdkerrors.Register(ModuleName, 16, "open interest exceeds the max open interest of the pair")
+	ErrRepegCostTooHigh                  = sdkerrors.Register(ModuleName, 17, "perp ecosystem fund cannot pay the repeg cost")
+	ErrTradingHalted                     = sdkerrors.Register(ModuleName, 18, "trading is halted for the pair")
+	ErrLiquidatorPriorityWindow          = sdkerrors.Register(ModuleName, 19, "position can only be liquidated by the whitelisted liquidators for now")
+	ErrTooManyLiquidations               = sdkerrors.Register(ModuleName, 20, "too many permissionless liquidations in the block")
+	ErrReduceOnly                        = sdkerrors.Register(ModuleName, 21, "position change would increase or flip the position")
+	ErrInvalidSubaccount                 = sdkerrors.Register(ModuleName, 22, "invalid subaccount")
 )
 
 func ZeroPosition(ctx sdk.Context, tokenPair common.AssetPair, traderAddr sdk.AccAddress) Position {
@@ -9,7 +9,7 @@ import (
 	"github.com/NibiruChain/nibiru/x/pricefeed/types"
 )
 
-// BeginBlocker updates the current pricefeed
+// BeginBlocker updates the current pricefeed and prunes the expired price snapshots
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	// Update the current price of each asset.
 	for _, pair := range k.GetPairs(ctx) {
@@ -22,4 +22,6 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 			panic(err)
 		}
 	}
+
+	k.PruneSnapshots(ctx)
 }
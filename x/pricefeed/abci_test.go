@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/NibiruChain/nibiru/collections"
 	"github.com/NibiruChain/nibiru/x/testutil"
 
 	"github.com/NibiruChain/nibiru/simapp"
@@ -146,3 +147,53 @@ func setLookbackWindow(ctx sdk.Context, pfk pricefeedkeeper.Keeper, d time.Durat
 	params.TwapLookbackWindow = d
 	pfk.SetParams(ctx, params)
 }
+
+func TestSnapshotPruning(t *testing.T) {
+	nibiruApp, ctx := simapp.NewTestNibiruAppAndContext(true)
+	start := time.Date(2015, 10, 21, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(start)
+
+	oracle := testutil.AccAddress()
+	pair := common.Pair_USDC_NUSD
+	nibiruApp.PricefeedKeeper.SetParams(ctx, ptypes.NewParams(common.AssetPairs{pair}, 10*time.Second))
+	nibiruApp.PricefeedKeeper.WhitelistOraclesForPairs(ctx, []sdk.AccAddress{oracle}, common.AssetPairs{pair})
+	require.EqualValues(t, 20*time.Second, nibiruApp.PricefeedKeeper.GetParams(ctx).SnapshotRetentionWindow)
+
+	snapshotTimes := func() (times []time.Time) {
+		for _, key := range nibiruApp.PricefeedKeeper.PriceSnapshots.Iterate(ctx, collections.PairRange[common.AssetPair, time.Time]{}.Prefix(pair)).Keys() {
+			times = append(times, key.K2())
+		}
+		return times
+	}
+
+	t.Log("store a backlog of snapshots larger than the per block limit")
+	for i := 0; i < ptypes.MaxSnapshotsPrunedPerBlock+50; i++ {
+		snapshotTime := start.Add(-time.Duration(i+1) * time.Minute)
+		nibiruApp.PricefeedKeeper.PriceSnapshots.Insert(ctx, collections.Join(pair, snapshotTime), ptypes.PriceSnapshot{
+			PairId:      pair.String(),
+			Price:       sdk.OneDec(),
+			TimestampMs: snapshotTime.UnixMilli(),
+		})
+	}
+	require.EqualValues(t, ptypes.MaxSnapshotsPrunedPerBlock, nibiruApp.PricefeedKeeper.PruneSnapshots(ctx))
+	require.EqualValues(t, 50, nibiruApp.PricefeedKeeper.PruneSnapshots(ctx))
+	require.Empty(t, snapshotTimes())
+
+	t.Log("snapshots are pruned as blocks are produced")
+	require.NoError(t, nibiruApp.PricefeedKeeper.PostRawPrice(ctx, oracle, pair.String(), sdk.OneDec(), start.Add(time.Hour)))
+	for i := 0; i < 10; i++ {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(5 * time.Second))
+		pricefeed.BeginBlocker(ctx, nibiruApp.PricefeedKeeper)
+	}
+	require.Equal(t, []time.Time{
+		start.Add(30 * time.Second),
+		start.Add(35 * time.Second),
+		start.Add(40 * time.Second),
+		start.Add(45 * time.Second),
+		start.Add(50 * time.Second),
+	}, snapshotTimes())
+
+	price, err := nibiruApp.PricefeedKeeper.GetCurrentTWAP(ctx, pair.Token0, pair.Token1)
+	require.NoError(t, err)
+	require.EqualValues(t, sdk.OneDec(), price)
+}
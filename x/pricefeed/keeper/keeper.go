@@ -193,6 +193,50 @@ func (k Keeper) GatherRawPrices(ctx sdk.Context, token0 string, token1 string) e
 	return nil
 }
 
+/*
+PruneSnapshots deletes the price snapshots older than the snapshot retention window.
+At most types.MaxSnapshotsPrunedPerBlock snapshots are deleted per call, the rest
+are deleted in the following blocks. A zero retention window disables pruning.
+
+args:
+  - ctx: cosmos-sdk context
+
+ret:
+  - pruned: the number of deleted snapshots
+*/
+func (k Keeper) PruneSnapshots(ctx sdk.Context) (pruned int) {
+	retentionWindow := k.GetParams(ctx).SnapshotRetentionWindow
+	if retentionWindow == 0 {
+		return 0
+	}
+	cutoff := ctx.BlockTime().Add(-1 * retentionWindow)
+
+	for _, pair := range k.GetPairs(ctx) {
+		iter := k.PriceSnapshots.Iterate(
+			ctx,
+			collections.PairRange[common.AssetPair, time.Time]{}.
+				Prefix(pair).
+				EndExclusive(cutoff),
+		)
+		var keys []collections.Pair[common.AssetPair, time.Time]
+		for ; iter.Valid() && pruned+len(keys) < types.MaxSnapshotsPrunedPerBlock; iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+
+		for _, key := range keys {
+			if err := k.PriceSnapshots.Delete(ctx, key); err != nil {
+				panic(err)
+			}
+		}
+		pruned += len(keys)
+		if pruned >= types.MaxSnapshotsPrunedPerBlock {
+			return pruned
+		}
+	}
+	return pruned
+}
+
 // CalculateMedianPrice calculates the median prices for the input prices.
 func (k Keeper) CalculateMedianPrice(prices []types.CurrentPrice) sdk.Dec {
 	l := len(prices)
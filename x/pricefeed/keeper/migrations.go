@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/pricefeed/types"
+)
+
+// Migrator handles the in-place store migrations of the x/pricefeed module.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 sets the snapshot retention window, which the previous versions do not
+// have, to twice the TWAP lookback window like the default params do.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params := types.DefaultParams()
+	m.keeper.paramstore.GetParamSetIfExists(ctx, &params)
+	if !m.keeper.paramstore.Has(ctx, types.KeySnapshotRetentionWindow) {
+		params.SnapshotRetentionWindow = 2 * params.TwapLookbackWindow
+	}
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
@@ -0,0 +1,36 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/simapp"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/pricefeed/keeper"
+	"github.com/NibiruChain/nibiru/x/pricefeed/types"
+)
+
+func TestMigrate2to3(t *testing.T) {
+	nibiruApp, ctx := simapp.NewTestNibiruAppAndContext(true)
+	k := nibiruApp.PricefeedKeeper
+
+	t.Log("set params of the previous version, which doesn't have the snapshot retention window")
+	k.SetParams(ctx, types.NewParams(common.AssetPairs{common.Pair_BTC_NUSD}, 20*time.Minute))
+	prefix.NewStore(ctx.KVStore(nibiruApp.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/")).
+		Delete(types.KeySnapshotRetentionWindow)
+	require.Panics(t, func() { k.GetParams(ctx) })
+
+	t.Log("migrate the store")
+	require.NoError(t, keeper.NewMigrator(k).Migrate2to3(ctx))
+
+	t.Log("assert the snapshot retention window is set and the other params are kept")
+	params := k.GetParams(ctx)
+	assert.EqualValues(t, common.AssetPairs{common.Pair_BTC_NUSD}, params.Pairs)
+	assert.Equal(t, 20*time.Minute, params.TwapLookbackWindow)
+	assert.Equal(t, 40*time.Minute, params.SnapshotRetentionWindow)
+}
@@ -36,6 +36,11 @@ func (k Keeper) GetTwapLookbackWindow(ctx sdk.Context) time.Duration {
 	return k.GetParams(ctx).TwapLookbackWindow
 }
 
+// GetSnapshotRetentionWindow returns how long the price snapshots are kept before being pruned.
+func (k Keeper) GetSnapshotRetentionWindow(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).SnapshotRetentionWindow
+}
+
 // GetOraclesForPair returns the oracles for a valid asset pair
 func (k Keeper) GetOraclesForPair(ctx sdk.Context, pairID string,
 ) (oracles []sdk.AccAddress) {
@@ -115,6 +120,7 @@ func (k Keeper) WhitelistOraclesForPairs(
 
 		k.OraclesStore().AddOracles(ctx, pair, oracles)
 	}
-	endingPairs := append(paramsPairs, newPairs...)
-	k.SetParams(ctx, types.NewParams(endingPairs, k.GetTwapLookbackWindow(ctx)))
+	params := k.GetParams(ctx)
+	params.Pairs = append(paramsPairs, newPairs...)
+	k.SetParams(ctx, params)
 }
@@ -39,6 +39,9 @@ type Params struct {
 	Pairs []common.AssetPair `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs"`
 	// amount of time to look back for TWAP calculations
 	TwapLookbackWindow time.Duration `protobuf:"bytes,2,opt,name=twap_lookback_window,json=twapLookbackWindow,proto3,stdduration" json:"twap_lookback_window,omitempty" yaml:"twap_lookback_window"`
+	// amount of time price snapshots are kept before being pruned. It must be at
+	// least the twap_lookback_window. Zero disables pruning.
+	SnapshotRetentionWindow time.Duration `protobuf:"bytes,3,opt,name=snapshot_retention_window,json=snapshotRetentionWindow,proto3,stdduration" json:"snapshot_retention_window,omitempty" yaml:"snapshot_retention_window"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -88,6 +91,13 @@ func (m *Params) GetTwapLookbackWindow() time.Duration {
 	return 0
 }
 
+func (m *Params) GetSnapshotRetentionWindow() time.Duration {
+	if m != nil {
+		return m.SnapshotRetentionWindow
+	}
+	return 0
+}
+
 // a snapshot of the pricefeed oracle's median price at a given point in time
 type PriceSnapshot struct {
 	// the token pair
@@ -407,48 +417,50 @@ func init() {
 func init() { proto.RegisterFile("pricefeed/state.proto", fileDescriptor_c1e08791a9dd0830) }
 
 var fileDescriptor_c1e08791a9dd0830 = []byte{
-	// 648 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xee, 0x50, 0x28, 0x74, 0xca, 0x2f, 0xf9, 0x65, 0x41, 0xad, 0x60, 0x76, 0xeb, 0x9a, 0x98,
-	0x1e, 0x64, 0x37, 0xa0, 0x27, 0xe3, 0xa5, 0x4b, 0x2f, 0x44, 0xd0, 0xba, 0x92, 0x90, 0x78, 0x69,
-	0xa6, 0xbb, 0x43, 0x3b, 0xa1, 0xbb, 0xb3, 0x99, 0x99, 0x02, 0xfd, 0x16, 0x78, 0x31, 0x7c, 0x04,
-	0x3f, 0x0a, 0x27, 0xc3, 0x4d, 0xe3, 0xa1, 0x62, 0xb9, 0x79, 0xf4, 0xe8, 0xc9, 0xcc, 0x9f, 0xb6,
-	0x04, 0x39, 0x20, 0x78, 0xda, 0x9d, 0x79, 0xde, 0x79, 0x9e, 0x67, 0x9e, 0xf7, 0xcd, 0xc0, 0x3b,
-	0x19, 0x23, 0x11, 0xde, 0xc5, 0x38, 0xf6, 0xb9, 0x40, 0x02, 0x7b, 0x19, 0xa3, 0x82, 0x5a, 0x0b,
-	0x29, 0x69, 0x11, 0xd6, 0xf3, 0xc6, 0xa8, 0xb7, 0xbf, 0xba, 0xb4, 0xd8, 0xa6, 0x6d, 0xaa, 0x70,
-	0x5f, 0xfe, 0xe9, 0xd2, 0x25, 0xa7, 0x4d, 0x69, 0xbb, 0x8b, 0x7d, 0xb5, 0x6a, 0xf5, 0x76, 0x7d,
-	0x41, 0x12, 0xcc, 0x05, 0x4a, 0x32, 0x53, 0x60, 0x5f, 0x2e, 0x88, 0x7b, 0x0c, 0x09, 0x42, 0x53,
-	0x83, 0x3f, 0x30, 0x38, 0xca, 0x88, 0x8f, 0xd2, 0x94, 0x0a, 0x05, 0x72, 0x83, 0x2e, 0x44, 0x34,
-	0x49, 0x68, 0xea, 0xeb, 0x8f, 0xde, 0x74, 0x3f, 0x03, 0x58, 0x68, 0x20, 0x86, 0x12, 0x6e, 0x3d,
-	0x83, 0x33, 0x19, 0x22, 0x8c, 0x97, 0x41, 0x25, 0x5f, 0x2d, 0xad, 0x95, 0x3d, 0xe3, 0xdc, 0xd4,
-	0xd7, 0x38, 0xc7, 0xa2, 0x81, 0x08, 0x0b, 0xa6, 0x4f, 0x06, 0x4e, 0x2e, 0xd4, 0xc5, 0xd6, 0x07,
-	0x00, 0x17, 0xc5, 0x01, 0xca, 0x9a, 0x5d, 0x4a, 0xf7, 0x5a, 0x28, 0xda, 0x6b, 0x1e, 0x90, 0x34,
-	0xa6, 0x07, 0xe5, 0xa9, 0x0a, 0xa8, 0x96, 0xd6, 0xee, 0x7b, 0xda, 0x93, 0x37, 0xf2, 0xec, 0xd5,
-	0x8d, 0xe7, 0x60, 0x43, 0xd2, 0xfc, 0x18, 0x38, 0xf6, 0x55, 0xc7, 0x9f, 0xd0, 0x84, 0x08, 0x9c,
-	0x64, 0xa2, 0xff, 0x73, 0xe0, 0x2c, 0xf7, 0x51, 0xd2, 0x7d, 0xee, 0x5e, 0x55, 0xe7, 0x1e, 0x7f,
-	0x73, 0x40, 0x68, 0x49, 0x68, 0xd3, 0x20, 0x3b, 0x1a, 0x78, 0x0f, 0xe0, 0x7f, 0x0d, 0x19, 0xfa,
-	0xdb, 0x14, 0x65, 0xbc, 0x43, 0x85, 0x75, 0x0f, 0xce, 0x4a, 0xcf, 0x4d, 0x12, 0x97, 0x41, 0x05,
-	0x54, 0x8b, 0x61, 0x41, 0x2e, 0x37, 0x62, 0xab, 0x0e, 0x67, 0x54, 0x7b, 0x94, 0xe7, 0x62, 0xe0,
-	0x49, 0x63, 0x5f, 0x07, 0xce, 0xe3, 0x36, 0x11, 0x9d, 0x5e, 0x4b, 0x06, 0xe0, 0x47, 0x94, 0x27,
-	0x94, 0x9b, 0xcf, 0x0a, 0x8f, 0xf7, 0x7c, 0xd1, 0xcf, 0x30, 0xf7, 0xea, 0x38, 0x0a, 0xf5, 0x61,
-	0xeb, 0x21, 0x9c, 0x1f, 0x37, 0xac, 0x99, 0xf0, 0x72, 0xbe, 0x02, 0xaa, 0xf9, 0xb0, 0x34, 0xde,
-	0xdb, 0xe2, 0x6e, 0x13, 0xfe, 0xff, 0x9a, 0xa1, 0xa8, 0x8b, 0xf9, 0x16, 0x62, 0xbc, 0x83, 0xba,
-	0x98, 0x59, 0x2f, 0xe1, 0x2c, 0xd5, 0x7b, 0x2a, 0xf8, 0xf9, 0x60, 0xf5, 0xd7, 0xc0, 0x59, 0xb9,
-	0x86, 0x74, 0x2d, 0x8a, 0x6a, 0x71, 0xcc, 0x30, 0xe7, 0xe1, 0x88, 0xc1, 0x5d, 0x83, 0x0b, 0xb5,
-	0x48, 0x90, 0x7d, 0x2c, 0x1b, 0x35, 0xd1, 0x58, 0x86, 0x45, 0xc2, 0x9b, 0x48, 0x21, 0xea, 0xee,
-	0x73, 0xe1, 0x1c, 0xe1, 0xba, 0xd2, 0xfd, 0x04, 0x60, 0xa9, 0x41, 0xb9, 0xc0, 0xb1, 0x8a, 0xcb,
-	0x7a, 0x74, 0x29, 0xa6, 0x00, 0x0e, 0x07, 0x4e, 0x41, 0x12, 0x6e, 0xd4, 0xc7, 0x91, 0xdd, 0x85,
-	0x05, 0xad, 0xa9, 0x33, 0x0b, 0xcd, 0x6a, 0x12, 0x65, 0xfe, 0x36, 0x51, 0xbe, 0x80, 0x05, 0x7c,
-	0x98, 0x11, 0xd6, 0x2f, 0x4f, 0xab, 0x29, 0x5a, 0xfa, 0x63, 0x8a, 0xb6, 0x47, 0xa9, 0x06, 0x73,
-	0x52, 0xe2, 0x48, 0x4e, 0x81, 0x39, 0xe3, 0xf6, 0xe1, 0xfc, 0x7a, 0x8f, 0x31, 0x9c, 0x8a, 0xbf,
-	0xb8, 0xd0, 0x3f, 0x99, 0x01, 0xf7, 0x78, 0x0a, 0x96, 0x8c, 0xf6, 0xf6, 0x4e, 0xad, 0x71, 0x3d,
-	0xe9, 0x4d, 0x58, 0x4c, 0x7b, 0x09, 0x66, 0x48, 0x50, 0x76, 0x43, 0xf9, 0x09, 0x81, 0xd5, 0x80,
-	0xa5, 0x18, 0xa7, 0x34, 0x21, 0xa9, 0xe2, 0xbb, 0x59, 0x1f, 0x2e, 0x52, 0x4c, 0xa2, 0x99, 0xbe,
-	0x45, 0x34, 0xc1, 0x9b, 0xb3, 0xef, 0x36, 0xf8, 0x38, 0xb4, 0xc1, 0xc9, 0xd0, 0x06, 0xa7, 0x43,
-	0x1b, 0x9c, 0x0d, 0x6d, 0x70, 0x74, 0x6e, 0xe7, 0x4e, 0xcf, 0xed, 0xdc, 0x97, 0x73, 0x3b, 0xf7,
-	0xce, 0xbf, 0x40, 0xf8, 0x4a, 0xbd, 0x3d, 0xeb, 0x1d, 0x44, 0x52, 0x5f, 0xbf, 0x43, 0xfe, 0xa1,
-	0x3f, 0x79, 0x61, 0x15, 0x7b, 0xab, 0xa0, 0xc6, 0xe1, 0xe9, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xb6, 0x51, 0x65, 0x1c, 0x7b, 0x05, 0x00, 0x00,
+	// 684 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x41, 0x4f, 0x13, 0x41,
+	0x18, 0xed, 0x50, 0x28, 0x74, 0x8a, 0x89, 0x59, 0x50, 0x0a, 0x98, 0xdd, 0xba, 0x24, 0x86, 0x83,
+	0xec, 0x06, 0xf4, 0x64, 0xbc, 0xb4, 0xf4, 0x42, 0x04, 0xad, 0x0b, 0x09, 0x89, 0x97, 0xcd, 0x74,
+	0x77, 0x68, 0x27, 0x74, 0x77, 0x36, 0x33, 0x53, 0xa0, 0x77, 0x7f, 0x00, 0x5e, 0x0c, 0x3f, 0xc1,
+	0xf8, 0x4b, 0x38, 0x19, 0x8e, 0xc6, 0x43, 0xc5, 0x72, 0xf3, 0xe8, 0xd1, 0x93, 0xd9, 0x99, 0x69,
+	0x8b, 0x08, 0x11, 0xc1, 0xd3, 0x76, 0xe6, 0xbd, 0x79, 0xdf, 0xdb, 0xb7, 0x2f, 0x53, 0x78, 0x2f,
+	0x61, 0x24, 0xc0, 0x3b, 0x18, 0x87, 0x2e, 0x17, 0x48, 0x60, 0x27, 0x61, 0x54, 0x50, 0x63, 0x2a,
+	0x26, 0x75, 0xc2, 0xda, 0xce, 0x00, 0x75, 0xf6, 0x96, 0xe7, 0xa6, 0x1b, 0xb4, 0x41, 0x25, 0xee,
+	0xa6, 0xbf, 0x14, 0x75, 0xce, 0x6a, 0x50, 0xda, 0x68, 0x61, 0x57, 0xae, 0xea, 0xed, 0x1d, 0x57,
+	0x90, 0x08, 0x73, 0x81, 0xa2, 0x44, 0x13, 0xcc, 0x8b, 0x84, 0xb0, 0xcd, 0x90, 0x20, 0x34, 0xd6,
+	0xf8, 0x03, 0x8d, 0xa3, 0x84, 0xb8, 0x28, 0x8e, 0xa9, 0x90, 0x20, 0xd7, 0xe8, 0x54, 0x40, 0xa3,
+	0x88, 0xc6, 0xae, 0x7a, 0xa8, 0x4d, 0xfb, 0x6d, 0x16, 0xe6, 0x6a, 0x88, 0xa1, 0x88, 0x1b, 0x4f,
+	0xe1, 0x58, 0x82, 0x08, 0xe3, 0x45, 0x50, 0xca, 0x2e, 0x16, 0x56, 0x8a, 0x8e, 0x76, 0xae, 0xf9,
+	0x65, 0xce, 0xb1, 0xa8, 0x21, 0xc2, 0x2a, 0xa3, 0xc7, 0x5d, 0x2b, 0xe3, 0x29, 0xb2, 0xf1, 0x1e,
+	0xc0, 0x69, 0xb1, 0x8f, 0x12, 0xbf, 0x45, 0xe9, 0x6e, 0x1d, 0x05, 0xbb, 0xfe, 0x3e, 0x89, 0x43,
+	0xba, 0x5f, 0x1c, 0x29, 0x81, 0xc5, 0xc2, 0xca, 0xac, 0xa3, 0x3c, 0x39, 0x7d, 0xcf, 0x4e, 0x55,
+	0x7b, 0xae, 0xac, 0xa5, 0x32, 0xdf, 0xbb, 0x96, 0x79, 0xd9, 0xf1, 0xc7, 0x34, 0x22, 0x02, 0x47,
+	0x89, 0xe8, 0xfc, 0xe8, 0x5a, 0xf3, 0x1d, 0x14, 0xb5, 0x9e, 0xd9, 0x97, 0xf1, 0xec, 0xa3, 0xaf,
+	0x16, 0xf0, 0x8c, 0x14, 0x5a, 0xd7, 0xc8, 0xb6, 0x04, 0x8c, 0x8f, 0x00, 0xce, 0xf2, 0x18, 0x25,
+	0xbc, 0x49, 0x85, 0xcf, 0xb0, 0xc0, 0x71, 0x3a, 0xb5, 0xef, 0x2e, 0xfb, 0x37, 0x77, 0x9b, 0xda,
+	0xdd, 0xc2, 0x95, 0x1a, 0xbf, 0x59, 0x2c, 0x29, 0x8b, 0x57, 0x92, 0x95, 0xcf, 0x99, 0x3e, 0xee,
+	0xf5, 0x61, 0x65, 0xd6, 0x7e, 0x07, 0xe0, 0x9d, 0x5a, 0xda, 0x90, 0x4d, 0x4d, 0x30, 0x66, 0xe0,
+	0x78, 0x1a, 0xb0, 0x4f, 0xc2, 0x22, 0x28, 0x81, 0xc5, 0xbc, 0x97, 0x4b, 0x97, 0x6b, 0xa1, 0x51,
+	0x85, 0x63, 0xb2, 0x4b, 0x32, 0xe0, 0x7c, 0xc5, 0x49, 0x7d, 0x7e, 0xe9, 0x5a, 0x8f, 0x1a, 0x44,
+	0x34, 0xdb, 0xf5, 0xf4, 0x6b, 0xb9, 0x01, 0xe5, 0x11, 0xe5, 0xfa, 0xb1, 0xc4, 0xc3, 0x5d, 0x57,
+	0x74, 0x12, 0xcc, 0x9d, 0x2a, 0x0e, 0x3c, 0x75, 0xd8, 0x78, 0x08, 0x27, 0x07, 0xed, 0xf2, 0x23,
+	0x2e, 0xf3, 0xc8, 0x7a, 0x85, 0xc1, 0xde, 0x06, 0xb7, 0x7d, 0x78, 0xf7, 0x15, 0x43, 0x41, 0x0b,
+	0xf3, 0x0d, 0xc4, 0x78, 0x13, 0xb5, 0x30, 0x33, 0x5e, 0xc0, 0x71, 0xaa, 0xf6, 0x64, 0x4b, 0x26,
+	0x2b, 0xcb, 0x3f, 0xbb, 0xd6, 0xd2, 0x35, 0x46, 0x97, 0x83, 0xa0, 0x1c, 0x86, 0x0c, 0x73, 0xee,
+	0xf5, 0x15, 0xec, 0x15, 0x38, 0x55, 0x0e, 0x04, 0xd9, 0xc3, 0x69, 0xab, 0x86, 0x33, 0xe6, 0x61,
+	0x9e, 0x70, 0x1f, 0x49, 0x44, 0xbe, 0xfb, 0x84, 0x37, 0x41, 0xb8, 0x62, 0xda, 0x9f, 0x00, 0x2c,
+	0xd4, 0x28, 0x17, 0x38, 0x94, 0x71, 0x19, 0x0b, 0x17, 0x62, 0xaa, 0xc0, 0x5e, 0xd7, 0xca, 0xa5,
+	0x82, 0x6b, 0xd5, 0x41, 0x64, 0xf7, 0x61, 0x4e, 0xcd, 0x54, 0x99, 0x79, 0x7a, 0x35, 0x8c, 0x32,
+	0x7b, 0x9b, 0x28, 0x9f, 0xc3, 0x1c, 0x3e, 0x48, 0x08, 0xeb, 0x14, 0x47, 0x65, 0xa9, 0xe6, 0xfe,
+	0x28, 0xd5, 0x56, 0x3f, 0xd5, 0xca, 0x44, 0x3a, 0xe2, 0x30, 0xad, 0x82, 0x3e, 0x63, 0x77, 0xe0,
+	0xe4, 0x6a, 0x9b, 0x31, 0x1c, 0x8b, 0x7f, 0x78, 0xa1, 0xff, 0xd2, 0x01, 0xfb, 0x68, 0x04, 0x16,
+	0xf4, 0xec, 0xad, 0xed, 0x72, 0xed, 0x7a, 0xa3, 0xd7, 0x61, 0x3e, 0x6e, 0x47, 0x98, 0x21, 0x41,
+	0xd9, 0x0d, 0xc7, 0x0f, 0x05, 0x8c, 0x1a, 0x2c, 0x84, 0x38, 0xa6, 0x11, 0x89, 0xa5, 0xde, 0xcd,
+	0xbe, 0xc3, 0x79, 0x89, 0x61, 0x34, 0xa3, 0xb7, 0x88, 0xa6, 0xf2, 0xfa, 0xf4, 0x9b, 0x09, 0x3e,
+	0xf4, 0x4c, 0x70, 0xdc, 0x33, 0xc1, 0x49, 0xcf, 0x04, 0xa7, 0x3d, 0x13, 0x1c, 0x9e, 0x99, 0x99,
+	0x93, 0x33, 0x33, 0xf3, 0xf9, 0xcc, 0xcc, 0xbc, 0x71, 0xcf, 0x09, 0xbe, 0x94, 0x17, 0xe5, 0x6a,
+	0x13, 0x91, 0xd8, 0x55, 0x97, 0xa6, 0x7b, 0xe0, 0x0e, 0xff, 0x0e, 0xa4, 0x7a, 0x3d, 0x27, 0xeb,
+	0xf0, 0xe4, 0xd7, 0x00, 0xc7, 0xf1, 0x82, 0x51, 0x28, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) VerboseEqual(that interface{}) error {
@@ -487,6 +499,9 @@ func (this *Params) VerboseEqual(that interface{}) error {
 	if this.TwapLookbackWindow != that1.TwapLookbackWindow {
 		return fmt.Errorf("TwapLookbackWindow this(%v) Not Equal that(%v)", this.TwapLookbackWindow, that1.TwapLookbackWindow)
 	}
+	if this.SnapshotRetentionWindow != that1.SnapshotRetentionWindow {
+		return fmt.Errorf("SnapshotRetentionWindow this(%v) Not Equal that(%v)", this.SnapshotRetentionWindow, that1.SnapshotRetentionWindow)
+	}
 	return nil
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -519,6 +534,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.TwapLookbackWindow != that1.TwapLookbackWindow {
 		return false
 	}
+	if this.SnapshotRetentionWindow != that1.SnapshotRetentionWindow {
+		return false
+	}
 	return true
 }
 func (this *PriceSnapshot) VerboseEqual(that interface{}) error {
@@ -929,13 +947,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapLookbackWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapLookbackWindow):])
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.SnapshotRetentionWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.SnapshotRetentionWindow):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintState(dAtA, i, uint64(n1))
 	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapLookbackWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapLookbackWindow):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintState(dAtA, i, uint64(n2))
+	i--
 	dAtA[i] = 0x12
 	if len(m.Pairs) > 0 {
 		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
@@ -1084,12 +1110,12 @@ func (m *PostedPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintState(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintState(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x22
 	{
@@ -1244,6 +1270,8 @@ func (m *Params) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapLookbackWindow)
 	n += 1 + l + sovState(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.SnapshotRetentionWindow)
+	n += 1 + l + sovState(uint64(l))
 	return n
 }
 
@@ -1449,6 +1477,39 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotRetentionWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.SnapshotRetentionWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
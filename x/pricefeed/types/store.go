@@ -20,14 +20,26 @@ var (
 	DefaultLookbackWindow = 15 * time.Minute
 )
 
-// NewParams creates a new AssetParams object
+// Parameter store keys
+var (
+	KeyPairs                   = []byte("Pairs")
+	KeyTwapLookbackWindow      = []byte("TwapLookbackWindow")
+	KeySnapshotRetentionWindow = []byte("SnapshotRetentionWindow")
+)
+
+// MaxSnapshotsPrunedPerBlock is the maximum number of price snapshots deleted in a single block.
+const MaxSnapshotsPrunedPerBlock = 100
+
+// NewParams creates a new AssetParams object.
+// The snapshot retention window is sized as twice the TWAP lookback window.
 func NewParams(
 	pairs common.AssetPairs,
 	twapLookbackWindow time.Duration,
 ) Params {
 	return Params{
-		Pairs:              pairs,
-		TwapLookbackWindow: twapLookbackWindow,
+		Pairs:                   pairs,
+		TwapLookbackWindow:      twapLookbackWindow,
+		SnapshotRetentionWindow: 2 * twapLookbackWindow,
 	}
 }
 
@@ -46,10 +58,13 @@ func ParamKeyTable() paramtypes.KeyTable {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(
-			[]byte("Pairs"), &p.Pairs, validateParamPairs,
+			KeyPairs, &p.Pairs, validateParamPairs,
 		),
 		paramtypes.NewParamSetPair(
-			[]byte("TwapLookbackWindow"), &p.TwapLookbackWindow, validateTwapLookbackWindow,
+			KeyTwapLookbackWindow, &p.TwapLookbackWindow, validateTwapLookbackWindow,
+		),
+		paramtypes.NewParamSetPair(
+			KeySnapshotRetentionWindow, &p.SnapshotRetentionWindow, validateSnapshotRetentionWindow,
 		),
 	}
 }
@@ -64,6 +79,15 @@ func (p Params) Validate() error {
 	if err != nil {
 		return err
 	}
+	err = validateSnapshotRetentionWindow(p.SnapshotRetentionWindow)
+	if err != nil {
+		return err
+	}
+	if p.SnapshotRetentionWindow != 0 && p.SnapshotRetentionWindow < p.TwapLookbackWindow {
+		return fmt.Errorf(
+			"snapshot retention window %s is shorter than the twap lookback window %s",
+			p.SnapshotRetentionWindow, p.TwapLookbackWindow)
+	}
 	return nil
 }
 
@@ -91,3 +115,14 @@ func validateTwapLookbackWindow(i interface{}) error {
 	}
 	return nil
 }
+
+func validateSnapshotRetentionWindow(i interface{}) error {
+	d, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for snapshot retention window: %T", i)
+	}
+	if d < 0 {
+		return fmt.Errorf("invalid snapshotRetentionWindow, negative value is not allowed: %s", d)
+	}
+	return nil
+}
@@ -37,7 +37,7 @@ func (k Keeper) GetGovMarketCap(ctx sdk.Context) (sdk.Int, error) {
 		return sdk.Int{}, err
 	}
 
-	price, err := pool.CalcSpotPrice(common.DenomNIBI, common.DenomNUSD)
+	price, err := pool.AsPoolI(ctx.BlockTime()).CalcSpotPrice(common.DenomNIBI, common.DenomNUSD)
 	if err != nil {
 		return sdk.Int{}, err
 	}
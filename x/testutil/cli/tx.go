@@ -44,6 +44,13 @@ func WithTxCanFail(canFail bool) ExecTxOption {
 	}
 }
 
+// WithTxGas sets the gas limit of the TX in place of the default one of the CLI.
+func WithTxGas(gas uint64) ExecTxOption {
+	return func(options *execTxOptions) {
+		options.gas = gas
+	}
+}
+
 func WithKeyringBackend(keyringBackend string) ExecTxOption {
 	return func(options *execTxOptions) {
 		options.keyringBackend = keyringBackend
@@ -56,6 +63,7 @@ type execTxOptions struct {
 	broadcastMode    string
 	canFail          bool
 	keyringBackend   string
+	gas              uint64
 }
 
 func ExecTx(network *Network, cmd *cobra.Command, txSender sdk.AccAddress, args []string, opt ...ExecTxOption) (*sdk.TxResponse, error) {
@@ -80,6 +88,9 @@ func ExecTx(network *Network, cmd *cobra.Command, txSender sdk.AccAddress, args
 	args = append(args, fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, options.broadcastMode))
 	args = append(args, fmt.Sprintf("--%s=%s", flags.FlagFees, options.fees))
 	args = append(args, fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, options.keyringBackend))
+	if options.gas > 0 {
+		args = append(args, fmt.Sprintf("--%s=%d", flags.FlagGas, options.gas))
+	}
 	switch options.skipConfirmation {
 	case true:
 		args = append(args, fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation))
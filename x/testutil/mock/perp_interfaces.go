@@ -105,6 +105,20 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// BurnCoins mocks base method.
+func (m *MockBankKeeper) BurnCoins(arg0 types2.Context, arg1 string, arg2 types2.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BurnCoins", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BurnCoins indicates an expected call of BurnCoins.
+func (mr *MockBankKeeperMockRecorder) BurnCoins(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnCoins", reflect.TypeOf((*MockBankKeeper)(nil).BurnCoins), arg0, arg1, arg2)
+}
+
 // GetBalance mocks base method.
 func (m *MockBankKeeper) GetBalance(arg0 types2.Context, arg1 types2.AccAddress, arg2 string) types2.Coin {
 	m.ctrl.T.Helper()
@@ -119,6 +133,20 @@ func (mr *MockBankKeeperMockRecorder) GetBalance(arg0, arg1, arg2 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockBankKeeper)(nil).GetBalance), arg0, arg1, arg2)
 }
 
+// GetSupply mocks base method.
+func (m *MockBankKeeper) GetSupply(arg0 types2.Context, arg1 string) types2.Coin {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupply", arg0, arg1)
+	ret0, _ := ret[0].(types2.Coin)
+	return ret0
+}
+
+// GetSupply indicates an expected call of GetSupply.
+func (mr *MockBankKeeperMockRecorder) GetSupply(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupply", reflect.TypeOf((*MockBankKeeper)(nil).GetSupply), arg0, arg1)
+}
+
 // MintCoins mocks base method.
 func (m *MockBankKeeper) MintCoins(arg0 types2.Context, arg1 string, arg2 types2.Coins) error {
 	m.ctrl.T.Helper()
@@ -133,6 +161,20 @@ func (mr *MockBankKeeperMockRecorder) MintCoins(arg0, arg1, arg2 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MintCoins", reflect.TypeOf((*MockBankKeeper)(nil).MintCoins), arg0, arg1, arg2)
 }
 
+// SendCoins mocks base method.
+func (m *MockBankKeeper) SendCoins(arg0 types2.Context, arg1, arg2 types2.AccAddress, arg3 types2.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoins", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoins indicates an expected call of SendCoins.
+func (mr *MockBankKeeperMockRecorder) SendCoins(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoins", reflect.TypeOf((*MockBankKeeper)(nil).SendCoins), arg0, arg1, arg2, arg3)
+}
+
 // SendCoinsFromAccountToModule mocks base method.
 func (m *MockBankKeeper) SendCoinsFromAccountToModule(arg0 types2.Context, arg1 types2.AccAddress, arg2 string, arg3 types2.Coins) error {
 	m.ctrl.T.Helper()
@@ -256,6 +298,20 @@ func (mr *MockPricefeedKeeperMockRecorder) GetCurrentTWAP(arg0, arg1, arg2 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentTWAP", reflect.TypeOf((*MockPricefeedKeeper)(nil).GetCurrentTWAP), arg0, arg1, arg2)
 }
 
+// GetSnapshotRetentionWindow mocks base method.
+func (m *MockPricefeedKeeper) GetSnapshotRetentionWindow(arg0 types2.Context) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshotRetentionWindow", arg0)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// GetSnapshotRetentionWindow indicates an expected call of GetSnapshotRetentionWindow.
+func (mr *MockPricefeedKeeperMockRecorder) GetSnapshotRetentionWindow(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotRetentionWindow", reflect.TypeOf((*MockPricefeedKeeper)(nil).GetSnapshotRetentionWindow), arg0)
+}
+
 // IsActivePair mocks base method.
 func (m *MockPricefeedKeeper) IsActivePair(arg0 types2.Context, arg1 string) bool {
 	m.ctrl.T.Helper()
@@ -380,6 +436,22 @@ func (mr *MockVpoolKeeperMockRecorder) GetMarkPrice(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMarkPrice", reflect.TypeOf((*MockVpoolKeeper)(nil).GetMarkPrice), arg0, arg1)
 }
 
+// GetMarkPriceRange mocks base method.
+func (m *MockVpoolKeeper) GetMarkPriceRange(arg0 types2.Context, arg1 common.AssetPair, arg2 time.Duration) (types2.Dec, types2.Dec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMarkPriceRange", arg0, arg1, arg2)
+	ret0, _ := ret[0].(types2.Dec)
+	ret1, _ := ret[1].(types2.Dec)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMarkPriceRange indicates an expected call of GetMarkPriceRange.
+func (mr *MockVpoolKeeperMockRecorder) GetMarkPriceRange(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMarkPriceRange", reflect.TypeOf((*MockVpoolKeeper)(nil).GetMarkPriceRange), arg0, arg1, arg2)
+}
+
 // GetMarkPriceTWAP mocks base method.
 func (m *MockVpoolKeeper) GetMarkPriceTWAP(arg0 types2.Context, arg1 common.AssetPair, arg2 time.Duration) (types2.Dec, error) {
 	m.ctrl.T.Helper()
@@ -409,6 +481,21 @@ func (mr *MockVpoolKeeperMockRecorder) GetMaxLeverage(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaxLeverage", reflect.TypeOf((*MockVpoolKeeper)(nil).GetMaxLeverage), arg0, arg1)
 }
 
+// GetPositionLimits mocks base method.
+func (m *MockVpoolKeeper) GetPositionLimits(arg0 types2.Context, arg1 common.AssetPair) (types2.Dec, types2.Dec) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPositionLimits", arg0, arg1)
+	ret0, _ := ret[0].(types2.Dec)
+	ret1, _ := ret[1].(types2.Dec)
+	return ret0, ret1
+}
+
+// GetPositionLimits indicates an expected call of GetPositionLimits.
+func (mr *MockVpoolKeeperMockRecorder) GetPositionLimits(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPositionLimits", reflect.TypeOf((*MockVpoolKeeper)(nil).GetPositionLimits), arg0, arg1)
+}
+
 // GetQuoteAssetPrice mocks base method.
 func (m *MockVpoolKeeper) GetQuoteAssetPrice(arg0 types2.Context, arg1 common.AssetPair, arg2 types1.Direction, arg3 types2.Dec) (types2.Dec, error) {
 	m.ctrl.T.Helper()
@@ -439,6 +526,20 @@ func (mr *MockVpoolKeeperMockRecorder) GetSettlementPrice(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSettlementPrice", reflect.TypeOf((*MockVpoolKeeper)(nil).GetSettlementPrice), arg0, arg1)
 }
 
+// GetSnapshotRetentionWindow mocks base method.
+func (m *MockVpoolKeeper) GetSnapshotRetentionWindow(arg0 types2.Context) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshotRetentionWindow", arg0)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// GetSnapshotRetentionWindow indicates an expected call of GetSnapshotRetentionWindow.
+func (mr *MockVpoolKeeperMockRecorder) GetSnapshotRetentionWindow(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotRetentionWindow", reflect.TypeOf((*MockVpoolKeeper)(nil).GetSnapshotRetentionWindow), arg0)
+}
+
 // IsOverSpreadLimit mocks base method.
 func (m *MockVpoolKeeper) IsOverSpreadLimit(arg0 types2.Context, arg1 common.AssetPair) bool {
 	m.ctrl.T.Helper()
@@ -13,7 +13,7 @@ import (
 	"github.com/NibiruChain/nibiru/x/vpool/keeper"
 )
 
-// EndBlocker Called every block to store a snapshot of the vpool.
+// EndBlocker Called every block to store a snapshot of the vpool and prune the expired snapshots.
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 	for _, pool := range k.Pools.Iterate(ctx, collections.Range[common.AssetPair]{}).Values() {
 		snapshot := types.NewReserveSnapshot(
@@ -33,5 +33,7 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 			BlockTimestamp: ctx.BlockTime(),
 		})
 	}
+
+	k.PruneReserveSnapshots(ctx)
 	return []abci.ValidatorUpdate{}
 }
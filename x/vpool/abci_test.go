@@ -40,6 +40,8 @@ func TestSnapshotUpdates(t *testing.T) {
 		sdk.OneDec(),
 		sdk.OneDec(),
 		sdk.NewDec(10),
+		/* maxOpenInterest */ sdk.ZeroDec(),
+		/* maxPositionSize */ sdk.ZeroDec(),
 	)
 	expectedSnapshot := types.NewReserveSnapshot(
 		common.Pair_BTC_NUSD,
@@ -88,3 +90,62 @@ func TestSnapshotUpdates(t *testing.T) {
 		BlockTimestamp: ctxAtSnapshot.BlockTime(),
 	})
 }
+
+func TestSnapshotPruning(t *testing.T) {
+	nibiruApp, ctx := simapp.NewTestNibiruAppAndContext(true)
+	vpoolKeeper := nibiruApp.VpoolKeeper
+	start := time.Date(2015, 10, 21, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(start).WithBlockHeight(1)
+
+	vpoolKeeper.CreatePool(
+		ctx,
+		common.Pair_BTC_NUSD,
+		sdk.OneDec(),
+		sdk.NewDec(10),
+		sdk.NewDec(10),
+		sdk.NewDec(3),
+		sdk.OneDec(),
+		sdk.OneDec(),
+		sdk.NewDec(10),
+		/* maxOpenInterest */ sdk.ZeroDec(),
+		/* maxPositionSize */ sdk.ZeroDec(),
+	)
+	vpoolKeeper.SetParams(ctx, types.NewParams(10*time.Second, sdk.ZeroDec(), types.DefaultRepegEpochIdentifier))
+
+	snapshotTimes := func() (times []time.Time) {
+		for _, key := range vpoolKeeper.ReserveSnapshots.Iterate(ctx, collections.PairRange[common.AssetPair, time.Time]{}.Prefix(common.Pair_BTC_NUSD)).Keys() {
+			times = append(times, key.K2())
+		}
+		return times
+	}
+
+	t.Log("store a backlog of snapshots larger than the per block limit")
+	for i := 0; i < types.MaxSnapshotsPrunedPerBlock+50; i++ {
+		snapshotTime := start.Add(-time.Duration(i+1) * time.Minute)
+		vpoolKeeper.ReserveSnapshots.Insert(ctx, collections.Join(common.Pair_BTC_NUSD, snapshotTime),
+			types.NewReserveSnapshot(common.Pair_BTC_NUSD, sdk.NewDec(10), sdk.NewDec(10), snapshotTime))
+	}
+
+	require.EqualValues(t, types.MaxSnapshotsPrunedPerBlock, vpoolKeeper.PruneReserveSnapshots(ctx))
+	require.Len(t, snapshotTimes(), 51)
+
+	t.Log("the latest snapshot before the retention window is kept")
+	require.EqualValues(t, 49, vpoolKeeper.PruneReserveSnapshots(ctx))
+	assert.EqualValues(t, []time.Time{start.Add(-time.Minute), start}, snapshotTimes())
+
+	t.Log("snapshots are pruned as blocks are produced")
+	for i := 0; i < 10; i++ {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(5 * time.Second))
+		vpool.EndBlocker(ctx, vpoolKeeper)
+	}
+	assert.EqualValues(t, []time.Time{
+		start.Add(35 * time.Second),
+		start.Add(40 * time.Second),
+		start.Add(45 * time.Second),
+		start.Add(50 * time.Second),
+	}, snapshotTimes())
+
+	t.Log("a zero retention window disables pruning")
+	vpoolKeeper.SetParams(ctx, types.NewParams(0, sdk.ZeroDec(), types.DefaultRepegEpochIdentifier))
+	require.Zero(t, vpoolKeeper.PruneReserveSnapshots(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))))
+}
@@ -86,6 +86,8 @@ func (s *IntegrationTestSuite) TestGovAddVpool() {
 		MaxOracleSpreadRatio:   sdk.MustNewDecFromStr("0.05"),
 		MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
 		MaxLeverage:            sdk.MustNewDecFromStr("15"),
+		MaxOpenInterest:        sdk.NewDec(100_000),
+		MaxPositionSize:        sdk.NewDec(10_000),
 	}
 	proposalFile := sdktestutil.WriteToNewTempFile(s.T(), string(val.ClientCtx.Codec.MustMarshalJSON(proposal)))
 	contents, err := ioutil.ReadFile(proposalFile.Name())
@@ -209,6 +211,8 @@ e.g. $ nibid tx gov vote 1 yes`)
 				MaxOracleSpreadRatio:   proposal.MaxOracleSpreadRatio,
 				MaintenanceMarginRatio: proposal.MaintenanceMarginRatio,
 				MaxLeverage:            proposal.MaxLeverage,
+				MaxOpenInterest:        proposal.MaxOpenInterest,
+				MaxPositionSize:        proposal.MaxPositionSize,
 			}, pool)
 			found = true
 		}
@@ -25,6 +25,9 @@ var (
 	ParamMaxOracleSpreadRatio   = "maxOracle-spread-ratio"
 	ParamMaintenanceMarginRatio = "maintenance-margin-ratio"
 	ParamMaxLeverage            = "max-leverage"
+
+	FlagMaxOpenInterest = "max-open-interest"
+	FlagMaxPositionSize = "max-position-size"
 )
 
 // AddVPoolGenesisCmd returns add-vpool-genesis
@@ -60,6 +63,15 @@ func AddVPoolGenesisCmd(defaultNodeHome string) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if vPool.MaxOpenInterest, err = decFromFlag(cmd, FlagMaxOpenInterest); err != nil {
+				return err
+			}
+			if vPool.MaxPositionSize, err = decFromFlag(cmd, FlagMaxPositionSize); err != nil {
+				return err
+			}
+			if err = vPool.Validate(); err != nil {
+				return err
+			}
 
 			vPoolGenState := types.GetGenesisStateFromAppState(clientCtx.Codec, appState)
 			vPoolGenState.Vpools = append(vPoolGenState.Vpools, vPool)
@@ -82,6 +94,8 @@ func AddVPoolGenesisCmd(defaultNodeHome string) *cobra.Command {
 	}
 
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(FlagMaxOpenInterest, "0", "Cap on the long and on the short open interest in base asset units, 0 for no cap")
+	cmd.Flags().String(FlagMaxPositionSize, "0", "Cap on the size of a single position in base asset units, 0 for no cap")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -135,7 +149,17 @@ func parseVpoolParams(args []string) (types.VPool, error) {
 		MaxOracleSpreadRatio:   maxOracleSpread,
 		MaintenanceMarginRatio: maintenanceMarginRatio,
 		MaxLeverage:            maxLeverage,
+		MaxOpenInterest:        sdk.ZeroDec(),
+		MaxPositionSize:        sdk.ZeroDec(),
 	}
 
 	return vPool, vPool.Validate()
 }
+
+func decFromFlag(cmd *cobra.Command, flag string) (sdk.Dec, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return sdk.NewDecFromStr(value)
+}
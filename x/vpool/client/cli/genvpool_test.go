@@ -33,6 +33,7 @@ func TestAddGenesisVpoolCmd(t *testing.T) {
 		maxOracle     string
 		maintainRatio string
 		maxLeverage   string
+		flags         []string
 		expectError   bool
 	}{
 		{
@@ -107,6 +108,35 @@ func TestAddGenesisVpoolCmd(t *testing.T) {
 			maxLeverage:   "10",
 			expectError:   false,
 		},
+		{
+			name:          "negative max open interest",
+			pairName:      "token0:token1",
+			baseAsset:     "100",
+			quoteAsset:    "100",
+			tradeLimit:    "0.1",
+			flucLimit:     "0.1",
+			maxOracle:     "0.1",
+			maintainRatio: "0.1",
+			maxLeverage:   "10",
+			flags:         []string{fmt.Sprintf("--%s=-1", cli.FlagMaxOpenInterest)},
+			expectError:   true,
+		},
+		{
+			name:          "valid vpool pair with open interest and position size caps",
+			pairName:      "token0:token1",
+			baseAsset:     "100",
+			quoteAsset:    "100",
+			tradeLimit:    "0.1",
+			flucLimit:     "0.1",
+			maxOracle:     "0.1",
+			maintainRatio: "0.1",
+			maxLeverage:   "10",
+			flags: []string{
+				fmt.Sprintf("--%s=50", cli.FlagMaxOpenInterest),
+				fmt.Sprintf("--%s=5", cli.FlagMaxPositionSize),
+			},
+			expectError: false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -130,7 +160,7 @@ func TestAddGenesisVpoolCmd(t *testing.T) {
 			ctx = context.WithValue(ctx, server.ServerContextKey, serverCtx)
 
 			cmd := cli.AddVPoolGenesisCmd("home")
-			cmd.SetArgs([]string{
+			cmd.SetArgs(append([]string{
 				tc.pairName,
 				tc.baseAsset,
 				tc.quoteAsset,
@@ -139,7 +169,7 @@ func TestAddGenesisVpoolCmd(t *testing.T) {
 				tc.maxOracle,
 				tc.maintainRatio,
 				tc.maxLeverage,
-				fmt.Sprintf("--%s=home", flags.FlagHome)})
+				fmt.Sprintf("--%s=home", flags.FlagHome)}, tc.flags...))
 
 			if tc.expectError {
 				require.Error(t, cmd.ExecuteContext(ctx))
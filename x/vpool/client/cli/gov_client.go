@@ -31,6 +31,42 @@ var (
 				},
 			}
 		})
+
+	ShutdownPoolProposalHandler = govclient.NewProposalHandler(
+		/* govclient.CLIHandlerFn */ CmdShutdownPoolProposal,
+		/* govclient.RESTHandlerFn */ func(context client.Context) govclientrest.ProposalRESTHandler {
+			return govclientrest.ProposalRESTHandler{
+				SubRoute: "shutdown_pool",
+				Handler: func(writer http.ResponseWriter, request *http.Request) {
+					_, _ = writer.Write([]byte("deprecated"))
+					writer.WriteHeader(http.StatusMethodNotAllowed)
+				},
+			}
+		})
+
+	EditPoolConfigProposalHandler = govclient.NewProposalHandler(
+		/* govclient.CLIHandlerFn */ CmdEditPoolConfigProposal,
+		/* govclient.RESTHandlerFn */ func(context client.Context) govclientrest.ProposalRESTHandler {
+			return govclientrest.ProposalRESTHandler{
+				SubRoute: "edit_pool_config",
+				Handler: func(writer http.ResponseWriter, request *http.Request) {
+					_, _ = writer.Write([]byte("deprecated"))
+					writer.WriteHeader(http.StatusMethodNotAllowed)
+				},
+			}
+		})
+
+	RepegPoolProposalHandler = govclient.NewProposalHandler(
+		/* govclient.CLIHandlerFn */ CmdRepegPoolProposal,
+		/* govclient.RESTHandlerFn */ func(context client.Context) govclientrest.ProposalRESTHandler {
+			return govclientrest.ProposalRESTHandler{
+				SubRoute: "repeg_pool",
+				Handler: func(writer http.ResponseWriter, request *http.Request) {
+					_, _ = writer.Write([]byte("deprecated"))
+					writer.WriteHeader(http.StatusMethodNotAllowed)
+				},
+			}
+		})
 )
 
 // CmdCreatePoolProposal implements the client command to submit a governance
@@ -106,3 +142,232 @@ func CmdCreatePoolProposal() *cobra.Command {
 
 	return cmd
 }
+
+// CmdShutdownPoolProposal implements the client command to submit a governance
+// proposal to shut down a vpool and settle its positions.
+func CmdShutdownPoolProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "shutdown-pool [proposal-json] --deposit=[deposit]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to shut down a vpool",
+		Example: strings.TrimSpace(fmt.Sprintf(`
+			Example: 
+			$ %s tx gov submit-proposal shutdown-pool <path/to/proposal.json> --deposit="1000unibi" --from=<key_or_address> 
+			`, version.AppName)),
+		Long: strings.TrimSpace(
+			`Submits a proposal to shut down a vpool, which freezes trading on its x/perp
+			market and settles its positions at the pricefeed TWAP, or at the last mark
+			price if there is no TWAP.
+
+			A proposal.json for 'ShutdownPoolProposal' contains:
+			{
+			  "title": "Shut down vpool for ETH:USDT",
+			  "description": "Delist the ETH:USDT market",
+			  "pair": "ETH:USDT"
+			}
+			`),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			proposal := &types.ShutdownPoolProposal{}
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			// marshals the contents into the proto.Message to which 'proposal' points.
+			if err = clientCtx.Codec.UnmarshalJSON(contents, proposal); err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(proposal, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(
+		/*name=*/ govcli.FlagDeposit,
+		/*defaultValue=*/ "",
+		/*usage=*/ "governance deposit for proposal")
+	if err := cmd.MarkFlagRequired(govcli.FlagDeposit); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// CmdEditPoolConfigProposal implements the client command to submit a governance
+// proposal to edit the trading config of an existing vpool.
+func CmdEditPoolConfigProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit-pool-config [proposal-json] --deposit=[deposit]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to edit the config of a vpool",
+		Example: strings.TrimSpace(fmt.Sprintf(`
+			Example: 
+			$ %s tx gov submit-proposal edit-pool-config <path/to/proposal.json> --deposit="1000unibi" --from=<key_or_address> 
+			`, version.AppName)),
+		Long: strings.TrimSpace(
+			`Submits a proposal to replace the trading config of a vpool. The reserves of
+			the vpool are left untouched. Every config field must be set, including the
+			ones that don't change.
+
+			A proposal.json for 'EditPoolConfigProposal' contains:
+			{
+			  "title": "Raise the maintenance margin ratio of ETH:USDT",
+			  "description": "ETH:USDT got too volatile for 15x leverage",
+			  "pair": "ETH:USDT",
+			  "trade_limit_ratio": "0.2",
+			  "maintenance_margin_ratio": "0.1",
+			  "max_leverage": "10",
+              ...
+			}
+			`),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			proposal := &types.EditPoolConfigProposal{}
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			// marshals the contents into the proto.Message to which 'proposal' points.
+			if err = clientCtx.Codec.UnmarshalJSON(contents, proposal); err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(proposal, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(
+		/*name=*/ govcli.FlagDeposit,
+		/*defaultValue=*/ "",
+		/*usage=*/ "governance deposit for proposal")
+	if err := cmd.MarkFlagRequired(govcli.FlagDeposit); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// CmdRepegPoolProposal implements the client command to submit a governance
+// proposal to move the peg and scale the invariant k of a vpool.
+func CmdRepegPoolProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "repeg-pool [proposal-json] --deposit=[deposit]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to repeg a vpool",
+		Example: strings.TrimSpace(fmt.Sprintf(`
+			Example: 
+			$ %s tx gov submit-proposal repeg-pool <path/to/proposal.json> --deposit="1000unibi" --from=<key_or_address> 
+			`, version.AppName)),
+		Long: strings.TrimSpace(
+			`Submits a proposal to move the mark price of a vpool to the peg price and to
+			multiply its invariant k by the k multiplier. A zero peg price keeps the
+			current mark price. The cost of the repeg, given the net position of the
+			traders, is paid by the perp ecosystem fund.
+
+			A proposal.json for 'RepegPoolProposal' contains:
+			{
+			  "title": "Repeg ETH:USDT",
+			  "description": "The ETH:USDT mark price drifted from the index price",
+			  "pair": "ETH:USDT",
+			  "peg_price": "1500",
+			  "k_multiplier": "1"
+			}
+			`),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			proposal := &types.RepegPoolProposal{}
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			// marshals the contents into the proto.Message to which 'proposal' points.
+			if err = clientCtx.Codec.UnmarshalJSON(contents, proposal); err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(proposal, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(
+		/*name=*/ govcli.FlagDeposit,
+		/*defaultValue=*/ "",
+		/*usage=*/ "governance deposit for proposal")
+	if err := cmd.MarkFlagRequired(govcli.FlagDeposit); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
@@ -16,6 +16,8 @@ import (
 // InitGenesis initializes the capability module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+
 	for _, vp := range genState.Vpools {
 		k.CreatePool(
 			ctx,
@@ -27,6 +29,8 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			vp.MaxOracleSpreadRatio,
 			vp.MaintenanceMarginRatio,
 			vp.MaxLeverage,
+			vp.MaxOpenInterest,
+			vp.MaxPositionSize,
 		)
 	}
 
@@ -34,12 +38,18 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		// TODO snapshot.TimestampMs can just be time...
 		k.ReserveSnapshots.Insert(ctx, collections.Join(snapshot.Pair, time.UnixMilli(snapshot.TimestampMs)), snapshot)
 	}
+
+	for _, settlement := range genState.Settlements {
+		k.Settlements.Insert(ctx, settlement.Pair, settlement)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Vpools:    k.Pools.Iterate(ctx, collections.Range[common.AssetPair]{}).Values(),
-		Snapshots: k.ReserveSnapshots.Iterate(ctx, collections.PairRange[common.AssetPair, time.Time]{}).Values(),
+		Vpools:      k.Pools.Iterate(ctx, collections.Range[common.AssetPair]{}).Values(),
+		Snapshots:   k.ReserveSnapshots.Iterate(ctx, collections.PairRange[common.AssetPair, time.Time]{}).Values(),
+		Settlements: k.Settlements.Iterate(ctx, collections.Range[common.AssetPair]{}).Values(),
+		Params:      k.GetParams(ctx),
 	}
 }
@@ -25,6 +25,8 @@ func TestGenesis(t *testing.T) {
 			MaxOracleSpreadRatio:   sdk.MustNewDecFromStr("0.20"),
 			MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
 			MaxLeverage:            sdk.MustNewDecFromStr("15"),
+			MaxOpenInterest:        sdk.NewDec(10_000_000),
+			MaxPositionSize:        sdk.NewDec(1_000_000),
 		},
 		{
 			Pair:                   common.MustNewAssetPair("ETH:NUSD"),
@@ -35,6 +37,8 @@ func TestGenesis(t *testing.T) {
 			MaxOracleSpreadRatio:   sdk.MustNewDecFromStr("0.30"),
 			MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
 			MaxLeverage:            sdk.MustNewDecFromStr("15"),
+			MaxOpenInterest:        sdk.ZeroDec(),
+			MaxPositionSize:        sdk.ZeroDec(),
 		},
 	}
 
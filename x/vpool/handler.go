@@ -24,7 +24,7 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 	}
 }
 
-func NewCreatePoolProposalHandler(k keeper.Keeper) govtypes.Handler {
+func NewVpoolProposalHandler(k keeper.Keeper) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) error {
 		switch m := content.(type) {
 		case *types.CreatePoolProposal:
@@ -42,8 +42,37 @@ func NewCreatePoolProposalHandler(k keeper.Keeper) govtypes.Handler {
 				m.MaxOracleSpreadRatio,
 				m.MaintenanceMarginRatio,
 				m.MaxLeverage,
+				m.MaxOpenInterest,
+				m.MaxPositionSize,
 			)
 			return nil
+		case *types.ShutdownPoolProposal:
+			if err := m.ValidateBasic(); err != nil {
+				return err
+			}
+			_, err := k.ShutdownPool(ctx, common.MustNewAssetPair(m.Pair))
+			return err
+		case *types.EditPoolConfigProposal:
+			if err := m.ValidateBasic(); err != nil {
+				return err
+			}
+			_, err := k.EditPoolConfig(ctx, m)
+			return err
+		case *types.RepegPoolProposal:
+			if err := m.ValidateBasic(); err != nil {
+				return err
+			}
+			pair := common.MustNewAssetPair(m.Pair)
+			pegPrice := m.PegPrice
+			if pegPrice.IsZero() {
+				pool, err := k.Pools.Get(ctx, pair)
+				if err != nil {
+					return types.ErrPairNotSupported.Wrapf("%s", pair)
+				}
+				pegPrice = pool.GetMarkPrice()
+			}
+			_, err := k.RepegPool(ctx, pair, pegPrice, m.KMultiplier)
+			return err
 		default:
 			return sdkerrors.Wrapf(
 				sdkerrors.ErrUnknownRequest,
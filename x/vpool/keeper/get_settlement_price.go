@@ -4,9 +4,63 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/vpool/types"
 )
 
-// TODO(mercilex): implement
+/*
+ShutdownPool freezes trading on a vpool and fixes the price at which its
+positions are settled. The settlement price is the pricefeed TWAP of the pair
+if there is one, and the last mark price of the vpool otherwise.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair of the vpool to shut down
+
+ret:
+  - settlementPrice: the price at which positions are settled
+  - err: error
+*/
+func (k Keeper) ShutdownPool(ctx sdk.Context, pair common.AssetPair) (settlementPrice sdk.Dec, err error) {
+	pool, err := k.Pools.Get(ctx, pair)
+	if err != nil {
+		return sdk.Dec{}, types.ErrPairNotSupported.Wrapf("%s", pair)
+	}
+
+	if _, err = k.Settlements.Get(ctx, pair); err == nil {
+		return sdk.Dec{}, types.ErrPoolShutdown.Wrapf("%s", pair)
+	}
+
+	settlementPrice, err = k.pricefeedKeeper.GetCurrentTWAP(ctx, pair.Token0, pair.Token1)
+	if err != nil || !settlementPrice.IsPositive() {
+		settlementPrice = pool.GetMarkPrice()
+	}
+
+	k.Settlements.Insert(ctx, pair, types.PoolSettlement{
+		Pair:            pair,
+		SettlementPrice: settlementPrice,
+		BlockHeight:     ctx.BlockHeight(),
+	})
+
+	return settlementPrice, ctx.EventManager().EmitTypedEvent(&types.PoolShutdownEvent{
+		Pair:            pair.String(),
+		SettlementPrice: settlementPrice,
+		BlockHeight:     ctx.BlockHeight(),
+	})
+}
+
+// GetSettlementPrice returns the price at which the positions of a vpool which
+// has been shut down are settled. Errors if the vpool has not been shut down.
 func (k Keeper) GetSettlementPrice(ctx sdk.Context, pair common.AssetPair) (sdk.Dec, error) {
-	panic("impl")
+	settlement, err := k.Settlements.Get(ctx, pair)
+	if err != nil {
+		return sdk.Dec{}, types.ErrPoolNotShutdown.Wrapf("%s", pair)
+	}
+
+	return settlement.SettlementPrice, nil
+}
+
+// IsPoolShutdown returns true if the vpool has been shut down.
+func (k Keeper) IsPoolShutdown(ctx sdk.Context, pair common.AssetPair) bool {
+	_, err := k.Settlements.Get(ctx, pair)
+	return err == nil
 }
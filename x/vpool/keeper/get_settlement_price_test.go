@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/testutil/mock"
+	"github.com/NibiruChain/nibiru/x/vpool/types"
+)
+
+func TestShutdownPool(t *testing.T) {
+	tests := []struct {
+		name          string
+		twap          sdk.Dec
+		twapErr       error
+		expectedPrice sdk.Dec
+	}{
+		{
+			name:          "settles at the pricefeed twap",
+			twap:          sdk.NewDec(3),
+			expectedPrice: sdk.NewDec(3),
+		},
+		{
+			name:          "settles at the mark price without twap",
+			twap:          sdk.OneDec().Neg(),
+			twapErr:       fmt.Errorf("no twap"),
+			expectedPrice: sdk.NewDec(2),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pfKeeper := mock.NewMockPricefeedKeeper(gomock.NewController(t))
+			pfKeeper.EXPECT().IsActivePair(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+			pfKeeper.EXPECT().
+				GetCurrentTWAP(gomock.Any(), common.DenomBTC, common.DenomNUSD).
+				Return(tc.twap, tc.twapErr)
+
+			vpoolKeeper, ctx := VpoolKeeper(t, pfKeeper)
+			vpoolKeeper.CreatePool(
+				ctx,
+				common.Pair_BTC_NUSD,
+				/* tradeLimitRatio */ sdk.MustNewDecFromStr("0.9"),
+				/* quoteAssetReserve */ sdk.NewDec(10_000_000),
+				/* baseAssetReserve */ sdk.NewDec(5_000_000),
+				/* fluctuationLimitRatio */ sdk.MustNewDecFromStr("0.1"),
+				/* maxOracleSpreadRatio */ sdk.MustNewDecFromStr("0.1"),
+				/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
+				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+				/* maxOpenInterest */ sdk.ZeroDec(),
+				/* maxPositionSize */ sdk.ZeroDec(),
+			)
+
+			_, err := vpoolKeeper.GetSettlementPrice(ctx, common.Pair_BTC_NUSD)
+			require.ErrorIs(t, err, types.ErrPoolNotShutdown)
+
+			settlementPrice, err := vpoolKeeper.ShutdownPool(ctx, common.Pair_BTC_NUSD)
+			require.NoError(t, err)
+			assert.EqualValues(t, tc.expectedPrice, settlementPrice)
+
+			price, err := vpoolKeeper.GetSettlementPrice(ctx, common.Pair_BTC_NUSD)
+			require.NoError(t, err)
+			assert.EqualValues(t, tc.expectedPrice, price)
+
+			t.Log("trading is frozen")
+			_, err = vpoolKeeper.SwapQuoteForBase(
+				ctx, common.Pair_BTC_NUSD, types.Direction_ADD_TO_POOL, sdk.NewDec(10), sdk.ZeroDec(), false)
+			require.ErrorIs(t, err, types.ErrPoolShutdown)
+			_, err = vpoolKeeper.SwapBaseForQuote(
+				ctx, common.Pair_BTC_NUSD, types.Direction_ADD_TO_POOL, sdk.NewDec(10), sdk.ZeroDec(), false)
+			require.ErrorIs(t, err, types.ErrPoolShutdown)
+
+			t.Log("cannot shut down twice")
+			_, err = vpoolKeeper.ShutdownPool(ctx, common.Pair_BTC_NUSD)
+			require.ErrorIs(t, err, types.ErrPoolShutdown)
+		})
+	}
+
+	t.Run("pair not supported", func(t *testing.T) {
+		vpoolKeeper, ctx := VpoolKeeper(t, mock.NewMockPricefeedKeeper(gomock.NewController(t)))
+
+		_, err := vpoolKeeper.ShutdownPool(ctx, common.Pair_BTC_NUSD)
+		require.ErrorIs(t, err, types.ErrPairNotSupported)
+	})
+}
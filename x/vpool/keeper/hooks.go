@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/NibiruChain/nibiru/x/epochs/types"
+)
+
+func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber uint64) {
+}
+
+// AfterEpochEnd repegs the vpools that drifted from their index TWAP at the end of
+// every repeg epoch, if automatic repegging is enabled.
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ uint64) {
+	params := k.GetParams(ctx)
+	if !params.IsRepegEnabled() || epochIdentifier != params.RepegEpochIdentifier {
+		return
+	}
+	k.repegPools(ctx)
+}
+
+// Hooks implements the epochs hooks of the vpool module.
+type Hooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = Hooks{}
+
+// Hooks returns the epochs hooks of the vpool keeper.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// BeforeEpochStart epochs hooks.
+func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber uint64) {
+	h.k.BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+}
+
+// AfterEpochEnd epochs hooks.
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber uint64) {
+	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/vpool/types"
+)
+
+// RegisterInvariants registers the vpool module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "valid-pools", ValidPoolsInvariant(k))
+}
+
+// ValidPoolsInvariant checks that the reserves of every pool are positive and that its
+// config respects the limits enforced when the pool is created or edited.
+func ValidPoolsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, pool := range k.Pools.Iterate(ctx, collections.Range[common.AssetPair]{}).Values() {
+			if err := pool.Validate(); err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tpool %s is invalid: %s\n", pool.Pair, err)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "valid-pools", msg), broken
+	}
+}
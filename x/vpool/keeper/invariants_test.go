@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common"
+)
+
+func TestValidPoolsInvariant(t *testing.T) {
+	vpoolKeeper, _, ctx := getKeeper(t)
+	invariant := ValidPoolsInvariant(vpoolKeeper)
+
+	vpoolKeeper.CreatePool(
+		ctx,
+		common.Pair_BTC_NUSD,
+		/* tradeLimitRatio */ sdk.MustNewDecFromStr("0.9"),
+		/* quoteAssetReserve */ sdk.NewDec(10_000_000),
+		/* baseAssetReserve */ sdk.NewDec(5_000_000),
+		/* fluctuationLimitRatio */ sdk.MustNewDecFromStr("0.1"),
+		/* maxOracleSpreadRatio */ sdk.MustNewDecFromStr("0.1"),
+		/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
+		/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+		/* maxOpenInterest */ sdk.ZeroDec(),
+		/* maxPositionSize */ sdk.ZeroDec(),
+	)
+	msg, broken := invariant(ctx)
+	require.False(t, broken, msg)
+
+	t.Log("a pool with an empty reserve breaks the invariant")
+	pool, err := vpoolKeeper.Pools.Get(ctx, common.Pair_BTC_NUSD)
+	require.NoError(t, err)
+	pool.BaseAssetReserve = sdk.ZeroDec()
+	vpoolKeeper.Pools.Insert(ctx, pool.Pair, pool)
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+
+	t.Log("a pool whose config is out of bounds breaks the invariant")
+	pool.BaseAssetReserve = sdk.NewDec(5_000_000)
+	pool.MaxLeverage = sdk.ZeroDec()
+	vpoolKeeper.Pools.Insert(ctx, pool.Pair, pool)
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/NibiruChain/nibiru/x/common"
@@ -17,11 +18,18 @@ import (
 func NewKeeper(
 	codec codec.BinaryCodec,
 	storeKey sdk.StoreKey,
+	paramSubspace paramtypes.Subspace,
 	pricefeedKeeper types.PricefeedKeeper,
 ) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSubspace.HasKeyTable() {
+		paramSubspace = paramSubspace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		codec:           codec,
 		storeKey:        storeKey,
+		ParamSubspace:   paramSubspace,
 		pricefeedKeeper: pricefeedKeeper,
 		Pools:           collections.NewMap(storeKey, 0, common.AssetPairKeyEncoder, collections.ProtoValueEncoder[types.VPool](codec)),
 		ReserveSnapshots: collections.NewMap(
@@ -29,22 +37,53 @@ func NewKeeper(
 			collections.PairKeyEncoder(common.AssetPairKeyEncoder, collections.TimeKeyEncoder),
 			collections.ProtoValueEncoder[types.ReserveSnapshot](codec),
 		),
+		Settlements: collections.NewMap(storeKey, 2, common.AssetPairKeyEncoder, collections.ProtoValueEncoder[types.PoolSettlement](codec)),
 	}
 }
 
 type Keeper struct {
 	codec           codec.BinaryCodec
 	storeKey        sdk.StoreKey
+	ParamSubspace   paramtypes.Subspace
 	pricefeedKeeper types.PricefeedKeeper
+	positionsKeeper types.PositionsKeeper
 
 	Pools            collections.Map[common.AssetPair, types.VPool]
 	ReserveSnapshots collections.Map[collections.Pair[common.AssetPair, time.Time], types.ReserveSnapshot]
+	// Settlements holds the settlement of the vpools which have been shut down.
+	Settlements collections.Map[common.AssetPair, types.PoolSettlement]
+}
+
+// SetPositionsKeeper sets the keeper of the positions traded on the vpools. It can't be
+// passed to NewKeeper, since x/perp depends on x/vpool.
+func (k *Keeper) SetPositionsKeeper(positionsKeeper types.PositionsKeeper) *Keeper {
+	if k.positionsKeeper != nil {
+		panic("cannot set positions keeper twice")
+	}
+	k.positionsKeeper = positionsKeeper
+	return k
 }
 
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// GetParams returns the module params.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.ParamSubspace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the module params.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.ParamSubspace.SetParamSet(ctx, &params)
+}
+
+// GetSnapshotRetentionWindow returns how long the reserve snapshots are kept before being pruned.
+func (k Keeper) GetSnapshotRetentionWindow(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).SnapshotRetentionWindow
+}
+
 /*
 SwapBaseForQuote
 Trades baseAssets in exchange for quoteAssets.
@@ -84,6 +123,10 @@ func (k Keeper) SwapBaseForQuote(
 		return sdk.Dec{}, types.ErrPairNotSupported
 	}
 
+	if k.IsPoolShutdown(ctx, pair) {
+		return sdk.Dec{}, types.ErrPoolShutdown.Wrapf("%s", pair)
+	}
+
 	if !pool.HasEnoughBaseReserve(baseAmt) {
 		return sdk.Dec{}, types.ErrOverTradingLimit
 	}
@@ -175,6 +218,10 @@ func (k Keeper) SwapQuoteForBase(
 		return sdk.Dec{}, types.ErrPairNotSupported
 	}
 
+	if k.IsPoolShutdown(ctx, pair) {
+		return sdk.Dec{}, types.ErrPoolShutdown.Wrapf("%s", pair)
+	}
+
 	// check trade limit ratio on quote in either direction
 	if !pool.HasEnoughQuoteReserve(quoteAmt) {
 		return sdk.Dec{}, types.ErrOverTradingLimit.Wrapf(
@@ -346,6 +393,35 @@ func (k Keeper) GetMaxLeverage(ctx sdk.Context, pair common.AssetPair) sdk.Dec {
 	return pool.MaxLeverage
 }
 
+/*
+GetPositionLimits returns the caps on the long and on the short open interest of the pool,
+and on the size of a single position in the pool, in base asset units. Zero means there
+is no cap.
+
+args:
+  - ctx: the cosmos-sdk context
+  - pair: the asset pair
+
+ret:
+  - maxOpenInterest: the cap on the open interest of each side
+  - maxPositionSize: the cap on the size of a single position
+*/
+func (k Keeper) GetPositionLimits(ctx sdk.Context, pair common.AssetPair) (maxOpenInterest sdk.Dec, maxPositionSize sdk.Dec) {
+	pool, err := k.Pools.Get(ctx, pair)
+	if err != nil {
+		panic(err)
+	}
+
+	maxOpenInterest, maxPositionSize = pool.MaxOpenInterest, pool.MaxPositionSize
+	if maxOpenInterest.IsNil() {
+		maxOpenInterest = sdk.ZeroDec()
+	}
+	if maxPositionSize.IsNil() {
+		maxPositionSize = sdk.ZeroDec()
+	}
+	return maxOpenInterest, maxPositionSize
+}
+
 /*
 GetAllPools returns an array of all the pools
 
@@ -180,6 +180,8 @@ func TestSwapQuoteForBase(t *testing.T) {
 				/* maxOracleSpreadRatio */ sdk.MustNewDecFromStr("0.1"),
 				/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+				/* maxOpenInterest */ sdk.ZeroDec(),
+				/* maxPositionSize */ sdk.ZeroDec(),
 			)
 
 			baseAmt, err := vpoolKeeper.SwapQuoteForBase(
@@ -371,6 +373,8 @@ func TestSwapBaseForQuote(t *testing.T) {
 				/* maxOracleSpreadRatio */ sdk.MustNewDecFromStr("0.1"),
 				/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+				/* maxOpenInterest */ sdk.ZeroDec(),
+				/* maxPositionSize */ sdk.ZeroDec(),
 			)
 
 			quoteAssetAmount, err := vpoolKeeper.SwapBaseForQuote(
@@ -414,6 +418,8 @@ func TestGetVpools(t *testing.T) {
 		sdk.OneDec(),
 		sdk.MustNewDecFromStr("0.0625"),
 		sdk.MustNewDecFromStr("15"),
+		/* maxOpenInterest */ sdk.ZeroDec(),
+		/* maxPositionSize */ sdk.ZeroDec(),
 	)
 	vpoolKeeper.CreatePool(
 		ctx,
@@ -425,6 +431,8 @@ func TestGetVpools(t *testing.T) {
 		sdk.OneDec(),
 		sdk.MustNewDecFromStr("0.0625"),
 		sdk.MustNewDecFromStr("15"),
+		/* maxOpenInterest */ sdk.ZeroDec(),
+		/* maxPositionSize */ sdk.ZeroDec(),
 	)
 
 	pools := vpoolKeeper.Pools.Iterate(ctx, collections.Range[common.AssetPair]{}).Values()
@@ -440,6 +448,8 @@ func TestGetVpools(t *testing.T) {
 		MaxOracleSpreadRatio:   sdk.OneDec(),
 		MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
 		MaxLeverage:            sdk.MustNewDecFromStr("15"),
+		MaxOpenInterest:        sdk.ZeroDec(),
+		MaxPositionSize:        sdk.ZeroDec(),
 	})
 	require.EqualValues(t, pools[1], types.VPool{
 		Pair:                   common.Pair_ETH_NUSD,
@@ -450,6 +460,8 @@ func TestGetVpools(t *testing.T) {
 		MaxOracleSpreadRatio:   sdk.OneDec(),
 		MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
 		MaxLeverage:            sdk.MustNewDecFromStr("15"),
+		MaxOpenInterest:        sdk.ZeroDec(),
+		MaxPositionSize:        sdk.ZeroDec(),
 	})
 }
 
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/vpool/types"
+)
+
+// Migrator handles the in-place store migrations of the x/vpool module.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 sets the params, which the previous versions do not have, to their
+// default values in the param subspace of the module.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params := types.DefaultParams()
+	m.keeper.ParamSubspace.GetParamSetIfExists(ctx, &params)
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/testutil/mock"
+	"github.com/NibiruChain/nibiru/x/vpool/types"
+)
+
+func TestMigrate2to3(t *testing.T) {
+	vpoolKeeper, ctx := vpoolKeeperWithoutParams(t, mock.NewMockPricefeedKeeper(nil))
+	require.False(t, vpoolKeeper.ParamSubspace.Has(ctx, types.KeySnapshotRetentionWindow))
+
+	t.Log("migrate the store")
+	require.NoError(t, NewMigrator(vpoolKeeper).Migrate2to3(ctx))
+
+	t.Log("assert the params are set to their default values")
+	assert.Equal(t, types.DefaultParams(), vpoolKeeper.GetParams(ctx))
+
+	t.Log("assert the params already set are kept")
+	vpoolKeeper.ParamSubspace.Set(ctx, types.KeyRepegSpreadRatio, sdk.MustNewDecFromStr("0.1"))
+	vpoolKeeper.ParamSubspace.Set(ctx, types.KeySnapshotRetentionWindow, time.Hour)
+	require.NoError(t, NewMigrator(vpoolKeeper).Migrate2to3(ctx))
+	assert.Equal(t, sdk.MustNewDecFromStr("0.1"), vpoolKeeper.GetParams(ctx).RepegSpreadRatio)
+	assert.Equal(t, time.Hour, vpoolKeeper.GetParams(ctx).SnapshotRetentionWindow)
+}
@@ -11,7 +11,8 @@ import (
 	"github.com/NibiruChain/nibiru/x/vpool/types"
 )
 
-// CreatePool creates a pool for a specific pair.
+// CreatePool creates a pool for a specific pair. A zero maxOpenInterest or
+// maxPositionSize leaves the open interest or the position size uncapped.
 func (k Keeper) CreatePool(
 	ctx sdk.Context,
 	pair common.AssetPair,
@@ -22,6 +23,8 @@ func (k Keeper) CreatePool(
 	maxOracleSpreadRatio sdk.Dec,
 	maintenanceMarginRatio sdk.Dec,
 	maxLeverage sdk.Dec,
+	maxOpenInterest sdk.Dec,
+	maxPositionSize sdk.Dec,
 ) {
 	k.Pools.Insert(ctx, pair, types.VPool{
 		Pair:                   pair,
@@ -32,6 +35,8 @@ func (k Keeper) CreatePool(
 		MaxOracleSpreadRatio:   maxOracleSpreadRatio,
 		MaintenanceMarginRatio: maintenanceMarginRatio,
 		MaxLeverage:            maxLeverage,
+		MaxOpenInterest:        maxOpenInterest,
+		MaxPositionSize:        maxPositionSize,
 	})
 
 	k.ReserveSnapshots.Insert(
@@ -46,6 +51,76 @@ func (k Keeper) CreatePool(
 	)
 }
 
+/*
+EditPoolConfig replaces the trading config of the pool of the proposal pair, leaving
+its reserves untouched. Pools which have been shut down can't be edited.
+
+The open positions of the pool are counted before and after the edit, so that the
+positions which the new config makes liquidatable, e.g. with a higher maintenance
+margin ratio, are reported in the emitted event.
+
+ret:
+  - positionsMadeLiquidatable: the number of positions that the edit made liquidatable
+  - err: error
+*/
+func (k Keeper) EditPoolConfig(
+	ctx sdk.Context, proposal *types.EditPoolConfigProposal,
+) (positionsMadeLiquidatable uint64, err error) {
+	pair, err := common.NewAssetPair(proposal.Pair)
+	if err != nil {
+		return 0, err
+	}
+
+	pool, err := k.Pools.Get(ctx, pair)
+	if err != nil {
+		return 0, types.ErrPairNotSupported.Wrapf("%s", pair)
+	}
+	if _, err = k.Settlements.Get(ctx, pair); err == nil {
+		return 0, types.ErrPoolShutdown.Wrapf("%s", pair)
+	}
+
+	editedPool := proposal.ApplyTo(pool)
+	if err = editedPool.Validate(); err != nil {
+		return 0, err
+	}
+
+	liquidatableBefore, err := k.countLiquidatablePositions(ctx, pair)
+	if err != nil {
+		return 0, err
+	}
+	k.Pools.Insert(ctx, pair, *editedPool)
+	liquidatableAfter, err := k.countLiquidatablePositions(ctx, pair)
+	if err != nil {
+		return 0, err
+	}
+
+	if liquidatableAfter > liquidatableBefore {
+		positionsMadeLiquidatable = liquidatableAfter - liquidatableBefore
+	}
+	k.Logger(ctx).Info("vpool config edited",
+		"pair", pair.String(),
+		"liquidatable_positions", liquidatableAfter,
+		"positions_made_liquidatable", positionsMadeLiquidatable,
+	)
+
+	return positionsMadeLiquidatable, ctx.EventManager().EmitTypedEvent(&types.PoolConfigEditedEvent{
+		Pair:                      pair.String(),
+		MaintenanceMarginRatio:    editedPool.MaintenanceMarginRatio,
+		MaxLeverage:               editedPool.MaxLeverage,
+		LiquidatablePositions:     liquidatableAfter,
+		PositionsMadeLiquidatable: positionsMadeLiquidatable,
+	})
+}
+
+// countLiquidatablePositions returns the number of liquidatable positions on the pair,
+// or zero if no positions keeper is set.
+func (k Keeper) countLiquidatablePositions(ctx sdk.Context, pair common.AssetPair) (uint64, error) {
+	if k.positionsKeeper == nil {
+		return 0, nil
+	}
+	return k.positionsKeeper.CountLiquidatablePositions(ctx, pair)
+}
+
 /*
 Saves an updated pool to state and snapshots it.
 
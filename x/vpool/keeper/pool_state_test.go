@@ -11,6 +11,7 @@ import (
 
 	"github.com/NibiruChain/nibiru/x/common"
 	pftypes "github.com/NibiruChain/nibiru/x/pricefeed/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
 	"github.com/NibiruChain/nibiru/x/vpool/types"
 )
 
@@ -27,6 +28,8 @@ func TestCreatePool(t *testing.T) {
 		sdk.MustNewDecFromStr("0.1"), // 0.9 ratio
 		sdk.MustNewDecFromStr("0.0625"),
 		sdk.MustNewDecFromStr("15"),
+		/* maxOpenInterest */ sdk.ZeroDec(),
+		/* maxPositionSize */ sdk.ZeroDec(),
 	)
 
 	exists := vpoolKeeper.ExistsPool(ctx, common.Pair_BTC_NUSD)
@@ -39,6 +42,158 @@ func TestCreatePool(t *testing.T) {
 	require.False(t, notExist)
 }
 
+// positionsKeeperFake holds the margin ratios of the positions of a pair, which are
+// liquidatable below the maintenance margin ratio of the vpool, their net size and
+// the repeg costs paid.
+type positionsKeeperFake struct {
+	vpoolKeeper     *Keeper
+	marginRatios    []sdk.Dec
+	netPositionSize sdk.Dec
+	paidCosts       []sdk.Dec
+	payErr          error
+}
+
+func (f *positionsKeeperFake) GetNetPositionSize(sdk.Context, common.AssetPair) sdk.Dec {
+	if f.netPositionSize.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return f.netPositionSize
+}
+
+func (f *positionsKeeperFake) PayRepegCost(_ sdk.Context, _ common.AssetPair, cost sdk.Dec) error {
+	if f.payErr != nil {
+		return f.payErr
+	}
+	f.paidCosts = append(f.paidCosts, cost)
+	return nil
+}
+
+func (f *positionsKeeperFake) CountLiquidatablePositions(ctx sdk.Context, pair common.AssetPair) (uint64, error) {
+	pool, err := f.vpoolKeeper.Pools.Get(ctx, pair)
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint64
+	for _, marginRatio := range f.marginRatios {
+		if marginRatio.LT(pool.MaintenanceMarginRatio) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func TestEditPoolConfig(t *testing.T) {
+	newProposal := func(maintenanceMarginRatio string, maxLeverage string) *types.EditPoolConfigProposal {
+		return &types.EditPoolConfigProposal{
+			Title:                  "edit",
+			Description:            "edit the config of the BTC:NUSD vpool",
+			Pair:                   common.Pair_BTC_NUSD.String(),
+			TradeLimitRatio:        sdk.MustNewDecFromStr("0.5"),
+			FluctuationLimitRatio:  sdk.MustNewDecFromStr("0.2"),
+			MaxOracleSpreadRatio:   sdk.MustNewDecFromStr("0.2"),
+			MaintenanceMarginRatio: sdk.MustNewDecFromStr(maintenanceMarginRatio),
+			MaxLeverage:            sdk.MustNewDecFromStr(maxLeverage),
+			MaxOpenInterest:        sdk.NewDec(1_000),
+			MaxPositionSize:        sdk.NewDec(100),
+		}
+	}
+
+	setup := func(t *testing.T) (Keeper, sdk.Context) {
+		vpoolKeeper, _, ctx := getKeeper(t)
+		vpoolKeeper.CreatePool(
+			ctx,
+			common.Pair_BTC_NUSD,
+			/* tradeLimitRatio */ sdk.MustNewDecFromStr("0.9"),
+			/* quoteAssetReserve */ sdk.NewDec(10_000_000),
+			/* baseAssetReserve */ sdk.NewDec(5_000_000),
+			/* fluctuationLimitRatio */ sdk.MustNewDecFromStr("0.1"),
+			/* maxOracleSpreadRatio */ sdk.MustNewDecFromStr("0.1"),
+			/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
+			/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+			/* maxOpenInterest */ sdk.ZeroDec(),
+			/* maxPositionSize */ sdk.ZeroDec(),
+		)
+		vpoolKeeper.SetPositionsKeeper(&positionsKeeperFake{
+			vpoolKeeper: &vpoolKeeper,
+			marginRatios: []sdk.Dec{
+				sdk.MustNewDecFromStr("0.05"),
+				sdk.MustNewDecFromStr("0.08"),
+				sdk.MustNewDecFromStr("0.09"),
+				sdk.MustNewDecFromStr("0.5"),
+			},
+		})
+		return vpoolKeeper, ctx
+	}
+
+	t.Run("raising the maintenance margin ratio reports the positions made liquidatable", func(t *testing.T) {
+		vpoolKeeper, ctx := setup(t)
+
+		proposal := newProposal("0.1", "10")
+		positionsMadeLiquidatable, err := vpoolKeeper.EditPoolConfig(ctx, proposal)
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, positionsMadeLiquidatable)
+
+		pool, err := vpoolKeeper.Pools.Get(ctx, common.Pair_BTC_NUSD)
+		require.NoError(t, err)
+		assert.Equal(t, types.VPool{
+			Pair:                   common.Pair_BTC_NUSD,
+			BaseAssetReserve:       sdk.NewDec(5_000_000),
+			QuoteAssetReserve:      sdk.NewDec(10_000_000),
+			TradeLimitRatio:        proposal.TradeLimitRatio,
+			FluctuationLimitRatio:  proposal.FluctuationLimitRatio,
+			MaxOracleSpreadRatio:   proposal.MaxOracleSpreadRatio,
+			MaintenanceMarginRatio: proposal.MaintenanceMarginRatio,
+			MaxLeverage:            proposal.MaxLeverage,
+			MaxOpenInterest:        proposal.MaxOpenInterest,
+			MaxPositionSize:        proposal.MaxPositionSize,
+		}, pool)
+
+		testutil.RequireContainsTypedEvent(t, ctx, &types.PoolConfigEditedEvent{
+			Pair:                      common.Pair_BTC_NUSD.String(),
+			MaintenanceMarginRatio:    sdk.MustNewDecFromStr("0.1"),
+			MaxLeverage:               sdk.NewDec(10),
+			LiquidatablePositions:     3,
+			PositionsMadeLiquidatable: 2,
+		})
+	})
+
+	t.Run("lowering the maintenance margin ratio makes no position liquidatable", func(t *testing.T) {
+		vpoolKeeper, ctx := setup(t)
+
+		positionsMadeLiquidatable, err := vpoolKeeper.EditPoolConfig(ctx, newProposal("0.01", "20"))
+		require.NoError(t, err)
+		assert.EqualValues(t, 0, positionsMadeLiquidatable)
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		vpoolKeeper, ctx := setup(t)
+
+		_, err := vpoolKeeper.EditPoolConfig(ctx, newProposal("0.1", "15"))
+		require.Error(t, err)
+	})
+
+	t.Run("pair not supported", func(t *testing.T) {
+		vpoolKeeper, ctx := setup(t)
+
+		proposal := newProposal("0.1", "10")
+		proposal.Pair = "abc:xyz"
+		_, err := vpoolKeeper.EditPoolConfig(ctx, proposal)
+		require.ErrorIs(t, err, types.ErrPairNotSupported)
+	})
+
+	t.Run("pool shut down", func(t *testing.T) {
+		vpoolKeeper, ctx := setup(t)
+		vpoolKeeper.Settlements.Insert(ctx, common.Pair_BTC_NUSD, types.PoolSettlement{
+			Pair:            common.Pair_BTC_NUSD,
+			SettlementPrice: sdk.NewDec(2),
+		})
+
+		_, err := vpoolKeeper.EditPoolConfig(ctx, newProposal("0.1", "10"))
+		require.ErrorIs(t, err, types.ErrPoolShutdown)
+	})
+}
+
 func TestGetPoolPrices_SetupErrors(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -173,6 +328,8 @@ func TestGetPoolPrices(t *testing.T) {
 					tc.vpool.MaxOracleSpreadRatio,
 					tc.vpool.MaintenanceMarginRatio,
 					tc.vpool.MaxLeverage,
+					/* maxOpenInterest */ sdk.ZeroDec(),
+					/* maxPositionSize */ sdk.ZeroDec(),
 				)
 			}
 
@@ -37,6 +37,57 @@ func (k Keeper) GetMarkPrice(ctx sdk.Context, pair common.AssetPair) (sdk.Dec, e
 	return pool.GetMarkPrice(), nil
 }
 
+/*
+GetMarkPriceRange returns the lowest and highest mark prices of the pool within
+the lookback window, taken from its reserve snapshots and its current reserves.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the token pair
+  - lookbackInterval: how far back to look at the reserve snapshots
+
+ret:
+  - low: the lowest mark price in the window
+  - high: the highest mark price in the window
+  - err: error
+*/
+func (k Keeper) GetMarkPriceRange(
+	ctx sdk.Context,
+	pair common.AssetPair,
+	lookbackInterval time.Duration,
+) (low sdk.Dec, high sdk.Dec, err error) {
+	pool, err := k.Pools.Get(ctx, pair)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	low, high = pool.GetMarkPrice(), pool.GetMarkPrice()
+
+	lowerLimitTimestampMs := ctx.BlockTime().Add(-1 * lookbackInterval).UnixMilli()
+	iter := k.ReserveSnapshots.Iterate(
+		ctx,
+		collections.PairRange[common.AssetPair, time.Time]{}.
+			Prefix(pair).
+			EndInclusive(ctx.BlockTime()).
+			Descending(),
+	)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		s := iter.Value()
+		if s.TimestampMs < lowerLimitTimestampMs {
+			break
+		}
+		if s.BaseAssetReserve.IsZero() {
+			continue
+		}
+		price := s.QuoteAssetReserve.Quo(s.BaseAssetReserve)
+		low = sdk.MinDec(low, price)
+		high = sdk.MaxDec(high, price)
+	}
+
+	return low, high, nil
+}
+
 /*
 GetBaseAssetPrice
 So how much stablecoin you would get if you sold baseAssetAmount amount of perpetual contracts.
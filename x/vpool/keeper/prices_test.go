@@ -55,6 +55,8 @@ func TestGetMarkPrice(t *testing.T) {
 				sdk.OneDec(),
 				sdk.MustNewDecFromStr("0.0625"),
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+				/* maxOpenInterest */ sdk.ZeroDec(),
+				/* maxPositionSize */ sdk.ZeroDec(),
 			)
 
 			price, err := vpoolKeeper.GetMarkPrice(ctx, tc.pair)
@@ -129,6 +131,8 @@ func TestGetBaseAssetPrice(t *testing.T) {
 				sdk.OneDec(),
 				sdk.MustNewDecFromStr("0.0625"),
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+				/* maxOpenInterest */ sdk.ZeroDec(),
+				/* maxPositionSize */ sdk.ZeroDec(),
 			)
 
 			quoteAmount, err := vpoolKeeper.GetBaseAssetPrice(ctx, tc.pair, tc.direction, tc.baseAmount)
@@ -210,6 +214,8 @@ func TestGetQuoteAssetPrice(t *testing.T) {
 				sdk.OneDec(),
 				sdk.MustNewDecFromStr("0.0625"),
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+				/* maxOpenInterest */ sdk.ZeroDec(),
+				/* maxPositionSize */ sdk.ZeroDec(),
 			)
 
 			baseAmount, err := vpoolKeeper.GetQuoteAssetPrice(ctx, tc.pair, tc.direction, tc.quoteAmount)
@@ -522,6 +528,8 @@ func TestCalcTwap(t *testing.T) {
 				sdk.OneDec(),
 				sdk.OneDec(),
 				/* maxLeverage */ sdk.NewDec(15),
+				/* maxOpenInterest */ sdk.ZeroDec(),
+				/* maxPositionSize */ sdk.ZeroDec(),
 			)
 
 			t.Log("throw in another market pair to ensure key iteration doesn't overlap")
@@ -535,6 +543,8 @@ func TestCalcTwap(t *testing.T) {
 				sdk.OneDec(),
 				sdk.OneDec(),
 				/* maxLeverage */ sdk.NewDec(15),
+				/* maxOpenInterest */ sdk.ZeroDec(),
+				/* maxPositionSize */ sdk.ZeroDec(),
 			)
 
 			for _, snapshot := range tc.reserveSnapshots {
@@ -66,9 +66,11 @@ func TestQueryAllPools(t *testing.T) {
 		MaxOracleSpreadRatio:   sdk.ZeroDec(),
 		MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
 		MaxLeverage:            sdk.MustNewDecFromStr("15"),
+		MaxOpenInterest:        sdk.ZeroDec(),
+		MaxPositionSize:        sdk.ZeroDec(),
 	}
 	vpoolKeeper.CreatePool(
-		ctx, pair, pool.TradeLimitRatio, pool.QuoteAssetReserve, pool.BaseAssetReserve, pool.FluctuationLimitRatio, pool.MaxOracleSpreadRatio, pool.MaintenanceMarginRatio, pool.MaxLeverage)
+		ctx, pair, pool.TradeLimitRatio, pool.QuoteAssetReserve, pool.BaseAssetReserve, pool.FluctuationLimitRatio, pool.MaxOracleSpreadRatio, pool.MaintenanceMarginRatio, pool.MaxLeverage, pool.MaxOpenInterest, pool.MaxPositionSize)
 
 	t.Log("query reserve assets and prices for the pair")
 	ctx = ctx.WithBlockHeight(2).WithBlockTime(time.Now().Add(5 * time.Second))
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/vpool/types"
+)
+
+/*
+RepegPool moves the mark price of the vpool to pegPrice and multiplies its invariant k
+by kMultiplier. The cost of the repeg, given the net position of the traders, is paid
+by the perp ecosystem fund, or credited to it if the repeg is profitable. The new
+reserves are saved in a reserve snapshot.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair of the vpool
+  - pegPrice: the mark price after the repeg
+  - kMultiplier: the multiplier of the invariant k, one leaves k unchanged
+
+ret:
+  - cost: the quote asset cost paid by the perp ecosystem fund
+  - err: error
+*/
+func (k Keeper) RepegPool(
+	ctx sdk.Context, pair common.AssetPair, pegPrice sdk.Dec, kMultiplier sdk.Dec,
+) (cost sdk.Dec, err error) {
+	if k.positionsKeeper == nil {
+		return sdk.Dec{}, types.ErrNoPositionsKeeper
+	}
+
+	pool, err := k.Pools.Get(ctx, pair)
+	if err != nil {
+		return sdk.Dec{}, types.ErrPairNotSupported.Wrapf("%s", pair)
+	}
+	if _, err = k.Settlements.Get(ctx, pair); err == nil {
+		return sdk.Dec{}, types.ErrPoolShutdown.Wrapf("%s", pair)
+	}
+
+	repegged, err := pool.Repeg(pegPrice, kMultiplier)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	netPositionSize := k.positionsKeeper.GetNetPositionSize(ctx, pair)
+	cost, err = pool.GetRepegCost(repegged, netPositionSize)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if err = k.positionsKeeper.PayRepegCost(ctx, pair, cost); err != nil {
+		return sdk.Dec{}, err
+	}
+
+	k.Pools.Insert(ctx, pair, repegged)
+	k.ReserveSnapshots.Insert(
+		ctx,
+		collections.Join(pair, ctx.BlockTime()),
+		types.NewReserveSnapshot(pair, repegged.BaseAssetReserve, repegged.QuoteAssetReserve, ctx.BlockTime()),
+	)
+
+	return cost, ctx.EventManager().EmitTypedEvent(&types.PoolRepeggedEvent{
+		Pair:               pair.String(),
+		QuoteReserveBefore: pool.QuoteAssetReserve,
+		BaseReserveBefore:  pool.BaseAssetReserve,
+		QuoteReserveAfter:  repegged.QuoteAssetReserve,
+		BaseReserveAfter:   repegged.BaseAssetReserve,
+		NetPositionSize:    netPositionSize,
+		Cost:               cost,
+		BlockHeight:        ctx.BlockHeight(),
+	})
+}
+
+/*
+repegPools repegs the vpools whose mark price is off their index TWAP by more than the
+repeg spread ratio to the index TWAP. Repegs that fail, e.g. because the perp ecosystem
+fund can't pay for them, are skipped and retried at the next repeg epoch.
+*/
+func (k Keeper) repegPools(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+
+	for _, pool := range k.Pools.Iterate(ctx, collections.Range[common.AssetPair]{}).Values() {
+		if _, err := k.Settlements.Get(ctx, pool.Pair); err == nil {
+			continue
+		}
+
+		indexTWAP, err := k.pricefeedKeeper.GetCurrentTWAP(ctx, pool.Pair.Token0, pool.Pair.Token1)
+		if err != nil || !indexTWAP.IsPositive() {
+			k.Logger(ctx).Error("no index twap to repeg vpool", "pair", pool.Pair.String(), "error", err)
+			continue
+		}
+
+		spread := pool.GetMarkPrice().Sub(indexTWAP).Abs().Quo(indexTWAP)
+		if spread.LTE(params.RepegSpreadRatio) {
+			continue
+		}
+
+		cachedCtx, commit := ctx.CacheContext()
+		cost, err := k.RepegPool(cachedCtx, pool.Pair, indexTWAP, sdk.OneDec())
+		if err != nil {
+			k.Logger(ctx).Error("failed to repeg vpool", "pair", pool.Pair.String(), "error", err)
+			continue
+		}
+		ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+		commit()
+		k.Logger(ctx).Info("repegged vpool", "pair", pool.Pair.String(), "peg_price", indexTWAP.String(), "cost", cost.String())
+	}
+}
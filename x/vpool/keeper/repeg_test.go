@@ -0,0 +1,253 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/testutil"
+	"github.com/NibiruChain/nibiru/x/vpool/types"
+)
+
+// createRepegPool creates a 1_000 x 1_000 BTC:NUSD vpool with a mark price of 1.
+func createRepegPool(vpoolKeeper Keeper, ctx sdk.Context) {
+	vpoolKeeper.CreatePool(
+		ctx,
+		common.Pair_BTC_NUSD,
+		/* tradeLimitRatio */ sdk.MustNewDecFromStr("0.9"),
+		/* quoteAssetReserve */ sdk.NewDec(1_000),
+		/* baseAssetReserve */ sdk.NewDec(1_000),
+		/* fluctuationLimitRatio */ sdk.MustNewDecFromStr("0.1"),
+		/* maxOracleSpreadRatio */ sdk.MustNewDecFromStr("0.1"),
+		/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
+		/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+		/* maxOpenInterest */ sdk.ZeroDec(),
+		/* maxPositionSize */ sdk.ZeroDec(),
+	)
+}
+
+func TestRepegPool(t *testing.T) {
+	tests := []struct {
+		name            string
+		pegPrice        sdk.Dec
+		kMultiplier     sdk.Dec
+		netPositionSize sdk.Dec
+
+		expectedBaseReserve  sdk.Dec
+		expectedQuoteReserve sdk.Dec
+		expectedCost         sdk.Dec
+	}{
+		{
+			name:                 "raising the peg of a net long market is a cost",
+			pegPrice:             sdk.NewDec(2),
+			kMultiplier:          sdk.OneDec(),
+			netPositionSize:      sdk.NewDec(100),
+			expectedBaseReserve:  sdk.NewDec(1_000),
+			expectedQuoteReserve: sdk.NewDec(2_000),
+			expectedCost:         sdk.MustNewDecFromStr("90.909090909090909091"),
+		},
+		{
+			name:                 "raising the peg of a net short market is a profit",
+			pegPrice:             sdk.NewDec(2),
+			kMultiplier:          sdk.OneDec(),
+			netPositionSize:      sdk.NewDec(-100),
+			expectedBaseReserve:  sdk.NewDec(1_000),
+			expectedQuoteReserve: sdk.NewDec(2_000),
+			expectedCost:         sdk.MustNewDecFromStr("-111.111111111111111111"),
+		},
+		{
+			name:                 "raising k of a net long market is a cost",
+			pegPrice:             sdk.OneDec(),
+			kMultiplier:          sdk.NewDec(4),
+			netPositionSize:      sdk.NewDec(100),
+			expectedBaseReserve:  sdk.NewDec(2_000),
+			expectedQuoteReserve: sdk.NewDec(2_000),
+			expectedCost:         sdk.MustNewDecFromStr("4.329004329004329004"),
+		},
+		{
+			name:                 "repegging a market without positions is free",
+			pegPrice:             sdk.MustNewDecFromStr("0.5"),
+			kMultiplier:          sdk.OneDec(),
+			netPositionSize:      sdk.ZeroDec(),
+			expectedBaseReserve:  sdk.NewDec(1_000),
+			expectedQuoteReserve: sdk.NewDec(500),
+			expectedCost:         sdk.ZeroDec(),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			vpoolKeeper, _, ctx := getKeeper(t)
+			ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Now())
+			createRepegPool(vpoolKeeper, ctx)
+			positionsKeeper := &positionsKeeperFake{vpoolKeeper: &vpoolKeeper, netPositionSize: tc.netPositionSize}
+			vpoolKeeper.SetPositionsKeeper(positionsKeeper)
+
+			ctx = ctx.WithBlockHeight(2).WithBlockTime(ctx.BlockTime().Add(time.Minute))
+			cost, err := vpoolKeeper.RepegPool(ctx, common.Pair_BTC_NUSD, tc.pegPrice, tc.kMultiplier)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedCost, cost)
+			assert.Equal(t, []sdk.Dec{tc.expectedCost}, positionsKeeper.paidCosts)
+
+			pool, err := vpoolKeeper.Pools.Get(ctx, common.Pair_BTC_NUSD)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedBaseReserve, pool.BaseAssetReserve)
+			assert.Equal(t, tc.expectedQuoteReserve, pool.QuoteAssetReserve)
+
+			snapshot, err := vpoolKeeper.ReserveSnapshots.Get(ctx, collections.Join(common.Pair_BTC_NUSD, ctx.BlockTime()))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedBaseReserve, snapshot.BaseAssetReserve)
+			assert.Equal(t, tc.expectedQuoteReserve, snapshot.QuoteAssetReserve)
+
+			testutil.RequireHasTypedEvent(t, ctx, &types.PoolRepeggedEvent{
+				Pair:               common.Pair_BTC_NUSD.String(),
+				QuoteReserveBefore: sdk.NewDec(1_000),
+				BaseReserveBefore:  sdk.NewDec(1_000),
+				QuoteReserveAfter:  tc.expectedQuoteReserve,
+				BaseReserveAfter:   tc.expectedBaseReserve,
+				NetPositionSize:    tc.netPositionSize,
+				Cost:               tc.expectedCost,
+				BlockHeight:        2,
+			})
+		})
+	}
+}
+
+func TestRepegPool_Errors(t *testing.T) {
+	setup := func(t *testing.T) (Keeper, *positionsKeeperFake, sdk.Context) {
+		vpoolKeeper, _, ctx := getKeeper(t)
+		createRepegPool(vpoolKeeper, ctx)
+		positionsKeeper := &positionsKeeperFake{vpoolKeeper: &vpoolKeeper}
+		vpoolKeeper.SetPositionsKeeper(positionsKeeper)
+		return vpoolKeeper, positionsKeeper, ctx
+	}
+
+	t.Run("no positions keeper", func(t *testing.T) {
+		vpoolKeeper, _, ctx := getKeeper(t)
+		createRepegPool(vpoolKeeper, ctx)
+
+		_, err := vpoolKeeper.RepegPool(ctx, common.Pair_BTC_NUSD, sdk.NewDec(2), sdk.OneDec())
+		require.ErrorIs(t, err, types.ErrNoPositionsKeeper)
+	})
+
+	t.Run("pair not supported", func(t *testing.T) {
+		vpoolKeeper, _, ctx := setup(t)
+
+		_, err := vpoolKeeper.RepegPool(ctx, common.Pair_ETH_NUSD, sdk.NewDec(2), sdk.OneDec())
+		require.ErrorIs(t, err, types.ErrPairNotSupported)
+	})
+
+	t.Run("pool shut down", func(t *testing.T) {
+		vpoolKeeper, _, ctx := setup(t)
+		vpoolKeeper.Settlements.Insert(ctx, common.Pair_BTC_NUSD, types.PoolSettlement{
+			Pair:            common.Pair_BTC_NUSD,
+			SettlementPrice: sdk.OneDec(),
+		})
+
+		_, err := vpoolKeeper.RepegPool(ctx, common.Pair_BTC_NUSD, sdk.NewDec(2), sdk.OneDec())
+		require.ErrorIs(t, err, types.ErrPoolShutdown)
+	})
+
+	t.Run("non positive peg price", func(t *testing.T) {
+		vpoolKeeper, _, ctx := setup(t)
+
+		_, err := vpoolKeeper.RepegPool(ctx, common.Pair_BTC_NUSD, sdk.ZeroDec(), sdk.OneDec())
+		require.Error(t, err)
+	})
+
+	t.Run("k multiplier leaving the net short position without base reserve", func(t *testing.T) {
+		vpoolKeeper, positionsKeeper, ctx := setup(t)
+		positionsKeeper.netPositionSize = sdk.NewDec(-600)
+
+		_, err := vpoolKeeper.RepegPool(ctx, common.Pair_BTC_NUSD, sdk.OneDec(), sdk.MustNewDecFromStr("0.25"))
+		require.ErrorIs(t, err, types.ErrBaseReserveAtZero)
+	})
+
+	t.Run("cost not paid leaves the pool untouched", func(t *testing.T) {
+		vpoolKeeper, positionsKeeper, ctx := setup(t)
+		positionsKeeper.netPositionSize = sdk.NewDec(100)
+		positionsKeeper.payErr = fmt.Errorf("not enough funds")
+
+		_, err := vpoolKeeper.RepegPool(ctx, common.Pair_BTC_NUSD, sdk.NewDec(2), sdk.OneDec())
+		require.Error(t, err)
+
+		pool, err := vpoolKeeper.Pools.Get(ctx, common.Pair_BTC_NUSD)
+		require.NoError(t, err)
+		assert.Equal(t, sdk.NewDec(1_000), pool.QuoteAssetReserve)
+	})
+}
+
+func TestAfterEpochEnd_Repeg(t *testing.T) {
+	tests := []struct {
+		name            string
+		params          types.Params
+		epochIdentifier string
+		indexTWAP       sdk.Dec
+		twapErr         error
+
+		expectedMarkPrice sdk.Dec
+	}{
+		{
+			name:              "repegs to the index twap over the spread ratio",
+			params:            types.NewParams(time.Hour, sdk.MustNewDecFromStr("0.1"), "30 min"),
+			epochIdentifier:   "30 min",
+			indexTWAP:         sdk.MustNewDecFromStr("1.5"),
+			expectedMarkPrice: sdk.MustNewDecFromStr("1.5"),
+		},
+		{
+			name:              "does not repeg within the spread ratio",
+			params:            types.NewParams(time.Hour, sdk.MustNewDecFromStr("0.1"), "30 min"),
+			epochIdentifier:   "30 min",
+			indexTWAP:         sdk.MustNewDecFromStr("1.05"),
+			expectedMarkPrice: sdk.OneDec(),
+		},
+		{
+			name:              "does not repeg at other epochs",
+			params:            types.NewParams(time.Hour, sdk.MustNewDecFromStr("0.1"), "30 min"),
+			epochIdentifier:   "week",
+			expectedMarkPrice: sdk.OneDec(),
+		},
+		{
+			name:              "does not repeg when disabled",
+			params:            types.DefaultParams(),
+			epochIdentifier:   types.DefaultRepegEpochIdentifier,
+			expectedMarkPrice: sdk.OneDec(),
+		},
+		{
+			name:              "does not repeg without index twap",
+			params:            types.NewParams(time.Hour, sdk.MustNewDecFromStr("0.1"), "30 min"),
+			epochIdentifier:   "30 min",
+			indexTWAP:         sdk.ZeroDec(),
+			twapErr:           fmt.Errorf("no twap"),
+			expectedMarkPrice: sdk.OneDec(),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			vpoolKeeper, mocks, ctx := getKeeper(t)
+			createRepegPool(vpoolKeeper, ctx)
+			vpoolKeeper.SetPositionsKeeper(&positionsKeeperFake{vpoolKeeper: &vpoolKeeper})
+			vpoolKeeper.SetParams(ctx, tc.params)
+			if !tc.indexTWAP.IsNil() {
+				mocks.mockPricefeedKeeper.EXPECT().
+					GetCurrentTWAP(ctx, common.DenomBTC, common.DenomNUSD).
+					Return(tc.indexTWAP, tc.twapErr)
+			}
+
+			vpoolKeeper.Hooks().AfterEpochEnd(ctx, tc.epochIdentifier, 1)
+
+			pool, err := vpoolKeeper.Pools.Get(ctx, common.Pair_BTC_NUSD)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedMarkPrice, pool.GetMarkPrice())
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/NibiruChain/nibiru/collections"
 	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/vpool/types"
 )
@@ -80,3 +83,55 @@ func getPriceWithSnapshot(
 
 	return sdk.ZeroDec(), nil
 }
+
+/*
+PruneReserveSnapshots deletes the reserve snapshots older than the snapshot retention window.
+The most recent snapshot before the window is kept, since the TWAP calculation uses it
+as the price at the start of the lookback interval.
+
+At most types.MaxSnapshotsPrunedPerBlock snapshots are deleted per call, the rest are
+deleted in the following blocks. A zero retention window disables pruning.
+
+args:
+  - ctx: cosmos-sdk context
+
+ret:
+  - pruned: the number of deleted snapshots
+*/
+func (k Keeper) PruneReserveSnapshots(ctx sdk.Context) (pruned int) {
+	retentionWindow := k.GetParams(ctx).SnapshotRetentionWindow
+	if retentionWindow == 0 {
+		return 0
+	}
+	cutoff := ctx.BlockTime().Add(-1 * retentionWindow)
+
+	for _, pair := range k.Pools.Iterate(ctx, collections.Range[common.AssetPair]{}).Keys() {
+		iter := k.ReserveSnapshots.Iterate(
+			ctx,
+			collections.PairRange[common.AssetPair, time.Time]{}.
+				Prefix(pair).
+				EndExclusive(cutoff).
+				Descending(),
+		)
+		// keep the most recent snapshot before the cutoff
+		if iter.Valid() {
+			iter.Next()
+		}
+		var keys []collections.Pair[common.AssetPair, time.Time]
+		for ; iter.Valid() && pruned+len(keys) < types.MaxSnapshotsPrunedPerBlock; iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+
+		for _, key := range keys {
+			if err := k.ReserveSnapshots.Delete(ctx, key); err != nil {
+				panic(err)
+			}
+		}
+		pruned += len(keys)
+		if pruned >= types.MaxSnapshotsPrunedPerBlock {
+			return pruned
+		}
+	}
+	return pruned
+}
@@ -9,6 +9,8 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
@@ -21,17 +23,33 @@ import (
 
 func VpoolKeeper(t *testing.T, pricefeedKeeper types.PricefeedKeeper) (
 	vpoolKeeper Keeper, ctx sdk.Context,
+) {
+	vpoolKeeper, ctx = vpoolKeeperWithoutParams(t, pricefeedKeeper)
+	vpoolKeeper.SetParams(ctx, types.DefaultParams())
+	return vpoolKeeper, ctx
+}
+
+// vpoolKeeperWithoutParams returns a keeper whose params have not been set yet.
+func vpoolKeeperWithoutParams(t *testing.T, pricefeedKeeper types.PricefeedKeeper) (
+	vpoolKeeper Keeper, ctx sdk.Context,
 ) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	paramsStoreKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTransientStoreKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
 
 	db := tmdb.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db)
 	stateStore.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(paramsStoreKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(paramsTransientStoreKey, sdk.StoreTypeTransient, nil)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
+	protoCodec := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
 	vpoolKeeper = NewKeeper(
-		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
-		storeKey, pricefeedKeeper,
+		protoCodec,
+		storeKey,
+		paramSubspace(protoCodec, paramsStoreKey, paramsTransientStoreKey),
+		pricefeedKeeper,
 	)
 	ctx = sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
 
@@ -56,6 +74,11 @@ func getKeeper(t *testing.T) (Keeper, mockedDependencies, sdk.Context) {
 	// Mount Memory store
 	memStoreKey := storetypes.NewMemoryStoreKey("mem" + types.StoreKey)
 	commitMultiStore.MountStoreWithDB(memStoreKey, sdk.StoreTypeMemory, nil)
+	// Mount the stores of the params
+	paramsStoreKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	commitMultiStore.MountStoreWithDB(paramsStoreKey, sdk.StoreTypeIAVL, db)
+	paramsTransientStoreKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	commitMultiStore.MountStoreWithDB(paramsTransientStoreKey, sdk.StoreTypeTransient, nil)
 
 	require.NoError(t, commitMultiStore.LoadLatestVersion())
 
@@ -72,13 +95,21 @@ func getKeeper(t *testing.T) (Keeper, mockedDependencies, sdk.Context) {
 	k := NewKeeper(
 		protoCodec,
 		storeKey,
+		paramSubspace(protoCodec, paramsStoreKey, paramsTransientStoreKey),
 		mockedPricefeedKeeper,
 	)
 
 	ctx := sdk.NewContext(commitMultiStore, tmproto.Header{}, false, log.NewNopLogger())
+	k.SetParams(ctx, types.DefaultParams())
 
 	return k, mockedDependencies{
 		mockPricefeedKeeper: mockedPricefeedKeeper,
 		mockAccountKeeper:   mockedAccountKeeper,
 	}, ctx
 }
+
+// paramSubspace returns the param subspace of the module, backed by the given stores.
+func paramSubspace(cdc codec.BinaryCodec, key sdk.StoreKey, tkey sdk.StoreKey) paramstypes.Subspace {
+	paramsKeeper := paramskeeper.NewKeeper(cdc, codec.NewLegacyAmino(), key, tkey)
+	return paramsKeeper.Subspace(types.ModuleName)
+}
@@ -16,7 +16,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		/* implementations */
 	)
 
-	registry.RegisterImplementations((*govtypes.Content)(nil), &CreatePoolProposal{})
+	registry.RegisterImplementations((*govtypes.Content)(nil), &CreatePoolProposal{}, &ShutdownPoolProposal{}, &EditPoolConfigProposal{}, &RepegPoolProposal{})
 
 	// msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
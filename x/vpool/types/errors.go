@@ -14,4 +14,7 @@ var (
 	ErrNoValidTWAP          = sdkerrors.Register(ModuleName, 9, "TWAP price not found")
 	// Could replace ErrBaseReserveAtZero and ErrQUoteReserveAtZero if wrapped
 	ErrNonPositiveReserves = sdkerrors.Register(ModuleName, 10, "base and quote reserves must always be positive")
+	ErrPoolShutdown        = sdkerrors.Register(ModuleName, 11, "vpool has been shut down")
+	ErrPoolNotShutdown     = sdkerrors.Register(ModuleName, 12, "vpool has not been shut down")
+	ErrNoPositionsKeeper   = sdkerrors.Register(ModuleName, 13, "no positions keeper set to settle the repeg cost")
 )
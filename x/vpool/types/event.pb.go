@@ -240,48 +240,251 @@ func (m *MarkPriceChangedEvent) GetBlockTimestamp() time.Time {
 	return time.Time{}
 }
 
+type PoolShutdownEvent struct {
+	Pair string `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	// SettlementPrice is the price at which the positions of the vpool are settled.
+	SettlementPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=settlement_price,json=settlementPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"settlement_price"`
+	BlockHeight     int64                                  `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *PoolShutdownEvent) Reset()         { *m = PoolShutdownEvent{} }
+func (m *PoolShutdownEvent) String() string { return proto.CompactTextString(m) }
+func (*PoolShutdownEvent) ProtoMessage()    {}
+func (*PoolShutdownEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faeff0bc76489252, []int{4}
+}
+func (m *PoolShutdownEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolShutdownEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolShutdownEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolShutdownEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolShutdownEvent.Merge(m, src)
+}
+func (m *PoolShutdownEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolShutdownEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolShutdownEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolShutdownEvent proto.InternalMessageInfo
+
+func (m *PoolShutdownEvent) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *PoolShutdownEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+type PoolConfigEditedEvent struct {
+	Pair                   string                                 `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	MaintenanceMarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=maintenance_margin_ratio,json=maintenanceMarginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maintenance_margin_ratio"`
+	MaxLeverage            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_leverage,json=maxLeverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_leverage"`
+	// LiquidatablePositions is the number of open positions of the vpool
+	// that can be liquidated under the new config.
+	LiquidatablePositions uint64 `protobuf:"varint,4,opt,name=liquidatable_positions,json=liquidatablePositions,proto3" json:"liquidatable_positions,omitempty"`
+	// PositionsMadeLiquidatable is the number of open positions that the
+	// edit made liquidatable.
+	PositionsMadeLiquidatable uint64 `protobuf:"varint,5,opt,name=positions_made_liquidatable,json=positionsMadeLiquidatable,proto3" json:"positions_made_liquidatable,omitempty"`
+}
+
+func (m *PoolConfigEditedEvent) Reset()         { *m = PoolConfigEditedEvent{} }
+func (m *PoolConfigEditedEvent) String() string { return proto.CompactTextString(m) }
+func (*PoolConfigEditedEvent) ProtoMessage()    {}
+func (*PoolConfigEditedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faeff0bc76489252, []int{5}
+}
+func (m *PoolConfigEditedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolConfigEditedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolConfigEditedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolConfigEditedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolConfigEditedEvent.Merge(m, src)
+}
+func (m *PoolConfigEditedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolConfigEditedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolConfigEditedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolConfigEditedEvent proto.InternalMessageInfo
+
+func (m *PoolConfigEditedEvent) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *PoolConfigEditedEvent) GetLiquidatablePositions() uint64 {
+	if m != nil {
+		return m.LiquidatablePositions
+	}
+	return 0
+}
+
+func (m *PoolConfigEditedEvent) GetPositionsMadeLiquidatable() uint64 {
+	if m != nil {
+		return m.PositionsMadeLiquidatable
+	}
+	return 0
+}
+
+type PoolRepeggedEvent struct {
+	Pair               string                                 `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	QuoteReserveBefore github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=quote_reserve_before,json=quoteReserveBefore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quote_reserve_before"`
+	BaseReserveBefore  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=base_reserve_before,json=baseReserveBefore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_reserve_before"`
+	QuoteReserveAfter  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=quote_reserve_after,json=quoteReserveAfter,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quote_reserve_after"`
+	BaseReserveAfter   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=base_reserve_after,json=baseReserveAfter,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_reserve_after"`
+	// NetPositionSize is the size of the net position of the traders on the
+	// vpool when it was repegged. Positive for net long, negative for net short.
+	NetPositionSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=net_position_size,json=netPositionSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"net_position_size"`
+	// Cost is the amount of quote asset paid by the perp ecosystem fund for the
+	// repeg. It is negative if the repeg was a profit for the fund.
+	Cost        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=cost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cost"`
+	BlockHeight int64                                  `protobuf:"varint,8,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *PoolRepeggedEvent) Reset()         { *m = PoolRepeggedEvent{} }
+func (m *PoolRepeggedEvent) String() string { return proto.CompactTextString(m) }
+func (*PoolRepeggedEvent) ProtoMessage()    {}
+func (*PoolRepeggedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faeff0bc76489252, []int{6}
+}
+func (m *PoolRepeggedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolRepeggedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolRepeggedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolRepeggedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolRepeggedEvent.Merge(m, src)
+}
+func (m *PoolRepeggedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolRepeggedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolRepeggedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolRepeggedEvent proto.InternalMessageInfo
+
+func (m *PoolRepeggedEvent) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *PoolRepeggedEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ReserveSnapshotSavedEvent)(nil), "nibiru.vpool.v1.ReserveSnapshotSavedEvent")
 	proto.RegisterType((*SwapQuoteForBaseEvent)(nil), "nibiru.vpool.v1.SwapQuoteForBaseEvent")
 	proto.RegisterType((*SwapBaseForQuoteEvent)(nil), "nibiru.vpool.v1.SwapBaseForQuoteEvent")
 	proto.RegisterType((*MarkPriceChangedEvent)(nil), "nibiru.vpool.v1.MarkPriceChangedEvent")
+	proto.RegisterType((*PoolShutdownEvent)(nil), "nibiru.vpool.v1.PoolShutdownEvent")
+	proto.RegisterType((*PoolConfigEditedEvent)(nil), "nibiru.vpool.v1.PoolConfigEditedEvent")
+	proto.RegisterType((*PoolRepeggedEvent)(nil), "nibiru.vpool.v1.PoolRepeggedEvent")
 }
 
 func init() { proto.RegisterFile("vpool/v1/event.proto", fileDescriptor_faeff0bc76489252) }
 
 var fileDescriptor_faeff0bc76489252 = []byte{
-	// 488 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x94, 0x4d, 0x6f, 0xd3, 0x30,
-	0x18, 0xc7, 0x6b, 0xba, 0x4d, 0xcc, 0x2d, 0x4c, 0x8a, 0x36, 0x29, 0xeb, 0x21, 0x2d, 0x3b, 0xa0,
-	0x4a, 0x88, 0x58, 0x85, 0x4f, 0x40, 0xf7, 0x22, 0x2e, 0x05, 0x9a, 0x72, 0xe2, 0x12, 0x39, 0x99,
-	0x49, 0xac, 0x36, 0x79, 0x82, 0xed, 0x04, 0xf8, 0x16, 0x3b, 0xf2, 0x71, 0x38, 0xee, 0xc6, 0x8e,
-	0x88, 0xc3, 0x40, 0xed, 0x17, 0x41, 0xb6, 0x93, 0x8a, 0x03, 0x70, 0xc8, 0x6d, 0xa7, 0xd8, 0xfe,
-	0x3f, 0xcf, 0xcf, 0xcf, 0x5b, 0x8c, 0x0f, 0xab, 0x02, 0x60, 0x45, 0xaa, 0x09, 0x61, 0x15, 0xcb,
-	0x95, 0x5f, 0x08, 0x50, 0xe0, 0x1c, 0xe4, 0x3c, 0xe2, 0xa2, 0xf4, 0x8d, 0xe8, 0x57, 0x93, 0xc1,
-	0x61, 0x02, 0x09, 0x18, 0x8d, 0xe8, 0x95, 0x35, 0x1b, 0x78, 0x31, 0xc8, 0x0c, 0x24, 0x89, 0xa8,
-	0x64, 0xa4, 0x9a, 0x44, 0x4c, 0xd1, 0x09, 0x89, 0x81, 0xe7, 0xb5, 0x7e, 0x6c, 0xf5, 0xd0, 0x3a,
-	0xda, 0x4d, 0x2d, 0x0d, 0x13, 0x80, 0x64, 0xc5, 0x88, 0xd9, 0x45, 0xe5, 0x7b, 0xa2, 0x78, 0xc6,
-	0xa4, 0xa2, 0x59, 0x61, 0x0d, 0x4e, 0xbe, 0x74, 0xf1, 0x71, 0xc0, 0x24, 0x13, 0x15, 0x5b, 0xe4,
-	0xb4, 0x90, 0x29, 0xa8, 0x05, 0xad, 0xd8, 0xe5, 0xb9, 0x0e, 0xd3, 0x71, 0xf0, 0x4e, 0x41, 0xb9,
-	0x70, 0xd1, 0x08, 0x8d, 0xf7, 0x03, 0xb3, 0x76, 0x16, 0xf8, 0xc1, 0x87, 0x12, 0x14, 0x0b, 0x85,
-	0x75, 0x73, 0xef, 0x69, 0x71, 0xea, 0x5f, 0xdf, 0x0e, 0x3b, 0x3f, 0x6e, 0x87, 0x8f, 0x13, 0xae,
-	0xd2, 0x32, 0xf2, 0x63, 0xc8, 0xea, 0x50, 0xea, 0xcf, 0x53, 0x79, 0xb9, 0x24, 0xea, 0x73, 0xc1,
-	0xa4, 0x7f, 0xc6, 0xe2, 0xa0, 0x6f, 0x20, 0xf5, 0xd5, 0xce, 0x1c, 0xf7, 0x75, 0x76, 0x5b, 0x66,
-	0xb7, 0x15, 0xb3, 0xa7, 0x19, 0x0d, 0x72, 0x86, 0x71, 0x46, 0xc5, 0x32, 0x2c, 0x04, 0x8f, 0x99,
-	0xbb, 0xd3, 0x0a, 0xb8, 0xaf, 0x09, 0x6f, 0x34, 0xc0, 0x79, 0x84, 0xfb, 0xd1, 0x0a, 0xe2, 0x65,
-	0x98, 0x32, 0x9e, 0xa4, 0xca, 0xdd, 0x1d, 0xa1, 0x71, 0x37, 0xe8, 0x99, 0xb3, 0x97, 0xe6, 0xc8,
-	0x99, 0xe1, 0x03, 0x6b, 0xb2, 0x2d, 0xb2, 0xbb, 0x37, 0x42, 0xe3, 0xde, 0xb3, 0x81, 0x6f, 0xdb,
-	0xe0, 0x37, 0x6d, 0xf0, 0xdf, 0x36, 0x16, 0xd3, 0xfb, 0x3a, 0xa4, 0xab, 0x9f, 0x43, 0x14, 0x3c,
-	0x34, 0xce, 0x5b, 0xe5, 0xe4, 0x1b, 0xc2, 0x47, 0x8b, 0x8f, 0xb4, 0x98, 0xeb, 0x42, 0x5d, 0x80,
-	0x98, 0x52, 0xc9, 0xfe, 0xdd, 0x96, 0x39, 0xb6, 0x15, 0x0d, 0x69, 0x06, 0x65, 0xae, 0x5a, 0x76,
-	0xa5, 0x67, 0x18, 0x2f, 0x0c, 0xc2, 0x79, 0x8d, 0x4d, 0x41, 0x1b, 0x62, 0xbb, 0x9e, 0x60, 0x8d,
-	0xb0, 0xc0, 0x6d, 0x46, 0x3a, 0x93, 0x0b, 0x10, 0x26, 0xb1, 0xbb, 0x9d, 0xd1, 0x57, 0x84, 0x8f,
-	0x66, 0xcd, 0x8c, 0x9c, 0xa6, 0x34, 0x4f, 0xfe, 0xf7, 0xeb, 0x9c, 0xe1, 0x5d, 0x3b, 0x8d, 0xed,
-	0x52, 0xb1, 0xce, 0x7f, 0x1b, 0xb3, 0x6e, 0xfb, 0x31, 0x9b, 0x9e, 0x5f, 0xaf, 0x3d, 0x74, 0xb3,
-	0xf6, 0xd0, 0xaf, 0xb5, 0x87, 0xae, 0x36, 0x5e, 0xe7, 0x66, 0xe3, 0x75, 0xbe, 0x6f, 0xbc, 0xce,
-	0xbb, 0x27, 0x7f, 0xc4, 0xf5, 0xca, 0xbc, 0x54, 0xa7, 0x29, 0xe5, 0x39, 0xb1, 0xaf, 0x16, 0xf9,
-	0x44, 0xec, 0xa3, 0x66, 0x02, 0x8c, 0xf6, 0xcc, 0xa5, 0xcf, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff,
-	0x61, 0xf5, 0x5c, 0x01, 0xea, 0x04, 0x00, 0x00,
+	// 774 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0xcf, 0x4f, 0xdb, 0x48,
+	0x14, 0xc7, 0x63, 0x12, 0x58, 0x98, 0xb0, 0x1b, 0xe2, 0x25, 0xc8, 0xb0, 0x52, 0xc2, 0xe6, 0xb0,
+	0x42, 0x5a, 0xad, 0xad, 0xec, 0x6a, 0xaf, 0x95, 0x08, 0x3f, 0xd4, 0x03, 0x69, 0xc1, 0xe9, 0xa5,
+	0xa8, 0xaa, 0x3b, 0x76, 0x5e, 0xec, 0x11, 0xf6, 0x8c, 0xb1, 0x27, 0x86, 0xf2, 0x57, 0x70, 0xec,
+	0x3f, 0xd0, 0xff, 0xa3, 0x47, 0x6e, 0xe5, 0x58, 0xf5, 0x00, 0x15, 0xdc, 0xfa, 0x57, 0x54, 0x9e,
+	0x89, 0x83, 0x11, 0x3f, 0x0e, 0xe6, 0xd4, 0x93, 0x7f, 0xbc, 0x37, 0x9f, 0x79, 0xef, 0xcd, 0x77,
+	0xe6, 0x0d, 0x5a, 0x4c, 0x42, 0xc6, 0x7c, 0x23, 0xe9, 0x18, 0x90, 0x00, 0xe5, 0x7a, 0x18, 0x31,
+	0xce, 0xd4, 0x1a, 0x25, 0x36, 0x89, 0x46, 0xba, 0x30, 0xea, 0x49, 0x67, 0x65, 0xd1, 0x65, 0x2e,
+	0x13, 0x36, 0x23, 0x7d, 0x93, 0x6e, 0x2b, 0x4d, 0x87, 0xc5, 0x01, 0x8b, 0x0d, 0x1b, 0xc7, 0x60,
+	0x24, 0x1d, 0x1b, 0x38, 0xee, 0x18, 0x0e, 0x23, 0x74, 0x6c, 0x5f, 0x96, 0x76, 0x4b, 0x0e, 0x94,
+	0x1f, 0x63, 0x53, 0xcb, 0x65, 0xcc, 0xf5, 0xc1, 0x10, 0x5f, 0xf6, 0x68, 0x68, 0x70, 0x12, 0x40,
+	0xcc, 0x71, 0x10, 0x4a, 0x87, 0xf6, 0x87, 0x32, 0x5a, 0x36, 0x21, 0x86, 0x28, 0x81, 0x3e, 0xc5,
+	0x61, 0xec, 0x31, 0xde, 0xc7, 0x09, 0x0c, 0xb6, 0xd2, 0x30, 0x55, 0x15, 0x55, 0x42, 0x4c, 0x22,
+	0x4d, 0x59, 0x55, 0xd6, 0xe6, 0x4c, 0xf1, 0xae, 0xf6, 0xd1, 0xaf, 0x87, 0x23, 0xc6, 0xc1, 0x8a,
+	0xe4, 0x30, 0x6d, 0x2a, 0x35, 0x76, 0xf5, 0xb3, 0x8b, 0x56, 0xe9, 0xeb, 0x45, 0xeb, 0x2f, 0x97,
+	0x70, 0x6f, 0x64, 0xeb, 0x0e, 0x0b, 0xc6, 0xa1, 0x8c, 0x1f, 0xff, 0xc4, 0x83, 0x03, 0x83, 0xbf,
+	0x0f, 0x21, 0xd6, 0x37, 0xc1, 0x31, 0xe7, 0x05, 0x64, 0x3c, 0xb5, 0xba, 0x87, 0xe6, 0xd3, 0xec,
+	0x26, 0xcc, 0x72, 0x21, 0x66, 0x35, 0x65, 0x64, 0xc8, 0x1e, 0x42, 0x01, 0x8e, 0x0e, 0xac, 0x30,
+	0x22, 0x0e, 0x68, 0x95, 0x42, 0xc0, 0xb9, 0x94, 0xb0, 0x9b, 0x02, 0xd4, 0x3f, 0xd1, 0xbc, 0xed,
+	0x33, 0xe7, 0xc0, 0xf2, 0x80, 0xb8, 0x1e, 0xd7, 0xa6, 0x57, 0x95, 0xb5, 0xb2, 0x59, 0x15, 0xff,
+	0x9e, 0x8b, 0x5f, 0x6a, 0x0f, 0xd5, 0xa4, 0xcb, 0xa4, 0xc8, 0xda, 0xcc, 0xaa, 0xb2, 0x56, 0xfd,
+	0x77, 0x45, 0x97, 0xcb, 0xa0, 0x67, 0xcb, 0xa0, 0xbf, 0xca, 0x3c, 0xba, 0xb3, 0x69, 0x48, 0xa7,
+	0x97, 0x2d, 0xc5, 0xfc, 0x4d, 0x0c, 0x9e, 0x58, 0xda, 0x9f, 0x15, 0xd4, 0xe8, 0x1f, 0xe1, 0x70,
+	0x2f, 0x2d, 0xd4, 0x36, 0x8b, 0xba, 0x38, 0x86, 0x87, 0x97, 0x65, 0x0f, 0xc9, 0x8a, 0x5a, 0x38,
+	0x60, 0x23, 0xca, 0x0b, 0xae, 0x4a, 0x55, 0x30, 0xd6, 0x05, 0x42, 0x7d, 0x89, 0x44, 0x41, 0x33,
+	0x62, 0xb1, 0x35, 0x41, 0x29, 0x42, 0x02, 0x27, 0x19, 0xa5, 0x99, 0x6c, 0xb3, 0x48, 0x24, 0xf6,
+	0x73, 0x67, 0xf4, 0x49, 0x41, 0x8d, 0x5e, 0xa6, 0x91, 0x0d, 0x0f, 0x53, 0xf7, 0xb1, 0xad, 0xb3,
+	0x89, 0xa6, 0xa5, 0x1a, 0x8b, 0xa5, 0x22, 0x07, 0xdf, 0x27, 0xb3, 0xf2, 0x13, 0x64, 0xf6, 0x51,
+	0x41, 0xf5, 0x5d, 0xc6, 0xfc, 0xbe, 0x37, 0xe2, 0x03, 0x76, 0x44, 0x1f, 0x0e, 0xff, 0x35, 0x5a,
+	0x88, 0x81, 0x73, 0x1f, 0x02, 0xa0, 0xdc, 0x7a, 0x4a, 0x26, 0xb5, 0x1b, 0xce, 0xfd, 0xbb, 0xab,
+	0x7c, 0x67, 0x77, 0xb5, 0x2f, 0xa7, 0x50, 0x23, 0x8d, 0x73, 0x83, 0xd1, 0x21, 0x71, 0xb7, 0x06,
+	0x84, 0x3f, 0x56, 0x6a, 0x0f, 0x69, 0x01, 0x26, 0x94, 0x03, 0xc5, 0xd4, 0x01, 0x2b, 0xc0, 0x91,
+	0x4b, 0xa8, 0x15, 0x61, 0x4e, 0x58, 0xc1, 0x98, 0x97, 0x72, 0xbc, 0x9e, 0xc0, 0x99, 0x29, 0x2d,
+	0x95, 0x69, 0x80, 0x8f, 0x2d, 0x1f, 0x12, 0x88, 0xb0, 0x5b, 0xf8, 0xe8, 0x0a, 0xf0, 0xf1, 0xce,
+	0x18, 0xa1, 0xfe, 0x8f, 0x96, 0x7c, 0x72, 0x38, 0x22, 0x03, 0xcc, 0xb1, 0xed, 0x83, 0x15, 0xb2,
+	0x98, 0x70, 0xc2, 0x68, 0x2c, 0x8e, 0xb1, 0x8a, 0xd9, 0xc8, 0x5b, 0x77, 0x33, 0xa3, 0xfa, 0x0c,
+	0xfd, 0x31, 0xf1, 0xb4, 0x02, 0x3c, 0x00, 0x2b, 0xef, 0x27, 0x4e, 0xac, 0x8a, 0xb9, 0x3c, 0x71,
+	0xe9, 0xe1, 0x01, 0xec, 0xe4, 0x1c, 0xda, 0xdf, 0x2b, 0x52, 0x09, 0x26, 0x84, 0xe0, 0x3e, 0x2a,
+	0xe4, 0x77, 0x68, 0xf1, 0x56, 0x0f, 0xb0, 0x6c, 0x18, 0xb2, 0xa8, 0xa8, 0x1a, 0xd4, 0x7c, 0x2b,
+	0xe8, 0x0a, 0x92, 0xfa, 0x16, 0xfd, 0x9e, 0x6f, 0x08, 0xd9, 0x04, 0xc5, 0x8a, 0x5b, 0xcf, 0xf5,
+	0x85, 0x1b, 0xfe, 0xed, 0x0c, 0xf0, 0x90, 0x43, 0x54, 0xb0, 0x4d, 0xd4, 0xf3, 0x09, 0xac, 0xa7,
+	0x20, 0xf5, 0x0d, 0x52, 0x6f, 0xc5, 0x2f, 0xf1, 0xd3, 0x85, 0xf0, 0x0b, 0xb9, 0xf0, 0x25, 0x7d,
+	0x1f, 0xd5, 0x29, 0xf0, 0x89, 0x2e, 0xac, 0x98, 0x9c, 0x80, 0x36, 0x53, 0x08, 0x5e, 0xa3, 0xc0,
+	0x33, 0x09, 0xf5, 0xc9, 0x09, 0xa8, 0x5d, 0x54, 0x71, 0x58, 0xcc, 0xb5, 0x5f, 0x0a, 0xe1, 0xc4,
+	0xd8, 0x3b, 0xdb, 0x79, 0xf6, 0xce, 0x76, 0xee, 0x6e, 0x9d, 0x5d, 0x35, 0x95, 0xf3, 0xab, 0xa6,
+	0xf2, 0xed, 0xaa, 0xa9, 0x9c, 0x5e, 0x37, 0x4b, 0xe7, 0xd7, 0xcd, 0xd2, 0x97, 0xeb, 0x66, 0x69,
+	0xff, 0xef, 0xdc, 0x54, 0x2f, 0xc4, 0x05, 0x69, 0xc3, 0xc3, 0x84, 0x1a, 0xf2, 0xb2, 0x64, 0x1c,
+	0x1b, 0xf2, 0x2e, 0x25, 0xe6, 0xb4, 0x67, 0xc4, 0x59, 0xf7, 0xdf, 0x8f, 0x01, 0x00, 0xa1, 0x36,
+	0x7c, 0xea, 0x61, 0x09, 0x00, 0x00,
 }
 
 func (m *ReserveSnapshotSavedEvent) Marshal() (dAtA []byte, err error) {
@@ -505,100 +708,369 @@ func (m *MarkPriceChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PoolShutdownEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ReserveSnapshotSavedEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PoolShutdownEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolShutdownEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Pair)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = m.QuoteReserve.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.BaseReserve.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.MarkPrice.Size()
-	n += 1 + l + sovEvent(uint64(l))
 	if m.BlockHeight != 0 {
-		n += 1 + sovEvent(uint64(m.BlockHeight))
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTimestamp)
-	n += 1 + l + sovEvent(uint64(l))
-	return n
-}
-
-func (m *SwapQuoteForBaseEvent) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size := m.SettlementPrice.Size()
+		i -= size
+		if _, err := m.SettlementPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.Pair)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.QuoteAmount.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.BaseAmount.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SwapBaseForQuoteEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Pair)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+func (m *PoolConfigEditedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.QuoteAmount.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.BaseAmount.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MarkPriceChangedEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PoolConfigEditedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolConfigEditedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Pair)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.PositionsMadeLiquidatable != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PositionsMadeLiquidatable))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTimestamp)
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	if m.LiquidatablePositions != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.LiquidatablePositions))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MaxLeverage.Size()
+		i -= size
+		if _, err := m.MaxLeverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaintenanceMarginRatio.Size()
+		i -= size
+		if _, err := m.MaintenanceMarginRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *PoolRepeggedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *PoolRepeggedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *ReserveSnapshotSavedEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+
+func (m *PoolRepeggedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.Cost.Size()
+		i -= size
+		if _, err := m.Cost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.NetPositionSize.Size()
+		i -= size
+		if _, err := m.NetPositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.BaseReserveAfter.Size()
+		i -= size
+		if _, err := m.BaseReserveAfter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.QuoteReserveAfter.Size()
+		i -= size
+		if _, err := m.QuoteReserveAfter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BaseReserveBefore.Size()
+		i -= size
+		if _, err := m.BaseReserveBefore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.QuoteReserveBefore.Size()
+		i -= size
+		if _, err := m.QuoteReserveBefore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ReserveSnapshotSavedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.QuoteReserve.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.BaseReserve.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTimestamp)
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *SwapQuoteForBaseEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.QuoteAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.BaseAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *SwapBaseForQuoteEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.QuoteAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.BaseAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *MarkPriceChangedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTimestamp)
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *PoolShutdownEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.SettlementPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *PoolConfigEditedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.MaintenanceMarginRatio.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.MaxLeverage.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.LiquidatablePositions != 0 {
+		n += 1 + sovEvent(uint64(m.LiquidatablePositions))
+	}
+	if m.PositionsMadeLiquidatable != 0 {
+		n += 1 + sovEvent(uint64(m.PositionsMadeLiquidatable))
+	}
+	return n
+}
+
+func (m *PoolRepeggedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.QuoteReserveBefore.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.BaseReserveBefore.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.QuoteReserveAfter.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.BaseReserveAfter.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.NetPositionSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Cost.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ReserveSnapshotSavedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -726,9 +1198,477 @@ func (m *ReserveSnapshotSavedEvent) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BlockTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapQuoteForBaseEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapQuoteForBaseEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapQuoteForBaseEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapBaseForQuoteEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapBaseForQuoteEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapBaseForQuoteEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarkPriceChangedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarkPriceChangedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarkPriceChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -756,30 +1696,11 @@ func (m *ReserveSnapshotSavedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
 			}
@@ -833,7 +1754,7 @@ func (m *ReserveSnapshotSavedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SwapQuoteForBaseEvent) Unmarshal(dAtA []byte) error {
+func (m *PoolShutdownEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -856,10 +1777,10 @@ func (m *SwapQuoteForBaseEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SwapQuoteForBaseEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolShutdownEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapQuoteForBaseEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolShutdownEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -896,7 +1817,7 @@ func (m *SwapQuoteForBaseEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -924,15 +1845,15 @@ func (m *SwapQuoteForBaseEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.QuoteAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SettlementPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAmount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -942,26 +1863,11 @@ func (m *SwapQuoteForBaseEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BaseAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -983,7 +1889,7 @@ func (m *SwapQuoteForBaseEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SwapBaseForQuoteEvent) Unmarshal(dAtA []byte) error {
+func (m *PoolConfigEditedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1006,10 +1912,10 @@ func (m *SwapBaseForQuoteEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SwapBaseForQuoteEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolConfigEditedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapBaseForQuoteEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolConfigEditedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1046,7 +1952,7 @@ func (m *SwapBaseForQuoteEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1074,13 +1980,13 @@ func (m *SwapBaseForQuoteEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.QuoteAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaintenanceMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeverage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1108,10 +2014,48 @@ func (m *SwapBaseForQuoteEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxLeverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatablePositions", wireType)
+			}
+			m.LiquidatablePositions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidatablePositions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionsMadeLiquidatable", wireType)
+			}
+			m.PositionsMadeLiquidatable = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionsMadeLiquidatable |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -1133,7 +2077,7 @@ func (m *SwapBaseForQuoteEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarkPriceChangedEvent) Unmarshal(dAtA []byte) error {
+func (m *PoolRepeggedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1156,10 +2100,10 @@ func (m *MarkPriceChangedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarkPriceChangedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolRepeggedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarkPriceChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolRepeggedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1196,7 +2140,7 @@ func (m *MarkPriceChangedEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteReserveBefore", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1224,15 +2168,15 @@ func (m *MarkPriceChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QuoteReserveBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseReserveBefore", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1242,25 +2186,181 @@ func (m *MarkPriceChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BlockTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BaseReserveBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteReserveAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteReserveAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseReserveAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseReserveAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetPositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/NibiruChain/nibiru/x/common"
 	pftypes "github.com/NibiruChain/nibiru/x/pricefeed/types"
 )
 
@@ -11,4 +12,21 @@ type PricefeedKeeper interface {
 		pftypes.CurrentPrice, error,
 	)
 	IsActivePair(ctx sdk.Context, pairID string) bool
+	GetCurrentTWAP(ctx sdk.Context, token0 string, token1 string) (sdk.Dec, error)
+}
+
+// PositionsKeeper reports on the positions traded on the vpools, so that edits of
+// a pool config can be checked against them, and settles the cost of repegs.
+type PositionsKeeper interface {
+	// CountLiquidatablePositions returns the number of open positions on the pair
+	// which can be liquidated under the current config of its vpool.
+	CountLiquidatablePositions(ctx sdk.Context, pair common.AssetPair) (uint64, error)
+
+	// GetNetPositionSize returns the size of the net position of the traders on the
+	// pair: positive when they are net long and negative when they are net short.
+	GetNetPositionSize(ctx sdk.Context, pair common.AssetPair) sdk.Dec
+
+	// PayRepegCost pays the cost of repegging the vpool of the pair out of the perp
+	// ecosystem fund, or credits the fund if the cost is negative.
+	PayRepegCost(ctx sdk.Context, pair common.AssetPair, cost sdk.Dec) error
 }
@@ -10,14 +10,20 @@ import (
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Vpools:    []VPool{},
-		Snapshots: []ReserveSnapshot{},
+		Vpools:      []VPool{},
+		Snapshots:   []ReserveSnapshot{},
+		Settlements: []PoolSettlement{},
+		Params:      DefaultParams(),
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
 	// validate vpools
 	vpools := make(map[string]struct{}, len(gs.Vpools))
 	for _, p := range gs.Vpools {
@@ -37,6 +43,21 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	settlements := make(map[string]struct{}, len(gs.Settlements))
+	for _, settlement := range gs.Settlements {
+		if err := settlement.Validate(); err != nil {
+			return err
+		}
+		pair := settlement.Pair.String()
+		if _, exists := vpools[pair]; !exists {
+			return fmt.Errorf("settlement of unknown vpool: %s", pair)
+		}
+		if _, exists := settlements[pair]; exists {
+			return fmt.Errorf("duplicate settlement: %s", pair)
+		}
+		settlements[pair] = struct{}{}
+	}
+
 	return nil
 }
 
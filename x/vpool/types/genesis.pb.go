@@ -25,8 +25,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the vpool module's genesis state.
 type GenesisState struct {
-	Vpools    []VPool           `protobuf:"bytes,1,rep,name=vpools,proto3" json:"vpools"`
-	Snapshots []ReserveSnapshot `protobuf:"bytes,2,rep,name=snapshots,proto3" json:"snapshots"`
+	Vpools      []VPool           `protobuf:"bytes,1,rep,name=vpools,proto3" json:"vpools"`
+	Snapshots   []ReserveSnapshot `protobuf:"bytes,2,rep,name=snapshots,proto3" json:"snapshots"`
+	Settlements []PoolSettlement  `protobuf:"bytes,3,rep,name=settlements,proto3" json:"settlements"`
+	Params      Params            `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +78,20 @@ func (m *GenesisState) GetSnapshots() []ReserveSnapshot {
 	return nil
 }
 
+func (m *GenesisState) GetSettlements() []PoolSettlement {
+	if m != nil {
+		return m.Settlements
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "nibiru.vpool.v1.GenesisState")
 }
@@ -83,22 +99,25 @@ func init() {
 func init() { proto.RegisterFile("vpool/v1/genesis.proto", fileDescriptor_fc3ffc8cca622811) }
 
 var fileDescriptor_fc3ffc8cca622811 = []byte{
-	// 231 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2b, 0x2b, 0xc8, 0xcf,
-	0xcf, 0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0xe2, 0xcf, 0xcb, 0x4c, 0xca, 0x2c, 0x2a, 0xd5, 0x03, 0x4b, 0xeb, 0x95, 0x19,
-	0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0xe5, 0xf4, 0x41, 0x2c, 0x88, 0x32, 0x29, 0x11, 0xb8,
-	0xf6, 0xe2, 0x92, 0xc4, 0x92, 0x54, 0x88, 0xa8, 0x52, 0x17, 0x23, 0x17, 0x8f, 0x3b, 0xc4, 0xb8,
-	0x60, 0x90, 0xb0, 0x90, 0x09, 0x17, 0x1b, 0x58, 0x61, 0xb1, 0x04, 0xa3, 0x02, 0xb3, 0x06, 0xb7,
-	0x91, 0x98, 0x1e, 0x9a, 0xf1, 0x7a, 0x61, 0x01, 0xf9, 0xf9, 0x39, 0x4e, 0x2c, 0x27, 0xee, 0xc9,
-	0x33, 0x04, 0x41, 0xd5, 0x0a, 0xb9, 0x70, 0x71, 0x16, 0xe7, 0x25, 0x16, 0x14, 0x67, 0xe4, 0x97,
-	0x14, 0x4b, 0x30, 0x81, 0x35, 0x2a, 0x60, 0x68, 0x0c, 0x4a, 0x2d, 0x4e, 0x2d, 0x2a, 0x4b, 0x0d,
-	0x86, 0x2a, 0x84, 0x1a, 0x81, 0xd0, 0xe8, 0xe4, 0x7a, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0xda, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x7e,
-	0x60, 0x63, 0x9d, 0x33, 0x12, 0x33, 0xf3, 0xf4, 0x21, 0x56, 0xe8, 0x57, 0xe8, 0x43, 0x3c, 0x57,
-	0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6, 0x9a, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x67,
-	0x5a, 0x0d, 0x00, 0x31, 0x01, 0x00, 0x00,
+	// 285 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xc1, 0x4a, 0xc3, 0x40,
+	0x10, 0x86, 0x93, 0xb6, 0x04, 0xdc, 0x08, 0x42, 0x28, 0x35, 0xf4, 0xb0, 0x0d, 0x9e, 0x0a, 0xc2,
+	0x2e, 0xad, 0xfa, 0x02, 0x55, 0xe9, 0x4d, 0xa4, 0x01, 0x0f, 0xde, 0x12, 0x59, 0x92, 0x40, 0x92,
+	0x09, 0x99, 0x6d, 0xd0, 0x57, 0xf0, 0xe4, 0x63, 0xf5, 0xd8, 0xa3, 0x27, 0x91, 0xe4, 0x45, 0x24,
+	0xbb, 0xb1, 0x4a, 0x73, 0x5b, 0xe6, 0xff, 0xfe, 0x6f, 0x99, 0x21, 0x93, 0xaa, 0x00, 0x48, 0x79,
+	0xb5, 0xe0, 0x91, 0xc8, 0x05, 0x26, 0xc8, 0x8a, 0x12, 0x24, 0x38, 0x67, 0x79, 0x12, 0x26, 0xe5,
+	0x96, 0xa9, 0x98, 0x55, 0x8b, 0xe9, 0x38, 0x82, 0x08, 0x54, 0xc6, 0xdb, 0x97, 0xc6, 0xa6, 0xe3,
+	0x43, 0x1d, 0x65, 0x20, 0x85, 0x9e, 0x5e, 0xbc, 0x0f, 0xc8, 0xe9, 0x5a, 0xeb, 0xfc, 0x76, 0xec,
+	0x5c, 0x13, 0x4b, 0x81, 0xe8, 0x9a, 0xde, 0x70, 0x6e, 0x2f, 0x27, 0xec, 0x48, 0xcf, 0x9e, 0x1e,
+	0x01, 0xd2, 0xd5, 0x68, 0xf7, 0x35, 0x33, 0x36, 0x1d, 0xeb, 0xdc, 0x91, 0x13, 0xcc, 0x83, 0x02,
+	0x63, 0x90, 0xe8, 0x0e, 0x54, 0xd1, 0xeb, 0x15, 0x37, 0x02, 0x45, 0x59, 0x09, 0xbf, 0x03, 0x3b,
+	0xc5, 0x5f, 0xd1, 0x59, 0x13, 0x1b, 0x85, 0x94, 0xa9, 0xc8, 0x44, 0x2e, 0xd1, 0x1d, 0x2a, 0xcf,
+	0xac, 0xe7, 0x69, 0xff, 0xf7, 0x0f, 0x5c, 0xa7, 0xf9, 0xdf, 0x74, 0x6e, 0x88, 0x55, 0x04, 0x65,
+	0x90, 0xa1, 0x3b, 0xf2, 0xcc, 0xb9, 0xbd, 0x3c, 0xef, 0x3b, 0x54, 0xfc, 0xbb, 0x85, 0x86, 0x57,
+	0xf7, 0xbb, 0x9a, 0x9a, 0xfb, 0x9a, 0x9a, 0xdf, 0x35, 0x35, 0x3f, 0x1a, 0x6a, 0xec, 0x1b, 0x6a,
+	0x7c, 0x36, 0xd4, 0x78, 0xbe, 0x8c, 0x12, 0x19, 0x6f, 0x43, 0xf6, 0x02, 0x19, 0x7f, 0x50, 0xaa,
+	0xdb, 0x38, 0x48, 0x72, 0xae, 0xb5, 0xfc, 0x95, 0xeb, 0xe3, 0xca, 0xb7, 0x42, 0x60, 0x68, 0xa9,
+	0xd3, 0x5e, 0xfd, 0x0c, 0x00, 0x53, 0x91, 0x45, 0xea, 0xb1, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -121,6 +140,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Settlements) > 0 {
+		for iNdEx := len(m.Settlements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Settlements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Snapshots) > 0 {
 		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -181,6 +224,14 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Settlements) > 0 {
+		for _, e := range m.Settlements {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -287,6 +338,73 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settlements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Settlements = append(m.Settlements, PoolSettlement{})
+			if err := m.Settlements[len(m.Settlements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -141,6 +142,24 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		"invalid params": {
+			genesis: &GenesisState{
+				Params: NewParams(-time.Minute, sdk.ZeroDec(), DefaultRepegEpochIdentifier),
+			},
+			wantErr: true,
+		},
+		"negative repeg spread ratio": {
+			genesis: &GenesisState{
+				Params: NewParams(time.Minute, sdk.NewDec(-1), DefaultRepegEpochIdentifier),
+			},
+			wantErr: true,
+		},
+		"repeg enabled without epoch": {
+			genesis: &GenesisState{
+				Params: NewParams(time.Minute, sdk.MustNewDecFromStr("0.1"), ""),
+			},
+			wantErr: true,
+		},
 	}
 
 	for name, tc := range cases {
@@ -1,20 +1,34 @@
 package types
 
 import (
+	"fmt"
+
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/NibiruChain/nibiru/x/common"
 )
 
 const (
-	ProposalTypeCreatePool = "CreatePool"
+	ProposalTypeCreatePool     = "CreatePool"
+	ProposalTypeShutdownPool   = "ShutdownPool"
+	ProposalTypeEditPoolConfig = "EditPoolConfig"
+	ProposalTypeRepegPool      = "RepegPool"
 )
 
 var _ govtypes.Content = &CreatePoolProposal{}
+var _ govtypes.Content = &ShutdownPoolProposal{}
+var _ govtypes.Content = &EditPoolConfigProposal{}
+var _ govtypes.Content = &RepegPoolProposal{}
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeCreatePool)
 	govtypes.RegisterProposalTypeCodec(&CreatePoolProposal{}, "nibiru/CreatePoolProposal")
+	govtypes.RegisterProposalType(ProposalTypeShutdownPool)
+	govtypes.RegisterProposalTypeCodec(&ShutdownPoolProposal{}, "nibiru/ShutdownPoolProposal")
+	govtypes.RegisterProposalType(ProposalTypeEditPoolConfig)
+	govtypes.RegisterProposalTypeCodec(&EditPoolConfigProposal{}, "nibiru/EditPoolConfigProposal")
+	govtypes.RegisterProposalType(ProposalTypeRepegPool)
+	govtypes.RegisterProposalTypeCodec(&RepegPoolProposal{}, "nibiru/RepegPoolProposal")
 }
 
 func (m *CreatePoolProposal) ProposalRoute() string {
@@ -43,7 +57,87 @@ func (m *CreatePoolProposal) ValidateBasic() error {
 		MaxOracleSpreadRatio:   m.MaxOracleSpreadRatio,
 		MaintenanceMarginRatio: m.MaintenanceMarginRatio,
 		MaxLeverage:            m.MaxLeverage,
+		MaxOpenInterest:        m.MaxOpenInterest,
+		MaxPositionSize:        m.MaxPositionSize,
 	}
 
 	return pool.Validate()
 }
+
+func (m *ShutdownPoolProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *ShutdownPoolProposal) ProposalType() string {
+	return ProposalTypeShutdownPool
+}
+
+func (m *ShutdownPoolProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	_, err := common.NewAssetPair(m.Pair)
+	return err
+}
+
+func (m *EditPoolConfigProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *EditPoolConfigProposal) ProposalType() string {
+	return ProposalTypeEditPoolConfig
+}
+
+func (m *EditPoolConfigProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	assetPair, err := common.NewAssetPair(m.Pair)
+	if err != nil {
+		return err
+	}
+
+	return m.ApplyTo(VPool{Pair: assetPair}).ValidateConfig()
+}
+
+// ApplyTo returns a copy of the pool with the config of the proposal.
+func (m *EditPoolConfigProposal) ApplyTo(pool VPool) *VPool {
+	pool.TradeLimitRatio = m.TradeLimitRatio
+	pool.FluctuationLimitRatio = m.FluctuationLimitRatio
+	pool.MaxOracleSpreadRatio = m.MaxOracleSpreadRatio
+	pool.MaintenanceMarginRatio = m.MaintenanceMarginRatio
+	pool.MaxLeverage = m.MaxLeverage
+	pool.MaxOpenInterest = m.MaxOpenInterest
+	pool.MaxPositionSize = m.MaxPositionSize
+	return &pool
+}
+
+func (m *RepegPoolProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *RepegPoolProposal) ProposalType() string {
+	return ProposalTypeRepegPool
+}
+
+func (m *RepegPoolProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	if _, err := common.NewAssetPair(m.Pair); err != nil {
+		return err
+	}
+
+	if m.PegPrice.IsNil() || m.PegPrice.IsNegative() {
+		return fmt.Errorf("peg price must be >= 0")
+	}
+
+	if m.KMultiplier.IsNil() || !m.KMultiplier.IsPositive() {
+		return fmt.Errorf("k multiplier must be > 0")
+	}
+
+	return nil
+}
@@ -45,6 +45,12 @@ type CreatePoolProposal struct {
 	MaintenanceMarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=maintenance_margin_ratio,json=maintenanceMarginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maintenance_margin_ratio"`
 	// max_leverage
 	MaxLeverage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=max_leverage,json=maxLeverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_leverage"`
+	// max_open_interest caps the long and the short open interest of the pool
+	// in base asset units. Zero means no cap.
+	MaxOpenInterest github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=max_open_interest,json=maxOpenInterest,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_open_interest"`
+	// max_position_size caps the size of a single position in base asset units.
+	// Zero means no cap.
+	MaxPositionSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=max_position_size,json=maxPositionSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_position_size"`
 }
 
 func (m *CreatePoolProposal) Reset()         { *m = CreatePoolProposal{} }
@@ -101,41 +107,255 @@ func (m *CreatePoolProposal) GetPair() string {
 	return ""
 }
 
+// ShutdownPoolProposal freezes trading on a vpool and fixes the price at which
+// its positions are settled.
+type ShutdownPoolProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// pair represents the pair of the vpool.
+	Pair string `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+}
+
+func (m *ShutdownPoolProposal) Reset()         { *m = ShutdownPoolProposal{} }
+func (m *ShutdownPoolProposal) String() string { return proto.CompactTextString(m) }
+func (*ShutdownPoolProposal) ProtoMessage()    {}
+func (*ShutdownPoolProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a393460ab414204, []int{1}
+}
+func (m *ShutdownPoolProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShutdownPoolProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShutdownPoolProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShutdownPoolProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShutdownPoolProposal.Merge(m, src)
+}
+func (m *ShutdownPoolProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShutdownPoolProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShutdownPoolProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShutdownPoolProposal proto.InternalMessageInfo
+
+func (m *ShutdownPoolProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ShutdownPoolProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ShutdownPoolProposal) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+// EditPoolConfigProposal replaces the trading config of an existing vpool.
+// The reserves of the vpool are left untouched.
+type EditPoolConfigProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// pair represents the pair of the vpool.
+	Pair                   string                                 `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	TradeLimitRatio        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=trade_limit_ratio,json=tradeLimitRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trade_limit_ratio"`
+	FluctuationLimitRatio  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=fluctuation_limit_ratio,json=fluctuationLimitRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fluctuation_limit_ratio"`
+	MaxOracleSpreadRatio   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_oracle_spread_ratio,json=maxOracleSpreadRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_oracle_spread_ratio"`
+	MaintenanceMarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=maintenance_margin_ratio,json=maintenanceMarginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maintenance_margin_ratio"`
+	MaxLeverage            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=max_leverage,json=maxLeverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_leverage"`
+	MaxOpenInterest        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=max_open_interest,json=maxOpenInterest,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_open_interest"`
+	MaxPositionSize        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=max_position_size,json=maxPositionSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_position_size"`
+}
+
+func (m *EditPoolConfigProposal) Reset()         { *m = EditPoolConfigProposal{} }
+func (m *EditPoolConfigProposal) String() string { return proto.CompactTextString(m) }
+func (*EditPoolConfigProposal) ProtoMessage()    {}
+func (*EditPoolConfigProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a393460ab414204, []int{2}
+}
+func (m *EditPoolConfigProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EditPoolConfigProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EditPoolConfigProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EditPoolConfigProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EditPoolConfigProposal.Merge(m, src)
+}
+func (m *EditPoolConfigProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *EditPoolConfigProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EditPoolConfigProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EditPoolConfigProposal proto.InternalMessageInfo
+
+func (m *EditPoolConfigProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *EditPoolConfigProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *EditPoolConfigProposal) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+// RepegPoolProposal moves the peg and scales the invariant k of a vpool.
+// The cost of the adjustment is paid by the perp ecosystem fund.
+type RepegPoolProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// pair represents the pair of the vpool.
+	Pair string `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	// peg_price is the mark price of the vpool after the repeg. Zero keeps the
+	// current mark price, e.g. to only scale k.
+	PegPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=peg_price,json=pegPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"peg_price"`
+	// k_multiplier scales the invariant k, i.e. the depth, of the vpool.
+	// One leaves it unchanged.
+	KMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=k_multiplier,json=kMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"k_multiplier"`
+}
+
+func (m *RepegPoolProposal) Reset()         { *m = RepegPoolProposal{} }
+func (m *RepegPoolProposal) String() string { return proto.CompactTextString(m) }
+func (*RepegPoolProposal) ProtoMessage()    {}
+func (*RepegPoolProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a393460ab414204, []int{3}
+}
+func (m *RepegPoolProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepegPoolProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepegPoolProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RepegPoolProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepegPoolProposal.Merge(m, src)
+}
+func (m *RepegPoolProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepegPoolProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepegPoolProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepegPoolProposal proto.InternalMessageInfo
+
+func (m *RepegPoolProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *RepegPoolProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *RepegPoolProposal) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*CreatePoolProposal)(nil), "nibiru.vpool.v1.CreatePoolProposal")
+	proto.RegisterType((*ShutdownPoolProposal)(nil), "nibiru.vpool.v1.ShutdownPoolProposal")
+	proto.RegisterType((*EditPoolConfigProposal)(nil), "nibiru.vpool.v1.EditPoolConfigProposal")
+	proto.RegisterType((*RepegPoolProposal)(nil), "nibiru.vpool.v1.RepegPoolProposal")
 }
 
 func init() { proto.RegisterFile("vpool/v1/gov.proto", fileDescriptor_8a393460ab414204) }
 
 var fileDescriptor_8a393460ab414204 = []byte{
-	// 424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0xd3, 0xb1, 0x6e, 0xd4, 0x30,
-	0x18, 0x07, 0xf0, 0x0b, 0xb4, 0x07, 0x75, 0x91, 0x4a, 0xcd, 0x41, 0x23, 0x86, 0xb4, 0x62, 0x40,
-	0x48, 0x88, 0x58, 0x15, 0x4f, 0x40, 0x0b, 0x5b, 0x81, 0x72, 0x6c, 0x15, 0x22, 0xfa, 0x2e, 0xf7,
-	0x35, 0x67, 0x61, 0xfb, 0x0b, 0xb6, 0x13, 0x1d, 0x2f, 0xc0, 0xcc, 0x63, 0x75, 0xec, 0x88, 0x18,
-	0x2a, 0x74, 0xf7, 0x22, 0xc8, 0xce, 0x0d, 0x29, 0x63, 0xa6, 0x38, 0xfe, 0xac, 0xdf, 0x5f, 0xb6,
-	0xf4, 0x67, 0xbc, 0xad, 0x89, 0x94, 0x68, 0x8f, 0x45, 0x45, 0x6d, 0x5e, 0x5b, 0xf2, 0xc4, 0xf7,
-	0x8c, 0x9c, 0x49, 0xdb, 0xe4, 0x71, 0x94, 0xb7, 0xc7, 0x4f, 0x27, 0x15, 0x55, 0x14, 0x67, 0x22,
-	0xac, 0xba, 0x63, 0xcf, 0x7e, 0x8e, 0x19, 0x3f, 0xb5, 0x08, 0x1e, 0xcf, 0x89, 0xd4, 0xb9, 0xa5,
-	0x9a, 0x1c, 0x28, 0x3e, 0x61, 0xdb, 0x5e, 0x7a, 0x85, 0x69, 0x72, 0x94, 0xbc, 0xd8, 0x99, 0x76,
-	0x3f, 0xfc, 0x88, 0xed, 0xce, 0xd1, 0x95, 0x56, 0xd6, 0x5e, 0x92, 0x49, 0xef, 0xc4, 0x59, 0x7f,
-	0x8b, 0x73, 0xb6, 0x55, 0x83, 0xb4, 0xe9, 0xdd, 0x38, 0x8a, 0x6b, 0x7e, 0xc1, 0xf6, 0xbd, 0x85,
-	0x39, 0x16, 0x4a, 0x6a, 0xe9, 0x0b, 0x0b, 0x5e, 0x52, 0xba, 0x15, 0x0e, 0x9c, 0xe4, 0x57, 0x37,
-	0x87, 0xa3, 0x3f, 0x37, 0x87, 0xcf, 0x2b, 0xe9, 0x17, 0xcd, 0x2c, 0x2f, 0x49, 0x8b, 0x92, 0x9c,
-	0x26, 0xb7, 0xf9, 0xbc, 0x72, 0xf3, 0x6f, 0xc2, 0xff, 0xa8, 0xd1, 0xe5, 0x6f, 0xb1, 0x9c, 0xee,
-	0x45, 0xe8, 0x2c, 0x38, 0xd3, 0xc0, 0xf0, 0xaf, 0xec, 0xd1, 0xf7, 0x86, 0x3c, 0x16, 0xe0, 0x1c,
-	0xfa, 0xc2, 0xa2, 0x43, 0xdb, 0x62, 0xba, 0x3d, 0x48, 0xdf, 0x8f, 0xd4, 0x9b, 0x20, 0x4d, 0x3b,
-	0x88, 0x7f, 0x61, 0x7c, 0x06, 0xee, 0x7f, 0x7e, 0x3c, 0x88, 0x7f, 0x18, 0xa4, 0x5b, 0xfa, 0x25,
-	0x3b, 0xb8, 0x54, 0x4d, 0xe9, 0x9b, 0x70, 0x17, 0x73, 0xeb, 0x7d, 0xee, 0x0d, 0x8a, 0x78, 0xdc,
-	0xe3, 0x7a, 0xaf, 0x84, 0xec, 0x40, 0xc3, 0xb2, 0x20, 0x0b, 0xa5, 0xc2, 0xc2, 0xd5, 0x16, 0x61,
-	0xbe, 0xc9, 0xb9, 0x3f, 0x28, 0x67, 0xa2, 0x61, 0xf9, 0x31, 0x6a, 0x9f, 0x23, 0xd6, 0xc5, 0x2c,
-	0x58, 0xaa, 0x41, 0x1a, 0x8f, 0x06, 0x4c, 0x89, 0x85, 0x06, 0x5b, 0x49, 0xb3, 0xc9, 0xd9, 0x19,
-	0x94, 0xf3, 0xa4, 0xe7, 0xbd, 0x8f, 0x5c, 0x97, 0xf4, 0x89, 0x3d, 0x08, 0x17, 0x52, 0xd8, 0xa2,
-	0x85, 0x0a, 0x53, 0x36, 0x48, 0xdf, 0xd5, 0xb0, 0x3c, 0xdb, 0x10, 0x27, 0xef, 0xae, 0x56, 0x59,
-	0x72, 0xbd, 0xca, 0x92, 0xbf, 0xab, 0x2c, 0xf9, 0xb5, 0xce, 0x46, 0xd7, 0xeb, 0x6c, 0xf4, 0x7b,
-	0x9d, 0x8d, 0x2e, 0x5e, 0xf6, 0xb8, 0x0f, 0xb1, 0x54, 0xa7, 0x0b, 0x90, 0x46, 0x74, 0x05, 0x13,
-	0x4b, 0xd1, 0xb5, 0x2f, 0xba, 0xb3, 0x71, 0xac, 0xd5, 0xeb, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x4f, 0xe9, 0xb5, 0x83, 0x93, 0x03, 0x00, 0x00,
+	// 574 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0x58, 0xff, 0xb9, 0x93, 0x46, 0x43, 0xd9, 0x22, 0x0e, 0xd9, 0xd4, 0x03, 0x42,
+	0x42, 0x24, 0x9a, 0xf8, 0x04, 0xac, 0xec, 0x80, 0xd8, 0xa0, 0xb4, 0xb7, 0x09, 0x11, 0xb9, 0xc9,
+	0xdb, 0xd4, 0x6a, 0x62, 0x1b, 0xdb, 0x09, 0x65, 0x67, 0x3e, 0x00, 0x9f, 0x88, 0xf3, 0x8e, 0x3b,
+	0x22, 0x0e, 0x13, 0x6a, 0x3f, 0x08, 0xc8, 0x4e, 0x40, 0x1d, 0x07, 0x0e, 0x11, 0x9d, 0xb4, 0x53,
+	0x9c, 0xbc, 0xce, 0xef, 0x89, 0x5f, 0xbf, 0x4f, 0xfc, 0x22, 0x3b, 0xe7, 0x8c, 0x25, 0x7e, 0x7e,
+	0xe8, 0xc7, 0x2c, 0xf7, 0xb8, 0x60, 0x8a, 0xd9, 0x3b, 0x94, 0x4c, 0x88, 0xc8, 0x3c, 0x13, 0xf2,
+	0xf2, 0xc3, 0x87, 0xbd, 0x98, 0xc5, 0xcc, 0xc4, 0x7c, 0x3d, 0x2a, 0xa6, 0xf5, 0xbf, 0x36, 0x91,
+	0x3d, 0x10, 0x80, 0x15, 0x0c, 0x19, 0x4b, 0x86, 0x82, 0x71, 0x26, 0x71, 0x62, 0xf7, 0x50, 0x5d,
+	0x11, 0x95, 0x80, 0x63, 0x1d, 0x58, 0x8f, 0xdb, 0xa3, 0xe2, 0xc6, 0x3e, 0x40, 0x9d, 0x08, 0x64,
+	0x28, 0x08, 0x57, 0x84, 0x51, 0xe7, 0x8e, 0x89, 0xad, 0x3f, 0xb2, 0x6d, 0xb4, 0xc5, 0x31, 0x11,
+	0xce, 0x5d, 0x13, 0x32, 0x63, 0xfb, 0x0c, 0x75, 0x95, 0xc0, 0x11, 0x04, 0x09, 0x49, 0x89, 0x0a,
+	0x04, 0x56, 0x84, 0x39, 0x5b, 0x7a, 0xc2, 0x91, 0x77, 0x71, 0xb5, 0x5f, 0xfb, 0x7e, 0xb5, 0xff,
+	0x28, 0x26, 0x6a, 0x96, 0x4d, 0xbc, 0x90, 0xa5, 0x7e, 0xc8, 0x64, 0xca, 0x64, 0x79, 0x79, 0x2a,
+	0xa3, 0xb9, 0xaf, 0x3e, 0x71, 0x90, 0xde, 0x0b, 0x08, 0x47, 0x3b, 0x06, 0x74, 0xa2, 0x39, 0x23,
+	0x8d, 0xb1, 0xdf, 0xa3, 0xfb, 0x1f, 0x32, 0xa6, 0x20, 0xc0, 0x52, 0x82, 0x0a, 0x04, 0x48, 0x10,
+	0x39, 0x38, 0xf5, 0x4a, 0xf4, 0xae, 0x41, 0x3d, 0xd7, 0xa4, 0x51, 0x01, 0xb2, 0xdf, 0x21, 0x7b,
+	0x82, 0xe5, 0xdf, 0xf8, 0x46, 0x25, 0xfc, 0x3d, 0x4d, 0xba, 0x46, 0x9f, 0xa2, 0xbd, 0x69, 0x92,
+	0x85, 0x2a, 0xd3, 0x6b, 0xa1, 0xd7, 0xf2, 0xd3, 0xac, 0x24, 0xf1, 0x60, 0x0d, 0xb7, 0x96, 0x25,
+	0x40, 0x7b, 0x29, 0x5e, 0x04, 0x4c, 0xe0, 0x30, 0x81, 0x40, 0x72, 0x01, 0x38, 0x2a, 0x75, 0x5a,
+	0x95, 0x74, 0x7a, 0x29, 0x5e, 0xbc, 0x31, 0xb4, 0xb1, 0x81, 0x15, 0x32, 0x33, 0xe4, 0xa4, 0x98,
+	0x50, 0x05, 0x14, 0xd3, 0x10, 0x82, 0x14, 0x8b, 0x98, 0xd0, 0x52, 0xa7, 0x5d, 0x49, 0x67, 0x77,
+	0x8d, 0x77, 0x6a, 0x70, 0x85, 0xd2, 0x5b, 0xb4, 0xad, 0x17, 0x94, 0x40, 0x0e, 0x02, 0xc7, 0xe0,
+	0xa0, 0x4a, 0xf4, 0x4e, 0x8a, 0x17, 0x27, 0x25, 0x42, 0x57, 0xa9, 0xc9, 0x11, 0x07, 0x1a, 0x68,
+	0x4d, 0x01, 0x52, 0x39, 0x9d, 0x6a, 0x55, 0xaa, 0xb3, 0xc3, 0x81, 0xbe, 0x2c, 0x31, 0xbf, 0xd9,
+	0x9c, 0x49, 0x62, 0x36, 0x5a, 0x92, 0x73, 0x70, 0xb6, 0x2b, 0xb3, 0x87, 0x25, 0x67, 0x4c, 0xce,
+	0xa1, 0x3f, 0x41, 0xbd, 0xf1, 0x2c, 0x53, 0x11, 0xfb, 0x48, 0x37, 0xe5, 0xe0, 0xfe, 0xe7, 0x06,
+	0xda, 0x3d, 0x8e, 0x88, 0xd2, 0x02, 0x03, 0x46, 0xa7, 0x24, 0xbe, 0x75, 0x3f, 0x8a, 0x7f, 0x58,
+	0xad, 0x7e, 0x43, 0x56, 0x6b, 0xdc, 0x90, 0xd5, 0x9a, 0x1b, 0xb5, 0x5a, 0x6b, 0x43, 0x56, 0x6b,
+	0x6f, 0xd0, 0x6a, 0xe8, 0xff, 0x58, 0xed, 0xa7, 0x85, 0xba, 0x23, 0xe0, 0x10, 0x6f, 0xec, 0xa8,
+	0x7c, 0x85, 0xda, 0x1c, 0xe2, 0x80, 0x0b, 0x12, 0x42, 0xc5, 0xca, 0x6f, 0xe9, 0xef, 0xd3, 0xef,
+	0xeb, 0x9d, 0x9b, 0x07, 0x69, 0x96, 0x28, 0xc2, 0x13, 0x02, 0xa2, 0x62, 0x9d, 0x77, 0xe6, 0xa7,
+	0x7f, 0x10, 0x47, 0xc7, 0x17, 0x4b, 0xd7, 0xba, 0x5c, 0xba, 0xd6, 0x8f, 0xa5, 0x6b, 0x7d, 0x59,
+	0xb9, 0xb5, 0xcb, 0x95, 0x5b, 0xfb, 0xb6, 0x72, 0x6b, 0x67, 0x4f, 0xd6, 0x70, 0xaf, 0x4d, 0xe7,
+	0x31, 0x98, 0x61, 0x42, 0xfd, 0xa2, 0x0b, 0xf1, 0x17, 0x7e, 0xd1, 0xa2, 0x18, 0xee, 0xa4, 0x61,
+	0x7a, 0x8f, 0x67, 0xbf, 0x06, 0x00, 0x69, 0x2a, 0x85, 0xed, 0xb8, 0x08, 0x00, 0x00,
 }
 
 func (m *CreatePoolProposal) Marshal() (dAtA []byte, err error) {
@@ -158,6 +378,26 @@ func (m *CreatePoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxPositionSize.Size()
+		i -= size
+		if _, err := m.MaxPositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.MaxOpenInterest.Size()
+		i -= size
+		if _, err := m.MaxOpenInterest.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
 	{
 		size := m.MaxLeverage.Size()
 		i -= size
@@ -252,59 +492,964 @@ func (m *CreatePoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGov(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ShutdownPoolProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *CreatePoolProposal) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ShutdownPoolProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShutdownPoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EditPoolConfigProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EditPoolConfigProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EditPoolConfigProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	{
+		size := m.MaxPositionSize.Size()
+		i -= size
+		if _, err := m.MaxPositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.MaxOpenInterest.Size()
+		i -= size
+		if _, err := m.MaxOpenInterest.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
 	}
-	l = len(m.Pair)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MaxLeverage.Size()
+		i -= size
+		if _, err := m.MaxLeverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MaintenanceMarginRatio.Size()
+		i -= size
+		if _, err := m.MaintenanceMarginRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MaxOracleSpreadRatio.Size()
+		i -= size
+		if _, err := m.MaxOracleSpreadRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.FluctuationLimitRatio.Size()
+		i -= size
+		if _, err := m.FluctuationLimitRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TradeLimitRatio.Size()
+		i -= size
+		if _, err := m.TradeLimitRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RepegPoolProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepegPoolProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepegPoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.KMultiplier.Size()
+		i -= size
+		if _, err := m.KMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PegPrice.Size()
+		i -= size
+		if _, err := m.PegPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CreatePoolProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.TradeLimitRatio.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.QuoteAssetReserve.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.BaseAssetReserve.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.FluctuationLimitRatio.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.MaxOracleSpreadRatio.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.MaintenanceMarginRatio.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.MaxLeverage.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.MaxOpenInterest.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.MaxPositionSize.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *ShutdownPoolProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *EditPoolConfigProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.TradeLimitRatio.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.FluctuationLimitRatio.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.MaxOracleSpreadRatio.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.MaintenanceMarginRatio.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.MaxLeverage.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.MaxOpenInterest.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.MaxPositionSize.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *RepegPoolProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.PegPrice.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.KMultiplier.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreatePoolProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreatePoolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeLimitRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TradeLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteAssetReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAssetReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FluctuationLimitRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FluctuationLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOracleSpreadRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOracleSpreadRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxLeverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenInterest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOpenInterest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShutdownPoolProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShutdownPoolProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShutdownPoolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.TradeLimitRatio.Size()
-	n += 1 + l + sovGov(uint64(l))
-	l = m.QuoteAssetReserve.Size()
-	n += 1 + l + sovGov(uint64(l))
-	l = m.BaseAssetReserve.Size()
-	n += 1 + l + sovGov(uint64(l))
-	l = m.FluctuationLimitRatio.Size()
-	n += 1 + l + sovGov(uint64(l))
-	l = m.MaxOracleSpreadRatio.Size()
-	n += 1 + l + sovGov(uint64(l))
-	l = m.MaintenanceMarginRatio.Size()
-	n += 1 + l + sovGov(uint64(l))
-	l = m.MaxLeverage.Size()
-	n += 1 + l + sovGov(uint64(l))
-	return n
-}
 
-func sovGov(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGov(x uint64) (n int) {
-	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
+func (m *EditPoolConfigProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -327,10 +1472,10 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreatePoolProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: EditPoolConfigProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreatePoolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EditPoolConfigProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -465,7 +1610,7 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetReserve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FluctuationLimitRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -493,13 +1638,13 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.QuoteAssetReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FluctuationLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetReserve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOracleSpreadRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -527,13 +1672,13 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseAssetReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxOracleSpreadRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FluctuationLimitRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -561,13 +1706,13 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FluctuationLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaintenanceMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxOracleSpreadRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeverage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -595,13 +1740,13 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxOracleSpreadRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxLeverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenInterest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -629,13 +1774,13 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaintenanceMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxOpenInterest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeverage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPositionSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -663,7 +1808,221 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxLeverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepegPoolProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepegPoolProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepegPoolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PegPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PegPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
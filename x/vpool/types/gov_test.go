@@ -48,3 +48,156 @@ func TestCreatePoolProposal_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestShutdownPoolProposal_ValidateBasic(t *testing.T) {
+	type test struct {
+		m         *ShutdownPoolProposal
+		expectErr bool
+	}
+
+	cases := map[string]test{
+		"invalid pair": {&ShutdownPoolProposal{
+			Title:       "shutdown proposal",
+			Description: "some weird description",
+			Pair:        "invalidpair",
+		}, true},
+
+		"missing title": {&ShutdownPoolProposal{
+			Description: "some weird description",
+			Pair:        "valid:pair",
+		}, true},
+
+		"success": {&ShutdownPoolProposal{
+			Title:       "shutdown proposal",
+			Description: "some weird description",
+			Pair:        "valid:pair",
+		}, false},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.m.ValidateBasic()
+			if err == nil && tc.expectErr {
+				t.Fatal("error expected")
+			} else if err != nil && !tc.expectErr {
+				t.Fatal("unexpected error")
+			}
+		})
+	}
+}
+
+func TestEditPoolConfigProposal_ValidateBasic(t *testing.T) {
+	validProposal := func() *EditPoolConfigProposal {
+		return &EditPoolConfigProposal{
+			Title:                  "edit proposal",
+			Description:            "some weird description",
+			Pair:                   "valid:pair",
+			TradeLimitRatio:        sdk.MustNewDecFromStr("0.10"),
+			FluctuationLimitRatio:  sdk.MustNewDecFromStr("0.10"),
+			MaxOracleSpreadRatio:   sdk.MustNewDecFromStr("0.10"),
+			MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.1"),
+			MaxLeverage:            sdk.MustNewDecFromStr("10"),
+			MaxOpenInterest:        sdk.ZeroDec(),
+			MaxPositionSize:        sdk.NewDec(1_000),
+		}
+	}
+
+	cases := map[string]struct {
+		edit      func(m *EditPoolConfigProposal)
+		expectErr bool
+	}{
+		"invalid pair": {
+			edit:      func(m *EditPoolConfigProposal) { m.Pair = "invalidpair" },
+			expectErr: true,
+		},
+		"missing title": {
+			edit:      func(m *EditPoolConfigProposal) { m.Title = "" },
+			expectErr: true,
+		},
+		"max leverage over the maintenance margin ratio": {
+			edit:      func(m *EditPoolConfigProposal) { m.MaxLeverage = sdk.NewDec(11) },
+			expectErr: true,
+		},
+		"negative max position size": {
+			edit:      func(m *EditPoolConfigProposal) { m.MaxPositionSize = sdk.NewDec(-1) },
+			expectErr: true,
+		},
+		"success": {
+			edit:      func(m *EditPoolConfigProposal) {},
+			expectErr: false,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			m := validProposal()
+			tc.edit(m)
+			err := m.ValidateBasic()
+			if err == nil && tc.expectErr {
+				t.Fatal("error expected")
+			} else if err != nil && !tc.expectErr {
+				t.Fatal("unexpected error")
+			}
+		})
+	}
+}
+
+func TestRepegPoolProposal_ValidateBasic(t *testing.T) {
+	cases := map[string]struct {
+		m         *RepegPoolProposal
+		expectErr bool
+	}{
+		"invalid pair": {&RepegPoolProposal{
+			Title:       "repeg proposal",
+			Description: "some weird description",
+			Pair:        "invalidpair",
+			PegPrice:    sdk.OneDec(),
+			KMultiplier: sdk.OneDec(),
+		}, true},
+
+		"negative peg price": {&RepegPoolProposal{
+			Title:       "repeg proposal",
+			Description: "some weird description",
+			Pair:        "valid:pair",
+			PegPrice:    sdk.NewDec(-1),
+			KMultiplier: sdk.OneDec(),
+		}, true},
+
+		"zero k multiplier": {&RepegPoolProposal{
+			Title:       "repeg proposal",
+			Description: "some weird description",
+			Pair:        "valid:pair",
+			PegPrice:    sdk.OneDec(),
+			KMultiplier: sdk.ZeroDec(),
+		}, true},
+
+		"missing k multiplier": {&RepegPoolProposal{
+			Title:       "repeg proposal",
+			Description: "some weird description",
+			Pair:        "valid:pair",
+			PegPrice:    sdk.OneDec(),
+		}, true},
+
+		"success with zero peg price": {&RepegPoolProposal{
+			Title:       "repeg proposal",
+			Description: "some weird description",
+			Pair:        "valid:pair",
+			PegPrice:    sdk.ZeroDec(),
+			KMultiplier: sdk.NewDec(2),
+		}, false},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.m.ValidateBasic()
+			if err == nil && tc.expectErr {
+				t.Fatal("error expected")
+			} else if err != nil && !tc.expectErr {
+				t.Fatal("unexpected error")
+			}
+		})
+	}
+}
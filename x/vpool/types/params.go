@@ -0,0 +1,109 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter keys
+var (
+	KeySnapshotRetentionWindow = []byte("SnapshotRetentionWindow")
+	KeyRepegSpreadRatio        = []byte("RepegSpreadRatio")
+	KeyRepegEpochIdentifier    = []byte("RepegEpochIdentifier")
+)
+
+// MaxSnapshotsPrunedPerBlock is the maximum number of reserve snapshots deleted in a single block.
+const MaxSnapshotsPrunedPerBlock = 100
+
+// DefaultSnapshotRetentionWindow is twice the default x/perp TWAP lookback window.
+var DefaultSnapshotRetentionWindow = 30 * time.Minute
+
+// DefaultRepegEpochIdentifier is the default x/perp funding rate interval.
+const DefaultRepegEpochIdentifier = "30 min"
+
+// NewParams creates a new Params object.
+func NewParams(
+	snapshotRetentionWindow time.Duration, repegSpreadRatio sdk.Dec, repegEpochIdentifier string,
+) Params {
+	return Params{
+		SnapshotRetentionWindow: snapshotRetentionWindow,
+		RepegSpreadRatio:        repegSpreadRatio,
+		RepegEpochIdentifier:    repegEpochIdentifier,
+	}
+}
+
+// DefaultParams returns the default parameters for the x/vpool module.
+// Automatic repegging is disabled by default.
+func DefaultParams() Params {
+	return NewParams(DefaultSnapshotRetentionWindow, sdk.ZeroDec(), DefaultRepegEpochIdentifier)
+}
+
+// ParamKeyTable returns the key table of the x/vpool params.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value
+// pairs of the x/vpool module's parameters.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeySnapshotRetentionWindow, &p.SnapshotRetentionWindow, validateSnapshotRetentionWindow),
+		paramtypes.NewParamSetPair(KeyRepegSpreadRatio, &p.RepegSpreadRatio, validateRepegSpreadRatio),
+		paramtypes.NewParamSetPair(KeyRepegEpochIdentifier, &p.RepegEpochIdentifier, validateRepegEpochIdentifier),
+	}
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if err := validateSnapshotRetentionWindow(p.SnapshotRetentionWindow); err != nil {
+		return err
+	}
+	if err := validateRepegSpreadRatio(p.RepegSpreadRatio); err != nil {
+		return err
+	}
+	if err := validateRepegEpochIdentifier(p.RepegEpochIdentifier); err != nil {
+		return err
+	}
+	if p.IsRepegEnabled() && p.RepegEpochIdentifier == "" {
+		return fmt.Errorf("repeg epoch identifier must be set when repegging is enabled")
+	}
+	return nil
+}
+
+func validateSnapshotRetentionWindow(i interface{}) error {
+	d, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for snapshot retention window: %T", i)
+	}
+	if d < 0 {
+		return fmt.Errorf("invalid snapshot retention window, negative value is not allowed: %s", d)
+	}
+	return nil
+}
+
+func validateRepegSpreadRatio(i interface{}) error {
+	ratio, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for repeg spread ratio: %T", i)
+	}
+	// params set before repegging was added have no repeg spread ratio, which disables it
+	if !ratio.IsNil() && ratio.IsNegative() {
+		return fmt.Errorf("invalid repeg spread ratio, negative value is not allowed: %s", ratio)
+	}
+	return nil
+}
+
+func validateRepegEpochIdentifier(i interface{}) error {
+	if _, ok := i.(string); !ok {
+		return fmt.Errorf("invalid parameter type for repeg epoch identifier: %T", i)
+	}
+	return nil
+}
+
+// IsRepegEnabled returns whether the vpools are automatically repegged to their index TWAP.
+func (p Params) IsRepegEnabled() bool {
+	return !p.RepegSpreadRatio.IsNil() && p.RepegSpreadRatio.IsPositive()
+}
@@ -133,21 +133,27 @@ func (m *VPool) Validate() error {
 		return fmt.Errorf("invalid asset pair: %w", err)
 	}
 
-	// trade limit ratio always between 0 and 1
-	if m.TradeLimitRatio.LT(sdk.ZeroDec()) || m.TradeLimitRatio.GT(sdk.OneDec()) {
-		return fmt.Errorf("trade limit ratio must be 0 <= ratio <= 1")
-	}
-
 	// quote asset reserve always > 0
-	if !m.QuoteAssetReserve.IsPositive() {
+	if m.QuoteAssetReserve.IsNil() || !m.QuoteAssetReserve.IsPositive() {
 		return fmt.Errorf("quote asset reserve must be > 0")
 	}
 
 	// base asset reserve always > 0
-	if !m.BaseAssetReserve.IsPositive() {
+	if m.BaseAssetReserve.IsNil() || !m.BaseAssetReserve.IsPositive() {
 		return fmt.Errorf("base asset reserve must be > 0")
 	}
 
+	return m.ValidateConfig()
+}
+
+// ValidateConfig checks the trading config of the pool, i.e. everything but its
+// pair and reserves, which governance can edit on a live pool.
+func (m *VPool) ValidateConfig() error {
+	// trade limit ratio always between 0 and 1
+	if m.TradeLimitRatio.LT(sdk.ZeroDec()) || m.TradeLimitRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("trade limit ratio must be 0 <= ratio <= 1")
+	}
+
 	// fluctuation limit ratio between 0 and 1
 	if m.FluctuationLimitRatio.LT(sdk.ZeroDec()) || m.FluctuationLimitRatio.GT(sdk.OneDec()) {
 		return fmt.Errorf("fluctuation limit ratio must be 0 <= ratio <= 1")
@@ -170,6 +176,15 @@ func (m *VPool) Validate() error {
 		return fmt.Errorf("margin ratio opened with max leverage position will be lower than Maintenance margin ratio")
 	}
 
+	// caps left unset are zero, which means there is no cap
+	if !m.MaxOpenInterest.IsNil() && m.MaxOpenInterest.IsNegative() {
+		return fmt.Errorf("max open interest must be >= 0")
+	}
+
+	if !m.MaxPositionSize.IsNil() && m.MaxPositionSize.IsNegative() {
+		return fmt.Errorf("max position size must be >= 0")
+	}
+
 	return nil
 }
 
@@ -226,3 +241,93 @@ func (p VPool) IsOverSpreadLimit(indexPrice sdk.Dec) bool {
 	return p.GetMarkPrice().Sub(indexPrice).
 		Quo(indexPrice).Abs().GTE(p.MaxOracleSpreadRatio)
 }
+
+func (s PoolSettlement) Validate() error {
+	if err := s.Pair.Validate(); err != nil {
+		return err
+	}
+
+	if s.SettlementPrice.IsNil() || !s.SettlementPrice.IsPositive() {
+		return fmt.Errorf("settlement price must be positive, not: %s", s.SettlementPrice)
+	}
+
+	if s.BlockHeight < 0 {
+		return fmt.Errorf("settlement block height cannot be negative: %d", s.BlockHeight)
+	}
+
+	return nil
+}
+
+/*
+Repeg returns the pool with its mark price moved to pegPrice and its invariant k
+multiplied by kMultiplier. Both reserves are scaled by the square root of
+kMultiplier, then the quote reserve is set so that the mark price is pegPrice.
+
+args:
+  - pegPrice: the mark price of the repegged pool
+  - kMultiplier: the multiplier of the invariant k, one leaves k unchanged
+
+ret:
+  - repegged: the repegged pool
+  - err: error
+*/
+func (p VPool) Repeg(pegPrice sdk.Dec, kMultiplier sdk.Dec) (repegged VPool, err error) {
+	if !pegPrice.IsPositive() {
+		return VPool{}, fmt.Errorf("peg price must be > 0: %s", pegPrice)
+	}
+	if !kMultiplier.IsPositive() {
+		return VPool{}, fmt.Errorf("k multiplier must be > 0: %s", kMultiplier)
+	}
+
+	depthMultiplier, err := kMultiplier.ApproxSqrt()
+	if err != nil {
+		return VPool{}, err
+	}
+
+	p.BaseAssetReserve = p.BaseAssetReserve.Mul(depthMultiplier)
+	p.QuoteAssetReserve = p.BaseAssetReserve.Mul(pegPrice)
+	return p, p.ValidateReserves()
+}
+
+/*
+GetRepegCost returns the cost of replacing the pool with the repegged one, given the
+net position of the traders. It is the change of the quote amount the traders would
+get by closing their net position against the pool, which the perp ecosystem fund
+pays. A negative cost is a profit for the fund.
+
+args:
+  - repegged: the repegged pool
+  - netPositionSize: the net base asset position of the traders, positive when
+    net long and negative when net short
+
+ret:
+  - cost: the quote asset cost of the repeg
+  - err: error
+*/
+func (p VPool) GetRepegCost(repegged VPool, netPositionSize sdk.Dec) (cost sdk.Dec, err error) {
+	if netPositionSize.IsZero() {
+		return sdk.ZeroDec(), nil
+	}
+
+	valueBefore, err := p.netPositionValue(netPositionSize)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	valueAfter, err := repegged.netPositionValue(netPositionSize)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return valueAfter.Sub(valueBefore), nil
+}
+
+// netPositionValue returns the quote amount the traders would get by closing a net
+// position of netPositionSize against the pool, i.e. quote * size / (base + size).
+// It is negative for net short positions.
+func (p VPool) netPositionValue(netPositionSize sdk.Dec) (sdk.Dec, error) {
+	baseAfterClose := p.BaseAssetReserve.Add(netPositionSize)
+	if !baseAfterClose.IsPositive() {
+		return sdk.Dec{}, ErrBaseReserveAtZero.Wrapf(
+			"net position size %s exceeds base reserve %s", netPositionSize, p.BaseAssetReserve)
+	}
+	return p.QuoteAssetReserve.Mul(netPositionSize).Quo(baseAfterClose), nil
+}
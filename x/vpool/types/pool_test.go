@@ -382,6 +382,52 @@ func TestPool_Validate(t *testing.T) {
 			expectErr: true,
 		},
 
+		"negative max open interest": {
+			m: &VPool{
+				Pair:                   common.MustNewAssetPair("btc:usd"),
+				TradeLimitRatio:        sdk.MustNewDecFromStr("0.10"),
+				QuoteAssetReserve:      sdk.NewDec(1_000_000),
+				BaseAssetReserve:       sdk.NewDec(1_000_000),
+				FluctuationLimitRatio:  sdk.MustNewDecFromStr("0.10"),
+				MaxOracleSpreadRatio:   sdk.MustNewDecFromStr("0.10"),
+				MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
+				MaxLeverage:            sdk.MustNewDecFromStr("15"),
+				MaxOpenInterest:        sdk.NewDec(-1),
+			},
+			expectErr: true,
+		},
+
+		"negative max position size": {
+			m: &VPool{
+				Pair:                   common.MustNewAssetPair("btc:usd"),
+				TradeLimitRatio:        sdk.MustNewDecFromStr("0.10"),
+				QuoteAssetReserve:      sdk.NewDec(1_000_000),
+				BaseAssetReserve:       sdk.NewDec(1_000_000),
+				FluctuationLimitRatio:  sdk.MustNewDecFromStr("0.10"),
+				MaxOracleSpreadRatio:   sdk.MustNewDecFromStr("0.10"),
+				MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
+				MaxLeverage:            sdk.MustNewDecFromStr("15"),
+				MaxPositionSize:        sdk.NewDec(-1),
+			},
+			expectErr: true,
+		},
+
+		"success with open interest and position size caps": {
+			m: &VPool{
+				Pair:                   common.MustNewAssetPair("btc:usd"),
+				TradeLimitRatio:        sdk.MustNewDecFromStr("0.10"),
+				QuoteAssetReserve:      sdk.NewDec(1_000_000),
+				BaseAssetReserve:       sdk.NewDec(1_000_000),
+				FluctuationLimitRatio:  sdk.MustNewDecFromStr("0.10"),
+				MaxOracleSpreadRatio:   sdk.MustNewDecFromStr("0.10"),
+				MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
+				MaxLeverage:            sdk.MustNewDecFromStr("15"),
+				MaxOpenInterest:        sdk.NewDec(100_000),
+				MaxPositionSize:        sdk.NewDec(10_000),
+			},
+			expectErr: false,
+		},
+
 		"success": {
 			m: &VPool{
 				Pair:                   common.MustNewAssetPair("btc:usd"),
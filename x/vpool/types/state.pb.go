@@ -9,16 +9,20 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -108,6 +112,12 @@ type VPool struct {
 	MaintenanceMarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=maintenance_margin_ratio,json=maintenanceMarginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maintenance_margin_ratio"`
 	// max_leverage
 	MaxLeverage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=max_leverage,json=maxLeverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_leverage"`
+	// max_open_interest caps the total size of the long positions, and of the
+	// short positions, on the pool in base asset units. Zero means no cap.
+	MaxOpenInterest github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=max_open_interest,json=maxOpenInterest,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_open_interest"`
+	// max_position_size caps the size of a single position on the pool in base
+	// asset units. Zero means no cap.
+	MaxPositionSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=max_position_size,json=maxPositionSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_position_size"`
 }
 
 func (m *VPool) Reset()         { *m = VPool{} }
@@ -255,6 +265,125 @@ func (m *ReserveSnapshot) GetTimestampMs() int64 {
 	return 0
 }
 
+// PoolSettlement records the shutdown of a vpool. Trading on the vpool is
+// frozen and positions are settled at the settlement price.
+type PoolSettlement struct {
+	Pair common.AssetPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair"`
+	// settlement_price is the price at which positions are settled.
+	SettlementPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=settlement_price,json=settlementPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"settlement_price"`
+	// block_height is the height at which the vpool was shut down.
+	BlockHeight int64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *PoolSettlement) Reset()         { *m = PoolSettlement{} }
+func (m *PoolSettlement) String() string { return proto.CompactTextString(m) }
+func (*PoolSettlement) ProtoMessage()    {}
+func (*PoolSettlement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9da3afd19017067, []int{3}
+}
+func (m *PoolSettlement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolSettlement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolSettlement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolSettlement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolSettlement.Merge(m, src)
+}
+func (m *PoolSettlement) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolSettlement) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolSettlement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolSettlement proto.InternalMessageInfo
+
+func (m *PoolSettlement) GetPair() common.AssetPair {
+	if m != nil {
+		return m.Pair
+	}
+	return common.AssetPair{}
+}
+
+func (m *PoolSettlement) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// Params defines the parameters for the x/vpool module.
+type Params struct {
+	// snapshot_retention_window is how long reserve snapshots are kept before
+	// being pruned. It must be at least the largest TWAP lookback window used to
+	// query the vpools, i.e. the x/perp twap_lookback_window. Zero disables pruning.
+	SnapshotRetentionWindow time.Duration `protobuf:"bytes,1,opt,name=snapshot_retention_window,json=snapshotRetentionWindow,proto3,stdduration" json:"snapshot_retention_window,omitempty" yaml:"snapshot_retention_window"`
+	// repeg_spread_ratio is the spread between the mark price of a vpool and its
+	// index TWAP over which the vpool is repegged to the index TWAP. Zero disables
+	// automatic repegging.
+	RepegSpreadRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=repeg_spread_ratio,json=repegSpreadRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"repeg_spread_ratio"`
+	// repeg_epoch_identifier is the epoch at the end of which the vpools are
+	// checked for repegging.
+	RepegEpochIdentifier string `protobuf:"bytes,3,opt,name=repeg_epoch_identifier,json=repegEpochIdentifier,proto3" json:"repeg_epoch_identifier,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9da3afd19017067, []int{4}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetSnapshotRetentionWindow() time.Duration {
+	if m != nil {
+		return m.SnapshotRetentionWindow
+	}
+	return 0
+}
+
+func (m *Params) GetRepegEpochIdentifier() string {
+	if m != nil {
+		return m.RepegEpochIdentifier
+	}
+	return ""
+}
+
 // PoolPrices is a simple structure that displays a snapshot of the mark and index
 // prices for an asset. Empty strings for the indexPrice or twapMark fields
 // indicate that the price is currently unavailable.
@@ -278,7 +407,7 @@ func (m *PoolPrices) Reset()         { *m = PoolPrices{} }
 func (m *PoolPrices) String() string { return proto.CompactTextString(m) }
 func (*PoolPrices) ProtoMessage()    {}
 func (*PoolPrices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9da3afd19017067, []int{3}
+	return fileDescriptor_e9da3afd19017067, []int{5}
 }
 func (m *PoolPrices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -341,65 +470,81 @@ func init() {
 	proto.RegisterType((*VPool)(nil), "nibiru.vpool.v1.VPool")
 	proto.RegisterType((*CurrentTWAP)(nil), "nibiru.vpool.v1.CurrentTWAP")
 	proto.RegisterType((*ReserveSnapshot)(nil), "nibiru.vpool.v1.ReserveSnapshot")
+	proto.RegisterType((*PoolSettlement)(nil), "nibiru.vpool.v1.PoolSettlement")
+	proto.RegisterType((*Params)(nil), "nibiru.vpool.v1.Params")
 	proto.RegisterType((*PoolPrices)(nil), "nibiru.vpool.v1.PoolPrices")
 }
 
 func init() { proto.RegisterFile("vpool/v1/state.proto", fileDescriptor_e9da3afd19017067) }
 
 var fileDescriptor_e9da3afd19017067 = []byte{
-	// 817 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xdf, 0x6e, 0x1b, 0x45,
-	0x14, 0xc6, 0x6d, 0x27, 0x4e, 0xe3, 0xe3, 0x34, 0x76, 0x27, 0x09, 0xdd, 0x16, 0x64, 0x07, 0x23,
-	0xa1, 0xaa, 0x08, 0x5b, 0x2d, 0x77, 0xdc, 0xf9, 0x5f, 0x91, 0x25, 0x3b, 0xbb, 0x5d, 0xbb, 0xad,
-	0x54, 0x21, 0x46, 0xe3, 0xf5, 0xd4, 0x19, 0x79, 0x67, 0x66, 0x99, 0x9d, 0x75, 0xdd, 0xb7, 0xe0,
-	0x92, 0x27, 0xe0, 0x39, 0xb8, 0xec, 0x65, 0x2f, 0x11, 0x17, 0x11, 0x24, 0x6f, 0xc0, 0x13, 0xa0,
-	0x99, 0x5d, 0x8a, 0x8d, 0xb8, 0x61, 0x81, 0xab, 0xcd, 0x7c, 0xe7, 0xe4, 0xf7, 0x8d, 0xcf, 0x9e,
-	0x73, 0x16, 0x4e, 0xd7, 0x91, 0x94, 0x61, 0x67, 0xfd, 0xa8, 0x13, 0x6b, 0xa2, 0x69, 0x3b, 0x52,
-	0x52, 0x4b, 0x54, 0x13, 0x6c, 0xce, 0x54, 0xd2, 0xb6, 0xc1, 0xf6, 0xfa, 0xd1, 0xfd, 0x7b, 0x81,
-	0x8c, 0xb9, 0x8c, 0xb1, 0x0d, 0x77, 0xd2, 0x43, 0x9a, 0x7b, 0xff, 0x74, 0x29, 0x97, 0x32, 0xd5,
-	0xcd, 0x5f, 0x99, 0x7a, 0x12, 0x48, 0xce, 0xa5, 0xe8, 0xa4, 0x8f, 0x54, 0x6c, 0xfd, 0x5a, 0x86,
-	0xf2, 0x73, 0x4f, 0xca, 0x10, 0x3d, 0x86, 0xfd, 0x88, 0x30, 0xe5, 0x14, 0xcf, 0x8b, 0x0f, 0xaa,
-	0x8f, 0x9d, 0x76, 0xe6, 0x97, 0x65, 0x77, 0xe3, 0x98, 0x6a, 0x8f, 0x30, 0xd5, 0xdb, 0x7f, 0x7b,
-	0xd5, 0x2c, 0xf8, 0x36, 0x17, 0x7d, 0x0d, 0x68, 0x4e, 0x62, 0x8a, 0x89, 0x89, 0x62, 0x45, 0x63,
-	0xaa, 0xd6, 0xd4, 0x29, 0x9d, 0x17, 0x1f, 0x54, 0x7a, 0x6d, 0x93, 0xf7, 0xf3, 0x55, 0xf3, 0xd3,
-	0x25, 0xd3, 0x97, 0xc9, 0xdc, 0x80, 0xb2, 0x5b, 0x66, 0x8f, 0xcf, 0xe3, 0xc5, 0xaa, 0xa3, 0xdf,
-	0x44, 0x34, 0x6e, 0x0f, 0x68, 0xe0, 0xd7, 0x0d, 0xc9, 0xda, 0xf8, 0x29, 0x07, 0x7d, 0x03, 0x27,
-	0xdf, 0x26, 0x52, 0xff, 0x15, 0xbf, 0x97, 0x0b, 0x7f, 0xc7, 0xa2, 0x76, 0xf8, 0x2f, 0xe1, 0x8e,
-	0x56, 0x64, 0x41, 0x71, 0xc8, 0x38, 0xd3, 0x58, 0x11, 0xcd, 0xa4, 0xb3, 0x9f, 0x8b, 0x5e, 0xb3,
-	0xa0, 0xb1, 0xe1, 0xf8, 0x06, 0x83, 0x5e, 0xc1, 0xdd, 0x57, 0x61, 0x12, 0xe8, 0xc4, 0x9c, 0xc4,
-	0x8e, 0x43, 0x39, 0x97, 0xc3, 0xd9, 0x16, 0x6e, 0xcb, 0x87, 0xc2, 0x5d, 0x4e, 0x36, 0x58, 0x2a,
-	0x12, 0x84, 0x14, 0xc7, 0x91, 0xa2, 0x64, 0x91, 0xf9, 0x1c, 0xe4, 0xf2, 0x39, 0xe5, 0x64, 0xe3,
-	0x5a, 0xda, 0xd4, 0xc2, 0x52, 0x9b, 0x4b, 0x70, 0x38, 0x61, 0x42, 0x53, 0x41, 0x44, 0x40, 0x31,
-	0x27, 0x6a, 0xc9, 0x44, 0xe6, 0x73, 0x2b, 0x97, 0xcf, 0x07, 0x5b, 0xbc, 0x89, 0xc5, 0xa5, 0x4e,
-	0x4f, 0xe1, 0xc8, 0xfc, 0xa0, 0x90, 0xae, 0xa9, 0x22, 0x4b, 0xea, 0x1c, 0xe6, 0xa2, 0x57, 0x39,
-	0xd9, 0x8c, 0x33, 0x44, 0xeb, 0xfb, 0x12, 0x54, 0xfb, 0x89, 0x52, 0x54, 0xe8, 0xd9, 0x8b, 0xae,
-	0x87, 0x3e, 0x81, 0x5b, 0xa6, 0x7b, 0x31, 0x5b, 0xd8, 0x66, 0xaf, 0xf4, 0xe0, 0xfa, 0xaa, 0x79,
-	0x60, 0x9a, 0x7b, 0x34, 0xf0, 0x0f, 0x4c, 0x68, 0xb4, 0x40, 0x63, 0xa8, 0x88, 0x84, 0x53, 0x45,
-	0xb4, 0x54, 0x39, 0x3b, 0xfa, 0x4f, 0x00, 0xf2, 0xa0, 0xba, 0xa0, 0x42, 0x72, 0x26, 0x2c, 0x2f,
-	0x5f, 0x0b, 0x6f, 0x23, 0xd0, 0x00, 0xca, 0x91, 0x62, 0x01, 0xcd, 0xd9, 0xb0, 0xe9, 0x3f, 0xb7,
-	0x7e, 0x28, 0x41, 0x2d, 0x1b, 0x87, 0xa9, 0x20, 0x51, 0x7c, 0x29, 0xf5, 0xfb, 0x45, 0x50, 0xfe,
-	0xd7, 0x8b, 0xa0, 0xf8, 0xff, 0x2e, 0x82, 0xd2, 0x7f, 0xb5, 0x08, 0x3e, 0x86, 0x23, 0xcd, 0x38,
-	0x8d, 0x35, 0xe1, 0x11, 0xe6, 0xb1, 0x7d, 0x3d, 0x7b, 0x7e, 0xf5, 0xbd, 0x36, 0x89, 0x5b, 0x3f,
-	0x96, 0x00, 0xcc, 0x9a, 0xf4, 0x4c, 0xd9, 0x62, 0x84, 0xb2, 0x1a, 0x55, 0xcc, 0x15, 0xb2, 0x1a,
-	0x4c, 0x00, 0x38, 0x51, 0x2b, 0x9c, 0xbe, 0x16, 0xc8, 0xd7, 0x32, 0x86, 0x60, 0x3d, 0x50, 0x13,
-	0xaa, 0x4c, 0x2c, 0xe8, 0x26, 0xe3, 0x55, 0xad, 0x13, 0x58, 0x29, 0x4d, 0xf8, 0x10, 0x2a, 0xfa,
-	0x35, 0x89, 0xcc, 0x30, 0xae, 0x9c, 0x23, 0x1b, 0x3e, 0x34, 0xc2, 0x84, 0xa8, 0x15, 0x12, 0x70,
-	0x1c, 0x9b, 0x20, 0x13, 0x6b, 0xa2, 0x18, 0x11, 0xda, 0xb9, 0x6d, 0x2f, 0xf4, 0xd5, 0x3f, 0xb8,
-	0xd0, 0x48, 0xe8, 0xdf, 0xae, 0x9a, 0x67, 0x6f, 0x08, 0x0f, 0xbf, 0x6c, 0xed, 0xd2, 0x5a, 0xfe,
-	0x6d, 0x23, 0x8c, 0xfe, 0x38, 0x9b, 0x12, 0xce, 0x43, 0x19, 0xac, 0xb0, 0x48, 0xf8, 0x9c, 0x2a,
-	0xe7, 0x38, 0x2d, 0xa1, 0xd5, 0x2e, 0xac, 0xf4, 0x70, 0x02, 0x95, 0x01, 0x53, 0x34, 0x30, 0x1b,
-	0x0c, 0xdd, 0x83, 0xb3, 0xc1, 0xc8, 0x1f, 0xf6, 0x67, 0x23, 0xf7, 0x02, 0x3f, 0xbb, 0x98, 0x7a,
-	0xc3, 0xfe, 0xe8, 0xc9, 0x68, 0x38, 0xa8, 0x17, 0x50, 0x0d, 0xaa, 0xdd, 0xc1, 0x00, 0xcf, 0x5c,
-	0xec, 0xb9, 0xee, 0xb8, 0x5e, 0x44, 0xa7, 0x50, 0xf7, 0x87, 0x13, 0xf7, 0xf9, 0x10, 0x3f, 0xf1,
-	0xdd, 0x49, 0xaa, 0x96, 0x1e, 0x2e, 0xe1, 0x78, 0xf6, 0x9a, 0x44, 0x7d, 0x12, 0x06, 0x6e, 0x64,
-	0x99, 0xe7, 0xf0, 0x91, 0x99, 0x6f, 0xdc, 0xef, 0x8e, 0xfb, 0xd8, 0xf5, 0xfe, 0x06, 0x7d, 0x08,
-	0xfb, 0x53, 0xcf, 0x9d, 0xa5, 0xcc, 0xa7, 0xcf, 0xdc, 0xd9, 0x10, 0x77, 0xa7, 0xd3, 0xe1, 0x0c,
-	0x4f, 0x5f, 0x74, 0xbd, 0x7a, 0x09, 0x9d, 0x40, 0xad, 0xd7, 0x9d, 0xee, 0x88, 0x7b, 0xbd, 0xe1,
-	0xdb, 0xeb, 0x46, 0xf1, 0xdd, 0x75, 0xa3, 0xf8, 0xcb, 0x75, 0xa3, 0xf8, 0xdd, 0x4d, 0xa3, 0xf0,
-	0xee, 0xa6, 0x51, 0xf8, 0xe9, 0xa6, 0x51, 0x78, 0xf9, 0xd9, 0x56, 0x11, 0x2f, 0xec, 0x94, 0xf4,
-	0x2f, 0x09, 0x13, 0x9d, 0x74, 0x62, 0x3a, 0x9b, 0x4e, 0xfa, 0x25, 0xb7, 0xd5, 0x9c, 0x1f, 0xd8,
-	0x0f, 0xee, 0x17, 0xbf, 0x07, 0x00, 0x00, 0xff, 0xff, 0xe8, 0xc1, 0x4c, 0x03, 0xdf, 0x07, 0x00,
-	0x00,
+	// 1043 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x4f, 0x73, 0x1b, 0xb5,
+	0x1b, 0xc7, 0xb3, 0x6e, 0xfe, 0x59, 0x4e, 0x63, 0x77, 0x93, 0x34, 0x9b, 0xfe, 0x7e, 0x63, 0x07,
+	0x77, 0x86, 0xe9, 0x14, 0xb0, 0xa7, 0x85, 0x13, 0x37, 0xff, 0x2b, 0x78, 0x26, 0xce, 0x6e, 0x77,
+	0xdd, 0x66, 0xe8, 0x30, 0x68, 0xe4, 0xb5, 0x62, 0x6b, 0xb2, 0x2b, 0x2d, 0x92, 0xec, 0x24, 0x7d,
+	0x15, 0x1c, 0xcb, 0x1b, 0xe0, 0xc0, 0xab, 0x60, 0x38, 0x95, 0x5b, 0x8f, 0x0c, 0x07, 0xc3, 0x24,
+	0x37, 0x8e, 0x7d, 0x05, 0x8c, 0xb4, 0x72, 0xe2, 0x30, 0xf4, 0xc0, 0x02, 0xa7, 0x8d, 0x9e, 0x47,
+	0xf9, 0x7c, 0x25, 0x3d, 0x7a, 0xbe, 0x32, 0xd8, 0x9e, 0x26, 0x8c, 0x45, 0xf5, 0xe9, 0xa3, 0xba,
+	0x90, 0x48, 0xe2, 0x5a, 0xc2, 0x99, 0x64, 0x76, 0x91, 0x92, 0x01, 0xe1, 0x93, 0x9a, 0x4e, 0xd6,
+	0xa6, 0x8f, 0xee, 0xed, 0x85, 0x4c, 0xc4, 0x4c, 0x40, 0x9d, 0xae, 0xa7, 0x83, 0x74, 0xee, 0xbd,
+	0xed, 0x11, 0x1b, 0xb1, 0x34, 0xae, 0xfe, 0x32, 0xd1, 0xf2, 0x88, 0xb1, 0x51, 0x84, 0xeb, 0x7a,
+	0x34, 0x98, 0x1c, 0xd7, 0x87, 0x13, 0x8e, 0x24, 0x61, 0xd4, 0xe4, 0xb7, 0x42, 0x16, 0xc7, 0x8c,
+	0xd6, 0xd3, 0x4f, 0x1a, 0xac, 0x7e, 0xbb, 0x06, 0x56, 0x9e, 0x7b, 0x8c, 0x45, 0xf6, 0x63, 0xb0,
+	0x9c, 0x20, 0xc2, 0x1d, 0x6b, 0xdf, 0x7a, 0x50, 0x78, 0xec, 0xd4, 0xcc, 0x7a, 0xcc, 0xec, 0x86,
+	0x10, 0x58, 0x7a, 0x88, 0xf0, 0xe6, 0xf2, 0xeb, 0x59, 0x65, 0xc9, 0xd7, 0x73, 0xed, 0x2f, 0x81,
+	0x3d, 0x40, 0x02, 0x43, 0xa4, 0xb2, 0x90, 0x63, 0x81, 0xf9, 0x14, 0x3b, 0xb9, 0x7d, 0xeb, 0x41,
+	0xbe, 0x59, 0x53, 0xf3, 0x7e, 0x99, 0x55, 0xde, 0x1f, 0x11, 0x39, 0x9e, 0x0c, 0x14, 0xc8, 0xec,
+	0xc2, 0x7c, 0x3e, 0x12, 0xc3, 0x93, 0xba, 0x3c, 0x4f, 0xb0, 0xa8, 0xb5, 0x71, 0xe8, 0x97, 0x14,
+	0x49, 0xcb, 0xf8, 0x29, 0xc7, 0xfe, 0x0a, 0x6c, 0x7d, 0x3d, 0x61, 0xf2, 0xcf, 0xf8, 0x5b, 0x99,
+	0xf0, 0x77, 0x34, 0xea, 0x06, 0xff, 0x05, 0xb8, 0x23, 0x39, 0x1a, 0x62, 0x18, 0x91, 0x98, 0x48,
+	0xa8, 0x0f, 0xcb, 0x59, 0xce, 0x44, 0x2f, 0x6a, 0xd0, 0x81, 0xe2, 0xf8, 0x0a, 0x63, 0x1f, 0x83,
+	0xdd, 0xe3, 0x68, 0x12, 0xca, 0x89, 0x1a, 0xd1, 0x1b, 0x0a, 0x2b, 0x99, 0x14, 0x76, 0x16, 0x70,
+	0x0b, 0x3a, 0x18, 0xec, 0xc6, 0xe8, 0x0c, 0x32, 0x8e, 0xc2, 0x08, 0x43, 0x91, 0x70, 0x8c, 0x86,
+	0x46, 0x67, 0x35, 0x93, 0xce, 0x76, 0x8c, 0xce, 0x5c, 0x4d, 0x0b, 0x34, 0x2c, 0x95, 0x19, 0x03,
+	0x27, 0x46, 0x84, 0x4a, 0x4c, 0x11, 0x0d, 0x31, 0x8c, 0x11, 0x1f, 0x11, 0x6a, 0x74, 0xd6, 0x32,
+	0xe9, 0xdc, 0x5d, 0xe0, 0xf5, 0x34, 0x2e, 0x55, 0x7a, 0x0a, 0x36, 0xd4, 0x86, 0x22, 0x3c, 0xc5,
+	0x1c, 0x8d, 0xb0, 0xb3, 0x9e, 0x89, 0x5e, 0x88, 0xd1, 0xd9, 0x81, 0x41, 0xa8, 0x3a, 0xeb, 0x33,
+	0x4a, 0x30, 0x85, 0x4a, 0x93, 0x63, 0x21, 0x9d, 0x7c, 0xb6, 0x3a, 0xab, 0xd3, 0x49, 0x30, 0xed,
+	0x1a, 0xcc, 0x9c, 0x9d, 0x30, 0x41, 0x74, 0xa1, 0x05, 0x79, 0x89, 0x1d, 0x90, 0x99, 0xed, 0x19,
+	0x4e, 0x40, 0x5e, 0xe2, 0xea, 0xab, 0x1c, 0x28, 0xb4, 0x26, 0x9c, 0x63, 0x2a, 0xfb, 0x47, 0x0d,
+	0xcf, 0xbe, 0x0f, 0xd6, 0x54, 0xd7, 0x41, 0x32, 0xd4, 0x4d, 0x9a, 0x6f, 0x82, 0x8b, 0x59, 0x65,
+	0x55, 0x35, 0x65, 0xb7, 0xed, 0xaf, 0xaa, 0x54, 0x77, 0x68, 0x1f, 0x80, 0x3c, 0x9d, 0xc4, 0x98,
+	0x23, 0xc9, 0x78, 0xc6, 0x4e, 0xbc, 0x06, 0xd8, 0x1e, 0x28, 0x0c, 0x31, 0x65, 0x31, 0xa1, 0x9a,
+	0x97, 0xad, 0xf5, 0x16, 0x11, 0x76, 0x1b, 0xac, 0x24, 0x9c, 0x84, 0x38, 0x63, 0xa3, 0xa5, 0xff,
+	0x5c, 0xfd, 0x2e, 0x07, 0x8a, 0xa6, 0x8d, 0x03, 0x8a, 0x12, 0x31, 0x66, 0xf2, 0xca, 0xc0, 0x56,
+	0xfe, 0xb1, 0x81, 0x59, 0xff, 0xad, 0x81, 0xe5, 0xfe, 0x2d, 0x03, 0x7b, 0x0f, 0x6c, 0x48, 0x12,
+	0x63, 0x21, 0x51, 0x9c, 0xc0, 0x58, 0xe8, 0xf2, 0xdc, 0xf2, 0x0b, 0x57, 0xb1, 0x9e, 0xa8, 0xfe,
+	0x68, 0x81, 0x4d, 0x65, 0xef, 0x01, 0x96, 0x32, 0xc2, 0x31, 0xa6, 0x32, 0x93, 0xd1, 0x7f, 0x01,
+	0x4a, 0xe2, 0x8a, 0x00, 0xd3, 0x02, 0x66, 0xdb, 0x46, 0xf1, 0x9a, 0xe3, 0x29, 0x8c, 0xda, 0xc4,
+	0x20, 0x62, 0xe1, 0x09, 0x1c, 0x63, 0x32, 0x1a, 0xcb, 0xf9, 0x26, 0x74, 0xec, 0x73, 0x1d, 0xaa,
+	0xfe, 0x94, 0x03, 0xab, 0x1e, 0xe2, 0x28, 0x16, 0xf6, 0xf7, 0x16, 0xd8, 0x13, 0xa6, 0xe2, 0x90,
+	0x63, 0x89, 0xa9, 0x6e, 0xbb, 0x53, 0x42, 0x87, 0xec, 0xd4, 0x6c, 0x69, 0xaf, 0x96, 0xbe, 0x84,
+	0xb5, 0xf9, 0x4b, 0x58, 0x6b, 0x9b, 0x97, 0xb0, 0x19, 0xa8, 0xd5, 0xfe, 0x3e, 0xab, 0xdc, 0x7f,
+	0x27, 0xe3, 0x43, 0x16, 0x13, 0x89, 0xe3, 0x44, 0x9e, 0xbf, 0x9d, 0x55, 0xf6, 0xcf, 0x51, 0x1c,
+	0x7d, 0x5a, 0x7d, 0xe7, 0xe4, 0xea, 0xab, 0x5f, 0x2b, 0x96, 0xbf, 0x3b, 0xcf, 0xfb, 0xf3, 0xf4,
+	0x91, 0xce, 0xaa, 0xdb, 0xc5, 0x71, 0x82, 0x47, 0x37, 0x7d, 0x39, 0xe3, 0xf3, 0xa8, 0x49, 0x8b,
+	0x9e, 0xfc, 0x09, 0xb8, 0x9b, 0xd2, 0x71, 0xc2, 0xc2, 0x31, 0x24, 0x43, 0xa5, 0x7d, 0x4c, 0xb0,
+	0x69, 0x53, 0x7f, 0x5b, 0x67, 0x3b, 0x2a, 0xd9, 0xbd, 0xca, 0x55, 0x7f, 0xc8, 0x01, 0xa0, 0x2e,
+	0x84, 0x3e, 0x7c, 0x61, 0xdb, 0xe6, 0x32, 0x68, 0x3b, 0x34, 0xc5, 0xee, 0x01, 0x10, 0x23, 0x7e,
+	0x62, 0xca, 0x9c, 0xcd, 0xcc, 0xf2, 0x8a, 0x90, 0x16, 0xb8, 0x02, 0x0a, 0x84, 0x0e, 0xf1, 0x99,
+	0xe1, 0x15, 0xb4, 0x12, 0xd0, 0xa1, 0x74, 0xc2, 0xff, 0x40, 0x5e, 0x9e, 0xa2, 0x44, 0xbd, 0x2a,
+	0x27, 0xce, 0x86, 0x4e, 0xaf, 0xab, 0x40, 0x0f, 0xf1, 0x13, 0x9b, 0x82, 0x4d, 0xa1, 0x92, 0x84,
+	0x4e, 0x11, 0x27, 0x88, 0x4a, 0xe7, 0xb6, 0x5e, 0xd0, 0x67, 0x7f, 0x63, 0x41, 0x5d, 0x2a, 0xdf,
+	0xce, 0x2a, 0x3b, 0xa6, 0x98, 0x37, 0x68, 0x55, 0xff, 0xb6, 0x0a, 0x74, 0xe7, 0xe3, 0xeb, 0xeb,
+	0x48, 0x27, 0xf1, 0x00, 0x73, 0x67, 0x73, 0xe1, 0x3a, 0x1e, 0xea, 0xd0, 0xc3, 0x1e, 0xc8, 0xb7,
+	0x09, 0xc7, 0xa1, 0xaa, 0xb4, 0xbd, 0x07, 0x76, 0xda, 0x5d, 0xbf, 0xd3, 0xea, 0x77, 0xdd, 0x43,
+	0xf8, 0xec, 0x30, 0xf0, 0x3a, 0xad, 0xee, 0x93, 0x6e, 0xa7, 0x5d, 0x5a, 0xb2, 0x8b, 0xa0, 0xd0,
+	0x68, 0xb7, 0x61, 0xdf, 0x85, 0x9e, 0xeb, 0x1e, 0x94, 0x2c, 0x7b, 0x1b, 0x94, 0xfc, 0x4e, 0xcf,
+	0x7d, 0xde, 0x81, 0x4f, 0x7c, 0xb7, 0x97, 0x46, 0x73, 0x0f, 0x47, 0x60, 0xb3, 0x7f, 0x8a, 0x92,
+	0x16, 0x8a, 0x42, 0x37, 0xd1, 0xcc, 0x7d, 0xf0, 0x7f, 0x65, 0xf8, 0xb0, 0xd5, 0x38, 0x68, 0x41,
+	0xd7, 0xfb, 0x0b, 0xf4, 0x3a, 0x58, 0x0e, 0x3c, 0xb7, 0x9f, 0x32, 0x9f, 0x3e, 0x73, 0xfb, 0x1d,
+	0xd8, 0x08, 0x82, 0x4e, 0x1f, 0x06, 0x47, 0x0d, 0xaf, 0x94, 0xb3, 0xb7, 0x40, 0xb1, 0xd9, 0x08,
+	0x6e, 0x04, 0x6f, 0x35, 0x3b, 0xaf, 0x2f, 0xca, 0xd6, 0x9b, 0x8b, 0xb2, 0xf5, 0xdb, 0x45, 0xd9,
+	0xfa, 0xe6, 0xb2, 0xbc, 0xf4, 0xe6, 0xb2, 0xbc, 0xf4, 0xf3, 0x65, 0x79, 0xe9, 0xc5, 0x07, 0x0b,
+	0x87, 0x78, 0xa8, 0xed, 0xa0, 0x35, 0x46, 0x84, 0xd6, 0x53, 0x6b, 0xa8, 0x9f, 0xd5, 0xd3, 0x9f,
+	0xac, 0xfa, 0x34, 0x07, 0xab, 0xba, 0xad, 0x3e, 0xfe, 0x63, 0x00, 0x62, 0x08, 0xa4, 0xb0, 0xc8,
+	0x0a, 0x00, 0x00,
 }
 
 func (m *VPool) Marshal() (dAtA []byte, err error) {
@@ -422,6 +567,26 @@ func (m *VPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxPositionSize.Size()
+		i -= size
+		if _, err := m.MaxPositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.MaxOpenInterest.Size()
+		i -= size
+		if _, err := m.MaxOpenInterest.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	{
 		size := m.MaxLeverage.Size()
 		i -= size
@@ -623,6 +788,102 @@ func (m *ReserveSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoolSettlement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolSettlement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolSettlement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.SettlementPrice.Size()
+		i -= size
+		if _, err := m.SettlementPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RepegEpochIdentifier) > 0 {
+		i -= len(m.RepegEpochIdentifier)
+		copy(dAtA[i:], m.RepegEpochIdentifier)
+		i = encodeVarintState(dAtA, i, uint64(len(m.RepegEpochIdentifier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.RepegSpreadRatio.Size()
+		i -= size
+		if _, err := m.RepegSpreadRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.SnapshotRetentionWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.SnapshotRetentionWindow):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintState(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *PoolPrices) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -725,6 +986,10 @@ func (m *VPool) Size() (n int) {
 	n += 1 + l + sovState(uint64(l))
 	l = m.MaxLeverage.Size()
 	n += 1 + l + sovState(uint64(l))
+	l = m.MaxOpenInterest.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.MaxPositionSize.Size()
+	n += 1 + l + sovState(uint64(l))
 	return n
 }
 
@@ -765,6 +1030,39 @@ func (m *ReserveSnapshot) Size() (n int) {
 	return n
 }
 
+func (m *PoolSettlement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.SettlementPrice.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovState(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.SnapshotRetentionWindow)
+	n += 1 + l + sovState(uint64(l))
+	l = m.RepegSpreadRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = len(m.RepegEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
 func (m *PoolPrices) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1099,6 +1397,74 @@ func (m *VPool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenInterest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOpenInterest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -1474,6 +1840,291 @@ func (m *ReserveSnapshot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PoolSettlement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolSettlement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolSettlement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SettlementPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotRetentionWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.SnapshotRetentionWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepegSpreadRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RepegSpreadRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepegEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepegEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PoolPrices) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0